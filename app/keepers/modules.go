@@ -30,17 +30,21 @@ import (
 	icq "github.com/strangelove-ventures/async-icq/v4"
 
 	_ "github.com/osmosis-labs/osmosis/v14/client/docs/statik"
+	concentratedliquidityclient "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/client"
 	concentratedliquidity "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/clmodule"
 	downtimemodule "github.com/osmosis-labs/osmosis/v14/x/downtime-detector/module"
 	"github.com/osmosis-labs/osmosis/v14/x/epochs"
 	"github.com/osmosis-labs/osmosis/v14/x/gamm"
+	gammclient "github.com/osmosis-labs/osmosis/v14/x/gamm/client"
 	ibc_rate_limit "github.com/osmosis-labs/osmosis/v14/x/ibc-rate-limit"
 	"github.com/osmosis-labs/osmosis/v14/x/incentives"
+	incentivesclient "github.com/osmosis-labs/osmosis/v14/x/incentives/client"
 	"github.com/osmosis-labs/osmosis/v14/x/lockup"
 	"github.com/osmosis-labs/osmosis/v14/x/mint"
 	poolincentives "github.com/osmosis-labs/osmosis/v14/x/pool-incentives"
 	poolincentivesclient "github.com/osmosis-labs/osmosis/v14/x/pool-incentives/client"
 	poolmanager "github.com/osmosis-labs/osmosis/v14/x/poolmanager/module"
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/poolsnapshotmodule"
 	"github.com/osmosis-labs/osmosis/v14/x/protorev"
 	superfluid "github.com/osmosis-labs/osmosis/v14/x/superfluid"
 	superfluidclient "github.com/osmosis-labs/osmosis/v14/x/superfluid/client"
@@ -68,6 +72,8 @@ var AppModuleBasics = []module.AppModuleBasic{
 			distrclient.ProposalHandler,
 			upgradeclient.ProposalHandler,
 			upgradeclient.CancelProposalHandler,
+			incentivesclient.CancelGaugeProposalHandler,
+			incentivesclient.EnableGaugeClaimsProposalHandler,
 			poolincentivesclient.UpdatePoolIncentivesHandler,
 			poolincentivesclient.ReplacePoolIncentivesHandler,
 			ibcclientclient.UpdateClientProposalHandler,
@@ -75,6 +81,8 @@ var AppModuleBasics = []module.AppModuleBasic{
 			superfluidclient.SetSuperfluidAssetsProposalHandler,
 			superfluidclient.RemoveSuperfluidAssetsProposalHandler,
 			superfluidclient.UpdateUnpoolWhitelistProposalHandler,
+			concentratedliquidityclient.UpdatePoolSwapFeeAndTickSpacingProposalHandler,
+			gammclient.SetStableSwapFeesProposalHandler,
 		)...,
 	),
 	params.AppModuleBasic{},
@@ -89,6 +97,7 @@ var AppModuleBasics = []module.AppModuleBasic{
 	gamm.AppModuleBasic{},
 	poolmanager.AppModuleBasic{},
 	twapmodule.AppModuleBasic{},
+	poolsnapshotmodule.AppModuleBasic{},
 	concentratedliquidity.AppModuleBasic{},
 	protorev.AppModuleBasic{},
 	txfees.AppModuleBasic{},
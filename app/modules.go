@@ -74,6 +74,8 @@ import (
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v14/x/pool-incentives/types"
 	poolmanager "github.com/osmosis-labs/osmosis/v14/x/poolmanager/module"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/poolsnapshotmodule"
+	poolsnapshottypes "github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/types"
 	"github.com/osmosis-labs/osmosis/v14/x/protorev"
 	protorevtypes "github.com/osmosis-labs/osmosis/v14/x/protorev/types"
 	superfluid "github.com/osmosis-labs/osmosis/v14/x/superfluid"
@@ -152,6 +154,7 @@ func appModules(
 		gamm.NewAppModule(appCodec, *app.GAMMKeeper, app.AccountKeeper, app.BankKeeper),
 		poolmanager.NewAppModule(*app.PoolManagerKeeper, app.GAMMKeeper),
 		twapmodule.NewAppModule(*app.TwapKeeper),
+		poolsnapshotmodule.NewAppModule(*app.PoolSnapshotKeeper),
 		concentratedliquidity.NewAppModule(appCodec, *app.ConcentratedLiquidityKeeper),
 		protorev.NewAppModule(appCodec, *app.ProtoRevKeeper, app.AccountKeeper, app.BankKeeper, app.EpochsKeeper, app.GAMMKeeper),
 		txfees.NewAppModule(*app.TxFeesKeeper),
@@ -206,7 +209,7 @@ func OrderEndBlockers(allModuleNames []string) []string {
 	ord.FirstElements(govtypes.ModuleName)
 	ord.LastElements(stakingtypes.ModuleName)
 
-	// only Osmosis modules with endblock code are: twap, crisis, govtypes, staking
+	// only Osmosis modules with endblock code are: twap, poolsnapshot, crisis, govtypes, staking
 	// we don't care about the relative ordering between them.
 	return ord.TotalOrdering()
 }
@@ -233,8 +236,11 @@ func OrderInitGenesis(allModuleNames []string) []string {
 		icatypes.ModuleName,
 		gammtypes.ModuleName,
 		poolmanagertypes.ModuleName,
+		// concentrated liquidity before txfees, so that txfees indexes its pools trading the base denom.
+		concentratedliquiditytypes.ModuleName,
 		protorevtypes.ModuleName,
 		twaptypes.ModuleName,
+		poolsnapshottypes.ModuleName,
 		txfeestypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -250,7 +256,6 @@ func OrderInitGenesis(allModuleNames []string) []string {
 		epochstypes.ModuleName,
 		lockuptypes.ModuleName,
 		authz.ModuleName,
-		concentratedliquiditytypes.ModuleName,
 		// wasm after ibc transfer
 		wasm.ModuleName,
 		// ibc_hooks after auth keeper
@@ -8,6 +8,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v14/app/upgrades"
 	cltypes "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
+	poolsnapshottypes "github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/types"
 	protorevtypes "github.com/osmosis-labs/osmosis/v14/x/protorev/types"
 	valsetpreftypes "github.com/osmosis-labs/osmosis/v14/x/valset-pref/types"
 )
@@ -19,7 +20,7 @@ var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateUpgradeHandler,
 	StoreUpgrades: store.StoreUpgrades{
-		Added:   []string{poolmanagertypes.StoreKey, cltypes.StoreKey, valsetpreftypes.StoreKey, protorevtypes.StoreKey, icqtypes.StoreKey, packetforwardtypes.StoreKey},
+		Added:   []string{poolmanagertypes.StoreKey, cltypes.StoreKey, valsetpreftypes.StoreKey, protorevtypes.StoreKey, icqtypes.StoreKey, packetforwardtypes.StoreKey, poolsnapshottypes.StoreKey},
 		Deleted: []string{},
 	},
 }
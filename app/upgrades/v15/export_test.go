@@ -5,6 +5,7 @@ import (
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	"github.com/osmosis-labs/osmosis/v14/app/keepers"
 	gammkeeper "github.com/osmosis-labs/osmosis/v14/x/gamm/keeper"
 	poolmanagerkeeper "github.com/osmosis-labs/osmosis/v14/x/poolmanager"
 )
@@ -16,3 +17,11 @@ func MigrateNextPoolId(ctx sdk.Context, gammKeeper *gammkeeper.Keeper, poolmanag
 func RegisterOsmoIonMetadata(ctx sdk.Context, bankKeeper bankkeeper.Keeper) {
 	registerOsmoIonMetadata(ctx, bankKeeper)
 }
+
+func SetScalingFactorRampDuration(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	return setScalingFactorRampDuration(ctx, keepers)
+}
+
+func SetTwapRetentionParams(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	return setTwapRetentionParams(ctx, keepers)
+}
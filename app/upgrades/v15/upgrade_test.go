@@ -3,8 +3,11 @@ package v15_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	gamm "github.com/osmosis-labs/osmosis/v14/x/gamm/keeper"
+	gammtypes "github.com/osmosis-labs/osmosis/v14/x/gamm/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v14/x/twap/types"
 
 	"github.com/stretchr/testify/suite"
 
@@ -95,3 +98,38 @@ func (suite *UpgradeTestSuite) TestRegisterOsmoIonMetadata() {
 	suite.Require().Equal(expectedUosmodenom, uosmoMetadata.Base)
 	suite.Require().Equal(expectedUiondenom, uionMetadata.Base)
 }
+
+func (suite *UpgradeTestSuite) TestSetScalingFactorRampDuration() {
+	suite.SetupTest() // reset
+
+	ctx := suite.Ctx
+	paramSpace, found := suite.App.ParamsKeeper.GetSubspace(gammtypes.ModuleName)
+	suite.Require().True(found)
+	paramSpace.Set(ctx, gammtypes.KeyScalingFactorRampDuration, time.Duration(0))
+
+	// system under test.
+	err := v15.SetScalingFactorRampDuration(ctx, &suite.App.AppKeepers)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(gammtypes.DefaultScalingFactorRampDuration, suite.App.GAMMKeeper.GetParams(ctx).ScalingFactorRampDuration)
+}
+
+func (suite *UpgradeTestSuite) TestSetTwapRetentionParams() {
+	suite.SetupTest() // reset
+
+	ctx := suite.Ctx
+	paramSpace, found := suite.App.ParamsKeeper.GetSubspace(twaptypes.ModuleName)
+	suite.Require().True(found)
+	paramSpace.Set(ctx, twaptypes.KeyArchivedRecordInterval, time.Duration(0))
+	paramSpace.Set(ctx, twaptypes.KeyArchivedRecordKeepPeriod, time.Duration(0))
+
+	// system under test.
+	err := v15.SetTwapRetentionParams(ctx, &suite.App.AppKeepers)
+	suite.Require().NoError(err)
+
+	params := suite.App.TwapKeeper.GetParams(ctx)
+	suite.Require().Empty(params.PoolRecordHistoryKeepPeriods)
+	suite.Require().Equal(twaptypes.DefaultArchivedRecordInterval, params.ArchivedRecordInterval)
+	suite.Require().Equal(twaptypes.DefaultArchivedRecordKeepPeriod, params.ArchivedRecordKeepPeriod)
+	suite.Require().NoError(params.Validate())
+}
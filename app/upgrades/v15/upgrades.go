@@ -1,6 +1,8 @@
 package v15
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -14,8 +16,10 @@ import (
 	appParams "github.com/osmosis-labs/osmosis/v14/app/params"
 	"github.com/osmosis-labs/osmosis/v14/app/upgrades"
 	gammkeeper "github.com/osmosis-labs/osmosis/v14/x/gamm/keeper"
+	gammtypes "github.com/osmosis-labs/osmosis/v14/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v14/x/poolmanager"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v14/x/twap/types"
 )
 
 func CreateUpgradeHandler(
@@ -25,6 +29,12 @@ func CreateUpgradeHandler(
 	keepers *keepers.AppKeepers,
 ) upgradetypes.UpgradeHandler {
 	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// The scaling factor ramp duration is a new gamm param. It is set before
+		// the gamm params are read below.
+		if err := setScalingFactorRampDuration(ctx, keepers); err != nil {
+			return nil, err
+		}
+
 		poolmanagerParams := poolmanagertypes.NewParams(keepers.GAMMKeeper.GetParams(ctx).PoolCreationFee)
 
 		keepers.PoolManagerKeeper.SetParams(ctx, poolmanagerParams)
@@ -43,10 +53,50 @@ func CreateUpgradeHandler(
 		// They are added in this upgrade.
 		registerOsmoIonMetadata(ctx, keepers.BankKeeper)
 
+		// Locks are indexed by creation time for ByTime gauges starting with this upgrade.
+		// Existing locks have a zero creation time, so they are indexed as the oldest locks.
+		if err := keepers.LockupKeeper.AddCreationTimeLockRefs(ctx); err != nil {
+			return nil, err
+		}
+
+		// Per pool record history keep periods and archived records are new twap params.
+		if err := setTwapRetentionParams(ctx, keepers); err != nil {
+			return nil, err
+		}
+
+		// TWAP records gained a squared log price accumulator, used for volatility queries.
+		// It is backfilled for existing records from their recorded spot prices.
+		if err := keepers.TwapKeeper.MigrateSquaredLogPriceAccumulators(ctx); err != nil {
+			return nil, err
+		}
+
+		// Fee tokens pick their deepest pool out of the pools trading the base denom, indexed starting with this upgrade.
+		keepers.TxFeesKeeper.IndexBaseDenomPools(ctx)
+
 		return mm.RunMigrations(ctx, configurator, fromVM)
 	}
 }
 
+func setScalingFactorRampDuration(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	paramSpace, ok := keepers.ParamsKeeper.GetSubspace(gammtypes.ModuleName)
+	if !ok {
+		return fmt.Errorf("gamm param subspace not found")
+	}
+	paramSpace.Set(ctx, gammtypes.KeyScalingFactorRampDuration, gammtypes.DefaultScalingFactorRampDuration)
+	return nil
+}
+
+func setTwapRetentionParams(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	paramSpace, ok := keepers.ParamsKeeper.GetSubspace(twaptypes.ModuleName)
+	if !ok {
+		return fmt.Errorf("twap param subspace not found")
+	}
+	paramSpace.Set(ctx, twaptypes.KeyPoolRecordHistoryKeepPeriods, []twaptypes.PoolRecordHistoryKeepPeriod{})
+	paramSpace.Set(ctx, twaptypes.KeyArchivedRecordInterval, twaptypes.DefaultArchivedRecordInterval)
+	paramSpace.Set(ctx, twaptypes.KeyArchivedRecordKeepPeriod, twaptypes.DefaultArchivedRecordKeepPeriod)
+	return nil
+}
+
 func migrateNextPoolId(ctx sdk.Context, gammKeeper *gammkeeper.Keeper, poolmanagerKeeper *poolmanager.Keeper) {
 	// N.B: pool id in gamm is to be deprecated in the future
 	// Instead,it is moved to poolmanager.
@@ -61,6 +111,9 @@ func migrateNextPoolId(ctx sdk.Context, gammKeeper *gammkeeper.Keeper, poolmanag
 		}
 
 		poolmanagerKeeper.SetPoolRoute(ctx, poolId, poolType)
+		if err := poolmanagerKeeper.IndexPoolDenoms(ctx, poolId); err != nil {
+			panic(err)
+		}
 	}
 }
 
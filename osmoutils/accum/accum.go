@@ -46,6 +46,29 @@ func MakeAccumulator(accumStore store.KVStore, accumName string) error {
 	return nil
 }
 
+// MakeAccumulatorWithValueAndShare makes a new accumulator at store/accum/{accumName}
+// with the given value and total shares. This is useful for restoring an accumulator,
+// for example from genesis. Positions are not created and must be restored separately.
+// Returns error if already exists / theres some overlapping keys or if the value is negative.
+func MakeAccumulatorWithValueAndShare(accumStore store.KVStore, accumName string, accumValue sdk.DecCoins, totalShares sdk.Dec) error {
+	if accumStore.Has(formatAccumPrefixKey(accumName)) {
+		return errors.New("Accumulator with given name already exists in store")
+	}
+	if accumValue.IsAnyNegative() {
+		return NegativeCustomAccError{accumValue}
+	}
+	if totalShares.IsNegative() {
+		return fmt.Errorf("total shares must be non-negative, was (%s)", totalShares)
+	}
+
+	newAccum := AccumulatorObject{accumStore, accumName, accumValue, totalShares}
+
+	// Stores accumulator in state
+	setAccumulator(newAccum, accumValue, totalShares)
+
+	return nil
+}
+
 // Gets the current value of the accumulator corresponding to accumName in accumStore
 func GetAccumulator(accumStore store.KVStore, accumName string) (AccumulatorObject, error) {
 	accumContent := AccumulatorContent{}
@@ -286,6 +309,51 @@ func (accum AccumulatorObject) SetPositionCustomAcc(name string, customAccumulat
 	return nil
 }
 
+// SetPositionIntervalAccumulation sets the position's accumulator to the given value.
+// Does not update shares or attempt to claim rewards.
+// Unlike SetPositionCustomAcc, the new value is not required to be greater than or equal
+// to the old one. This is needed by callers that track growth within an interval (e.g. a tick range)
+// rather than the global accumulator value, where the snapshot may legitimately be lower than
+// the value the position was last moved up to by a claim.
+// All custom accumulator values must be non-negative.
+// Returns nil on success, error otherwise.
+func (accum AccumulatorObject) SetPositionIntervalAccumulation(name string, intervalAccumulationPerShare sdk.DecCoins) error {
+	if intervalAccumulationPerShare.IsAnyNegative() {
+		return NegativeCustomAccError{intervalAccumulationPerShare}
+	}
+
+	// Get addr's current position
+	position, err := GetPosition(accum, name)
+	if err != nil {
+		return err
+	}
+
+	initOrUpdatePosition(accum, intervalAccumulationPerShare, name, position.NumShares, position.UnclaimedRewards, position.Options)
+
+	return nil
+}
+
+// SetPositionRecord sets the record of the position with the given name as is,
+// overwriting any existing record. Unlike NewPosition, it does not update the
+// accumulator's total shares, so the caller is responsible for keeping them consistent.
+// This is useful for restoring positions, for example from genesis.
+// Returns error if the record has negative shares or a negative initial accumulator value.
+func (accum AccumulatorObject) SetPositionRecord(name string, record Record) error {
+	if record.NumShares.IsNegative() {
+		return fmt.Errorf("number of shares must be non-negative, was (%s)", record.NumShares)
+	}
+	if record.InitAccumValue.IsAnyNegative() {
+		return NegativeCustomAccError{record.InitAccumValue}
+	}
+	if err := record.Options.validate(); err != nil {
+		return err
+	}
+
+	initOrUpdatePosition(accum, record.InitAccumValue, name, record.NumShares, record.UnclaimedRewards, record.Options)
+
+	return nil
+}
+
 func (accum AccumulatorObject) deletePosition(name string) {
 	accum.store.Delete(formatPositionPrefixKey(accum.name, name))
 }
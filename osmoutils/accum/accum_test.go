@@ -1,6 +1,7 @@
 package accum_test
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
 
@@ -1463,6 +1464,182 @@ func (suite *AccumTestSuite) TestSetPositionCustomAcc() {
 	}
 }
 
+func (suite *AccumTestSuite) TestSetPositionIntervalAccumulation() {
+	// We setup store and accum
+	// once at beginning.
+	suite.SetupTest()
+
+	// Setup.
+	var (
+		accObject           = accumPackage.MakeTestAccumulator(suite.store, testNameOne, initialCoinsDenomOne, emptyDec)
+		validPositionName   = testAddressThree
+		invalidPositionName = testAddressTwo
+	)
+
+	tests := map[string]struct {
+		positionName         string
+		intervalAccumulation sdk.DecCoins
+		expectedError        error
+	}{
+		"valid update greater than initial value": {
+			positionName:         validPositionName,
+			intervalAccumulation: initialCoinsDenomOne.Add(initialCoinDenomOne),
+		},
+		"valid update equal to the initial value": {
+			positionName:         validPositionName,
+			intervalAccumulation: initialCoinsDenomOne,
+		},
+		"valid update smaller than the initial value": {
+			positionName:         validPositionName,
+			intervalAccumulation: emptyCoins,
+		},
+		"valid update smaller than the initial value (non-empty value)": {
+			positionName:         validPositionName,
+			intervalAccumulation: initialCoinsDenomOne.QuoDec(sdk.NewDec(2)),
+		},
+		"invalid negative value": {
+			positionName:         validPositionName,
+			intervalAccumulation: sdk.DecCoins{sdk.DecCoin{Denom: denomOne, Amount: sdk.NewDec(-1)}},
+
+			expectedError: accumPackage.NegativeCustomAccError{CustomAccumulatorValue: sdk.DecCoins{sdk.DecCoin{Denom: denomOne, Amount: sdk.NewDec(-1)}}},
+		},
+		"invalid position - different name": {
+			positionName:  invalidPositionName,
+			expectedError: accumPackage.NoPositionError{Name: invalidPositionName},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			// Setup
+			err := accObject.NewPositionCustomAcc(validPositionName, sdk.OneDec(), initialCoinsDenomOne, nil)
+			suite.Require().NoError(err)
+
+			// System under test.
+			err = accObject.SetPositionIntervalAccumulation(tc.positionName, tc.intervalAccumulation)
+
+			// Assertions.
+			if tc.expectedError != nil {
+				suite.Require().Error(err)
+				suite.Require().Equal(tc.expectedError, err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			position := accObject.GetPosition(tc.positionName)
+			suite.Require().Equal(tc.intervalAccumulation, position.GetInitAccumValue())
+			// unchanged
+			suite.Require().Equal(sdk.OneDec(), position.NumShares)
+			suite.Require().Equal(emptyCoins, position.GetUnclaimedRewards())
+		})
+	}
+}
+
+func (suite *AccumTestSuite) TestMakeAccumulatorWithValueAndShare() {
+	tests := map[string]struct {
+		accumValue    sdk.DecCoins
+		totalShares   sdk.Dec
+		preExisting   bool
+		expectedError bool
+	}{
+		"valid accumulator with value and shares": {
+			accumValue:  initialCoinsDenomOne,
+			totalShares: sdk.NewDec(100),
+		},
+		"valid accumulator with zero value and shares": {
+			accumValue:  emptyCoins,
+			totalShares: emptyDec,
+		},
+		"invalid: duplicate accumulator": {
+			accumValue:    initialCoinsDenomOne,
+			totalShares:   sdk.NewDec(100),
+			preExisting:   true,
+			expectedError: true,
+		},
+		"invalid: negative value": {
+			accumValue:    sdk.DecCoins{sdk.DecCoin{Denom: denomOne, Amount: sdk.NewDec(-1)}},
+			totalShares:   sdk.NewDec(100),
+			expectedError: true,
+		},
+		"invalid: negative total shares": {
+			accumValue:    initialCoinsDenomOne,
+			totalShares:   sdk.NewDec(-1),
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			if tc.preExisting {
+				suite.Require().NoError(accumPackage.MakeAccumulator(suite.store, testNameOne))
+			}
+
+			// System under test.
+			err := accumPackage.MakeAccumulatorWithValueAndShare(suite.store, testNameOne, tc.accumValue, tc.totalShares)
+
+			if tc.expectedError {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			accum, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.accumValue, accum.GetValue())
+			suite.Require().Equal(tc.totalShares, accum.GetTotalShares())
+		})
+	}
+}
+
+func (suite *AccumTestSuite) TestSetPositionRecord() {
+	tests := map[string]struct {
+		record        accumPackage.Record
+		expectedError error
+	}{
+		"valid record": {
+			record: withUnclaimedRewards(withInitialAccumValue(positionOne, initialCoinsDenomOne), initialCoinsDenomOne),
+		},
+		"valid record with zero shares": {
+			record: withInitialAccumValue(accumPackage.Record{NumShares: emptyDec, UnclaimedRewards: emptyCoins}, initialCoinsDenomOne),
+		},
+		"invalid: negative shares": {
+			record:        accumPackage.Record{NumShares: sdk.NewDec(-1), InitAccumValue: emptyCoins, UnclaimedRewards: emptyCoins},
+			expectedError: fmt.Errorf("number of shares must be non-negative, was (%s)", sdk.NewDec(-1)),
+		},
+		"invalid: negative initial accumulator value": {
+			record:        withInitialAccumValue(positionOne, sdk.DecCoins{sdk.DecCoin{Denom: denomOne, Amount: sdk.NewDec(-1)}}),
+			expectedError: accumPackage.NegativeCustomAccError{CustomAccumulatorValue: sdk.DecCoins{sdk.DecCoin{Denom: denomOne, Amount: sdk.NewDec(-1)}}},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			accObject := accumPackage.MakeTestAccumulator(suite.store, testNameOne, initialCoinsDenomOne, emptyDec)
+
+			// System under test.
+			err := accObject.SetPositionRecord(testAddressOne, tc.record)
+
+			if tc.expectedError != nil {
+				suite.Require().Error(err)
+				suite.Require().Equal(tc.expectedError, err)
+				hasPosition, err := accObject.HasPosition(testAddressOne)
+				suite.Require().NoError(err)
+				suite.Require().False(hasPosition)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// The record is stored as is and the accumulator's total shares are unchanged.
+			suite.Require().Equal(tc.record, accObject.GetPosition(testAddressOne))
+			accum, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+			suite.Require().NoError(err)
+			suite.Require().Equal(emptyDec, accum.GetTotalShares())
+		})
+	}
+}
+
 // We run a series of partially random operations on two accumulators to ensure that total shares are properly tracked in state
 func (suite *AccumTestSuite) TestGetTotalShares() {
 	suite.SetupTest()
@@ -100,7 +100,13 @@ func resetCommandFlagValues(cmd *cobra.Command) error {
 	var retErr error = nil
 	cmd.Flags().VisitAll(func(f *pflag.Flag) {
 		if f.Changed {
-			err := f.Value.Set(f.DefValue)
+			var err error
+			// Setting a slice flag appends to it, so empty slice flags are replaced instead.
+			if sliceValue, ok := f.Value.(pflag.SliceValue); ok && f.DefValue == "[]" {
+				err = sliceValue.Replace([]string{})
+			} else {
+				err = f.Value.Set(f.DefValue)
+			}
 			if err != nil {
 				retErr = err
 			}
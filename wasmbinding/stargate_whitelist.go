@@ -118,6 +118,11 @@ func init() {
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/NumPools", &poolmanagerqueryproto.NumPoolsResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn", &poolmanagerqueryproto.EstimateSwapExactAmountInResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut", &poolmanagerqueryproto.EstimateSwapExactAmountOutRequest{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountInWithPriceLimit", &poolmanagerqueryproto.EstimateSwapExactAmountInWithPriceLimitResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOutWithPriceLimit", &poolmanagerqueryproto.EstimateSwapExactAmountOutWithPriceLimitResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSplitRouteSwapExactAmountIn", &poolmanagerqueryproto.EstimateSplitRouteSwapExactAmountInResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSplitRouteSwapExactAmountOut", &poolmanagerqueryproto.EstimateSplitRouteSwapExactAmountOutResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/RouteQuote", &poolmanagerqueryproto.RouteQuoteResponse{})
 
 	// txfees
 	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/FeeTokens", &txfeestypes.QueryFeeTokensResponse{})
@@ -135,6 +140,11 @@ func init() {
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow", &twapquerytypes.ArithmeticTwapToNowResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwap", &twapquerytypes.GeometricTwapResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwapToNow", &twapquerytypes.GeometricTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/HarmonicMeanTwap", &twapquerytypes.HarmonicMeanTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/HarmonicMeanTwapToNow", &twapquerytypes.HarmonicMeanTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Volatility", &twapquerytypes.VolatilityResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/VolatilityToNow", &twapquerytypes.VolatilityToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/BatchTwap", &twapquerytypes.BatchTwapResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Params", &twapquerytypes.ParamsResponse{})
 
 	// downtime-detector
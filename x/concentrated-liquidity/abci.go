@@ -0,0 +1,58 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+)
+
+// BeginBlock syncs the uptime accumulators of every concentrated liquidity pool with emitting incentive records
+// to the current block time, so that incentives are emitted to positions at every block regardless of pool activity.
+// Pools without emitting records are skipped: they have nothing to emit, and are synced lazily whenever their
+// liquidity changes or an incentive is created. Only pools marked as having incentive records are visited, and
+// records are deleted once fully emitted, so the cost of a block does not grow with every incentive ever created.
+// Failures are isolated per pool: an error syncing one pool is logged and its changes are discarded.
+func (k Keeper) BeginBlock(ctx sdk.Context) {
+	poolIds, err := k.getPoolIdsWithEmittingIncentives(ctx)
+	if err != nil {
+		ctx.Logger().Error("concentrated-liquidity, could not get pools to update uptime accumulators. " + err.Error())
+		return
+	}
+
+	for _, poolId := range poolIds {
+		poolId := poolId
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.updateUptimeAccumulatorsToNow(ctx, poolId)
+		})
+	}
+
+	k.removeUptimeRecordsOfUnfrozenPositions(ctx)
+}
+
+// removeUptimeRecordsOfUnfrozenPositions ends the uptime incentive eligibility of every position whose freeze has
+// ended by the current block time. A position is eligible for an uptime's incentives only while it is committed to
+// stay in the pool for that uptime, so once its freeze ends, its accrued incentives are collected to its owner and its
+// liquidity stops qualifying. Each position is removed from the freeze index, so it is only visited once per freeze.
+// Failures are isolated per position: an error for one position is logged and its changes are discarded.
+func (k Keeper) removeUptimeRecordsOfUnfrozenPositions(ctx sdk.Context) {
+	positionIds, err := k.getPositionIdsWithEndedFreeze(ctx, ctx.BlockTime())
+	if err != nil {
+		ctx.Logger().Error("concentrated-liquidity, could not get positions with ended freeze. " + err.Error())
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, positionId := range positionIds {
+		position, err := k.GetPosition(ctx, positionId)
+		if err != nil {
+			ctx.Logger().Error("concentrated-liquidity, could not get position with ended freeze. " + err.Error())
+			continue
+		}
+		store.Delete(types.KeyFrozenUntilPositionId(position.FrozenUntil, positionId))
+
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.removePositionUptimeRecords(ctx, position)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
@@ -13,6 +14,14 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPool)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPools)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetUserPositions)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetPositionById)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetPoolPositions)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetIncentiveRecords)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetClaimableIncentives)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetClaimableFees)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetUserClaimableFees)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetLiquidityNetAroundCurrentTick)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetSimulateSwapSteps)
 	cmd.AddCommand(
 		osmocli.GetParams[*types.QueryParamsRequest](
 			types.ModuleName, types.NewQueryClient),
@@ -25,7 +34,26 @@ func GetUserPositions() (*osmocli.QueryDescriptor, *types.QueryUserPositionsRequ
 		Use:   "user-positions [address]",
 		Short: "Query user's positions",
 		Long: `{{.Short}}{{.ExampleHeader}}
-{{.CommandPrefix}} user-positions osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj`}, &types.QueryUserPositionsRequest{}
+{{.CommandPrefix}} user-positions osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj --pool-id 1`,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+		CustomFlagOverrides: poolIdFlagOverride,
+	}, &types.QueryUserPositionsRequest{}
+}
+
+func GetPositionById() (*osmocli.QueryDescriptor, *types.QueryPositionByIdRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "position-by-id [positionID]",
+		Short: "Query position by id",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} position-by-id 1`}, &types.QueryPositionByIdRequest{}
+}
+
+func GetPoolPositions() (*osmocli.QueryDescriptor, *types.QueryPoolPositionsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-positions [poolID]",
+		Short: "Query all positions in a given pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-positions 1`}, &types.QueryPoolPositionsRequest{}
 }
 
 func GetCmdPool() (*osmocli.QueryDescriptor, *types.QueryPoolRequest) {
@@ -43,3 +71,54 @@ func GetCmdPools() (*osmocli.QueryDescriptor, *types.QueryPoolsRequest) {
 		Long: `{{.Short}}{{.ExampleHeader}}
 {{.CommandPrefix}} pools`}, &types.QueryPoolsRequest{}
 }
+
+func GetIncentiveRecords() (*osmocli.QueryDescriptor, *types.QueryIncentiveRecordsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "incentive-records [poolID]",
+		Short: "Query incentive records for a given pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} incentive-records 1`}, &types.QueryIncentiveRecordsRequest{}
+}
+
+func GetClaimableIncentives() (*osmocli.QueryDescriptor, *types.QueryClaimableIncentivesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "claimable-incentives [positionID]",
+		Short: "Query the incentives claimable by a given position",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} claimable-incentives 1`}, &types.QueryClaimableIncentivesRequest{}
+}
+
+func GetClaimableFees() (*osmocli.QueryDescriptor, *types.QueryClaimableFeesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "claimable-fees [positionID]",
+		Short: "Query the fees claimable by a given position",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} claimable-fees 1`}, &types.QueryClaimableFeesRequest{}
+}
+
+func GetUserClaimableFees() (*osmocli.QueryDescriptor, *types.QueryUserClaimableFeesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "user-claimable-fees [address]",
+		Short: "Query the fees and incentives claimable by each of a user's positions",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} user-claimable-fees osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj --pool-id 1`,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+		CustomFlagOverrides: poolIdFlagOverride,
+	}, &types.QueryUserClaimableFeesRequest{}
+}
+
+func GetLiquidityNetAroundCurrentTick() (*osmocli.QueryDescriptor, *types.QueryLiquidityNetAroundCurrentTickRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "liquidity-net-around-current-tick [poolID] [ticksPerSide]",
+		Short: "Query the active liquidity and the liquidity net of the initialized ticks around the current tick of a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} liquidity-net-around-current-tick 1 10`}, &types.QueryLiquidityNetAroundCurrentTickRequest{}
+}
+
+func GetSimulateSwapSteps() (*osmocli.QueryDescriptor, *types.QuerySimulateSwapStepsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "simulate-swap-steps [poolID] [tokenIn] [tokenOutDenom]",
+		Short: "Query each step of swapping the given token in through a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} simulate-swap-steps 1 1000000uosmo uion`}, &types.QuerySimulateSwapStepsRequest{}
+}
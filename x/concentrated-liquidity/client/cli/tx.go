@@ -1,10 +1,18 @@
 package cli
 
 import (
+	"strconv"
+
 	flag "github.com/spf13/pflag"
 
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	clmodel "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
@@ -16,6 +24,10 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewWithdrawPositionCmd)
 	osmocli.AddTxCmd(txCmd, NewCreateConcentratedPoolCmd)
 	osmocli.AddTxCmd(txCmd, NewCollectFeesCmd)
+	osmocli.AddTxCmd(txCmd, NewCreateIncentiveCmd)
+	osmocli.AddTxCmd(txCmd, NewCollectIncentivesCmd)
+	osmocli.AddTxCmd(txCmd, NewAddToPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewCompoundFeesCmd)
 	return txCmd
 }
 
@@ -43,20 +55,124 @@ func NewCreatePositionCmd() (*osmocli.TxCliDesc, *types.MsgCreatePosition) {
 
 func NewWithdrawPositionCmd() (*osmocli.TxCliDesc, *types.MsgWithdrawPosition) {
 	return &osmocli.TxCliDesc{
-		Use:                 "withdraw-position [lower-tick] [upper-tick] [liquidity-out] [frozenUntil]",
-		Short:               "withdraw from an existing concentrated liquidity position",
-		Example:             "withdraw-position [-69082] 69082 100317215 100 --pool-id 1 --from val --chain-id osmosis-1",
-		CustomFlagOverrides: poolIdFlagOverride,
-		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+		Use:     "withdraw-position [position-id] [liquidity-out]",
+		Short:   "withdraw from an existing concentrated liquidity position",
+		Example: "withdraw-position 1 100317215 --from val --chain-id osmosis-1",
 	}, &types.MsgWithdrawPosition{}
 }
 
 func NewCollectFeesCmd() (*osmocli.TxCliDesc, *types.MsgCollectFees) {
 	return &osmocli.TxCliDesc{
-		Use:                 "collect-fees [lower-tick] [upper-tick]",
-		Short:               "collect fees from a liquidity position",
-		Example:             "collect-fees [-69082] 69082 --pool-id 1 --from val --chain-id osmosis-1",
+		Use:     "collect-fees [position-id]",
+		Short:   "collect fees from a liquidity position",
+		Example: "collect-fees 1 --from val --chain-id osmosis-1",
+	}, &types.MsgCollectFees{}
+}
+
+func NewCreateIncentiveCmd() (*osmocli.TxCliDesc, *types.MsgCreateIncentive) {
+	return &osmocli.TxCliDesc{
+		Use:                 "create-incentive [incentive-denom] [incentive-amount] [emission-rate] [start-time] [min-uptime]",
+		Short:               "create an incentive record to emit incentives to liquidity providers of a concentrated liquidity pool",
+		Example:             "create-incentive uion 1000000000 1000 1675237890 24h --pool-id 1 --from val --chain-id osmosis-1",
 		CustomFlagOverrides: poolIdFlagOverride,
 		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
-	}, &types.MsgCollectFees{}
+	}, &types.MsgCreateIncentive{}
+}
+
+func NewCollectIncentivesCmd() (*osmocli.TxCliDesc, *types.MsgCollectIncentives) {
+	return &osmocli.TxCliDesc{
+		Use:     "collect-incentives [position-id]",
+		Short:   "collect incentives from a liquidity position",
+		Example: "collect-incentives 1 --from val --chain-id osmosis-1",
+	}, &types.MsgCollectIncentives{}
+}
+
+func NewAddToPositionCmd() (*osmocli.TxCliDesc, *types.MsgAddToPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "add-to-position [position-id] [token-0] [token-1] [token-0-min-amount] [token-1-min-amount] [frozen-until]",
+		Short:   "add liquidity to an existing concentrated liquidity position at its current ratio",
+		Example: "add-to-position 1 1000000000uosmo 10000000uion 0 0 1675237890 --from val --chain-id osmosis-1",
+	}, &types.MsgAddToPosition{}
+}
+
+func NewCompoundFeesCmd() (*osmocli.TxCliDesc, *types.MsgCompoundFees) {
+	return &osmocli.TxCliDesc{
+		Use:     "compound-fees [position-id]",
+		Short:   "collect fees from a liquidity position and add them back to the same position",
+		Example: "compound-fees 1 --from val --chain-id osmosis-1",
+	}, &types.MsgCompoundFees{}
+}
+
+// NewCmdUpdatePoolSwapFeeAndTickSpacingProposal defines the command to create a new proposal to update
+// the swap fee and tick spacing of an existing concentrated liquidity pool.
+func NewCmdUpdatePoolSwapFeeAndTickSpacingProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-pool-swap-fee-and-tick-spacing [pool-id] [swap-fee] [tick-spacing]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to update the swap fee and tick spacing of a concentrated liquidity pool",
+		Long: "This proposal will update the swap fee and tick spacing of the given pool if passed. " +
+			"The swap fee and tick spacing must be one of the authorized swap fee tiers, and the ticks of every existing position in the pool must be divisible by the new tick spacing.",
+		Example: "osmosisd tx gov submit-proposal update-pool-swap-fee-and-tick-spacing 1 0.003 60 --title \"Title\" --description \"Description\"",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			swapFee, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			tickSpacing, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdatePoolSwapFeeAndTickSpacingProposal(title, description, poolId, swapFee, tickSpacing)
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
 }
@@ -0,0 +1,12 @@
+package client
+
+import (
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/client/cli"
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/client/rest"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	UpdatePoolSwapFeeAndTickSpacingProposalHandler = govclient.NewProposalHandler(cli.NewCmdUpdatePoolSwapFeeAndTickSpacingProposal, rest.ProposalUpdatePoolSwapFeeAndTickSpacingRESTHandler)
+)
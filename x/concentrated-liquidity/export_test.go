@@ -23,19 +23,23 @@ func (k Keeper) SetPool(ctx sdk.Context, pool types.ConcentratedPoolExtension) e
 	return k.setPool(ctx, pool)
 }
 
-func (k Keeper) HasFullPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, frozenUntil time.Time) bool {
-	return k.hasFullPosition(ctx, poolId, owner, lowerTick, upperTick, frozenUntil)
+func (k Keeper) HasPosition(ctx sdk.Context, positionId uint64) bool {
+	return k.hasPosition(ctx, positionId)
 }
 
-func (k Keeper) DeletePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, frozenUntil time.Time) error {
-	return k.deletePosition(ctx, poolId, owner, lowerTick, upperTick, frozenUntil)
+func (k Keeper) DeletePosition(ctx sdk.Context, positionId uint64) error {
+	return k.deletePosition(ctx, positionId)
+}
+
+func (k Keeper) GetNextPositionIdAndIncrement(ctx sdk.Context) uint64 {
+	return k.getNextPositionIdAndIncrement(ctx)
 }
 
 func (k Keeper) GetPoolById(ctx sdk.Context, poolId uint64) (types.ConcentratedPoolExtension, error) {
 	return k.getPoolById(ctx, poolId)
 }
 
-func (k Keeper) CrossTick(ctx sdk.Context, poolId uint64, tickIndex int64) (liquidityDelta sdk.Dec, err error) {
+func (k Keeper) CrossTick(ctx sdk.Context, poolId uint64, tickIndex int64) (liquidityDelta sdk.Dec, uptimeLiquidityDeltas []sdk.Dec, err error) {
 	return k.crossTick(ctx, poolId, tickIndex)
 }
 
@@ -55,16 +59,16 @@ func (k Keeper) CalcOutAmtGivenInInternal(ctx sdk.Context, tokenInMin sdk.Coin,
 	return k.calcOutAmtGivenIn(ctx, tokenInMin, tokenOutDenom, swapFee, priceLimit, poolId)
 }
 
-func (k Keeper) UpdatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, liquidityDelta sdk.Dec, frozenUntil time.Time) (sdk.Int, sdk.Int, error) {
-	return k.updatePosition(ctx, poolId, owner, lowerTick, upperTick, liquidityDelta, frozenUntil)
+func (k Keeper) UpdatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, liquidityDelta sdk.Dec, frozenUntil time.Time, positionId uint64) (sdk.Int, sdk.Int, error) {
+	return k.updatePosition(ctx, poolId, owner, lowerTick, upperTick, liquidityDelta, frozenUntil, positionId)
 }
 
-func (k Keeper) InitOrUpdateTick(ctx sdk.Context, poolId uint64, currentTick int64, tickIndex int64, liquidityIn sdk.Dec, upper bool) (err error) {
-	return k.initOrUpdateTick(ctx, poolId, currentTick, tickIndex, liquidityIn, upper)
+func (k Keeper) InitOrUpdateTick(ctx sdk.Context, poolId uint64, tickIndex int64, liquidityIn sdk.Dec, upper bool) (err error) {
+	return k.initOrUpdateTick(ctx, poolId, tickIndex, liquidityIn, upper)
 }
 
-func (k Keeper) InitOrUpdatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, liquidityDelta sdk.Dec, frozenUntil time.Time) (err error) {
-	return k.initOrUpdatePosition(ctx, poolId, owner, lowerTick, upperTick, liquidityDelta, frozenUntil)
+func (k Keeper) InitOrUpdatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, liquidityDelta sdk.Dec, frozenUntil time.Time, positionId uint64) (err error) {
+	return k.initOrUpdatePosition(ctx, poolId, owner, lowerTick, upperTick, liquidityDelta, frozenUntil, positionId)
 }
 
 func (k Keeper) PoolExists(ctx sdk.Context, poolId uint64) bool {
@@ -79,8 +83,8 @@ func (k Keeper) InitializeInitialPositionForPool(ctx sdk.Context, pool types.Con
 	return k.initializeInitialPositionForPool(ctx, pool, amount0Desired, amount1Desired)
 }
 
-func (k Keeper) CollectFees(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick int64, upperTick int64) (sdk.Coins, error) {
-	return k.collectFees(ctx, poolId, owner, lowerTick, upperTick)
+func (k Keeper) CollectFees(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (sdk.Coins, error) {
+	return k.collectFees(ctx, owner, positionId)
 }
 
 func ConvertConcentratedToPoolInterface(concentratedPool types.ConcentratedPoolExtension) (poolmanagertypes.PoolI, error) {
@@ -91,12 +95,8 @@ func ConvertPoolInterfaceToConcentrated(poolI poolmanagertypes.PoolI) (types.Con
 	return convertPoolInterfaceToConcentrated(poolI)
 }
 
-func (k Keeper) GetAllPositionsWithVaryingFreezeTimes(ctx sdk.Context, poolId uint64, addr sdk.AccAddress, lowerTick, upperTick int64) ([]model.Position, error) {
-	return k.getAllPositionsWithVaryingFreezeTimes(ctx, poolId, addr, lowerTick, upperTick)
-}
-
-func (k Keeper) SetPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, position *model.Position, frozenUntil time.Time) {
-	k.setPosition(ctx, poolId, owner, lowerTick, upperTick, position, frozenUntil)
+func (k Keeper) SetPosition(ctx sdk.Context, position model.Position) {
+	k.setPosition(ctx, position)
 }
 
 // fees methods
@@ -108,12 +108,12 @@ func (k Keeper) GetFeeAccumulator(ctx sdk.Context, poolId uint64) (accum.Accumul
 	return k.getFeeAccumulator(ctx, poolId)
 }
 
-func (k Keeper) InitializeFeeAccumulatorPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) error {
-	return k.initializeFeeAccumulatorPosition(ctx, poolId, owner, lowerTick, upperTick)
+func (k Keeper) InitializeFeeAccumulatorPosition(ctx sdk.Context, poolId uint64, positionId uint64) error {
+	return k.initializeFeeAccumulatorPosition(ctx, poolId, positionId)
 }
 
-func (k Keeper) UpdateFeeAccumulatorPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, liquidityDelta sdk.Dec, lowerTick int64, upperTick int64) error {
-	return k.updateFeeAccumulatorPosition(ctx, poolId, owner, liquidityDelta, lowerTick, upperTick)
+func (k Keeper) UpdateFeeAccumulatorPosition(ctx sdk.Context, poolId uint64, positionId uint64, liquidityDelta sdk.Dec, lowerTick int64, upperTick int64) error {
+	return k.updateFeeAccumulatorPosition(ctx, poolId, positionId, liquidityDelta, lowerTick, upperTick)
 }
 
 func (k Keeper) GetFeeGrowthOutside(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64) (sdk.DecCoins, error) {
@@ -136,8 +136,8 @@ func (k Keeper) ChargeFee(ctx sdk.Context, poolId uint64, feeUpdate sdk.DecCoin)
 	return k.chargeFee(ctx, poolId, feeUpdate)
 }
 
-func FormatPositionAccumulatorKey(poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) string {
-	return formatFeePositionAccumulatorKey(poolId, owner, lowerTick, upperTick)
+func FormatPositionAccumulatorKey(positionId uint64) string {
+	return formatFeePositionAccumulatorKey(positionId)
 }
 
 // incentive methods
@@ -152,3 +152,38 @@ func (k Keeper) GetUptimeAccumulators(ctx sdk.Context, poolId uint64) ([]accum.A
 func GetUptimeAccumulatorName(poolId, uptimeIndex uint64) string {
 	return getUptimeAccumulatorName(poolId, uptimeIndex)
 }
+
+func (k Keeper) GetUptimeAccumulatorValues(ctx sdk.Context, poolId uint64) ([]sdk.DecCoins, error) {
+	return k.getUptimeAccumulatorValues(ctx, poolId)
+}
+
+func (k Keeper) UpdateUptimeAccumulatorsToNow(ctx sdk.Context, poolId uint64) error {
+	return k.updateUptimeAccumulatorsToNow(ctx, poolId)
+}
+
+func CalcAccruedIncentivesForAccum(accumUptime time.Duration, qualifyingLiquidity sdk.Dec, lastUpdate, blockTime time.Time, incentiveRecords []types.IncentiveRecord) (sdk.DecCoins, []types.IncentiveRecord) {
+	return calcAccruedIncentivesForAccum(accumUptime, qualifyingLiquidity, lastUpdate, blockTime, incentiveRecords)
+}
+
+func (k Keeper) SetIncentiveRecord(ctx sdk.Context, incentiveRecord types.IncentiveRecord) {
+	k.setIncentiveRecord(ctx, incentiveRecord)
+}
+
+func (k Keeper) GetPoolIdsWithEmittingIncentives(ctx sdk.Context) ([]uint64, error) {
+	return k.getPoolIdsWithEmittingIncentives(ctx)
+}
+
+func (k Keeper) GetUptimeGrowthInsideRange(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64) ([]sdk.DecCoins, error) {
+	return k.getUptimeGrowthInsideRange(ctx, poolId, lowerTick, upperTick)
+}
+
+func (k Keeper) CollectIncentives(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (sdk.Coins, error) {
+	return k.collectIncentives(ctx, owner, positionId)
+}
+
+// SetListenersUnsafe replaces the listeners of the concentrated liquidity keeper.
+// This utility function is only exposed for testing and should not be moved
+// outside of the _test.go files.
+func (k *Keeper) SetListenersUnsafe(listeners types.ConcentratedLiquidityListeners) {
+	k.listeners = listeners
+}
@@ -62,14 +62,14 @@ func (k Keeper) chargeFee(ctx sdk.Context, poolId uint64, feeUpdate sdk.DecCoin)
 // - fails to get an accumulator for a given poold id
 // - attempts to re-initialize an existing fee accumulator liqudity position
 // - fails to create a position
-func (k Keeper) initializeFeeAccumulatorPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) error {
+func (k Keeper) initializeFeeAccumulatorPosition(ctx sdk.Context, poolId uint64, positionId uint64) error {
 	// get fee accumulator for the pool
 	feeAccumulator, err := k.getFeeAccumulator(ctx, poolId)
 	if err != nil {
 		return err
 	}
 
-	positionKey := formatFeePositionAccumulatorKey(poolId, owner, lowerTick, upperTick)
+	positionKey := formatFeePositionAccumulatorKey(positionId)
 
 	hasPosition, err := feeAccumulator.HasPosition(positionKey)
 	if err != nil {
@@ -89,10 +89,10 @@ func (k Keeper) initializeFeeAccumulatorPosition(ctx sdk.Context, poolId uint64,
 	return nil
 }
 
-// updateFeeAccumulatorPosition updates the fee accumulator position for a given pool, owner, and tick range.
-// It retrieves the current fee growth outside of the given tick range and updates the position's accumulator
-// with the provided liquidity delta and the retrieved fee growth outside.
-func (k Keeper) updateFeeAccumulatorPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, liquidityDelta sdk.Dec, lowerTick int64, upperTick int64) error {
+// updateFeeAccumulatorPosition updates the fee accumulator position with the given id in the given pool and tick range.
+// It moves the fees accrued inside of the tick range since the position's last update into its unclaimed rewards,
+// updates the position's shares by the provided liquidity delta and checkpoints it at the current fee growth inside of the range.
+func (k Keeper) updateFeeAccumulatorPosition(ctx sdk.Context, poolId uint64, positionId uint64, liquidityDelta sdk.Dec, lowerTick int64, upperTick int64) error {
 	feeGrowthOutside, err := k.getFeeGrowthOutside(ctx, poolId, lowerTick, upperTick)
 	if err != nil {
 		return err
@@ -103,16 +103,25 @@ func (k Keeper) updateFeeAccumulatorPosition(ctx sdk.Context, poolId uint64, own
 		return err
 	}
 
-	// replace position's accumulator with the updated liquidity and the feeGrowthOutside
-	err = feeAccumulator.UpdatePositionCustomAcc(
-		formatFeePositionAccumulatorKey(poolId, owner, lowerTick, upperTick),
-		liquidityDelta,
-		feeGrowthOutside)
+	positionKey := formatFeePositionAccumulatorKey(positionId)
+
+	positionSize, err := feeAccumulator.GetPositionSize(positionKey)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	// Positions without liquidity have not accrued any fees, so there is nothing to move into their unclaimed rewards.
+	if positionSize.IsPositive() {
+		if err := updatePositionToInitValuePlusGrowthOutside(feeAccumulator, positionKey, feeGrowthOutside); err != nil {
+			return err
+		}
+	}
+
+	if err := feeAccumulator.UpdatePosition(positionKey, liquidityDelta); err != nil {
+		return err
+	}
+
+	return feeAccumulator.SetPositionIntervalAccumulation(positionKey, feeAccumulator.GetValue().Sub(feeGrowthOutside))
 }
 
 // getFeeGrowthOutside returns fee growth upper tick - fee growth lower tick
@@ -173,19 +182,25 @@ func (k Keeper) getInitialFeeGrowthOutsideForTick(ctx sdk.Context, poolId uint64
 	return emptyCoins, nil
 }
 
-// collectFees collects fees from the fee accumulator for the position given by pool id, owner, lower tick and upper tick.
+// collectFees collects fees from the fee accumulator for the position with the given id.
 // Upon successful collection, it bank sends the fees from the pool address to the owner and returns the collected coins.
 // Returns error if:
-// - pool with the given id does not exist
-// - position given by pool id, owner, lower tick and upper tick does not exist
+// - position with the given id does not exist
+// - position with the given id is not owned by the owner
 // - other internal database or math errors.
-func (k Keeper) collectFees(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick int64, upperTick int64) (sdk.Coins, error) {
+func (k Keeper) collectFees(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (sdk.Coins, error) {
+	position, err := k.getPositionOwnedBy(ctx, owner, positionId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	poolId := position.PoolId
+
 	feeAccumulator, err := k.getFeeAccumulator(ctx, poolId)
 	if err != nil {
 		return sdk.Coins{}, err
 	}
 
-	positionKey := formatFeePositionAccumulatorKey(poolId, owner, lowerTick, upperTick)
+	positionKey := formatFeePositionAccumulatorKey(positionId)
 
 	hasPosition, err := feeAccumulator.HasPosition(positionKey)
 	if err != nil {
@@ -193,18 +208,18 @@ func (k Keeper) collectFees(ctx sdk.Context, poolId uint64, owner sdk.AccAddress
 	}
 
 	if !hasPosition {
-		return sdk.Coins{}, cltypes.PositionNotFoundError{PoolId: poolId, LowerTick: lowerTick, UpperTick: upperTick}
+		return sdk.Coins{}, cltypes.PositionNotFoundError{PositionId: positionId}
 	}
 
 	// compute fee growth outside of the range between lower tick and upper tick.
-	feeGrowthOutside, err := k.getFeeGrowthOutside(ctx, poolId, lowerTick, upperTick)
+	feeGrowthOutside, err := k.getFeeGrowthOutside(ctx, poolId, position.LowerTick, position.UpperTick)
 	if err != nil {
 		return sdk.Coins{}, err
 	}
 
-	// We need to update the position's accumulator to the current fee growth outside
-	// before we claim rewards.
-	if err := feeAccumulator.SetPositionCustomAcc(positionKey, feeGrowthOutside); err != nil {
+	// We need to move the position's accumulator up by the current fee growth outside
+	// before we claim rewards, so that only the fees accrued inside of the range are claimed.
+	if err := updatePositionToInitValuePlusGrowthOutside(feeAccumulator, positionKey, feeGrowthOutside); err != nil {
 		return sdk.Coins{}, err
 	}
 
@@ -214,6 +229,18 @@ func (k Keeper) collectFees(ctx sdk.Context, poolId uint64, owner sdk.AccAddress
 		return sdk.Coins{}, err
 	}
 
+	// Claiming deletes positions with no shares, in which case there is nothing left to checkpoint.
+	// Otherwise, checkpoint the position at the current fee growth inside of its range.
+	hasPosition, err = feeAccumulator.HasPosition(positionKey)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	if hasPosition {
+		if err := feeAccumulator.SetPositionIntervalAccumulation(positionKey, feeAccumulator.GetValue().Sub(feeGrowthOutside)); err != nil {
+			return sdk.Coins{}, err
+		}
+	}
+
 	// Once we have iterated through all the positions, we do a single bank send from the pool to the owner.
 	pool, err := k.getPoolById(ctx, poolId)
 	if err != nil {
@@ -225,6 +252,60 @@ func (k Keeper) collectFees(ctx sdk.Context, poolId uint64, owner sdk.AccAddress
 	return feesClaimed, nil
 }
 
+// GetClaimableFees returns the fees that the position with the given id would collect if it were to claim them
+// at the current block height. It computes the same amount as collectFees from the position's snapshot in the fee
+// accumulator and the current fee growth outside of the position's range, without writing to state.
+// Returns error if:
+// - position with the given id does not exist
+// - other internal database or math errors.
+func (k Keeper) GetClaimableFees(ctx sdk.Context, positionId uint64) (sdk.Coins, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	poolId := position.PoolId
+
+	feeAccumulator, err := k.getFeeAccumulator(ctx, poolId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	positionKey := formatFeePositionAccumulatorKey(positionId)
+
+	hasPosition, err := feeAccumulator.HasPosition(positionKey)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if !hasPosition {
+		return sdk.Coins{}, cltypes.PositionNotFoundError{PositionId: positionId}
+	}
+
+	feePosition, err := accum.GetPosition(feeAccumulator, positionKey)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// compute fee growth outside of the range between lower tick and upper tick.
+	feeGrowthOutside, err := k.getFeeGrowthOutside(ctx, poolId, position.LowerTick, position.UpperTick)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// collectFees moves the position's snapshot up by the current fee growth outside before claiming,
+	// so that the fees accrued since the snapshot are the fee growth inside of the range since then.
+	feeGrowthInsideSinceSnapshot, isNegative := feeAccumulator.GetValue().SafeSub(feePosition.InitAccumValue.Add(feeGrowthOutside...))
+	if isNegative {
+		return sdk.Coins{}, accum.NegativeAccDifferenceError{AccumulatorDifference: feeGrowthInsideSinceSnapshot}
+	}
+
+	claimableFees := feePosition.UnclaimedRewards.Add(feeGrowthInsideSinceSnapshot.MulDec(feePosition.NumShares)...)
+
+	// The remaining change is not claimable, mirroring the rounding done on claim.
+	truncatedFees, _ := claimableFees.TruncateDecimal()
+	return truncatedFees, nil
+}
+
 func getFeeAccumulatorName(poolId uint64) string {
 	poolIdStr := strconv.FormatUint(poolId, uintBase)
 	return strings.Join([]string{feeAccumPrefix, poolIdStr}, "/")
@@ -242,8 +323,8 @@ func calculateFeeGrowth(targetTick int64, feeGrowthOutside sdk.DecCoins, current
 	return feeGrowthOutside
 }
 
-// formatFeePositionAccumulatorKey formats the position's fee accumulator key prefixed by pool id, owner, lower tick
-// and upper tick with a key separator in-between.
-func formatFeePositionAccumulatorKey(poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) string {
-	return strings.Join([]string{feeAccumPrefix, strconv.FormatUint(poolId, uintBase), owner.String(), strconv.FormatInt(lowerTick, uintBase), strconv.FormatInt(upperTick, uintBase)}, keySeparator)
+// formatFeePositionAccumulatorKey formats the position's fee accumulator key prefixed by the fee accumulator
+// prefix and followed by the position id with a key separator in-between.
+func formatFeePositionAccumulatorKey(positionId uint64) string {
+	return strings.Join([]string{feeAccumPrefix, strconv.FormatUint(positionId, uintBase)}, keySeparator)
 }
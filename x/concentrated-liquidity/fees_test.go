@@ -15,11 +15,8 @@ import (
 
 // fields used to identify a fee position.
 type positionIdentifiers struct {
-	poolId      uint64
-	owner       sdk.AccAddress
-	lowerTick   int64
-	upperTick   int64
-	frozenUntil time.Time
+	poolId     uint64
+	positionId uint64
 }
 
 var (
@@ -33,32 +30,17 @@ func (s *KeeperTestSuite) TestInitializeFeeAccumulatorPosition() {
 	// For example, that positions with non-zero liquidity
 	// cannot be overriden.
 	s.SetupTest()
-	defaultFrozenUntil := s.Ctx.BlockTime().Add(DefaultFreezeDuration)
-	defaultAccount := s.TestAccs[0]
 
 	var (
 		defaultPoolId     = uint64(1)
 		defaultPositionId = positionIdentifiers{
 			defaultPoolId,
-			defaultAccount,
-			DefaultLowerTick,
-			DefaultUpperTick,
-			defaultFrozenUntil,
+			1,
 		}
 	)
 
-	withOwner := func(posId positionIdentifiers, owner sdk.AccAddress) positionIdentifiers {
-		posId.owner = owner
-		return posId
-	}
-
-	withUpperTick := func(posId positionIdentifiers, upperTick int64) positionIdentifiers {
-		posId.upperTick = upperTick
-		return posId
-	}
-
-	withLowerTick := func(posId positionIdentifiers, lowerTick int64) positionIdentifiers {
-		posId.lowerTick = lowerTick
+	withPositionId := func(posId positionIdentifiers, positionId uint64) positionIdentifiers {
+		posId.positionId = positionId
 		return posId
 	}
 
@@ -81,7 +63,7 @@ func (s *KeeperTestSuite) TestInitializeFeeAccumulatorPosition() {
 		},
 		{
 			name:         "second position",
-			positionId:   withLowerTick(defaultPositionId, DefaultLowerTick+1),
+			positionId:   withPositionId(defaultPositionId, 2),
 			expectedPass: true,
 		},
 		{
@@ -92,7 +74,7 @@ func (s *KeeperTestSuite) TestInitializeFeeAccumulatorPosition() {
 		},
 		{
 			name:       "overriding second position - error",
-			positionId: withLowerTick(defaultPositionId, DefaultLowerTick+1),
+			positionId: withPositionId(defaultPositionId, 2),
 			// Does not get overwritten by the next test case.
 			expectedPass: false,
 		},
@@ -100,26 +82,13 @@ func (s *KeeperTestSuite) TestInitializeFeeAccumulatorPosition() {
 			name: "error: non-existing accumulator (wrong pool)",
 			positionId: positionIdentifiers{
 				defaultPoolId + 1, // non-existing pool
-				defaultAccount,
-				DefaultLowerTick,
-				DefaultUpperTick,
-				defaultFrozenUntil,
+				3,
 			},
 			expectedPass: false,
 		},
 		{
-			name:         "existing accumulator, different owner - different position",
-			positionId:   withOwner(defaultPositionId, s.TestAccs[1]),
-			expectedPass: true,
-		},
-		{
-			name:         "existing accumulator, different upper tick - different position",
-			positionId:   withUpperTick(defaultPositionId, DefaultUpperTick+1),
-			expectedPass: true,
-		},
-		{
-			name:         "existing accumulator, different lower tick - different position",
-			positionId:   withLowerTick(defaultPositionId, DefaultUpperTick+1),
+			name:         "existing accumulator, different position id - different position",
+			positionId:   withPositionId(defaultPositionId, 3),
 			expectedPass: true,
 		},
 	}
@@ -128,7 +97,7 @@ func (s *KeeperTestSuite) TestInitializeFeeAccumulatorPosition() {
 		tc := tc
 		s.Run(tc.name, func() {
 			// system under test
-			err := clKeeper.InitializeFeeAccumulatorPosition(s.Ctx, tc.positionId.poolId, tc.positionId.owner, tc.positionId.lowerTick, tc.positionId.upperTick)
+			err := clKeeper.InitializeFeeAccumulatorPosition(s.Ctx, tc.positionId.poolId, tc.positionId.positionId)
 			if tc.expectedPass {
 				s.Require().NoError(err)
 
@@ -136,7 +105,7 @@ func (s *KeeperTestSuite) TestInitializeFeeAccumulatorPosition() {
 				poolFeeAccumulator, err := clKeeper.GetFeeAccumulator(s.Ctx, defaultPoolId)
 				s.Require().NoError(err)
 
-				positionKey := cl.FormatPositionAccumulatorKey(tc.positionId.poolId, tc.positionId.owner, tc.positionId.lowerTick, tc.positionId.upperTick)
+				positionKey := cl.FormatPositionAccumulatorKey(tc.positionId.positionId)
 
 				positionSize, err := poolFeeAccumulator.GetPositionSize(positionKey)
 				s.Require().NoError(err)
@@ -570,6 +539,7 @@ func (s *KeeperTestSuite) TestCollectFees() {
 		globalFeeGrowth           sdk.DecCoins
 		currentTick               int64
 		isInvalidPoolIdGiven      bool
+		isInvalidPositionIdGiven  bool
 
 		// inputs parameters.
 		owner       sdk.AccAddress
@@ -748,6 +718,24 @@ func (s *KeeperTestSuite) TestCollectFees() {
 
 			globalFeeGrowth: sdk.NewDecCoins(sdk.NewDecCoin(ETH, sdk.NewInt(10))),
 
+			owner:       ownerWithValidPosition,
+			lowerTick:   0,
+			upperTick:   1,
+			frozenUntil: defaultFrozenUntil,
+
+			currentTick: 2,
+
+			isInvalidPositionIdGiven: true,
+			expectedError:            cltypes.PositionNotFoundError{PositionId: 2},
+		},
+		"not the position owner": {
+			initialLiquidity: sdk.OneDec(),
+
+			lowerTickFeeGrowthOutside: sdk.NewDecCoins(sdk.NewDecCoin(ETH, sdk.NewInt(0))),
+			upperTickFeeGrowthOutside: sdk.NewDecCoins(sdk.NewDecCoin(ETH, sdk.NewInt(10))),
+
+			globalFeeGrowth: sdk.NewDecCoins(sdk.NewDecCoin(ETH, sdk.NewInt(10))),
+
 			owner:       s.TestAccs[1], // different owner from the one who initialized the position.
 			lowerTick:   0,
 			upperTick:   1,
@@ -755,7 +743,7 @@ func (s *KeeperTestSuite) TestCollectFees() {
 
 			currentTick: 2,
 
-			expectedError: cltypes.PositionNotFoundError{PoolId: 1, LowerTick: 0, UpperTick: 1},
+			expectedError: cltypes.NotPositionOwnerError{PositionId: 1, Address: s.TestAccs[1].String()},
 		},
 	}
 
@@ -772,7 +760,24 @@ func (s *KeeperTestSuite) TestCollectFees() {
 			clKeeper := s.App.ConcentratedLiquidityKeeper
 			ctx := s.Ctx
 
-			s.initializeFeeAccumulatorPositionWithLiquidity(ctx, validPoolId, ownerWithValidPosition, tc.lowerTick, tc.upperTick, tc.initialLiquidity)
+			positionPoolId := validPoolId
+			if tc.isInvalidPoolIdGiven {
+				positionPoolId = positionPoolId + 1
+			}
+
+			// The position references the pool whose fee accumulator is used when collecting.
+			positionId := uint64(1)
+			clKeeper.SetPosition(ctx, clmodel.Position{
+				PositionId:  positionId,
+				Address:     ownerWithValidPosition.String(),
+				PoolId:      positionPoolId,
+				LowerTick:   tc.lowerTick,
+				UpperTick:   tc.upperTick,
+				FrozenUntil: tc.frozenUntil,
+				Liquidity:   tc.initialLiquidity,
+			})
+
+			s.initializeFeeAccumulatorPositionWithLiquidity(ctx, validPoolId, positionId, tc.lowerTick, tc.upperTick, tc.initialLiquidity)
 
 			s.initializeTick(ctx, tc.currentTick, tc.lowerTick, tc.initialLiquidity, tc.lowerTickFeeGrowthOutside, false)
 
@@ -787,13 +792,13 @@ func (s *KeeperTestSuite) TestCollectFees() {
 			poolBalanceBeforeCollect := s.App.BankKeeper.GetBalance(ctx, validPool.GetAddress(), ETH)
 			ownerBalancerBeforeCollect := s.App.BankKeeper.GetBalance(ctx, tc.owner, ETH)
 
-			sutPoolId := validPoolId
-			if tc.isInvalidPoolIdGiven {
-				sutPoolId = sutPoolId + 1
+			sutPositionId := positionId
+			if tc.isInvalidPositionIdGiven {
+				sutPositionId = sutPositionId + 1
 			}
 
 			// System under test
-			actualFeesClaimed, err := clKeeper.CollectFees(ctx, sutPoolId, tc.owner, tc.lowerTick, tc.upperTick)
+			actualFeesClaimed, err := clKeeper.CollectFees(ctx, tc.owner, sutPositionId)
 
 			// Assertions.
 
@@ -821,86 +826,153 @@ func (s *KeeperTestSuite) TestCollectFees() {
 	}
 }
 
-func (s *KeeperTestSuite) TestUpdateFeeAccumulatorPosition() {
-	var (
-		ownerOne = s.TestAccs[0]
-		ownerTwo = s.TestAccs[1]
-	)
-	defaultFrozenUntil := s.Ctx.BlockTime().Add(DefaultFreezeDuration)
+// TestGetClaimableFees tests that the claimable fees query returns what collecting
+// the fees would pay out, without modifying state.
+func (s *KeeperTestSuite) TestGetClaimableFees() {
+	tests := map[string]struct {
+		lowerTick   int64
+		upperTick   int64
+		swapsToMake int
 
+		expectNonZeroFees bool
+	}{
+		"position in range, no swaps": {
+			lowerTick: DefaultLowerTick,
+			upperTick: DefaultUpperTick,
+		},
+		"position in range, one swap": {
+			lowerTick:         DefaultLowerTick,
+			upperTick:         DefaultUpperTick,
+			swapsToMake:       1,
+			expectNonZeroFees: true,
+		},
+		"position in range, multiple swaps": {
+			lowerTick:         DefaultLowerTick,
+			upperTick:         DefaultUpperTick,
+			swapsToMake:       3,
+			expectNonZeroFees: true,
+		},
+		"position above range, one swap": {
+			lowerTick:   DefaultUpperTick,
+			upperTick:   DefaultUpperTick + 100,
+			swapsToMake: 1,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			owner := s.TestAccs[0]
+
+			pool := s.PrepareCustomConcentratedPool(owner, ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, sdk.NewDecWithPrec(1, 2))
+			s.SetupDefaultPosition(pool.GetId())
+			position := s.SetupPosition(pool.GetId(), owner, DefaultCoin0, DefaultCoin1, tc.lowerTick, tc.upperTick, s.Ctx.BlockTime())
+
+			swapper := s.TestAccs[1]
+			tokenIn := sdk.NewCoin(USDC, sdk.NewInt(10000000))
+			for i := 0; i < tc.swapsToMake; i++ {
+				s.FundAcc(swapper, sdk.NewCoins(tokenIn))
+				poolI, err := clKeeper.GetPool(s.Ctx, pool.GetId())
+				s.Require().NoError(err)
+				_, err = clKeeper.SwapExactAmountIn(s.Ctx, swapper, poolI, tokenIn, ETH, sdk.OneInt(), poolI.GetSwapFee(s.Ctx))
+				s.Require().NoError(err)
+			}
+
+			// System under test
+			claimableFees, err := clKeeper.GetClaimableFees(s.Ctx, position.PositionId)
+			s.Require().NoError(err)
+
+			// The query does not modify state, so querying again yields the same result.
+			claimableFeesAgain, err := clKeeper.GetClaimableFees(s.Ctx, position.PositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(claimableFees.String(), claimableFeesAgain.String())
+
+			s.Require().Equal(tc.expectNonZeroFees, !claimableFees.IsZero())
+
+			collectedFees, err := clKeeper.CollectFees(s.Ctx, owner, position.PositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(collectedFees.String(), claimableFees.String())
+
+			// Nothing is left to claim after collecting.
+			claimableFees, err = clKeeper.GetClaimableFees(s.Ctx, position.PositionId)
+			s.Require().NoError(err)
+			s.Require().True(claimableFees.IsZero())
+		})
+	}
+
+	s.Run("position does not exist", func() {
+		s.SetupTest()
+
+		_, err := s.App.ConcentratedLiquidityKeeper.GetClaimableFees(s.Ctx, 1)
+		s.Require().ErrorIs(err, cltypes.PositionNotFoundError{PositionId: 1})
+	})
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeAccumulatorPosition() {
 	type updateFeeAccumPositionTest struct {
 		poolId        uint64
-		owner         sdk.AccAddress
+		positionId    uint64
 		liquidity     sdk.Dec
 		lowerTick     int64
 		upperTick     int64
-		frozenUntil   time.Time
 		expectedError error
 	}
 
 	positions := map[int]updateFeeAccumPositionTest{
 		1: {
-			lowerTick:   DefaultLowerTick,
-			upperTick:   DefaultUpperTick,
-			frozenUntil: defaultFrozenUntil,
+			lowerTick: DefaultLowerTick,
+			upperTick: DefaultUpperTick,
 		},
 		2: {
-			lowerTick:   DefaultLowerTick + 1,
-			upperTick:   DefaultUpperTick,
-			frozenUntil: defaultFrozenUntil,
+			lowerTick: DefaultLowerTick + 1,
+			upperTick: DefaultUpperTick,
 		},
 		3: {
-			lowerTick:   DefaultLowerTick,
-			upperTick:   DefaultUpperTick + 1,
-			frozenUntil: defaultFrozenUntil,
+			lowerTick: DefaultLowerTick,
+			upperTick: DefaultUpperTick + 1,
 		},
 	}
 
+	// positions are assigned ids in the order of pools, so that the
+	// positions of the second pool have ids 4, 5 and 6.
+	getPositionId := func(poolIndex int, positionKey int) uint64 {
+		return uint64(poolIndex*len(positions) + positionKey)
+	}
+
 	tests := map[string]updateFeeAccumPositionTest{
-		"update position with a different pool ID": {
-			poolId:      2,
-			owner:       ownerOne,
-			liquidity:   DefaultLiquidityAmt,
-			lowerTick:   DefaultLowerTick,
-			upperTick:   DefaultUpperTick,
-			frozenUntil: defaultFrozenUntil,
-		},
-		"update position with a different owner": {
-			poolId:      1,
-			owner:       ownerTwo,
-			liquidity:   DefaultLiquidityAmt,
-			lowerTick:   DefaultLowerTick,
-			upperTick:   DefaultUpperTick,
-			frozenUntil: defaultFrozenUntil,
+		"update position in a different pool": {
+			poolId:     2,
+			positionId: 4,
+			liquidity:  DefaultLiquidityAmt,
+			lowerTick:  DefaultLowerTick,
+			upperTick:  DefaultUpperTick,
 		},
 		"update position with a different lower tick": {
-			poolId:      1,
-			owner:       ownerOne,
-			liquidity:   DefaultLiquidityAmt,
-			lowerTick:   DefaultLowerTick + 1,
-			upperTick:   DefaultUpperTick,
-			frozenUntil: defaultFrozenUntil,
+			poolId:     1,
+			positionId: 2,
+			liquidity:  DefaultLiquidityAmt,
+			lowerTick:  DefaultLowerTick + 1,
+			upperTick:  DefaultUpperTick,
 		},
 		"update position with a different upper tick": {
-			poolId:      1,
-			owner:       ownerOne,
-			liquidity:   DefaultLiquidityAmt,
-			lowerTick:   DefaultLowerTick,
-			upperTick:   DefaultUpperTick + 1,
-			frozenUntil: defaultFrozenUntil,
+			poolId:     1,
+			positionId: 3,
+			liquidity:  DefaultLiquidityAmt,
+			lowerTick:  DefaultLowerTick,
+			upperTick:  DefaultUpperTick + 1,
 		},
 		"err: pool does not exist": {
 			poolId:        3,
 			expectedError: cltypes.PoolNotFoundError{PoolId: 3},
 		},
-		"err: position does not exist": {
+		"err: position does not exist in the given pool": {
 			poolId:        1,
-			owner:         ownerOne,
+			positionId:    4,
 			liquidity:     DefaultLiquidityAmt,
-			lowerTick:     DefaultLowerTick - 1,
+			lowerTick:     DefaultLowerTick,
 			upperTick:     DefaultUpperTick,
-			frozenUntil:   defaultFrozenUntil,
-			expectedError: accum.NoPositionError{Name: cl.FormatPositionAccumulatorKey(1, ownerOne, DefaultLowerTick-1, DefaultUpperTick)},
+			expectedError: accum.NoPositionError{Name: cl.FormatPositionAccumulatorKey(4)},
 		},
 	}
 
@@ -913,20 +985,17 @@ func (s *KeeperTestSuite) TestUpdateFeeAccumulatorPosition() {
 			poolTwo := s.PrepareConcentratedPool()
 
 			pools := []cltypes.ConcentratedPoolExtension{poolOne, poolTwo}
-			owners := []sdk.AccAddress{ownerOne, ownerTwo}
 
-			// Initialize three base positions in each pool for each owner (total of 12 positions)
-			for _, pos := range positions {
-				for _, pool := range pools {
-					for _, owner := range owners {
-						s.initializeFeeAccumulatorPositionWithLiquidity(s.Ctx, pool.GetId(), owner, pos.lowerTick, pos.upperTick, DefaultLiquidityAmt)
-					}
+			// Initialize three base positions in each pool (total of 6 positions)
+			for positionKey, pos := range positions {
+				for poolIndex, pool := range pools {
+					s.initializeFeeAccumulatorPositionWithLiquidity(s.Ctx, pool.GetId(), getPositionId(poolIndex, positionKey), pos.lowerTick, pos.upperTick, DefaultLiquidityAmt)
 				}
 			}
 
 			// System under test
 			// Update one of the positions as per the test case
-			err := s.App.ConcentratedLiquidityKeeper.UpdateFeeAccumulatorPosition(s.Ctx, tc.poolId, tc.owner, tc.liquidity, tc.lowerTick, tc.upperTick)
+			err := s.App.ConcentratedLiquidityKeeper.UpdateFeeAccumulatorPosition(s.Ctx, tc.poolId, tc.positionId, tc.liquidity, tc.lowerTick, tc.upperTick)
 
 			if tc.expectedError != nil {
 				s.Require().Error(err)
@@ -937,15 +1006,14 @@ func (s *KeeperTestSuite) TestUpdateFeeAccumulatorPosition() {
 			s.Require().NoError(err)
 
 			// Validate the test case position was updated and all other positions did not change
-			for _, pos := range positions {
-				for _, pool := range pools {
-					for _, owner := range owners {
-						liq := DefaultLiquidityAmt
-						if pool.GetId() == tc.poolId && owner.Equals(tc.owner) && pos.lowerTick == tc.lowerTick && pos.upperTick == tc.upperTick {
-							liq = DefaultLiquidityAmt.Mul(sdk.NewDec(2))
-						}
-						s.validatePositionFeeAccUpdate(s.Ctx, pool.GetId(), owner, pos.lowerTick, pos.upperTick, pos.frozenUntil, liq)
+			for positionKey := range positions {
+				for poolIndex, pool := range pools {
+					positionId := getPositionId(poolIndex, positionKey)
+					liq := DefaultLiquidityAmt
+					if positionId == tc.positionId {
+						liq = DefaultLiquidityAmt.Mul(sdk.NewDec(2))
 					}
+					s.validatePositionFeeAccUpdate(s.Ctx, pool.GetId(), positionId, liq)
 				}
 			}
 		})
@@ -1,20 +1,27 @@
 package concentrated_liquidity
 
 import (
+	"fmt"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/model"
 	types "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types/genesis"
 )
 
 // InitGenesis initializes the concentrated-liquidity module with the provided genesis state.
-func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+// It restores pools together with their ticks, accumulators and incentive records, as well as
+// all positions with their accumulator records and the next position id.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 	// Initialize pools
 	var unpacker codectypes.AnyUnpacker = k.cdc
-	for _, poolAny := range genState.Pools {
+	for _, poolData := range genState.PoolData {
 		var pool types.ConcentratedPoolExtension
-		err := unpacker.UnpackAny(poolAny, &pool)
+		err := unpacker.UnpackAny(poolData.Pool, &pool)
 		if err != nil {
 			panic(err)
 		}
@@ -22,26 +29,207 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+
+		poolId := pool.GetId()
+		for _, tick := range poolData.Ticks {
+			k.SetTickInfo(ctx, poolId, tick.TickIndex, tick.Info)
+		}
+
+		store := ctx.KVStore(k.storeKey)
+		feeAccumulator := poolData.FeeAccumulator
+		err = accum.MakeAccumulatorWithValueAndShare(store, getFeeAccumulatorName(poolId), feeAccumulator.AccumValue, feeAccumulator.TotalShares)
+		if err != nil {
+			panic(err)
+		}
+		for uptimeIndex, uptimeAccumulator := range poolData.UptimeAccumulators {
+			err = accum.MakeAccumulatorWithValueAndShare(store, getUptimeAccumulatorName(poolId, uint64(uptimeIndex)), uptimeAccumulator.AccumValue, uptimeAccumulator.TotalShares)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		for _, incentiveRecord := range poolData.IncentiveRecords {
+			k.setIncentiveRecord(ctx, incentiveRecord)
+		}
 	}
+
+	// Initialize positions
+	for _, positionData := range genState.Positions {
+		if err := k.setPositionData(ctx, positionData); err != nil {
+			panic(err)
+		}
+	}
+	k.setNextPositionId(ctx, genState.NextPositionId)
 }
 
 // ExportGenesis returns the concentrated-liquidity module's exported genesis state.
-func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 	pools, err := k.GetAllPools(ctx)
 	if err != nil {
 		panic(err)
 	}
-	poolAnys := []*codectypes.Any{}
+	poolData := make([]genesis.PoolData, 0, len(pools))
+	positionData := []genesis.PositionData{}
 	for _, poolI := range pools {
 		any, err := codectypes.NewAnyWithValue(poolI)
 		if err != nil {
 			panic(err)
 		}
 		anyCopy := *any
-		poolAnys = append(poolAnys, &anyCopy)
+
+		poolId := poolI.GetId()
+		ticks, err := k.getAllTicksInPool(ctx, poolId)
+		if err != nil {
+			panic(err)
+		}
+
+		feeAccumulator, err := k.getFeeAccumulator(ctx, poolId)
+		if err != nil {
+			panic(err)
+		}
+
+		uptimeAccumulators, err := k.getUptimeAccumulators(ctx, poolId)
+		if err != nil {
+			panic(err)
+		}
+		uptimeAccumulatorContents := make([]accum.AccumulatorContent, 0, len(uptimeAccumulators))
+		for _, uptimeAccumulator := range uptimeAccumulators {
+			uptimeAccumulatorContents = append(uptimeAccumulatorContents, accumulatorContent(uptimeAccumulator))
+		}
+
+		incentiveRecords, err := k.GetAllIncentiveRecordsForPool(ctx, poolId)
+		if err != nil {
+			panic(err)
+		}
+
+		poolData = append(poolData, genesis.PoolData{
+			Pool:               &anyCopy,
+			Ticks:              ticks,
+			FeeAccumulator:     accumulatorContent(feeAccumulator),
+			UptimeAccumulators: uptimeAccumulatorContents,
+			IncentiveRecords:   incentiveRecords,
+		})
+
+		positions, err := k.GetPoolPositions(ctx, poolId)
+		if err != nil {
+			panic(err)
+		}
+		for _, position := range positions {
+			data, err := k.getPositionData(ctx, position, feeAccumulator, uptimeAccumulators)
+			if err != nil {
+				panic(err)
+			}
+			positionData = append(positionData, data)
+		}
 	}
-	return &types.GenesisState{
-		Params: k.GetParams(ctx),
-		Pools:  poolAnys,
+
+	return &genesis.GenesisState{
+		Params:         k.GetParams(ctx),
+		PoolData:       poolData,
+		Positions:      positionData,
+		NextPositionId: k.getNextPositionId(ctx),
+	}
+}
+
+// getAllTicksInPool returns all the initialized ticks of the given pool in ascending tick index order.
+func (k Keeper) getAllTicksInPool(ctx sdk.Context, poolId uint64) ([]genesis.FullTick, error) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeyTickPrefix(poolId)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	ticks := []genesis.FullTick{}
+	for ; iterator.Valid(); iterator.Next() {
+		tickIndex, err := types.TickIndexFromBytes(iterator.Key()[len(prefix):])
+		if err != nil {
+			return nil, err
+		}
+
+		tickInfo := model.TickInfo{}
+		if err := k.cdc.Unmarshal(iterator.Value(), &tickInfo); err != nil {
+			return nil, err
+		}
+
+		ticks = append(ticks, genesis.FullTick{
+			PoolId:    poolId,
+			TickIndex: tickIndex,
+			Info:      tickInfo,
+		})
+	}
+	return ticks, nil
+}
+
+// getPositionData returns the given position together with its records in the given fee and uptime accumulators.
+// Returns error if the position has no record in the fee accumulator.
+func (k Keeper) getPositionData(ctx sdk.Context, position model.Position, feeAccumulator accum.AccumulatorObject, uptimeAccumulators []accum.AccumulatorObject) (genesis.PositionData, error) {
+	feeRecord, err := accum.GetPosition(feeAccumulator, formatFeePositionAccumulatorKey(position.PositionId))
+	if err != nil {
+		return genesis.PositionData{}, err
+	}
+
+	positionName := string(types.KeyPositionId(position.PositionId))
+	uptimeRecords := []genesis.UptimeAccumRecord{}
+	for uptimeIndex, uptimeAccumulator := range uptimeAccumulators {
+		// Positions that are not frozen for long enough do not have records in the respective accumulators.
+		hasPosition, err := uptimeAccumulator.HasPosition(positionName)
+		if err != nil {
+			return genesis.PositionData{}, err
+		}
+		if !hasPosition {
+			continue
+		}
+
+		uptimeRecord, err := accum.GetPosition(uptimeAccumulator, positionName)
+		if err != nil {
+			return genesis.PositionData{}, err
+		}
+		uptimeRecords = append(uptimeRecords, genesis.UptimeAccumRecord{
+			UptimeIndex: uint64(uptimeIndex),
+			Record:      uptimeRecord,
+		})
+	}
+
+	return genesis.PositionData{
+		Position:           position,
+		FeeAccumRecord:     feeRecord,
+		UptimeAccumRecords: uptimeRecords,
+	}, nil
+}
+
+// setPositionData stores the given position together with its records in the fee and uptime accumulators
+// of the position's pool. The accumulators must already exist.
+func (k Keeper) setPositionData(ctx sdk.Context, positionData genesis.PositionData) error {
+	position := positionData.Position
+	k.setPosition(ctx, position)
+
+	feeAccumulator, err := k.getFeeAccumulator(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+	if err := feeAccumulator.SetPositionRecord(formatFeePositionAccumulatorKey(position.PositionId), positionData.FeeAccumRecord); err != nil {
+		return err
+	}
+
+	uptimeAccumulators, err := k.getUptimeAccumulators(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+	positionName := string(types.KeyPositionId(position.PositionId))
+	for _, uptimeRecord := range positionData.UptimeAccumRecords {
+		if uptimeRecord.UptimeIndex >= uint64(len(uptimeAccumulators)) {
+			return fmt.Errorf("position (%d) uptime index (%d) must be less than (%d)", position.PositionId, uptimeRecord.UptimeIndex, len(uptimeAccumulators))
+		}
+		if err := uptimeAccumulators[uptimeRecord.UptimeIndex].SetPositionRecord(positionName, uptimeRecord.Record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// accumulatorContent returns the stored content of the given accumulator.
+func accumulatorContent(accumulator accum.AccumulatorObject) accum.AccumulatorContent {
+	return accum.AccumulatorContent{
+		AccumValue:  accumulator.GetValue(),
+		TotalShares: accumulator.GetTotalShares(),
 	}
 }
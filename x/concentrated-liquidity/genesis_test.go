@@ -11,17 +11,43 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	osmoapp "github.com/osmosis-labs/osmosis/v14/app"
+	cl "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity"
 	clmodule "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/clmodule"
 	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types/genesis"
 )
 
 var (
 	testGenesisPools = []model.Pool{}
-	testGenesis      = types.GenesisState{
-		Params: types.Params{AuthorizedTickSpacing: []uint64{1, 10, 50}},
-		Pools:  []*codectypes.Any{},
+	testGenesis      = genesis.GenesisState{
+		Params:         types.Params{AuthorizedTickSpacing: []uint64{1, 10, 50}},
+		PoolData:       []genesis.PoolData{},
+		NextPositionId: 2,
+	}
+
+	testFrozenUntil = time.Unix(1_700_000_000, 0).UTC()
+	testTickInfo    = model.TickInfo{
+		LiquidityGross:   sdk.NewDec(10),
+		LiquidityNet:     sdk.NewDec(-10),
+		FeeGrowthOutside: sdk.NewDecCoins(sdk.NewDecCoin("uosmo", sdk.NewInt(5))),
+		UptimeTrackers:   testUptimeTrackers(),
+	}
+	testFeeAccumulator = accum.AccumulatorContent{
+		AccumValue:  sdk.NewDecCoins(sdk.NewDecCoin("uosmo", sdk.NewInt(20))),
+		TotalShares: sdk.NewDec(10),
+	}
+	testFeeAccumRecord = accum.Record{
+		NumShares:        sdk.NewDec(10),
+		InitAccumValue:   sdk.NewDecCoins(sdk.NewDecCoin("uosmo", sdk.NewInt(1))),
+		UnclaimedRewards: sdk.NewDecCoins(sdk.NewDecCoin("uosmo", sdk.NewInt(2))),
+	}
+	testUptimeAccumRecord = accum.Record{
+		NumShares:        sdk.NewDec(10),
+		InitAccumValue:   sdk.NewDecCoins(sdk.NewDecCoin("uion", sdk.NewInt(3))),
+		UnclaimedRewards: sdk.NewDecCoins(sdk.NewDecCoin("uion", sdk.NewInt(4))),
 	}
 )
 
@@ -42,8 +68,66 @@ func init() {
 		if err != nil {
 			panic(err)
 		}
-		testGenesis.Pools = append(testGenesis.Pools, poolAny)
+		testGenesis.PoolData = append(testGenesis.PoolData, genesis.PoolData{
+			Pool:               poolAny,
+			Ticks:              []genesis.FullTick{},
+			FeeAccumulator:     accum.AccumulatorContent{TotalShares: sdk.ZeroDec()},
+			UptimeAccumulators: testUptimeAccumulators(),
+			IncentiveRecords:   []types.IncentiveRecord{},
+		})
+	}
+
+	// The first pool has a position with an initialized tick range, accrued fees and an incentive record.
+	owner := sdk.AccAddress([]byte("addr1_______________")).String()
+	testGenesis.PoolData[0].Ticks = []genesis.FullTick{
+		{PoolId: 1, TickIndex: -10, Info: testTickInfo},
+		{PoolId: 1, TickIndex: 10, Info: testTickInfo},
+	}
+	testGenesis.PoolData[0].FeeAccumulator = testFeeAccumulator
+	testGenesis.PoolData[0].IncentiveRecords = []types.IncentiveRecord{
+		{
+			PoolId:           1,
+			IncentiveDenom:   "uion",
+			IncentiveCreator: owner,
+			RemainingAmount:  sdk.NewDec(1000),
+			EmissionRate:     sdk.NewDec(10),
+			StartTime:        testFrozenUntil,
+			MinUptime:        time.Hour,
+		},
+	}
+	testGenesis.Positions = []genesis.PositionData{
+		{
+			Position: model.Position{
+				PositionId:  1,
+				Address:     owner,
+				PoolId:      1,
+				LowerTick:   -10,
+				UpperTick:   10,
+				FrozenUntil: testFrozenUntil,
+				Liquidity:   sdk.NewDec(10),
+			},
+			FeeAccumRecord: testFeeAccumRecord,
+			UptimeAccumRecords: []genesis.UptimeAccumRecord{
+				{UptimeIndex: 1, Record: testUptimeAccumRecord},
+			},
+		},
+	}
+}
+
+func testUptimeTrackers() []model.UptimeTracker {
+	uptimeTrackers := make([]model.UptimeTracker, len(types.SupportedUptimes))
+	for uptimeIndex := range uptimeTrackers {
+		uptimeTrackers[uptimeIndex].UptimeGrowthOutside = sdk.NewDecCoins(sdk.NewDecCoin("uion", sdk.NewInt(int64(uptimeIndex+1))))
+	}
+	return uptimeTrackers
+}
+
+func testUptimeAccumulators() []accum.AccumulatorContent {
+	uptimeAccumulators := make([]accum.AccumulatorContent, len(types.SupportedUptimes))
+	for uptimeIndex := range uptimeAccumulators {
+		uptimeAccumulators[uptimeIndex].TotalShares = sdk.ZeroDec()
 	}
+	return uptimeAccumulators
 }
 
 // TestInitGenesis tests the InitGenesis function of the ConcentratedLiquidityKeeper.
@@ -54,19 +138,68 @@ func TestInitGenesis(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
 	now := ctx.BlockTime()
 	ctx = ctx.WithBlockTime(now.Add(time.Second))
+	clKeeper := app.ConcentratedLiquidityKeeper
 
 	// Initialize the state with the provided genesis
-	app.ConcentratedLiquidityKeeper.InitGenesis(ctx, testGenesis)
+	clKeeper.InitGenesis(ctx, testGenesis)
 
 	// Check that the state was initialized correctly
-	clParamsAfterInitialization := app.ConcentratedLiquidityKeeper.GetParams(ctx)
+	clParamsAfterInitialization := clKeeper.GetParams(ctx)
 	require.Equal(t, testGenesis.Params.String(), clParamsAfterInitialization.String())
-	clPoolsAfterInitialization, err := app.ConcentratedLiquidityKeeper.GetAllPools(ctx)
+	clPoolsAfterInitialization, err := clKeeper.GetAllPools(ctx)
 	require.NoError(t, err)
 	require.Equal(t, len(clPoolsAfterInitialization), 2)
 	for i := 0; i < len(clPoolsAfterInitialization); i++ {
 		require.Equal(t, &testGenesisPools[i], clPoolsAfterInitialization[i])
 	}
+
+	// Check ticks
+	for _, tick := range testGenesis.PoolData[0].Ticks {
+		tickInfo, err := clKeeper.GetTickInfo(ctx, tick.PoolId, tick.TickIndex)
+		require.NoError(t, err)
+		require.Equal(t, tick.Info.String(), tickInfo.String())
+	}
+
+	// Check accumulators
+	feeAccumulator, err := clKeeper.GetFeeAccumulator(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, testFeeAccumulator.AccumValue, feeAccumulator.GetValue())
+	require.Equal(t, testFeeAccumulator.TotalShares.String(), feeAccumulator.GetTotalShares().String())
+	for _, pool := range testGenesisPools {
+		uptimeAccumulators, err := clKeeper.GetUptimeAccumulators(ctx, pool.GetId())
+		require.NoError(t, err)
+		require.Len(t, uptimeAccumulators, len(types.SupportedUptimes))
+	}
+
+	// Check incentive records
+	incentiveRecords, err := clKeeper.GetAllIncentiveRecordsForPool(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, testGenesis.PoolData[0].IncentiveRecords, incentiveRecords)
+
+	// Check positions and their accumulator records
+	expectedPositionData := testGenesis.Positions[0]
+	position, err := clKeeper.GetPosition(ctx, expectedPositionData.Position.PositionId)
+	require.NoError(t, err)
+	require.Equal(t, expectedPositionData.Position, position)
+
+	feeRecord, err := accum.GetPosition(feeAccumulator, cl.FormatPositionAccumulatorKey(position.PositionId))
+	require.NoError(t, err)
+	require.Equal(t, testFeeAccumRecord.String(), feeRecord.String())
+
+	uptimeAccumulators, err := clKeeper.GetUptimeAccumulators(ctx, 1)
+	require.NoError(t, err)
+	positionName := string(types.KeyPositionId(position.PositionId))
+	for uptimeIndex, uptimeAccumulator := range uptimeAccumulators {
+		hasPosition, err := uptimeAccumulator.HasPosition(positionName)
+		require.NoError(t, err)
+		require.Equal(t, uptimeIndex == 1, hasPosition)
+	}
+	uptimeRecord, err := accum.GetPosition(uptimeAccumulators[1], positionName)
+	require.NoError(t, err)
+	require.Equal(t, testUptimeAccumRecord.String(), uptimeRecord.String())
+
+	// Check the next position id
+	require.Equal(t, testGenesis.NextPositionId, clKeeper.GetNextPositionIdAndIncrement(ctx))
 }
 
 // TestExportGenesis tests the ExportGenesis function of the ConcentratedLiquidityKeeper.
@@ -84,8 +217,14 @@ func TestExportGenesis(t *testing.T) {
 	// Export the genesis state and check that it is correct
 	genesisExported := app.ConcentratedLiquidityKeeper.ExportGenesis(ctx)
 	require.Equal(t, testGenesis.Params.String(), genesisExported.Params.String())
-	require.Len(t, genesisExported.Pools, 2)
-	require.Equal(t, testGenesis.Pools, genesisExported.Pools)
+	require.Len(t, genesisExported.PoolData, 2)
+	for i, poolData := range genesisExported.PoolData {
+		require.Equal(t, testGenesis.PoolData[i].Pool, poolData.Pool)
+	}
+
+	// Compare the full state in its JSON encoding, as pools are packed as Any.
+	appCodec := osmoapp.MakeEncodingConfig().Marshaler
+	require.Equal(t, string(appCodec.MustMarshalJSON(&testGenesis)), string(appCodec.MustMarshalJSON(genesisExported)))
 }
 
 // TestMarshalUnmarshalGenesis tests the MarshalUnmarshalGenesis functions of the ConcentratedLiquidityKeeper.
@@ -114,3 +253,99 @@ func TestMarshalUnmarshalGenesis(t *testing.T) {
 		am.InitGenesis(ctx, appCodec, genesisExported)
 	})
 }
+
+// TestExportImportGenesisRoundTrip tests that exporting the state of a pool that has had swaps, accrued fees
+// and incentives, and importing it into a fresh chain preserves the state of every position.
+func (s *KeeperTestSuite) TestExportImportGenesisRoundTrip() {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(defaultStartTime)
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	// Set up a pool with a swap fee, two positions and an incentive record.
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, sdk.NewDecWithPrec(1, 2))
+	s.SetupDefaultPosition(pool.GetId())
+	s.SetupPosition(pool.GetId(), s.TestAccs[1], DefaultCoin0, DefaultCoin1, DefaultLowerTick+100, DefaultUpperTick-100, s.Ctx.BlockTime().Add(time.Hour))
+
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(sdk.NewCoin(defaultIncentiveDenom, defaultIncentiveAmount)))
+	_, err := clKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[2], defaultIncentiveDenom, defaultIncentiveAmount, defaultEmissionRate, defaultStartTime, time.Minute)
+	s.Require().NoError(err)
+
+	// Swap in both directions to accrue fees and cross ticks.
+	swapper := s.TestAccs[2]
+	poolI, err := clKeeper.GetPool(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	for _, tokenIn := range []sdk.Coin{sdk.NewCoin(USDC, sdk.NewInt(10000000)), sdk.NewCoin(ETH, sdk.NewInt(5000))} {
+		tokenOutDenom := ETH
+		if tokenIn.Denom == ETH {
+			tokenOutDenom = USDC
+		}
+		s.FundAcc(swapper, sdk.NewCoins(tokenIn))
+		_, err = clKeeper.SwapExactAmountIn(s.Ctx, swapper, poolI, tokenIn, tokenOutDenom, sdk.OneInt(), poolI.GetSwapFee(s.Ctx))
+		s.Require().NoError(err)
+	}
+
+	// Let incentives accrue.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute * 10))
+	s.Require().NoError(clKeeper.UpdateUptimeAccumulatorsToNow(s.Ctx, pool.GetId()))
+
+	positions, err := clKeeper.GetPoolPositions(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Len(positions, 2)
+
+	claimableFeesBefore := make([]sdk.Coins, len(positions))
+	claimableIncentivesBefore := make([]sdk.Coins, len(positions))
+	for i, position := range positions {
+		claimableFeesBefore[i], err = clKeeper.GetClaimableFees(s.Ctx, position.PositionId)
+		s.Require().NoError(err)
+		s.Require().False(claimableFeesBefore[i].IsZero())
+
+		claimableIncentivesBefore[i], err = clKeeper.GetClaimableIncentives(s.Ctx, position.PositionId)
+		s.Require().NoError(err)
+		s.Require().False(claimableIncentivesBefore[i].IsZero())
+	}
+
+	// System under test.
+	exported := clKeeper.ExportGenesis(s.Ctx)
+	s.Require().NoError(exported.Validate())
+
+	// Marshal and unmarshal the exported state as a node would when restarting from the genesis file.
+	appCodec := s.App.AppCodec()
+	bz, err := appCodec.MarshalJSON(exported)
+	s.Require().NoError(err)
+	imported := genesis.GenesisState{}
+	s.Require().NoError(appCodec.UnmarshalJSON(bz, &imported))
+	s.Require().NoError(imported.Validate())
+
+	// Import into a fresh chain. The balances of the pool and of its incentives escrow are restored separately, as the bank module would.
+	blockTime := s.Ctx.BlockTime()
+	poolBalances := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+	incentivesBalances := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetIncentivesAddress())
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(blockTime)
+	s.FundAcc(pool.GetAddress(), poolBalances)
+	s.FundAcc(pool.GetIncentivesAddress(), incentivesBalances)
+	clKeeper = s.App.ConcentratedLiquidityKeeper
+	clKeeper.InitGenesis(s.Ctx, imported)
+
+	// The exported state is unchanged after the round trip.
+	s.Require().Equal(string(bz), string(appCodec.MustMarshalJSON(clKeeper.ExportGenesis(s.Ctx))))
+
+	// Every position can claim the same fees and incentives as before.
+	for i, position := range positions {
+		importedPosition, err := clKeeper.GetPosition(s.Ctx, position.PositionId)
+		s.Require().NoError(err)
+		s.Require().Equal(position, importedPosition)
+
+		claimableFees, err := clKeeper.GetClaimableFees(s.Ctx, position.PositionId)
+		s.Require().NoError(err)
+		s.Require().Equal(claimableFeesBefore[i], claimableFees)
+
+		claimableIncentives, err := clKeeper.GetClaimableIncentives(s.Ctx, position.PositionId)
+		s.Require().NoError(err)
+		s.Require().Equal(claimableIncentivesBefore[i], claimableIncentives)
+	}
+
+	// New positions continue from the exported next position id.
+	newPosition := s.SetupDefaultPosition(pool.GetId())
+	s.Require().Equal(exported.NextPositionId, newPosition.PositionId)
+}
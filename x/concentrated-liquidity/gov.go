@@ -0,0 +1,76 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+)
+
+func NewConcentratedLiquidityProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdatePoolSwapFeeAndTickSpacingProposal:
+			return k.HandleUpdatePoolSwapFeeAndTickSpacingProposal(ctx, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized concentrated liquidity proposal content type: %T", c)
+		}
+	}
+}
+
+func (k Keeper) HandleUpdatePoolSwapFeeAndTickSpacingProposal(ctx sdk.Context, p *types.UpdatePoolSwapFeeAndTickSpacingProposal) error {
+	return k.UpdatePoolSwapFeeAndTickSpacing(ctx, p.PoolId, p.SwapFee, p.TickSpacing)
+}
+
+// UpdatePoolSwapFeeAndTickSpacing updates the swap fee and tick spacing of the pool with the given id.
+// Returns error if:
+// - the pool does not exist
+// - the tick spacing is not one of the authorized tick spacings
+// - the swap fee and tick spacing are not one of the authorized swap fee tiers
+// - the lower or upper tick of any existing position in the pool is not divisible by the new tick spacing
+func (k Keeper) UpdatePoolSwapFeeAndTickSpacing(ctx sdk.Context, poolId uint64, swapFee sdk.Dec, tickSpacing uint64) error {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	if !k.validateTickSpacing(ctx, tickSpacing) {
+		return fmt.Errorf("invalid tick spacing. Got %d", tickSpacing)
+	}
+
+	if !k.GetParams(ctx).IsAuthorizedSwapFeeTier(swapFee, tickSpacing) {
+		return types.UnauthorizedSwapFeeTierError{SwapFee: swapFee, TickSpacing: tickSpacing}
+	}
+
+	// Existing positions must remain valid under the new tick spacing so that they can still be
+	// withdrawn from and added to.
+	positions, err := k.GetPoolPositions(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	for _, position := range positions {
+		if position.LowerTick%int64(tickSpacing) != 0 || position.UpperTick%int64(tickSpacing) != 0 {
+			return types.PositionTickSpacingError{PositionId: position.PositionId, LowerTick: position.LowerTick, UpperTick: position.UpperTick, TickSpacing: tickSpacing}
+		}
+	}
+
+	pool.SetSwapFee(swapFee)
+	pool.SetTickSpacing(tickSpacing)
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtUpdatePoolSwapFeeAndTickSpacing,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeySwapFee, swapFee.String()),
+		sdk.NewAttribute(types.AttributeKeyTickSpacing, strconv.FormatUint(tickSpacing, 10)),
+	))
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package concentrated_liquidity_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cl "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestHandleUpdatePoolSwapFeeAndTickSpacingProposal() {
+	tests := map[string]struct {
+		poolId      uint64
+		swapFee     sdk.Dec
+		tickSpacing uint64
+		// positionTicks are the lower and upper ticks of the position created prior to the update.
+		positionTicks [2]int64
+
+		expectedErr error
+	}{
+		"happy path: update the swap fee only": {
+			poolId:        1,
+			swapFee:       sdk.MustNewDecFromStr("0.0001"),
+			tickSpacing:   DefaultTickSpacing,
+			positionTicks: [2]int64{DefaultLowerTick, DefaultUpperTick},
+		},
+		"happy path: update the swap fee and tick spacing, existing position remains valid": {
+			poolId:        1,
+			swapFee:       sdk.MustNewDecFromStr("0.003"),
+			tickSpacing:   60,
+			positionTicks: [2]int64{DefaultLowerTick - DefaultLowerTick%60, DefaultUpperTick - DefaultUpperTick%60},
+		},
+		"error: pool does not exist": {
+			poolId:        2,
+			swapFee:       sdk.MustNewDecFromStr("0.0001"),
+			tickSpacing:   DefaultTickSpacing,
+			positionTicks: [2]int64{DefaultLowerTick, DefaultUpperTick},
+			expectedErr:   types.PoolNotFoundError{PoolId: 2},
+		},
+		"error: tick spacing is not authorized": {
+			poolId:        1,
+			swapFee:       sdk.MustNewDecFromStr("0.01"),
+			tickSpacing:   7,
+			positionTicks: [2]int64{DefaultLowerTick, DefaultUpperTick},
+			expectedErr:   fmt.Errorf("invalid tick spacing. Got %d", 7),
+		},
+		"error: swap fee is not an authorized swap fee tier": {
+			poolId:        1,
+			swapFee:       sdk.MustNewDecFromStr("0.002"),
+			tickSpacing:   DefaultTickSpacing,
+			positionTicks: [2]int64{DefaultLowerTick, DefaultUpperTick},
+			expectedErr:   types.UnauthorizedSwapFeeTierError{SwapFee: sdk.MustNewDecFromStr("0.002"), TickSpacing: DefaultTickSpacing},
+		},
+		"error: tick spacing is not authorized for the swap fee tier": {
+			poolId:        1,
+			swapFee:       sdk.MustNewDecFromStr("0.0001"),
+			tickSpacing:   60,
+			positionTicks: [2]int64{DefaultLowerTick, DefaultUpperTick},
+			expectedErr:   types.UnauthorizedSwapFeeTierError{SwapFee: sdk.MustNewDecFromStr("0.0001"), TickSpacing: 60},
+		},
+		"error: existing position would not be divisible by the new tick spacing": {
+			poolId:        1,
+			swapFee:       sdk.MustNewDecFromStr("0.003"),
+			tickSpacing:   60,
+			positionTicks: [2]int64{DefaultLowerTick - DefaultLowerTick%60 + 1, DefaultUpperTick - DefaultUpperTick%60},
+			expectedErr: types.PositionTickSpacingError{
+				PositionId:  1,
+				LowerTick:   DefaultLowerTick - DefaultLowerTick%60 + 1,
+				UpperTick:   DefaultUpperTick - DefaultUpperTick%60,
+				TickSpacing: 60,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+
+			pool := s.PrepareConcentratedPool()
+			s.SetupPosition(pool.GetId(), s.TestAccs[0], DefaultCoin0, DefaultCoin1, tc.positionTicks[0], tc.positionTicks[1], s.Ctx.BlockTime())
+
+			poolBefore, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			proposal := types.NewUpdatePoolSwapFeeAndTickSpacingProposal("title", "description", tc.poolId, tc.swapFee, tc.tickSpacing)
+			s.Require().NoError(proposal.ValidateBasic())
+
+			// System under test
+			err = cl.NewConcentratedLiquidityProposalHandler(*clKeeper)(s.Ctx, &proposal)
+
+			poolAfter, getErr := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(getErr)
+
+			if tc.expectedErr != nil {
+				s.Require().Error(err)
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+				s.Require().Equal(poolBefore, poolAfter)
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtUpdatePoolSwapFeeAndTickSpacing, 0)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.swapFee, poolAfter.GetSwapFee(s.Ctx))
+			s.Require().Equal(tc.tickSpacing, poolAfter.GetTickSpacing())
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtUpdatePoolSwapFeeAndTickSpacing, 1)
+
+			// The rest of the pool's state is unchanged.
+			poolAfter.SetSwapFee(poolBefore.GetSwapFee(s.Ctx))
+			poolAfter.SetTickSpacing(poolBefore.GetTickSpacing())
+			s.Require().Equal(poolBefore, poolAfter)
+		})
+	}
+}
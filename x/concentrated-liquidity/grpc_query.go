@@ -18,6 +18,7 @@ import (
 
 const (
 	liquidityDepthRangeQueryLimit = 10000
+	liquidityNetTicksPerSideLimit = 1000
 )
 
 var (
@@ -59,7 +60,7 @@ func (q Querier) Pool(
 	return &types.QueryPoolResponse{Pool: any}, nil
 }
 
-// UserPositions returns positions of a specified address
+// UserPositions returns positions of a specified address, optionally filtered by pool id.
 func (q Querier) UserPositions(ctx context.Context, req *types.QueryUserPositionsRequest) (*types.QueryUserPositionsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -71,16 +72,70 @@ func (q Querier) UserPositions(ctx context.Context, req *types.QueryUserPosition
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	userPositions, err := q.Keeper.GetUserPositions(sdkCtx, sdkAddr)
+	userPositions, err := q.Keeper.GetUserPositions(sdkCtx, sdkAddr, req.PoolId)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &types.QueryUserPositionsResponse{
-		Positions: userPositions,
+		Positions: convertPositionsToFullPositionResults(userPositions),
 	}, nil
 }
 
+// PositionById returns the position with the given id.
+func (q Querier) PositionById(ctx context.Context, req *types.QueryPositionByIdRequest) (*types.QueryPositionByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	position, err := q.Keeper.GetPosition(sdkCtx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPositionByIdResponse{
+		Position: convertPositionToFullPositionResult(position),
+	}, nil
+}
+
+// PoolPositions returns all positions in the given pool.
+func (q Querier) PoolPositions(ctx context.Context, req *types.QueryPoolPositionsRequest) (*types.QueryPoolPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	poolPositions, err := q.Keeper.GetPoolPositions(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolPositionsResponse{
+		Positions: convertPositionsToFullPositionResults(poolPositions),
+	}, nil
+}
+
+func convertPositionToFullPositionResult(position model.Position) types.FullPositionByOwnerResult {
+	return types.FullPositionByOwnerResult{
+		PositionId:  position.PositionId,
+		Address:     position.Address,
+		PoolId:      position.PoolId,
+		LowerTick:   position.LowerTick,
+		UpperTick:   position.UpperTick,
+		FrozenUntil: position.FrozenUntil,
+		Liquidity:   position.Liquidity,
+	}
+}
+
+func convertPositionsToFullPositionResults(positions []model.Position) []types.FullPositionByOwnerResult {
+	results := make([]types.FullPositionByOwnerResult, 0, len(positions))
+	for _, position := range positions {
+		results = append(results, convertPositionToFullPositionResult(position))
+	}
+	return results
+}
+
 // Pools returns all concentrated pools in existence.
 func (q Querier) Pools(
 	ctx context.Context,
@@ -160,3 +215,138 @@ func (q Querier) LiquidityDepthsForRange(goCtx context.Context, req *types.Query
 		LiquidityDepths: liquidityDepths,
 	}, nil
 }
+
+// LiquidityNetAroundCurrentTick returns the pool's active liquidity along with the liquidity net
+// of the initialized ticks closest to the current tick on either side.
+func (q Querier) LiquidityNetAroundCurrentTick(goCtx context.Context, req *types.QueryLiquidityNetAroundCurrentTickRequest) (*types.QueryLiquidityNetAroundCurrentTickResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.TicksPerSide == 0 || req.TicksPerSide > liquidityNetTicksPerSideLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "ticks per side (%d) must be between 1 and %d", req.TicksPerSide, liquidityNetTicksPerSideLimit)
+	}
+
+	currentTick, currentSqrtPrice, currentLiquidity, liquidityDepths, err := q.Keeper.GetLiquidityNetAroundCurrentTick(ctx, req.PoolId, req.TicksPerSide)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLiquidityNetAroundCurrentTickResponse{
+		CurrentTick:      currentTick,
+		CurrentSqrtPrice: currentSqrtPrice,
+		CurrentLiquidity: currentLiquidity,
+		LiquidityDepths:  liquidityDepths,
+	}, nil
+}
+
+// SimulateSwapSteps returns each step of swapping the given token in through the pool.
+func (q Querier) SimulateSwapSteps(goCtx context.Context, req *types.QuerySimulateSwapStepsRequest) (*types.QuerySimulateSwapStepsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token in: %s", err)
+	}
+
+	tokenInUsed, tokenOut, swapSteps, err := q.Keeper.SimulateSwapSteps(ctx, req.PoolId, tokenIn, req.TokenOutDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySimulateSwapStepsResponse{TokenIn: tokenInUsed, TokenOut: tokenOut, SwapSteps: swapSteps}, nil
+}
+
+// IncentiveRecords returns all the incentive records for the given pool.
+func (q Querier) IncentiveRecords(goCtx context.Context, req *types.QueryIncentiveRecordsRequest) (*types.QueryIncentiveRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !q.Keeper.poolExists(ctx, req.PoolId) {
+		return nil, status.Error(codes.NotFound, types.PoolNotFoundError{PoolId: req.PoolId}.Error())
+	}
+
+	incentiveRecords, err := q.Keeper.GetAllIncentiveRecordsForPool(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIncentiveRecordsResponse{IncentiveRecords: incentiveRecords}, nil
+}
+
+// ClaimableIncentives returns the uptime incentives that the given position can collect at the current block time.
+func (q Querier) ClaimableIncentives(goCtx context.Context, req *types.QueryClaimableIncentivesRequest) (*types.QueryClaimableIncentivesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	claimableIncentives, err := q.Keeper.GetClaimableIncentives(ctx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClaimableIncentivesResponse{ClaimableIncentives: claimableIncentives}, nil
+}
+
+// ClaimableFees returns the fees that the given position can collect at the current block height.
+func (q Querier) ClaimableFees(goCtx context.Context, req *types.QueryClaimableFeesRequest) (*types.QueryClaimableFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	claimableFees, err := q.Keeper.GetClaimableFees(ctx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClaimableFeesResponse{ClaimableFees: claimableFees}, nil
+}
+
+// UserClaimableFees returns the fees and incentives that each position of a specified address can collect,
+// optionally filtered by pool id.
+func (q Querier) UserClaimableFees(goCtx context.Context, req *types.QueryUserClaimableFeesRequest) (*types.QueryUserClaimableFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sdkAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	userPositions, err := q.Keeper.GetUserPositions(ctx, sdkAddr, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	results := make([]types.PositionClaimableFees, 0, len(userPositions))
+	for _, position := range userPositions {
+		claimableFees, err := q.Keeper.GetClaimableFees(ctx, position.PositionId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		claimableIncentives, err := q.Keeper.GetClaimableIncentives(ctx, position.PositionId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		results = append(results, types.PositionClaimableFees{
+			PositionId:          position.PositionId,
+			PoolId:              position.PoolId,
+			ClaimableFees:       claimableFees,
+			ClaimableIncentives: claimableIncentives,
+		})
+	}
+
+	return &types.QueryUserClaimableFeesResponse{Positions: results}, nil
+}
@@ -3,10 +3,13 @@ package concentrated_liquidity
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
 )
 
@@ -33,7 +36,6 @@ func getUptimeAccumulatorName(poolId uint64, uptimeIndex uint64) string {
 	return strings.Join([]string{uptimeAccumPrefix, poolIdStr, uptimeIndexStr}, "/")
 }
 
-// nolint: unused
 // getUptimeAccumulators gets the uptime accumulator objects for the given poolId
 // Returns error if accumulator for the given poolId does not exist.
 func (k Keeper) getUptimeAccumulators(ctx sdk.Context, poolId uint64) ([]accum.AccumulatorObject, error) {
@@ -49,3 +51,665 @@ func (k Keeper) getUptimeAccumulators(ctx sdk.Context, poolId uint64) ([]accum.A
 
 	return accums, nil
 }
+
+// getUptimeAccumulatorValues gets the accumulator values for the supported uptimes for the given poolId
+// Returns error if accumulator for the given poolId does not exist.
+func (k Keeper) getUptimeAccumulatorValues(ctx sdk.Context, poolId uint64) ([]sdk.DecCoins, error) {
+	uptimeAccums, err := k.getUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return []sdk.DecCoins{}, err
+	}
+
+	uptimeValues := make([]sdk.DecCoins, len(uptimeAccums))
+	for uptimeIndex, uptimeAccum := range uptimeAccums {
+		uptimeValues[uptimeIndex] = uptimeAccum.GetValue()
+	}
+
+	return uptimeValues, nil
+}
+
+// getInitialUptimeGrowthOutsidesForTick returns the initial values of uptime growth outside for a given tick.
+// This value depends on the tick's location relative to the current tick.
+//
+// uptimeGrowthOutside =
+// { uptimeGrowthGlobal current tick >= tick }
+// { 0                  current tick <  tick }
+//
+// Similar to fees, the value is chosen as if all of the uptime incentives earned to date had occurred below the tick.
+// Returns error if the pool with the given id does exist or if fails to get any of the uptime accumulators.
+func (k Keeper) getInitialUptimeGrowthOutsidesForTick(ctx sdk.Context, poolId uint64, tick int64) ([]model.UptimeTracker, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return []model.UptimeTracker{}, err
+	}
+
+	uptimeTrackers := make([]model.UptimeTracker, len(types.SupportedUptimes))
+	currentTick := pool.GetCurrentTick().Int64()
+	if currentTick >= tick {
+		uptimeAccumulatorValues, err := k.getUptimeAccumulatorValues(ctx, poolId)
+		if err != nil {
+			return []model.UptimeTracker{}, err
+		}
+
+		for uptimeIndex := range uptimeTrackers {
+			uptimeTrackers[uptimeIndex].UptimeGrowthOutside = uptimeAccumulatorValues[uptimeIndex]
+			uptimeTrackers[uptimeIndex].LiquidityNet = sdk.ZeroDec()
+		}
+		return uptimeTrackers, nil
+	}
+
+	for uptimeIndex := range uptimeTrackers {
+		uptimeTrackers[uptimeIndex].UptimeGrowthOutside = emptyCoins
+		uptimeTrackers[uptimeIndex].LiquidityNet = sdk.ZeroDec()
+	}
+	return uptimeTrackers, nil
+}
+
+// getUptimeTrackerValues returns the uptime growth outside values of the given uptime trackers.
+// Ticks that were initialized before uptime trackers existed have no trackers, in which case
+// empty values are returned for every supported uptime.
+func getUptimeTrackerValues(uptimeTrackers []model.UptimeTracker) []sdk.DecCoins {
+	trackerValues := make([]sdk.DecCoins, len(types.SupportedUptimes))
+	for uptimeIndex := range trackerValues {
+		if uptimeIndex < len(uptimeTrackers) {
+			trackerValues[uptimeIndex] = uptimeTrackers[uptimeIndex].UptimeGrowthOutside
+		} else {
+			trackerValues[uptimeIndex] = emptyCoins
+		}
+	}
+	return trackerValues
+}
+
+// getUptimeTrackerLiquidityNets returns the qualifying liquidity nets of the given uptime trackers.
+// Trackers that predate qualifying liquidity tracking have no liquidity net, in which case zero is returned.
+func getUptimeTrackerLiquidityNets(uptimeTrackers []model.UptimeTracker) []sdk.Dec {
+	liquidityNets := make([]sdk.Dec, len(types.SupportedUptimes))
+	for uptimeIndex := range liquidityNets {
+		if uptimeIndex < len(uptimeTrackers) && !uptimeTrackers[uptimeIndex].LiquidityNet.IsNil() {
+			liquidityNets[uptimeIndex] = uptimeTrackers[uptimeIndex].LiquidityNet
+		} else {
+			liquidityNets[uptimeIndex] = sdk.ZeroDec()
+		}
+	}
+	return liquidityNets
+}
+
+// getUptimeGrowthOutsideRange returns the uptime growth outside of the given tick range for each supported uptime.
+// This is computed the same way as fee growth outside: growth above the upper tick plus growth below the lower tick.
+func (k Keeper) getUptimeGrowthOutsideRange(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64) ([]sdk.DecCoins, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return []sdk.DecCoins{}, err
+	}
+	currentTick := pool.GetCurrentTick().Int64()
+
+	lowerTickInfo, err := k.getTickInfo(ctx, poolId, lowerTick)
+	if err != nil {
+		return []sdk.DecCoins{}, err
+	}
+	upperTickInfo, err := k.getTickInfo(ctx, poolId, upperTick)
+	if err != nil {
+		return []sdk.DecCoins{}, err
+	}
+
+	globalUptimeValues, err := k.getUptimeAccumulatorValues(ctx, poolId)
+	if err != nil {
+		return []sdk.DecCoins{}, err
+	}
+
+	lowerTickUptimeValues := getUptimeTrackerValues(lowerTickInfo.UptimeTrackers)
+	upperTickUptimeValues := getUptimeTrackerValues(upperTickInfo.UptimeTrackers)
+
+	uptimeGrowthOutside := make([]sdk.DecCoins, len(types.SupportedUptimes))
+	for uptimeIndex := range types.SupportedUptimes {
+		uptimeGrowthAboveUpperTick := calculateFeeGrowth(upperTick, upperTickUptimeValues[uptimeIndex], currentTick, globalUptimeValues[uptimeIndex], true)
+		uptimeGrowthBelowLowerTick := calculateFeeGrowth(lowerTick, lowerTickUptimeValues[uptimeIndex], currentTick, globalUptimeValues[uptimeIndex], false)
+		uptimeGrowthOutside[uptimeIndex] = uptimeGrowthAboveUpperTick.Add(uptimeGrowthBelowLowerTick...)
+	}
+
+	return uptimeGrowthOutside, nil
+}
+
+// getUptimeGrowthInsideRange returns the uptime growth within the given tick range for each supported uptime.
+// It is computed as the global uptime growth minus the uptime growth outside of the range.
+// Returns error if the growth outside of the range exceeds the global growth for any uptime.
+func (k Keeper) getUptimeGrowthInsideRange(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64) ([]sdk.DecCoins, error) {
+	globalUptimeValues, err := k.getUptimeAccumulatorValues(ctx, poolId)
+	if err != nil {
+		return []sdk.DecCoins{}, err
+	}
+
+	uptimeGrowthOutside, err := k.getUptimeGrowthOutsideRange(ctx, poolId, lowerTick, upperTick)
+	if err != nil {
+		return []sdk.DecCoins{}, err
+	}
+
+	uptimeGrowthInside := make([]sdk.DecCoins, len(types.SupportedUptimes))
+	for uptimeIndex := range types.SupportedUptimes {
+		growthInside, isNegative := globalUptimeValues[uptimeIndex].SafeSub(uptimeGrowthOutside[uptimeIndex])
+		if isNegative {
+			return []sdk.DecCoins{}, types.NegativeUptimeGrowthInsideError{PoolId: poolId, LowerTick: lowerTick, UpperTick: upperTick}
+		}
+		uptimeGrowthInside[uptimeIndex] = growthInside
+	}
+
+	return uptimeGrowthInside, nil
+}
+
+// updateUptimeAccumulatorsToNow syncs all uptime accumulators of the given pool to the current block time.
+// Incentives emitted since the pool's last liquidity update are distributed per unit of liquidity qualifying
+// for the respective uptime, that is the active liquidity of the positions that have a record in the uptime
+// accumulator. The remaining amounts of the emitting incentive records are reduced accordingly, so that
+// every emitted incentive is claimable by a qualifying position.
+// If no active liquidity qualifies for an uptime, no incentives are emitted for it over the elapsed period.
+//
+// Returns error if the pool with the given id does not exist or if fails to read or update the accumulators.
+func (k Keeper) updateUptimeAccumulatorsToNow(ctx sdk.Context, poolId uint64) error {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	blockTime := ctx.BlockTime()
+	lastLiquidityUpdate := pool.GetLastLiquidityUpdate()
+	uptimeLiquidity := pool.GetUptimeLiquidity()
+
+	if blockTime.After(lastLiquidityUpdate) {
+		uptimeAccums, err := k.getUptimeAccumulators(ctx, poolId)
+		if err != nil {
+			return err
+		}
+
+		incentiveRecords, err := k.GetAllIncentiveRecordsForPool(ctx, poolId)
+		if err != nil {
+			return err
+		}
+
+		for uptimeIndex, uptime := range types.SupportedUptimes {
+			qualifyingLiquidity := uptimeLiquidity[uptimeIndex]
+			if !qualifyingLiquidity.IsPositive() {
+				continue
+			}
+
+			incentivesToAdd, updatedRecords := calcAccruedIncentivesForAccum(uptime, qualifyingLiquidity, lastLiquidityUpdate, blockTime, incentiveRecords)
+			uptimeAccums[uptimeIndex].AddToAccumulator(incentivesToAdd)
+
+			// Records that have emitted their full amount are deleted so that they are not iterated over again.
+			for _, record := range updatedRecords {
+				if record.RemainingAmount.IsPositive() {
+					k.setIncentiveRecord(ctx, record)
+				} else {
+					k.deleteIncentiveRecord(ctx, record)
+				}
+			}
+		}
+	}
+
+	pool.SetLastLiquidityUpdate(blockTime)
+	return k.setPool(ctx, pool)
+}
+
+// zeroUptimeLiquidity returns a zero liquidity for each supported uptime.
+func zeroUptimeLiquidity() []sdk.Dec {
+	uptimeLiquidity := make([]sdk.Dec, len(types.SupportedUptimes))
+	for uptimeIndex := range uptimeLiquidity {
+		uptimeLiquidity[uptimeIndex] = sdk.ZeroDec()
+	}
+	return uptimeLiquidity
+}
+
+// addPoolUptimeLiquidity adds the given deltas to the active liquidity qualifying for each supported uptime of the given pool.
+func (k Keeper) addPoolUptimeLiquidity(ctx sdk.Context, poolId uint64, uptimeLiquidityDeltas []sdk.Dec) error {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	pool.AddUptimeLiquidity(uptimeLiquidityDeltas)
+	return k.setPool(ctx, pool)
+}
+
+// updateUptimeLiquidityForPosition applies the change of the liquidity that the position with the given tick range
+// has recorded in each uptime accumulator. The qualifying liquidity nets of the position's ticks are updated, as well as
+// the pool's qualifying liquidity if the position is active.
+func (k Keeper) updateUptimeLiquidityForPosition(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64, uptimeLiquidityDeltas []sdk.Dec) error {
+	if err := k.updateTickUptimeLiquidity(ctx, poolId, lowerTick, uptimeLiquidityDeltas, false); err != nil {
+		return err
+	}
+	if err := k.updateTickUptimeLiquidity(ctx, poolId, upperTick, uptimeLiquidityDeltas, true); err != nil {
+		return err
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if pool.UpdateUptimeLiquidityIfActivePosition(ctx, lowerTick, upperTick, uptimeLiquidityDeltas) {
+		return k.setPool(ctx, pool)
+	}
+	return nil
+}
+
+// calcAccruedIncentivesForAccum calculates the amount of incentives accrued per unit of qualifying liquidity
+// by the records with the given min uptime between lastUpdate and blockTime.
+// Records that start after blockTime or that have been fully emitted do not contribute.
+// Returns the accrued incentives per unit of liquidity alongside the records with their remaining
+// amounts reduced by the emitted amounts. Only the records that emitted incentives are returned.
+func calcAccruedIncentivesForAccum(accumUptime time.Duration, qualifyingLiquidity sdk.Dec, lastUpdate, blockTime time.Time, incentiveRecords []types.IncentiveRecord) (sdk.DecCoins, []types.IncentiveRecord) {
+	incentivesToAdd := sdk.NewDecCoins()
+	updatedRecords := []types.IncentiveRecord{}
+	for _, record := range incentiveRecords {
+		if record.MinUptime != accumUptime || !record.RemainingAmount.IsPositive() {
+			continue
+		}
+
+		// Incentives only accrue from the record's start time onward.
+		accrualStart := lastUpdate
+		if record.StartTime.After(accrualStart) {
+			accrualStart = record.StartTime
+		}
+		if !blockTime.After(accrualStart) {
+			continue
+		}
+
+		timeElapsed := sdk.NewDec(int64(blockTime.Sub(accrualStart))).QuoInt64(int64(time.Second))
+
+		// Emissions are capped by the amount remaining in the record.
+		totalEmittedAmount := sdk.MinDec(record.EmissionRate.Mul(timeElapsed), record.RemainingAmount)
+		incentivesToAdd = incentivesToAdd.Add(sdk.NewDecCoinFromDec(record.IncentiveDenom, totalEmittedAmount.QuoTruncate(qualifyingLiquidity)))
+
+		record.RemainingAmount = record.RemainingAmount.Sub(totalEmittedAmount)
+		updatedRecords = append(updatedRecords, record)
+	}
+
+	return incentivesToAdd, updatedRecords
+}
+
+// setIncentiveRecord sets the given incentive record in state and marks its pool as having incentives.
+func (k Keeper) setIncentiveRecord(ctx sdk.Context, incentiveRecord types.IncentiveRecord) {
+	store := ctx.KVStore(k.storeKey)
+	creator := sdk.MustAccAddressFromBech32(incentiveRecord.IncentiveCreator)
+	key := types.KeyIncentiveRecord(incentiveRecord.PoolId, incentiveRecord.MinUptime, incentiveRecord.IncentiveDenom, creator)
+	osmoutils.MustSet(store, key, &incentiveRecord)
+	store.Set(types.KeyPoolIdWithIncentives(incentiveRecord.PoolId), sdk.Uint64ToBigEndian(incentiveRecord.PoolId))
+}
+
+// deleteIncentiveRecord deletes the given incentive record from state.
+// If it was the last record of its pool, the pool is no longer marked as having incentives.
+func (k Keeper) deleteIncentiveRecord(ctx sdk.Context, incentiveRecord types.IncentiveRecord) {
+	store := ctx.KVStore(k.storeKey)
+	creator := sdk.MustAccAddressFromBech32(incentiveRecord.IncentiveCreator)
+	store.Delete(types.KeyIncentiveRecord(incentiveRecord.PoolId, incentiveRecord.MinUptime, incentiveRecord.IncentiveDenom, creator))
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPoolIncentiveRecords(incentiveRecord.PoolId))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		store.Delete(types.KeyPoolIdWithIncentives(incentiveRecord.PoolId))
+	}
+}
+
+// GetIncentiveRecord gets the incentive record corresponding to the passed in values from store.
+// Returns error if the record does not exist.
+func (k Keeper) GetIncentiveRecord(ctx sdk.Context, poolId uint64, denom string, minUptime time.Duration, incentiveCreator sdk.AccAddress) (types.IncentiveRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	incentiveRecord := types.IncentiveRecord{}
+	key := types.KeyIncentiveRecord(poolId, minUptime, denom, incentiveCreator)
+
+	found, err := osmoutils.Get(store, key, &incentiveRecord)
+	if err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
+	if !found {
+		return types.IncentiveRecord{}, types.IncentiveRecordNotFoundError{PoolId: poolId, IncentiveDenom: denom, MinUptime: minUptime, Creator: incentiveCreator.String()}
+	}
+
+	return incentiveRecord, nil
+}
+
+// GetAllIncentiveRecordsForPool gets all the incentive records for the given pool id.
+func (k Keeper) GetAllIncentiveRecordsForPool(ctx sdk.Context, poolId uint64) ([]types.IncentiveRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPoolIncentiveRecords(poolId), ParseIncentiveRecordBz)
+}
+
+// getPoolIdsWithEmittingIncentives returns the ids of the pools with at least one incentive record that has started,
+// in ascending order. Since fully emitted records are deleted, only the records of pools marked as having
+// incentives are visited.
+func (k Keeper) getPoolIdsWithEmittingIncentives(ctx sdk.Context) ([]uint64, error) {
+	incentivizedPoolIds, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.PoolIdWithIncentivesPrefix, func(bz []byte) (uint64, error) {
+		return sdk.BigEndianToUint64(bz), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	poolIds := []uint64{}
+	for _, poolId := range incentivizedPoolIds {
+		incentiveRecords, err := k.GetAllIncentiveRecordsForPool(ctx, poolId)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range incentiveRecords {
+			if !record.StartTime.After(ctx.BlockTime()) {
+				poolIds = append(poolIds, poolId)
+				break
+			}
+		}
+	}
+	return poolIds, nil
+}
+
+// CreateIncentive creates an incentive record for the given pool that emits the given amount of
+// incentiveDenom at emissionRate per second, starting at startTime, to positions frozen for at least minUptime.
+// The incentive amount is transferred from the sender to the pool's incentives address upon creation,
+// which escrows it apart from the pool's reserves until it is collected by positions.
+// Prior to creating the record, the pool's uptime accumulators are synced so that the new record does
+// not accrue for the period before it existed.
+// Returns the created record on success. Returns error if:
+// - the pool with the given id does not exist
+// - minUptime is not one of the supported uptimes
+// - incentiveAmount or emissionRate is not positive
+// - startTime is before the current block time
+// - a record with the same denom, min uptime and creator already exists for the pool
+// - the sender does not have enough funds
+func (k Keeper) CreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveDenom string, incentiveAmount sdk.Int, emissionRate sdk.Dec, startTime time.Time, minUptime time.Duration) (types.IncentiveRecord, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
+	if !types.ValidateMinUptime(minUptime) {
+		return types.IncentiveRecord{}, types.InvalidMinUptimeError{PoolId: poolId, MinUptime: minUptime, AuthorizedUptimes: types.SupportedUptimes}
+	}
+
+	if !incentiveAmount.IsPositive() {
+		return types.IncentiveRecord{}, types.NonPositiveIncentiveAmountError{PoolId: poolId, IncentiveAmount: incentiveAmount}
+	}
+
+	if !emissionRate.IsPositive() {
+		return types.IncentiveRecord{}, types.NonPositiveEmissionRateError{PoolId: poolId, EmissionRate: emissionRate}
+	}
+
+	if startTime.Before(ctx.BlockTime()) {
+		return types.IncentiveRecord{}, types.InvalidIncentiveStartTimeError{PoolId: poolId, StartTime: startTime, BlockTime: ctx.BlockTime()}
+	}
+
+	if _, err := k.GetIncentiveRecord(ctx, poolId, incentiveDenom, minUptime, sender); err == nil {
+		return types.IncentiveRecord{}, types.IncentiveRecordExistsError{PoolId: poolId, IncentiveDenom: incentiveDenom, MinUptime: minUptime, Creator: sender.String()}
+	}
+
+	// Sync the accumulators so that the new record only accrues going forward.
+	if err := k.updateUptimeAccumulatorsToNow(ctx, poolId); err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetIncentivesAddress(), sdk.NewCoins(sdk.NewCoin(incentiveDenom, incentiveAmount))); err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
+	incentiveRecord := types.IncentiveRecord{
+		PoolId:           poolId,
+		IncentiveDenom:   incentiveDenom,
+		IncentiveCreator: sender.String(),
+		RemainingAmount:  incentiveAmount.ToDec(),
+		EmissionRate:     emissionRate,
+		StartTime:        startTime,
+		MinUptime:        minUptime,
+	}
+	k.setIncentiveRecord(ctx, incentiveRecord)
+
+	return incentiveRecord, nil
+}
+
+// AddToIncentive adds incentiveAmount to the sender's incentive record for the given pool, denom and minUptime,
+// creating the record starting at the current block time if it does not exist. The record's emission rate is set
+// so that its whole remaining amount is emitted over emissionDuration.
+// It is meant for modules that fund a pool's incentives periodically, such as the incentives module distributing
+// gauges to concentrated liquidity pools, and is not exposed through messages.
+// Prior to updating the record, the pool's uptime accumulators are synced so that the new amount and emission rate
+// only apply going forward.
+// Returns the updated record on success. Returns error if:
+// - the pool with the given id does not exist
+// - minUptime is not one of the supported uptimes
+// - incentiveAmount is not positive
+// - emissionDuration is shorter than a second
+// - the sender does not have enough funds
+func (k Keeper) AddToIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveDenom string, incentiveAmount sdk.Int, minUptime time.Duration, emissionDuration time.Duration) (types.IncentiveRecord, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
+	if !types.ValidateMinUptime(minUptime) {
+		return types.IncentiveRecord{}, types.InvalidMinUptimeError{PoolId: poolId, MinUptime: minUptime, AuthorizedUptimes: types.SupportedUptimes}
+	}
+
+	if !incentiveAmount.IsPositive() {
+		return types.IncentiveRecord{}, types.NonPositiveIncentiveAmountError{PoolId: poolId, IncentiveAmount: incentiveAmount}
+	}
+
+	if emissionDuration < time.Second {
+		return types.IncentiveRecord{}, types.EmissionDurationTooShortError{PoolId: poolId, EmissionDuration: emissionDuration}
+	}
+
+	// Sync the accumulators so that the record's remaining amount is up to date
+	// and the new emission rate only applies going forward.
+	if err := k.updateUptimeAccumulatorsToNow(ctx, poolId); err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetIncentivesAddress(), sdk.NewCoins(sdk.NewCoin(incentiveDenom, incentiveAmount))); err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
+	incentiveRecord, err := k.GetIncentiveRecord(ctx, poolId, incentiveDenom, minUptime, sender)
+	if err != nil {
+		incentiveRecord = types.IncentiveRecord{
+			PoolId:           poolId,
+			IncentiveDenom:   incentiveDenom,
+			IncentiveCreator: sender.String(),
+			RemainingAmount:  sdk.ZeroDec(),
+			StartTime:        ctx.BlockTime(),
+			MinUptime:        minUptime,
+		}
+	}
+	incentiveRecord.RemainingAmount = incentiveRecord.RemainingAmount.Add(incentiveAmount.ToDec())
+	incentiveRecord.EmissionRate = incentiveRecord.RemainingAmount.QuoInt64(int64(emissionDuration / time.Second))
+	k.setIncentiveRecord(ctx, incentiveRecord)
+
+	return incentiveRecord, nil
+}
+
+// validateFrozenUntilCoversUptimes returns an error if the position is recorded in an uptime accumulator
+// whose uptime is longer than the time left until the given frozenUntil.
+func (k Keeper) validateFrozenUntilCoversUptimes(ctx sdk.Context, position model.Position, frozenUntil time.Time) error {
+	uptimeAccumulators, err := k.getUptimeAccumulators(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+
+	positionName := string(types.KeyPositionId(position.PositionId))
+	for uptimeIndex, uptime := range types.SupportedUptimes {
+		hasPosition, err := uptimeAccumulators[uptimeIndex].HasPosition(positionName)
+		if err != nil {
+			return err
+		}
+		if hasPosition && frozenUntil.Sub(ctx.BlockTime()) < uptime {
+			return types.FrozenUntilTooShortForUptimeError{PositionId: position.PositionId, FrozenUntil: frozenUntil, Uptime: uptime}
+		}
+	}
+
+	return nil
+}
+
+// updatePositionToInitValuePlusGrowthOutside moves the position's accumulator value up by the given growth outside.
+// Since the position's accumulator value tracks the growth inside of its range at the time of the last update,
+// adding the growth outside makes the standard accumulator reward computation (global - init) equal to the
+// growth inside of the range accrued since the last update.
+func updatePositionToInitValuePlusGrowthOutside(accumulator accum.AccumulatorObject, positionName string, growthOutside sdk.DecCoins) error {
+	position, err := accum.GetPosition(accumulator, positionName)
+	if err != nil {
+		return err
+	}
+
+	return accumulator.SetPositionCustomAcc(positionName, position.InitAccumValue.Add(growthOutside...))
+}
+
+// claimAllIncentivesForPosition claims the incentives accrued by the given position across all uptime accumulators.
+// The position's accumulator values are reset to the current growth inside of its range so that future claims
+// only account for growth from this point onward.
+// Returns the claimed incentives on success. Returns error if fails to read or update any of the accumulators.
+func (k Keeper) claimAllIncentivesForPosition(ctx sdk.Context, poolId uint64, positionName string, lowerTick, upperTick int64) (sdk.Coins, error) {
+	uptimeAccumulators, err := k.getUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	uptimeGrowthOutside, err := k.getUptimeGrowthOutsideRange(ctx, poolId, lowerTick, upperTick)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	uptimeGrowthInside, err := k.getUptimeGrowthInsideRange(ctx, poolId, lowerTick, upperTick)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	collectedIncentives := sdk.NewCoins()
+	for uptimeIndex, uptimeAccum := range uptimeAccumulators {
+		// Positions that are not frozen for long enough do not have records in the respective accumulators.
+		hasPosition, err := uptimeAccum.HasPosition(positionName)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		if !hasPosition {
+			continue
+		}
+
+		if err := updatePositionToInitValuePlusGrowthOutside(uptimeAccum, positionName, uptimeGrowthOutside[uptimeIndex]); err != nil {
+			return sdk.Coins{}, err
+		}
+
+		incentivesClaimed, err := uptimeAccum.ClaimRewards(positionName)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		collectedIncentives = collectedIncentives.Add(incentivesClaimed...)
+
+		// Claiming deletes positions with no shares, in which case there is nothing left to reset.
+		hasPosition, err = uptimeAccum.HasPosition(positionName)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		if hasPosition {
+			if err := uptimeAccum.SetPositionIntervalAccumulation(positionName, uptimeGrowthInside[uptimeIndex]); err != nil {
+				return sdk.Coins{}, err
+			}
+		}
+	}
+
+	return collectedIncentives, nil
+}
+
+// removePositionUptimeRecords removes the given position from every uptime accumulator it is recorded in, which
+// ends its eligibility for uptime incentives. The pool's uptime accumulators are synced to the current block time
+// beforehand, and the incentives accrued by the position up to that point are collected to its owner.
+// The position's liquidity no longer counts towards the qualifying liquidity of its ticks and pool.
+// Returns error if fails to read or update any of the accumulators, or if the bank send fails.
+func (k Keeper) removePositionUptimeRecords(ctx sdk.Context, position model.Position) error {
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.collectIncentives(ctx, owner, position.PositionId); err != nil {
+		return err
+	}
+
+	uptimeAccumulators, err := k.getUptimeAccumulators(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+
+	uptimeLiquidityDeltas := zeroUptimeLiquidity()
+	positionName := string(types.KeyPositionId(position.PositionId))
+	for uptimeIndex, uptimeAccum := range uptimeAccumulators {
+		hasPosition, err := uptimeAccum.HasPosition(positionName)
+		if err != nil {
+			return err
+		}
+		if !hasPosition {
+			continue
+		}
+
+		positionSize, err := uptimeAccum.GetPositionSize(positionName)
+		if err != nil {
+			return err
+		}
+		if positionSize.IsPositive() {
+			if err := uptimeAccum.RemoveFromPosition(positionName, positionSize); err != nil {
+				return err
+			}
+		}
+		uptimeLiquidityDeltas[uptimeIndex] = positionSize.Neg()
+
+		// The accrued incentives were collected above, so claiming only deletes the emptied record.
+		if _, err := uptimeAccum.ClaimRewards(positionName); err != nil {
+			return err
+		}
+	}
+
+	return k.updateUptimeLiquidityForPosition(ctx, position.PoolId, position.LowerTick, position.UpperTick, uptimeLiquidityDeltas)
+}
+
+// collectIncentives collects the uptime incentives accrued by the position with the given id.
+// The pool's uptime accumulators are synced to the current block time prior to claiming.
+// Upon successful collection, it bank sends the incentives from the pool's incentives address to the owner and returns the collected coins.
+// Returns error if:
+// - position with the given id does not exist
+// - position with the given id is not owned by the owner
+// - other internal database or math errors.
+func (k Keeper) collectIncentives(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (sdk.Coins, error) {
+	position, err := k.getPositionOwnedBy(ctx, owner, positionId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	poolId := position.PoolId
+
+	if err := k.updateUptimeAccumulatorsToNow(ctx, poolId); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	positionName := string(types.KeyPositionId(positionId))
+	collectedIncentives, err := k.claimAllIncentivesForPosition(ctx, poolId, positionName, position.LowerTick, position.UpperTick)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetIncentivesAddress(), owner, collectedIncentives); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	return collectedIncentives, nil
+}
+
+// GetClaimableIncentives returns the uptime incentives that the position with the given id would collect at the current block time.
+// This method is non-mutative: the claim is simulated in a cache context that is never written.
+func (k Keeper) GetClaimableIncentives(ctx sdk.Context, positionId uint64) (sdk.Coins, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	return k.collectIncentives(cacheCtx, owner, positionId)
+}
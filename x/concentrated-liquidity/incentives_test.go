@@ -1,13 +1,60 @@
 package concentrated_liquidity_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	cl "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
 )
 
-var defaultPoolId = uint64(1)
+var (
+	defaultPoolId          = uint64(1)
+	defaultIncentiveDenom  = "incentive"
+	defaultIncentiveAmount = sdk.NewInt(1_000_000)
+	defaultEmissionRate    = sdk.NewDec(1000)
+	defaultStartTime       = time.Unix(1_700_000_000, 0).UTC()
+)
+
+// emptyUptimeValues returns an empty value for each supported uptime.
+func emptyUptimeValues() []sdk.DecCoins {
+	values := []sdk.DecCoins{}
+	for range types.SupportedUptimes {
+		values = append(values, cl.EmptyCoins)
+	}
+	return values
+}
+
+// wrapUptimeTrackers wraps the given uptime growth values into uptime trackers.
+func wrapUptimeTrackers(values []sdk.DecCoins) []model.UptimeTracker {
+	trackers := []model.UptimeTracker{}
+	for _, value := range values {
+		trackers = append(trackers, model.UptimeTracker{UptimeGrowthOutside: value, LiquidityNet: sdk.ZeroDec()})
+	}
+	return trackers
+}
+
+func withRemainingAmount(record types.IncentiveRecord, remainingAmount sdk.Dec) types.IncentiveRecord {
+	record.RemainingAmount = remainingAmount
+	return record
+}
+
+func withMinUptime(record types.IncentiveRecord, minUptime time.Duration) types.IncentiveRecord {
+	record.MinUptime = minUptime
+	return record
+}
+
+func withStartTime(record types.IncentiveRecord, startTime time.Time) types.IncentiveRecord {
+	record.StartTime = startTime
+	return record
+}
+
+func withDenom(record types.IncentiveRecord, denom string) types.IncentiveRecord {
+	record.IncentiveDenom = denom
+	return record
+}
 
 func (s *KeeperTestSuite) TestCreateAndGetUptimeAccumulators() {
 	// We expect there to be len(types.SupportedUptimes) number of initialized accumulators
@@ -117,3 +164,683 @@ func (s *KeeperTestSuite) TestGetUptimeAccumulatorName() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestCalcAccruedIncentivesForAccum() {
+	defaultRecord := types.IncentiveRecord{
+		PoolId:          defaultPoolId,
+		IncentiveDenom:  defaultIncentiveDenom,
+		RemainingAmount: sdk.NewDec(10_000),
+		EmissionRate:    sdk.NewDec(10),
+		StartTime:       defaultStartTime,
+		MinUptime:       time.Hour,
+	}
+	defaultLiquidity := sdk.NewDec(100)
+	lastUpdate := defaultStartTime
+
+	type calcAccruedIncentivesTest struct {
+		accumUptime      time.Duration
+		qualifyingLiq    sdk.Dec
+		timeElapsed      time.Duration
+		incentiveRecords []types.IncentiveRecord
+
+		expectedIncentives     sdk.DecCoins
+		expectedUpdatedRecords []types.IncentiveRecord
+	}
+	tests := map[string]calcAccruedIncentivesTest{
+		"one record, partially emitted": {
+			accumUptime:      time.Hour,
+			qualifyingLiq:    defaultLiquidity,
+			timeElapsed:      time.Second * 100,
+			incentiveRecords: []types.IncentiveRecord{defaultRecord},
+
+			// 10 per second for 100 seconds = 1000, distributed over 100 units of liquidity
+			expectedIncentives:     sdk.NewDecCoins(sdk.NewDecCoin(defaultIncentiveDenom, sdk.NewInt(10))),
+			expectedUpdatedRecords: []types.IncentiveRecord{withRemainingAmount(defaultRecord, sdk.NewDec(9000))},
+		},
+		"one record, emission capped by remaining amount": {
+			accumUptime:      time.Hour,
+			qualifyingLiq:    defaultLiquidity,
+			timeElapsed:      time.Hour,
+			incentiveRecords: []types.IncentiveRecord{defaultRecord},
+
+			expectedIncentives:     sdk.NewDecCoins(sdk.NewDecCoin(defaultIncentiveDenom, sdk.NewInt(100))),
+			expectedUpdatedRecords: []types.IncentiveRecord{withRemainingAmount(defaultRecord, sdk.ZeroDec())},
+		},
+		"record for a different uptime": {
+			accumUptime:      time.Minute,
+			qualifyingLiq:    defaultLiquidity,
+			timeElapsed:      time.Second * 100,
+			incentiveRecords: []types.IncentiveRecord{defaultRecord},
+
+			expectedIncentives:     sdk.NewDecCoins(),
+			expectedUpdatedRecords: []types.IncentiveRecord{},
+		},
+		"record starting after last update": {
+			accumUptime:      time.Hour,
+			qualifyingLiq:    defaultLiquidity,
+			timeElapsed:      time.Second * 100,
+			incentiveRecords: []types.IncentiveRecord{withStartTime(defaultRecord, defaultStartTime.Add(time.Second*50))},
+
+			expectedIncentives:     sdk.NewDecCoins(sdk.NewDecCoin(defaultIncentiveDenom, sdk.NewInt(5))),
+			expectedUpdatedRecords: []types.IncentiveRecord{withRemainingAmount(withStartTime(defaultRecord, defaultStartTime.Add(time.Second*50)), sdk.NewDec(9500))},
+		},
+		"record not yet started": {
+			accumUptime:      time.Hour,
+			qualifyingLiq:    defaultLiquidity,
+			timeElapsed:      time.Second * 100,
+			incentiveRecords: []types.IncentiveRecord{withStartTime(defaultRecord, defaultStartTime.Add(time.Second*200))},
+
+			expectedIncentives:     sdk.NewDecCoins(),
+			expectedUpdatedRecords: []types.IncentiveRecord{},
+		},
+		"fully emitted record": {
+			accumUptime:      time.Hour,
+			qualifyingLiq:    defaultLiquidity,
+			timeElapsed:      time.Second * 100,
+			incentiveRecords: []types.IncentiveRecord{withRemainingAmount(defaultRecord, sdk.ZeroDec())},
+
+			expectedIncentives:     sdk.NewDecCoins(),
+			expectedUpdatedRecords: []types.IncentiveRecord{},
+		},
+		"multiple records across denoms and uptimes": {
+			accumUptime:   time.Hour,
+			qualifyingLiq: defaultLiquidity,
+			timeElapsed:   time.Second * 100,
+			incentiveRecords: []types.IncentiveRecord{
+				defaultRecord,
+				withDenom(defaultRecord, "bar"),
+				withMinUptime(defaultRecord, time.Minute),
+			},
+
+			expectedIncentives: sdk.NewDecCoins(
+				sdk.NewDecCoin(defaultIncentiveDenom, sdk.NewInt(10)),
+				sdk.NewDecCoin("bar", sdk.NewInt(10)),
+			),
+			expectedUpdatedRecords: []types.IncentiveRecord{
+				withRemainingAmount(defaultRecord, sdk.NewDec(9000)),
+				withRemainingAmount(withDenom(defaultRecord, "bar"), sdk.NewDec(9000)),
+			},
+		},
+		"per-liquidity growth is truncated": {
+			accumUptime:      time.Hour,
+			qualifyingLiq:    sdk.NewDec(3),
+			timeElapsed:      time.Second,
+			incentiveRecords: []types.IncentiveRecord{defaultRecord},
+
+			expectedIncentives:     sdk.NewDecCoins(sdk.NewDecCoinFromDec(defaultIncentiveDenom, sdk.MustNewDecFromStr("3.333333333333333333"))),
+			expectedUpdatedRecords: []types.IncentiveRecord{withRemainingAmount(defaultRecord, sdk.NewDec(9990))},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			// system under test
+			incentives, updatedRecords := cl.CalcAccruedIncentivesForAccum(tc.accumUptime, tc.qualifyingLiq, lastUpdate, lastUpdate.Add(tc.timeElapsed), tc.incentiveRecords)
+
+			s.Require().Equal(tc.expectedIncentives, incentives)
+			s.Require().Equal(len(tc.expectedUpdatedRecords), len(updatedRecords))
+			for i, expectedRecord := range tc.expectedUpdatedRecords {
+				// Dec equality is checked separately since zero values may differ in their internal representation.
+				s.Require().Equal(expectedRecord.RemainingAmount.String(), updatedRecords[i].RemainingAmount.String())
+				expectedRecord.RemainingAmount = updatedRecords[i].RemainingAmount
+				s.Require().Equal(expectedRecord, updatedRecords[i])
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateUptimeAccumulatorsToNow() {
+	type updateAccumToNow struct {
+		createPosition bool
+		minUptime      time.Duration
+		timeElapsed    time.Duration
+
+		expectedAccumGrowth sdk.Dec
+		expectedRemaining   sdk.Dec
+	}
+	tests := map[string]updateAccumToNow{
+		"no liquidity, no emissions": {
+			minUptime:   time.Hour,
+			timeElapsed: time.Second * 100,
+
+			expectedAccumGrowth: sdk.ZeroDec(),
+			expectedRemaining:   defaultIncentiveAmount.ToDec(),
+		},
+		"liquidity, partial emission": {
+			createPosition: true,
+			minUptime:      time.Hour,
+			timeElapsed:    time.Second * 100,
+
+			expectedAccumGrowth: defaultEmissionRate.MulInt64(100).QuoTruncate(DefaultLiquidityAmt),
+			expectedRemaining:   defaultIncentiveAmount.ToDec().Sub(defaultEmissionRate.MulInt64(100)),
+		},
+		"liquidity, full emission": {
+			createPosition: true,
+			minUptime:      time.Minute,
+			timeElapsed:    time.Hour,
+
+			expectedAccumGrowth: defaultIncentiveAmount.ToDec().QuoTruncate(DefaultLiquidityAmt),
+			expectedRemaining:   sdk.ZeroDec(),
+		},
+		"no time elapsed": {
+			createPosition: true,
+			minUptime:      time.Hour,
+
+			expectedAccumGrowth: sdk.ZeroDec(),
+			expectedRemaining:   defaultIncentiveAmount.ToDec(),
+		},
+		// The default position is frozen for a day, so none of the active liquidity qualifies for a week.
+		"liquidity not qualifying for the uptime, no emissions": {
+			createPosition: true,
+			minUptime:      time.Hour * 24 * 7,
+			timeElapsed:    time.Second * 100,
+
+			expectedAccumGrowth: sdk.ZeroDec(),
+			expectedRemaining:   defaultIncentiveAmount.ToDec(),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			s.Ctx = s.Ctx.WithBlockTime(defaultStartTime)
+
+			pool := s.PrepareConcentratedPool()
+			if tc.createPosition {
+				s.SetupDefaultPosition(pool.GetId())
+			}
+
+			s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(defaultIncentiveDenom, defaultIncentiveAmount)))
+			_, err := clKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[1], defaultIncentiveDenom, defaultIncentiveAmount, defaultEmissionRate, defaultStartTime, tc.minUptime)
+			s.Require().NoError(err)
+
+			s.Ctx = s.Ctx.WithBlockTime(defaultStartTime.Add(tc.timeElapsed))
+
+			// system under test
+			err = clKeeper.UpdateUptimeAccumulatorsToNow(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			// only the accumulator matching the record's min uptime grows
+			accumValues, err := clKeeper.GetUptimeAccumulatorValues(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			for uptimeIndex, uptime := range types.SupportedUptimes {
+				if uptime == tc.minUptime {
+					s.Require().Equal(tc.expectedAccumGrowth, accumValues[uptimeIndex].AmountOf(defaultIncentiveDenom))
+				} else {
+					s.Require().True(accumValues[uptimeIndex].IsZero())
+				}
+			}
+
+			// fully emitted records are deleted
+			record, err := clKeeper.GetIncentiveRecord(s.Ctx, pool.GetId(), defaultIncentiveDenom, tc.minUptime, s.TestAccs[1])
+			if tc.expectedRemaining.IsZero() {
+				s.Require().ErrorIs(err, types.IncentiveRecordNotFoundError{PoolId: pool.GetId(), IncentiveDenom: defaultIncentiveDenom, MinUptime: tc.minUptime, Creator: s.TestAccs[1].String()})
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedRemaining, record.RemainingAmount)
+			}
+
+			updatedPool, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(s.Ctx.BlockTime(), updatedPool.GetLastLiquidityUpdate())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPoolUptimeLiquidity() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+
+	// The default position is in range and frozen for a day, so it qualifies for every uptime but a week.
+	inRangePosition := s.SetupDefaultPosition(pool.GetId())
+	// The position above the current price is frozen for a week, so it qualifies for every uptime.
+	abovePosition := s.SetupPosition(pool.GetId(), s.TestAccs[1], DefaultCoin0, DefaultCoin1, DefaultUpperTick, DefaultUpperTick+1000, s.Ctx.BlockTime().Add(time.Hour*24*7))
+
+	inRangeLiquidity := inRangePosition.Liquidity
+	updatedPool, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Dec{inRangeLiquidity, inRangeLiquidity, inRangeLiquidity, sdk.ZeroDec()}, updatedPool.GetUptimeLiquidity())
+
+	// Swapping the price above the in range position makes only the position above active.
+	_, _, _, _, _, err = clKeeper.SwapOutAmtGivenIn(s.Ctx, sdk.NewCoin(USDC, sdk.NewInt(10_000_000_000)), ETH, sdk.ZeroDec(), sdk.ZeroDec(), pool.GetId())
+	s.Require().NoError(err)
+
+	aboveLiquidity := abovePosition.Liquidity
+	updatedPool, err = clKeeper.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(aboveLiquidity, updatedPool.GetLiquidity())
+	s.Require().Equal([]sdk.Dec{aboveLiquidity, aboveLiquidity, aboveLiquidity, aboveLiquidity}, updatedPool.GetUptimeLiquidity())
+
+	// Withdrawing the active position once its freeze ends removes its qualifying liquidity.
+	s.Ctx = s.Ctx.WithBlockTime(abovePosition.FrozenUntil)
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, s.TestAccs[1], abovePosition.PositionId, aboveLiquidity)
+	s.Require().NoError(err)
+
+	updatedPool, err = clKeeper.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()}, updatedPool.GetUptimeLiquidity())
+}
+
+func (s *KeeperTestSuite) TestBeginBlockSyncsPoolsWithEmittingIncentives() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	s.Ctx = s.Ctx.WithBlockTime(defaultStartTime)
+
+	emittingPool := s.PrepareConcentratedPool()
+	futurePool := s.PrepareConcentratedPool()
+	unincentivizedPool := s.PrepareConcentratedPool()
+	for _, pool := range []types.ConcentratedPoolExtension{emittingPool, futurePool, unincentivizedPool} {
+		s.SetupDefaultPosition(pool.GetId())
+	}
+
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(defaultIncentiveDenom, defaultIncentiveAmount.MulRaw(2))))
+	_, err := clKeeper.CreateIncentive(s.Ctx, emittingPool.GetId(), s.TestAccs[1], defaultIncentiveDenom, defaultIncentiveAmount, defaultEmissionRate, defaultStartTime, time.Hour)
+	s.Require().NoError(err)
+	_, err = clKeeper.CreateIncentive(s.Ctx, futurePool.GetId(), s.TestAccs[1], defaultIncentiveDenom, defaultIncentiveAmount, defaultEmissionRate, defaultStartTime.Add(time.Hour), time.Hour)
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockTime(defaultStartTime.Add(time.Second * 100))
+
+	// system under test
+	clKeeper.BeginBlock(s.Ctx)
+
+	// Only the pool with a started incentive record is synced.
+	expectedLastLiquidityUpdates := map[uint64]time.Time{
+		emittingPool.GetId():       s.Ctx.BlockTime(),
+		futurePool.GetId():         defaultStartTime,
+		unincentivizedPool.GetId(): defaultStartTime,
+	}
+	for poolId, expectedLastLiquidityUpdate := range expectedLastLiquidityUpdates {
+		pool, err := clKeeper.GetPoolById(s.Ctx, poolId)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedLastLiquidityUpdate, pool.GetLastLiquidityUpdate())
+	}
+
+	record, err := clKeeper.GetIncentiveRecord(s.Ctx, emittingPool.GetId(), defaultIncentiveDenom, time.Hour, s.TestAccs[1])
+	s.Require().NoError(err)
+	s.Require().Equal(defaultIncentiveAmount.ToDec().Sub(defaultEmissionRate.MulInt64(100)), record.RemainingAmount)
+
+	// Once the emitting pool's record is exhausted, it is deleted and the pool is no longer visited,
+	// while the future pool's record has started emitting.
+	s.Ctx = s.Ctx.WithBlockTime(defaultStartTime.Add(time.Hour + time.Second*100))
+	clKeeper.BeginBlock(s.Ctx)
+
+	_, err = clKeeper.GetIncentiveRecord(s.Ctx, emittingPool.GetId(), defaultIncentiveDenom, time.Hour, s.TestAccs[1])
+	s.Require().ErrorIs(err, types.IncentiveRecordNotFoundError{PoolId: emittingPool.GetId(), IncentiveDenom: defaultIncentiveDenom, MinUptime: time.Hour, Creator: s.TestAccs[1].String()})
+
+	poolIds, err := clKeeper.GetPoolIdsWithEmittingIncentives(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{futurePool.GetId()}, poolIds)
+}
+
+func (s *KeeperTestSuite) TestBeginBlockRemovesUptimeRecordsOfUnfrozenPositions() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	s.Ctx = s.Ctx.WithBlockTime(defaultStartTime)
+	pool := s.PrepareConcentratedPool()
+
+	// Both positions share a range. The default position is frozen for a day, the other one for a week.
+	unfrozenPosition := s.SetupDefaultPosition(pool.GetId())
+	frozenPosition := s.SetupPosition(pool.GetId(), s.TestAccs[2], DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime().Add(time.Hour*24*7))
+	owner := s.TestAccs[0]
+
+	incentiveAmount := sdk.NewInt(1_000_000_000)
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(defaultIncentiveDenom, incentiveAmount)))
+	_, err := clKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[1], defaultIncentiveDenom, incentiveAmount, defaultEmissionRate, defaultStartTime, time.Hour)
+	s.Require().NoError(err)
+
+	// Right before the freeze ends, the position is still eligible and accrues incentives.
+	s.Ctx = s.Ctx.WithBlockTime(unfrozenPosition.FrozenUntil.Add(-time.Second))
+	clKeeper.BeginBlock(s.Ctx)
+	s.Require().False(s.App.BankKeeper.GetBalance(s.Ctx, owner, defaultIncentiveDenom).IsPositive())
+
+	s.Ctx = s.Ctx.WithBlockTime(unfrozenPosition.FrozenUntil)
+	expectedIncentives, err := clKeeper.GetClaimableIncentives(s.Ctx, unfrozenPosition.PositionId)
+	s.Require().NoError(err)
+	s.Require().True(expectedIncentives.AmountOf(defaultIncentiveDenom).IsPositive())
+
+	// system under test
+	clKeeper.BeginBlock(s.Ctx)
+
+	// The accrued incentives are collected to the owner and the position's liquidity no longer qualifies.
+	s.Require().Equal(expectedIncentives, sdk.NewCoins(s.App.BankKeeper.GetBalance(s.Ctx, owner, defaultIncentiveDenom)))
+	frozenLiquidity := frozenPosition.Liquidity
+	updatedPool, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Dec{frozenLiquidity, frozenLiquidity, frozenLiquidity, frozenLiquidity}, updatedPool.GetUptimeLiquidity())
+
+	// As time passes, the position no longer accrues incentives, while the position that is still frozen does.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second * 100))
+	clKeeper.BeginBlock(s.Ctx)
+
+	claimableIncentives, err := clKeeper.GetClaimableIncentives(s.Ctx, unfrozenPosition.PositionId)
+	s.Require().NoError(err)
+	s.Require().True(claimableIncentives.IsZero())
+	claimableIncentives, err = clKeeper.GetClaimableIncentives(s.Ctx, frozenPosition.PositionId)
+	s.Require().NoError(err)
+	s.Require().True(claimableIncentives.AmountOf(defaultIncentiveDenom).GT(expectedIncentives.AmountOf(defaultIncentiveDenom)))
+
+	// Extending the freeze when adding liquidity requalifies the position.
+	s.FundAcc(owner, sdk.NewCoins(DefaultCoin0, DefaultCoin1))
+	_, _, liquidityDelta, err := clKeeper.AddToPosition(s.Ctx, owner, unfrozenPosition.PositionId, DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), s.Ctx.BlockTime().Add(DefaultFreezeDuration))
+	s.Require().NoError(err)
+
+	totalLiquidity := frozenLiquidity.Add(unfrozenPosition.Liquidity).Add(liquidityDelta)
+	updatedPool, err = clKeeper.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Dec{totalLiquidity, totalLiquidity, totalLiquidity, frozenLiquidity}, updatedPool.GetUptimeLiquidity())
+}
+
+func (s *KeeperTestSuite) TestCreateIncentive() {
+	type createIncentiveTest struct {
+		poolId          uint64
+		incentiveAmount sdk.Int
+		emissionRate    sdk.Dec
+		startTime       time.Time
+		minUptime       time.Duration
+		recordExists    bool
+
+		expectedErr error
+	}
+	tests := map[string]createIncentiveTest{
+		"valid incentive": {
+			poolId:          defaultPoolId,
+			incentiveAmount: defaultIncentiveAmount,
+			emissionRate:    defaultEmissionRate,
+			startTime:       defaultStartTime,
+			minUptime:       time.Hour,
+		},
+		"valid incentive starting in the future": {
+			poolId:          defaultPoolId,
+			incentiveAmount: defaultIncentiveAmount,
+			emissionRate:    defaultEmissionRate,
+			startTime:       defaultStartTime.Add(time.Hour),
+			minUptime:       time.Hour * 24 * 7,
+		},
+		"pool does not exist": {
+			poolId:          defaultPoolId + 1,
+			incentiveAmount: defaultIncentiveAmount,
+			emissionRate:    defaultEmissionRate,
+			startTime:       defaultStartTime,
+			minUptime:       time.Hour,
+
+			expectedErr: types.PoolNotFoundError{PoolId: defaultPoolId + 1},
+		},
+		"unsupported min uptime": {
+			poolId:          defaultPoolId,
+			incentiveAmount: defaultIncentiveAmount,
+			emissionRate:    defaultEmissionRate,
+			startTime:       defaultStartTime,
+			minUptime:       time.Hour * 2,
+
+			expectedErr: types.InvalidMinUptimeError{PoolId: defaultPoolId, MinUptime: time.Hour * 2, AuthorizedUptimes: types.SupportedUptimes},
+		},
+		"zero incentive amount": {
+			poolId:          defaultPoolId,
+			incentiveAmount: sdk.ZeroInt(),
+			emissionRate:    defaultEmissionRate,
+			startTime:       defaultStartTime,
+			minUptime:       time.Hour,
+
+			expectedErr: types.NonPositiveIncentiveAmountError{PoolId: defaultPoolId, IncentiveAmount: sdk.ZeroInt()},
+		},
+		"zero emission rate": {
+			poolId:          defaultPoolId,
+			incentiveAmount: defaultIncentiveAmount,
+			emissionRate:    sdk.ZeroDec(),
+			startTime:       defaultStartTime,
+			minUptime:       time.Hour,
+
+			expectedErr: types.NonPositiveEmissionRateError{PoolId: defaultPoolId, EmissionRate: sdk.ZeroDec()},
+		},
+		"start time in the past": {
+			poolId:          defaultPoolId,
+			incentiveAmount: defaultIncentiveAmount,
+			emissionRate:    defaultEmissionRate,
+			startTime:       defaultStartTime.Add(-time.Second),
+			minUptime:       time.Hour,
+
+			expectedErr: types.InvalidIncentiveStartTimeError{PoolId: defaultPoolId, StartTime: defaultStartTime.Add(-time.Second), BlockTime: defaultStartTime},
+		},
+		"record already exists": {
+			poolId:          defaultPoolId,
+			incentiveAmount: defaultIncentiveAmount,
+			emissionRate:    defaultEmissionRate,
+			startTime:       defaultStartTime,
+			minUptime:       time.Hour,
+			recordExists:    true,
+
+			expectedErr: types.IncentiveRecordExistsError{PoolId: defaultPoolId, IncentiveDenom: defaultIncentiveDenom, MinUptime: time.Hour},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			s.Ctx = s.Ctx.WithBlockTime(defaultStartTime)
+			pool := s.PrepareConcentratedPool()
+			sender := s.TestAccs[1]
+			s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(defaultIncentiveDenom, defaultIncentiveAmount.MulRaw(2))))
+
+			if tc.recordExists {
+				_, err := clKeeper.CreateIncentive(s.Ctx, defaultPoolId, sender, defaultIncentiveDenom, tc.incentiveAmount, tc.emissionRate, tc.startTime, tc.minUptime)
+				s.Require().NoError(err)
+			}
+
+			// system under test
+			record, err := clKeeper.CreateIncentive(s.Ctx, tc.poolId, sender, defaultIncentiveDenom, tc.incentiveAmount, tc.emissionRate, tc.startTime, tc.minUptime)
+			if tc.expectedErr != nil {
+				s.Require().Error(err)
+				if tc.recordExists {
+					s.Require().ErrorContains(err, "already exists")
+				} else {
+					s.Require().ErrorContains(err, tc.expectedErr.Error())
+				}
+				return
+			}
+			s.Require().NoError(err)
+
+			expectedRecord := types.IncentiveRecord{
+				PoolId:           tc.poolId,
+				IncentiveDenom:   defaultIncentiveDenom,
+				IncentiveCreator: sender.String(),
+				RemainingAmount:  tc.incentiveAmount.ToDec(),
+				EmissionRate:     tc.emissionRate,
+				StartTime:        tc.startTime,
+				MinUptime:        tc.minUptime,
+			}
+			s.Require().Equal(expectedRecord, record)
+
+			// the record is retrievable from state
+			records, err := clKeeper.GetAllIncentiveRecordsForPool(s.Ctx, tc.poolId)
+			s.Require().NoError(err)
+			s.Require().Equal([]types.IncentiveRecord{expectedRecord}, records)
+
+			// the incentives were escrowed apart from the pool's reserves
+			s.Require().Equal(tc.incentiveAmount, s.App.BankKeeper.GetBalance(s.Ctx, pool.GetIncentivesAddress(), defaultIncentiveDenom).Amount)
+			s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, pool.GetAddress(), defaultIncentiveDenom).IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAddToIncentive() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	s.Ctx = s.Ctx.WithBlockTime(defaultStartTime)
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	sender := s.TestAccs[1]
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(defaultIncentiveDenom, defaultIncentiveAmount.MulRaw(2))))
+	emissionDuration := time.Second * 1000
+
+	// invalid top ups are rejected.
+	_, err := clKeeper.AddToIncentive(s.Ctx, defaultPoolId+1, sender, defaultIncentiveDenom, defaultIncentiveAmount, time.Minute, emissionDuration)
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: defaultPoolId + 1})
+	_, err = clKeeper.AddToIncentive(s.Ctx, defaultPoolId, sender, defaultIncentiveDenom, defaultIncentiveAmount, time.Hour*2, emissionDuration)
+	s.Require().ErrorContains(err, types.InvalidMinUptimeError{PoolId: defaultPoolId, MinUptime: time.Hour * 2, AuthorizedUptimes: types.SupportedUptimes}.Error())
+	_, err = clKeeper.AddToIncentive(s.Ctx, defaultPoolId, sender, defaultIncentiveDenom, sdk.ZeroInt(), time.Minute, emissionDuration)
+	s.Require().ErrorContains(err, types.NonPositiveIncentiveAmountError{PoolId: defaultPoolId, IncentiveAmount: sdk.ZeroInt()}.Error())
+	_, err = clKeeper.AddToIncentive(s.Ctx, defaultPoolId, sender, defaultIncentiveDenom, defaultIncentiveAmount, time.Minute, time.Millisecond)
+	s.Require().ErrorIs(err, types.EmissionDurationTooShortError{PoolId: defaultPoolId, EmissionDuration: time.Millisecond})
+
+	// the first top up creates the record, emitting its amount over the emission duration.
+	record, err := clKeeper.AddToIncentive(s.Ctx, defaultPoolId, sender, defaultIncentiveDenom, defaultIncentiveAmount, time.Minute, emissionDuration)
+	s.Require().NoError(err)
+	s.Require().Equal(types.IncentiveRecord{
+		PoolId:           defaultPoolId,
+		IncentiveDenom:   defaultIncentiveDenom,
+		IncentiveCreator: sender.String(),
+		RemainingAmount:  defaultIncentiveAmount.ToDec(),
+		EmissionRate:     sdk.NewDec(1000),
+		StartTime:        defaultStartTime,
+		MinUptime:        time.Minute,
+	}, record)
+
+	// a later top up adds to the amount left after syncing the emissions so far,
+	// and emits the total over the emission duration from now on.
+	s.Ctx = s.Ctx.WithBlockTime(defaultStartTime.Add(time.Second * 100))
+	record, err = clKeeper.AddToIncentive(s.Ctx, defaultPoolId, sender, defaultIncentiveDenom, defaultIncentiveAmount, time.Minute, emissionDuration)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(1_900_000), record.RemainingAmount)
+	s.Require().Equal(sdk.NewDec(1900), record.EmissionRate)
+	s.Require().Equal(defaultStartTime, record.StartTime)
+
+	records, err := clKeeper.GetAllIncentiveRecordsForPool(s.Ctx, defaultPoolId)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.IncentiveRecord{record}, records)
+	s.Require().Equal(defaultIncentiveAmount.MulRaw(2), s.App.BankKeeper.GetBalance(s.Ctx, pool.GetIncentivesAddress(), defaultIncentiveDenom).Amount)
+}
+
+func (s *KeeperTestSuite) TestCollectIncentives() {
+	type collectIncentivesTest struct {
+		minUptime       time.Duration
+		timeElapsed     time.Duration
+		positionInRange bool
+
+		expectedNoIncentives bool
+	}
+	tests := map[string]collectIncentivesTest{
+		"in range position frozen for long enough": {
+			minUptime:       time.Hour,
+			timeElapsed:     time.Second * 100,
+			positionInRange: true,
+		},
+		"in range position, incentives fully emitted": {
+			minUptime:       time.Minute,
+			timeElapsed:     time.Hour,
+			positionInRange: true,
+		},
+		"in range position not frozen for long enough": {
+			minUptime:       time.Hour * 24 * 7,
+			timeElapsed:     time.Second * 100,
+			positionInRange: true,
+
+			expectedNoIncentives: true,
+		},
+		"out of range position": {
+			minUptime:   time.Hour,
+			timeElapsed: time.Second * 100,
+
+			expectedNoIncentives: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			s.Ctx = s.Ctx.WithBlockTime(defaultStartTime)
+			pool := s.PrepareConcentratedPool()
+			frozenUntil := s.Ctx.BlockTime().Add(DefaultFreezeDuration)
+
+			// The default position is in range and sets the pool's current tick.
+			owner := s.TestAccs[0]
+			position := s.SetupDefaultPosition(pool.GetId())
+			if !tc.positionInRange {
+				owner = s.TestAccs[2]
+				position = s.SetupPosition(pool.GetId(), owner, DefaultCoin0, DefaultCoin1, DefaultUpperTick+100, DefaultUpperTick+200, frozenUntil)
+			}
+
+			s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(defaultIncentiveDenom, defaultIncentiveAmount)))
+			_, err := clKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[1], defaultIncentiveDenom, defaultIncentiveAmount, defaultEmissionRate, defaultStartTime, tc.minUptime)
+			s.Require().NoError(err)
+
+			s.Ctx = s.Ctx.WithBlockTime(defaultStartTime.Add(tc.timeElapsed))
+
+			claimable, err := clKeeper.GetClaimableIncentives(s.Ctx, position.PositionId)
+			s.Require().NoError(err)
+
+			balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, owner, defaultIncentiveDenom)
+
+			// system under test
+			collected, err := clKeeper.CollectIncentives(s.Ctx, owner, position.PositionId)
+			s.Require().NoError(err)
+
+			// the claimable query matches what is actually collected
+			s.Require().Equal(claimable, collected)
+
+			balanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, owner, defaultIncentiveDenom)
+			s.Require().Equal(collected.AmountOf(defaultIncentiveDenom), balanceAfter.Amount.Sub(balanceBefore.Amount))
+
+			if tc.expectedNoIncentives {
+				s.Require().True(collected.Empty())
+				return
+			}
+
+			// The position holds all of the active liquidity so it receives all of the emissions,
+			// less the truncation of the per-liquidity growth.
+			emitted := sdk.MinDec(defaultEmissionRate.MulInt64(int64(tc.timeElapsed/time.Second)), defaultIncentiveAmount.ToDec())
+			expectedGrowth := sdk.NewDecCoins(sdk.NewDecCoinFromDec(defaultIncentiveDenom, emitted.QuoTruncate(DefaultLiquidityAmt)))
+			expectedCollected, _ := expectedGrowth.MulDec(DefaultLiquidityAmt).TruncateDecimal()
+			s.Require().Equal(expectedCollected, collected)
+			s.Require().True(collected.AmountOf(defaultIncentiveDenom).LTE(emitted.TruncateInt()))
+
+			// collecting again in the same block yields nothing
+			collectedAgain, err := clKeeper.CollectIncentives(s.Ctx, owner, position.PositionId)
+			s.Require().NoError(err)
+			s.Require().True(collectedAgain.Empty())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestWithdrawPositionCollectsIncentives() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	s.Ctx = s.Ctx.WithBlockTime(defaultStartTime)
+	pool := s.PrepareConcentratedPool()
+	frozenUntil := s.Ctx.BlockTime().Add(DefaultFreezeDuration)
+	owner := s.TestAccs[0]
+	position := s.SetupPosition(pool.GetId(), owner, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, frozenUntil)
+
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(defaultIncentiveDenom, defaultIncentiveAmount)))
+	_, err := clKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[1], defaultIncentiveDenom, defaultIncentiveAmount, defaultEmissionRate, defaultStartTime, time.Hour)
+	s.Require().NoError(err)
+
+	// Move past the freeze so that the position can be withdrawn. All incentives have been emitted by then.
+	s.Ctx = s.Ctx.WithBlockTime(frozenUntil)
+
+	// system under test
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, position.PositionId, position.Liquidity)
+	s.Require().NoError(err)
+
+	expectedGrowth := sdk.NewDecCoins(sdk.NewDecCoinFromDec(defaultIncentiveDenom, defaultIncentiveAmount.ToDec().QuoTruncate(position.Liquidity)))
+	expectedCollected, _ := expectedGrowth.MulDec(position.Liquidity).TruncateDecimal()
+	s.Require().Equal(expectedCollected.AmountOf(defaultIncentiveDenom), s.App.BankKeeper.GetBalance(s.Ctx, owner, defaultIncentiveDenom).Amount)
+
+	// the position's uptime accumulator records are cleared
+	uptimeAccums, err := clKeeper.GetUptimeAccumulators(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	positionName := string(types.KeyPositionId(position.PositionId))
+	for uptimeIndex := range types.SupportedUptimes {
+		hasPosition, err := uptimeAccums[uptimeIndex].HasPosition(positionName)
+		s.Require().NoError(err)
+		s.Require().False(hasPosition)
+	}
+}
@@ -14,6 +14,7 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 
 	paramSpace paramtypes.Subspace
+	listeners  types.ConcentratedLiquidityListeners
 
 	// keepers
 	poolmanagerKeeper types.PoolManagerKeeper
@@ -48,3 +49,14 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 func (k *Keeper) SetPoolManagerKeeper(poolmanagerKeeper types.PoolManagerKeeper) {
 	k.poolmanagerKeeper = poolmanagerKeeper
 }
+
+// Set the concentrated liquidity listeners.
+func (k *Keeper) SetListeners(listeners types.ConcentratedLiquidityListeners) *Keeper {
+	if k.listeners != nil {
+		panic("cannot set concentrated liquidity listeners twice")
+	}
+
+	k.listeners = listeners
+
+	return k
+}
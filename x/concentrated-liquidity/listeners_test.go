@@ -0,0 +1,126 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
+)
+
+type listenerCall struct {
+	name       string
+	sender     sdk.AccAddress
+	poolId     uint64
+	positionId uint64
+	coinsIn    sdk.Coins
+	coinsOut   sdk.Coins
+	liquidity  sdk.Dec
+}
+
+// recordingListener records every call to its callbacks.
+type recordingListener struct {
+	calls []listenerCall
+}
+
+var _ types.ConcentratedLiquidityListener = &recordingListener{}
+
+func (l *recordingListener) AfterConcentratedPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	l.calls = append(l.calls, listenerCall{name: "pool created", sender: sender, poolId: poolId})
+}
+
+func (l *recordingListener) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	l.calls = append(l.calls, listenerCall{name: "swap", sender: sender, poolId: poolId, coinsIn: input, coinsOut: output})
+}
+
+func (l *recordingListener) AfterPositionCreated(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidity sdk.Dec) {
+	l.calls = append(l.calls, listenerCall{name: "position created", sender: owner, poolId: poolId, positionId: positionId, coinsIn: tokensIn, liquidity: liquidity})
+}
+
+func (l *recordingListener) AfterPositionModified(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidityDelta sdk.Dec) {
+	l.calls = append(l.calls, listenerCall{name: "position modified", sender: owner, poolId: poolId, positionId: positionId, coinsIn: tokensIn, liquidity: liquidityDelta})
+}
+
+func (l *recordingListener) AfterPositionWithdrawn(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensOut sdk.Coins, liquidity sdk.Dec) {
+	l.calls = append(l.calls, listenerCall{name: "position withdrawn", sender: owner, poolId: poolId, positionId: positionId, coinsOut: tokensOut, liquidity: liquidity})
+}
+
+// TestListeners tests that the listeners are called with the pool creator, swapper or position owner
+// and the tokens moved, after creating a pool, swapping, creating a position and withdrawing it.
+func (s *KeeperTestSuite) TestListeners() {
+	s.SetupTest()
+	listener := &recordingListener{}
+	s.App.ConcentratedLiquidityKeeper.SetListenersUnsafe(types.NewConcentratedLiquidityListeners(listener))
+	owner := s.TestAccs[0]
+
+	pool := s.PrepareConcentratedPool()
+	poolId := pool.GetId()
+
+	s.FundAcc(owner, sdk.NewCoins(DefaultCoin0, DefaultCoin1))
+	positionId, amount0, amount1, liquidity, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, poolId, owner,
+		DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+
+	tokenIn := sdk.NewInt64Coin(USDC, 1_000_000)
+	s.FundAcc(owner, sdk.NewCoins(tokenIn))
+	tokenOutAmount, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, owner,
+		[]poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: ETH}}, tokenIn, sdk.OneInt())
+	s.Require().NoError(err)
+
+	withdrawn0, withdrawn1, err := s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, owner, positionId, liquidity)
+	s.Require().NoError(err)
+
+	s.Require().Equal([]listenerCall{
+		{name: "pool created", sender: owner, poolId: poolId},
+		{name: "position created", sender: owner, poolId: poolId, positionId: positionId, coinsIn: sdk.NewCoins(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1)), liquidity: liquidity},
+		{name: "swap", sender: owner, poolId: poolId, coinsIn: sdk.Coins{tokenIn}, coinsOut: sdk.Coins{sdk.NewCoin(ETH, tokenOutAmount)}},
+		{name: "position withdrawn", sender: owner, poolId: poolId, positionId: positionId, coinsOut: sdk.NewCoins(sdk.NewCoin(ETH, withdrawn0), sdk.NewCoin(USDC, withdrawn1)), liquidity: liquidity},
+	}, listener.calls)
+}
+
+// TestListeners_PositionModified tests that the listeners are called with the tokens and liquidity added
+// when liquidity is added to an existing position or its fees are compounded.
+func (s *KeeperTestSuite) TestListeners_PositionModified() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	owner := s.TestAccs[0]
+
+	pool := s.PrepareCustomConcentratedPool(owner, ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, sdk.NewDecWithPrec(1, 2))
+	poolId := pool.GetId()
+	position := s.SetupDefaultPosition(poolId)
+
+	listener := &recordingListener{}
+	clKeeper.SetListenersUnsafe(types.NewConcentratedLiquidityListeners(listener))
+
+	s.FundAcc(owner, sdk.NewCoins(DefaultCoin0, DefaultCoin1))
+	added0, added1, addedLiquidity, err := clKeeper.AddToPosition(s.Ctx, owner, position.PositionId,
+		DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), position.FrozenUntil)
+	s.Require().NoError(err)
+
+	// Swap both ways so that fees accrue in both tokens and can be compounded.
+	swapper := s.TestAccs[2]
+	for _, tokenIn := range []sdk.Coin{sdk.NewCoin(USDC, sdk.NewInt(10000000)), sdk.NewCoin(ETH, sdk.NewInt(2000))} {
+		s.FundAcc(swapper, sdk.NewCoins(tokenIn))
+		tokenOutDenom := ETH
+		if tokenIn.Denom == ETH {
+			tokenOutDenom = USDC
+		}
+		_, err = s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, swapper,
+			[]poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}, tokenIn, sdk.OneInt())
+		s.Require().NoError(err)
+	}
+
+	compounded0, compounded1, compoundedLiquidity, _, err := clKeeper.CompoundFees(s.Ctx, owner, position.PositionId)
+	s.Require().NoError(err)
+	s.Require().True(compoundedLiquidity.IsPositive())
+
+	modifiedCalls := []listenerCall{}
+	for _, call := range listener.calls {
+		if call.name == "position modified" {
+			modifiedCalls = append(modifiedCalls, call)
+		}
+	}
+	s.Require().Equal([]listenerCall{
+		{name: "position modified", sender: owner, poolId: poolId, positionId: position.PositionId, coinsIn: sdk.NewCoins(sdk.NewCoin(ETH, added0), sdk.NewCoin(USDC, added1)), liquidity: addedLiquidity},
+		{name: "position modified", sender: owner, poolId: poolId, positionId: position.PositionId, coinsIn: sdk.NewCoins(sdk.NewCoin(ETH, compounded0), sdk.NewCoin(USDC, compounded1)), liquidity: compoundedLiquidity},
+	}, modifiedCalls)
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/internal/math"
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/model"
 	types "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
 )
 
@@ -16,28 +17,29 @@ import (
 // liquidity proportional to the existing reserves, the actual amount of tokens used might differ from requested.
 // As a result, LPs may also provide the minimum amount of each token to be used so that the system fails
 // to create position if the desired amounts cannot be satisfied.
-// On success, returns an actual amount of each token used and liquidity created.
+// Every call creates a new position with a unique, monotonically increasing position id.
+// On success, returns the id of the created position, an actual amount of each token used and liquidity created.
 // Returns error if:
 // - the provided ticks are out of range / invalid
 // - the pool provided does not exist
 // - the liquidity delta is zero
 // - the amount0 or amount1 returned from the position update is less than the given minimums
 // - the pool or user does not have enough tokens to satisfy the requested amount
-func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, amount0Desired, amount1Desired, amount0Min, amount1Min sdk.Int, lowerTick, upperTick int64, frozenUntil time.Time) (sdk.Int, sdk.Int, sdk.Dec, error) {
+func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, amount0Desired, amount1Desired, amount0Min, amount1Min sdk.Int, lowerTick, upperTick int64, frozenUntil time.Time) (positionId uint64, actualAmount0 sdk.Int, actualAmount1 sdk.Int, liquidityDelta sdk.Dec, err error) {
 	// Retrieve the pool associated with the given pool ID.
 	pool, err := k.getPoolById(ctx, poolId)
 	if err != nil {
-		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
 	}
 	// Check if the provided tick range is valid according to the pool's tick spacing and module parameters.
 	if err := validateTickRangeIsValid(pool.GetTickSpacing(), pool.GetPrecisionFactorAtPriceOne(), lowerTick, upperTick); err != nil {
-		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
 	}
 
 	// Transform the provided ticks into their corresponding sqrtPrices.
 	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(lowerTick, upperTick, pool.GetPrecisionFactorAtPriceOne())
 	if err != nil {
-		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
 	}
 
 	// Create a cache context for the current transaction.
@@ -53,34 +55,139 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	if k.isInitialPositionForPool(initialSqrtPrice, initialTick) {
 		err := k.initializeInitialPositionForPool(cacheCtx, pool, amount0Desired, amount1Desired)
 		if err != nil {
-			return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+			return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
 		}
 	}
 
 	// Calculate the amount of liquidity that will be added to the pool by creating this position.
-	liquidityDelta := math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0Desired, amount1Desired)
+	liquidityDelta = math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0Desired, amount1Desired)
 	if liquidityDelta.IsZero() {
-		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, errors.New("liquidityDelta calculated equals zero")
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, errors.New("liquidityDelta calculated equals zero")
+	}
+
+	// Assign the next position id and initialize the fee accumulator for the new position.
+	positionId = k.getNextPositionIdAndIncrement(cacheCtx)
+	if err := k.initializeFeeAccumulatorPosition(cacheCtx, poolId, positionId); err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	// Update the position in the pool based on the provided tick range and liquidity delta.
+	actualAmount0, actualAmount1, err = k.updatePosition(cacheCtx, poolId, owner, lowerTick, upperTick, liquidityDelta, frozenUntil, positionId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	// Check if the actual amounts of tokens 0 and 1 are greater than or equal to the given minimum amounts.
+	if actualAmount0.LT(amount0Min) {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.InsufficientLiquidityCreatedError{Actual: actualAmount0, Minimum: amount0Min, IsTokenZero: true}
+	}
+	if actualAmount1.LT(amount1Min) {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.InsufficientLiquidityCreatedError{Actual: actualAmount1, Minimum: amount1Min}
+	}
+
+	// Transfer the actual amounts of tokens 0 and 1 from the position owner to the pool.
+	err = k.sendCoinsBetweenPoolAndUser(cacheCtx, pool.GetToken0(), pool.GetToken1(), actualAmount0, actualAmount1, owner, pool.GetAddress())
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
 	}
 
-	// If this is a new position, initialize the fee accumulator for the position.
-	positions, err := k.getAllPositionsWithVaryingFreezeTimes(ctx, poolId, owner, lowerTick, upperTick)
+	// Persist the changes made to the cache context if the actual amounts of tokens 0 and 1 are greater than or equal to the given minimum amounts.
+	writeCacheCtx()
+
+	tokensIn := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), actualAmount0), sdk.NewCoin(pool.GetToken1(), actualAmount1))
+	k.listeners.AfterPositionCreated(ctx, owner, poolId, positionId, tokensIn, liquidityDelta)
+
+	return positionId, actualAmount0, actualAmount1, liquidityDelta, nil
+}
+
+// CalcFullRangePosition returns the actual amount of each token that would be used and the liquidity that would
+// be created by a full range position in the given pool with the desired amount of each token, without
+// creating the position. If the pool has no positions yet, its price is derived from the desired amounts as it
+// would be by CreatePosition.
+// Returns error if:
+// - the pool provided does not exist
+// - the pool has no positions yet and either desired amount is zero
+// - the liquidity delta is zero
+func (k Keeper) CalcFullRangePosition(ctx sdk.Context, poolId uint64, amount0Desired, amount1Desired sdk.Int) (actualAmount0 sdk.Int, actualAmount1 sdk.Int, liquidityDelta sdk.Dec, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
 	}
-	if len(positions) == 0 {
-		if err := k.initializeFeeAccumulatorPosition(cacheCtx, poolId, owner, lowerTick, upperTick); err != nil {
+
+	// Initializing the pool price is only done on a cache context that is never written.
+	cacheCtx, _ := ctx.CacheContext()
+	if k.isInitialPositionForPool(pool.GetCurrentSqrtPrice(), pool.GetCurrentTick()) {
+		if err := k.initializeInitialPositionForPool(cacheCtx, pool, amount0Desired, amount1Desired); err != nil {
 			return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
 		}
 	}
 
-	// Update the position in the pool based on the provided tick range and liquidity delta.
-	actualAmount0, actualAmount1, err := k.updatePosition(cacheCtx, poolId, owner, lowerTick, upperTick, liquidityDelta, frozenUntil)
+	minTick, maxTick := GetMinAndMaxTicksFromExponentAtPriceOne(pool.GetPrecisionFactorAtPriceOne())
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(minTick, maxTick, pool.GetPrecisionFactorAtPriceOne())
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	liquidityDelta = math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0Desired, amount1Desired)
+	if liquidityDelta.IsZero() {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, errors.New("liquidityDelta calculated equals zero")
+	}
+
+	// The amounts are rounded down as they are when the position is created.
+	amount0, amount1 := pool.CalcActualAmounts(cacheCtx, minTick, maxTick, sqrtPriceLowerTick, sqrtPriceUpperTick, liquidityDelta)
+	return amount0.TruncateInt(), amount1.TruncateInt(), liquidityDelta, nil
+}
+
+// AddToPosition adds liquidity to the existing position with the given id. Since liquidity can only be provided
+// proportional to the pool's current reserves within the position's range, the actual amount of tokens used might
+// differ from requested. The position keeps its id and fee accrual history: the fees and incentives accrued so far
+// are moved into its unclaimed rewards.
+// The position stays frozen until the later of its current frozenUntil and the given frozenUntil. Since every
+// position update requires the position to be frozen for the min uptime again, the resulting frozenUntil must
+// cover every uptime that the position earns incentives for.
+// On success, returns an actual amount of each token used and liquidity added.
+// Returns error if:
+// - there is no position with the given id
+// - the position is not owned by the given owner
+// - the liquidity delta is zero
+// - the resulting frozenUntil does not cover the uptimes the position earns incentives for
+// - the amount0 or amount1 used is less than the given minimums
+// - the user does not have enough tokens to satisfy the requested amount
+func (k Keeper) AddToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, amount0Desired, amount1Desired, amount0Min, amount1Min sdk.Int, frozenUntil time.Time) (actualAmount0 sdk.Int, actualAmount1 sdk.Int, liquidityDelta sdk.Dec, err error) {
+	position, err := k.getPositionOwnedBy(ctx, owner, positionId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	liquidityDelta, err = getLiquidityToAddToPosition(pool, position, amount0Desired, amount1Desired)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	if liquidityDelta.IsZero() {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, errors.New("liquidityDelta calculated equals zero")
+	}
+
+	if frozenUntil.Before(position.FrozenUntil) {
+		frozenUntil = position.FrozenUntil
+	}
+
+	if err := k.validateFrozenUntilCoversUptimes(ctx, position, frozenUntil); err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	// Only persist the changes if the actual amounts used are greater than or equal to the given minimum amounts.
+	cacheCtx, writeCacheCtx := ctx.CacheContext()
+
+	actualAmount0, actualAmount1, err = k.addLiquidityToPosition(cacheCtx, owner, pool, position, liquidityDelta, frozenUntil)
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
 	}
 
-	// Check if the actual amounts of tokens 0 and 1 are greater than or equal to the given minimum amounts.
 	if actualAmount0.LT(amount0Min) {
 		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.InsufficientLiquidityCreatedError{Actual: actualAmount0, Minimum: amount0Min, IsTokenZero: true}
 	}
@@ -88,38 +195,113 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.InsufficientLiquidityCreatedError{Actual: actualAmount1, Minimum: amount1Min}
 	}
 
-	// Transfer the actual amounts of tokens 0 and 1 from the position owner to the pool.
-	err = k.sendCoinsBetweenPoolAndUser(cacheCtx, pool.GetToken0(), pool.GetToken1(), actualAmount0, actualAmount1, owner, pool.GetAddress())
+	writeCacheCtx()
+
+	return actualAmount0, actualAmount1, liquidityDelta, nil
+}
+
+// CompoundFees collects the fees accrued by the position with the given id and adds the portion of them that
+// matches the pool's current reserves within the position's range back to the position. The remainder of the
+// collected fees is left with the owner. The position's frozenUntil is unchanged. Unlike AddToPosition, compounding
+// does not require the position to be frozen for the min uptime again: the compounded liquidity earns incentives for
+// the uptime records the position already has, which it keeps until its freeze ends.
+// On success, returns the amount of each token compounded, the liquidity added and the remaining fees.
+// Returns error if:
+// - there is no position with the given id
+// - the position is not owned by the given owner
+// - other internal database or math errors.
+func (k Keeper) CompoundFees(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (compoundedAmount0, compoundedAmount1 sdk.Int, liquidityDelta sdk.Dec, remainingFees sdk.Coins, err error) {
+	feesCollected, err := k.collectFees(ctx, owner, positionId)
 	if err != nil {
-		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdk.Coins{}, err
 	}
 
-	// Persist the changes made to the cache context if the actual amounts of tokens 0 and 1 are greater than or equal to the given minimum amounts.
-	writeCacheCtx()
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdk.Coins{}, err
+	}
 
-	return actualAmount0, actualAmount1, liquidityDelta, nil
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdk.Coins{}, err
+	}
+
+	liquidityDelta, err = getLiquidityToAddToPosition(pool, position, feesCollected.AmountOf(pool.GetToken0()), feesCollected.AmountOf(pool.GetToken1()))
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdk.Coins{}, err
+	}
+
+	// If none of the fees can be matched with the current reserves, they are all left with the owner.
+	if liquidityDelta.IsZero() {
+		return sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroDec(), feesCollected, nil
+	}
+
+	compoundedAmount0, compoundedAmount1, err = k.addLiquidityToPosition(ctx, owner, pool, position, liquidityDelta, position.FrozenUntil)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdk.Coins{}, err
+	}
+
+	remainingFees, isNegative := feesCollected.SafeSub(sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), compoundedAmount0), sdk.NewCoin(pool.GetToken1(), compoundedAmount1)))
+	if isNegative {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdk.Coins{}, fmt.Errorf("compounded amounts (%s, %s) exceed the collected fees (%s)", compoundedAmount0, compoundedAmount1, feesCollected)
+	}
+
+	return compoundedAmount0, compoundedAmount1, liquidityDelta, remainingFees, nil
 }
 
-// WithdrawPosition attempts to withdraw liquidityAmount from a position with the given pool id in the given tick range.
-// On success, returns a positive amount of each token withdrawn.
-// Returns error if
-// - there is no position in the given tick ranges
-// - if tick ranges are invalid
-// - if attempts to withdraw an amount higher than originally provided in createPosition for a given range.
-func (k Keeper) WithdrawPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, frozenUntil time.Time, requestedLiquidityAmountToWithdraw sdk.Dec) (amtDenom0, amtDenom1 sdk.Int, err error) {
-	// Retrieve the pool associated with the given pool ID.
-	pool, err := k.getPoolById(ctx, poolId)
+// getLiquidityToAddToPosition returns the liquidity that the given amounts provide within the position's range
+// at the pool's current price.
+func getLiquidityToAddToPosition(pool types.ConcentratedPoolExtension, position model.Position, amount0, amount1 sdk.Int) (sdk.Dec, error) {
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(position.LowerTick, position.UpperTick, pool.GetPrecisionFactorAtPriceOne())
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0, amount1), nil
+}
+
+// addLiquidityToPosition adds the given positive liquidity delta to the existing position and transfers the
+// corresponding amounts of tokens from the owner to the pool. The position is frozen until the given frozenUntil.
+// The liquidity is added to every uptime record the position already has, and records are created for the uptimes
+// that the given frozenUntil newly qualifies it for. The listeners are notified of the added liquidity.
+// Returns the actual amount of each token transferred.
+func (k Keeper) addLiquidityToPosition(ctx sdk.Context, owner sdk.AccAddress, pool types.ConcentratedPoolExtension, position model.Position, liquidityDelta sdk.Dec, frozenUntil time.Time) (sdk.Int, sdk.Int, error) {
+	// The position's frozenUntil is persisted prior to the update so that the uptime
+	// accumulators it newly qualifies for are initialized.
+	position.FrozenUntil = frozenUntil
+	k.setPosition(ctx, position)
+
+	actualAmount0, actualAmount1, err := k.updatePosition(ctx, position.PoolId, owner, position.LowerTick, position.UpperTick, liquidityDelta, frozenUntil, position.PositionId)
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, err
 	}
 
-	// Check if the provided tick range is valid according to the pool's tick spacing and module parameters.
-	if err := validateTickRangeIsValid(pool.GetTickSpacing(), pool.GetPrecisionFactorAtPriceOne(), lowerTick, upperTick); err != nil {
+	if err := k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), actualAmount0, actualAmount1, owner, pool.GetAddress()); err != nil {
 		return sdk.Int{}, sdk.Int{}, err
 	}
 
-	// Retrieve the position in the pool for the provided owner and tick range.
-	position, err := k.GetPosition(ctx, poolId, owner, lowerTick, upperTick, frozenUntil)
+	tokensIn := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), actualAmount0), sdk.NewCoin(pool.GetToken1(), actualAmount1))
+	k.listeners.AfterPositionModified(ctx, owner, position.PoolId, position.PositionId, tokensIn, liquidityDelta)
+
+	return actualAmount0, actualAmount1, nil
+}
+
+// WithdrawPosition attempts to withdraw liquidityAmount from the position with the given id.
+// On success, returns a positive amount of each token withdrawn.
+// Returns error if
+// - there is no position with the given id
+// - the position is not owned by the given owner
+// - the position is still frozen
+// - if attempts to withdraw an amount higher than originally provided in createPosition for the position.
+func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw sdk.Dec) (amtDenom0, amtDenom1 sdk.Int, err error) {
+	// Retrieve the position with the given id, ensuring it belongs to the owner.
+	position, err := k.getPositionOwnedBy(ctx, owner, positionId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	// Retrieve the pool associated with the position.
+	pool, err := k.getPoolById(ctx, position.PoolId)
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, err
 	}
@@ -129,6 +311,32 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAd
 		return sdk.Int{}, sdk.Int{}, types.PositionStillFrozenError{FrozenUntil: position.FrozenUntil}
 	}
 
+	return k.withdrawPosition(ctx, owner, position, pool, requestedLiquidityAmountToWithdraw)
+}
+
+// ForceWithdrawPosition withdraws liquidityAmount from the position with the given id to its owner,
+// regardless of whether the position is still frozen.
+// It is not exposed through messages and exists for modules that enforce obligations on frozen positions,
+// such as superfluid slashing positions migrated from superfluid staked locks.
+func (k Keeper) ForceWithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, liquidityAmount sdk.Dec) (amtDenom0, amtDenom1 sdk.Int, err error) {
+	position, err := k.getPositionOwnedBy(ctx, owner, positionId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	return k.withdrawPosition(ctx, owner, position, pool, liquidityAmount)
+}
+
+// withdrawPosition withdraws requestedLiquidityAmountToWithdraw from the given position of the given pool
+// without checking whether the position is frozen.
+func (k Keeper) withdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position model.Position, pool types.ConcentratedPoolExtension, requestedLiquidityAmountToWithdraw sdk.Dec) (amtDenom0, amtDenom1 sdk.Int, err error) {
+	positionId := position.PositionId
+
 	// Check if the requested liquidity amount to withdraw is less than or equal to the available liquidity for the position.
 	// If it is greater than the available liquidity, return an error.
 	availableLiquidity := position.Liquidity
@@ -141,7 +349,7 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAd
 	liquidityDelta := requestedLiquidityAmountToWithdraw.Neg()
 
 	// Update the position in the pool based on the provided tick range and liquidity delta.
-	actualAmount0, actualAmount1, err := k.updatePosition(ctx, poolId, owner, lowerTick, upperTick, liquidityDelta, frozenUntil)
+	actualAmount0, actualAmount1, err := k.updatePosition(ctx, position.PoolId, owner, position.LowerTick, position.UpperTick, liquidityDelta, position.FrozenUntil, positionId)
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, err
 	}
@@ -155,48 +363,59 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAd
 	// If the requested liquidity amount to withdraw is equal to the available liquidity, delete the position from state.
 	// Ensure we collect any outstanding fees prior to deleting the position from state
 	if requestedLiquidityAmountToWithdraw.Equal(availableLiquidity) {
-		if _, err := k.collectFees(ctx, poolId, owner, lowerTick, upperTick); err != nil {
+		if _, err := k.collectFees(ctx, owner, positionId); err != nil {
 			return sdk.Int{}, sdk.Int{}, err
 		}
-		if err := k.deletePosition(ctx, poolId, owner, lowerTick, upperTick, frozenUntil); err != nil {
+		if _, err := k.collectIncentives(ctx, owner, positionId); err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
+		if err := k.deletePosition(ctx, positionId); err != nil {
 			return sdk.Int{}, sdk.Int{}, err
 		}
 	}
 
+	tokensOut := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), actualAmount0.Abs()), sdk.NewCoin(pool.GetToken1(), actualAmount1.Abs()))
+	k.listeners.AfterPositionWithdrawn(ctx, owner, position.PoolId, positionId, tokensOut, requestedLiquidityAmountToWithdraw)
+
 	return actualAmount0.Neg(), actualAmount1.Neg(), nil
 }
 
-// updatePosition updates the position in the given pool id and in the given tick range and liquidityAmount.
+// updatePosition updates the position with the given id in the given pool id and in the given tick range and liquidityAmount.
 // Negative liquidityDelta implies withdrawing liquidity.
 // Positive liquidityDelta implies adding liquidity.
 // Updates ticks and pool liquidity. Returns how much of each token is either added or removed.
 // Negative returned amounts imply that tokens are removed from the pool.
 // Positive returned amounts imply that tokens are added to the pool.
-func (k Keeper) updatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, liquidityDelta sdk.Dec, frozenUntil time.Time) (sdk.Int, sdk.Int, error) {
-	// now calculate amount for token0 and token1
-	pool, err := k.getPoolById(ctx, poolId)
-	if err != nil {
+func (k Keeper) updatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, liquidityDelta sdk.Dec, frozenUntil time.Time, positionId uint64) (sdk.Int, sdk.Int, error) {
+	// Sync the uptime accumulators so that incentives emitted so far are distributed
+	// based on the liquidity prior to this update.
+	if err := k.updateUptimeAccumulatorsToNow(ctx, poolId); err != nil {
 		return sdk.Int{}, sdk.Int{}, err
 	}
 
-	currentTick := pool.GetCurrentTick().Int64()
-
 	// update tickInfo state
 	// TODO: come back to sdk.Int vs sdk.Dec state & truncation
-	err = k.initOrUpdateTick(ctx, poolId, currentTick, lowerTick, liquidityDelta, false)
+	err := k.initOrUpdateTick(ctx, poolId, lowerTick, liquidityDelta, false)
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, err
 	}
 
 	// TODO: come back to sdk.Int vs sdk.Dec state & truncation
-	err = k.initOrUpdateTick(ctx, poolId, currentTick, upperTick, liquidityDelta, true)
+	err = k.initOrUpdateTick(ctx, poolId, upperTick, liquidityDelta, true)
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, err
 	}
 
 	// update position state
 	// TODO: come back to sdk.Int vs sdk.Dec state & truncation
-	err = k.initOrUpdatePosition(ctx, poolId, owner, lowerTick, upperTick, liquidityDelta, frozenUntil)
+	err = k.initOrUpdatePosition(ctx, poolId, owner, lowerTick, upperTick, liquidityDelta, frozenUntil, positionId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	// now calculate amount for token0 and token1
+	// The pool is read after the position update, which updates the pool's qualifying uptime liquidity.
+	pool, err := k.getPoolById(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, err
 	}
@@ -219,7 +438,7 @@ func (k Keeper) updatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	}
 
 	// TODO: test https://github.com/osmosis-labs/osmosis/issues/3997
-	if err := k.updateFeeAccumulatorPosition(ctx, poolId, owner, liquidityDelta, lowerTick, upperTick); err != nil {
+	if err := k.updateFeeAccumulatorPosition(ctx, poolId, positionId, liquidityDelta, lowerTick, upperTick); err != nil {
 		return sdk.Int{}, sdk.Int{}, err
 	}
 
@@ -13,6 +13,7 @@ import (
 
 type lpTest struct {
 	poolId                            uint64
+	positionId                        uint64
 	owner                             sdk.AccAddress
 	currentTick                       sdk.Int
 	lowerTick                         int64
@@ -106,7 +107,7 @@ var (
 			expectedFeeGrowthOutsideLower: oneEthCoins,
 			expectedFeeGrowthOutsideUpper: oneEthCoins,
 		},
-		"second position: lower tick < upper tick == current tick -> both tick's fee accumulators are updated with one eth": {
+		"second position with the same parameters: lower tick < upper tick == current tick -> both tick's fee accumulators are updated with one eth": {
 			lowerTick:   DefaultLowerTick,
 			upperTick:   DefaultUpperTick,
 			currentTick: sdk.NewInt(DefaultUpperTick),
@@ -220,12 +221,20 @@ func (s *KeeperTestSuite) TestCreatePosition() {
 			expectedLiquidityCreated := tc.liquidityAmount
 			if tc.isNotFirstPositionWithSameAccount {
 				// Since this is a second position with the same parameters,
-				// we expect to create half of the final liquidity amount.
+				// we expect to create half of the final tick liquidity amount.
+				// The two positions are not merged and each receives its own id.
 				expectedLiquidityCreated = tc.liquidityAmount.QuoInt64(2)
 
 				s.SetupPosition(1, s.TestAccs[0], DefaultCoin0, DefaultCoin1, tc.lowerTick, tc.upperTick, tc.frozenUntil)
 			}
 
+			expectedPositionId := uint64(1)
+			if tc.isNotFirstPosition || tc.isNotFirstPositionWithSameAccount {
+				expectedPositionId = 2
+			}
+			userPositionsPrePositionCreation, err := clKeeper.GetUserPositions(s.Ctx, s.TestAccs[0], 0)
+			s.Require().NoError(err)
+
 			// Fund test account and create the desired position
 			s.FundAcc(s.TestAccs[0], sdk.NewCoins(DefaultCoin0, DefaultCoin1))
 
@@ -234,7 +243,7 @@ func (s *KeeperTestSuite) TestCreatePosition() {
 			poolBalancePrePositionCreation := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
 
 			// System under test.
-			asset0, asset1, liquidityCreated, err := clKeeper.CreatePosition(s.Ctx, tc.poolId, s.TestAccs[0], tc.amount0Desired, tc.amount1Desired, tc.amount0Minimum, tc.amount1Minimum, tc.lowerTick, tc.upperTick, tc.frozenUntil)
+			positionId, asset0, asset1, liquidityCreated, err := clKeeper.CreatePosition(s.Ctx, tc.poolId, s.TestAccs[0], tc.amount0Desired, tc.amount1Desired, tc.amount0Minimum, tc.amount1Minimum, tc.lowerTick, tc.upperTick, tc.frozenUntil)
 
 			// Note user and pool account balances to compare after create position is called
 			userBalancePostPositionCreation := s.App.BankKeeper.GetAllBalances(s.Ctx, s.TestAccs[0])
@@ -256,10 +265,10 @@ func (s *KeeperTestSuite) TestCreatePosition() {
 				s.Require().Equal(poolBalancePrePositionCreation.String(), poolBalancePostPositionCreation.String())
 
 				// Redundantly ensure that position was not created
-				position, err := clKeeper.GetPosition(s.Ctx, tc.poolId, s.TestAccs[0], tc.lowerTick, tc.upperTick, tc.frozenUntil)
-				s.Require().Error(err)
-				s.Require().ErrorAs(err, &types.PositionNotFoundError{PoolId: tc.poolId, LowerTick: tc.lowerTick, UpperTick: tc.upperTick})
-				s.Require().Nil(position)
+				s.Require().Equal(uint64(0), positionId)
+				userPositions, err := clKeeper.GetUserPositions(s.Ctx, s.TestAccs[0], 0)
+				s.Require().NoError(err)
+				s.Require().Equal(userPositionsPrePositionCreation, userPositions)
 				return
 			}
 
@@ -268,18 +277,35 @@ func (s *KeeperTestSuite) TestCreatePosition() {
 			s.Require().Equal(tc.amount0Expected.String(), asset0.String())
 			s.Require().Equal(tc.amount1Expected.String(), asset1.String())
 			s.Require().Equal(expectedLiquidityCreated.String(), liquidityCreated.String())
+			s.Require().Equal(expectedPositionId, positionId)
 
 			// Check account balances
 			s.Require().Equal(userBalancePrePositionCreation.Sub(sdk.NewCoins(sdk.NewCoin(ETH, asset0), (sdk.NewCoin(USDC, asset1)))).String(), userBalancePostPositionCreation.String())
 			s.Require().Equal(poolBalancePrePositionCreation.Add(sdk.NewCoin(ETH, asset0), (sdk.NewCoin(USDC, asset1))).String(), poolBalancePostPositionCreation.String())
 
-			hasPosition := clKeeper.HasFullPosition(s.Ctx, tc.poolId, s.TestAccs[0], tc.lowerTick, tc.upperTick, tc.frozenUntil)
+			hasPosition := clKeeper.HasPosition(s.Ctx, positionId)
 			s.Require().True(hasPosition)
 
 			// Check position state
-			s.validatePositionUpdate(s.Ctx, tc.poolId, s.TestAccs[0], tc.lowerTick, tc.upperTick, tc.frozenUntil, tc.liquidityAmount)
+			s.validatePositionUpdate(s.Ctx, positionId, expectedLiquidityCreated)
+
+			s.validatePositionFeeAccUpdate(s.Ctx, tc.poolId, positionId, expectedLiquidityCreated)
 
-			s.validatePositionFeeAccUpdate(s.Ctx, tc.poolId, s.TestAccs[0], tc.lowerTick, tc.upperTick, tc.frozenUntil, tc.liquidityAmount)
+			// Check that the position is indexed by its owner and pool
+			position, err := clKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(s.TestAccs[0].String(), position.Address)
+			s.Require().Equal(tc.poolId, position.PoolId)
+			s.Require().Equal(tc.lowerTick, position.LowerTick)
+			s.Require().Equal(tc.upperTick, position.UpperTick)
+
+			userPositions, err := clKeeper.GetUserPositions(s.Ctx, s.TestAccs[0], tc.poolId)
+			s.Require().NoError(err)
+			s.Require().Contains(userPositions, position)
+
+			poolPositions, err := clKeeper.GetPoolPositions(s.Ctx, tc.poolId)
+			s.Require().NoError(err)
+			s.Require().Contains(poolPositions, position)
 
 			// Check tick state
 			s.validateTickUpdates(s.Ctx, tc.poolId, s.TestAccs[0], tc.lowerTick, tc.upperTick, tc.liquidityAmount, tc.expectedFeeGrowthOutsideLower, tc.expectedFeeGrowthOutsideUpper)
@@ -329,19 +355,19 @@ func (s *KeeperTestSuite) TestWithdrawPosition() {
 			// system under test parameters
 			// for withdrawing a position.
 			sutConfigOverwrite: &lpTest{
-				lowerTick:     -1, // valid tick at which no position exists
-				expectedError: types.PositionNotFoundError{PoolId: 1, LowerTick: -1, UpperTick: 315000, FrozenUntil: time.Time{}},
+				positionId:    2, // position id at which no position exists
+				expectedError: types.PositionNotFoundError{PositionId: 2},
 			},
 		},
-		"error: no position created (position exists but wrong frozenUntil value)": {
+		"error: position is owned by a different address": {
 			// setup parameters for creation a pool and position.
 			setupConfig: baseCase,
 
 			// system under test parameters
 			// for withdrawing a position.
 			sutConfigOverwrite: &lpTest{
-				frozenUntil:   defaultFrozenUntil,
-				expectedError: types.PositionNotFoundError{PoolId: 1, LowerTick: 305450, UpperTick: 315000, FrozenUntil: defaultFrozenUntil},
+				owner:         s.TestAccs[1],
+				expectedError: types.NotPositionOwnerError{PositionId: 1, Address: s.TestAccs[1].String()},
 			},
 		},
 		"error: withdraw liquidity that is still frozen": {
@@ -354,39 +380,6 @@ func (s *KeeperTestSuite) TestWithdrawPosition() {
 				expectedError: types.PositionStillFrozenError{FrozenUntil: defaultFrozenUntil},
 			},
 		},
-		"error: pool id for pool that does not exist": {
-			// setup parameters for creating a pool and position.
-			setupConfig: baseCase,
-
-			// system under test parameters
-			// for withdrawing a position.
-			sutConfigOverwrite: &lpTest{
-				poolId:        2, // does not exist
-				expectedError: types.PoolNotFoundError{PoolId: 2},
-			},
-		},
-		"error: upper tick out of bounds": {
-			// setup parameters for creating a pool and position.
-			setupConfig: baseCase,
-
-			// system under test parameters
-			// for withdrawing a position.
-			sutConfigOverwrite: &lpTest{
-				upperTick:     DefaultMaxTick + 1, // invalid tick
-				expectedError: types.InvalidTickError{Tick: DefaultMaxTick + 1, IsLower: false, MinTick: DefaultMinTick, MaxTick: DefaultMaxTick},
-			},
-		},
-		"error: lower tick out of bounds": {
-			// setup parameters for creating a pool and position.
-			setupConfig: baseCase,
-
-			// system under test parameters
-			// for withdrawing a position.
-			sutConfigOverwrite: &lpTest{
-				lowerTick:     DefaultMinTick - 1, // invalid tick
-				expectedError: types.InvalidTickError{Tick: DefaultMinTick - 1, IsLower: true, MinTick: DefaultMinTick, MaxTick: DefaultMaxTick},
-			},
-		},
 		"error: insufficient liquidity": {
 			// setup parameters for creating a pool and position.
 			setupConfig: baseCase,
@@ -398,18 +391,6 @@ func (s *KeeperTestSuite) TestWithdrawPosition() {
 				expectedError:   types.InsufficientLiquidityError{Actual: baseCase.liquidityAmount.Add(sdk.OneDec()), Available: baseCase.liquidityAmount},
 			},
 		},
-		"error: upper tick is below the lower tick, but both are in bounds": {
-			// setup parameters for creating a pool and position.
-			setupConfig: baseCase,
-
-			// system under test parameters
-			// for withdrawing a position.
-			sutConfigOverwrite: &lpTest{
-				lowerTick:     50,
-				upperTick:     40,
-				expectedError: types.InvalidLowerUpperTickError{LowerTick: 50, UpperTick: 40},
-			},
-		},
 		// TODO: test with custom amounts that potentially lead to truncations.
 	}
 
@@ -434,7 +415,7 @@ func (s *KeeperTestSuite) TestWithdrawPosition() {
 			s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin("eth", sdk.NewInt(10000000000000)), sdk.NewCoin("usdc", sdk.NewInt(1000000000000))))
 
 			// Create a position from the parameters in the test case.
-			_, _, liquidityCreated, err := concentratedLiquidityKeeper.CreatePosition(ctx, config.poolId, owner, config.amount0Desired, config.amount1Desired, sdk.ZeroInt(), sdk.ZeroInt(), config.lowerTick, config.upperTick, config.frozenUntil)
+			positionId, _, _, liquidityCreated, err := concentratedLiquidityKeeper.CreatePosition(ctx, config.poolId, owner, config.amount0Desired, config.amount1Desired, sdk.ZeroInt(), sdk.ZeroInt(), config.lowerTick, config.upperTick, config.frozenUntil)
 			s.Require().NoError(err)
 
 			// Set global fee growth to 1 ETH and charge the fee to the pool.
@@ -462,8 +443,17 @@ func (s *KeeperTestSuite) TestWithdrawPosition() {
 
 			expectedBalanceDelta := expectedFeesClaimed.Add(sdk.NewCoin(ETH, config.amount0Expected.Abs())).Add(sdk.NewCoin(USDC, config.amount1Expected.Abs()))
 
+			sutOwner := owner
+			if config.owner != nil {
+				sutOwner = config.owner
+			}
+			sutPositionId := positionId
+			if config.positionId != 0 {
+				sutPositionId = config.positionId
+			}
+
 			// System under test.
-			amtDenom0, amtDenom1, err := concentratedLiquidityKeeper.WithdrawPosition(ctx, config.poolId, owner, config.lowerTick, config.upperTick, config.frozenUntil, config.liquidityAmount)
+			amtDenom0, amtDenom1, err := concentratedLiquidityKeeper.WithdrawPosition(ctx, sutOwner, sutPositionId, config.liquidityAmount)
 
 			if config.expectedError != nil {
 				s.Require().Error(err)
@@ -486,22 +476,68 @@ func (s *KeeperTestSuite) TestWithdrawPosition() {
 			s.Require().Equal(expectedBalanceDelta.String(), ownerBalancerAfterCollect.Sub(ownerBalancerBeforeCollect).String())
 
 			if expectedRemainingLiquidity.IsZero() {
-				// Check that the position was deleted.
-				position, err := concentratedLiquidityKeeper.GetPosition(ctx, config.poolId, owner, config.lowerTick, config.upperTick, config.frozenUntil)
+				// Check that the position was deleted, including from the owner and pool indexes.
+				_, err := concentratedLiquidityKeeper.GetPosition(ctx, positionId)
 				s.Require().Error(err)
-				s.Require().ErrorAs(err, &types.PositionNotFoundError{PoolId: config.poolId, LowerTick: config.lowerTick, UpperTick: config.upperTick})
-				s.Require().Nil(position)
+				s.Require().ErrorIs(err, types.PositionNotFoundError{PositionId: positionId})
+
+				userPositions, err := concentratedLiquidityKeeper.GetUserPositions(ctx, owner, 0)
+				s.Require().NoError(err)
+				s.Require().Empty(userPositions)
+
+				poolPositions, err := concentratedLiquidityKeeper.GetPoolPositions(ctx, config.poolId)
+				s.Require().NoError(err)
+				s.Require().Empty(poolPositions)
 			} else {
 				// Check that the position was updated.
-				s.validatePositionUpdate(ctx, config.poolId, owner, config.lowerTick, config.upperTick, config.frozenUntil, expectedRemainingLiquidity)
+				s.validatePositionUpdate(ctx, positionId, expectedRemainingLiquidity)
 			}
 
 			// Check tick state.
-			s.validateTickUpdates(ctx, config.poolId, owner, config.lowerTick, config.upperTick, expectedRemainingLiquidity, config.expectedFeeGrowthOutsideLower, config.expectedFeeGrowthOutsideUpper)
+			// The ticks were initialized before any fees were charged and have not been crossed since,
+			// so updating them does not change their fee growth outside.
+			s.validateTickUpdates(ctx, config.poolId, owner, config.lowerTick, config.upperTick, expectedRemainingLiquidity, cl.EmptyCoins, cl.EmptyCoins)
 		})
 	}
 }
 
+func (s *KeeperTestSuite) TestForceWithdrawPosition() {
+	s.SetupTest()
+	owner := s.TestAccs[0]
+	frozenUntil := s.Ctx.BlockTime().Add(DefaultFreezeDuration)
+	s.PrepareConcentratedPool()
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(10000000000000)), sdk.NewCoin(USDC, sdk.NewInt(1000000000000))))
+
+	positionId, _, _, liquidityCreated, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, baseCase.poolId, owner, baseCase.amount0Desired, baseCase.amount1Desired, sdk.ZeroInt(), sdk.ZeroInt(), baseCase.lowerTick, baseCase.upperTick, frozenUntil)
+	s.Require().NoError(err)
+	liquidityToWithdraw := liquidityCreated.QuoRoundUp(sdk.NewDec(2))
+
+	// the frozen position cannot be withdrawn from.
+	_, _, err = s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, owner, positionId, liquidityToWithdraw)
+	s.Require().ErrorIs(err, types.PositionStillFrozenError{FrozenUntil: frozenUntil})
+
+	// it can still only be force withdrawn to its owner.
+	_, _, err = s.App.ConcentratedLiquidityKeeper.ForceWithdrawPosition(s.Ctx, s.TestAccs[1], positionId, liquidityToWithdraw)
+	s.Require().ErrorIs(err, types.NotPositionOwnerError{PositionId: positionId, Address: s.TestAccs[1].String()})
+
+	ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+	// System under test.
+	amtDenom0, amtDenom1, err := s.App.ConcentratedLiquidityKeeper.ForceWithdrawPosition(s.Ctx, owner, positionId, liquidityToWithdraw)
+	s.Require().NoError(err)
+	s.Require().Equal(baseCase.amount0Expected.QuoRaw(2).String(), amtDenom0.String())
+	s.Require().Equal(baseCase.amount1Expected.QuoRaw(2).String(), amtDenom1.String())
+
+	ownerBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(ETH, amtDenom0), sdk.NewCoin(USDC, amtDenom1)).String(), ownerBalanceAfter.Sub(ownerBalanceBefore).String())
+
+	// the position stays frozen.
+	position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(liquidityCreated.Sub(liquidityToWithdraw), position.Liquidity)
+	s.Require().Equal(frozenUntil, position.FrozenUntil)
+}
+
 // mergeConfigs merges every desired non-zero field from overwrite
 // into dst. dst is mutated due to being a pointer.
 func mergeConfigs(dst *lpTest, overwrite *lpTest) {
@@ -509,6 +545,12 @@ func mergeConfigs(dst *lpTest, overwrite *lpTest) {
 		if overwrite.poolId != 0 {
 			dst.poolId = overwrite.poolId
 		}
+		if overwrite.positionId != 0 {
+			dst.positionId = overwrite.positionId
+		}
+		if overwrite.owner != nil {
+			dst.owner = overwrite.owner
+		}
 		if overwrite.lowerTick != 0 {
 			dst.lowerTick = overwrite.lowerTick
 		}
@@ -733,6 +775,7 @@ func (s *KeeperTestSuite) TestUpdatePosition() {
 	defaultFrozenUntil := s.Ctx.BlockTime().Add(DefaultFreezeDuration)
 	type updatePositionTest struct {
 		poolId                    uint64
+		positionId                uint64
 		ownerIndex                int
 		lowerTick                 int64
 		upperTick                 int64
@@ -749,6 +792,7 @@ func (s *KeeperTestSuite) TestUpdatePosition() {
 	tests := map[string]updatePositionTest{
 		"update existing position with positive amount": {
 			poolId:                    1,
+			positionId:                1,
 			ownerIndex:                0,
 			lowerTick:                 DefaultLowerTick,
 			upperTick:                 DefaultUpperTick,
@@ -763,6 +807,7 @@ func (s *KeeperTestSuite) TestUpdatePosition() {
 		},
 		"update existing position with negative amount (equal amount as liquidity provided)": {
 			poolId:                    1,
+			positionId:                1,
 			ownerIndex:                0,
 			lowerTick:                 DefaultLowerTick,
 			upperTick:                 DefaultUpperTick,
@@ -777,6 +822,7 @@ func (s *KeeperTestSuite) TestUpdatePosition() {
 		},
 		"error - update existing position with negative amount (more than liquidity provided)": {
 			poolId:         1,
+			positionId:     1,
 			ownerIndex:     0,
 			lowerTick:      DefaultLowerTick,
 			upperTick:      DefaultUpperTick,
@@ -784,8 +830,9 @@ func (s *KeeperTestSuite) TestUpdatePosition() {
 			liquidityDelta: DefaultLiquidityAmt.Neg().Mul(sdk.NewDec(2)),
 			expectedError:  true,
 		},
-		"try updating with ticks outside existing position's tick range - error because fee accumulator is uninitialized": {
+		"new position id with ticks outside existing position's tick range - error because fee accumulator is uninitialized": {
 			poolId:         1,
+			positionId:     2,
 			ownerIndex:     0,
 			lowerTick:      DefaultUpperTick + 1,
 			upperTick:      DefaultUpperTick + 100,
@@ -795,6 +842,7 @@ func (s *KeeperTestSuite) TestUpdatePosition() {
 		},
 		"error: invalid pool id": {
 			poolId:         2,
+			positionId:     1,
 			ownerIndex:     0,
 			lowerTick:      DefaultLowerTick,
 			upperTick:      DefaultUpperTick,
@@ -804,7 +852,8 @@ func (s *KeeperTestSuite) TestUpdatePosition() {
 		},
 		"new position when calling update position - error because fee accumulator is not initialized": {
 			poolId:         1,
-			ownerIndex:     1, // using a different address makes this a new position
+			positionId:     2, // using a different position id makes this a new position
+			ownerIndex:     1,
 			lowerTick:      DefaultLowerTick,
 			upperTick:      DefaultUpperTick,
 			frozenUntil:    defaultFrozenUntil,
@@ -823,7 +872,7 @@ func (s *KeeperTestSuite) TestUpdatePosition() {
 			// create position
 			// Fund test account and create the desired position
 			s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewCoin(ETH, DefaultAmt0), sdk.NewCoin(USDC, DefaultAmt1)))
-			_, _, _, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(
+			_, _, _, _, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(
 				s.Ctx,
 				1,
 				s.TestAccs[0],
@@ -843,6 +892,7 @@ func (s *KeeperTestSuite) TestUpdatePosition() {
 				tc.upperTick,
 				tc.liquidityDelta,
 				tc.frozenUntil,
+				tc.positionId,
 			)
 
 			if tc.expectedError {
@@ -855,7 +905,7 @@ func (s *KeeperTestSuite) TestUpdatePosition() {
 				s.Require().Equal(actualAmount1, tc.amount1Expected)
 
 				// validate if position has been properly updated
-				s.validatePositionUpdate(s.Ctx, tc.poolId, s.TestAccs[tc.ownerIndex], tc.lowerTick, tc.upperTick, tc.frozenUntil, tc.expectedPositionLiquidity)
+				s.validatePositionUpdate(s.Ctx, tc.positionId, tc.expectedPositionLiquidity)
 				s.validateTickUpdates(s.Ctx, tc.poolId, s.TestAccs[tc.ownerIndex], tc.lowerTick, tc.upperTick, tc.expectedTickLiquidity, cl.EmptyCoins, cl.EmptyCoins)
 
 				// validate if pool liquidity has been updated properly
@@ -967,9 +1017,9 @@ func (s *KeeperTestSuite) TestInverseRelation_CreatePosition_WithdrawPosition()
 			poolBalancePrePositionCreation := s.App.BankKeeper.GetAllBalances(s.Ctx, poolBefore.GetAddress())
 
 			// System under test.
-			amtDenom0CreatePosition, amtDenom1CreatePosition, liquidityCreated, err := clKeeper.CreatePosition(s.Ctx, tc.poolId, s.TestAccs[0], tc.amount0Desired, tc.amount1Desired, tc.amount0Minimum, tc.amount1Minimum, tc.lowerTick, tc.upperTick, tc.frozenUntil)
+			positionId, amtDenom0CreatePosition, amtDenom1CreatePosition, liquidityCreated, err := clKeeper.CreatePosition(s.Ctx, tc.poolId, s.TestAccs[0], tc.amount0Desired, tc.amount1Desired, tc.amount0Minimum, tc.amount1Minimum, tc.lowerTick, tc.upperTick, tc.frozenUntil)
 			s.Require().NoError(err)
-			amtDenom0WithdrawPosition, amtDenom1WithdrawPosition, err := clKeeper.WithdrawPosition(s.Ctx, tc.poolId, s.TestAccs[0], tc.lowerTick, tc.upperTick, tc.frozenUntil, liquidityCreated)
+			amtDenom0WithdrawPosition, amtDenom1WithdrawPosition, err := clKeeper.WithdrawPosition(s.Ctx, s.TestAccs[0], positionId, liquidityCreated)
 			s.Require().NoError(err)
 
 			// INVARIANTS
@@ -985,10 +1035,9 @@ func (s *KeeperTestSuite) TestInverseRelation_CreatePosition_WithdrawPosition()
 			s.Require().Equal(poolBalancePrePositionCreation, poolBalancePostPositionCreation)
 
 			// 3. Check that position was deleted
-			position, err := clKeeper.GetPosition(s.Ctx, tc.poolId, s.TestAccs[0], tc.lowerTick, tc.upperTick, tc.frozenUntil)
+			_, err = clKeeper.GetPosition(s.Ctx, positionId)
 			s.Require().Error(err)
-			s.Require().ErrorAs(err, &types.PositionNotFoundError{PoolId: tc.poolId, LowerTick: tc.lowerTick, UpperTick: tc.upperTick})
-			s.Require().Nil(position)
+			s.Require().ErrorIs(err, types.PositionNotFoundError{PositionId: positionId})
 
 			// 4. Check that pool has come back to original state
 			poolAfter, err := clKeeper.GetPool(s.Ctx, poolID)
@@ -998,3 +1047,300 @@ func (s *KeeperTestSuite) TestInverseRelation_CreatePosition_WithdrawPosition()
 		})
 	}
 }
+
+// TestAddToPosition tests that liquidity is added to an existing position at the pool's current
+// ratio, and that the position keeps its id and the fees it accrued prior to the addition.
+func (s *KeeperTestSuite) TestCalcFullRangePosition() {
+	tests := map[string]struct {
+		existingPosition bool
+		amount0Desired   sdk.Int
+		amount1Desired   sdk.Int
+		expectedErr      bool
+	}{
+		"pool without positions": {
+			amount0Desired: DefaultAmt0,
+			amount1Desired: DefaultAmt1,
+		},
+		"pool with an existing position, amounts not proportional to the pool price": {
+			existingPosition: true,
+			amount0Desired:   DefaultAmt0,
+			amount1Desired:   DefaultAmt1.MulRaw(2),
+		},
+		"pool without positions, zero amount1": {
+			amount0Desired: DefaultAmt0,
+			amount1Desired: sdk.ZeroInt(),
+			expectedErr:    true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			if tc.existingPosition {
+				s.SetupDefaultPosition(pool.GetId())
+			}
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+
+			amount0, amount1, liquidity, err := clKeeper.CalcFullRangePosition(s.Ctx, pool.GetId(), tc.amount0Desired, tc.amount1Desired)
+			if tc.expectedErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the calculation does not change the pool.
+			poolAfterCalc, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			if !tc.existingPosition {
+				s.Require().Equal(sdk.ZeroDec(), poolAfterCalc.GetCurrentSqrtPrice())
+			}
+
+			// the calculation matches the full range position that is created with the same amounts.
+			s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(ETH, tc.amount0Desired), sdk.NewCoin(USDC, tc.amount1Desired)))
+			_, actualAmount0, actualAmount1, actualLiquidity, err := clKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], tc.amount0Desired, tc.amount1Desired, sdk.ZeroInt(), sdk.ZeroInt(), DefaultMinTick, DefaultMaxTick, time.Time{})
+			s.Require().NoError(err)
+			s.Require().Equal(actualAmount0, amount0)
+			s.Require().Equal(actualAmount1, amount1)
+			s.Require().Equal(actualLiquidity, liquidity)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAddToPosition() {
+	tests := map[string]struct {
+		senderIndex    int
+		amount0Minimum sdk.Int
+		// timeElapsed is the time elapsed between the position's creation and the addition.
+		timeElapsed time.Duration
+		// frozenUntilOffset and expectedFrozenUntilOffset are relative to the position's creation.
+		frozenUntilOffset         time.Duration
+		expectedFrozenUntilOffset time.Duration
+		expectedError             error
+	}{
+		"add without extending the freeze": {
+			expectedFrozenUntilOffset: DefaultFreezeDuration,
+		},
+		"add extending the freeze": {
+			frozenUntilOffset:         DefaultFreezeDuration * 2,
+			expectedFrozenUntilOffset: DefaultFreezeDuration * 2,
+		},
+		"add after time elapsed, extending the freeze to cover the uptimes the position earns incentives for": {
+			timeElapsed:               time.Hour,
+			frozenUntilOffset:         DefaultFreezeDuration + time.Hour,
+			expectedFrozenUntilOffset: DefaultFreezeDuration + time.Hour,
+		},
+		"error: freeze no longer covers the uptimes the position earns incentives for": {
+			timeElapsed:   time.Hour,
+			expectedError: types.FrozenUntilTooShortForUptimeError{},
+		},
+		"error: amount0 used is less than the minimum": {
+			amount0Minimum: DefaultAmt0.Add(sdk.OneInt()),
+			expectedError:  types.InsufficientLiquidityCreatedError{},
+		},
+		"error: sender is not the position owner": {
+			senderIndex:   1,
+			expectedError: types.NotPositionOwnerError{},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			owner := s.TestAccs[0]
+			creationTime := s.Ctx.BlockTime()
+
+			pool := s.PrepareCustomConcentratedPool(owner, ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, sdk.NewDecWithPrec(1, 2))
+			positionBefore := s.SetupDefaultPosition(pool.GetId())
+
+			// Swap to accrue fees to the position prior to the addition.
+			swapper := s.TestAccs[2]
+			tokenIn := sdk.NewCoin(USDC, sdk.NewInt(10000000))
+			s.FundAcc(swapper, sdk.NewCoins(tokenIn))
+			poolI, err := clKeeper.GetPool(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			_, err = clKeeper.SwapExactAmountIn(s.Ctx, swapper, poolI, tokenIn, ETH, sdk.OneInt(), poolI.GetSwapFee(s.Ctx))
+			s.Require().NoError(err)
+
+			s.Ctx = s.Ctx.WithBlockTime(creationTime.Add(tc.timeElapsed))
+
+			claimableFeesBefore, err := clKeeper.GetClaimableFees(s.Ctx, positionBefore.PositionId)
+			s.Require().NoError(err)
+			s.Require().False(claimableFeesBefore.IsZero())
+
+			sender := s.TestAccs[tc.senderIndex]
+			s.FundAcc(sender, sdk.NewCoins(DefaultCoin0, DefaultCoin1))
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+			amount0Minimum := sdk.ZeroInt()
+			if !tc.amount0Minimum.IsNil() {
+				amount0Minimum = tc.amount0Minimum
+			}
+
+			// System under test
+			actualAmount0, actualAmount1, liquidityDelta, err := clKeeper.AddToPosition(s.Ctx, sender, positionBefore.PositionId, DefaultAmt0, DefaultAmt1, amount0Minimum, sdk.ZeroInt(), creationTime.Add(tc.frozenUntilOffset))
+
+			positionAfter, getErr := clKeeper.GetPosition(s.Ctx, positionBefore.PositionId)
+			s.Require().NoError(getErr)
+
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().IsType(tc.expectedError, err)
+
+				// The position is left unchanged.
+				s.Require().Equal(positionBefore, positionAfter)
+				s.Require().Equal(balanceBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().True(liquidityDelta.IsPositive())
+			s.Require().True(actualAmount0.LTE(DefaultAmt0))
+			s.Require().True(actualAmount1.LTE(DefaultAmt1))
+
+			// The position keeps its id and range while its liquidity and freeze are updated.
+			s.Require().Equal(positionBefore.PositionId, positionAfter.PositionId)
+			s.Require().Equal(positionBefore.LowerTick, positionAfter.LowerTick)
+			s.Require().Equal(positionBefore.UpperTick, positionAfter.UpperTick)
+			s.Require().Equal(positionBefore.Liquidity.Add(liquidityDelta), positionAfter.Liquidity)
+			s.Require().Equal(creationTime.Add(tc.expectedFrozenUntilOffset), positionAfter.FrozenUntil)
+
+			// The fees accrued prior to the addition are still claimable.
+			claimableFeesAfter, err := clKeeper.GetClaimableFees(s.Ctx, positionBefore.PositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(claimableFeesBefore.String(), claimableFeesAfter.String())
+
+			expectedBalance := balanceBefore.Sub(sdk.NewCoins(sdk.NewCoin(ETH, actualAmount0), sdk.NewCoin(USDC, actualAmount1)))
+			s.Require().Equal(expectedBalance.String(), s.App.BankKeeper.GetAllBalances(s.Ctx, sender).String())
+		})
+	}
+}
+
+// TestCompoundFees tests that the ratio-matched portion of the fees collected from a position
+// is added back to the position, and that the remainder is left with the owner.
+func (s *KeeperTestSuite) TestCompoundFees() {
+	tests := map[string]struct {
+		senderIndex int
+		// swapsIn are the tokens swapped through the pool to accrue fees to the position.
+		swapsIn []sdk.Coin
+		// compoundAfterFreeze compounds the fees only once the position's freeze has ended.
+		compoundAfterFreeze bool
+
+		expectCompounded bool
+		expectedError    error
+	}{
+		"no fees accrued": {},
+		"fees accrued in a single token, none can be compounded in range": {
+			swapsIn: []sdk.Coin{sdk.NewCoin(USDC, sdk.NewInt(10000000))},
+		},
+		"fees accrued in both tokens": {
+			swapsIn:          []sdk.Coin{sdk.NewCoin(USDC, sdk.NewInt(10000000)), sdk.NewCoin(ETH, sdk.NewInt(2000))},
+			expectCompounded: true,
+		},
+		"fees accrued in both tokens, compounded after the freeze ended": {
+			swapsIn:             []sdk.Coin{sdk.NewCoin(USDC, sdk.NewInt(10000000)), sdk.NewCoin(ETH, sdk.NewInt(2000))},
+			compoundAfterFreeze: true,
+			expectCompounded:    true,
+		},
+		"error: sender is not the position owner": {
+			senderIndex:   1,
+			expectedError: types.NotPositionOwnerError{},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			owner := s.TestAccs[0]
+
+			pool := s.PrepareCustomConcentratedPool(owner, ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, sdk.NewDecWithPrec(1, 2))
+			positionBefore := s.SetupDefaultPosition(pool.GetId())
+
+			swapper := s.TestAccs[2]
+			for _, tokenIn := range tc.swapsIn {
+				s.FundAcc(swapper, sdk.NewCoins(tokenIn))
+				poolI, err := clKeeper.GetPool(s.Ctx, pool.GetId())
+				s.Require().NoError(err)
+				tokenOutDenom := ETH
+				if tokenIn.Denom == ETH {
+					tokenOutDenom = USDC
+				}
+				_, err = clKeeper.SwapExactAmountIn(s.Ctx, swapper, poolI, tokenIn, tokenOutDenom, sdk.OneInt(), poolI.GetSwapFee(s.Ctx))
+				s.Require().NoError(err)
+			}
+
+			if tc.compoundAfterFreeze {
+				s.Ctx = s.Ctx.WithBlockTime(positionBefore.FrozenUntil.Add(time.Minute))
+			}
+
+			claimableFees, err := clKeeper.GetClaimableFees(s.Ctx, positionBefore.PositionId)
+			s.Require().NoError(err)
+
+			uptimeAccumulators, err := clKeeper.GetUptimeAccumulators(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			positionName := string(types.KeyPositionId(positionBefore.PositionId))
+			hasUptimeRecord := make([]bool, len(uptimeAccumulators))
+			for uptimeIndex, uptimeAccumulator := range uptimeAccumulators {
+				hasUptimeRecord[uptimeIndex], err = uptimeAccumulator.HasPosition(positionName)
+				s.Require().NoError(err)
+			}
+
+			sender := s.TestAccs[tc.senderIndex]
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+
+			// System under test
+			compoundedAmount0, compoundedAmount1, liquidityDelta, remainingFees, err := clKeeper.CompoundFees(s.Ctx, sender, positionBefore.PositionId)
+
+			positionAfter, getErr := clKeeper.GetPosition(s.Ctx, positionBefore.PositionId)
+			s.Require().NoError(getErr)
+
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().IsType(tc.expectedError, err)
+				s.Require().Equal(positionBefore, positionAfter)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			// The compounded amounts and the remaining fees add up to the fees that were claimable.
+			compounded := sdk.NewCoins(sdk.NewCoin(ETH, compoundedAmount0), sdk.NewCoin(USDC, compoundedAmount1))
+			s.Require().Equal(claimableFees.String(), compounded.Add(remainingFees...).String())
+
+			// Only the remaining fees are left with the owner.
+			s.Require().Equal(balanceBefore.Add(remainingFees...).String(), s.App.BankKeeper.GetAllBalances(s.Ctx, sender).String())
+
+			s.Require().Equal(positionBefore.PositionId, positionAfter.PositionId)
+			s.Require().Equal(positionBefore.FrozenUntil, positionAfter.FrozenUntil)
+			s.Require().Equal(positionBefore.Liquidity.Add(liquidityDelta), positionAfter.Liquidity)
+			s.Require().Equal(tc.expectCompounded, liquidityDelta.IsPositive())
+			s.Require().Equal(tc.expectCompounded, compoundedAmount0.IsPositive() && compoundedAmount1.IsPositive())
+			if !tc.expectCompounded {
+				s.Require().Equal(claimableFees.String(), remainingFees.String())
+			}
+
+			// Nothing is left to claim after compounding.
+			claimableFees, err = clKeeper.GetClaimableFees(s.Ctx, positionBefore.PositionId)
+			s.Require().NoError(err)
+			s.Require().True(claimableFees.IsZero())
+
+			// The position keeps its uptime records, which now hold the compounded liquidity.
+			uptimeAccumulators, err = clKeeper.GetUptimeAccumulators(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			for uptimeIndex, uptimeAccumulator := range uptimeAccumulators {
+				hasPosition, err := uptimeAccumulator.HasPosition(positionName)
+				s.Require().NoError(err)
+				s.Require().Equal(hasUptimeRecord[uptimeIndex], hasPosition)
+				if !hasPosition {
+					continue
+				}
+				recordSize, err := uptimeAccumulator.GetPositionSize(positionName)
+				s.Require().NoError(err)
+				s.Require().Equal(positionAfter.Liquidity.String(), recordSize.String())
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
 )
 
@@ -23,6 +24,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&Pool{},
 	)
 
+	registry.RegisterImplementations(
+		(*types.ConcentratedPoolExtension)(nil),
+		&Pool{},
+	)
+
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgCreateConcentratedPool{},
@@ -3,8 +3,10 @@ package model
 import (
 	"encoding/json"
 	fmt "fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 
 	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/internal/math"
 	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
@@ -62,6 +64,13 @@ func (p Pool) GetAddress() sdk.AccAddress {
 	return addr
 }
 
+// GetIncentivesAddress returns the address that escrows the uptime incentives of the pool.
+// Incentives are kept apart from the pool address so that they never mix with the pool's reserves.
+func (p Pool) GetIncentivesAddress() sdk.AccAddress {
+	key := append([]byte("incentives"), sdk.Uint64ToBigEndian(p.Id)...)
+	return address.Module(types.ModuleName, key)
+}
+
 // GetId returns the id of the concentrated liquidity pool
 func (p Pool) GetId() uint64 {
 	return p.Id
@@ -150,6 +159,25 @@ func (p Pool) GetLiquidity() sdk.Dec {
 	return p.Liquidity
 }
 
+// GetUptimeLiquidity returns the active liquidity qualifying for each supported uptime,
+// in the same order as types.SupportedUptimes.
+func (p Pool) GetUptimeLiquidity() []sdk.Dec {
+	uptimeLiquidity := make([]sdk.Dec, len(types.SupportedUptimes))
+	for uptimeIndex := range uptimeLiquidity {
+		if uptimeIndex < len(p.UptimeLiquidity) && !p.UptimeLiquidity[uptimeIndex].IsNil() {
+			uptimeLiquidity[uptimeIndex] = p.UptimeLiquidity[uptimeIndex]
+		} else {
+			uptimeLiquidity[uptimeIndex] = sdk.ZeroDec()
+		}
+	}
+	return uptimeLiquidity
+}
+
+// GetLastLiquidityUpdate returns the last time the pool's uptime accumulators were updated
+func (p Pool) GetLastLiquidityUpdate() time.Time {
+	return p.LastLiquidityUpdate
+}
+
 func (p Pool) GetType() poolmanagertypes.PoolType {
 	return poolmanagertypes.Concentrated
 }
@@ -169,6 +197,21 @@ func (p *Pool) SetCurrentTick(newTick sdk.Int) {
 	p.CurrentTick = newTick
 }
 
+// SetSwapFee updates the swap fee of the pool.
+func (p *Pool) SetSwapFee(newSwapFee sdk.Dec) {
+	p.SwapFee = newSwapFee
+}
+
+// SetTickSpacing updates the tick spacing of the pool.
+func (p *Pool) SetTickSpacing(newTickSpacing uint64) {
+	p.TickSpacing = newTickSpacing
+}
+
+// SetLastLiquidityUpdate updates the pool's last liquidity update time.
+func (p *Pool) SetLastLiquidityUpdate(newTime time.Time) {
+	p.LastLiquidityUpdate = newTime
+}
+
 // updateLiquidityIfActivePosition updates the pool's liquidity if the position is active.
 // Returns true if updated, false otherwise.
 // TODO: add tests.
@@ -180,6 +223,26 @@ func (p *Pool) UpdateLiquidityIfActivePosition(ctx sdk.Context, lowerTick, upper
 	return false
 }
 
+// AddUptimeLiquidity adds the given deltas to the active liquidity qualifying for each supported uptime.
+// Note that this method is mutative.
+func (p *Pool) AddUptimeLiquidity(uptimeLiquidityDeltas []sdk.Dec) {
+	uptimeLiquidity := p.GetUptimeLiquidity()
+	for uptimeIndex, liquidityDelta := range uptimeLiquidityDeltas {
+		uptimeLiquidity[uptimeIndex] = uptimeLiquidity[uptimeIndex].Add(liquidityDelta)
+	}
+	p.UptimeLiquidity = uptimeLiquidity
+}
+
+// UpdateUptimeLiquidityIfActivePosition adds the given deltas to the active liquidity qualifying for each supported uptime
+// if the position is active. Returns true if updated, false otherwise.
+func (p *Pool) UpdateUptimeLiquidityIfActivePosition(ctx sdk.Context, lowerTick, upperTick int64, uptimeLiquidityDeltas []sdk.Dec) bool {
+	if p.isCurrentTickInRange(lowerTick, upperTick) {
+		p.AddUptimeLiquidity(uptimeLiquidityDeltas)
+		return true
+	}
+	return false
+}
+
 // calcActualAmounts calculates and returns actual amounts based on where the current tick is located relative to position's
 // lower and upper ticks.
 // There are 3 possible cases:
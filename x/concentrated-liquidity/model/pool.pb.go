@@ -14,15 +14,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -45,6 +49,15 @@ type Pool struct {
 	PrecisionFactorAtPriceOne github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=precision_factor_at_price_one,json=precisionFactorAtPriceOne,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"precision_factor_at_price_one" yaml:"precision_factor_at_price_one"`
 	// swap_fee is the ratio that is charged on the amount of token in.
 	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	// last_liquidity_update is the last time either the pool liquidity or the
+	// active tick changed. It is used to determine how much time has elapsed
+	// when growing the uptime accumulators.
+	LastLiquidityUpdate time.Time `protobuf:"bytes,11,opt,name=last_liquidity_update,json=lastLiquidityUpdate,proto3,stdtime" json:"last_liquidity_update" yaml:"last_liquidity_update"`
+	// uptime_liquidity is the active liquidity that qualifies for each supported
+	// uptime, in the same order as types.SupportedUptimes. That is the liquidity
+	// of the in-range positions that have a record in the respective uptime
+	// accumulator. Uptime incentives are emitted per unit of this liquidity.
+	UptimeLiquidity []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,rep,name=uptime_liquidity,json=uptimeLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uptime_liquidity" yaml:"uptime_liquidity"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -88,40 +101,47 @@ func init() {
 }
 
 var fileDescriptor_3526ea5373d96c9a = []byte{
-	// 522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0xb5, 0x43, 0xfe, 0x34, 0xd7, 0xaa, 0xb4, 0x07, 0x82, 0x6b, 0x25, 0xec, 0xca, 0x02, 0x54,
-	0x24, 0x12, 0x13, 0xc1, 0x94, 0xad, 0x01, 0x2a, 0x75, 0xa2, 0x72, 0x11, 0x03, 0xaa, 0x64, 0x9c,
-	0xf3, 0x35, 0x3d, 0xc5, 0xf1, 0x39, 0x77, 0x97, 0x42, 0xbe, 0x01, 0x23, 0x63, 0xc7, 0x7e, 0x08,
-	0x3e, 0x44, 0xc5, 0xd4, 0x11, 0x31, 0x58, 0x28, 0xf9, 0x06, 0x59, 0x59, 0x90, 0x7d, 0x17, 0xd7,
-	0x0b, 0x48, 0x99, 0x7c, 0xef, 0xfd, 0x7e, 0xf7, 0xde, 0xb3, 0x7f, 0xfe, 0x81, 0x67, 0x4c, 0x8c,
-	0x98, 0xa0, 0xc2, 0xc5, 0x2c, 0xc6, 0x24, 0x96, 0x3c, 0x90, 0x24, 0x6c, 0x45, 0x74, 0x3c, 0xa1,
-	0x21, 0x95, 0x53, 0x37, 0x61, 0x2c, 0x6a, 0x27, 0x9c, 0x49, 0x06, 0x9f, 0xe8, 0xd6, 0x76, 0xb9,
-	0xb5, 0xe8, 0x6c, 0x5f, 0x74, 0xfa, 0x44, 0x06, 0x9d, 0xdd, 0x1d, 0x9c, 0xf7, 0xf9, 0xf9, 0x25,
-	0x57, 0x01, 0xa5, 0xb0, 0x7b, 0x7f, 0xc0, 0x06, 0x4c, 0xf1, 0xd9, 0x49, 0xb1, 0xce, 0x9f, 0x1a,
-	0xa8, 0x1e, 0x33, 0x16, 0xc1, 0xe7, 0xa0, 0x11, 0x84, 0x21, 0x27, 0x42, 0x20, 0x73, 0xcf, 0xdc,
-	0x6f, 0xf6, 0xe0, 0x22, 0xb5, 0x37, 0xa7, 0xc1, 0x28, 0xea, 0x3a, 0xba, 0xe0, 0x78, 0xcb, 0x16,
-	0xb8, 0x09, 0x2a, 0x34, 0x44, 0x95, 0x3d, 0x73, 0xbf, 0xea, 0x55, 0x68, 0x08, 0x3f, 0x81, 0x66,
-	0x11, 0x06, 0xdd, 0xc9, 0xef, 0xf7, 0xae, 0x53, 0xdb, 0xf8, 0x95, 0xda, 0x4f, 0x07, 0x54, 0x9e,
-	0x4f, 0xfa, 0x6d, 0xcc, 0x46, 0x3a, 0x90, 0x7e, 0xb4, 0x44, 0x38, 0x74, 0xe5, 0x34, 0x21, 0xa2,
-	0xfd, 0x86, 0xe0, 0x45, 0x6a, 0x6f, 0x29, 0xb7, 0x42, 0xc8, 0xf1, 0x6e, 0x45, 0xe1, 0x03, 0x50,
-	0x97, 0x6c, 0x48, 0xe2, 0x17, 0xa8, 0x9a, 0xc9, 0x7b, 0x1a, 0x15, 0x7c, 0x07, 0xd5, 0x4a, 0x7c,
-	0x07, 0x8e, 0x01, 0xc4, 0x13, 0xce, 0x49, 0x2c, 0x7d, 0x31, 0xe6, 0xd2, 0x4f, 0x38, 0xc5, 0x04,
-	0xd5, 0xf3, 0x68, 0xaf, 0x57, 0x8e, 0xb6, 0xad, 0xa2, 0x89, 0x84, 0x69, 0x25, 0xc7, 0xdb, 0xd2,
-	0xf2, 0x27, 0x63, 0x2e, 0x8f, 0x33, 0x0a, 0x9e, 0x83, 0x8d, 0xa5, 0xa5, 0xa4, 0x78, 0x88, 0x1a,
-	0xb9, 0xd9, 0xdb, 0x15, 0xcc, 0x8e, 0x62, 0xb9, 0x48, 0xed, 0x7b, 0xca, 0xac, 0xac, 0xe5, 0x78,
-	0xeb, 0x1a, 0xbe, 0xa7, 0x78, 0x08, 0xbb, 0x60, 0x23, 0x63, 0x7d, 0x91, 0x04, 0x98, 0xc6, 0x03,
-	0xb4, 0x96, 0x0d, 0xa2, 0xf7, 0xf0, 0xf6, 0x6e, 0xb9, 0xea, 0x78, 0xeb, 0x19, 0x3c, 0x51, 0x08,
-	0x5e, 0x9a, 0xe0, 0x51, 0xc2, 0x09, 0xa6, 0x82, 0xb2, 0xd8, 0x3f, 0x0b, 0xb0, 0x64, 0xdc, 0x0f,
-	0xf4, 0x6b, 0xf9, 0x2c, 0x26, 0xa8, 0x99, 0xe7, 0xfe, 0xb0, 0x72, 0xee, 0xc7, 0xca, 0xfb, 0xbf,
-	0xe2, 0x8e, 0xb7, 0x53, 0xd4, 0x0f, 0xf3, 0xf2, 0x81, 0xfa, 0x7a, 0xef, 0x62, 0x02, 0x4f, 0xc1,
-	0x9a, 0xf8, 0x1c, 0x24, 0xfe, 0x19, 0x21, 0x08, 0xe4, 0x21, 0x0e, 0x56, 0x9e, 0xd4, 0x5d, 0x3d,
-	0x29, 0xad, 0xe3, 0x78, 0x8d, 0xec, 0x78, 0x48, 0x48, 0x77, 0xfb, 0xeb, 0x95, 0x6d, 0x5c, 0x5e,
-	0xd9, 0xc6, 0x8f, 0xef, 0xad, 0x5a, 0xf6, 0xcf, 0x1f, 0xf5, 0x4e, 0xaf, 0x67, 0x96, 0x79, 0x33,
-	0xb3, 0xcc, 0xdf, 0x33, 0xcb, 0xfc, 0x36, 0xb7, 0x8c, 0x9b, 0xb9, 0x65, 0xfc, 0x9c, 0x5b, 0xc6,
-	0xc7, 0x5e, 0xc9, 0x50, 0xaf, 0x5e, 0x2b, 0x0a, 0xfa, 0x62, 0x09, 0xdc, 0x8b, 0xce, 0x2b, 0xf7,
-	0xcb, 0xbf, 0x16, 0x77, 0xc4, 0x42, 0x12, 0xf5, 0xeb, 0xf9, 0x8a, 0xbd, 0xfc, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0xaf, 0x6b, 0xaf, 0x25, 0xe7, 0x03, 0x00, 0x00,
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0xfb, 0x97, 0x66, 0x52, 0xf5, 0x67, 0xfa, 0x7d, 0x74, 0x5a, 0x41, 0x1c, 0x59, 0x80,
+	0x82, 0x44, 0x6c, 0x02, 0xac, 0xba, 0xab, 0x81, 0x4a, 0x95, 0x90, 0xa8, 0xdc, 0xc2, 0x02, 0x55,
+	0x32, 0x93, 0xf1, 0x34, 0x1d, 0xc5, 0xf1, 0x38, 0x9e, 0x49, 0x69, 0xdf, 0x80, 0x65, 0x97, 0x5d,
+	0xf6, 0x21, 0x78, 0x88, 0x8a, 0x55, 0x97, 0x88, 0x45, 0x40, 0xed, 0x92, 0x5d, 0x9e, 0x00, 0xd9,
+	0x33, 0x76, 0x23, 0x04, 0x48, 0x59, 0xd9, 0xf7, 0x9e, 0x7b, 0xcf, 0x39, 0xf3, 0x73, 0x07, 0x3c,
+	0xe2, 0xa2, 0xc7, 0x05, 0x13, 0x0e, 0xe1, 0x11, 0xa1, 0x91, 0x4c, 0xb0, 0xa4, 0x41, 0x33, 0x64,
+	0xfd, 0x01, 0x0b, 0x98, 0x3c, 0x75, 0x62, 0xce, 0x43, 0x3b, 0x4e, 0xb8, 0xe4, 0xf0, 0x81, 0x2e,
+	0xb5, 0xc7, 0x4b, 0x8b, 0x4a, 0xfb, 0xb8, 0xd5, 0xa6, 0x12, 0xb7, 0x36, 0xd6, 0x49, 0x56, 0xe7,
+	0x67, 0x4d, 0x8e, 0x0a, 0x14, 0xc3, 0xc6, 0x7f, 0x1d, 0xde, 0xe1, 0x2a, 0x9f, 0xfe, 0xe9, 0xac,
+	0xd9, 0xe1, 0xbc, 0x13, 0x52, 0x27, 0x8b, 0xda, 0x83, 0x43, 0x47, 0xb2, 0x1e, 0x15, 0x12, 0xf7,
+	0x62, 0x55, 0x60, 0xfd, 0x2c, 0x83, 0x99, 0x5d, 0xce, 0x43, 0xf8, 0x18, 0x94, 0x71, 0x10, 0x24,
+	0x54, 0x08, 0x64, 0xd4, 0x8d, 0x46, 0xc5, 0x85, 0xa3, 0xa1, 0xb9, 0x78, 0x8a, 0x7b, 0xe1, 0xa6,
+	0xa5, 0x01, 0xcb, 0xcb, 0x4b, 0xe0, 0x22, 0x98, 0x62, 0x01, 0x9a, 0xaa, 0x1b, 0x8d, 0x19, 0x6f,
+	0x8a, 0x05, 0xf0, 0x03, 0xa8, 0x14, 0x6e, 0xd1, 0x74, 0xd6, 0xef, 0x5e, 0x0e, 0xcd, 0xd2, 0xb7,
+	0xa1, 0xf9, 0xb0, 0xc3, 0xe4, 0xd1, 0xa0, 0x6d, 0x13, 0xde, 0xd3, 0x8e, 0xf5, 0xa7, 0x29, 0x82,
+	0xae, 0x23, 0x4f, 0x63, 0x2a, 0xec, 0x97, 0x94, 0x8c, 0x86, 0xe6, 0xb2, 0x52, 0x2b, 0x88, 0x2c,
+	0xef, 0x96, 0x14, 0xde, 0x01, 0x73, 0x92, 0x77, 0x69, 0xf4, 0x04, 0xcd, 0xa4, 0xf4, 0x9e, 0x8e,
+	0x8a, 0x7c, 0x0b, 0xcd, 0x8e, 0xe5, 0x5b, 0xb0, 0x0f, 0x20, 0x19, 0x24, 0x09, 0x8d, 0xa4, 0x2f,
+	0xfa, 0x89, 0xf4, 0xe3, 0x84, 0x11, 0x8a, 0xe6, 0x32, 0x6b, 0x2f, 0x26, 0xb6, 0xb6, 0xa2, 0xac,
+	0x89, 0x98, 0x6b, 0x26, 0xcb, 0x5b, 0xd6, 0xf4, 0x7b, 0xfd, 0x44, 0xee, 0xa6, 0x29, 0x78, 0x04,
+	0x16, 0x72, 0x49, 0xc9, 0x48, 0x17, 0x95, 0x33, 0xb1, 0x57, 0x13, 0x88, 0xed, 0x44, 0x72, 0x34,
+	0x34, 0x57, 0x95, 0xd8, 0x38, 0x97, 0xe5, 0x55, 0x75, 0xb8, 0xcf, 0x48, 0x17, 0x6e, 0x82, 0x85,
+	0x34, 0xeb, 0x8b, 0x18, 0x13, 0x16, 0x75, 0xd0, 0x7c, 0x7a, 0x10, 0xee, 0xda, 0x6d, 0xef, 0x38,
+	0x6a, 0x79, 0xd5, 0x34, 0xdc, 0x53, 0x11, 0x3c, 0x37, 0xc0, 0xbd, 0x38, 0xa1, 0x84, 0x09, 0xc6,
+	0x23, 0xff, 0x10, 0x13, 0xc9, 0x13, 0x1f, 0xeb, 0x65, 0xf9, 0x3c, 0xa2, 0xa8, 0x92, 0xf9, 0x7e,
+	0x37, 0xb1, 0xef, 0xfb, 0x4a, 0xfb, 0x9f, 0xe4, 0x96, 0xb7, 0x5e, 0xe0, 0xdb, 0x19, 0xbc, 0xa5,
+	0x76, 0xef, 0x4d, 0x44, 0xe1, 0x01, 0x98, 0x17, 0x1f, 0x71, 0xec, 0x1f, 0x52, 0x8a, 0x40, 0x66,
+	0x62, 0x6b, 0xe2, 0x93, 0x5a, 0xd2, 0x27, 0xa5, 0x79, 0x2c, 0xaf, 0x9c, 0xfe, 0x6e, 0x53, 0x0a,
+	0x4f, 0xc0, 0xff, 0x21, 0x16, 0xd2, 0x2f, 0xee, 0x94, 0x3f, 0x88, 0x03, 0x2c, 0x29, 0xaa, 0xd6,
+	0x8d, 0x46, 0xf5, 0xe9, 0x86, 0xad, 0x66, 0xc5, 0xce, 0x67, 0xc5, 0xde, 0xcf, 0x67, 0xc5, 0x6d,
+	0xa4, 0x36, 0x46, 0x43, 0xf3, 0xae, 0xbe, 0xa1, 0x7f, 0xa2, 0xb1, 0xce, 0xbe, 0x9b, 0x86, 0xb7,
+	0x9a, 0x62, 0xaf, 0x73, 0xe8, 0x6d, 0x86, 0x40, 0x09, 0x96, 0x07, 0x71, 0x3a, 0x79, 0xb7, 0x4d,
+	0x68, 0xa1, 0x3e, 0xdd, 0xa8, 0xb8, 0x3b, 0x13, 0xaf, 0x6f, 0x4d, 0x59, 0xf8, 0x9d, 0xcf, 0xf2,
+	0x96, 0x54, 0xaa, 0xd0, 0xde, 0x5c, 0xf9, 0x74, 0x61, 0x96, 0xce, 0x2f, 0xcc, 0xd2, 0x97, 0xcf,
+	0xcd, 0xd9, 0x74, 0xc6, 0x77, 0xdc, 0x83, 0xcb, 0xeb, 0x9a, 0x71, 0x75, 0x5d, 0x33, 0x7e, 0x5c,
+	0xd7, 0x8c, 0xb3, 0x9b, 0x5a, 0xe9, 0xea, 0xa6, 0x56, 0xfa, 0x7a, 0x53, 0x2b, 0xbd, 0x77, 0xc7,
+	0x0c, 0xe8, 0xb7, 0xa8, 0x19, 0xe2, 0xb6, 0xc8, 0x03, 0xe7, 0xb8, 0xf5, 0xdc, 0x39, 0xf9, 0xdb,
+	0x4b, 0xd6, 0xe3, 0x01, 0x0d, 0xdb, 0x73, 0xd9, 0xce, 0x3d, 0xfb, 0x35, 0x00, 0x08, 0xf5, 0xce,
+	0x41, 0xf8, 0x04, 0x00, 0x00,
 }
 
 func (m *Pool) Marshal() (dAtA []byte, err error) {
@@ -144,6 +164,28 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UptimeLiquidity) > 0 {
+		for iNdEx := len(m.UptimeLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.UptimeLiquidity[iNdEx].Size()
+				i -= size
+				if _, err := m.UptimeLiquidity[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastLiquidityUpdate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastLiquidityUpdate):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPool(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x5a
 	{
 		size := m.SwapFee.Size()
 		i -= size
@@ -273,6 +315,14 @@ func (m *Pool) Size() (n int) {
 	n += 1 + l + sovPool(uint64(l))
 	l = m.SwapFee.Size()
 	n += 1 + l + sovPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastLiquidityUpdate)
+	n += 1 + l + sovPool(uint64(l))
+	if len(m.UptimeLiquidity) > 0 {
+		for _, e := range m.UptimeLiquidity {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -615,6 +665,75 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastLiquidityUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastLiquidityUpdate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.UptimeLiquidity = append(m.UptimeLiquidity, v)
+			if err := m.UptimeLiquidity[len(m.UptimeLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
@@ -34,9 +34,16 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Position contains position's id, address, pool id, lower tick, upper tick
+// frozen until time, and liquidity.
 type Position struct {
 	Liquidity   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
 	FrozenUntil time.Time                              `protobuf:"bytes,2,opt,name=frozen_until,json=frozenUntil,proto3,stdtime" json:"frozen_until" yaml:"frozen_until"`
+	PositionId  uint64                                 `protobuf:"varint,3,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	Address     string                                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	PoolId      uint64                                 `protobuf:"varint,5,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	LowerTick   int64                                  `protobuf:"varint,6,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick   int64                                  `protobuf:"varint,7,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
 }
 
 func (m *Position) Reset()         { *m = Position{} }
@@ -79,6 +86,41 @@ func (m *Position) GetFrozenUntil() time.Time {
 	return time.Time{}
 }
 
+func (m *Position) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *Position) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Position) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *Position) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *Position) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Position)(nil), "osmosis.concentratedliquidity.v1beta1.Position")
 }
@@ -88,28 +130,36 @@ func init() {
 }
 
 var fileDescriptor_ffdfd7b30d37d326 = []byte{
-	// 329 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0xe3, 0x6f, 0xf8, 0x44, 0x53, 0x06, 0x54, 0x18, 0x4a, 0x87, 0xb8, 0x8a, 0x04, 0xea,
-	0x12, 0x5b, 0x05, 0x26, 0xc6, 0x88, 0x07, 0x40, 0x15, 0x2c, 0x08, 0x51, 0xf2, 0xc7, 0x0d, 0x16,
-	0x71, 0x6e, 0xa8, 0x9d, 0x8a, 0xf2, 0x14, 0x7d, 0xac, 0x0e, 0x0c, 0x1d, 0x11, 0x43, 0x40, 0xed,
-	0x1b, 0xf4, 0x09, 0x50, 0x63, 0xb7, 0x64, 0x61, 0x4a, 0xee, 0xd1, 0x39, 0xe7, 0xfe, 0x6c, 0xdb,
-	0x1e, 0x48, 0x01, 0x92, 0x4b, 0x1a, 0x41, 0x16, 0xb1, 0x4c, 0x8d, 0x03, 0xc5, 0x62, 0x2f, 0xe5,
-	0x2f, 0x05, 0x8f, 0xb9, 0x9a, 0xd2, 0x1c, 0x24, 0x57, 0x1c, 0x32, 0x92, 0x8f, 0x41, 0x41, 0xeb,
-	0xc4, 0xd8, 0x49, 0xdd, 0xbe, 0x73, 0x93, 0x49, 0x3f, 0x64, 0x2a, 0xe8, 0x77, 0x8e, 0xa3, 0xca,
-	0x37, 0xac, 0x42, 0x54, 0x0f, 0xba, 0xa1, 0x83, 0x13, 0x80, 0x24, 0x65, 0xb4, 0x9a, 0xc2, 0x62,
-	0x44, 0x15, 0x17, 0x4c, 0xaa, 0x40, 0xe4, 0xc6, 0x70, 0x94, 0x40, 0x02, 0x3a, 0xb8, 0xf9, 0xd3,
-	0xaa, 0xfb, 0x8e, 0xec, 0xbd, 0x6b, 0xc3, 0xd2, 0x7a, 0xb4, 0x1b, 0xbb, 0x9d, 0x6d, 0xd4, 0x45,
-	0xbd, 0x86, 0xef, 0xcf, 0x4b, 0x6c, 0x7d, 0x96, 0xf8, 0x34, 0xe1, 0xea, 0xa9, 0x08, 0x49, 0x04,
-	0xc2, 0xec, 0x35, 0x1f, 0x4f, 0xc6, 0xcf, 0x54, 0x4d, 0x73, 0x26, 0xc9, 0x15, 0x8b, 0xd6, 0x25,
-	0x3e, 0x98, 0x06, 0x22, 0xbd, 0x74, 0x77, 0x45, 0xee, 0xe0, 0xb7, 0xb4, 0xf5, 0x60, 0xef, 0x8f,
-	0xc6, 0xf0, 0xc6, 0xb2, 0x61, 0x91, 0x29, 0x9e, 0xb6, 0xff, 0x75, 0x51, 0xaf, 0x79, 0xd6, 0x21,
-	0x1a, 0x9e, 0x6c, 0xe1, 0xc9, 0xcd, 0x16, 0xde, 0xc7, 0x1b, 0x80, 0x75, 0x89, 0x0f, 0x75, 0x6d,
-	0x3d, 0xed, 0xce, 0xbe, 0x30, 0x1a, 0x34, 0xb5, 0x74, 0xbb, 0x51, 0xfc, 0xfb, 0xf9, 0xd2, 0x41,
-	0x8b, 0xa5, 0x83, 0xbe, 0x97, 0x0e, 0x9a, 0xad, 0x1c, 0x6b, 0xb1, 0x72, 0xac, 0x8f, 0x95, 0x63,
-	0xdd, 0xf9, 0xb5, 0x03, 0x98, 0xcb, 0xf6, 0xd2, 0x20, 0x94, 0xdb, 0x81, 0x4e, 0xfa, 0x17, 0xf4,
-	0xf5, 0xaf, 0xe7, 0x12, 0x10, 0xb3, 0x34, 0xfc, 0x5f, 0xf1, 0x9d, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0x3e, 0x46, 0xdd, 0xb2, 0xdd, 0x01, 0x00, 0x00,
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcd, 0x8e, 0xd3, 0x30,
+	0x18, 0xac, 0xe9, 0xd2, 0x52, 0x17, 0x21, 0x30, 0x3f, 0x0a, 0x3d, 0xc4, 0x55, 0x24, 0x50, 0x25,
+	0x68, 0xa2, 0xc2, 0x4a, 0x48, 0x1c, 0x23, 0x2e, 0x7b, 0x43, 0xd1, 0x72, 0x41, 0x88, 0x92, 0xc4,
+	0xde, 0x60, 0x35, 0x89, 0x43, 0xec, 0x2c, 0x94, 0xa7, 0xd8, 0xc7, 0xda, 0x03, 0x87, 0x3d, 0x22,
+	0x0e, 0x01, 0xb5, 0x6f, 0x90, 0x27, 0x40, 0x8e, 0x9d, 0x6e, 0x84, 0xc4, 0x29, 0x9e, 0x6f, 0x66,
+	0xbe, 0x6f, 0xf2, 0xd9, 0x70, 0xc9, 0x45, 0xc6, 0x05, 0x13, 0x5e, 0xcc, 0xf3, 0x98, 0xe6, 0xb2,
+	0x0c, 0x25, 0x25, 0xcb, 0x94, 0x7d, 0xa9, 0x18, 0x61, 0x72, 0xeb, 0x15, 0x5c, 0x30, 0xc9, 0x78,
+	0xee, 0x16, 0x25, 0x97, 0x1c, 0x3d, 0x31, 0x72, 0xb7, 0x2f, 0x3f, 0xa8, 0xdd, 0xf3, 0x55, 0x44,
+	0x65, 0xb8, 0x9a, 0x3d, 0x8e, 0x5b, 0xdd, 0xba, 0x35, 0x79, 0x1a, 0xe8, 0x0e, 0x33, 0x9c, 0x70,
+	0x9e, 0xa4, 0xd4, 0x6b, 0x51, 0x54, 0x9d, 0x79, 0x92, 0x65, 0x54, 0xc8, 0x30, 0x2b, 0x8c, 0xe0,
+	0x41, 0xc2, 0x13, 0xae, 0x8d, 0xea, 0xa4, 0xab, 0xce, 0x8f, 0x21, 0xbc, 0xf5, 0xd6, 0x64, 0x41,
+	0x9f, 0xe0, 0xe4, 0x30, 0xd3, 0x02, 0x73, 0xb0, 0x98, 0xf8, 0xfe, 0x65, 0x8d, 0x07, 0xbf, 0x6a,
+	0xfc, 0x34, 0x61, 0xf2, 0x73, 0x15, 0xb9, 0x31, 0xcf, 0xcc, 0x5c, 0xf3, 0x59, 0x0a, 0xb2, 0xf1,
+	0xe4, 0xb6, 0xa0, 0xc2, 0x7d, 0x43, 0xe3, 0xa6, 0xc6, 0x77, 0xb7, 0x61, 0x96, 0xbe, 0x76, 0x0e,
+	0x8d, 0x9c, 0xe0, 0xba, 0x29, 0xfa, 0x08, 0x6f, 0x9f, 0x95, 0xfc, 0x3b, 0xcd, 0xd7, 0x55, 0x2e,
+	0x59, 0x6a, 0xdd, 0x98, 0x83, 0xc5, 0xf4, 0xc5, 0xcc, 0xd5, 0xe1, 0xdd, 0x2e, 0xbc, 0x7b, 0xda,
+	0x85, 0xf7, 0xb1, 0x0a, 0xd0, 0xd4, 0xf8, 0xbe, 0x6e, 0xdb, 0x77, 0x3b, 0x17, 0xbf, 0x31, 0x08,
+	0xa6, 0xba, 0xf4, 0x4e, 0x55, 0xd0, 0x2b, 0x38, 0xed, 0x36, 0xbb, 0x66, 0xc4, 0x1a, 0xce, 0xc1,
+	0xe2, 0xc8, 0x7f, 0xd4, 0xd4, 0x18, 0x69, 0x7b, 0x8f, 0x74, 0x02, 0xd8, 0xa1, 0x13, 0x82, 0x9e,
+	0xc3, 0x71, 0x48, 0x48, 0x49, 0x85, 0xb0, 0x8e, 0xda, 0x1f, 0x47, 0x4d, 0x8d, 0xef, 0x68, 0x93,
+	0x21, 0x9c, 0xa0, 0x93, 0xa0, 0x67, 0x70, 0x5c, 0x70, 0x9e, 0xaa, 0x11, 0x37, 0xdb, 0x11, 0x3d,
+	0xb5, 0x21, 0x9c, 0x60, 0xa4, 0x4e, 0x27, 0x04, 0x1d, 0x43, 0x98, 0xf2, 0xaf, 0xb4, 0x5c, 0x4b,
+	0x16, 0x6f, 0xac, 0xd1, 0x1c, 0x2c, 0x86, 0xfe, 0xc3, 0xa6, 0xc6, 0xf7, 0xcc, 0xa2, 0x0e, 0x9c,
+	0xda, 0x94, 0x02, 0xa7, 0x2c, 0xde, 0x28, 0x57, 0x55, 0x14, 0x9d, 0x6b, 0xfc, 0xaf, 0xeb, 0x9a,
+	0x73, 0x82, 0x49, 0x0b, 0x94, 0xcb, 0xff, 0x70, 0xb9, 0xb3, 0xc1, 0xd5, 0xce, 0x06, 0x7f, 0x76,
+	0x36, 0xb8, 0xd8, 0xdb, 0x83, 0xab, 0xbd, 0x3d, 0xf8, 0xb9, 0xb7, 0x07, 0xef, 0xfd, 0xde, 0x05,
+	0x9a, 0xc7, 0xb6, 0x4c, 0xc3, 0x48, 0x74, 0xc0, 0x3b, 0x5f, 0x1d, 0x7b, 0xdf, 0xfe, 0xf7, 0x5c,
+	0x33, 0x4e, 0x68, 0x1a, 0x8d, 0xda, 0xfb, 0x79, 0xf9, 0x77, 0x00, 0xf0, 0x5d, 0x24, 0x28, 0xdd,
+	0x02, 0x00, 0x00,
 }
 
 func (m *Position) Marshal() (dAtA []byte, err error) {
@@ -132,6 +182,33 @@ func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPosition(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x18
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FrozenUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FrozenUntil):])
 	if err1 != nil {
 		return 0, err1
@@ -174,6 +251,22 @@ func (m *Position) Size() (n int) {
 	n += 1 + l + sovPosition(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FrozenUntil)
 	n += 1 + l + sovPosition(uint64(l))
+	if m.PositionId != 0 {
+		n += 1 + sovPosition(uint64(m.PositionId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPosition(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovPosition(uint64(m.PoolId))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovPosition(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovPosition(uint64(m.UpperTick))
+	}
 	return n
 }
 
@@ -279,6 +372,114 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPosition(dAtA[iNdEx:])
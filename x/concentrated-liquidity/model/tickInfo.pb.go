@@ -35,6 +35,9 @@ type TickInfo struct {
 	LiquidityGross   github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,1,opt,name=liquidity_gross,json=liquidityGross,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_gross" yaml:"liquidity_gross"`
 	LiquidityNet     github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,2,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net" yaml:"liquidity_net"`
 	FeeGrowthOutside github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=fee_growth_outside,json=feeGrowthOutside,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fee_growth_outside"`
+	// uptime_trackers holds the uptime growth outside of this tick for each
+	// supported uptime, in the same order as types.SupportedUptimes.
+	UptimeTrackers []UptimeTracker `protobuf:"bytes,4,rep,name=uptime_trackers,json=uptimeTrackers,proto3" json:"uptime_trackers" yaml:"uptime_trackers"`
 }
 
 func (m *TickInfo) Reset()         { *m = TickInfo{} }
@@ -77,8 +80,64 @@ func (m *TickInfo) GetFeeGrowthOutside() github_com_cosmos_cosmos_sdk_types.DecC
 	return nil
 }
 
+func (m *TickInfo) GetUptimeTrackers() []UptimeTracker {
+	if m != nil {
+		return m.UptimeTrackers
+	}
+	return nil
+}
+
+type UptimeTracker struct {
+	UptimeGrowthOutside github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=uptime_growth_outside,json=uptimeGrowthOutside,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"uptime_growth_outside"`
+	// liquidity_net is the net liquidity qualifying for the uptime that is
+	// added when crossing this tick from left to right, the same way as the
+	// tick's liquidity_net.
+	LiquidityNet github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net" yaml:"liquidity_net"`
+}
+
+func (m *UptimeTracker) Reset()         { *m = UptimeTracker{} }
+func (m *UptimeTracker) String() string { return proto.CompactTextString(m) }
+func (*UptimeTracker) ProtoMessage()    {}
+func (*UptimeTracker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ccb7e45032b943a, []int{1}
+}
+func (m *UptimeTracker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UptimeTracker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UptimeTracker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UptimeTracker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UptimeTracker.Merge(m, src)
+}
+func (m *UptimeTracker) XXX_Size() int {
+	return m.Size()
+}
+func (m *UptimeTracker) XXX_DiscardUnknown() {
+	xxx_messageInfo_UptimeTracker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UptimeTracker proto.InternalMessageInfo
+
+func (m *UptimeTracker) GetUptimeGrowthOutside() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.UptimeGrowthOutside
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TickInfo)(nil), "osmosis.concentratedliquidity.v1beta1.TickInfo")
+	proto.RegisterType((*UptimeTracker)(nil), "osmosis.concentratedliquidity.v1beta1.UptimeTracker")
 }
 
 func init() {
@@ -86,31 +145,36 @@ func init() {
 }
 
 var fileDescriptor_1ccb7e45032b943a = []byte{
-	// 374 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x4f, 0xce, 0xcf, 0x4b, 0x4e, 0xcd, 0x2b, 0x29, 0x4a, 0x2c, 0x49, 0x4d,
-	0xd1, 0xcd, 0xc9, 0x2c, 0x2c, 0xcd, 0x4c, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0xc9, 0x4c, 0xce, 0xf6,
-	0xcc, 0x4b, 0xcb, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x85, 0x2a, 0xd7, 0x43, 0x56,
-	0x0e, 0x57, 0xad, 0x57, 0x66, 0x98, 0x94, 0x5a, 0x92, 0x68, 0x28, 0x25, 0x99, 0x0c, 0x56, 0x17,
-	0x0f, 0xd6, 0xa4, 0x0f, 0xe1, 0x40, 0x4c, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x87, 0x88, 0x83,
-	0x58, 0x50, 0x51, 0x39, 0x88, 0x1a, 0xfd, 0xa4, 0xc4, 0xe2, 0x54, 0x7d, 0xa8, 0x29, 0xfa, 0xc9,
-	0xf9, 0x99, 0x79, 0x10, 0x79, 0xa5, 0xd7, 0x4c, 0x5c, 0x1c, 0x21, 0x50, 0xa7, 0x08, 0x15, 0x72,
-	0xf1, 0xc3, 0xad, 0x8c, 0x4f, 0x2f, 0xca, 0x2f, 0x2e, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74,
-	0xf2, 0x38, 0x71, 0x4f, 0x9e, 0xe1, 0xd6, 0x3d, 0x79, 0xb5, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0xa8, 0xe5, 0x50, 0x4a, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0xa4, 0xb2, 0x20,
-	0xb5, 0x58, 0xcf, 0x25, 0x35, 0xf9, 0xd3, 0x3d, 0x79, 0xb1, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25,
-	0x34, 0xe3, 0x94, 0x82, 0xf8, 0xe0, 0x22, 0xee, 0x20, 0x01, 0xa1, 0x6c, 0x2e, 0x5e, 0x84, 0x9a,
-	0xbc, 0xd4, 0x12, 0x09, 0x26, 0xb0, 0x85, 0x6e, 0x24, 0x5b, 0x28, 0x82, 0x6e, 0x61, 0x5e, 0x6a,
-	0x89, 0x52, 0x10, 0x0f, 0x9c, 0xef, 0x97, 0x5a, 0x22, 0x54, 0xcf, 0x25, 0x94, 0x96, 0x9a, 0x0a,
-	0x72, 0x4a, 0x79, 0x49, 0x46, 0x7c, 0x7e, 0x69, 0x49, 0x71, 0x66, 0x4a, 0xaa, 0x04, 0xb3, 0x02,
-	0xb3, 0x06, 0xb7, 0x91, 0x8c, 0x1e, 0x34, 0x34, 0x41, 0x21, 0x05, 0x0b, 0x6f, 0x90, 0xd9, 0xce,
-	0xf9, 0x99, 0x79, 0x4e, 0xc6, 0x20, 0xf7, 0xac, 0xba, 0x2f, 0xaf, 0x4d, 0x9c, 0x7b, 0x40, 0x7a,
-	0x8a, 0x83, 0x04, 0xd2, 0x52, 0x53, 0xdd, 0xc1, 0x76, 0xf9, 0x43, 0xac, 0x72, 0x8a, 0x39, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x27, 0x24, 0x83, 0xa1, 0x49, 0x41, 0x37,
-	0x27, 0x31, 0xa9, 0x18, 0xc6, 0xd1, 0x2f, 0x33, 0x34, 0xd1, 0xaf, 0xc0, 0x95, 0x98, 0x72, 0xf3,
-	0x53, 0x52, 0x73, 0x92, 0xd8, 0xc0, 0x51, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x93, 0x5e,
-	0x70, 0x0c, 0x7b, 0x02, 0x00, 0x00,
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x37, 0x5d, 0x11, 0x1d, 0x6d, 0x2b, 0xb1, 0xca, 0x5a, 0x64, 0xb6, 0x04, 0x94, 0x82,
+	0xec, 0x0c, 0x6b, 0x7b, 0xf2, 0x18, 0xc5, 0xea, 0x45, 0x61, 0xa9, 0x17, 0x11, 0x42, 0x32, 0x79,
+	0x37, 0x1d, 0xf2, 0x67, 0xb6, 0x99, 0x49, 0x75, 0x0f, 0xe2, 0x45, 0xef, 0x7e, 0x0e, 0x3f, 0x49,
+	0x8f, 0x3d, 0x8a, 0x87, 0x55, 0x76, 0x6f, 0x1e, 0xfd, 0x04, 0x32, 0x99, 0x69, 0xba, 0x0d, 0x08,
+	0xf5, 0xa0, 0xa7, 0xe4, 0x7d, 0xf3, 0xbc, 0xcf, 0xef, 0xe5, 0x99, 0x0c, 0x1a, 0x08, 0x99, 0x0b,
+	0xc9, 0x25, 0x65, 0xa2, 0x60, 0x50, 0xa8, 0x32, 0x54, 0x10, 0x0f, 0x32, 0x7e, 0x58, 0xf1, 0x98,
+	0xab, 0x29, 0x55, 0x9c, 0xa5, 0xcf, 0x8b, 0xb1, 0x20, 0x93, 0x52, 0x28, 0xe1, 0xde, 0xb3, 0x72,
+	0xb2, 0x2c, 0x6f, 0xd4, 0xe4, 0x68, 0x18, 0x81, 0x0a, 0x87, 0x9b, 0x77, 0x58, 0xad, 0x0b, 0xea,
+	0x21, 0x6a, 0x0a, 0xe3, 0xb0, 0xb9, 0x91, 0x88, 0x44, 0x98, 0xbe, 0x7e, 0xb3, 0x5d, 0x6c, 0x34,
+	0x34, 0x0a, 0x25, 0x50, 0xeb, 0x42, 0x99, 0xe0, 0x85, 0xf9, 0xee, 0xfd, 0xec, 0xa2, 0x2b, 0xfb,
+	0x76, 0x15, 0xf7, 0x10, 0xad, 0x37, 0xc8, 0x20, 0x29, 0x85, 0x94, 0x3d, 0x67, 0xcb, 0xd9, 0xbe,
+	0xea, 0x3f, 0x3b, 0x9e, 0xf5, 0x3b, 0xdf, 0x66, 0xfd, 0xfb, 0x09, 0x57, 0x07, 0x55, 0x44, 0x98,
+	0xc8, 0x2d, 0xdc, 0x3e, 0x06, 0x32, 0x4e, 0xa9, 0x9a, 0x4e, 0x40, 0x92, 0x27, 0xc0, 0x7e, 0xcd,
+	0xfa, 0xb7, 0xa7, 0x61, 0x9e, 0x3d, 0xf2, 0x5a, 0x76, 0xde, 0x68, 0xad, 0xe9, 0xec, 0xe9, 0x86,
+	0x9b, 0xa2, 0xd5, 0x33, 0x4d, 0x01, 0xaa, 0xb7, 0x52, 0x03, 0x9f, 0xfe, 0x35, 0x70, 0xa3, 0x0d,
+	0x2c, 0x40, 0x79, 0xa3, 0xeb, 0x4d, 0xfd, 0x02, 0x94, 0xfb, 0x01, 0xb9, 0x63, 0x00, 0xbd, 0xca,
+	0x5b, 0x75, 0x10, 0x88, 0x4a, 0x49, 0x1e, 0x43, 0xaf, 0xbb, 0xd5, 0xdd, 0xbe, 0xf6, 0xf0, 0x2e,
+	0xb1, 0x69, 0xea, 0xa4, 0x4e, 0xf3, 0xd6, 0xde, 0x8f, 0x05, 0x2f, 0xfc, 0x1d, 0xbd, 0xcf, 0x97,
+	0xef, 0xfd, 0x07, 0x17, 0xdb, 0x47, 0xcf, 0xc8, 0xd1, 0x8d, 0x31, 0xc0, 0x5e, 0xcd, 0x7a, 0x69,
+	0x50, 0xee, 0x7b, 0xb4, 0x5e, 0x4d, 0x14, 0xcf, 0x21, 0x50, 0x65, 0xc8, 0x52, 0x28, 0x65, 0xef,
+	0x52, 0x4d, 0xdf, 0x25, 0x17, 0x3a, 0x7f, 0xf2, 0xaa, 0x9e, 0xde, 0x37, 0xc3, 0x3e, 0xd6, 0x5b,
+	0x9d, 0x85, 0xdd, 0xb2, 0xf6, 0x46, 0x6b, 0xd5, 0xb2, 0x5c, 0x7a, 0x1f, 0x57, 0xd0, 0xea, 0x39,
+	0x07, 0xf7, 0x93, 0x83, 0x6e, 0xd9, 0xb1, 0x56, 0x2a, 0xce, 0xbf, 0x4a, 0xe5, 0xa6, 0xe1, 0x9d,
+	0x0f, 0xe6, 0x7f, 0xfe, 0x06, 0xfe, 0x9b, 0xe3, 0x39, 0x76, 0x4e, 0xe6, 0xd8, 0xf9, 0x31, 0xc7,
+	0xce, 0xe7, 0x05, 0xee, 0x9c, 0x2c, 0x70, 0xe7, 0xeb, 0x02, 0x77, 0x5e, 0xfb, 0x4b, 0x1c, 0x7b,
+	0x20, 0x83, 0x2c, 0x8c, 0xe4, 0x69, 0x41, 0x8f, 0x86, 0xbb, 0xf4, 0xdd, 0x9f, 0xae, 0x74, 0x2e,
+	0x62, 0xc8, 0xa2, 0xcb, 0xf5, 0xc5, 0xda, 0xf9, 0x3d, 0x00, 0xa5, 0xb9, 0x48, 0x80, 0x01, 0x04,
+	0x00, 0x00,
 }
 
 func (m *TickInfo) Marshal() (dAtA []byte, err error) {
@@ -133,6 +197,20 @@ func (m *TickInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UptimeTrackers) > 0 {
+		for iNdEx := len(m.UptimeTrackers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeTrackers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTickInfo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.FeeGrowthOutside) > 0 {
 		for iNdEx := len(m.FeeGrowthOutside) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -170,6 +248,53 @@ func (m *TickInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UptimeTracker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UptimeTracker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UptimeTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTickInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UptimeGrowthOutside) > 0 {
+		for iNdEx := len(m.UptimeGrowthOutside) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeGrowthOutside[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTickInfo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTickInfo(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTickInfo(v)
 	base := offset
@@ -197,6 +322,29 @@ func (m *TickInfo) Size() (n int) {
 			n += 1 + l + sovTickInfo(uint64(l))
 		}
 	}
+	if len(m.UptimeTrackers) > 0 {
+		for _, e := range m.UptimeTrackers {
+			l = e.Size()
+			n += 1 + l + sovTickInfo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UptimeTracker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UptimeGrowthOutside) > 0 {
+		for _, e := range m.UptimeGrowthOutside {
+			l = e.Size()
+			n += 1 + l + sovTickInfo(uint64(l))
+		}
+	}
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovTickInfo(uint64(l))
 	return n
 }
 
@@ -337,6 +485,158 @@ func (m *TickInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeTrackers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeTrackers = append(m.UptimeTrackers, UptimeTracker{})
+			if err := m.UptimeTrackers[len(m.UptimeTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTickInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UptimeTracker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTickInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UptimeTracker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UptimeTracker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeGrowthOutside", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeGrowthOutside = append(m.UptimeGrowthOutside, types.DecCoin{})
+			if err := m.UptimeGrowthOutside[len(m.UptimeGrowthOutside)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTickInfo(dAtA[iNdEx:])
@@ -32,7 +32,7 @@ func (suite *KeeperTestSuite) TestCreateConcentratedPool_Events() {
 			tickSpacing:               DefaultTickSpacing,
 			precisionFactorAtPriceOne: DefaultExponentAtPriceOne,
 			expectedPoolCreatedEvent:  1,
-			expectedMessageEvents:     3, // 1 for pool created, 1 for coin spent, 1 for coin received
+			expectedMessageEvents:     4, // 1 for pool created, 1 for coin spent, 1 for coin received, 1 for the pool-incentives gauge created
 		},
 		"error: missing denom0": {
 			denom1:                    USDC,
@@ -136,30 +136,26 @@ func (suite *KeeperTestSuite) TestCreateConcentratedPool_Events() {
 // when calling CollectFees.
 func (suite *KeeperTestSuite) TestCollectFees_Events() {
 	testcases := map[string]struct {
-		upperTick                int64
-		lowerTick                int64
+		positionId               uint64
+		senderIndex              int
 		expectedCollectFeesEvent int
 		expectedMessageEvents    int
 		expectedError            error
-		errorFromValidateBasic   error
 	}{
 		"happy path": {
-			upperTick:                DefaultUpperTick,
-			lowerTick:                DefaultLowerTick,
+			positionId:               1,
 			expectedCollectFeesEvent: 1,
 			expectedMessageEvents:    2, // 1 for collect fees, 1 for message
 		},
-		"error: lowerTick greater than upperTick": {
-			upperTick:              DefaultLowerTick,
-			lowerTick:              DefaultUpperTick,
-			expectedError:          types.PositionNotFoundError{PoolId: 1, LowerTick: DefaultUpperTick, UpperTick: DefaultLowerTick},
-			errorFromValidateBasic: types.InvalidLowerUpperTickError{LowerTick: DefaultUpperTick, UpperTick: DefaultLowerTick},
+		"error: position does not exist": {
+			positionId:    2,
+			expectedError: types.PositionNotFoundError{PositionId: 2},
 		},
-		"error: lowerTick equal to upperTick": {
-			upperTick:              10,
-			lowerTick:              10,
-			expectedError:          types.PositionNotFoundError{PoolId: 1, LowerTick: 10, UpperTick: 10},
-			errorFromValidateBasic: types.InvalidLowerUpperTickError{LowerTick: 10, UpperTick: 10},
+		"error: sender is not the position owner": {
+			positionId:  1,
+			senderIndex: 1,
+			// Address is filled in with the sender once the test accounts are set up.
+			expectedError: types.NotPositionOwnerError{PositionId: 1},
 		},
 	}
 
@@ -179,10 +175,13 @@ func (suite *KeeperTestSuite) TestCollectFees_Events() {
 			suite.Equal(0, len(ctx.EventManager().Events()))
 
 			msg := &cltypes.MsgCollectFees{
-				PoolId:    pool.GetId(),
-				Sender:    suite.TestAccs[0].String(),
-				LowerTick: tc.lowerTick,
-				UpperTick: tc.upperTick,
+				PositionId: tc.positionId,
+				Sender:     suite.TestAccs[tc.senderIndex].String(),
+			}
+
+			if notOwnerErr, ok := tc.expectedError.(types.NotPositionOwnerError); ok {
+				notOwnerErr.Address = msg.Sender
+				tc.expectedError = notOwnerErr
 			}
 
 			response, err := msgServer.CollectFees(sdk.WrapSDKContext(ctx), msg)
@@ -197,13 +196,6 @@ func (suite *KeeperTestSuite) TestCollectFees_Events() {
 				suite.Require().ErrorContains(err, tc.expectedError.Error())
 				suite.Require().Nil(response)
 			}
-
-			// Some validate basic checks are defense in depth so they would normally not be possible to reach
-			// This check allows us to still test these cases
-			if tc.errorFromValidateBasic != nil {
-				suite.Require().Error(msg.ValidateBasic())
-				suite.Require().ErrorAs(msg.ValidateBasic(), &tc.errorFromValidateBasic)
-			}
 		})
 	}
 }
@@ -3,12 +3,16 @@ package concentrated_liquidity
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/internal/math"
 	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/model"
 	types "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v14/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
 )
 
@@ -33,7 +37,19 @@ func (k Keeper) InitializePool(ctx sdk.Context, poolI poolmanagertypes.PoolI, cr
 		return fmt.Errorf("invalid tick spacing. Got %d", tickSpacing)
 	}
 
-	return k.setPool(ctx, concentratedPool)
+	swapFee := concentratedPool.GetSwapFee(ctx)
+	if !k.GetParams(ctx).IsAuthorizedSwapFeeTier(swapFee, tickSpacing) {
+		return types.UnauthorizedSwapFeeTierError{SwapFee: swapFee, TickSpacing: tickSpacing}
+	}
+
+	concentratedPool.SetLastLiquidityUpdate(ctx.BlockTime())
+
+	if err := k.setPool(ctx, concentratedPool); err != nil {
+		return err
+	}
+
+	k.listeners.AfterConcentratedPoolCreated(ctx, creatorAddress, concentratedPool.GetId())
+	return nil
 }
 
 // GetPool returns a pool with a given id.
@@ -49,6 +65,87 @@ func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI,
 	return poolI, nil
 }
 
+// GetPoolDenoms returns the denoms of the two assets of the concentrated liquidity pool with the given id,
+// sorted lexicographically.
+func (k Keeper) GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error) {
+	concentratedPool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := []string{concentratedPool.GetToken0(), concentratedPool.GetToken1()}
+	sort.Strings(denoms)
+	return denoms, nil
+}
+
+// GetPoolReserves returns the amounts of token0 and token1 held by all positions in the pool with the given id
+// at the pool's current price. Unlike the balances of the pool's address, they exclude uncollected fees.
+func (k Keeper) GetPoolReserves(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	concentratedPool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	positions, err := k.GetPoolPositions(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	amount0, amount1 := sdk.ZeroDec(), sdk.ZeroDec()
+	for _, position := range positions {
+		sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(position.LowerTick, position.UpperTick, concentratedPool.GetPrecisionFactorAtPriceOne())
+		if err != nil {
+			return nil, err
+		}
+
+		positionAmount0, positionAmount1 := concentratedPool.CalcActualAmounts(ctx, position.LowerTick, position.UpperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, position.Liquidity)
+		amount0 = amount0.Add(positionAmount0)
+		amount1 = amount1.Add(positionAmount1)
+	}
+
+	return sdk.NewCoins(
+		sdk.NewCoin(concentratedPool.GetToken0(), amount0.TruncateInt()),
+		sdk.NewCoin(concentratedPool.GetToken1(), amount1.TruncateInt()),
+	), nil
+}
+
+// CalculateSpotPrice returns the spot price of the base asset in terms of the quote asset
+// in the concentrated liquidity pool with the given id, rounded like CFMM pool spot prices.
+// Returns an error if the pool has no price yet, i.e. before its first position is created.
+func (k Keeper) CalculateSpotPrice(
+	ctx sdk.Context,
+	poolId uint64,
+	quoteAssetDenom string,
+	baseAssetDenom string,
+) (spotPrice sdk.Dec, err error) {
+	concentratedPool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// defer to catch panics, in case something internal overflows or divides by zero.
+	defer func() {
+		if r := recover(); r != nil {
+			spotPrice = sdk.Dec{}
+			err = gammtypes.ErrSpotPriceInternal
+		}
+	}()
+
+	// Unlike CFMM pools, concentrated liquidity pools take the base asset first.
+	spotPrice, err = concentratedPool.SpotPrice(ctx, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if spotPrice.GT(gammtypes.MaxSpotPrice) {
+		return gammtypes.MaxSpotPrice, gammtypes.ErrSpotPriceOverflow
+	} else if !spotPrice.IsPositive() {
+		return sdk.Dec{}, gammtypes.ErrSpotPriceInternal
+	}
+
+	return osmomath.SigFigRound(spotPrice, gammtypes.SpotPriceSigFigs), nil
+}
+
 // getPoolById returns a concentratedPoolExtension that corresponds to the requested pool id. Returns error if pool id is not found.
 func (k Keeper) getPoolById(ctx sdk.Context, poolId uint64) (types.ConcentratedPoolExtension, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	cl "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity"
 	clmodel "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
@@ -36,6 +37,15 @@ func (s *KeeperTestSuite) TestInitializePool() {
 	invalidConcentratedPool, err := clmodel.NewConcentratedLiquidityPool(2, ETH, USDC, invalidTickSpacing, DefaultExponentAtPriceOne, DefaultZeroSwapFee)
 	s.Require().NoError(err)
 
+	// Create concentrated liquidity pools with a swap fee that is not an authorized tier, and with a
+	// tick spacing that is not authorized for the pool's swap fee tier.
+	unauthorizedSwapFee := sdk.MustNewDecFromStr("0.002")
+	unauthorizedSwapFeePool, err := clmodel.NewConcentratedLiquidityPool(3, ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, unauthorizedSwapFee)
+	s.Require().NoError(err)
+	unauthorizedTierSwapFee, unauthorizedTierTickSpacing := sdk.MustNewDecFromStr("0.0001"), uint64(10)
+	unauthorizedTierTickSpacingPool, err := clmodel.NewConcentratedLiquidityPool(4, ETH, USDC, unauthorizedTierTickSpacing, DefaultExponentAtPriceOne, unauthorizedTierSwapFee)
+	s.Require().NoError(err)
+
 	// Create an invalid PoolI that doesn't implement ConcentratedPoolExtension
 	var invalidPoolI poolmanagertypes.PoolI
 
@@ -64,6 +74,18 @@ func (s *KeeperTestSuite) TestInitializePool() {
 			creatorAddress: validCreatorAddress,
 			expectedErr:    fmt.Errorf("invalid tick spacing. Got %d", invalidTickSpacing),
 		},
+		{
+			name:           "Swap fee is not an authorized swap fee tier",
+			poolI:          &unauthorizedSwapFeePool,
+			creatorAddress: validCreatorAddress,
+			expectedErr:    types.UnauthorizedSwapFeeTierError{SwapFee: unauthorizedSwapFee, TickSpacing: DefaultTickSpacing},
+		},
+		{
+			name:           "Tick spacing is not authorized for the swap fee tier",
+			poolI:          &unauthorizedTierTickSpacingPool,
+			creatorAddress: validCreatorAddress,
+			expectedErr:    types.UnauthorizedSwapFeeTierError{SwapFee: unauthorizedTierSwapFee, TickSpacing: unauthorizedTierTickSpacing},
+		},
 		// We cannot test
 		// We don't check creator address because we don't mint anything when making concentrated liquidity pools
 
@@ -150,6 +172,38 @@ func (s *KeeperTestSuite) TestGetPoolById() {
 	}
 }
 
+func (s *KeeperTestSuite) TestGetPoolReserves() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	// Create default CL pool
+	pool := s.PrepareConcentratedPool()
+
+	// A pool without positions has no reserves.
+	reserves, err := clKeeper.GetPoolReserves(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().True(reserves.IsZero())
+
+	// Create a position in range, and a position above the current price that only holds token0.
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewCoin(ETH, DefaultAmt0.MulRaw(2)), DefaultCoin1))
+	_, inRangeAmount0, inRangeAmount1, _, err := clKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[0], DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	_, aboveRangeAmount0, aboveRangeAmount1, _, err := clKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[0], DefaultAmt0, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), DefaultUpperTick, DefaultUpperTick+100, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().True(aboveRangeAmount1.IsZero())
+
+	// The reserves are what the positions hold, up to rounding of every position's amounts.
+	reserves, err = clKeeper.GetPoolReserves(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	errTolerance := osmomath.ErrTolerance{AdditiveTolerance: sdk.NewDec(2)}
+	s.Require().Equal(0, errTolerance.Compare(inRangeAmount0.Add(aboveRangeAmount0), reserves.AmountOf(pool.GetToken0())))
+	s.Require().Equal(0, errTolerance.Compare(inRangeAmount1, reserves.AmountOf(pool.GetToken1())))
+
+	// Non-existent pool.
+	_, err = clKeeper.GetPoolReserves(s.Ctx, pool.GetId()+1)
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: pool.GetId() + 1})
+}
+
 func (s *KeeperTestSuite) TestPoolExists() {
 	s.SetupTest()
 
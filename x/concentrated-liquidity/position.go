@@ -13,28 +13,33 @@ import (
 
 var emptyOptions = &accum.Options{}
 
-// getOrInitPosition retrieves the position for the given tick range. If it doesn't exist, it returns an initialized position with zero liquidity.
+// getOrInitPosition retrieves the position with the given id. If it doesn't exist, it returns an initialized position with zero liquidity.
 func (k Keeper) getOrInitPosition(
 	ctx sdk.Context,
+	positionId uint64,
 	poolId uint64,
 	owner sdk.AccAddress,
 	lowerTick, upperTick int64,
 	frozenUntil time.Time,
-) (*model.Position, error) {
+) (model.Position, error) {
 	if !k.poolExists(ctx, poolId) {
-		return nil, types.PoolNotFoundError{PoolId: poolId}
+		return model.Position{}, types.PoolNotFoundError{PoolId: poolId}
 	}
-	if k.hasFullPosition(ctx, poolId, owner, lowerTick, upperTick, frozenUntil) {
-		position, err := k.GetPosition(ctx, poolId, owner, lowerTick, upperTick, frozenUntil)
-		if err != nil {
-			return nil, err
-		}
-		return position, nil
+	if k.hasPosition(ctx, positionId) {
+		return k.GetPosition(ctx, positionId)
 	}
-	return &model.Position{Liquidity: sdk.ZeroDec()}, nil
+	return model.Position{
+		PositionId:  positionId,
+		Address:     owner.String(),
+		PoolId:      poolId,
+		LowerTick:   lowerTick,
+		UpperTick:   upperTick,
+		FrozenUntil: frozenUntil,
+		Liquidity:   sdk.ZeroDec(),
+	}, nil
 }
 
-// initOrUpdatePosition checks to see if the specified owner has an existing position at the given tick range.
+// initOrUpdatePosition checks to see if a position with the given id exists.
 // If a position is not present, it initializes the position with the provided liquidity delta.
 // If a position is present, it combines the existing liquidity in that position with the provided liquidity delta.
 func (k Keeper) initOrUpdatePosition(
@@ -44,8 +49,9 @@ func (k Keeper) initOrUpdatePosition(
 	lowerTick, upperTick int64,
 	liquidityDelta sdk.Dec,
 	frozenUntil time.Time,
+	positionId uint64,
 ) (err error) {
-	position, err := k.getOrInitPosition(ctx, poolId, owner, lowerTick, upperTick, frozenUntil)
+	position, err := k.getOrInitPosition(ctx, positionId, poolId, owner, lowerTick, upperTick, frozenUntil)
 	if err != nil {
 		return err
 	}
@@ -61,8 +67,6 @@ func (k Keeper) initOrUpdatePosition(
 
 	position.Liquidity = liquidityAfter
 
-	position.FrozenUntil = frozenUntil
-
 	// TODO: consider deleting position if liquidity becomes zero
 
 	// Create records for relevant uptime accumulators here.
@@ -71,95 +75,213 @@ func (k Keeper) initOrUpdatePosition(
 		return err
 	}
 
+	uptimeGrowthOutside, err := k.getUptimeGrowthOutsideRange(ctx, poolId, lowerTick, upperTick)
+	if err != nil {
+		return err
+	}
+
+	uptimeGrowthInside, err := k.getUptimeGrowthInsideRange(ctx, poolId, lowerTick, upperTick)
+	if err != nil {
+		return err
+	}
+
+	// The change of the position's liquidity in each uptime accumulator, which is the liquidity qualifying for the uptime.
+	uptimeLiquidityDeltas := zeroUptimeLiquidity()
+
+	positionName := string(types.KeyPositionId(positionId))
 	for uptimeIndex, uptime := range types.SupportedUptimes {
-		// We assume every position update requires the position to be frozen for the
-		// min uptime again. Thus, the difference between the position's `FrozenUntil`
-		// and the blocktime when the update happens should be greater than or equal
-		// to the required uptime.
-		if position.FrozenUntil.Sub(ctx.BlockTime()) >= uptime {
-			curUptimeAccum := uptimeAccumulators[uptimeIndex]
-
-			// If a record does not exist for this uptime accumulator, create a new position.
-			// Otherwise, add to existing record.
-			positionName := string(types.KeyFullPosition(poolId, owner, lowerTick, upperTick, frozenUntil))
-			recordExists, err := curUptimeAccum.HasPosition(positionName)
-			if err != nil {
-				return err
-			}
+		curUptimeAccum := uptimeAccumulators[uptimeIndex]
 
-			if !recordExists {
-				err = curUptimeAccum.NewPosition(positionName, position.Liquidity, emptyOptions)
-			} else if !liquidityDelta.IsNegative() {
-				err = curUptimeAccum.AddToPosition(positionName, liquidityDelta)
-			} else {
-				err = curUptimeAccum.RemoveFromPosition(positionName, liquidityDelta.Neg())
-			}
-			if err != nil {
-				return err
+		recordExists, err := curUptimeAccum.HasPosition(positionName)
+		if err != nil {
+			return err
+		}
+
+		if !recordExists {
+			// A position becomes eligible for an uptime's incentives by committing to stay
+			// in the pool for that uptime: the difference between the position's `FrozenUntil`
+			// and the blocktime when the update happens should be greater than or equal
+			// to the required uptime. Eligibility lasts until the freeze ends, at which point
+			// the position's records are removed by `removeUptimeRecordsOfUnfrozenPositions`,
+			// and the position can requalify by extending its freeze when adding liquidity.
+			if position.FrozenUntil.Sub(ctx.BlockTime()) >= uptime {
+				// The position's accumulator value is initialized to the growth inside of its range
+				// so that it does not earn incentives accrued prior to its creation.
+				err = curUptimeAccum.NewPositionCustomAcc(positionName, position.Liquidity, uptimeGrowthInside[uptimeIndex], emptyOptions)
+				if err != nil {
+					return err
+				}
+				uptimeLiquidityDeltas[uptimeIndex] = position.Liquidity
 			}
+			continue
+		}
+
+		if liquidityDelta.IsZero() {
+			continue
 		}
+		uptimeLiquidityDeltas[uptimeIndex] = liquidityDelta
+
+		// Move the incentives accrued inside of the range so far into the position's unclaimed rewards
+		// before updating its shares, then checkpoint it at the current growth inside of the range.
+		if err := updatePositionToInitValuePlusGrowthOutside(curUptimeAccum, positionName, uptimeGrowthOutside[uptimeIndex]); err != nil {
+			return err
+		}
+		if err := curUptimeAccum.UpdatePosition(positionName, liquidityDelta); err != nil {
+			return err
+		}
+		if err := curUptimeAccum.SetPositionIntervalAccumulation(positionName, uptimeGrowthInside[uptimeIndex]); err != nil {
+			return err
+		}
+	}
+
+	if err := k.updateUptimeLiquidityForPosition(ctx, poolId, lowerTick, upperTick, uptimeLiquidityDeltas); err != nil {
+		return err
 	}
 
-	k.setPosition(ctx, poolId, owner, lowerTick, upperTick, position, frozenUntil)
+	k.setPosition(ctx, position)
 	return nil
 }
 
-func (k Keeper) hasFullPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, frozenUntil time.Time) bool {
+func (k Keeper) hasPosition(ctx sdk.Context, positionId uint64) bool {
 	store := ctx.KVStore(k.storeKey)
-	key := types.KeyFullPosition(poolId, owner, lowerTick, upperTick, frozenUntil)
-	return store.Has(key)
+	return store.Has(types.KeyPositionId(positionId))
 }
 
-// GetPosition checks if a position exists at the provided upper and lower ticks and frozenUntil time for the given owner. Returns position if found.
-func (k Keeper) GetPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, frozenUntil time.Time) (*model.Position, error) {
+// GetPosition returns the position with the given id. Returns error if the position does not exist.
+func (k Keeper) GetPosition(ctx sdk.Context, positionId uint64) (model.Position, error) {
 	store := ctx.KVStore(k.storeKey)
-	positionStruct := &model.Position{}
-	key := types.KeyFullPosition(poolId, owner, lowerTick, upperTick, frozenUntil)
+	position := model.Position{}
 
-	found, err := osmoutils.Get(store, key, positionStruct)
+	found, err := osmoutils.Get(store, types.KeyPositionId(positionId), &position)
 	if err != nil {
-		return nil, err
+		return model.Position{}, err
 	}
 
 	if !found {
-		return nil, types.PositionNotFoundError{PoolId: poolId, LowerTick: lowerTick, UpperTick: upperTick, FrozenUntil: frozenUntil}
+		return model.Position{}, types.PositionNotFoundError{PositionId: positionId}
 	}
 
-	return positionStruct, nil
+	return position, nil
 }
 
-// GetUserPositions gets all the existing user positions across many pools.
-func (k Keeper) GetUserPositions(ctx sdk.Context, addr sdk.AccAddress) ([]types.FullPositionByOwnerResult, error) {
-	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), types.KeyUserPositions(addr), ParseFullPositionFromBytes)
+// GetUserPositions gets all the existing positions of the given user.
+// If poolId is non-zero, only the positions in that pool are returned. Otherwise, positions across all pools are returned.
+func (k Keeper) GetUserPositions(ctx sdk.Context, addr sdk.AccAddress, poolId uint64) ([]model.Position, error) {
+	prefix := types.KeyUserPositions(addr)
+	if poolId != 0 {
+		prefix = types.KeyAddressAndPoolId(addr, poolId)
+	}
+	return k.getPositionsFromIndex(ctx, prefix)
 }
 
-// ParsePositionFromBz parses bytes into a position struct. Returns a parsed position and nil on success.
-// Returns error if bytes length is zero or if fails to parse the given bytes into the position struct.
-func (k Keeper) setPosition(ctx sdk.Context,
-	poolId uint64,
-	owner sdk.AccAddress,
-	lowerTick, upperTick int64,
-	position *model.Position,
-	frozenUntil time.Time,
-) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.KeyFullPosition(poolId, owner, lowerTick, upperTick, frozenUntil)
-	osmoutils.MustSet(store, key, position)
+// GetPoolPositions gets all the existing positions in the given pool.
+func (k Keeper) GetPoolPositions(ctx sdk.Context, poolId uint64) ([]model.Position, error) {
+	return k.getPositionsFromIndex(ctx, types.KeyPoolPosition(poolId))
 }
 
-func (k Keeper) deletePosition(ctx sdk.Context,
-	poolId uint64,
-	owner sdk.AccAddress,
-	lowerTick, upperTick int64,
-	frozenUntil time.Time,
-) error {
+// getPositionsFromIndex returns the positions whose ids are stored under the given index prefix.
+func (k Keeper) getPositionsFromIndex(ctx sdk.Context, indexPrefix []byte) ([]model.Position, error) {
+	positionIds, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), indexPrefix, ParsePositionIdFromBz)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([]model.Position, 0, len(positionIds))
+	for _, positionId := range positionIds {
+		position, err := k.GetPosition(ctx, positionId)
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, position)
+	}
+	return positions, nil
+}
+
+// setPosition stores the given position by its id and updates the owner, pool and freeze indexes.
+func (k Keeper) setPosition(ctx sdk.Context, position model.Position) {
 	store := ctx.KVStore(k.storeKey)
-	key := types.KeyFullPosition(poolId, owner, lowerTick, upperTick, frozenUntil)
+	owner := sdk.MustAccAddressFromBech32(position.Address)
+	positionIdBz := sdk.Uint64ToBigEndian(position.PositionId)
+
+	// A position's freeze can be extended, in which case its previous freeze index entry is stale.
+	if previousPosition, err := k.GetPosition(ctx, position.PositionId); err == nil && !previousPosition.FrozenUntil.Equal(position.FrozenUntil) {
+		store.Delete(types.KeyFrozenUntilPositionId(previousPosition.FrozenUntil, position.PositionId))
+	}
+
+	osmoutils.MustSet(store, types.KeyPositionId(position.PositionId), &position)
+	store.Set(types.KeyAddressPoolIdPositionId(owner, position.PoolId, position.PositionId), positionIdBz)
+	store.Set(types.KeyPoolPositionPositionId(position.PoolId, position.PositionId), positionIdBz)
+	store.Set(types.KeyFrozenUntilPositionId(position.FrozenUntil, position.PositionId), positionIdBz)
+}
 
-	if !store.Has(key) {
-		return types.PositionNotFoundError{PoolId: poolId, LowerTick: lowerTick, UpperTick: upperTick, FrozenUntil: frozenUntil}
+// deletePosition removes the position with the given id together with its owner and pool index entries.
+// Returns error if the position does not exist.
+func (k Keeper) deletePosition(ctx sdk.Context, positionId uint64) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
 	}
 
-	store.Delete(key)
+	store := ctx.KVStore(k.storeKey)
+	owner := sdk.MustAccAddressFromBech32(position.Address)
+
+	store.Delete(types.KeyPositionId(positionId))
+	store.Delete(types.KeyAddressPoolIdPositionId(owner, position.PoolId, positionId))
+	store.Delete(types.KeyPoolPositionPositionId(position.PoolId, positionId))
+	store.Delete(types.KeyFrozenUntilPositionId(position.FrozenUntil, positionId))
 	return nil
 }
+
+// getPositionIdsWithEndedFreeze returns the ids of the positions frozen until the given time or earlier
+// that are still in the freeze index, in chronological order of their freeze ends.
+func (k Keeper) getPositionIdsWithEndedFreeze(ctx sdk.Context, blockTime time.Time) ([]uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.PositionFrozenUntilPrefix, sdk.PrefixEndBytes(types.KeyFrozenUntil(blockTime)))
+	defer iterator.Close()
+
+	positionIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		positionId, err := ParsePositionIdFromBz(iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		positionIds = append(positionIds, positionId)
+	}
+	return positionIds, nil
+}
+
+// getNextPositionIdAndIncrement returns the next position id and increments the stored counter.
+// Position ids start at 1.
+func (k Keeper) getNextPositionIdAndIncrement(ctx sdk.Context) uint64 {
+	nextPositionId := k.getNextPositionId(ctx)
+	k.setNextPositionId(ctx, nextPositionId+1)
+	return nextPositionId
+}
+
+// getNextPositionId returns the id that will be assigned to the next created position.
+// Position ids start at 1.
+func (k Keeper) getNextPositionId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.NextPositionIdKey); bz != nil {
+		return sdk.BigEndianToUint64(bz)
+	}
+	return 1
+}
+
+// setNextPositionId sets the id that will be assigned to the next created position.
+func (k Keeper) setNextPositionId(ctx sdk.Context, nextPositionId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextPositionIdKey, sdk.Uint64ToBigEndian(nextPositionId))
+}
+
+// getPositionOwnedBy returns the position with the given id, ensuring that it belongs to the given owner.
+func (k Keeper) getPositionOwnedBy(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (model.Position, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return model.Position{}, err
+	}
+	if position.Address != owner.String() {
+		return model.Position{}, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+	return position, nil
+}
@@ -10,7 +10,10 @@ import (
 )
 
 func (s *KeeperTestSuite) TestInitOrUpdatePosition() {
-	const validPoolId = 1
+	const (
+		validPoolId     = 1
+		validPositionId = 1
+	)
 	defaultFrozenUntil := s.Ctx.BlockTime().Add(DefaultFreezeDuration)
 	type param struct {
 		poolId         uint64
@@ -97,13 +100,13 @@ func (s *KeeperTestSuite) TestInitOrUpdatePosition() {
 			// If positionExists set, initialize the specified position with defaultLiquidityAmt
 			preexistingLiquidity := sdk.ZeroDec()
 			if test.positionExists {
-				err := s.App.ConcentratedLiquidityKeeper.InitOrUpdatePosition(s.Ctx, test.param.poolId, s.TestAccs[0], test.param.lowerTick, test.param.upperTick, test.param.liquidityDelta, test.param.frozenUntil)
+				err := s.App.ConcentratedLiquidityKeeper.InitOrUpdatePosition(s.Ctx, test.param.poolId, s.TestAccs[0], test.param.lowerTick, test.param.upperTick, test.param.liquidityDelta, test.param.frozenUntil, validPositionId)
 				s.Require().NoError(err)
 				preexistingLiquidity = DefaultLiquidityAmt
 			}
 
-			// Get the position info for poolId 1
-			positionInfo, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, validPoolId, s.TestAccs[0], test.param.lowerTick, test.param.upperTick, test.param.frozenUntil)
+			// Get the position info for the position id
+			positionInfo, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, validPositionId)
 			if test.positionExists {
 				// If we had a position before, ensure the position info displays proper liquidity
 				s.Require().NoError(err)
@@ -111,11 +114,11 @@ func (s *KeeperTestSuite) TestInitOrUpdatePosition() {
 			} else {
 				// If we did not have a position before, ensure getting the non-existent position returns an error
 				s.Require().Error(err)
-				s.Require().ErrorContains(err, types.PositionNotFoundError{PoolId: validPoolId, LowerTick: test.param.lowerTick, UpperTick: test.param.upperTick}.Error())
+				s.Require().ErrorIs(err, types.PositionNotFoundError{PositionId: validPositionId})
 			}
 
 			// System under test. Initialize or update the position according to the test case
-			err = s.App.ConcentratedLiquidityKeeper.InitOrUpdatePosition(s.Ctx, test.param.poolId, s.TestAccs[0], test.param.lowerTick, test.param.upperTick, test.param.liquidityDelta, test.param.frozenUntil)
+			err = s.App.ConcentratedLiquidityKeeper.InitOrUpdatePosition(s.Ctx, test.param.poolId, s.TestAccs[0], test.param.lowerTick, test.param.upperTick, test.param.liquidityDelta, test.param.frozenUntil, validPositionId)
 			if test.expectedErr != nil {
 				s.Require().Error(err)
 				s.Require().ErrorContains(err, test.expectedErr.Error())
@@ -123,15 +126,19 @@ func (s *KeeperTestSuite) TestInitOrUpdatePosition() {
 			}
 			s.Require().NoError(err)
 
-			// Get the tick info for poolId 1
-			positionInfo, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, validPoolId, s.TestAccs[0], test.param.lowerTick, test.param.upperTick, test.param.frozenUntil)
+			// Get the position info for the position id
+			positionInfo, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, validPositionId)
 			s.Require().NoError(err)
 
 			// Check that the initialized or updated position matches our expectation
 			s.Require().Equal(test.expectedLiquidity, positionInfo.Liquidity)
+			s.Require().Equal(s.TestAccs[0].String(), positionInfo.Address)
+			s.Require().Equal(test.param.poolId, positionInfo.PoolId)
+			s.Require().Equal(test.param.lowerTick, positionInfo.LowerTick)
+			s.Require().Equal(test.param.upperTick, positionInfo.UpperTick)
 
 			// Check that the relevant uptime accumulators were properly checkpointed
-			positionName := string(types.KeyFullPosition(validPoolId, s.TestAccs[0], test.param.lowerTick, test.param.upperTick, test.param.frozenUntil))
+			positionName := string(types.KeyPositionId(validPositionId))
 			uptimeAccums, err := s.App.ConcentratedLiquidityKeeper.GetUptimeAccumulators(s.Ctx, test.param.poolId)
 			s.Require().NoError(err)
 
@@ -153,55 +160,26 @@ func (s *KeeperTestSuite) TestGetPosition() {
 	defaultFrozenUntil := s.Ctx.BlockTime().Add(DefaultFreezeDuration)
 	tests := []struct {
 		name             string
-		poolToGet        uint64
-		ownerIndex       uint64
-		lowerTick        int64
-		upperTick        int64
-		frozenUntil      time.Time
-		expectedPosition *model.Position
+		positionId       uint64
+		expectedPosition model.Position
 		expectedErr      error
 	}{
 		{
-			name:             "Get position info on existing pool and existing position",
-			poolToGet:        validPoolId,
-			lowerTick:        DefaultLowerTick,
-			upperTick:        DefaultUpperTick,
-			frozenUntil:      defaultFrozenUntil,
-			expectedPosition: &model.Position{Liquidity: DefaultLiquidityAmt, FrozenUntil: defaultFrozenUntil},
-		},
-		{
-			name:        "Get position info on existing pool and existing position but wrong owner",
-			poolToGet:   validPoolId,
-			ownerIndex:  1,
-			lowerTick:   DefaultLowerTick,
-			upperTick:   DefaultUpperTick,
-			frozenUntil: defaultFrozenUntil,
-			expectedErr: types.PositionNotFoundError{PoolId: validPoolId, LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick, FrozenUntil: defaultFrozenUntil},
-		},
-		{
-			name:        "Get position info on existing pool and existing position but wrong frozenUntil time",
-			poolToGet:   validPoolId,
-			ownerIndex:  1,
-			lowerTick:   DefaultLowerTick,
-			upperTick:   DefaultUpperTick,
-			frozenUntil: defaultFrozenUntil.Add(time.Second),
-			expectedErr: types.PositionNotFoundError{PoolId: validPoolId, LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick, FrozenUntil: defaultFrozenUntil.Add(time.Second)},
-		},
-		{
-			name:        "Get position info on existing pool with no existing position",
-			poolToGet:   validPoolId,
-			lowerTick:   DefaultLowerTick - 1,
-			upperTick:   DefaultUpperTick + 1,
-			frozenUntil: defaultFrozenUntil,
-			expectedErr: types.PositionNotFoundError{PoolId: validPoolId, LowerTick: DefaultLowerTick - 1, UpperTick: DefaultUpperTick + 1, FrozenUntil: defaultFrozenUntil},
+			name:       "Get position info on existing pool and existing position",
+			positionId: 1,
+			expectedPosition: model.Position{
+				PositionId:  1,
+				PoolId:      validPoolId,
+				LowerTick:   DefaultLowerTick,
+				UpperTick:   DefaultUpperTick,
+				FrozenUntil: defaultFrozenUntil,
+				Liquidity:   DefaultLiquidityAmt,
+			},
 		},
 		{
-			name:        "Get position info on a non-existing pool with no existing position",
-			poolToGet:   2,
-			lowerTick:   DefaultLowerTick - 1,
-			upperTick:   DefaultUpperTick + 1,
-			frozenUntil: defaultFrozenUntil,
-			expectedErr: types.PositionNotFoundError{PoolId: 2, LowerTick: DefaultLowerTick - 1, UpperTick: DefaultUpperTick + 1, FrozenUntil: defaultFrozenUntil},
+			name:        "Get position info with no existing position",
+			positionId:  2,
+			expectedErr: types.PositionNotFoundError{PositionId: 2},
 		},
 	}
 
@@ -214,23 +192,27 @@ func (s *KeeperTestSuite) TestGetPosition() {
 			s.PrepareConcentratedPool()
 
 			// Set up a default initialized position
-			err := s.App.ConcentratedLiquidityKeeper.InitOrUpdatePosition(s.Ctx, validPoolId, s.TestAccs[0], DefaultLowerTick, DefaultUpperTick, DefaultLiquidityAmt, defaultFrozenUntil)
+			err := s.App.ConcentratedLiquidityKeeper.InitOrUpdatePosition(s.Ctx, validPoolId, s.TestAccs[0], DefaultLowerTick, DefaultUpperTick, DefaultLiquidityAmt, defaultFrozenUntil, 1)
+			s.Require().NoError(err)
 
 			// System under test
-			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, test.poolToGet, s.TestAccs[test.ownerIndex], test.lowerTick, test.upperTick, test.frozenUntil)
+			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, test.positionId)
 			if test.expectedErr != nil {
 				s.Require().Error(err)
 				s.Require().ErrorIs(err, test.expectedErr)
-				s.Require().Nil(position)
+				s.Require().Equal(model.Position{}, position)
 			} else {
 				s.Require().NoError(err)
-				s.Require().Equal(test.expectedPosition, position)
+				// The owner is only known once the test accounts are set up.
+				expectedPosition := test.expectedPosition
+				expectedPosition.Address = s.TestAccs[0].String()
+				s.Require().Equal(expectedPosition, position)
 			}
 		})
 	}
 }
 
-func (s *KeeperTestSuite) TestGetAllUserPositions() {
+func (s *KeeperTestSuite) TestGetUserPositions() {
 	defaultAddress := s.TestAccs[0]
 	secondAddress := s.TestAccs[1]
 
@@ -246,6 +228,7 @@ func (s *KeeperTestSuite) TestGetAllUserPositions() {
 	tests := []struct {
 		name           string
 		sender         sdk.AccAddress
+		poolId         uint64
 		setupPositions []position
 		expectedErr    error
 	}{
@@ -265,6 +248,14 @@ func (s *KeeperTestSuite) TestGetAllUserPositions() {
 				{1, defaultAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick + 2, DefaultUpperTick + 2},
 			},
 		},
+		{
+			name:   "Get current users multiple position same pool and same range",
+			sender: defaultAddress,
+			setupPositions: []position{
+				{1, defaultAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick},
+				{1, defaultAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick},
+			},
+		},
 		{
 			name:   "Get current users multiple position multiple pools",
 			sender: secondAddress,
@@ -274,6 +265,26 @@ func (s *KeeperTestSuite) TestGetAllUserPositions() {
 				{3, secondAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick + 2, DefaultUpperTick + 2},
 			},
 		},
+		{
+			name:   "Get current users positions filtered by pool",
+			sender: secondAddress,
+			poolId: 2,
+			setupPositions: []position{
+				{1, secondAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick},
+				{2, secondAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick + 1, DefaultUpperTick + 1},
+				{2, secondAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick + 2, DefaultUpperTick + 2},
+				{3, secondAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick + 2, DefaultUpperTick + 2},
+			},
+		},
+		{
+			name:   "Get current users positions ignoring other users' positions",
+			sender: defaultAddress,
+			setupPositions: []position{
+				{1, defaultAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick},
+				{1, secondAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick},
+				{2, defaultAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick + 1, DefaultUpperTick + 1},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -284,85 +295,67 @@ func (s *KeeperTestSuite) TestGetAllUserPositions() {
 			// Create a default CL pools
 			s.PrepareMultipleConcentratedPools(3)
 
-			expectedUserPositions := []types.FullPositionByOwnerResult{}
+			expectedUserPositions := []model.Position{}
 			for _, pos := range test.setupPositions {
 				// if position does not exist this errors
 				position := s.SetupPosition(pos.poolId, pos.acc, pos.coin0, pos.coin1, pos.lowerTick, pos.upperTick, s.Ctx.BlockTime().Add(DefaultFreezeDuration))
-				if pos.acc.Equals(pos.acc) {
-					expectedUserPositions = append(expectedUserPositions, types.FullPositionByOwnerResult{
-						PoolId:      pos.poolId,
-						LowerTick:   pos.lowerTick,
-						UpperTick:   pos.upperTick,
-						FrozenUntil: s.Ctx.BlockTime().Add(DefaultFreezeDuration),
-						Liquidity:   position.Liquidity,
-					})
+				if pos.acc.Equals(test.sender) && (test.poolId == 0 || test.poolId == pos.poolId) {
+					expectedUserPositions = append(expectedUserPositions, position)
 				}
 			}
 
 			// System under test
-			position, err := s.App.ConcentratedLiquidityKeeper.GetUserPositions(s.Ctx, test.sender)
+			positions, err := s.App.ConcentratedLiquidityKeeper.GetUserPositions(s.Ctx, test.sender, test.poolId)
 			if test.expectedErr != nil {
 				s.Require().Error(err)
 				s.Require().ErrorIs(err, test.expectedErr)
-				s.Require().Nil(position)
+				s.Require().Nil(positions)
 			} else {
 				s.Require().NoError(err)
-				s.Require().Equal(expectedUserPositions, position)
+				s.Require().Equal(expectedUserPositions, positions)
 			}
 		})
 	}
 }
 
+func (s *KeeperTestSuite) TestGetPoolPositions() {
+	defaultAddress := s.TestAccs[0]
+	secondAddress := s.TestAccs[1]
+
+	s.Setup()
+	s.PrepareMultipleConcentratedPools(2)
+
+	// Set up positions of multiple owners in multiple pools.
+	firstPosition := s.SetupPosition(1, defaultAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+	s.SetupPosition(2, defaultAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+	secondPosition := s.SetupPosition(1, secondAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick+1, DefaultUpperTick+1, s.Ctx.BlockTime())
+
+	// System under test
+	positions, err := s.App.ConcentratedLiquidityKeeper.GetPoolPositions(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal([]model.Position{firstPosition, secondPosition}, positions)
+
+	// A pool without positions returns an empty list.
+	positions, err = s.App.ConcentratedLiquidityKeeper.GetPoolPositions(s.Ctx, 3)
+	s.Require().NoError(err)
+	s.Require().Empty(positions)
+}
+
 func (s *KeeperTestSuite) TestDeletePosition() {
 	defaultFrozenUntil := s.Ctx.BlockTime().Add(DefaultFreezeDuration)
 	tests := []struct {
 		name        string
-		poolToGet   uint64
-		ownerIndex  uint64
-		lowerTick   int64
-		upperTick   int64
-		frozenUntil time.Time
+		positionId  uint64
 		expectedErr error
 	}{
 		{
-			name:        "Delete position info on existing pool and existing position",
-			poolToGet:   validPoolId,
-			lowerTick:   DefaultLowerTick,
-			upperTick:   DefaultUpperTick,
-			frozenUntil: defaultFrozenUntil,
-		},
-		{
-			name:        "Delete position on existing pool and existing position but wrong owner",
-			poolToGet:   validPoolId,
-			ownerIndex:  1,
-			lowerTick:   DefaultLowerTick,
-			upperTick:   DefaultUpperTick,
-			frozenUntil: defaultFrozenUntil,
-			expectedErr: types.PositionNotFoundError{PoolId: validPoolId, LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick, FrozenUntil: defaultFrozenUntil},
-		},
-		{
-			name:        "Delete position on existing pool and existing position but wrong frozenUntil time",
-			poolToGet:   validPoolId,
-			lowerTick:   DefaultLowerTick,
-			upperTick:   DefaultUpperTick,
-			frozenUntil: defaultFrozenUntil.Add(time.Second),
-			expectedErr: types.PositionNotFoundError{PoolId: validPoolId, LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick, FrozenUntil: defaultFrozenUntil.Add(time.Second)},
+			name:       "Delete position info on existing pool and existing position",
+			positionId: 1,
 		},
 		{
-			name:        "Delete position on existing pool with no existing position",
-			poolToGet:   validPoolId,
-			lowerTick:   DefaultLowerTick - 1,
-			upperTick:   DefaultUpperTick + 1,
-			frozenUntil: defaultFrozenUntil,
-			expectedErr: types.PositionNotFoundError{PoolId: validPoolId, LowerTick: DefaultLowerTick - 1, UpperTick: DefaultUpperTick + 1, FrozenUntil: defaultFrozenUntil},
-		},
-		{
-			name:        "Delete position on a non-existing pool with no existing position",
-			poolToGet:   2,
-			lowerTick:   DefaultLowerTick - 1,
-			upperTick:   DefaultUpperTick + 1,
-			frozenUntil: defaultFrozenUntil,
-			expectedErr: types.PositionNotFoundError{PoolId: 2, LowerTick: DefaultLowerTick - 1, UpperTick: DefaultUpperTick + 1, FrozenUntil: defaultFrozenUntil},
+			name:        "Delete position with no existing position",
+			positionId:  2,
+			expectedErr: types.PositionNotFoundError{PositionId: 2},
 		},
 	}
 
@@ -375,10 +368,10 @@ func (s *KeeperTestSuite) TestDeletePosition() {
 			s.PrepareConcentratedPool()
 
 			// Set up a default initialized position
-			err := s.App.ConcentratedLiquidityKeeper.InitOrUpdatePosition(s.Ctx, validPoolId, s.TestAccs[0], DefaultLowerTick, DefaultUpperTick, DefaultLiquidityAmt, defaultFrozenUntil)
+			err := s.App.ConcentratedLiquidityKeeper.InitOrUpdatePosition(s.Ctx, validPoolId, s.TestAccs[0], DefaultLowerTick, DefaultUpperTick, DefaultLiquidityAmt, defaultFrozenUntil, 1)
 			s.Require().NoError(err)
 
-			err = s.App.ConcentratedLiquidityKeeper.DeletePosition(s.Ctx, test.poolToGet, s.TestAccs[test.ownerIndex], test.lowerTick, test.upperTick, test.frozenUntil)
+			err = s.App.ConcentratedLiquidityKeeper.DeletePosition(s.Ctx, test.positionId)
 			if test.expectedErr != nil {
 				s.Require().Error(err)
 				s.Require().ErrorIs(err, test.expectedErr)
@@ -386,11 +379,28 @@ func (s *KeeperTestSuite) TestDeletePosition() {
 				s.Require().NoError(err)
 
 				// Since the position is deleted, retrieving it should return an error.
-				position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, test.poolToGet, s.TestAccs[test.ownerIndex], test.lowerTick, test.upperTick, test.frozenUntil)
+				_, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, test.positionId)
 				s.Require().Error(err)
-				s.Require().ErrorIs(err, types.PositionNotFoundError{PoolId: test.poolToGet, LowerTick: test.lowerTick, UpperTick: test.upperTick, FrozenUntil: test.frozenUntil})
-				s.Require().Nil(position)
+				s.Require().ErrorIs(err, types.PositionNotFoundError{PositionId: test.positionId})
+
+				// The position is also removed from the owner and pool indexes.
+				userPositions, err := s.App.ConcentratedLiquidityKeeper.GetUserPositions(s.Ctx, s.TestAccs[0], 0)
+				s.Require().NoError(err)
+				s.Require().Empty(userPositions)
+
+				poolPositions, err := s.App.ConcentratedLiquidityKeeper.GetPoolPositions(s.Ctx, validPoolId)
+				s.Require().NoError(err)
+				s.Require().Empty(poolPositions)
 			}
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGetNextPositionIdAndIncrement() {
+	s.Setup()
+
+	// Position ids start at 1 and increase monotonically.
+	for expectedPositionId := uint64(1); expectedPositionId <= 3; expectedPositionId++ {
+		s.Require().Equal(expectedPositionId, s.App.ConcentratedLiquidityKeeper.GetNextPositionIdAndIncrement(s.Ctx))
+	}
+}
@@ -21,6 +21,17 @@ type SwapState struct {
 	tick                     sdk.Int // new tick when swap is done
 	liquidity                sdk.Dec // new liquidity when swap is done
 	feeGrowthGlobal          sdk.Dec // global fee growth per-swap
+	// uptimeLiquidityDeltas is the change of the liquidity qualifying for each supported uptime
+	// from the ticks crossed by the swap.
+	uptimeLiquidityDeltas []sdk.Dec
+}
+
+// crossUptimeLiquidity adds the qualifying liquidity nets of a crossed tick to the swap state's uptime liquidity deltas.
+// The nets must already have the sign matching the swap direction.
+func (ss *SwapState) crossUptimeLiquidity(uptimeLiquidityNets []sdk.Dec) {
+	for uptimeIndex, liquidityNet := range uptimeLiquidityNets {
+		ss.uptimeLiquidityDeltas[uptimeIndex] = ss.uptimeLiquidityDeltas[uptimeIndex].Add(liquidityNet)
+	}
 }
 
 // updateFeeGrowthGlobal updates the swap state's fee growth global per unit of liquidity
@@ -69,27 +80,86 @@ func (k Keeper) SwapExactAmountIn(
 	} else {
 		priceLimit = types.MaxSpotPrice
 	}
-	tokenIn, tokenOut, newCurrentTick, newLiquidity, newSqrtPrice, err := k.SwapOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee, priceLimit, pool.GetId())
+
+	_, tokenOut, err := k.swapOutGivenInWithPoolPriceLimit(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee, priceLimit)
 	if err != nil {
 		return sdk.Int{}, err
 	}
 
+	return tokenOut.Amount, nil
+}
+
+// SwapExactAmountInWithPriceLimit swaps up to tokenIn through the pool, stopping early once the
+// spot price of tokenIn quoted in tokenOutDenom falls to priceLimit. The price limit is converted
+// into the pool's native price limit so that the swap stops at the limit natively, mid-tick if needed.
+// Returns the amount of tokenIn actually swapped and the amount of tokenOut received.
+func (k Keeper) SwapExactAmountInWithPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
+	priceLimit sdk.Dec,
+) (tokenInAmount, tokenOutAmount sdk.Int, err error) {
+	if tokenIn.Denom == tokenOutDenom {
+		return sdk.Int{}, sdk.Int{}, types.DenomDuplicatedError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom}
+	}
+
+	// type cast PoolI to ConcentratedPoolExtension
+	pool, ok := poolI.(types.ConcentratedPoolExtension)
+	if !ok {
+		return sdk.Int{}, sdk.Int{}, fmt.Errorf("pool type (%T) cannot be cast to ConcentratedPoolExtension", poolI)
+	}
+
+	poolPriceLimit, err := toPoolPriceLimit(pool, tokenIn.Denom, priceLimit)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	tokenInUsed, tokenOut, err := k.swapOutGivenInWithPoolPriceLimit(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee, poolPriceLimit)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	return tokenInUsed.Amount, tokenOut.Amount, nil
+}
+
+// swapOutGivenInWithPoolPriceLimit swaps up to tokenIn through the pool, stopping once the pool's price
+// (token1 per token0) reaches priceLimit. It settles balances between the sender and the pool.
+// Returns the tokens actually swapped in and out.
+func (k Keeper) swapOutGivenInWithPoolPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool types.ConcentratedPoolExtension,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
+	priceLimit sdk.Dec,
+) (tokenInUsed, tokenOut sdk.Coin, err error) {
+	tokenInUsed, tokenOut, newCurrentTick, newLiquidity, newSqrtPrice, err := k.SwapOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee, priceLimit, pool.GetId())
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
 	// check that the tokenOut calculated is both valid and less than specified limit
-	tokenOutAmount = tokenOut.Amount
+	tokenOutAmount := tokenOut.Amount
 	if !tokenOutAmount.IsPositive() {
-		return sdk.Int{}, fmt.Errorf("token amount must be positive: got %v", tokenOutAmount)
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("token amount must be positive: got %v", tokenOutAmount)
 	}
 	if tokenOutAmount.LT(tokenOutMinAmount) {
-		return sdk.Int{}, types.AmountLessThanMinError{TokenAmount: tokenOutAmount, TokenMin: tokenOutMinAmount}
+		return sdk.Coin{}, sdk.Coin{}, types.AmountLessThanMinError{TokenAmount: tokenOutAmount, TokenMin: tokenOutMinAmount}
 	}
 
 	// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 	// Also emits swap event and updates related liquidity metrics
-	if err := k.updatePoolForSwap(ctx, poolI, sender, tokenIn, tokenOut, newCurrentTick, newLiquidity, newSqrtPrice); err != nil {
-		return sdk.Int{}, err
+	if err := k.updatePoolForSwap(ctx, pool, sender, tokenInUsed, tokenOut, newCurrentTick, newLiquidity, newSqrtPrice); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
-	return tokenOutAmount, nil
+	return tokenInUsed, tokenOut, nil
 }
 
 func (k Keeper) SwapExactAmountOut(
@@ -122,27 +192,86 @@ func (k Keeper) SwapExactAmountOut(
 	} else {
 		priceLimit = types.MaxSpotPrice
 	}
-	tokenIn, tokenOut, newCurrentTick, newLiquidity, newSqrtPrice, err := k.SwapInAmtGivenOut(ctx, tokenOut, tokenInDenom, swapFee, priceLimit, pool.GetId())
+
+	tokenIn, _, err := k.swapInGivenOutWithPoolPriceLimit(ctx, sender, pool, tokenInDenom, tokenInMaxAmount, tokenOut, swapFee, priceLimit)
 	if err != nil {
 		return sdk.Int{}, err
 	}
 
-	// check that the tokenOut calculated is both valid and less than specified limit
-	tokenInAmount = tokenIn.Amount
+	return tokenIn.Amount, nil
+}
+
+// SwapExactAmountOutWithPriceLimit swaps for up to tokenOut through the pool, stopping early once the
+// spot price of tokenInDenom quoted in tokenOut's denom reaches priceLimit. The price limit is converted
+// into the pool's native price limit so that the swap stops at the limit natively, mid-tick if needed.
+// Returns the amount of tokenIn paid and the amount of tokenOut actually received.
+func (k Keeper) SwapExactAmountOutWithPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolI poolmanagertypes.PoolI,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
+	priceLimit sdk.Dec,
+) (tokenInAmount, tokenOutAmount sdk.Int, err error) {
+	if tokenOut.Denom == tokenInDenom {
+		return sdk.Int{}, sdk.Int{}, types.DenomDuplicatedError{TokenInDenom: tokenInDenom, TokenOutDenom: tokenOut.Denom}
+	}
+
+	// type cast PoolI to ConcentratedPoolExtension
+	pool, ok := poolI.(types.ConcentratedPoolExtension)
+	if !ok {
+		return sdk.Int{}, sdk.Int{}, fmt.Errorf("pool type (%T) cannot be cast to ConcentratedPoolExtension", poolI)
+	}
+
+	poolPriceLimit, err := toPoolPriceLimit(pool, tokenInDenom, priceLimit)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	tokenIn, tokenOutReceived, err := k.swapInGivenOutWithPoolPriceLimit(ctx, sender, pool, tokenInDenom, tokenInMaxAmount, tokenOut, swapFee, poolPriceLimit)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	return tokenIn.Amount, tokenOutReceived.Amount, nil
+}
+
+// swapInGivenOutWithPoolPriceLimit swaps for up to tokenOut through the pool, stopping once the pool's price
+// (token1 per token0) reaches priceLimit. It settles balances between the sender and the pool.
+// Returns the tokens actually swapped in and out.
+func (k Keeper) swapInGivenOutWithPoolPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool types.ConcentratedPoolExtension,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	desiredTokenOut sdk.Coin,
+	swapFee sdk.Dec,
+	priceLimit sdk.Dec,
+) (tokenIn, tokenOut sdk.Coin, err error) {
+	tokenIn, tokenOut, newCurrentTick, newLiquidity, newSqrtPrice, err := k.SwapInAmtGivenOut(ctx, desiredTokenOut, tokenInDenom, swapFee, priceLimit, pool.GetId())
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// check that the tokenIn calculated is both valid and less than specified limit
+	tokenInAmount := tokenIn.Amount
 	if !tokenInAmount.IsPositive() {
-		return sdk.Int{}, fmt.Errorf("token amount must be positive: got %v", tokenInAmount)
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("token amount must be positive: got %v", tokenInAmount)
 	}
 	if tokenInAmount.GT(tokenInMaxAmount) {
-		return sdk.Int{}, types.AmountGreaterThanMaxError{TokenAmount: tokenInAmount, TokenMax: tokenInMaxAmount}
+		return sdk.Coin{}, sdk.Coin{}, types.AmountGreaterThanMaxError{TokenAmount: tokenInAmount, TokenMax: tokenInMaxAmount}
 	}
 
 	// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 	// Also emits swap event and updates related liquidity metrics
-	if err := k.updatePoolForSwap(ctx, poolI, sender, tokenIn, tokenOut, newCurrentTick, newLiquidity, newSqrtPrice); err != nil {
-		return sdk.Int{}, err
+	if err := k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut, newCurrentTick, newLiquidity, newSqrtPrice); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
-	return tokenInAmount, nil
+	return tokenIn, tokenOut, nil
 }
 
 // SwapOutAmtGivenIn is the internal mutative method for CalcOutAmtGivenIn. Utilizing CalcOutAmtGivenIn's output, this function applies the
@@ -227,6 +356,131 @@ func (k Keeper) CalcInAmtGivenOut(
 	return tokenIn, nil
 }
 
+// SimulateSwapExactAmountIn applies swapping tokenIn through the pool to the pool's state without
+// transferring any tokens, and returns the resulting tokenOut. It is only meant to be called on a
+// cache context that is never written.
+func (k Keeper) SimulateSwapExactAmountIn(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+) (tokenOut sdk.Coin, err error) {
+	_, tokenOut, _, _, _, err = k.SwapOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee, sdk.ZeroDec(), poolI.GetId())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return tokenOut, nil
+}
+
+// SimulateSwapExactAmountOut applies swapping for tokenOut through the pool to the pool's state without
+// transferring any tokens, and returns the required tokenIn. It is only meant to be called on a
+// cache context that is never written.
+func (k Keeper) SimulateSwapExactAmountOut(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (tokenIn sdk.Coin, err error) {
+	tokenIn, _, _, _, _, err = k.SwapInAmtGivenOut(ctx, tokenOut, tokenInDenom, swapFee, sdk.ZeroDec(), poolI.GetId())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return tokenIn, nil
+}
+
+// CalcOutAmtGivenInWithPriceLimit calculates how much of tokenIn can be swapped before the spot price
+// of tokenIn quoted in tokenOutDenom falls to priceLimit, along with the resulting tokenOut.
+// This method is non-mutative.
+func (k Keeper) CalcOutAmtGivenInWithPriceLimit(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+	priceLimit sdk.Dec,
+) (tokenInUsed, tokenOut sdk.Coin, err error) {
+	pool, ok := poolI.(types.ConcentratedPoolExtension)
+	if !ok {
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("pool type (%T) cannot be cast to ConcentratedPoolExtension", poolI)
+	}
+
+	poolPriceLimit, err := toPoolPriceLimit(pool, tokenIn.Denom, priceLimit)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	_, tokenInUsed, tokenOut, _, _, _, err = k.calcOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee, poolPriceLimit, pool.GetId())
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	return tokenInUsed, tokenOut, nil
+}
+
+// CalcInAmtGivenOutWithPriceLimit calculates how much of tokenOut can be swapped for before the spot price
+// of tokenInDenom quoted in tokenOut's denom reaches priceLimit, along with the tokenIn required.
+// This method is non-mutative.
+func (k Keeper) CalcInAmtGivenOutWithPriceLimit(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+	priceLimit sdk.Dec,
+) (tokenIn, tokenOutUsed sdk.Coin, err error) {
+	pool, ok := poolI.(types.ConcentratedPoolExtension)
+	if !ok {
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("pool type (%T) cannot be cast to ConcentratedPoolExtension", poolI)
+	}
+
+	poolPriceLimit, err := toPoolPriceLimit(pool, tokenInDenom, priceLimit)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	_, tokenIn, tokenOutUsed, _, _, _, err = k.calcInAmtGivenOut(ctx, tokenOut, tokenInDenom, swapFee, poolPriceLimit, pool.GetId())
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	return tokenIn, tokenOutUsed, nil
+}
+
+// toPoolPriceLimit converts a price limit expressed as the spot price of tokenInDenom quoted in the
+// other pool asset into the pool's native price limit, which is always the price of token0 in token1.
+// Errors if the price limit is not positive.
+func toPoolPriceLimit(pool types.ConcentratedPoolExtension, tokenInDenom string, priceLimit sdk.Dec) (sdk.Dec, error) {
+	if priceLimit.IsNil() || !priceLimit.IsPositive() {
+		return sdk.Dec{}, types.NonPositivePriceLimitError{PriceLimit: priceLimit}
+	}
+	if tokenInDenom == pool.GetToken0() {
+		return priceLimit, nil
+	}
+	return sdk.OneDec().Quo(priceLimit), nil
+}
+
+// SimulateSwapSteps simulates swapping tokenIn for tokenOutDenom through the pool at the pool's swap fee
+// and returns every step of the swap, along with the actual amount of tokenIn swapped and the resulting tokenOut.
+// The steps are computed by the same swap strategy as the actual swap, so replaying them yields the exact
+// swap outcome. This method is non-mutative.
+func (k Keeper) SimulateSwapSteps(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) (tokenInUsed, tokenOut sdk.Coin, swapSteps []types.SwapStep, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, nil, err
+	}
+
+	swapSteps = []types.SwapStep{}
+	recordSwapStep := func(swapStep types.SwapStep) {
+		swapSteps = append(swapSteps, swapStep)
+	}
+
+	_, tokenInUsed, tokenOut, _, _, _, err = k.calcOutAmtGivenInWithSwapSteps(ctx, tokenIn, tokenOutDenom, pool.GetSwapFee(ctx), sdk.ZeroDec(), poolId, recordSwapStep)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, nil, err
+	}
+	return tokenInUsed, tokenOut, swapSteps, nil
+}
+
 // calcOutAmtGivenIn calculates tokens to be swapped out given the provided amount and fee deducted. It also returns
 // what the updated tick, liquidity, and currentSqrtPrice for the pool would be after this swap.
 // Note this method is non-mutative, so the values returned by CalcOutAmtGivenIn do not get stored
@@ -237,8 +491,26 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 	swapFee sdk.Dec,
 	priceLimit sdk.Dec,
 	poolId uint64,
+) (writeCtx func(), tokenIn, tokenOut sdk.Coin, updatedTick sdk.Int, updatedLiquidity, updatedSqrtPrice sdk.Dec, err error) {
+	return k.calcOutAmtGivenInWithSwapSteps(ctx, tokenInMin, tokenOutDenom, swapFee, priceLimit, poolId, nil)
+}
+
+// calcOutAmtGivenInWithSwapSteps is calcOutAmtGivenIn that additionally calls recordSwapStep, if non-nil,
+// with each step of the swap once it is computed.
+func (k Keeper) calcOutAmtGivenInWithSwapSteps(ctx sdk.Context,
+	tokenInMin sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+	priceLimit sdk.Dec,
+	poolId uint64,
+	recordSwapStep func(types.SwapStep),
 ) (writeCtx func(), tokenIn, tokenOut sdk.Coin, updatedTick sdk.Int, updatedLiquidity, updatedSqrtPrice sdk.Dec, err error) {
 	ctx, writeCtx = ctx.CacheContext()
+	// Sync the uptime accumulators prior to the swap so that ticks crossed
+	// below snapshot the up-to-date uptime growth.
+	if err := k.updateUptimeAccumulatorsToNow(ctx, poolId); err != nil {
+		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
+	}
 	p, err := k.getPoolById(ctx, poolId)
 	if err != nil {
 		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
@@ -294,6 +566,7 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 		tick:                     swapStrategy.InitializeTickValue(p.GetCurrentTick()),
 		liquidity:                p.GetLiquidity(),
 		feeGrowthGlobal:          sdk.ZeroDec(),
+		uptimeLiquidityDeltas:    zeroUptimeLiquidity(),
 	}
 
 	// iterate and update swapState until we swap all tokenIn or we reach the specific sqrtPriceLimit
@@ -338,6 +611,16 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 
 		swapState.updateFeeGrowthGlobal(feeChargeTotal)
 
+		// the liquidity the step was computed with is recorded prior to crossing the next tick
+		swapStep := types.SwapStep{
+			SqrtPriceStart: sqrtPriceStart,
+			SqrtPriceNext:  sqrtPrice,
+			Liquidity:      swapState.liquidity,
+			AmountIn:       amountIn,
+			AmountOut:      amountOut,
+			FeeCharge:      feeChargeTotal,
+		}
+
 		// update the swapState with the new sqrtPrice from the above swap
 		swapState.sqrtPrice = sqrtPrice
 		// we deduct the amount of tokens we input in the computeSwapStep above from the user's defined tokenIn amount
@@ -349,17 +632,22 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 		// tick has been consumed and we must move on to the next tick to complete the swap
 		if nextTickSqrtPrice.Equal(sqrtPrice) {
 			// retrieve the liquidity held in the next closest initialized tick
-			liquidityNet, err := k.crossTick(ctx, p.GetId(), nextTick.Int64())
+			liquidityNet, uptimeLiquidityNets, err := k.crossTick(ctx, p.GetId(), nextTick.Int64())
 			if err != nil {
 				return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
 			}
 			liquidityNet = swapStrategy.SetLiquidityDeltaSign(liquidityNet)
+			for uptimeIndex := range uptimeLiquidityNets {
+				uptimeLiquidityNets[uptimeIndex] = swapStrategy.SetLiquidityDeltaSign(uptimeLiquidityNets[uptimeIndex])
+			}
+			swapState.crossUptimeLiquidity(uptimeLiquidityNets)
 			// update the swapState's liquidity with the new tick's liquidity
 			newLiquidity := math.AddLiquidity(swapState.liquidity, liquidityNet)
 			swapState.liquidity = newLiquidity
 
 			// update the swapState's tick with the tick we retrieved liquidity from
 			swapState.tick = nextTick
+			swapStep.TickCrossed = true
 		} else if !sqrtPriceStart.Equal(sqrtPrice) {
 			// otherwise if the sqrtPrice calculated from computeSwapStep does not equal the sqrtPrice we started with at the
 			// beginning of this iteration, we set the swapState tick to the corresponding tick of the sqrtPrice calculated from computeSwapStep
@@ -368,6 +656,17 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 				return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
 			}
 		}
+
+		if recordSwapStep != nil {
+			swapStep.Tick = swapState.tick
+			recordSwapStep(swapStep)
+		}
+	}
+
+	// The qualifying liquidity of the crossed ticks is persisted here, while the rest of the pool's
+	// swap state is applied by the caller.
+	if err := k.addPoolUptimeLiquidity(ctx, poolId, swapState.uptimeLiquidityDeltas); err != nil {
+		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
 	}
 
 	if err := k.chargeFee(ctx, poolId, sdk.NewDecCoinFromDec(tokenInMin.Denom, swapState.feeGrowthGlobal)); err != nil {
@@ -401,6 +700,11 @@ func (k Keeper) calcInAmtGivenOut(
 	poolId uint64,
 ) (writeCtx func(), tokenIn, tokenOut sdk.Coin, updatedTick sdk.Int, updatedLiquidity, updatedSqrtPrice sdk.Dec, err error) {
 	ctx, writeCtx = ctx.CacheContext()
+	// Sync the uptime accumulators prior to the swap so that ticks crossed
+	// below snapshot the up-to-date uptime growth.
+	if err := k.updateUptimeAccumulatorsToNow(ctx, poolId); err != nil {
+		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
+	}
 	p, err := k.getPoolById(ctx, poolId)
 	if err != nil {
 		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
@@ -456,6 +760,7 @@ func (k Keeper) calcInAmtGivenOut(
 		tick:                     swapStrategy.InitializeTickValue(p.GetCurrentTick()),
 		liquidity:                p.GetLiquidity(),
 		feeGrowthGlobal:          sdk.ZeroDec(),
+		uptimeLiquidityDeltas:    zeroUptimeLiquidity(),
 	}
 
 	// TODO: This should be GT 0 but some instances have very small remainder
@@ -507,11 +812,15 @@ func (k Keeper) calcInAmtGivenOut(
 		// tick has been consumed and we must move on to the next tick to complete the swap
 		if sqrtPriceNextTick.Equal(sqrtPrice) {
 			// retrieve the liquidity held in the next closest initialized tick
-			liquidityNet, err := k.crossTick(ctx, p.GetId(), nextTick.Int64())
+			liquidityNet, uptimeLiquidityNets, err := k.crossTick(ctx, p.GetId(), nextTick.Int64())
 			if err != nil {
 				return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
 			}
 			liquidityNet = swapStrategy.SetLiquidityDeltaSign(liquidityNet)
+			for uptimeIndex := range uptimeLiquidityNets {
+				uptimeLiquidityNets[uptimeIndex] = swapStrategy.SetLiquidityDeltaSign(uptimeLiquidityNets[uptimeIndex])
+			}
+			swapState.crossUptimeLiquidity(uptimeLiquidityNets)
 			// update the swapState's liquidity with the new tick's liquidity
 			newLiquidity := math.AddLiquidity(swapState.liquidity, liquidityNet)
 			swapState.liquidity = newLiquidity
@@ -528,6 +837,12 @@ func (k Keeper) calcInAmtGivenOut(
 		}
 	}
 
+	// The qualifying liquidity of the crossed ticks is persisted here, while the rest of the pool's
+	// swap state is applied by the caller.
+	if err := k.addPoolUptimeLiquidity(ctx, poolId, swapState.uptimeLiquidityDeltas); err != nil {
+		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
+	}
+
 	if err := k.chargeFee(ctx, poolId, sdk.NewDecCoinFromDec(tokenInDenom, swapState.feeGrowthGlobal)); err != nil {
 		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
 	}
@@ -608,9 +923,8 @@ func (k Keeper) updatePoolForSwap(
 		return err
 	}
 
-	// TODO: implement hooks
 	events.EmitSwapEvent(ctx, sender, pool.GetId(), sdk.Coins{tokenIn}, sdk.Coins{tokenOut})
-	// k.hooks.AfterSwap(ctx, sender, pool.GetId(), tokenIn, tokenOut)
+	k.listeners.AfterConcentratedPoolSwap(ctx, sender, pool.GetId(), sdk.Coins{tokenIn}, sdk.Coins{tokenOut})
 
 	return err
 }
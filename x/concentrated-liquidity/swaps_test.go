@@ -945,7 +945,7 @@ func (s *KeeperTestSuite) TestCalcAndSwapOutAmtGivenIn() {
 				newUpperTick, err := math.PriceToTick(test.secondPositionUpperPrice, DefaultExponentAtPriceOne)
 				s.Require().NoError(err)
 
-				_, _, _, err = s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), newLowerTick.Int64(), newUpperTick.Int64(), s.Ctx.BlockTime().Add(DefaultFreezeDuration))
+				_, _, _, _, err = s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), newLowerTick.Int64(), newUpperTick.Int64(), s.Ctx.BlockTime().Add(DefaultFreezeDuration))
 				s.Require().NoError(err)
 			}
 
@@ -1092,7 +1092,7 @@ func (s *KeeperTestSuite) TestSwapOutAmtGivenIn_TickUpdates() {
 				newUpperTick, err := math.PriceToTick(test.secondPositionUpperPrice, DefaultExponentAtPriceOne)
 				s.Require().NoError(err)
 
-				_, _, _, err = s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), newLowerTick.Int64(), newUpperTick.Int64(), s.Ctx.BlockTime().Add(DefaultFreezeDuration))
+				_, _, _, _, err = s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), newLowerTick.Int64(), newUpperTick.Int64(), s.Ctx.BlockTime().Add(DefaultFreezeDuration))
 				s.Require().NoError(err)
 			}
 
@@ -1174,7 +1174,7 @@ func (s *KeeperTestSuite) TestCalcAndSwapInAmtGivenOut() {
 				newUpperTick, err := math.PriceToTick(test.secondPositionUpperPrice, DefaultExponentAtPriceOne)
 				s.Require().NoError(err)
 
-				_, _, _, err = s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), newLowerTick.Int64(), newUpperTick.Int64(), s.Ctx.BlockTime().Add(DefaultFreezeDuration))
+				_, _, _, _, err = s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), newLowerTick.Int64(), newUpperTick.Int64(), s.Ctx.BlockTime().Add(DefaultFreezeDuration))
 				s.Require().NoError(err)
 			}
 
@@ -1332,7 +1332,7 @@ func (s *KeeperTestSuite) TestSwapInAmtGivenOut_TickUpdates() {
 				newUpperTick, err := math.PriceToTick(test.secondPositionUpperPrice, DefaultExponentAtPriceOne)
 				s.Require().NoError(err)
 
-				_, _, _, err = s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), newLowerTick.Int64(), newUpperTick.Int64(), s.Ctx.BlockTime().Add(DefaultFreezeDuration))
+				_, _, _, _, err = s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), newLowerTick.Int64(), newUpperTick.Int64(), s.Ctx.BlockTime().Add(DefaultFreezeDuration))
 				s.Require().NoError(err)
 			}
 
@@ -1696,7 +1696,7 @@ func (s *KeeperTestSuite) TestInverseRelationshipSwapOutAmtGivenIn() {
 				newUpperTick, err := math.PriceToTick(test.secondPositionUpperPrice, DefaultExponentAtPriceOne)
 				s.Require().NoError(err)
 
-				_, _, _, err = s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), newLowerTick.Int64(), newUpperTick.Int64(), s.Ctx.BlockTime().Add(DefaultFreezeDuration))
+				_, _, _, _, err = s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), newLowerTick.Int64(), newUpperTick.Int64(), s.Ctx.BlockTime().Add(DefaultFreezeDuration))
 				s.Require().NoError(err)
 			}
 
@@ -1747,7 +1747,7 @@ func (s *KeeperTestSuite) TestInverseRelationshipSwapInAmtGivenOut() {
 				newUpperTick, err := math.PriceToTick(test.secondPositionUpperPrice, DefaultExponentAtPriceOne)
 				s.Require().NoError(err)
 
-				_, _, _, err = s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), newLowerTick.Int64(), newUpperTick.Int64(), s.Ctx.BlockTime().Add(DefaultFreezeDuration))
+				_, _, _, _, err = s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), newLowerTick.Int64(), newUpperTick.Int64(), s.Ctx.BlockTime().Add(DefaultFreezeDuration))
 				s.Require().NoError(err)
 			}
 
@@ -1819,3 +1819,96 @@ func (s *KeeperTestSuite) inverseRelationshipInvariants(firstTokenIn, firstToken
 	s.Require().Equal(userBalanceBeforeSwap, userBalanceAfterSwap)
 	s.Require().Equal(poolBalanceBeforeSwap, poolBalanceAfterSwap)
 }
+
+// TestSimulateSwapSteps tests that the simulated swap steps replay to the same outcome as the swap
+// itself and that every tick crossed is reported.
+func (s *KeeperTestSuite) TestSimulateSwapSteps() {
+	tests := map[string]struct {
+		tokenIn                  sdk.Coin
+		tokenOutDenom            string
+		secondPositionLowerPrice sdk.Dec
+		secondPositionUpperPrice sdk.Dec
+
+		expectedTicksCrossed []bool
+		expectedErr          error
+	}{
+		"single position within one tick: usdc -> eth": {
+			tokenIn:              sdk.NewCoin(USDC, sdk.NewInt(42000000)),
+			tokenOutDenom:        ETH,
+			expectedTicksCrossed: []bool{false},
+		},
+		"single position within one tick: eth -> usdc": {
+			tokenIn:              sdk.NewCoin(ETH, sdk.NewInt(13370)),
+			tokenOutDenom:        USDC,
+			expectedTicksCrossed: []bool{false},
+		},
+		"two positions with consecutive price ranges: usdc -> eth": {
+			tokenIn:                  sdk.NewCoin(USDC, sdk.NewInt(10000000000)),
+			tokenOutDenom:            ETH,
+			secondPositionLowerPrice: sdk.NewDec(5500),
+			secondPositionUpperPrice: sdk.NewDec(6250),
+			expectedTicksCrossed:     []bool{true, false},
+		},
+		"error: token in denom is not in the pool": {
+			tokenIn:       sdk.NewCoin("foo", sdk.NewInt(42000000)),
+			tokenOutDenom: ETH,
+			expectedErr:   types.TokenInDenomNotInPoolError{TokenInDenom: "foo"},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, sdk.NewDecWithPrec(1, 2))
+			s.SetupDefaultPosition(pool.GetId())
+
+			if !tc.secondPositionLowerPrice.IsNil() {
+				lowerTick, err := math.PriceToTick(tc.secondPositionLowerPrice, DefaultExponentAtPriceOne)
+				s.Require().NoError(err)
+				upperTick, err := math.PriceToTick(tc.secondPositionUpperPrice, DefaultExponentAtPriceOne)
+				s.Require().NoError(err)
+				s.SetupPosition(pool.GetId(), s.TestAccs[1], DefaultCoin0, DefaultCoin1, lowerTick.Int64(), upperTick.Int64(), s.Ctx.BlockTime())
+			}
+
+			poolBefore, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			// System under test
+			tokenIn, tokenOut, swapSteps, err := clKeeper.SimulateSwapSteps(s.Ctx, pool.GetId(), tc.tokenIn, tc.tokenOutDenom)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The simulation matches the estimate of the swap at the pool's swap fee.
+			expectedTokenOut, err := clKeeper.CalcOutAmtGivenIn(s.Ctx, poolBefore, tc.tokenIn, tc.tokenOutDenom, poolBefore.GetSwapFee(s.Ctx))
+			s.Require().NoError(err)
+			s.Require().Equal(expectedTokenOut.String(), tokenOut.String())
+
+			// Replaying the steps yields the same amounts, and each step starts where the previous one ended.
+			s.Require().Len(swapSteps, len(tc.expectedTicksCrossed))
+			amountIn, amountOut := sdk.ZeroDec(), sdk.ZeroDec()
+			sqrtPrice := poolBefore.GetCurrentSqrtPrice()
+			for i, swapStep := range swapSteps {
+				s.Require().Equal(sqrtPrice, swapStep.SqrtPriceStart)
+				s.Require().Equal(tc.expectedTicksCrossed[i], swapStep.TickCrossed)
+				s.Require().True(swapStep.FeeCharge.IsPositive())
+
+				amountIn = amountIn.Add(swapStep.AmountIn).Add(swapStep.FeeCharge)
+				amountOut = amountOut.Add(swapStep.AmountOut)
+				sqrtPrice = swapStep.SqrtPriceNext
+			}
+			s.Require().Equal(tokenIn.Amount, amountIn.RoundInt())
+			s.Require().Equal(tokenOut.Amount, amountOut.TruncateInt())
+
+			// The simulation does not modify the pool.
+			poolAfter, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(poolBefore, poolAfter)
+		})
+	}
+}
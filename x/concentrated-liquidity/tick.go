@@ -1,6 +1,8 @@
 package concentrated_liquidity
 
 import (
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,12 +14,11 @@ import (
 )
 
 // initOrUpdateTick retrieves the tickInfo from the specified tickIndex and updates both the liquidityNet and LiquidityGross.
-// The given currentTick value is used to determine the strategy for updating the fee accumulator.
-// We update the tick's fee growth outside accumulator to the fee growth global when tick index is <= current tick.
-// Otherwise, it is set to zero.
+// The tick's fee growth outside is only set when the tick is first initialized (see getTickInfo) and is left
+// unchanged by subsequent updates, so that it keeps tracking the fees accrued on the other side of the tick.
 // if we are initializing or updating an upper tick, we subtract the liquidityIn from the LiquidityNet
 // if we are initializing or updating an lower tick, we add the liquidityIn from the LiquidityNet
-func (k Keeper) initOrUpdateTick(ctx sdk.Context, poolId uint64, currentTick int64, tickIndex int64, liquidityIn sdk.Dec, upper bool) (err error) {
+func (k Keeper) initOrUpdateTick(ctx sdk.Context, poolId uint64, tickIndex int64, liquidityIn sdk.Dec, upper bool) (err error) {
 	tickInfo, err := k.getTickInfo(ctx, poolId, tickIndex)
 	if err != nil {
 		return err
@@ -39,37 +40,69 @@ func (k Keeper) initOrUpdateTick(ctx sdk.Context, poolId uint64, currentTick int
 		tickInfo.LiquidityNet = tickInfo.LiquidityNet.Add(liquidityIn)
 	}
 
-	// if given tickIndex is LTE to current tick, tick's fee growth outside is set as fee accumulator's value
-	if tickIndex <= currentTick {
-		accum, err := k.getFeeAccumulator(ctx, poolId)
-		if err != nil {
-			return err
-		}
-
-		tickInfo.FeeGrowthOutside = accum.GetValue()
-	}
-
 	k.SetTickInfo(ctx, poolId, tickIndex, tickInfo)
 
 	return nil
 }
 
-func (k Keeper) crossTick(ctx sdk.Context, poolId uint64, tickIndex int64) (liquidityDelta sdk.Dec, err error) {
+// crossTick flips the fee and uptime growth outside of the given tick, as the current tick of the pool moves across it.
+// Returns the tick's liquidity net alongside its qualifying liquidity net for each supported uptime.
+func (k Keeper) crossTick(ctx sdk.Context, poolId uint64, tickIndex int64) (liquidityDelta sdk.Dec, uptimeLiquidityDeltas []sdk.Dec, err error) {
 	tickInfo, err := k.getTickInfo(ctx, poolId, tickIndex)
 	if err != nil {
-		return sdk.Dec{}, err
+		return sdk.Dec{}, nil, err
 	}
 
 	accum, err := k.getFeeAccumulator(ctx, poolId)
 	if err != nil {
-		return sdk.Dec{}, err
+		return sdk.Dec{}, nil, err
 	}
 
 	// subtract tick's fee growth outside from current fee accumulator
 	tickInfo.FeeGrowthOutside = accum.GetValue().Sub(tickInfo.FeeGrowthOutside)
+
+	// update the tick's uptime trackers in the same manner
+	uptimeAccumValues, err := k.getUptimeAccumulatorValues(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, nil, err
+	}
+	uptimeTrackerValues := getUptimeTrackerValues(tickInfo.UptimeTrackers)
+	uptimeLiquidityDeltas = getUptimeTrackerLiquidityNets(tickInfo.UptimeTrackers)
+	uptimeTrackers := make([]model.UptimeTracker, len(types.SupportedUptimes))
+	for uptimeIndex := range uptimeTrackers {
+		uptimeTrackers[uptimeIndex].UptimeGrowthOutside = uptimeAccumValues[uptimeIndex].Sub(uptimeTrackerValues[uptimeIndex])
+		uptimeTrackers[uptimeIndex].LiquidityNet = uptimeLiquidityDeltas[uptimeIndex]
+	}
+	tickInfo.UptimeTrackers = uptimeTrackers
+
 	k.SetTickInfo(ctx, poolId, tickIndex, tickInfo)
 
-	return tickInfo.LiquidityNet, nil
+	return tickInfo.LiquidityNet, uptimeLiquidityDeltas, nil
+}
+
+// updateTickUptimeLiquidity updates the qualifying liquidity net of the given tick for each supported uptime,
+// in the same manner as initOrUpdateTick updates the tick's liquidity net.
+func (k Keeper) updateTickUptimeLiquidity(ctx sdk.Context, poolId uint64, tickIndex int64, uptimeLiquidityDeltas []sdk.Dec, upper bool) error {
+	tickInfo, err := k.getTickInfo(ctx, poolId, tickIndex)
+	if err != nil {
+		return err
+	}
+
+	uptimeTrackerValues := getUptimeTrackerValues(tickInfo.UptimeTrackers)
+	uptimeLiquidityNets := getUptimeTrackerLiquidityNets(tickInfo.UptimeTrackers)
+	uptimeTrackers := make([]model.UptimeTracker, len(types.SupportedUptimes))
+	for uptimeIndex := range uptimeTrackers {
+		uptimeTrackers[uptimeIndex].UptimeGrowthOutside = uptimeTrackerValues[uptimeIndex]
+		if upper {
+			uptimeTrackers[uptimeIndex].LiquidityNet = uptimeLiquidityNets[uptimeIndex].Sub(uptimeLiquidityDeltas[uptimeIndex])
+		} else {
+			uptimeTrackers[uptimeIndex].LiquidityNet = uptimeLiquidityNets[uptimeIndex].Add(uptimeLiquidityDeltas[uptimeIndex])
+		}
+	}
+	tickInfo.UptimeTrackers = uptimeTrackers
+
+	k.SetTickInfo(ctx, poolId, tickIndex, tickInfo)
+	return nil
 }
 
 // getTickInfo gets tickInfo given poolId and tickIndex. Returns a boolean field that returns true if value is found for given key.
@@ -91,7 +124,13 @@ func (k Keeper) getTickInfo(ctx sdk.Context, poolId uint64, tickIndex int64) (ti
 			return tickStruct, err
 		}
 
-		return model.TickInfo{LiquidityGross: sdk.ZeroDec(), LiquidityNet: sdk.ZeroDec(), FeeGrowthOutside: initialFeeGrowthOutside}, nil
+		// Uptime growth outside is initialized the same way.
+		initialUptimeTrackers, err := k.getInitialUptimeGrowthOutsidesForTick(ctx, poolId, tickIndex)
+		if err != nil {
+			return tickStruct, err
+		}
+
+		return model.TickInfo{LiquidityGross: sdk.ZeroDec(), LiquidityNet: sdk.ZeroDec(), FeeGrowthOutside: initialFeeGrowthOutside, UptimeTrackers: initialUptimeTrackers}, nil
 	}
 	if err != nil {
 		return tickStruct, err
@@ -184,3 +223,63 @@ func (k Keeper) GetPerTickLiquidityDepthFromRange(ctx sdk.Context, poolId uint64
 
 	return liquidityDepths, nil
 }
+
+// GetLiquidityNetAroundCurrentTick returns the pool's current tick, sqrt price and active liquidity along with
+// the liquidity net of up to ticksPerSide initialized ticks on each side of the current tick, in ascending
+// order of tick index. Ticks at or below the current tick are on the lower side.
+// Returns error if the pool does not exist.
+func (k Keeper) GetLiquidityNetAroundCurrentTick(ctx sdk.Context, poolId uint64, ticksPerSide uint64) (currentTick sdk.Int, currentSqrtPrice, currentLiquidity sdk.Dec, liquidityDepths []types.LiquidityDepth, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Dec{}, sdk.Dec{}, nil, err
+	}
+	currentTick = pool.GetCurrentTick()
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyTickPrefix(poolId))
+	firstTickAboveKey := types.TickIndexToBytes(currentTick.Int64() + 1)
+
+	// Ticks at or below the current tick are iterated in descending order, so they are
+	// collected in reverse to keep the result in ascending order.
+	lowerDepths, err := collectLiquidityDepths(prefixStore.ReverseIterator(nil, firstTickAboveKey), ticksPerSide)
+	if err != nil {
+		return sdk.Int{}, sdk.Dec{}, sdk.Dec{}, nil, err
+	}
+	upperDepths, err := collectLiquidityDepths(prefixStore.Iterator(firstTickAboveKey, nil), ticksPerSide)
+	if err != nil {
+		return sdk.Int{}, sdk.Dec{}, sdk.Dec{}, nil, err
+	}
+
+	liquidityDepths = make([]types.LiquidityDepth, 0, len(lowerDepths)+len(upperDepths))
+	for i := len(lowerDepths) - 1; i >= 0; i-- {
+		liquidityDepths = append(liquidityDepths, lowerDepths[i])
+	}
+	liquidityDepths = append(liquidityDepths, upperDepths...)
+
+	return currentTick, pool.GetCurrentSqrtPrice(), pool.GetLiquidity(), liquidityDepths, nil
+}
+
+// collectLiquidityDepths returns the liquidity depths of up to limit ticks from the given tick iterator,
+// in iteration order. The iterator is closed once done.
+func collectLiquidityDepths(iterator sdk.Iterator, limit uint64) ([]types.LiquidityDepth, error) {
+	defer iterator.Close()
+
+	liquidityDepths := []types.LiquidityDepth{}
+	for ; iterator.Valid() && uint64(len(liquidityDepths)) < limit; iterator.Next() {
+		tickIndex, err := types.TickIndexFromBytes(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		tickInfo := model.TickInfo{}
+		if err := proto.Unmarshal(iterator.Value(), &tickInfo); err != nil {
+			return nil, err
+		}
+
+		liquidityDepths = append(liquidityDepths, types.LiquidityDepth{
+			TickIndex:    sdk.NewInt(tickIndex),
+			LiquidityNet: tickInfo.LiquidityNet,
+		})
+	}
+
+	return liquidityDepths, nil
+}
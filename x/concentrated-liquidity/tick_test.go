@@ -231,8 +231,7 @@ func (s *KeeperTestSuite) TestInitOrUpdateTick() {
 			s.Setup()
 
 			// Create a default CL pool
-			pool := s.PrepareConcentratedPool()
-			currentTick := pool.GetCurrentTick().Int64()
+			s.PrepareConcentratedPool()
 
 			_, err := s.App.ConcentratedLiquidityKeeper.GetFeeAccumulator(s.Ctx, 1)
 			s.Require().NoError(err)
@@ -246,7 +245,7 @@ func (s *KeeperTestSuite) TestInitOrUpdateTick() {
 			// If tickExists set, initialize the specified tick with defaultLiquidityAmt
 			preexistingLiquidity := sdk.ZeroDec()
 			if test.tickExists {
-				err := s.App.ConcentratedLiquidityKeeper.InitOrUpdateTick(s.Ctx, test.param.poolId, currentTick, test.param.tickIndex, DefaultLiquidityAmt, test.param.upper)
+				err := s.App.ConcentratedLiquidityKeeper.InitOrUpdateTick(s.Ctx, test.param.poolId, test.param.tickIndex, DefaultLiquidityAmt, test.param.upper)
 				s.Require().NoError(err)
 				preexistingLiquidity = DefaultLiquidityAmt
 			}
@@ -259,7 +258,7 @@ func (s *KeeperTestSuite) TestInitOrUpdateTick() {
 			s.Require().Equal(preexistingLiquidity, tickInfo.LiquidityGross)
 
 			// Initialize or update the tick according to the test case
-			err = s.App.ConcentratedLiquidityKeeper.InitOrUpdateTick(s.Ctx, test.param.poolId, currentTick, test.param.tickIndex, test.param.liquidityIn, test.param.upper)
+			err = s.App.ConcentratedLiquidityKeeper.InitOrUpdateTick(s.Ctx, test.param.poolId, test.param.tickIndex, test.param.liquidityIn, test.param.upper)
 			if test.expectedErr != nil {
 				s.Require().ErrorIs(err, test.expectedErr)
 				return
@@ -286,6 +285,7 @@ func (s *KeeperTestSuite) TestInitOrUpdateTick() {
 func (s *KeeperTestSuite) TestGetTickInfo() {
 	var (
 		preInitializedTickIndex = DefaultCurrTick.Int64() + 2
+		emptyUptimeTrackers     = wrapUptimeTrackers(emptyUptimeValues())
 	)
 
 	tests := []struct {
@@ -300,21 +300,21 @@ func (s *KeeperTestSuite) TestGetTickInfo() {
 			poolToGet: validPoolId,
 			tickToGet: preInitializedTickIndex,
 			// Note that FeeGrowthOutside is not updated.
-			expectedTickInfo: model.TickInfo{LiquidityGross: DefaultLiquidityAmt, LiquidityNet: DefaultLiquidityAmt.Neg()},
+			expectedTickInfo: model.TickInfo{LiquidityGross: DefaultLiquidityAmt, LiquidityNet: DefaultLiquidityAmt.Neg(), UptimeTrackers: emptyUptimeTrackers},
 		},
 		{
 			name:      "Get tick info on existing pool with no existing tick (cur pool tick > tick)",
 			poolToGet: validPoolId,
 			tickToGet: DefaultCurrTick.Int64() + 1,
 			// Note that FeeGrowthOutside is not initialized.
-			expectedTickInfo: model.TickInfo{LiquidityGross: sdk.ZeroDec(), LiquidityNet: sdk.ZeroDec()},
+			expectedTickInfo: model.TickInfo{LiquidityGross: sdk.ZeroDec(), LiquidityNet: sdk.ZeroDec(), UptimeTrackers: emptyUptimeTrackers},
 		},
 		{
 			name:      "Get tick info on existing pool with no existing tick (cur pool tick == tick), initialized fee growth outside",
 			poolToGet: validPoolId,
 			tickToGet: DefaultCurrTick.Int64(),
 			// Note that FeeGrowthOutside is initialized.
-			expectedTickInfo: model.TickInfo{LiquidityGross: sdk.ZeroDec(), LiquidityNet: sdk.ZeroDec(), FeeGrowthOutside: sdk.NewDecCoins(oneEth)},
+			expectedTickInfo: model.TickInfo{LiquidityGross: sdk.ZeroDec(), LiquidityNet: sdk.ZeroDec(), FeeGrowthOutside: sdk.NewDecCoins(oneEth), UptimeTrackers: emptyUptimeTrackers},
 		},
 		{
 			name:        "Get tick info on a non-existing pool with no existing tick",
@@ -333,7 +333,7 @@ func (s *KeeperTestSuite) TestGetTickInfo() {
 			s.PrepareConcentratedPool()
 
 			// Set up an initialized tick
-			err := s.App.ConcentratedLiquidityKeeper.InitOrUpdateTick(s.Ctx, validPoolId, DefaultCurrTick.Int64(), preInitializedTickIndex, DefaultLiquidityAmt, true)
+			err := s.App.ConcentratedLiquidityKeeper.InitOrUpdateTick(s.Ctx, validPoolId, preInitializedTickIndex, DefaultLiquidityAmt, true)
 			s.Require().NoError(err)
 
 			// Charge fee to make sure that the global fee accumulator is always updated.
@@ -368,6 +368,7 @@ func (s *KeeperTestSuite) TestCrossTick() {
 		poolToGet                    uint64
 		tickToGet                    int64
 		expectedLiquidityDelta       sdk.Dec
+		expectedUptimeLiquidityDelta []sdk.Dec
 		expectedTickFeeGrowthOutside sdk.DecCoins
 		expectedErr                  bool
 	}{
@@ -376,8 +377,18 @@ func (s *KeeperTestSuite) TestCrossTick() {
 			poolToGet:                    validPoolId,
 			tickToGet:                    preInitializedTickIndex,
 			expectedLiquidityDelta:       DefaultLiquidityAmt.Neg(),
+			expectedUptimeLiquidityDelta: []sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()},
 			expectedTickFeeGrowthOutside: DefaultFeeAccumCoins,
 		},
+		{
+			// The position is frozen for a day, so it only qualifies for the uptimes of up to a day.
+			name:                         "Cross lower tick of a position qualifying for uptimes",
+			poolToGet:                    validPoolId,
+			tickToGet:                    DefaultLowerTick,
+			expectedLiquidityDelta:       DefaultLiquidityAmt,
+			expectedUptimeLiquidityDelta: []sdk.Dec{DefaultLiquidityAmt, DefaultLiquidityAmt, DefaultLiquidityAmt, sdk.ZeroDec()},
+			expectedTickFeeGrowthOutside: sdk.NewDecCoins(sdk.NewDecCoin("foo", sdk.NewInt(100))),
+		},
 		{
 			name:        "Try invalid tick",
 			poolToGet:   2,
@@ -406,7 +417,7 @@ func (s *KeeperTestSuite) TestCrossTick() {
 			s.Require().NoError(err)
 
 			// Set up an initialized tick
-			err = s.App.ConcentratedLiquidityKeeper.InitOrUpdateTick(s.Ctx, validPoolId, DefaultCurrTick.Int64(), preInitializedTickIndex, DefaultLiquidityAmt, true)
+			err = s.App.ConcentratedLiquidityKeeper.InitOrUpdateTick(s.Ctx, validPoolId, preInitializedTickIndex, DefaultLiquidityAmt, true)
 			s.Require().NoError(err)
 
 			// update the fee accumulator so that we have accum value > tick fee growth value
@@ -415,12 +426,13 @@ func (s *KeeperTestSuite) TestCrossTick() {
 			s.Require().NoError(err)
 
 			// System under test
-			liquidityDelta, err := s.App.ConcentratedLiquidityKeeper.CrossTick(s.Ctx, test.poolToGet, test.tickToGet)
+			liquidityDelta, uptimeLiquidityDeltas, err := s.App.ConcentratedLiquidityKeeper.CrossTick(s.Ctx, test.poolToGet, test.tickToGet)
 			if test.expectedErr {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
 				s.Require().Equal(test.expectedLiquidityDelta, liquidityDelta)
+				s.Require().Equal(test.expectedUptimeLiquidityDelta, uptimeLiquidityDeltas)
 
 				// now check if fee accumulator has been properly updated
 				accum, err := s.App.ConcentratedLiquidityKeeper.GetFeeAccumulator(s.Ctx, test.poolToGet)
@@ -430,7 +442,7 @@ func (s *KeeperTestSuite) TestCrossTick() {
 				s.Require().Equal(accum.GetValue(), sdk.NewDecCoins(defaultAccumCoins).MulDec(sdk.NewDec(2)))
 
 				// check if the tick fee growth outside has been correctly subtracted
-				tickInfo, err := s.App.ConcentratedLiquidityKeeper.GetTickInfo(s.Ctx, test.poolToGet, preInitializedTickIndex)
+				tickInfo, err := s.App.ConcentratedLiquidityKeeper.GetTickInfo(s.Ctx, test.poolToGet, test.tickToGet)
 				s.Require().NoError(err)
 				s.Require().Equal(test.expectedTickFeeGrowthOutside, tickInfo.FeeGrowthOutside)
 			}
@@ -553,3 +565,72 @@ func (s *KeeperTestSuite) TestGetLiquidityDepthFromIterator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGetLiquidityNetAroundCurrentTick() {
+	// Initialized tick offsets from the pool's current tick -> liquidity net.
+	tickOffsets := []int64{-200, 0, 100, 200}
+	liquidityNets := []sdk.Dec{sdk.NewDec(20), sdk.NewDec(10), sdk.NewDec(-10), sdk.NewDec(-20)}
+
+	tests := []struct {
+		name         string
+		invalidPool  bool
+		ticksPerSide uint64
+		// expectedTicks are the indexes into tickOffsets of the expected ticks.
+		expectedTicks []int
+	}{
+		{
+			name:          "all initialized ticks",
+			ticksPerSide:  10,
+			expectedTicks: []int{0, 1, 2, 3},
+		},
+		{
+			name:          "closest tick on each side, the current tick being on the lower side",
+			ticksPerSide:  1,
+			expectedTicks: []int{1, 2},
+		},
+		{
+			name:        "invalid pool id",
+			invalidPool: true,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.Setup()
+			pool := s.PrepareConcentratedPool()
+			currentTick := pool.GetCurrentTick().Int64()
+
+			liquidityDepths := make([]types.LiquidityDepth, len(tickOffsets))
+			for i, tickOffset := range tickOffsets {
+				liquidityDepths[i] = types.LiquidityDepth{TickIndex: sdk.NewInt(currentTick + tickOffset), LiquidityNet: liquidityNets[i]}
+				s.App.ConcentratedLiquidityKeeper.SetTickInfo(s.Ctx, pool.GetId(), currentTick+tickOffset, model.TickInfo{
+					LiquidityNet: liquidityNets[i],
+				})
+			}
+
+			paramPoolId := pool.GetId()
+			if test.invalidPool {
+				paramPoolId = pool.GetId() + 1
+			}
+
+			// System Under Test
+			actualCurrentTick, currentSqrtPrice, currentLiquidity, actualLiquidityDepths, err := s.App.ConcentratedLiquidityKeeper.GetLiquidityNetAroundCurrentTick(s.Ctx, paramPoolId, test.ticksPerSide)
+
+			if test.invalidPool {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(pool.GetCurrentTick(), actualCurrentTick)
+			s.Require().Equal(pool.GetCurrentSqrtPrice(), currentSqrtPrice)
+			s.Require().Equal(pool.GetLiquidity(), currentLiquidity)
+
+			expectedLiquidityDepths := make([]types.LiquidityDepth, 0, len(test.expectedTicks))
+			for _, i := range test.expectedTicks {
+				expectedLiquidityDepths = append(expectedLiquidityDepths, liquidityDepths[i])
+			}
+			s.Require().Equal(expectedLiquidityDepths, actualLiquidityDepths)
+		})
+	}
+}
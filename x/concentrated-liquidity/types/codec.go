@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -13,6 +14,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreatePosition{}, "osmosis/cl-create-position", nil)
 	cdc.RegisterConcrete(&MsgWithdrawPosition{}, "osmosis/cl-withdraw-position", nil)
 	cdc.RegisterConcrete(&MsgCollectFees{}, "osmosis/cl-collect-fees", nil)
+	cdc.RegisterConcrete(&MsgCreateIncentive{}, "osmosis/cl-create-incentive", nil)
+	cdc.RegisterConcrete(&MsgCollectIncentives{}, "osmosis/cl-collect-incentives", nil)
+	cdc.RegisterConcrete(&MsgAddToPosition{}, "osmosis/cl-add-to-position", nil)
+	cdc.RegisterConcrete(&MsgCompoundFees{}, "osmosis/cl-compound-fees", nil)
+	cdc.RegisterConcrete(&UpdatePoolSwapFeeAndTickSpacingProposal{}, "osmosis/UpdatePoolSwapFeeAndTickSpacingProposal", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -26,6 +32,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreatePosition{},
 		&MsgWithdrawPosition{},
 		&MsgCollectFees{},
+		&MsgCreateIncentive{},
+		&MsgCollectIncentives{},
+		&MsgAddToPosition{},
+		&MsgCompoundFees{},
+	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&UpdatePoolSwapFeeAndTickSpacingProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
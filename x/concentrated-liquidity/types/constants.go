@@ -19,4 +19,13 @@ var (
 	// Supported uptimes preset to 1 min, 1 hr, 1D, 1W
 	SupportedUptimes      = []time.Duration{time.Minute, time.Hour, time.Hour * 24, time.Hour * 24 * 7}
 	AuthorizedTickSpacing = []uint64{1, 10, 60, 200}
+	// Authorized swap fee tiers preset to 0%, 0.01%, 0.05%, 0.3% and 1%,
+	// with wider tick spacings allowed for the higher swap fees.
+	AuthorizedSwapFeeTiers = []SwapFeeTier{
+		{SwapFee: sdk.ZeroDec(), AuthorizedTickSpacings: []uint64{1, 10}},
+		{SwapFee: sdk.MustNewDecFromStr("0.0001"), AuthorizedTickSpacings: []uint64{1}},
+		{SwapFee: sdk.MustNewDecFromStr("0.0005"), AuthorizedTickSpacings: []uint64{1, 10}},
+		{SwapFee: sdk.MustNewDecFromStr("0.003"), AuthorizedTickSpacings: []uint64{10, 60}},
+		{SwapFee: sdk.MustNewDecFromStr("0.01"), AuthorizedTickSpacings: []uint64{1, 10, 60, 200}},
+	}
 )
@@ -26,14 +26,20 @@ func (e NotPositiveRequireAmountError) Error() string {
 }
 
 type PositionNotFoundError struct {
-	PoolId      uint64
-	LowerTick   int64
-	UpperTick   int64
-	FrozenUntil time.Time
+	PositionId uint64
 }
 
 func (e PositionNotFoundError) Error() string {
-	return fmt.Sprintf("position not found. pool id (%d), lower tick (%d), upper tick (%d), frozen until (%s)", e.PoolId, e.LowerTick, e.UpperTick, e.FrozenUntil)
+	return fmt.Sprintf("position not found. position id (%d)", e.PositionId)
+}
+
+type NotPositionOwnerError struct {
+	PositionId uint64
+	Address    string
+}
+
+func (e NotPositionOwnerError) Error() string {
+	return fmt.Sprintf("address (%s) is not the owner of position id (%d)", e.Address, e.PositionId)
 }
 
 type PoolNotFoundError struct {
@@ -143,6 +149,14 @@ func (e InvalidPriceLimitError) Error() string {
 	return fmt.Sprintf("invalid sqrt price limit given (%s), should be greater than (%s) and less than (%s)", e.SqrtPriceLimit, e.LowerBound, e.UpperBound)
 }
 
+type NonPositivePriceLimitError struct {
+	PriceLimit sdk.Dec
+}
+
+func (e NonPositivePriceLimitError) Error() string {
+	return fmt.Sprintf("price limit must be positive, was (%s)", e.PriceLimit)
+}
+
 type TickSpacingError struct {
 	TickSpacing uint64
 	LowerTick   int64
@@ -232,3 +246,112 @@ type QueryRangeUnsupportedError struct {
 func (e QueryRangeUnsupportedError) Error() string {
 	return fmt.Sprintf("tick range given (%s) is greater than max range supported(%s)", e.RequestedRange, e.MaxRange)
 }
+
+type InvalidMinUptimeError struct {
+	PoolId            uint64
+	MinUptime         time.Duration
+	AuthorizedUptimes []time.Duration
+}
+
+func (e InvalidMinUptimeError) Error() string {
+	return fmt.Sprintf("attempted to create an incentive record on pool (%d) with unsupported min uptime (%s), must be one of %v", e.PoolId, e.MinUptime, e.AuthorizedUptimes)
+}
+
+type NonPositiveIncentiveAmountError struct {
+	PoolId          uint64
+	IncentiveAmount sdk.Int
+}
+
+func (e NonPositiveIncentiveAmountError) Error() string {
+	return fmt.Sprintf("incentive amount must be positive. Attempted to create incentive record on pool (%d) with amount (%s)", e.PoolId, e.IncentiveAmount)
+}
+
+type NonPositiveEmissionRateError struct {
+	PoolId       uint64
+	EmissionRate sdk.Dec
+}
+
+func (e NonPositiveEmissionRateError) Error() string {
+	return fmt.Sprintf("emission rate must be positive. Attempted to create incentive record on pool (%d) with emission rate (%s)", e.PoolId, e.EmissionRate)
+}
+
+type EmissionDurationTooShortError struct {
+	PoolId           uint64
+	EmissionDuration time.Duration
+}
+
+func (e EmissionDurationTooShortError) Error() string {
+	return fmt.Sprintf("emission duration must be at least a second. Attempted to add to incentive record on pool (%d) with emission duration (%s)", e.PoolId, e.EmissionDuration)
+}
+
+type InvalidIncentiveStartTimeError struct {
+	PoolId    uint64
+	StartTime time.Time
+	BlockTime time.Time
+}
+
+func (e InvalidIncentiveStartTimeError) Error() string {
+	return fmt.Sprintf("incentive start time (%s) on pool (%d) cannot be before the current block time (%s)", e.StartTime, e.PoolId, e.BlockTime)
+}
+
+type IncentiveRecordExistsError struct {
+	PoolId         uint64
+	IncentiveDenom string
+	MinUptime      time.Duration
+	Creator        string
+}
+
+func (e IncentiveRecordExistsError) Error() string {
+	return fmt.Sprintf("incentive record for denom (%s) with min uptime (%s) created by (%s) already exists on pool (%d)", e.IncentiveDenom, e.MinUptime, e.Creator, e.PoolId)
+}
+
+type IncentiveRecordNotFoundError struct {
+	PoolId         uint64
+	IncentiveDenom string
+	MinUptime      time.Duration
+	Creator        string
+}
+
+func (e IncentiveRecordNotFoundError) Error() string {
+	return fmt.Sprintf("incentive record not found. pool id (%d), denom (%s), min uptime (%s), creator (%s)", e.PoolId, e.IncentiveDenom, e.MinUptime, e.Creator)
+}
+
+type NegativeUptimeGrowthInsideError struct {
+	PoolId    uint64
+	LowerTick int64
+	UpperTick int64
+}
+
+func (e NegativeUptimeGrowthInsideError) Error() string {
+	return fmt.Sprintf("uptime growth inside the range of lower tick (%d) and upper tick (%d) on pool (%d) is negative", e.LowerTick, e.UpperTick, e.PoolId)
+}
+
+type FrozenUntilTooShortForUptimeError struct {
+	PositionId  uint64
+	FrozenUntil time.Time
+	Uptime      time.Duration
+}
+
+func (e FrozenUntilTooShortForUptimeError) Error() string {
+	return fmt.Sprintf("position (%d) earns incentives for uptime (%s) and must stay frozen for at least as long when updated, was frozen until (%s)", e.PositionId, e.Uptime, e.FrozenUntil)
+}
+
+type UnauthorizedSwapFeeTierError struct {
+	SwapFee     sdk.Dec
+	TickSpacing uint64
+}
+
+func (e UnauthorizedSwapFeeTierError) Error() string {
+	return fmt.Sprintf("swap fee (%s) with tick spacing (%d) is not one of the authorized swap fee tiers", e.SwapFee, e.TickSpacing)
+}
+
+type PositionTickSpacingError struct {
+	PositionId  uint64
+	LowerTick   int64
+	UpperTick   int64
+	TickSpacing uint64
+}
+
+func (e PositionTickSpacingError) Error() string {
+	return fmt.Sprintf("position (%d) with lowerTick (%d) and upperTick (%d) would not be divisible by the new tickSpacing (%d)", e.PositionId, e.LowerTick, e.UpperTick, e.TickSpacing)
+}
@@ -1,22 +1,36 @@
 package types
 
 const (
-	TypeEvtCreatePosition   = "create_position"
-	TypeEvtWithdrawPosition = "withdraw_position"
-	TypeEvtCollectFees      = "collect_fees"
+	TypeEvtCreatePosition    = "create_position"
+	TypeEvtWithdrawPosition  = "withdraw_position"
+	TypeEvtCollectFees       = "collect_fees"
+	TypeEvtCreateIncentive   = "create_incentive"
+	TypeEvtCollectIncentives = "collect_incentives"
+	TypeEvtAddToPosition     = "add_to_position"
+	TypeEvtCompoundFees      = "compound_fees"
 
-	AttributeValueCategory = ModuleName
-	AttributeKeyPoolId     = "pool_id"
-	AttributeAmount0       = "amount0"
-	AttributeAmount1       = "amount1"
-	AttributeKeySwapFee    = "swap_fee"
-	AttributeKeyTokensIn   = "tokens_in"
-	AttributeKeyTokensOut  = "tokens_out"
-	AttributeLiquidity     = "liquidity"
-	AttributeLowerTick     = "lower_tick"
-	AttributeUpperTick     = "upper_tick"
-	TypeEvtPoolJoined      = "pool_joined"
-	TypeEvtPoolExited      = "pool_exited"
-	TypeEvtPoolCreated     = "pool_created"
-	TypeEvtTokenSwapped    = "token_swapped"
+	TypeEvtUpdatePoolSwapFeeAndTickSpacing = "update_pool_swap_fee_and_tick_spacing"
+
+	AttributeValueCategory         = ModuleName
+	AttributeKeyPoolId             = "pool_id"
+	AttributeAmount0               = "amount0"
+	AttributeAmount1               = "amount1"
+	AttributeKeySwapFee            = "swap_fee"
+	AttributeKeyTickSpacing        = "tick_spacing"
+	AttributeKeyTokensIn           = "tokens_in"
+	AttributeKeyTokensOut          = "tokens_out"
+	AttributeLiquidity             = "liquidity"
+	AttributeLowerTick             = "lower_tick"
+	AttributeUpperTick             = "upper_tick"
+	AttributeIncentiveDenom        = "incentive_denom"
+	AttributeIncentiveAmount       = "incentive_amount"
+	AttributeIncentiveEmissionRate = "incentive_emission_rate"
+	AttributeIncentiveStartTime    = "incentive_start_time"
+	AttributeIncentiveMinUptime    = "incentive_min_uptime"
+	AttributeFrozenUntil           = "frozen_until"
+	AttributePositionId            = "position_id"
+	TypeEvtPoolJoined              = "pool_joined"
+	TypeEvtPoolExited              = "pool_exited"
+	TypeEvtPoolCreated             = "pool_created"
+	TypeEvtTokenSwapped            = "token_swapped"
 )
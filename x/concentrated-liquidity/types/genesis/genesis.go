@@ -0,0 +1,213 @@
+package genesis
+
+import (
+	"errors"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+)
+
+// DefaultGenesis returns the default GenesisState for the concentrated-liquidity module.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:         types.DefaultParams(),
+		PoolData:       []PoolData{},
+		Positions:      []PositionData{},
+		NextPositionId: 1,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenPoolIds := make(map[uint64]bool, len(gs.PoolData))
+	for _, poolData := range gs.PoolData {
+		pool, err := poolData.GetConcentratedPool()
+		if err != nil {
+			return err
+		}
+		poolId := pool.GetId()
+		if seenPoolIds[poolId] {
+			return fmt.Errorf("duplicate pool id (%d)", poolId)
+		}
+		seenPoolIds[poolId] = true
+
+		if err := poolData.validate(poolId); err != nil {
+			return err
+		}
+	}
+
+	if gs.NextPositionId == 0 {
+		return errors.New("next position id must be positive")
+	}
+
+	seenPositionIds := make(map[uint64]bool, len(gs.Positions))
+	for _, positionData := range gs.Positions {
+		position := positionData.Position
+		if seenPositionIds[position.PositionId] {
+			return fmt.Errorf("duplicate position id (%d)", position.PositionId)
+		}
+		seenPositionIds[position.PositionId] = true
+
+		if position.PositionId >= gs.NextPositionId {
+			return fmt.Errorf("position id (%d) must be less than the next position id (%d)", position.PositionId, gs.NextPositionId)
+		}
+		if !seenPoolIds[position.PoolId] {
+			return fmt.Errorf("position (%d) belongs to pool (%d) that is not in genesis", position.PositionId, position.PoolId)
+		}
+		if err := positionData.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetConcentratedPool returns the pool of the pool data unpacked as a concentrated pool.
+// Returns error if the pool is missing or is not a concentrated pool.
+func (p PoolData) GetConcentratedPool() (types.ConcentratedPoolExtension, error) {
+	if p.Pool == nil {
+		return nil, errors.New("pool cannot be empty")
+	}
+	pool, ok := p.Pool.GetCachedValue().(types.ConcentratedPoolExtension)
+	if !ok {
+		return nil, fmt.Errorf("pool must be a concentrated pool, was (%s)", p.Pool.TypeUrl)
+	}
+	return pool, nil
+}
+
+// validate validates the ticks, accumulators and incentive records of the pool data
+// belonging to the pool with the given id. Returns nil on success, error otherwise.
+func (p PoolData) validate(poolId uint64) error {
+	seenTicks := make(map[int64]bool, len(p.Ticks))
+	for _, tick := range p.Ticks {
+		if tick.PoolId != poolId {
+			return fmt.Errorf("tick (%d) pool id (%d) does not match pool id (%d)", tick.TickIndex, tick.PoolId, poolId)
+		}
+		if seenTicks[tick.TickIndex] {
+			return fmt.Errorf("duplicate tick (%d) in pool (%d)", tick.TickIndex, poolId)
+		}
+		seenTicks[tick.TickIndex] = true
+
+		if len(tick.Info.UptimeTrackers) != len(types.SupportedUptimes) {
+			return fmt.Errorf("tick (%d) in pool (%d) must have (%d) uptime trackers, was (%d)", tick.TickIndex, poolId, len(types.SupportedUptimes), len(tick.Info.UptimeTrackers))
+		}
+	}
+
+	if err := validateAccumulatorContent(p.FeeAccumulator); err != nil {
+		return fmt.Errorf("invalid fee accumulator for pool (%d): %w", poolId, err)
+	}
+
+	if len(p.UptimeAccumulators) != len(types.SupportedUptimes) {
+		return fmt.Errorf("pool (%d) must have (%d) uptime accumulators, was (%d)", poolId, len(types.SupportedUptimes), len(p.UptimeAccumulators))
+	}
+	for uptimeIndex, uptimeAccumulator := range p.UptimeAccumulators {
+		if err := validateAccumulatorContent(uptimeAccumulator); err != nil {
+			return fmt.Errorf("invalid uptime accumulator (%d) for pool (%d): %w", uptimeIndex, poolId, err)
+		}
+	}
+
+	for _, incentiveRecord := range p.IncentiveRecords {
+		if incentiveRecord.PoolId != poolId {
+			return fmt.Errorf("incentive record pool id (%d) does not match pool id (%d)", incentiveRecord.PoolId, poolId)
+		}
+		if _, err := sdk.AccAddressFromBech32(incentiveRecord.IncentiveCreator); err != nil {
+			return fmt.Errorf("invalid incentive record creator (%s): %w", incentiveRecord.IncentiveCreator, err)
+		}
+		if err := sdk.ValidateDenom(incentiveRecord.IncentiveDenom); err != nil {
+			return err
+		}
+		if incentiveRecord.RemainingAmount.IsNil() || incentiveRecord.RemainingAmount.IsNegative() {
+			return fmt.Errorf("incentive record remaining amount cannot be negative, was (%s)", incentiveRecord.RemainingAmount)
+		}
+		if incentiveRecord.EmissionRate.IsNil() || !incentiveRecord.EmissionRate.IsPositive() {
+			return fmt.Errorf("incentive record emission rate must be positive, was (%s)", incentiveRecord.EmissionRate)
+		}
+		if !isSupportedUptime(incentiveRecord) {
+			return types.InvalidMinUptimeError{PoolId: poolId, MinUptime: incentiveRecord.MinUptime, AuthorizedUptimes: types.SupportedUptimes}
+		}
+	}
+	return nil
+}
+
+// validate validates the position and its accumulator records. Returns nil on success, error otherwise.
+func (p PositionData) validate() error {
+	position := p.Position
+	if _, err := sdk.AccAddressFromBech32(position.Address); err != nil {
+		return fmt.Errorf("invalid position (%d) owner (%s): %w", position.PositionId, position.Address, err)
+	}
+	if position.LowerTick >= position.UpperTick {
+		return types.InvalidLowerUpperTickError{LowerTick: position.LowerTick, UpperTick: position.UpperTick}
+	}
+	if position.Liquidity.IsNil() || position.Liquidity.IsNegative() {
+		return types.NegativeLiquidityError{Liquidity: position.Liquidity}
+	}
+
+	if err := validateAccumulatorRecord(p.FeeAccumRecord); err != nil {
+		return fmt.Errorf("invalid fee accumulator record for position (%d): %w", position.PositionId, err)
+	}
+
+	seenUptimeIndexes := make(map[uint64]bool, len(p.UptimeAccumRecords))
+	for _, uptimeRecord := range p.UptimeAccumRecords {
+		if uptimeRecord.UptimeIndex >= uint64(len(types.SupportedUptimes)) {
+			return fmt.Errorf("position (%d) uptime index (%d) must be less than (%d)", position.PositionId, uptimeRecord.UptimeIndex, len(types.SupportedUptimes))
+		}
+		if seenUptimeIndexes[uptimeRecord.UptimeIndex] {
+			return fmt.Errorf("duplicate uptime index (%d) for position (%d)", uptimeRecord.UptimeIndex, position.PositionId)
+		}
+		seenUptimeIndexes[uptimeRecord.UptimeIndex] = true
+
+		if err := validateAccumulatorRecord(uptimeRecord.Record); err != nil {
+			return fmt.Errorf("invalid uptime accumulator record (%d) for position (%d): %w", uptimeRecord.UptimeIndex, position.PositionId, err)
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, poolData := range gs.PoolData {
+		if err := poolData.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p PoolData) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pool types.ConcentratedPoolExtension
+	return unpacker.UnpackAny(p.Pool, &pool)
+}
+
+func validateAccumulatorContent(content accum.AccumulatorContent) error {
+	if content.TotalShares.IsNil() || content.TotalShares.IsNegative() {
+		return fmt.Errorf("total shares cannot be negative, was (%s)", content.TotalShares)
+	}
+	return content.AccumValue.Validate()
+}
+
+func validateAccumulatorRecord(record accum.Record) error {
+	if record.NumShares.IsNil() || record.NumShares.IsNegative() {
+		return fmt.Errorf("number of shares cannot be negative, was (%s)", record.NumShares)
+	}
+	if err := record.InitAccumValue.Validate(); err != nil {
+		return err
+	}
+	return record.UnclaimedRewards.Validate()
+}
+
+func isSupportedUptime(incentiveRecord types.IncentiveRecord) bool {
+	for _, supportedUptime := range types.SupportedUptimes {
+		if incentiveRecord.MinUptime == supportedUptime {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,1702 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentrated-liquidity/genesis.proto
+
+package genesis
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	accum "github.com/osmosis-labs/osmosis/osmoutils/accum"
+	model "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/model"
+	types1 "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FullTick contains tick index and pool id along with other tick model
+// information.
+type FullTick struct {
+	// pool id associated with the tick.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// tick's index.
+	TickIndex int64 `protobuf:"varint,2,opt,name=tick_index,json=tickIndex,proto3" json:"tick_index,omitempty" yaml:"tick_index"`
+	// tick's info.
+	Info model.TickInfo `protobuf:"bytes,3,opt,name=info,proto3" json:"info" yaml:"tick_info"`
+}
+
+func (m *FullTick) Reset()         { *m = FullTick{} }
+func (m *FullTick) String() string { return proto.CompactTextString(m) }
+func (*FullTick) ProtoMessage()    {}
+func (*FullTick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c140d686ee6724a, []int{0}
+}
+func (m *FullTick) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FullTick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FullTick.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FullTick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FullTick.Merge(m, src)
+}
+func (m *FullTick) XXX_Size() int {
+	return m.Size()
+}
+func (m *FullTick) XXX_DiscardUnknown() {
+	xxx_messageInfo_FullTick.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FullTick proto.InternalMessageInfo
+
+func (m *FullTick) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *FullTick) GetTickIndex() int64 {
+	if m != nil {
+		return m.TickIndex
+	}
+	return 0
+}
+
+func (m *FullTick) GetInfo() model.TickInfo {
+	if m != nil {
+		return m.Info
+	}
+	return model.TickInfo{}
+}
+
+// PoolData represents a serialized pool along with its ticks, accumulators
+// and incentive records for genesis state.
+type PoolData struct {
+	// pool struct
+	Pool *types.Any `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	// pool's ticks
+	Ticks []FullTick `protobuf:"bytes,2,rep,name=ticks,proto3" json:"ticks" yaml:"ticks"`
+	// fee_accumulator is the content of the pool's fee accumulator.
+	FeeAccumulator accum.AccumulatorContent `protobuf:"bytes,3,opt,name=fee_accumulator,json=feeAccumulator,proto3" json:"fee_accumulator" yaml:"fee_accumulator"`
+	// uptime_accumulators are the contents of the pool's uptime accumulators,
+	// in the same order as types.SupportedUptimes.
+	UptimeAccumulators []accum.AccumulatorContent `protobuf:"bytes,4,rep,name=uptime_accumulators,json=uptimeAccumulators,proto3" json:"uptime_accumulators" yaml:"uptime_accumulators"`
+	// incentive_records are the pool's active incentive records.
+	IncentiveRecords []types1.IncentiveRecord `protobuf:"bytes,5,rep,name=incentive_records,json=incentiveRecords,proto3" json:"incentive_records" yaml:"incentive_records"`
+}
+
+func (m *PoolData) Reset()         { *m = PoolData{} }
+func (m *PoolData) String() string { return proto.CompactTextString(m) }
+func (*PoolData) ProtoMessage()    {}
+func (*PoolData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c140d686ee6724a, []int{1}
+}
+func (m *PoolData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolData.Merge(m, src)
+}
+func (m *PoolData) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolData proto.InternalMessageInfo
+
+func (m *PoolData) GetPool() *types.Any {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+func (m *PoolData) GetTicks() []FullTick {
+	if m != nil {
+		return m.Ticks
+	}
+	return nil
+}
+
+func (m *PoolData) GetFeeAccumulator() accum.AccumulatorContent {
+	if m != nil {
+		return m.FeeAccumulator
+	}
+	return accum.AccumulatorContent{}
+}
+
+func (m *PoolData) GetUptimeAccumulators() []accum.AccumulatorContent {
+	if m != nil {
+		return m.UptimeAccumulators
+	}
+	return nil
+}
+
+func (m *PoolData) GetIncentiveRecords() []types1.IncentiveRecord {
+	if m != nil {
+		return m.IncentiveRecords
+	}
+	return nil
+}
+
+// UptimeAccumRecord is a position's record in the uptime accumulator at the
+// given index of types.SupportedUptimes.
+type UptimeAccumRecord struct {
+	UptimeIndex uint64       `protobuf:"varint,1,opt,name=uptime_index,json=uptimeIndex,proto3" json:"uptime_index,omitempty" yaml:"uptime_index"`
+	Record      accum.Record `protobuf:"bytes,2,opt,name=record,proto3" json:"record" yaml:"record"`
+}
+
+func (m *UptimeAccumRecord) Reset()         { *m = UptimeAccumRecord{} }
+func (m *UptimeAccumRecord) String() string { return proto.CompactTextString(m) }
+func (*UptimeAccumRecord) ProtoMessage()    {}
+func (*UptimeAccumRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c140d686ee6724a, []int{2}
+}
+func (m *UptimeAccumRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UptimeAccumRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UptimeAccumRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UptimeAccumRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UptimeAccumRecord.Merge(m, src)
+}
+func (m *UptimeAccumRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *UptimeAccumRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UptimeAccumRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UptimeAccumRecord proto.InternalMessageInfo
+
+func (m *UptimeAccumRecord) GetUptimeIndex() uint64 {
+	if m != nil {
+		return m.UptimeIndex
+	}
+	return 0
+}
+
+func (m *UptimeAccumRecord) GetRecord() accum.Record {
+	if m != nil {
+		return m.Record
+	}
+	return accum.Record{}
+}
+
+// PositionData represents a position along with its records in the pool's
+// fee and uptime accumulators for genesis state.
+type PositionData struct {
+	Position       model.Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position" yaml:"position"`
+	FeeAccumRecord accum.Record   `protobuf:"bytes,2,opt,name=fee_accum_record,json=feeAccumRecord,proto3" json:"fee_accum_record" yaml:"fee_accum_record"`
+	// uptime_accum_records are the position's records in the pool's uptime
+	// accumulators. Positions that are not frozen for long enough to qualify
+	// for an uptime have no record in the respective accumulator.
+	UptimeAccumRecords []UptimeAccumRecord `protobuf:"bytes,3,rep,name=uptime_accum_records,json=uptimeAccumRecords,proto3" json:"uptime_accum_records" yaml:"uptime_accum_records"`
+}
+
+func (m *PositionData) Reset()         { *m = PositionData{} }
+func (m *PositionData) String() string { return proto.CompactTextString(m) }
+func (*PositionData) ProtoMessage()    {}
+func (*PositionData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c140d686ee6724a, []int{3}
+}
+func (m *PositionData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionData.Merge(m, src)
+}
+func (m *PositionData) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionData proto.InternalMessageInfo
+
+func (m *PositionData) GetPosition() model.Position {
+	if m != nil {
+		return m.Position
+	}
+	return model.Position{}
+}
+
+func (m *PositionData) GetFeeAccumRecord() accum.Record {
+	if m != nil {
+		return m.FeeAccumRecord
+	}
+	return accum.Record{}
+}
+
+func (m *PositionData) GetUptimeAccumRecords() []UptimeAccumRecord {
+	if m != nil {
+		return m.UptimeAccumRecords
+	}
+	return nil
+}
+
+// GenesisState defines the concentrated liquidity module's genesis state.
+type GenesisState struct {
+	// params are all the parameters of the module
+	Params types1.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// pool data containing serialized pool struct and ticks.
+	PoolData       []PoolData     `protobuf:"bytes,2,rep,name=pool_data,json=poolData,proto3" json:"pool_data"`
+	Positions      []PositionData `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions"`
+	NextPositionId uint64         `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c140d686ee6724a, []int{4}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() types1.Params {
+	if m != nil {
+		return m.Params
+	}
+	return types1.Params{}
+}
+
+func (m *GenesisState) GetPoolData() []PoolData {
+	if m != nil {
+		return m.PoolData
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPositions() []PositionData {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextPositionId() uint64 {
+	if m != nil {
+		return m.NextPositionId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FullTick)(nil), "osmosis.concentratedliquidity.v1beta1.FullTick")
+	proto.RegisterType((*PoolData)(nil), "osmosis.concentratedliquidity.v1beta1.PoolData")
+	proto.RegisterType((*UptimeAccumRecord)(nil), "osmosis.concentratedliquidity.v1beta1.UptimeAccumRecord")
+	proto.RegisterType((*PositionData)(nil), "osmosis.concentratedliquidity.v1beta1.PositionData")
+	proto.RegisterType((*GenesisState)(nil), "osmosis.concentratedliquidity.v1beta1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/genesis.proto", fileDescriptor_5c140d686ee6724a)
+}
+
+var fileDescriptor_5c140d686ee6724a = []byte{
+	// 827 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcb, 0x6e, 0x23, 0x45,
+	0x14, 0x75, 0xdb, 0x1e, 0xe3, 0x94, 0x4d, 0xc6, 0xa9, 0xf1, 0x90, 0x9e, 0x8c, 0xc6, 0x36, 0x85,
+	0x46, 0x0a, 0x1a, 0xdc, 0xad, 0x64, 0x06, 0x84, 0x66, 0x97, 0x0e, 0x21, 0x32, 0x62, 0x11, 0x35,
+	0x41, 0x48, 0x20, 0xd4, 0x6a, 0x77, 0x97, 0x9d, 0x52, 0xda, 0x5d, 0xc6, 0x55, 0x1d, 0xd9, 0x1b,
+	0x76, 0xac, 0xe1, 0x07, 0xf8, 0x03, 0x96, 0xfc, 0x03, 0x11, 0x62, 0x91, 0x25, 0x2b, 0x0b, 0x25,
+	0x3f, 0x80, 0xfc, 0x05, 0xa8, 0xeb, 0xe1, 0x57, 0x88, 0x6c, 0xef, 0x5c, 0x55, 0xf7, 0x9e, 0x73,
+	0xee, 0xad, 0x53, 0xb7, 0x0d, 0x3e, 0xa2, 0xac, 0x47, 0x19, 0x61, 0x76, 0x40, 0xe3, 0x00, 0xc7,
+	0x7c, 0xe0, 0x73, 0x1c, 0x36, 0x23, 0xf2, 0x43, 0x42, 0x42, 0xc2, 0x47, 0x76, 0x17, 0xc7, 0x98,
+	0x11, 0x66, 0xf5, 0x07, 0x94, 0x53, 0xf8, 0x52, 0x45, 0x5b, 0xf3, 0xd1, 0xd3, 0x60, 0xeb, 0xea,
+	0xa0, 0x8d, 0xb9, 0x7f, 0xb0, 0x57, 0xed, 0xd2, 0x2e, 0x15, 0x19, 0x76, 0xfa, 0x4b, 0x26, 0xef,
+	0x3d, 0x0b, 0x44, 0xb6, 0x27, 0x0f, 0xe4, 0x42, 0x1f, 0x75, 0x29, 0xed, 0x46, 0xd8, 0x16, 0xab,
+	0x76, 0xd2, 0xb1, 0xfd, 0x78, 0xa4, 0x8e, 0xde, 0xd7, 0x02, 0xfd, 0x20, 0x48, 0x7a, 0xb6, 0xa2,
+	0x90, 0x2b, 0x15, 0xf2, 0x6a, 0x45, 0x0d, 0x7d, 0x7f, 0xe0, 0xf7, 0x34, 0x55, 0x73, 0x55, 0x30,
+	0x65, 0x84, 0x13, 0x1a, 0xaf, 0x19, 0xce, 0x49, 0x70, 0xd9, 0x8a, 0x3b, 0xba, 0xc6, 0x8f, 0x57,
+	0x84, 0x13, 0xb1, 0x4b, 0xae, 0xb0, 0x37, 0xc0, 0x01, 0x1d, 0x84, 0x32, 0x0d, 0xfd, 0x65, 0x80,
+	0xe2, 0xe7, 0x49, 0x14, 0x9d, 0x93, 0xe0, 0x12, 0xbe, 0x02, 0xef, 0xf4, 0x29, 0x8d, 0x3c, 0x12,
+	0x9a, 0x46, 0xc3, 0xd8, 0xcf, 0x3b, 0x70, 0x32, 0xae, 0x6f, 0x8f, 0xfc, 0x5e, 0xf4, 0x16, 0xa9,
+	0x03, 0xe4, 0x16, 0xd2, 0x5f, 0xad, 0x10, 0xbe, 0x01, 0x20, 0x95, 0xe0, 0x91, 0x38, 0xc4, 0x43,
+	0x33, 0xdb, 0x30, 0xf6, 0x73, 0xce, 0xd3, 0xc9, 0xb8, 0xbe, 0x23, 0xe3, 0x67, 0x67, 0xc8, 0xdd,
+	0x92, 0x5a, 0x43, 0x3c, 0x84, 0xdf, 0x83, 0x3c, 0x89, 0x3b, 0xd4, 0xcc, 0x35, 0x8c, 0xfd, 0xd2,
+	0xa1, 0x6d, 0xad, 0x75, 0xad, 0xd6, 0xb9, 0xaa, 0xd5, 0x31, 0xaf, 0xc7, 0xf5, 0xcc, 0x64, 0x5c,
+	0xaf, 0x2c, 0x90, 0x74, 0x28, 0x72, 0x05, 0x2c, 0xfa, 0x2d, 0x0f, 0x8a, 0x67, 0x94, 0x46, 0x9f,
+	0xf9, 0xdc, 0x87, 0xa7, 0x20, 0x9f, 0x6a, 0x15, 0xb5, 0x94, 0x0e, 0xab, 0x96, 0xbc, 0x6a, 0x4b,
+	0x5f, 0xb5, 0x75, 0x14, 0x8f, 0x9c, 0x17, 0x7f, 0xfe, 0xde, 0x7c, 0x76, 0x3c, 0x47, 0x9e, 0x66,
+	0x9f, 0x0c, 0x39, 0x8e, 0x19, 0xa1, 0xb1, 0x2b, 0x00, 0xe0, 0x77, 0xe0, 0x51, 0xca, 0xc4, 0xcc,
+	0x6c, 0x23, 0xb7, 0x81, 0x6a, 0xdd, 0x57, 0xa7, 0xaa, 0x54, 0x97, 0x67, 0xaa, 0x19, 0x72, 0x25,
+	0x26, 0x1c, 0x80, 0xc7, 0x1d, 0x8c, 0x3d, 0x61, 0xab, 0x24, 0xf2, 0x39, 0x1d, 0xa8, 0xe6, 0x7c,
+	0x38, 0xa5, 0x11, 0x67, 0x53, 0xd8, 0xa3, 0x59, 0xe4, 0x31, 0x8d, 0x39, 0x8e, 0xb9, 0x53, 0x53,
+	0x04, 0xef, 0x49, 0x82, 0x25, 0x3c, 0xe4, 0x6e, 0x77, 0x30, 0x9e, 0x4b, 0x83, 0x3f, 0x82, 0x27,
+	0x49, 0x9f, 0x93, 0xde, 0x42, 0x18, 0x33, 0xf3, 0x8d, 0xdc, 0x66, 0xbc, 0x48, 0xf1, 0xee, 0x49,
+	0xde, 0xff, 0xc1, 0x44, 0x2e, 0x94, 0xbb, 0x73, 0xd9, 0x0c, 0xfe, 0x64, 0x80, 0x9d, 0x65, 0x43,
+	0x32, 0xf3, 0x91, 0xa0, 0xff, 0x64, 0xcd, 0xee, 0xb6, 0x74, 0xbe, 0x2b, 0xd2, 0x9d, 0x86, 0xd2,
+	0x62, 0x4a, 0x2d, 0xf7, 0xe0, 0x91, 0x5b, 0x21, 0x8b, 0x29, 0x0c, 0xfd, 0x6a, 0x80, 0x9d, 0xaf,
+	0x67, 0xf2, 0xe4, 0x36, 0x7c, 0x0b, 0xca, 0xaa, 0x12, 0xe9, 0x6d, 0xf9, 0x16, 0x76, 0x27, 0xe3,
+	0xfa, 0x93, 0x85, 0x3a, 0x95, 0xbb, 0x4b, 0x72, 0x29, 0xfd, 0xfd, 0x25, 0x28, 0x48, 0x3e, 0xf1,
+	0x22, 0x4a, 0x87, 0x2f, 0x1e, 0x68, 0xa6, 0x12, 0xfd, 0x54, 0x89, 0x7e, 0x57, 0x02, 0xcb, 0x54,
+	0xe4, 0x2a, 0x0c, 0xf4, 0x6f, 0x16, 0x94, 0xcf, 0xd4, 0x58, 0x10, 0x96, 0x0e, 0x41, 0x51, 0x8f,
+	0x09, 0x65, 0xeb, 0x75, 0xcd, 0xa8, 0x61, 0x9c, 0x5d, 0x45, 0xf9, 0x58, 0xbf, 0x6b, 0xb9, 0x8f,
+	0xdc, 0x29, 0x32, 0xbc, 0x00, 0x95, 0xa9, 0x85, 0xbc, 0x4d, 0xca, 0xa9, 0x2b, 0xec, 0xdd, 0x25,
+	0x1f, 0x7a, 0xba, 0xb0, 0xa9, 0x11, 0x55, 0xab, 0x7f, 0x36, 0x40, 0x75, 0xde, 0x35, 0x53, 0x2f,
+	0xe4, 0x84, 0x17, 0x3e, 0x5d, 0xb3, 0xb8, 0x7b, 0x77, 0xe8, 0x7c, 0xa0, 0x94, 0x3c, 0xbf, 0xef,
+	0xcc, 0x99, 0x21, 0x60, 0xb2, 0x9c, 0xc7, 0xd0, 0x1f, 0x59, 0x50, 0x3e, 0x95, 0x9f, 0x9e, 0xaf,
+	0xb8, 0xcf, 0x31, 0x3c, 0x06, 0x05, 0x39, 0xc6, 0x55, 0xc3, 0x5f, 0xae, 0xd0, 0x74, 0x26, 0x82,
+	0x9d, 0x7c, 0x2a, 0xc0, 0x55, 0xa9, 0xd0, 0x05, 0x5b, 0x62, 0x80, 0x86, 0x3e, 0xf7, 0x37, 0x9c,
+	0x22, 0x7a, 0x9c, 0x29, 0xc4, 0x62, 0x5f, 0x8f, 0xb7, 0x6f, 0xc0, 0x96, 0xbe, 0x31, 0xdd, 0xaf,
+	0xd7, 0x1b, 0x9a, 0x61, 0x0e, 0x77, 0x86, 0x05, 0x4f, 0x40, 0x25, 0xc6, 0x43, 0xee, 0xe9, 0x9d,
+	0xf4, 0x7b, 0x90, 0x17, 0x6f, 0xe0, 0xf9, 0xec, 0x6e, 0x97, 0x23, 0x90, 0xbb, 0x9d, 0x6e, 0x69,
+	0xe4, 0x56, 0xe8, 0x84, 0xd7, 0xb7, 0x35, 0xe3, 0xe6, 0xb6, 0x66, 0xfc, 0x73, 0x5b, 0x33, 0x7e,
+	0xb9, 0xab, 0x65, 0x6e, 0xee, 0x6a, 0x99, 0xbf, 0xef, 0x6a, 0x99, 0x6f, 0xbf, 0xe8, 0x12, 0x7e,
+	0x91, 0xb4, 0xad, 0x80, 0xf6, 0x6c, 0x25, 0xb8, 0x19, 0xf9, 0x6d, 0xa6, 0x17, 0xf6, 0xd5, 0xc1,
+	0x1b, 0x7b, 0xf8, 0xe0, 0x87, 0x6f, 0xd4, 0xc7, 0x4c, 0xff, 0x3d, 0x68, 0x17, 0xc4, 0x38, 0x7f,
+	0xfd, 0xdf, 0x00, 0x53, 0x54, 0x8e, 0x30, 0x4f, 0x08, 0x00, 0x00,
+}
+
+func (m *FullTick) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FullTick) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FullTick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TickIndex != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IncentiveRecords) > 0 {
+		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UptimeAccumulators) > 0 {
+		for iNdEx := len(m.UptimeAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.FeeAccumulator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Ticks) > 0 {
+		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UptimeAccumRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UptimeAccumRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UptimeAccumRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.UptimeIndex != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UptimeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UptimeAccumRecords) > 0 {
+		for iNdEx := len(m.UptimeAccumRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeAccumRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.FeeAccumRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextPositionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPositionId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PoolData) > 0 {
+		for iNdEx := len(m.PoolData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FullTick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovGenesis(uint64(m.TickIndex))
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PoolData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Ticks) > 0 {
+		for _, e := range m.Ticks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.FeeAccumulator.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.UptimeAccumulators) > 0 {
+		for _, e := range m.UptimeAccumulators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IncentiveRecords) > 0 {
+		for _, e := range m.IncentiveRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UptimeAccumRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UptimeIndex != 0 {
+		n += 1 + sovGenesis(uint64(m.UptimeIndex))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PositionData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeAccumRecord.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.UptimeAccumRecords) > 0 {
+		for _, e := range m.UptimeAccumRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolData) > 0 {
+		for _, e := range m.PoolData {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPositionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPositionId))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FullTick) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FullTick: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FullTick: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &types.Any{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticks = append(m.Ticks, FullTick{})
+			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAccumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeAccumulators = append(m.UptimeAccumulators, accum.AccumulatorContent{})
+			if err := m.UptimeAccumulators[len(m.UptimeAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveRecords = append(m.IncentiveRecords, types1.IncentiveRecord{})
+			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UptimeAccumRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UptimeAccumRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UptimeAccumRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeIndex", wireType)
+			}
+			m.UptimeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UptimeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAccumRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeAccumRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeAccumRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeAccumRecords = append(m.UptimeAccumRecords, UptimeAccumRecord{})
+			if err := m.UptimeAccumRecords[len(m.UptimeAccumRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolData = append(m.PoolData, PoolData{})
+			if err := m.PoolData[len(m.PoolData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, PositionData{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPositionId", wireType)
+			}
+			m.NextPositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,160 @@
+package genesis_test
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types/genesis"
+)
+
+var (
+	testAddress = sdk.AccAddress([]byte("addr1_______________")).String()
+	testRecord  = accum.Record{NumShares: sdk.OneDec()}
+)
+
+func validGenesis(t *testing.T) genesis.GenesisState {
+	pool, err := model.NewConcentratedLiquidityPool(1, "uosmo", "uatom", 1, sdk.NewInt(-4), sdk.ZeroDec())
+	require.NoError(t, err)
+	poolAny, err := codectypes.NewAnyWithValue(&pool)
+	require.NoError(t, err)
+
+	uptimeAccumulators := make([]accum.AccumulatorContent, len(types.SupportedUptimes))
+	for i := range uptimeAccumulators {
+		uptimeAccumulators[i].TotalShares = sdk.ZeroDec()
+	}
+
+	return genesis.GenesisState{
+		Params: types.DefaultParams(),
+		PoolData: []genesis.PoolData{
+			{
+				Pool: poolAny,
+				Ticks: []genesis.FullTick{
+					{PoolId: 1, TickIndex: -10, Info: model.TickInfo{UptimeTrackers: make([]model.UptimeTracker, len(types.SupportedUptimes))}},
+					{PoolId: 1, TickIndex: 10, Info: model.TickInfo{UptimeTrackers: make([]model.UptimeTracker, len(types.SupportedUptimes))}},
+				},
+				FeeAccumulator:     accum.AccumulatorContent{TotalShares: sdk.OneDec()},
+				UptimeAccumulators: uptimeAccumulators,
+				IncentiveRecords: []types.IncentiveRecord{
+					{
+						PoolId:           1,
+						IncentiveDenom:   "uion",
+						IncentiveCreator: testAddress,
+						RemainingAmount:  sdk.NewDec(100),
+						EmissionRate:     sdk.OneDec(),
+						MinUptime:        time.Hour,
+					},
+				},
+			},
+		},
+		Positions: []genesis.PositionData{
+			{
+				Position: model.Position{
+					PositionId: 1,
+					Address:    testAddress,
+					PoolId:     1,
+					LowerTick:  -10,
+					UpperTick:  10,
+					Liquidity:  sdk.OneDec(),
+				},
+				FeeAccumRecord:     testRecord,
+				UptimeAccumRecords: []genesis.UptimeAccumRecord{{UptimeIndex: 0, Record: testRecord}},
+			},
+		},
+		NextPositionId: 2,
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	tests := map[string]struct {
+		modify    func(*genesis.GenesisState)
+		expectErr bool
+	}{
+		"valid genesis": {
+			modify: func(*genesis.GenesisState) {},
+		},
+		"valid default genesis": {
+			modify: func(gs *genesis.GenesisState) { *gs = *genesis.DefaultGenesis() },
+		},
+		"invalid: missing pool": {
+			modify:    func(gs *genesis.GenesisState) { gs.PoolData[0].Pool = nil },
+			expectErr: true,
+		},
+		"invalid: duplicate pool": {
+			modify:    func(gs *genesis.GenesisState) { gs.PoolData = append(gs.PoolData, gs.PoolData[0]) },
+			expectErr: true,
+		},
+		"invalid: tick pool id does not match the pool": {
+			modify:    func(gs *genesis.GenesisState) { gs.PoolData[0].Ticks[0].PoolId = 2 },
+			expectErr: true,
+		},
+		"invalid: duplicate tick": {
+			modify:    func(gs *genesis.GenesisState) { gs.PoolData[0].Ticks[1].TickIndex = -10 },
+			expectErr: true,
+		},
+		"invalid: wrong number of uptime accumulators": {
+			modify: func(gs *genesis.GenesisState) {
+				gs.PoolData[0].UptimeAccumulators = gs.PoolData[0].UptimeAccumulators[1:]
+			},
+			expectErr: true,
+		},
+		"invalid: negative fee accumulator shares": {
+			modify:    func(gs *genesis.GenesisState) { gs.PoolData[0].FeeAccumulator.TotalShares = sdk.NewDec(-1) },
+			expectErr: true,
+		},
+		"invalid: unsupported incentive record uptime": {
+			modify:    func(gs *genesis.GenesisState) { gs.PoolData[0].IncentiveRecords[0].MinUptime = time.Second },
+			expectErr: true,
+		},
+		"invalid: zero next position id": {
+			modify:    func(gs *genesis.GenesisState) { gs.NextPositionId = 0 },
+			expectErr: true,
+		},
+		"invalid: position id not below next position id": {
+			modify:    func(gs *genesis.GenesisState) { gs.NextPositionId = 1 },
+			expectErr: true,
+		},
+		"invalid: duplicate position": {
+			modify:    func(gs *genesis.GenesisState) { gs.Positions = append(gs.Positions, gs.Positions[0]) },
+			expectErr: true,
+		},
+		"invalid: position in unknown pool": {
+			modify:    func(gs *genesis.GenesisState) { gs.Positions[0].Position.PoolId = 2 },
+			expectErr: true,
+		},
+		"invalid: position lower tick not below upper tick": {
+			modify:    func(gs *genesis.GenesisState) { gs.Positions[0].Position.LowerTick = 10 },
+			expectErr: true,
+		},
+		"invalid: negative fee record shares": {
+			modify:    func(gs *genesis.GenesisState) { gs.Positions[0].FeeAccumRecord.NumShares = sdk.NewDec(-1) },
+			expectErr: true,
+		},
+		"invalid: uptime record index out of range": {
+			modify: func(gs *genesis.GenesisState) {
+				gs.Positions[0].UptimeAccumRecords[0].UptimeIndex = uint64(len(types.SupportedUptimes))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			gs := validGenesis(t)
+			tc.modify(&gs)
+
+			err := gs.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeUpdatePoolSwapFeeAndTickSpacing = "UpdatePoolSwapFeeAndTickSpacing"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdatePoolSwapFeeAndTickSpacing)
+	govtypes.RegisterProposalTypeCodec(&UpdatePoolSwapFeeAndTickSpacingProposal{}, "osmosis/UpdatePoolSwapFeeAndTickSpacingProposal")
+}
+
+var _ govtypes.Content = &UpdatePoolSwapFeeAndTickSpacingProposal{}
+
+func NewUpdatePoolSwapFeeAndTickSpacingProposal(title, description string, poolId uint64, swapFee sdk.Dec, tickSpacing uint64) UpdatePoolSwapFeeAndTickSpacingProposal {
+	return UpdatePoolSwapFeeAndTickSpacingProposal{
+		Title:       title,
+		Description: description,
+		PoolId:      poolId,
+		SwapFee:     swapFee,
+		TickSpacing: tickSpacing,
+	}
+}
+
+func (p *UpdatePoolSwapFeeAndTickSpacingProposal) GetTitle() string { return p.Title }
+
+func (p *UpdatePoolSwapFeeAndTickSpacingProposal) GetDescription() string { return p.Description }
+
+func (p *UpdatePoolSwapFeeAndTickSpacingProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdatePoolSwapFeeAndTickSpacingProposal) ProposalType() string {
+	return ProposalTypeUpdatePoolSwapFeeAndTickSpacing
+}
+
+func (p *UpdatePoolSwapFeeAndTickSpacingProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.PoolId == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+
+	if p.SwapFee.IsNil() || p.SwapFee.IsNegative() || p.SwapFee.GTE(sdk.OneDec()) {
+		return InvalidSwapFeeError{ActualFee: p.SwapFee}
+	}
+
+	if p.TickSpacing == 0 {
+		return fmt.Errorf("tick spacing must be positive")
+	}
+
+	return nil
+}
+
+func (p UpdatePoolSwapFeeAndTickSpacingProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Pool Swap Fee And Tick Spacing Proposal:
+  Title:       %s
+  Description: %s
+  Pool ID:     %d
+  Swap Fee:    %s
+  Tick Spacing: %d
+`, p.Title, p.Description, p.PoolId, p.SwapFee, p.TickSpacing))
+	return b.String()
+}
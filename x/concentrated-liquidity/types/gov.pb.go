@@ -0,0 +1,516 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentrated-liquidity/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UpdatePoolSwapFeeAndTickSpacingProposal is a gov Content type for updating
+// the swap fee and tick spacing of an existing concentrated-liquidity pool.
+// The new swap fee and tick spacing must be one of the authorized swap fee
+// tiers, and the ticks of every existing position in the pool must be
+// divisible by the new tick spacing.
+type UpdatePoolSwapFeeAndTickSpacingProposal struct {
+	Title       string                                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	PoolId      uint64                                 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	SwapFee     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	TickSpacing uint64                                 `protobuf:"varint,5,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty" yaml:"tick_spacing"`
+}
+
+func (m *UpdatePoolSwapFeeAndTickSpacingProposal) Reset() {
+	*m = UpdatePoolSwapFeeAndTickSpacingProposal{}
+}
+func (*UpdatePoolSwapFeeAndTickSpacingProposal) ProtoMessage() {}
+func (*UpdatePoolSwapFeeAndTickSpacingProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6d167276ceeedc2, []int{0}
+}
+func (m *UpdatePoolSwapFeeAndTickSpacingProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatePoolSwapFeeAndTickSpacingProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePoolSwapFeeAndTickSpacingProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdatePoolSwapFeeAndTickSpacingProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePoolSwapFeeAndTickSpacingProposal.Merge(m, src)
+}
+func (m *UpdatePoolSwapFeeAndTickSpacingProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatePoolSwapFeeAndTickSpacingProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePoolSwapFeeAndTickSpacingProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePoolSwapFeeAndTickSpacingProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*UpdatePoolSwapFeeAndTickSpacingProposal)(nil), "osmosis.concentratedliquidity.v1beta1.UpdatePoolSwapFeeAndTickSpacingProposal")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/gov.proto", fileDescriptor_e6d167276ceeedc2)
+}
+
+var fileDescriptor_e6d167276ceeedc2 = []byte{
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbf, 0x8e, 0xd3, 0x40,
+	0x10, 0xc6, 0xed, 0xe3, 0xfe, 0x80, 0x2f, 0x02, 0xb4, 0x20, 0xb0, 0x28, 0xbc, 0x27, 0x4b, 0x1c,
+	0x91, 0x50, 0x6c, 0x45, 0x50, 0xa0, 0x74, 0x67, 0x21, 0x24, 0xba, 0x93, 0x0f, 0x1a, 0x74, 0x52,
+	0xb4, 0xde, 0x5d, 0xcc, 0x2a, 0x8e, 0x67, 0xf1, 0x6e, 0x12, 0xf2, 0x06, 0x94, 0x94, 0x94, 0x79,
+	0x9c, 0x94, 0x29, 0x11, 0x85, 0x15, 0x25, 0x0d, 0xb5, 0x9f, 0x00, 0x65, 0xed, 0x10, 0x37, 0x54,
+	0x1e, 0xef, 0xf7, 0x9b, 0xd9, 0x99, 0x6f, 0xd6, 0xe9, 0x82, 0x1a, 0x83, 0x12, 0x2a, 0xa4, 0x90,
+	0x53, 0x9e, 0xeb, 0x82, 0x68, 0xce, 0x7a, 0x99, 0xf8, 0x3a, 0x11, 0x4c, 0xe8, 0x79, 0x98, 0xc2,
+	0x34, 0x90, 0x05, 0x68, 0x40, 0xcf, 0x1b, 0x32, 0x68, 0x93, 0xff, 0xc0, 0x60, 0xda, 0x4f, 0xb8,
+	0x26, 0xfd, 0x67, 0x8f, 0x53, 0x48, 0xc1, 0x64, 0x84, 0xbb, 0xa8, 0x4e, 0xf6, 0xd7, 0x47, 0xce,
+	0x8b, 0x8f, 0x92, 0x11, 0xcd, 0xaf, 0x01, 0xb2, 0x9b, 0x19, 0x91, 0xef, 0x38, 0xbf, 0xca, 0xd9,
+	0x07, 0x41, 0x47, 0x37, 0x92, 0x50, 0x91, 0xa7, 0xd7, 0x05, 0x48, 0x50, 0x24, 0x43, 0x97, 0xce,
+	0x89, 0x16, 0x3a, 0xe3, 0xae, 0x7d, 0x61, 0x77, 0xef, 0x45, 0x0f, 0xab, 0x12, 0x77, 0xe6, 0x64,
+	0x9c, 0x0d, 0x7c, 0x73, 0xec, 0xc7, 0xb5, 0x8c, 0xde, 0x38, 0xe7, 0x8c, 0x2b, 0x5a, 0x08, 0xa9,
+	0x05, 0xe4, 0xee, 0x91, 0xa1, 0x9f, 0x54, 0x25, 0x46, 0x35, 0xdd, 0x12, 0xfd, 0xb8, 0x8d, 0xa2,
+	0x97, 0xce, 0x99, 0x04, 0xc8, 0x86, 0x82, 0xb9, 0x77, 0x2e, 0xec, 0xee, 0x71, 0x84, 0xaa, 0x12,
+	0xdf, 0xaf, 0xb3, 0x1a, 0xc1, 0x8f, 0x4f, 0x77, 0xd1, 0x7b, 0x86, 0x6e, 0x9d, 0xbb, 0x6a, 0x46,
+	0xe4, 0xf0, 0x33, 0xe7, 0xee, 0xb1, 0xb9, 0xe3, 0x6a, 0x59, 0x62, 0xeb, 0x77, 0x89, 0x2f, 0x53,
+	0xa1, 0xbf, 0x4c, 0x92, 0x80, 0xc2, 0x38, 0xa4, 0xc6, 0x9d, 0xe6, 0xd3, 0x53, 0x6c, 0x14, 0xea,
+	0xb9, 0xe4, 0x2a, 0x78, 0xcb, 0x69, 0x55, 0xe2, 0x07, 0x75, 0xed, 0x7d, 0x1d, 0x3f, 0x3e, 0x53,
+	0xb5, 0x05, 0x68, 0xe0, 0x74, 0xb4, 0xa0, 0xa3, 0xa1, 0xaa, 0x4d, 0x70, 0x4f, 0x4c, 0x3f, 0x4f,
+	0xab, 0x12, 0x3f, 0xda, 0xcf, 0x7c, 0x50, 0xfd, 0xf8, 0x5c, 0x1f, 0x0c, 0x1b, 0x74, 0xbe, 0x2f,
+	0xb0, 0xf5, 0x73, 0x81, 0xad, 0x3f, 0x0b, 0x6c, 0x47, 0xb7, 0xcb, 0x8d, 0x67, 0xaf, 0x36, 0x9e,
+	0xbd, 0xde, 0x78, 0xf6, 0x8f, 0xad, 0x67, 0xad, 0xb6, 0x9e, 0xf5, 0x6b, 0xeb, 0x59, 0x9f, 0xa2,
+	0x56, 0x9f, 0xcd, 0x12, 0x7b, 0x19, 0x49, 0xd4, 0xfe, 0x27, 0x9c, 0xf6, 0x5f, 0x87, 0xdf, 0xfe,
+	0xf7, 0x02, 0xcc, 0x1c, 0xc9, 0xa9, 0xd9, 0xe3, 0xab, 0xbf, 0x03, 0x00, 0xc5, 0xde, 0x59, 0x2a,
+	0x30, 0x02, 0x00, 0x00,
+}
+
+func (this *UpdatePoolSwapFeeAndTickSpacingProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdatePoolSwapFeeAndTickSpacingProposal)
+	if !ok {
+		that2, ok := that.(UpdatePoolSwapFeeAndTickSpacingProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.SwapFee.Equal(that1.SwapFee) {
+		return false
+	}
+	if this.TickSpacing != that1.TickSpacing {
+		return false
+	}
+	return true
+}
+func (m *UpdatePoolSwapFeeAndTickSpacingProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePoolSwapFeeAndTickSpacingProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePoolSwapFeeAndTickSpacingProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TickSpacing != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpdatePoolSwapFeeAndTickSpacingProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.TickSpacing != 0 {
+		n += 1 + sovGov(uint64(m.TickSpacing))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdatePoolSwapFeeAndTickSpacingProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePoolSwapFeeAndTickSpacingProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePoolSwapFeeAndTickSpacingProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
+			}
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
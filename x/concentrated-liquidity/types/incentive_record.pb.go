@@ -0,0 +1,639 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentrated-liquidity/incentive_record.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IncentiveRecord is the struct we use to track an independent incentive being
+// distributed on a pool. Each record emits its denom at a fixed rate per second
+// into the uptime accumulator matching its min_uptime, starting at start_time
+// and until remaining_amount is exhausted.
+type IncentiveRecord struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// incentive_denom is the denom of the token being distributed as part of
+	// this incentive record
+	IncentiveDenom string `protobuf:"bytes,2,opt,name=incentive_denom,json=incentiveDenom,proto3" json:"incentive_denom,omitempty" yaml:"incentive_denom"`
+	// incentive_creator is the address that created this incentive record
+	IncentiveCreator string `protobuf:"bytes,3,opt,name=incentive_creator,json=incentiveCreator,proto3" json:"incentive_creator,omitempty" yaml:"incentive_creator"`
+	// remaining_amount is the total amount of incentives to be distributed
+	RemainingAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=remaining_amount,json=remainingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"remaining_amount" yaml:"remaining_amount"`
+	// emission_rate is the incentive emission rate per second
+	EmissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=emission_rate,json=emissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"emission_rate" yaml:"emission_rate"`
+	// start_time is the time when the incentive starts distributing
+	StartTime time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// min_uptime is the minimum uptime required for liquidity to qualify for this
+	// incentive. It must be one of the supported uptimes.
+	MinUptime time.Duration `protobuf:"bytes,7,opt,name=min_uptime,json=minUptime,proto3,stdduration" json:"min_uptime,omitempty" yaml:"min_uptime"`
+}
+
+func (m *IncentiveRecord) Reset()         { *m = IncentiveRecord{} }
+func (m *IncentiveRecord) String() string { return proto.CompactTextString(m) }
+func (*IncentiveRecord) ProtoMessage()    {}
+func (*IncentiveRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d38bf94e42ee434, []int{0}
+}
+func (m *IncentiveRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveRecord.Merge(m, src)
+}
+func (m *IncentiveRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveRecord proto.InternalMessageInfo
+
+func (m *IncentiveRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *IncentiveRecord) GetIncentiveDenom() string {
+	if m != nil {
+		return m.IncentiveDenom
+	}
+	return ""
+}
+
+func (m *IncentiveRecord) GetIncentiveCreator() string {
+	if m != nil {
+		return m.IncentiveCreator
+	}
+	return ""
+}
+
+func (m *IncentiveRecord) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *IncentiveRecord) GetMinUptime() time.Duration {
+	if m != nil {
+		return m.MinUptime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*IncentiveRecord)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecord")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/incentive_record.proto", fileDescriptor_9d38bf94e42ee434)
+}
+
+var fileDescriptor_9d38bf94e42ee434 = []byte{
+	// 514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0x6e, 0x74, 0xed, 0xb2, 0xa3, 0x6e, 0x77, 0x43, 0xd1, 0x58, 0x34, 0x53, 0x02, 0x4a, 0x41,
+	0x9b, 0x50, 0x75, 0x2f, 0xde, 0xcc, 0x16, 0xa1, 0xd7, 0xa0, 0x20, 0x22, 0x84, 0x69, 0x32, 0xc6,
+	0x61, 0x3b, 0xf3, 0x62, 0x66, 0x52, 0xec, 0xbf, 0xd8, 0xa3, 0x3f, 0x69, 0x8f, 0x7b, 0x14, 0x0f,
+	0x51, 0xda, 0x9b, 0xc7, 0x9e, 0x3d, 0x48, 0x26, 0x69, 0xb3, 0x56, 0x3c, 0x78, 0xca, 0xbc, 0xef,
+	0xbd, 0xef, 0xfb, 0x5e, 0xde, 0x9b, 0x41, 0x27, 0x20, 0x39, 0x48, 0x26, 0xbd, 0x08, 0x44, 0x44,
+	0x85, 0xca, 0x88, 0xa2, 0xf1, 0x70, 0xc6, 0x3e, 0xe5, 0x2c, 0x66, 0x6a, 0xe1, 0x31, 0x8d, 0xb2,
+	0x39, 0x0d, 0x33, 0x1a, 0x41, 0x16, 0xbb, 0x69, 0x06, 0x0a, 0xcc, 0x87, 0x35, 0xcd, 0xbd, 0x4a,
+	0xdb, 0xb2, 0xdc, 0xf9, 0x68, 0x4a, 0x15, 0x19, 0xf5, 0xba, 0x09, 0x24, 0xa0, 0x19, 0x5e, 0x79,
+	0xaa, 0xc8, 0x3d, 0x3b, 0x01, 0x48, 0x66, 0xd4, 0xd3, 0xd1, 0x34, 0xff, 0xe0, 0xc5, 0x79, 0x46,
+	0x14, 0x03, 0x51, 0xe7, 0xf1, 0x6e, 0x5e, 0x31, 0x4e, 0xa5, 0x22, 0x3c, 0xad, 0x0a, 0x9c, 0x5f,
+	0x7b, 0xa8, 0x33, 0xd9, 0x34, 0x16, 0xe8, 0xbe, 0xcc, 0xc7, 0x68, 0x3f, 0x05, 0x98, 0x85, 0x2c,
+	0xb6, 0x8c, 0xbe, 0x31, 0xd8, 0xf3, 0xcd, 0x75, 0x81, 0x0f, 0x17, 0x84, 0xcf, 0x5e, 0x38, 0x75,
+	0xc2, 0x09, 0xda, 0xe5, 0x69, 0x12, 0x9b, 0xa7, 0xa8, 0xd3, 0xfc, 0x58, 0x4c, 0x05, 0x70, 0xeb,
+	0x5a, 0xdf, 0x18, 0x1c, 0xf8, 0xbd, 0x75, 0x81, 0xef, 0x54, 0xa4, 0x9d, 0x02, 0x27, 0x38, 0xdc,
+	0x22, 0xe3, 0x12, 0x30, 0x27, 0xe8, 0xb8, 0xa9, 0x89, 0x32, 0x4a, 0x14, 0x64, 0xd6, 0x75, 0x2d,
+	0x73, 0x7f, 0x5d, 0x60, 0x6b, 0x57, 0xa6, 0x2e, 0x71, 0x82, 0xa3, 0x2d, 0x76, 0x5a, 0x41, 0xa6,
+	0x42, 0x47, 0x19, 0xe5, 0x84, 0x09, 0x26, 0x92, 0x90, 0x70, 0xc8, 0x85, 0xb2, 0xf6, 0xb4, 0xd2,
+	0xe4, 0xa2, 0xc0, 0xad, 0x6f, 0x05, 0x7e, 0x94, 0x30, 0xf5, 0x31, 0x9f, 0xba, 0x11, 0x70, 0x2f,
+	0xd2, 0xc3, 0xaf, 0x3f, 0x43, 0x19, 0x9f, 0x79, 0x6a, 0x91, 0x52, 0xe9, 0x8e, 0x69, 0xb4, 0x2e,
+	0xf0, 0xdd, 0xca, 0x77, 0x57, 0xcf, 0x09, 0x3a, 0x5b, 0xe8, 0xa5, 0x46, 0xcc, 0x33, 0x74, 0x9b,
+	0x72, 0x26, 0x25, 0x03, 0x11, 0x96, 0x0b, 0xb4, 0x6e, 0x68, 0xcb, 0x57, 0xff, 0x6d, 0xd9, 0xad,
+	0x2c, 0xff, 0x10, 0x73, 0x82, 0x5b, 0x9b, 0x38, 0x20, 0x8a, 0x9a, 0x6f, 0x11, 0x92, 0x8a, 0x64,
+	0x2a, 0x2c, 0x97, 0x69, 0xb5, 0xfb, 0xc6, 0xe0, 0xe6, 0xd3, 0x9e, 0x5b, 0x6d, 0xda, 0xdd, 0x6c,
+	0xda, 0x7d, 0xbd, 0xd9, 0xb4, 0xff, 0xa0, 0xec, 0x62, 0x5d, 0xe0, 0xe3, 0x4a, 0xbb, 0xe1, 0x3a,
+	0xe7, 0xdf, 0xb1, 0x11, 0x1c, 0x68, 0xa0, 0x2c, 0x37, 0x01, 0x21, 0xce, 0x44, 0x98, 0xa7, 0x5a,
+	0x79, 0x5f, 0x2b, 0xdf, 0xfb, 0x4b, 0x79, 0x5c, 0xdf, 0x31, 0xff, 0xa4, 0x14, 0xfe, 0x59, 0xe0,
+	0x6e, 0x43, 0x7a, 0x02, 0x9c, 0x29, 0xca, 0x53, 0xb5, 0x68, 0x0c, 0x9b, 0xac, 0xf3, 0x45, 0x1b,
+	0x72, 0x26, 0xde, 0xe8, 0xd8, 0x7f, 0x7f, 0xb1, 0xb4, 0x8d, 0xcb, 0xa5, 0x6d, 0xfc, 0x58, 0xda,
+	0xc6, 0xf9, 0xca, 0x6e, 0x5d, 0xae, 0xec, 0xd6, 0xd7, 0x95, 0xdd, 0x7a, 0xe7, 0x5f, 0x19, 0x59,
+	0xfd, 0x42, 0x86, 0x33, 0x32, 0x95, 0x9b, 0xc0, 0x9b, 0x8f, 0x9e, 0x7b, 0x9f, 0xff, 0xf5, 0xd6,
+	0xf4, 0x48, 0xa7, 0x6d, 0xdd, 0xf2, 0xb3, 0xdf, 0x03, 0x00, 0x51, 0x78, 0x4b, 0xec, 0x9a, 0x03,
+	0x00, 0x00,
+}
+
+func (m *IncentiveRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinUptime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinUptime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintIncentiveRecord(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintIncentiveRecord(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.EmissionRate.Size()
+		i -= size
+		if _, err := m.EmissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RemainingAmount.Size()
+		i -= size
+		if _, err := m.RemainingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.IncentiveCreator) > 0 {
+		i -= len(m.IncentiveCreator)
+		copy(dAtA[i:], m.IncentiveCreator)
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(len(m.IncentiveCreator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IncentiveDenom) > 0 {
+		i -= len(m.IncentiveDenom)
+		copy(dAtA[i:], m.IncentiveDenom)
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(len(m.IncentiveDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIncentiveRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIncentiveRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IncentiveRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovIncentiveRecord(uint64(m.PoolId))
+	}
+	l = len(m.IncentiveDenom)
+	if l > 0 {
+		n += 1 + l + sovIncentiveRecord(uint64(l))
+	}
+	l = len(m.IncentiveCreator)
+	if l > 0 {
+		n += 1 + l + sovIncentiveRecord(uint64(l))
+	}
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovIncentiveRecord(uint64(l))
+	l = m.EmissionRate.Size()
+	n += 1 + l + sovIncentiveRecord(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIncentiveRecord(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinUptime)
+	n += 1 + l + sovIncentiveRecord(uint64(l))
+	return n
+}
+
+func sovIncentiveRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIncentiveRecord(x uint64) (n int) {
+	return sovIncentiveRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IncentiveRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentiveRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveCreator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveCreator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUptime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinUptime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentiveRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIncentiveRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIncentiveRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIncentiveRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIncentiveRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIncentiveRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIncentiveRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIncentiveRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIncentiveRecord = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,8 +6,6 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
-
-	"github.com/osmosis-labs/osmosis/osmoutils"
 )
 
 const (
@@ -20,9 +18,17 @@ const (
 
 // Key prefixes
 var (
-	TickPrefix     = []byte{0x01}
-	PositionPrefix = []byte{0x02}
-	PoolPrefix     = []byte{0x03}
+	TickPrefix         = []byte{0x01}
+	PositionPrefix     = []byte{0x02}
+	PoolPrefix         = []byte{0x03}
+	IncentivePrefix    = []byte{0x04}
+	PoolPositionPrefix = []byte{0x05}
+	PositionIdPrefix   = []byte{0x06}
+
+	NextPositionIdKey = []byte{0x07}
+
+	PoolIdWithIncentivesPrefix = []byte{0x08}
+	PositionFrozenUntilPrefix  = []byte{0x09}
 )
 
 // TickIndexToBytes converts a tick index to a byte slice. Negative tick indexes
@@ -66,24 +72,71 @@ func KeyTickPrefix(poolId uint64) []byte {
 	return key
 }
 
-// KeyFullPosition uses pool Id, owner, lower tick, upper tick, and frozenUntil for keys
-func KeyFullPosition(poolId uint64, addr sdk.AccAddress, lowerTick, upperTick int64, frozenUntil time.Time) []byte {
-	frozenUntilKey := osmoutils.FormatTimeString(frozenUntil)
-	addrKey := address.MustLengthPrefix(addr.Bytes())
-	return []byte(fmt.Sprintf("%s%s%s%s%d%s%d%s%d%s%s", PositionPrefix, KeySeparator, addrKey, KeySeparator, poolId, KeySeparator, lowerTick, KeySeparator, upperTick, KeySeparator, frozenUntilKey))
+// KeyPositionId returns the key for storing a position by its id.
+func KeyPositionId(positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d", PositionIdPrefix, KeySeparator, positionId))
 }
 
-// KeyPosition uses pool Id, owner, lower tick and upper tick for keys
-func KeyPosition(poolId uint64, addr sdk.AccAddress, lowerTick, upperTick int64) []byte {
-	addrKey := address.MustLengthPrefix(addr.Bytes())
-	return []byte(fmt.Sprintf("%s%s%s%s%d%s%d%s%d", PositionPrefix, KeySeparator, addrKey, KeySeparator, poolId, KeySeparator, lowerTick, KeySeparator, upperTick))
+// KeyAddressPoolIdPositionId returns the key of the owner index entry for the given position.
+// The index is prefixed by owner and then pool id so that an owner's positions can be iterated
+// over across all pools or within a single pool.
+func KeyAddressPoolIdPositionId(addr sdk.AccAddress, poolId uint64, positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", KeyAddressAndPoolId(addr, poolId), positionId))
+}
+
+// KeyAddressAndPoolId returns the owner index prefix for all of an owner's positions in the given pool.
+func KeyAddressAndPoolId(addr sdk.AccAddress, poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d%s", KeyUserPositions(addr), poolId, KeySeparator))
 }
 
+// KeyUserPositions returns the owner index prefix for all of an owner's positions.
 func KeyUserPositions(addr sdk.AccAddress) []byte {
 	addrKey := address.MustLengthPrefix(addr.Bytes())
-	return []byte(fmt.Sprintf("%s%s%s", PositionPrefix, KeySeparator, addrKey))
+	return []byte(fmt.Sprintf("%s%s%s%s", PositionPrefix, KeySeparator, addrKey, KeySeparator))
+}
+
+// KeyPoolPositionPositionId returns the key of the pool index entry for the given position.
+func KeyPoolPositionPositionId(poolId uint64, positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", KeyPoolPosition(poolId), positionId))
+}
+
+// KeyPoolPosition returns the pool index prefix for all positions in the given pool.
+func KeyPoolPosition(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d%s", PoolPositionPrefix, KeySeparator, poolId, KeySeparator))
 }
 
 func KeyPool(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d", PoolPrefix, poolId))
 }
+
+// KeyIncentiveRecord returns the key for storing an incentive record.
+// The key is prefixed by pool id so that all of a pool's records can be iterated over.
+func KeyIncentiveRecord(poolId uint64, minUptime time.Duration, denom string, creator sdk.AccAddress) []byte {
+	addrKey := address.MustLengthPrefix(creator.Bytes())
+	return []byte(fmt.Sprintf("%s%s%d%s%d%s%s%s%s", IncentivePrefix, KeySeparator, poolId, KeySeparator, minUptime, KeySeparator, denom, KeySeparator, addrKey))
+}
+
+// KeyPoolIncentiveRecords returns the key prefix for all incentive records of the given pool.
+func KeyPoolIncentiveRecords(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d%s", IncentivePrefix, KeySeparator, poolId, KeySeparator))
+}
+
+// KeyPoolIdWithIncentives returns the key marking that the given pool has at least one incentive record.
+// The pool id is big endian encoded so that the marked pools are iterated in ascending order.
+func KeyPoolIdWithIncentives(poolId uint64) []byte {
+	var key []byte
+	key = append(key, PoolIdWithIncentivesPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	return key
+}
+
+// KeyFrozenUntilPositionId returns the key of the freeze index entry for the given position.
+func KeyFrozenUntilPositionId(frozenUntil time.Time, positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", KeyFrozenUntil(frozenUntil), positionId))
+}
+
+// KeyFrozenUntil returns the freeze index prefix for all positions frozen until the given time.
+// Times are formatted with a fixed width so that the index is iterated over in chronological order.
+func KeyFrozenUntil(frozenUntil time.Time) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s", PositionFrozenUntilPrefix, KeySeparator, sdk.FormatTimeBytes(frozenUntil), KeySeparator))
+}
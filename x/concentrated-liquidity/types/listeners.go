@@ -0,0 +1,62 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// ConcentratedLiquidityListener defines the callbacks other modules can register
+// to be notified of changes to concentrated liquidity pools.
+type ConcentratedLiquidityListener interface {
+	// AfterConcentratedPoolCreated is called after a concentrated liquidity pool is initialized.
+	AfterConcentratedPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64)
+
+	// AfterConcentratedPoolSwap is called after a swap against a concentrated liquidity pool.
+	AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins)
+
+	// AfterPositionCreated is called after CreatePosition.
+	AfterPositionCreated(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidity sdk.Dec)
+
+	// AfterPositionModified is called after liquidity is added to an existing position by AddToPosition or CompoundFees.
+	AfterPositionModified(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidityDelta sdk.Dec)
+
+	// AfterPositionWithdrawn is called after WithdrawPosition.
+	AfterPositionWithdrawn(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensOut sdk.Coins, liquidity sdk.Dec)
+}
+
+var _ ConcentratedLiquidityListener = ConcentratedLiquidityListeners{}
+
+// ConcentratedLiquidityListeners combines multiple listeners, all listener functions are run in array sequence.
+type ConcentratedLiquidityListeners []ConcentratedLiquidityListener
+
+// NewConcentratedLiquidityListeners creates listeners for the concentrated liquidity module.
+func NewConcentratedLiquidityListeners(listeners ...ConcentratedLiquidityListener) ConcentratedLiquidityListeners {
+	return listeners
+}
+
+func (l ConcentratedLiquidityListeners) AfterConcentratedPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	for i := range l {
+		l[i].AfterConcentratedPoolCreated(ctx, sender, poolId)
+	}
+}
+
+func (l ConcentratedLiquidityListeners) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	for i := range l {
+		l[i].AfterConcentratedPoolSwap(ctx, sender, poolId, input, output)
+	}
+}
+
+func (l ConcentratedLiquidityListeners) AfterPositionCreated(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidity sdk.Dec) {
+	for i := range l {
+		l[i].AfterPositionCreated(ctx, owner, poolId, positionId, tokensIn, liquidity)
+	}
+}
+
+func (l ConcentratedLiquidityListeners) AfterPositionModified(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidityDelta sdk.Dec) {
+	for i := range l {
+		l[i].AfterPositionModified(ctx, owner, poolId, positionId, tokensIn, liquidityDelta)
+	}
+}
+
+func (l ConcentratedLiquidityListeners) AfterPositionWithdrawn(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensOut sdk.Coins, liquidity sdk.Dec) {
+	for i := range l {
+		l[i].AfterPositionWithdrawn(ctx, owner, poolId, positionId, tokensOut, liquidity)
+	}
+}
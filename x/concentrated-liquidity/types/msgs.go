@@ -2,15 +2,20 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // constants.
 const (
-	TypeMsgCreatePosition   = "create-position"
-	TypeMsgWithdrawPosition = "withdraw-position"
-	TypeMsgCollectFees      = "collect-fees"
+	TypeMsgCreatePosition    = "create-position"
+	TypeMsgWithdrawPosition  = "withdraw-position"
+	TypeMsgCollectFees       = "collect-fees"
+	TypeMsgCreateIncentive   = "create-incentive"
+	TypeMsgCollectIncentives = "collect-incentives"
+	TypeMsgAddToPosition     = "add-to-position"
+	TypeMsgCompoundFees      = "compound-fees"
 )
 
 var _ sdk.Msg = &MsgCreatePosition{}
@@ -68,10 +73,6 @@ func (msg MsgWithdrawPosition) ValidateBasic() error {
 		return fmt.Errorf("Invalid sender address (%s)", err)
 	}
 
-	if msg.LowerTick >= msg.UpperTick {
-		return InvalidLowerUpperTickError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
-	}
-
 	if !msg.LiquidityAmount.IsPositive() {
 		return NotPositiveRequireAmountError{Amount: msg.LiquidityAmount.String()}
 	}
@@ -101,10 +102,6 @@ func (msg MsgCollectFees) ValidateBasic() error {
 		return fmt.Errorf("Invalid sender address (%s)", err)
 	}
 
-	if msg.LowerTick >= msg.UpperTick {
-		return InvalidLowerUpperTickError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
-	}
-
 	return nil
 }
 
@@ -119,3 +116,151 @@ func (msg MsgCollectFees) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgCreateIncentive{}
+
+func (msg MsgCreateIncentive) Route() string { return RouterKey }
+func (msg MsgCreateIncentive) Type() string  { return TypeMsgCreateIncentive }
+func (msg MsgCreateIncentive) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.IncentiveDenom); err != nil {
+		return fmt.Errorf("Invalid incentive denom (%s)", err)
+	}
+
+	if !msg.IncentiveAmount.IsPositive() {
+		return NonPositiveIncentiveAmountError{PoolId: msg.PoolId, IncentiveAmount: msg.IncentiveAmount}
+	}
+
+	if !msg.EmissionRate.IsPositive() {
+		return NonPositiveEmissionRateError{PoolId: msg.PoolId, EmissionRate: msg.EmissionRate}
+	}
+
+	if !ValidateMinUptime(msg.MinUptime) {
+		return InvalidMinUptimeError{PoolId: msg.PoolId, MinUptime: msg.MinUptime, AuthorizedUptimes: SupportedUptimes}
+	}
+
+	return nil
+}
+
+func (msg MsgCreateIncentive) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateIncentive) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCollectIncentives{}
+
+func (msg MsgCollectIncentives) Route() string { return RouterKey }
+func (msg MsgCollectIncentives) Type() string  { return TypeMsgCollectIncentives }
+func (msg MsgCollectIncentives) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgCollectIncentives) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCollectIncentives) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgAddToPosition{}
+
+func (msg MsgAddToPosition) Route() string { return RouterKey }
+func (msg MsgAddToPosition) Type() string  { return TypeMsgAddToPosition }
+func (msg MsgAddToPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenDesired0.IsValid() {
+		return fmt.Errorf("Invalid coins (%s)", msg.TokenDesired0.String())
+	}
+
+	if !msg.TokenDesired1.IsValid() {
+		return fmt.Errorf("Invalid coins (%s)", msg.TokenDesired1.String())
+	}
+
+	// Liquidity may be added on a single side when the position is out of range,
+	// but at least one of the desired amounts must be positive.
+	if msg.TokenDesired0.IsZero() && msg.TokenDesired1.IsZero() {
+		return fmt.Errorf("Invalid coins (%s, %s)", msg.TokenDesired0.String(), msg.TokenDesired1.String())
+	}
+
+	if msg.TokenMinAmount0.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount0.String()}
+	}
+
+	if msg.TokenMinAmount1.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount1.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgAddToPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddToPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCompoundFees{}
+
+func (msg MsgCompoundFees) Route() string { return RouterKey }
+func (msg MsgCompoundFees) Type() string  { return TypeMsgCompoundFees }
+func (msg MsgCompoundFees) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgCompoundFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCompoundFees) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateMinUptime returns true if the given uptime is one of the supported uptimes.
+func ValidateMinUptime(minUptime time.Duration) bool {
+	for _, supportedUptime := range SupportedUptimes {
+		if minUptime == supportedUptime {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -169,10 +170,8 @@ func TestMsgWithdrawPosition(t *testing.T) {
 		{
 			name: "proper msg",
 			msg: types.MsgWithdrawPosition{
-				PoolId:          1,
+				PositionId:      1,
 				Sender:          addr1,
-				LowerTick:       1,
-				UpperTick:       10,
 				LiquidityAmount: sdk.OneDec(),
 			},
 			expectPass: true,
@@ -180,44 +179,134 @@ func TestMsgWithdrawPosition(t *testing.T) {
 		{
 			name: "invalid sender",
 			msg: types.MsgWithdrawPosition{
-				PoolId:          1,
+				PositionId:      1,
 				Sender:          invalidAddr.String(),
-				LowerTick:       1,
-				UpperTick:       10,
 				LiquidityAmount: sdk.OneDec(),
 			},
 			expectPass: false,
 		},
 		{
-			name: "invalid price range, lower tick > upper",
+			name: "negative amount",
 			msg: types.MsgWithdrawPosition{
-				PoolId:          1,
+				PositionId:      1,
 				Sender:          addr1,
-				LowerTick:       10,
-				UpperTick:       1,
-				LiquidityAmount: sdk.OneDec(),
+				LiquidityAmount: sdk.NewDec(-10),
 			},
 			expectPass: false,
 		},
 		{
-			name: "negative amount",
+			name: "zero amount",
 			msg: types.MsgWithdrawPosition{
+				PositionId:      1,
+				Sender:          addr1,
+				LiquidityAmount: sdk.ZeroDec(),
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "withdraw-position")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCreateIncentive(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCreateIncentive
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCreateIncentive{
 				PoolId:          1,
 				Sender:          addr1,
-				LowerTick:       1,
-				UpperTick:       10,
-				LiquidityAmount: sdk.NewDec(-10),
+				IncentiveDenom:  "stake",
+				IncentiveAmount: sdk.NewInt(100),
+				EmissionRate:    sdk.OneDec(),
+				StartTime:       time.Unix(1675237890, 0),
+				MinUptime:       time.Hour,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgCreateIncentive{
+				PoolId:          1,
+				Sender:          invalidAddr.String(),
+				IncentiveDenom:  "stake",
+				IncentiveAmount: sdk.NewInt(100),
+				EmissionRate:    sdk.OneDec(),
+				StartTime:       time.Unix(1675237890, 0),
+				MinUptime:       time.Hour,
 			},
 			expectPass: false,
 		},
 		{
-			name: "zero amount",
-			msg: types.MsgWithdrawPosition{
+			name: "invalid denom",
+			msg: types.MsgCreateIncentive{
 				PoolId:          1,
 				Sender:          addr1,
-				LowerTick:       1,
-				UpperTick:       10,
-				LiquidityAmount: sdk.ZeroDec(),
+				IncentiveDenom:  "1",
+				IncentiveAmount: sdk.NewInt(100),
+				EmissionRate:    sdk.OneDec(),
+				StartTime:       time.Unix(1675237890, 0),
+				MinUptime:       time.Hour,
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero incentive amount",
+			msg: types.MsgCreateIncentive{
+				PoolId:          1,
+				Sender:          addr1,
+				IncentiveDenom:  "stake",
+				IncentiveAmount: sdk.ZeroInt(),
+				EmissionRate:    sdk.OneDec(),
+				StartTime:       time.Unix(1675237890, 0),
+				MinUptime:       time.Hour,
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative emission rate",
+			msg: types.MsgCreateIncentive{
+				PoolId:          1,
+				Sender:          addr1,
+				IncentiveDenom:  "stake",
+				IncentiveAmount: sdk.NewInt(100),
+				EmissionRate:    sdk.NewDec(-1),
+				StartTime:       time.Unix(1675237890, 0),
+				MinUptime:       time.Hour,
+			},
+			expectPass: false,
+		},
+		{
+			name: "unsupported min uptime",
+			msg: types.MsgCreateIncentive{
+				PoolId:          1,
+				Sender:          addr1,
+				IncentiveDenom:  "stake",
+				IncentiveAmount: sdk.NewInt(100),
+				EmissionRate:    sdk.OneDec(),
+				StartTime:       time.Unix(1675237890, 0),
+				MinUptime:       time.Hour * 2,
 			},
 			expectPass: false,
 		},
@@ -229,7 +318,186 @@ func TestMsgWithdrawPosition(t *testing.T) {
 		if test.expectPass {
 			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
 			require.Equal(t, msg.Route(), types.RouterKey)
-			require.Equal(t, msg.Type(), "withdraw-position")
+			require.Equal(t, msg.Type(), "create-incentive")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCollectIncentives(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCollectIncentives
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCollectIncentives{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgCollectIncentives{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "collect-incentives")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgAddToPosition(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	tests := []struct {
+		name       string
+		msg        types.MsgAddToPosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgAddToPosition{
+				PositionId:      1,
+				Sender:          addr1,
+				TokenDesired0:   sdk.NewCoin("stake", sdk.OneInt()),
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.OneInt()),
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.OneInt(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "proper msg, single sided",
+			msg: types.MsgAddToPosition{
+				PositionId:      1,
+				Sender:          addr1,
+				TokenDesired0:   sdk.NewCoin("stake", sdk.OneInt()),
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.ZeroInt()),
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.ZeroInt(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgAddToPosition{
+				PositionId:      1,
+				Sender:          invalidAddr.String(),
+				TokenDesired0:   sdk.NewCoin("stake", sdk.OneInt()),
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.OneInt()),
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.OneInt(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "both token desired amounts are zero",
+			msg: types.MsgAddToPosition{
+				PositionId:      1,
+				Sender:          addr1,
+				TokenDesired0:   sdk.NewCoin("stake", sdk.ZeroInt()),
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.ZeroInt()),
+				TokenMinAmount0: sdk.ZeroInt(),
+				TokenMinAmount1: sdk.ZeroInt(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative token min amount",
+			msg: types.MsgAddToPosition{
+				PositionId:      1,
+				Sender:          addr1,
+				TokenDesired0:   sdk.NewCoin("stake", sdk.OneInt()),
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.OneInt()),
+				TokenMinAmount0: sdk.OneInt().Neg(),
+				TokenMinAmount1: sdk.OneInt(),
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "add-to-position")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCompoundFees(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCompoundFees
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCompoundFees{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgCompoundFees{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "compound-fees")
 			signers := msg.GetSigners()
 			require.Equal(t, len(signers), 1)
 			require.Equal(t, signers[0].String(), addr1)
@@ -243,6 +511,7 @@ func TestConcentratedLiquiditySerialization(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
 	addr1 := sdk.AccAddress(pk1.Address()).String()
 	defaultPoolId := uint64(1)
+	defaultPositionId := uint64(1)
 
 	testCases := []struct {
 		name  string
@@ -260,10 +529,8 @@ func TestConcentratedLiquiditySerialization(t *testing.T) {
 		{
 			name: "MsgWithdrawPosition",
 			clMsg: &types.MsgWithdrawPosition{
-				PoolId:          defaultPoolId,
+				PositionId:      defaultPositionId,
 				Sender:          addr1,
-				LowerTick:       int64(10000),
-				UpperTick:       int64(20000),
 				LiquidityAmount: sdk.NewDec(100),
 			},
 		},
@@ -280,6 +547,44 @@ func TestConcentratedLiquiditySerialization(t *testing.T) {
 				TokenMinAmount1: sdk.OneInt(),
 			},
 		},
+		{
+			name: "MsgCreateIncentive",
+			clMsg: &types.MsgCreateIncentive{
+				PoolId:          defaultPoolId,
+				Sender:          addr1,
+				IncentiveDenom:  "foo",
+				IncentiveAmount: sdk.NewInt(1000),
+				EmissionRate:    sdk.OneDec(),
+				StartTime:       time.Unix(1675237890, 0).UTC(),
+				MinUptime:       time.Hour,
+			},
+		},
+		{
+			name: "MsgCollectIncentives",
+			clMsg: &types.MsgCollectIncentives{
+				PositionId: defaultPositionId,
+				Sender:     addr1,
+			},
+		},
+		{
+			name: "MsgAddToPosition",
+			clMsg: &types.MsgAddToPosition{
+				PositionId:      defaultPositionId,
+				Sender:          addr1,
+				TokenDesired0:   sdk.NewCoin("foo", sdk.NewInt(1000)),
+				TokenDesired1:   sdk.NewCoin("bar", sdk.NewInt(1000)),
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.OneInt(),
+				FrozenUntil:     time.Unix(1675237890, 0).UTC(),
+			},
+		},
+		{
+			name: "MsgCompoundFees",
+			clMsg: &types.MsgCompoundFees{
+				PositionId: defaultPositionId,
+				Sender:     addr1,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -3,12 +3,14 @@ package types
 import (
 	fmt "fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter store keys.
 var (
-	KeyAuthorizedTickSpacing = []byte("AuthorizedTickSpacing")
+	KeyAuthorizedTickSpacing  = []byte("AuthorizedTickSpacing")
+	KeyAuthorizedSwapFeeTiers = []byte("AuthorizedSwapFeeTiers")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -18,9 +20,10 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(authorizedTickSpacing []uint64) Params {
+func NewParams(authorizedTickSpacing []uint64, authorizedSwapFeeTiers []SwapFeeTier) Params {
 	return Params{
-		AuthorizedTickSpacing: authorizedTickSpacing,
+		AuthorizedTickSpacing:  authorizedTickSpacing,
+		AuthorizedSwapFeeTiers: authorizedSwapFeeTiers,
 	}
 }
 
@@ -29,7 +32,8 @@ func NewParams(authorizedTickSpacing []uint64) Params {
 // https://github.com/osmosis-labs/osmosis/issues/3684
 func DefaultParams() Params {
 	return Params{
-		AuthorizedTickSpacing: AuthorizedTickSpacing,
+		AuthorizedTickSpacing:  AuthorizedTickSpacing,
+		AuthorizedSwapFeeTiers: AuthorizedSwapFeeTiers,
 	}
 }
 
@@ -38,13 +42,34 @@ func (p Params) Validate() error {
 	if err := validateTicks(p.AuthorizedTickSpacing); err != nil {
 		return err
 	}
+	if err := validateSwapFeeTiers(p.AuthorizedSwapFeeTiers); err != nil {
+		return err
+	}
 	return nil
 }
 
+// IsAuthorizedSwapFeeTier returns true if the given swap fee is one of the authorized swap fee tiers
+// and the given tick spacing is authorized for that tier.
+func (p Params) IsAuthorizedSwapFeeTier(swapFee sdk.Dec, tickSpacing uint64) bool {
+	for _, tier := range p.AuthorizedSwapFeeTiers {
+		if !tier.SwapFee.Equal(swapFee) {
+			continue
+		}
+		for _, authorizedTickSpacing := range tier.AuthorizedTickSpacings {
+			if tickSpacing == authorizedTickSpacing {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
 // ParamSetPairs implements params.ParamSet.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAuthorizedTickSpacing, &p.AuthorizedTickSpacing, validateTicks),
+		paramtypes.NewParamSetPair(KeyAuthorizedSwapFeeTiers, &p.AuthorizedSwapFeeTiers, validateSwapFeeTiers),
 	}
 }
 
@@ -59,3 +84,32 @@ func validateTicks(i interface{}) error {
 
 	return nil
 }
+
+// validateSwapFeeTiers validates that the given parameter is a slice of swap fee tiers with unique swap fees
+// in the [0, 1) range, each authorizing only non-zero tick spacings.
+func validateSwapFeeTiers(i interface{}) error {
+	tiers, ok := i.([]SwapFeeTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, tier := range tiers {
+		if tier.SwapFee.IsNil() || tier.SwapFee.IsNegative() || tier.SwapFee.GTE(sdk.OneDec()) {
+			return InvalidSwapFeeError{ActualFee: tier.SwapFee}
+		}
+
+		for _, tickSpacing := range tier.AuthorizedTickSpacings {
+			if tickSpacing == 0 {
+				return fmt.Errorf("swap fee tier (%s) authorizes a zero tick spacing", tier.SwapFee)
+			}
+		}
+
+		for _, otherTier := range tiers[:i] {
+			if otherTier.SwapFee.Equal(tier.SwapFee) {
+				return fmt.Errorf("duplicate swap fee tier (%s)", tier.SwapFee)
+			}
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -29,6 +30,13 @@ type Params struct {
 	// example, an authorized_tick_spacing of [1, 10, 30] allows for pools
 	// to be created with tick spacing of 1, 10, or 30.
 	AuthorizedTickSpacing []uint64 `protobuf:"varint,1,rep,packed,name=authorized_tick_spacing,json=authorizedTickSpacing,proto3" json:"authorized_tick_spacing,omitempty" yaml:"authorized_tick_spacing"`
+	// authorized_swap_fee_tiers is an array of the swap fees
+	// concentrated-liquidity pools can be created with, each paired with the
+	// tick spacings allowed for pools with that swap fee. For example, a tier
+	// with a swap fee of 0.003 and authorized tick spacings of [10, 60] allows
+	// for pools with a swap fee of 0.003 to be created with tick spacing of 10
+	// or 60.
+	AuthorizedSwapFeeTiers []SwapFeeTier `protobuf:"bytes,2,rep,name=authorized_swap_fee_tiers,json=authorizedSwapFeeTiers,proto3" json:"authorized_swap_fee_tiers" yaml:"authorized_swap_fee_tiers"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -71,8 +79,63 @@ func (m *Params) GetAuthorizedTickSpacing() []uint64 {
 	return nil
 }
 
+func (m *Params) GetAuthorizedSwapFeeTiers() []SwapFeeTier {
+	if m != nil {
+		return m.AuthorizedSwapFeeTiers
+	}
+	return nil
+}
+
+// SwapFeeTier is a swap fee that concentrated-liquidity pools can be created
+// with, along with the tick spacings allowed for pools with that swap fee.
+type SwapFeeTier struct {
+	SwapFee                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	AuthorizedTickSpacings []uint64                               `protobuf:"varint,2,rep,packed,name=authorized_tick_spacings,json=authorizedTickSpacings,proto3" json:"authorized_tick_spacings,omitempty" yaml:"authorized_tick_spacings"`
+}
+
+func (m *SwapFeeTier) Reset()         { *m = SwapFeeTier{} }
+func (m *SwapFeeTier) String() string { return proto.CompactTextString(m) }
+func (*SwapFeeTier) ProtoMessage()    {}
+func (*SwapFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd3784445b6f6ba7, []int{1}
+}
+func (m *SwapFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapFeeTier.Merge(m, src)
+}
+func (m *SwapFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapFeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapFeeTier proto.InternalMessageInfo
+
+func (m *SwapFeeTier) GetAuthorizedTickSpacings() []uint64 {
+	if m != nil {
+		return m.AuthorizedTickSpacings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.concentratedliquidity.Params")
+	proto.RegisterType((*SwapFeeTier)(nil), "osmosis.concentratedliquidity.SwapFeeTier")
 }
 
 func init() {
@@ -80,22 +143,31 @@ func init() {
 }
 
 var fileDescriptor_cd3784445b6f6ba7 = []byte{
-	// 226 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xce, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x4f, 0xce, 0xcf, 0x4b, 0x4e, 0xcd, 0x2b, 0x29, 0x4a, 0x2c, 0x49, 0x4d,
-	0xd1, 0xcd, 0xc9, 0x2c, 0x2c, 0xcd, 0x4c, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x85, 0x2a, 0xd6, 0x43, 0x56, 0x0c, 0x57,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa9, 0x0f, 0x62, 0x41, 0x34, 0x29, 0xa5, 0x70,
-	0xb1, 0x05, 0x80, 0x0d, 0x11, 0x8a, 0xe2, 0x12, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0xac,
-	0x4a, 0x4d, 0x89, 0x2f, 0xc9, 0x4c, 0xce, 0x8e, 0x2f, 0x2e, 0x48, 0x4c, 0xce, 0xcc, 0x4b, 0x97,
-	0x60, 0x54, 0x60, 0xd6, 0x60, 0x71, 0x52, 0xfa, 0x74, 0x4f, 0x5e, 0xae, 0x32, 0x31, 0x37, 0xc7,
-	0x4a, 0x09, 0x87, 0x42, 0xa5, 0x20, 0x51, 0x84, 0x4c, 0x48, 0x66, 0x72, 0x76, 0x30, 0x44, 0xdc,
-	0x29, 0xe6, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x9c, 0xd2, 0x33, 0x4b,
-	0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xa1, 0xee, 0xd7, 0xcd, 0x49, 0x4c, 0x2a, 0x86,
-	0x71, 0xf4, 0xcb, 0x0c, 0x4d, 0xf4, 0x2b, 0x70, 0xf9, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x15, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x23, 0xfe, 0x60, 0x06, 0x2e, 0x01,
-	0x00, 0x00,
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4d, 0x4f, 0xea, 0x40,
+	0x14, 0xed, 0x3c, 0x08, 0xef, 0xbd, 0xb2, 0x78, 0x49, 0xf3, 0xd4, 0x6a, 0x62, 0xdb, 0xd4, 0xc4,
+	0x34, 0x1a, 0x3a, 0xf1, 0x63, 0xe5, 0xce, 0xc6, 0xb8, 0x36, 0xc0, 0x8a, 0x60, 0x9a, 0x61, 0x3a,
+	0x96, 0x09, 0x94, 0xa9, 0x9d, 0x41, 0xc4, 0x3f, 0xa1, 0x3f, 0x8b, 0xb8, 0x62, 0x69, 0x5c, 0x34,
+	0x06, 0xfe, 0x41, 0x17, 0xae, 0x0d, 0x2d, 0x48, 0x89, 0x81, 0x55, 0x3b, 0xf7, 0x9e, 0x7b, 0xee,
+	0x39, 0x27, 0x57, 0x3e, 0x66, 0x3c, 0x60, 0x9c, 0x72, 0x88, 0x59, 0x0f, 0x93, 0x9e, 0x88, 0x90,
+	0x20, 0x5e, 0xa5, 0x4b, 0xef, 0xfb, 0xd4, 0xa3, 0x62, 0x08, 0x43, 0x14, 0xa1, 0x80, 0xdb, 0x61,
+	0xc4, 0x04, 0x53, 0xf6, 0xe7, 0x60, 0x3b, 0x0f, 0xfe, 0xc6, 0xee, 0xfd, 0xf7, 0x99, 0xcf, 0x52,
+	0x24, 0x9c, 0xfd, 0x65, 0x43, 0xe6, 0x27, 0x90, 0x4b, 0x37, 0x29, 0x8b, 0xd2, 0x90, 0x77, 0x50,
+	0x5f, 0xb4, 0x59, 0x44, 0x9f, 0x88, 0xe7, 0x0a, 0x8a, 0x3b, 0x2e, 0x0f, 0x11, 0xa6, 0x3d, 0x5f,
+	0x05, 0x46, 0xc1, 0x2a, 0x3a, 0x66, 0x12, 0xeb, 0xda, 0x10, 0x05, 0xdd, 0x0b, 0x73, 0x0d, 0xd0,
+	0xac, 0x6e, 0x2d, 0x3b, 0x75, 0x8a, 0x3b, 0xb5, 0xac, 0xae, 0x3c, 0x03, 0x79, 0x37, 0x37, 0xc3,
+	0x07, 0x28, 0x74, 0xef, 0x08, 0x71, 0x05, 0x25, 0x11, 0x57, 0x7f, 0x19, 0x05, 0xab, 0x7c, 0x7a,
+	0x64, 0x6f, 0x34, 0x60, 0xd7, 0x06, 0x28, 0xbc, 0x26, 0xa4, 0x4e, 0x49, 0xe4, 0x58, 0xa3, 0x58,
+	0x97, 0x92, 0x58, 0x37, 0x7e, 0xc8, 0x59, 0xa5, 0x36, 0xab, 0xdb, 0xcb, 0x5e, 0x8e, 0x80, 0x9b,
+	0xaf, 0x40, 0x2e, 0xe7, 0x0a, 0x4a, 0x53, 0xfe, 0xb3, 0x18, 0x55, 0x81, 0x01, 0xac, 0xbf, 0xce,
+	0xe5, 0x6c, 0xc7, 0x7b, 0xac, 0x1f, 0xfa, 0x54, 0xb4, 0xfb, 0x2d, 0x1b, 0xb3, 0x00, 0xe2, 0x54,
+	0xe2, 0xfc, 0x53, 0xe1, 0x5e, 0x07, 0x8a, 0x61, 0x48, 0xb8, 0x7d, 0x45, 0x70, 0x12, 0xeb, 0xff,
+	0x32, 0x35, 0x0b, 0x1e, 0xb3, 0xfa, 0x9b, 0x67, 0x1b, 0x94, 0x5b, 0x59, 0x5d, 0x13, 0x59, 0xe6,
+	0xbe, 0xe8, 0x1c, 0x24, 0xb1, 0xae, 0x6f, 0x0c, 0x77, 0xd5, 0x4c, 0x2e, 0x5d, 0xee, 0x34, 0x47,
+	0x13, 0x0d, 0x8c, 0x27, 0x1a, 0xf8, 0x98, 0x68, 0xe0, 0x65, 0xaa, 0x49, 0xe3, 0xa9, 0x26, 0xbd,
+	0x4d, 0x35, 0xa9, 0xe1, 0xe4, 0xc4, 0xcf, 0xe3, 0xad, 0x74, 0x51, 0x8b, 0x2f, 0x1e, 0xf0, 0xe1,
+	0xe4, 0x1c, 0x3e, 0xae, 0xbb, 0xaf, 0xd4, 0x5c, 0xab, 0x94, 0x9e, 0xca, 0xd9, 0xd7, 0x00, 0x30,
+	0x59, 0xb1, 0xd8, 0x8e, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -118,6 +190,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AuthorizedSwapFeeTiers) > 0 {
+		for iNdEx := len(m.AuthorizedSwapFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthorizedSwapFeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.AuthorizedTickSpacing) > 0 {
 		dAtA2 := make([]byte, len(m.AuthorizedTickSpacing)*10)
 		var j1 int
@@ -139,6 +225,57 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SwapFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuthorizedTickSpacings) > 0 {
+		dAtA4 := make([]byte, len(m.AuthorizedTickSpacings)*10)
+		var j3 int
+		for _, num := range m.AuthorizedTickSpacings {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintParams(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -163,6 +300,30 @@ func (m *Params) Size() (n int) {
 		}
 		n += 1 + sovParams(uint64(l)) + l
 	}
+	if len(m.AuthorizedSwapFeeTiers) > 0 {
+		for _, e := range m.AuthorizedSwapFeeTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SwapFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.AuthorizedTickSpacings) > 0 {
+		l = 0
+		for _, e := range m.AuthorizedTickSpacings {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
 	return n
 }
 
@@ -277,6 +438,200 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedTickSpacing", wireType)
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedSwapFeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedSwapFeeTiers = append(m.AuthorizedSwapFeeTiers, SwapFeeTier{})
+			if err := m.AuthorizedSwapFeeTiers[len(m.AuthorizedSwapFeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapFeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapFeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AuthorizedTickSpacings = append(m.AuthorizedTickSpacings, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AuthorizedTickSpacings) == 0 {
+					m.AuthorizedTickSpacings = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AuthorizedTickSpacings = append(m.AuthorizedTickSpacings, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedTickSpacings", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
@@ -17,11 +19,19 @@ type ConcentratedPoolExtension interface {
 	GetPrecisionFactorAtPriceOne() sdk.Int
 	GetTickSpacing() uint64
 	GetLiquidity() sdk.Dec
+	GetUptimeLiquidity() []sdk.Dec
+	GetLastLiquidityUpdate() time.Time
+	GetIncentivesAddress() sdk.AccAddress
 	SetCurrentSqrtPrice(newSqrtPrice sdk.Dec)
 	SetCurrentTick(newTick sdk.Int)
+	SetLastLiquidityUpdate(newTime time.Time)
+	SetSwapFee(newSwapFee sdk.Dec)
+	SetTickSpacing(newTickSpacing uint64)
 
 	UpdateLiquidity(newLiquidity sdk.Dec)
 	ApplySwap(newLiquidity sdk.Dec, newCurrentTick sdk.Int, newCurrentSqrtPrice sdk.Dec) error
 	CalcActualAmounts(ctx sdk.Context, lowerTick, upperTick int64, sqrtRatioLowerTick, sqrtRatioUpperTick sdk.Dec, liquidityDelta sdk.Dec) (actualAmountDenom0 sdk.Dec, actualAmountDenom1 sdk.Dec)
 	UpdateLiquidityIfActivePosition(ctx sdk.Context, lowerTick, upperTick int64, liquidityDelta sdk.Dec) bool
+	AddUptimeLiquidity(uptimeLiquidityDeltas []sdk.Dec)
+	UpdateUptimeLiquidityIfActivePosition(ctx sdk.Context, lowerTick, upperTick int64, uptimeLiquidityDeltas []sdk.Dec) bool
 }
@@ -3,6 +3,7 @@ package cli_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v14/x/gamm/client/cli"
 	"github.com/osmosis-labs/osmosis/v14/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v14/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v14/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
 
@@ -54,6 +56,18 @@ func TestNewCreatePoolCmd(t *testing.T) {
 			`, cli.PoolFileWeights, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee, cli.PoolFileFutureGovernor),
 			false,
 		},
+		"pool controller address": {
+			fmt.Sprintf(`
+			{
+			  "%s": "1node0token,3stake",
+			  "%s": "100node0token,100stake",
+			  "%s": "0.001",
+			  "%s": "0.001",
+			  "%s": "osmo1fqlr98d45v5ysqgp6h56kpujcj4cvsjnjq9nck"
+			}
+			`, cli.PoolFileWeights, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee, cli.PoolFilePoolController),
+			false,
+		},
 		"bad pool json - missing quotes around exit fee": {
 			fmt.Sprintf(`
 			{
@@ -177,6 +191,94 @@ func TestNewCreatePoolCmd(t *testing.T) {
 	}
 }
 
+func TestNewScheduleSmoothWeightChangeCmd(t *testing.T) {
+	desc := cli.NewScheduleSmoothWeightChangeCmd()
+	targetPoolWeights := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(2), Token: sdk.NewCoin("uatom", sdk.ZeroInt())},
+		{Weight: sdk.NewInt(8), Token: sdk.NewCoin("uosmo", sdk.ZeroInt())},
+	}
+	tcs := map[string]osmocli.TxCliTestCase[*balancer.MsgScheduleSmoothWeightChange]{
+		"schedule weight change": {
+			Cmd: "1 2uatom,8uosmo 72h --from=" + testAddresses[0].String(),
+			ExpectedMsg: &balancer.MsgScheduleSmoothWeightChange{
+				Sender: testAddresses[0].String(),
+				PoolID: 1,
+				SmoothWeightChangeParams: balancer.SmoothWeightChangeParams{
+					Duration:          72 * time.Hour,
+					TargetPoolWeights: targetPoolWeights,
+				},
+			},
+		},
+		"schedule weight change with start time": {
+			Cmd: "1 2uatom,8uosmo 72h --start-time=2023-03-01T00:00:00Z --from=" + testAddresses[0].String(),
+			ExpectedMsg: &balancer.MsgScheduleSmoothWeightChange{
+				Sender: testAddresses[0].String(),
+				PoolID: 1,
+				SmoothWeightChangeParams: balancer.SmoothWeightChangeParams{
+					StartTime:         time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+					Duration:          72 * time.Hour,
+					TargetPoolWeights: targetPoolWeights,
+				},
+			},
+		},
+		"invalid duration": {
+			Cmd:         "1 2uatom,8uosmo 72 --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewPauseSwapsCmd(t *testing.T) {
+	desc := cli.NewPauseSwapsCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*balancer.MsgSetSwapsPaused]{
+		"pause swaps": {
+			Cmd: "1 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &balancer.MsgSetSwapsPaused{
+				Sender:      testAddresses[0].String(),
+				PoolID:      1,
+				SwapsPaused: true,
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewStableSwapAdjustFeesCmd(t *testing.T) {
+	desc, _ := cli.NewStableSwapAdjustFeesCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*stableswap.MsgStableSwapAdjustFees]{
+		"adjust fees": {
+			Cmd: "1 0.003 0.001 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &stableswap.MsgStableSwapAdjustFees{
+				Sender:  testAddresses[0].String(),
+				PoolID:  1,
+				SwapFee: sdk.MustNewDecFromStr("0.003"),
+				ExitFee: sdk.MustNewDecFromStr("0.001"),
+			},
+		},
+		"invalid swap fee": {
+			Cmd:         "1 abc 0.001 --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewResumeSwapsCmd(t *testing.T) {
+	desc := cli.NewResumeSwapsCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*balancer.MsgSetSwapsPaused]{
+		"resume swaps": {
+			Cmd: "1 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &balancer.MsgSetSwapsPaused{
+				Sender:      testAddresses[0].String(),
+				PoolID:      1,
+				SwapsPaused: false,
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestNewJoinPoolCmd(t *testing.T) {
 	desc, _ := cli.NewJoinPoolCmd()
 	tcs := map[string]osmocli.TxCliTestCase[*types.MsgJoinPool]{
@@ -257,6 +359,36 @@ func TestNewJoinSwapExternAmountInCmd(t *testing.T) {
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
 
+func TestNewJoinPoolWithArbitraryTokensCmd(t *testing.T) {
+	desc, _ := cli.NewJoinPoolWithArbitraryTokens()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgJoinPoolWithArbitraryTokens]{
+		"join with pool assets": {
+			Cmd: "10stake,20uosmo 1 --pool-id=1 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgJoinPoolWithArbitraryTokens{
+				Sender:            testAddresses[0].String(),
+				PoolId:            1,
+				TokensIn:          sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uosmo", 20)),
+				TokenInRoutes:     []types.TokenInRoutes{},
+				ShareOutMinAmount: sdk.NewIntFromUint64(1),
+			},
+		},
+		"join with token in routes": {
+			Cmd: "10stake,20uatom,30uion 1 --pool-id=1 --token-in-routes=uatom=2:uosmo --token-in-routes=uion=3:ibc/111,4:stake --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgJoinPoolWithArbitraryTokens{
+				Sender:   testAddresses[0].String(),
+				PoolId:   1,
+				TokensIn: sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uatom", 20), sdk.NewInt64Coin("uion", 30)),
+				TokenInRoutes: []types.TokenInRoutes{
+					{TokenInDenom: "uatom", Routes: []poolmanagertypes.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "uosmo"}}},
+					{TokenInDenom: "uion", Routes: []poolmanagertypes.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: "ibc/111"}, {PoolId: 4, TokenOutDenom: "stake"}}},
+				},
+				ShareOutMinAmount: sdk.NewIntFromUint64(1),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestNewJoinSwapShareAmountOutCmd(t *testing.T) {
 	desc, _ := cli.NewJoinSwapShareAmountOut()
 	tcs := map[string]osmocli.TxCliTestCase[*types.MsgJoinSwapShareAmountOut]{
@@ -331,6 +463,56 @@ func TestGetCmdPool(t *testing.T) {
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
 
+func TestGetCmdLBPStatus(t *testing.T) {
+	desc, _ := cli.GetCmdLBPStatus()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryLBPStatusRequest]{
+		"basic test": {
+			Cmd:           "1",
+			ExpectedQuery: &types.QueryLBPStatusRequest{PoolId: 1},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdScalingFactorRamp(t *testing.T) {
+	desc, _ := cli.GetCmdScalingFactorRamp()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryScalingFactorRampRequest]{
+		"basic test": {
+			Cmd:           "1",
+			ExpectedQuery: &types.QueryScalingFactorRampRequest{PoolId: 1},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdCalcMigration(t *testing.T) {
+	desc, _ := cli.GetCmdCalcMigration()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryCalcMigrationRequest]{
+		"basic test": {
+			Cmd:           "1000gamm/pool/1",
+			ExpectedQuery: &types.QueryCalcMigrationRequest{SharesToMigrate: sdk.NewInt64Coin("gamm/pool/1", 1000)},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdEstimateJoinPoolWithArbitraryTokens(t *testing.T) {
+	desc, _ := cli.GetCmdEstimateJoinPoolWithArbitraryTokens()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryEstimateJoinPoolWithArbitraryTokensRequest]{
+		"basic test": {
+			Cmd: "1 10stake,20uatom --token-in-routes=uatom=2:uosmo",
+			ExpectedQuery: &types.QueryEstimateJoinPoolWithArbitraryTokensRequest{
+				PoolId:   1,
+				TokensIn: sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uatom", 20)),
+				TokenInRoutes: []types.TokenInRoutes{
+					{TokenInDenom: "uatom", Routes: []poolmanagertypes.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "uosmo"}}},
+				},
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
 func TestGetCmdSpotPrice(t *testing.T) {
 	desc, _ := cli.GetCmdSpotPrice()
 	tcs := map[string]osmocli.QueryCliTestCase[*types.QuerySpotPriceRequest]{
@@ -15,6 +15,7 @@ const (
 	PoolFileSwapFee        = "swap-fee"
 	PoolFileExitFee        = "exit-fee"
 	PoolFileFutureGovernor = "future-governor"
+	PoolFilePoolController = "pool-controller"
 
 	PoolFileSmoothWeightChangeParams = "lbp-params"
 	PoolFileStartTime                = "start-time"
@@ -40,6 +41,10 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// FlagScalingFactors represents the flag name for the scaling factors.
 	FlagScalingFactors = "scaling-factors"
+	// Will be parsed to time.Time, formatted as RFC3339.
+	FlagStartTime = "start-time"
+	// Will be parsed to []types.TokenInRoutes.
+	FlagTokenInRoutes = "token-in-routes"
 )
 
 type createBalancerPoolInputs struct {
@@ -48,6 +53,7 @@ type createBalancerPoolInputs struct {
 	SwapFee                  string                         `json:"swap-fee"`
 	ExitFee                  string                         `json:"exit-fee"`
 	FutureGovernor           string                         `json:"future-governor"`
+	PoolController           string                         `json:"pool-controller"`
 	SmoothWeightChangeParams smoothWeightChangeParamsInputs `json:"lbp-params"`
 }
 
@@ -114,3 +120,16 @@ func FlagSetAdjustScalingFactors() *flag.FlagSet {
 	fs.String(FlagScalingFactors, "", "The scaling factors")
 	return fs
 }
+
+func FlagSetScheduleSmoothWeightChange() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagStartTime, "", "The time the weight change starts at, formatted as RFC3339 (defaults to the block time the change is scheduled at)")
+	return fs
+}
+
+func FlagSetTokenInRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.StringArray(FlagTokenInRoutes, []string{}, "The routes swapping a token in that is not in the pool into a pool asset, formatted as "+
+		"[token-in-denom]=[pool-id]:[token-out-denom],... (specify multiple tokens with: --token-in-routes=uion=1:uosmo --token-in-routes=uatom=2:uosmo,3:uusdc)")
+	return fs
+}
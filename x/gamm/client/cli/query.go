@@ -28,6 +28,10 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPools)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdLBPStatus)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdScalingFactorRamp)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdCalcMigration)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateJoinPoolWithArbitraryTokens)
 	cmd.AddCommand(
 		GetCmdNumPools(),
 		GetCmdPoolParams(),
@@ -53,6 +57,47 @@ func GetCmdPool() (*osmocli.QueryDescriptor, *types.QueryPoolRequest) {
 {{.CommandPrefix}} pool 1`}, &types.QueryPoolRequest{}
 }
 
+// GetCmdLBPStatus returns the current and target weights of a balancer pool and the time left on its weight change.
+func GetCmdLBPStatus() (*osmocli.QueryDescriptor, *types.QueryLBPStatusRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "lbp-status [poolID]",
+		Short: "Query the weight change status of a balancer pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} lbp-status 1`}, &types.QueryLBPStatusRequest{}
+}
+
+// GetCmdScalingFactorRamp returns the current and target scaling factors of a stableswap pool and the time left on its ramp.
+func GetCmdScalingFactorRamp() (*osmocli.QueryDescriptor, *types.QueryScalingFactorRampRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "scaling-factor-ramp [poolID]",
+		Short: "Query the scaling factor ramp of a stableswap pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} scaling-factor-ramp 1`}, &types.QueryScalingFactorRampRequest{}
+}
+
+// GetCmdCalcMigration returns the result of migrating gamm shares to a full range concentrated liquidity position.
+func GetCmdCalcMigration() (*osmocli.QueryDescriptor, *types.QueryCalcMigrationRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "calc-migration [shares-to-migrate]",
+		Short: "Query the result of migrating gamm shares to a full range concentrated liquidity position",
+		Long: `{{.Short}}, including the tokens refunded to the owner of the shares as dust.{{.ExampleHeader}}
+{{.CommandPrefix}} calc-migration 1000000000000000000gamm/pool/1`}, &types.QueryCalcMigrationRequest{}
+}
+
+// GetCmdEstimateJoinPoolWithArbitraryTokens returns the shares and pool assets joined by joining a pool with any basket of tokens.
+func GetCmdEstimateJoinPoolWithArbitraryTokens() (*osmocli.QueryDescriptor, *types.QueryEstimateJoinPoolWithArbitraryTokensRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-join-pool-with-arbitrary-tokens [pool-id] [tokens-in]",
+		Short: "Query the shares and pool assets joined by joining a pool with any basket of tokens",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-join-pool-with-arbitrary-tokens 1 100uosmo,50uion,20uatom --token-in-routes=uatom=2:uosmo`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"TokenInRoutes": osmocli.FlagOnlyParser(ParseTokenInRoutes),
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetTokenInRoutes()}},
+	}, &types.QueryEstimateJoinPoolWithArbitraryTokensRequest{}
+}
+
 // TODO: Push this to the SDK.
 func writeOutputBoilerplate(ctx client.Context, out []byte) error {
 	writer := ctx.Output
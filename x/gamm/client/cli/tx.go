@@ -17,7 +17,10 @@ import (
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func NewTxCmd() *cobra.Command {
@@ -30,9 +33,14 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewJoinSwapShareAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapExternAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapShareAmountIn)
+	osmocli.AddTxCmd(txCmd, NewJoinPoolWithArbitraryTokens)
+	osmocli.AddTxCmd(txCmd, NewStableSwapAdjustFeesCmd)
 	txCmd.AddCommand(
 		NewCreatePoolCmd().BuildCommandCustomFn(),
 		NewStableSwapAdjustScalingFactorsCmd(),
+		NewScheduleSmoothWeightChangeCmd().BuildCommandCustomFn(),
+		NewPauseSwapsCmd().BuildCommandCustomFn(),
+		NewResumeSwapsCmd().BuildCommandCustomFn(),
 	)
 	return txCmd
 }
@@ -52,9 +60,13 @@ Sample pool JSON file contents for balancer:
 	"initial-deposit": "100uatom,5osmo,20uakt",
 	"swap-fee": "0.01",
 	"exit-fee": "0.01",
-	"future-governor": "168h"
+	"future-governor": "168h",
+	"pool-controller": "osmo1fqlr98d45v5ysqgp6h56kpujcj4cvsjnjq9nck"
 }
 
+The optional pool-controller is allowed to schedule new smooth weight changes
+and to pause and resume swaps against the balancer pool.
+
 For stableswap (demonstrating need for a 1:1000 scaling factor, see doc)
 {
 	"initial-deposit": "1000000uusdc,1000miliusdc",
@@ -135,6 +147,24 @@ func NewJoinSwapExternAmountIn() (*osmocli.TxCliDesc, *types.MsgJoinSwapExternAm
 	}, &types.MsgJoinSwapExternAmountIn{}
 }
 
+func NewJoinPoolWithArbitraryTokens() (*osmocli.TxCliDesc, *types.MsgJoinPoolWithArbitraryTokens) {
+	return &osmocli.TxCliDesc{
+		Use:   "join-pool-with-arbitrary-tokens [tokens-in] [share-out-min-amount]",
+		Short: "join a pool with any basket of tokens",
+		Long: `Join a pool with any basket of tokens. Tokens that are not in the pool are swapped into pool assets along their routes.
+The pool assets are then joined at the pool ratio, and their excess is joined by swapping it through the pool.`,
+		Example:             `osmosisd tx gamm join-pool-with-arbitrary-tokens 100uosmo,50uion,20uatom 1000000 --pool-id 1 --token-in-routes=uatom=2:uosmo --from val --chain-id osmosis-1`,
+		CustomFlagOverrides: poolIdFlagOverride,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"TokenInRoutes": osmocli.FlagOnlyParser(ParseTokenInRoutes),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()},
+			OptionalFlags: []*flag.FlagSet{FlagSetTokenInRoutes()},
+		},
+	}, &types.MsgJoinPoolWithArbitraryTokens{}
+}
+
 func NewJoinSwapShareAmountOut() (*osmocli.TxCliDesc, *types.MsgJoinSwapShareAmountOut) {
 	return &osmocli.TxCliDesc{
 		Use:                 "join-swap-share-amount-out [token-in-denom] [share-out-amount] [token-in-max-amount] ",
@@ -178,6 +208,123 @@ func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	return cmd
 }
 
+func NewStableSwapAdjustFeesCmd() (*osmocli.TxCliDesc, *stableswap.MsgStableSwapAdjustFees) {
+	return &osmocli.TxCliDesc{
+		Use:     "adjust-fees [pool-id] [swap-fee] [exit-fee]",
+		Short:   "adjust the swap and exit fees of a stableswap pool",
+		Long:    "Adjust the swap and exit fees of a stableswap pool. Only the scaling factor controller of the pool may adjust its fees.",
+		Example: "osmosisd tx gamm adjust-fees 1 0.003 0",
+	}, &stableswap.MsgStableSwapAdjustFees{}
+}
+
+// NewCmdSetStableSwapFeesProposal defines the command to create a new proposal to set
+// the swap and exit fees of an existing stableswap pool.
+func NewCmdSetStableSwapFeesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-stableswap-fees [pool-id] [swap-fee] [exit-fee]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "Submit a proposal to set the swap and exit fees of a stableswap pool",
+		Long:    "This proposal will set the swap and exit fees of the given stableswap pool if passed.",
+		Example: "osmosisd tx gov submit-proposal set-stableswap-fees 1 0.003 0 --title \"Title\" --description \"Description\"",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			swapFee, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			exitFee, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetStableSwapFeesProposal(title, description, poolId, swapFee, exitFee)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
+
+func NewScheduleSmoothWeightChangeCmd() *osmocli.TxCliDesc {
+	return &osmocli.TxCliDesc{
+		Use:   "schedule-weight-change [pool-id] [target-pool-weights] [duration]",
+		Short: "schedule a smooth weight change on a balancer pool",
+		Long: `Schedule a smooth change from the current weights of a balancer pool to the target weights over the given duration.
+Any weight change in progress is replaced. Only the pool controller may schedule weight changes.`,
+		Example:          "osmosisd tx gamm schedule-weight-change 1 2uatom,8uosmo 72h --start-time=2023-03-01T00:00:00Z",
+		NumArgs:          3,
+		ParseAndBuildMsg: NewBuildScheduleSmoothWeightChangeMsg,
+		Flags:            osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetScheduleSmoothWeightChange()}},
+	}
+}
+
+func NewPauseSwapsCmd() *osmocli.TxCliDesc {
+	return &osmocli.TxCliDesc{
+		Use:              "pause-swaps [pool-id]",
+		Short:            "pause swaps against a balancer pool",
+		Long:             "Pause swaps against a balancer pool. Only the pool controller may pause swaps.",
+		Example:          "osmosisd tx gamm pause-swaps 1",
+		NumArgs:          1,
+		ParseAndBuildMsg: newBuildSetSwapsPausedMsg(true),
+	}
+}
+
+func NewResumeSwapsCmd() *osmocli.TxCliDesc {
+	return &osmocli.TxCliDesc{
+		Use:              "resume-swaps [pool-id]",
+		Short:            "resume swaps against a balancer pool",
+		Long:             "Resume swaps against a balancer pool. Only the pool controller may resume swaps.",
+		Example:          "osmosisd tx gamm resume-swaps 1",
+		NumArgs:          1,
+		ParseAndBuildMsg: newBuildSetSwapsPausedMsg(false),
+	}
+}
+
 func BuildCreatePoolCmd(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
 	poolType, err := fs.GetString(FlagPoolType)
 	if err != nil {
@@ -252,6 +399,7 @@ func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, fs *flag.FlagSet) (
 		PoolParams:         poolParams,
 		PoolAssets:         poolAssets,
 		FuturePoolGovernor: pool.FutureGovernor,
+		PoolController:     pool.PoolController,
 	}
 
 	if (pool.SmoothWeightChangeParams != smoothWeightChangeParamsInputs{}) {
@@ -303,6 +451,63 @@ func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, fs *flag.FlagSet) (
 	return msg, nil
 }
 
+func NewBuildScheduleSmoothWeightChangeMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	targetPoolAssetCoins, err := sdk.ParseDecCoins(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	targetPoolAssets := make([]balancer.PoolAsset, 0, len(targetPoolAssetCoins))
+	for _, targetPoolAssetCoin := range targetPoolAssetCoins {
+		targetPoolAssets = append(targetPoolAssets, balancer.PoolAsset{
+			Weight: targetPoolAssetCoin.Amount.RoundInt(),
+			Token:  sdk.NewCoin(targetPoolAssetCoin.Denom, sdk.ZeroInt()),
+		})
+	}
+
+	duration, err := time.ParseDuration(args[2])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse duration: %w", err)
+	}
+
+	params := balancer.SmoothWeightChangeParams{
+		Duration:          duration,
+		TargetPoolWeights: targetPoolAssets,
+	}
+
+	startTimeStr, err := fs.GetString(FlagStartTime)
+	if err != nil {
+		return nil, err
+	}
+	if startTimeStr != "" {
+		startTime, err := time.Parse(time.RFC3339, startTimeStr)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse time: %w", err)
+		}
+		params.StartTime = startTime
+	}
+
+	msg := balancer.NewMsgScheduleSmoothWeightChange(clientCtx.GetFromAddress().String(), poolId, params)
+	return &msg, nil
+}
+
+func newBuildSetSwapsPausedMsg(swapsPaused bool) func(client.Context, []string, *flag.FlagSet) (sdk.Msg, error) {
+	return func(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+		poolId, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		msg := balancer.NewMsgSetSwapsPaused(clientCtx.GetFromAddress().String(), poolId, swapsPaused)
+		return &msg, nil
+	}
+}
+
 // Apologies to whoever has to touch this next, this code is horrendous
 func NewBuildCreateStableswapPoolMsg(clientCtx client.Context, fs *flag.FlagSet) (sdk.Msg, error) {
 	flags, err := parseCreateStableswapPoolFlags(fs)
@@ -412,6 +617,44 @@ func swapAmountInRoutes(fs *flag.FlagSet) ([]poolmanagertypes.SwapAmountInRoute,
 	return routes, nil
 }
 
+// ParseTokenInRoutes parses token in routes formatted as [token-in-denom]=[pool-id]:[token-out-denom],...
+func ParseTokenInRoutes(fs *flag.FlagSet) ([]types.TokenInRoutes, error) {
+	tokenInRoutesStrs, err := fs.GetStringArray(FlagTokenInRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	allTokenInRoutes := []types.TokenInRoutes{}
+	for _, tokenInRoutesStr := range tokenInRoutesStrs {
+		denomAndRoutes := strings.Split(tokenInRoutesStr, "=")
+		if len(denomAndRoutes) != 2 {
+			return nil, fmt.Errorf("token in routes %s must be formatted as [token-in-denom]=[pool-id]:[token-out-denom],...", tokenInRoutesStr)
+		}
+
+		routes := []poolmanagertypes.SwapAmountInRoute{}
+		for _, routeStr := range strings.Split(denomAndRoutes[1], ",") {
+			// denoms may contain ':', but pool ids may not.
+			poolIdAndDenom := strings.SplitN(routeStr, ":", 2)
+			if len(poolIdAndDenom) != 2 {
+				return nil, fmt.Errorf("route %s must be formatted as [pool-id]:[token-out-denom]", routeStr)
+			}
+			poolId, err := strconv.ParseUint(poolIdAndDenom[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			routes = append(routes, poolmanagertypes.SwapAmountInRoute{
+				PoolId:        poolId,
+				TokenOutDenom: poolIdAndDenom[1],
+			})
+		}
+		allTokenInRoutes = append(allTokenInRoutes, types.TokenInRoutes{
+			TokenInDenom: denomAndRoutes[0],
+			Routes:       routes,
+		})
+	}
+	return allTokenInRoutes, nil
+}
+
 func swapAmountOutRoutes(fs *flag.FlagSet) ([]poolmanagertypes.SwapAmountOutRoute, error) {
 	swapRoutePoolIds, err := fs.GetString(FlagSwapRoutePoolIds)
 	swapRoutePoolIdsArray := strings.Split(swapRoutePoolIds, ",")
@@ -0,0 +1,12 @@
+package client
+
+import (
+	"github.com/osmosis-labs/osmosis/v14/x/gamm/client/cli"
+	"github.com/osmosis-labs/osmosis/v14/x/gamm/client/rest"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	SetStableSwapFeesProposalHandler = govclient.NewProposalHandler(cli.NewCmdSetStableSwapFeesProposal, rest.ProposalSetStableSwapFeesRESTHandler)
+)
@@ -9,7 +9,8 @@ import (
 	"github.com/osmosis-labs/osmosis/v14/x/gamm/types"
 )
 
-// NewMigrationRecordHandler is a handler for governance proposals on new migration records.
+// NewMigrationRecordHandler is a handler for governance proposals on new migration records
+// and on stableswap pool fees.
 func NewMigrationRecordHandler(k keeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
@@ -17,9 +18,11 @@ func NewMigrationRecordHandler(k keeper.Keeper) govtypes.Handler {
 			return handleUpdateMigrationRecordsProposal(ctx, k, c)
 		case *types.ReplaceMigrationRecordsProposal:
 			return handleReplaceMigrationRecordsProposal(ctx, k, c)
+		case *types.SetStableSwapFeesProposal:
+			return handleSetStableSwapFeesProposal(ctx, k, c)
 
 		default:
-			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized migration record proposal content type: %T", c)
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gamm proposal content type: %T", c)
 		}
 	}
 }
@@ -33,3 +36,8 @@ func handleReplaceMigrationRecordsProposal(ctx sdk.Context, k keeper.Keeper, p *
 func handleUpdateMigrationRecordsProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateMigrationRecordsProposal) error {
 	return k.HandleUpdateMigrationRecordsProposal(ctx, p)
 }
+
+// handleSetStableSwapFeesProposal is a handler for setting stableswap pool fees governance proposals
+func handleSetStableSwapFeesProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetStableSwapFeesProposal) error {
+	return k.HandleSetStableSwapFeesProposal(ctx, p)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v14/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v14/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
 )
@@ -21,6 +22,18 @@ func (k Keeper) SetStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scal
 	return k.setStableSwapScalingFactors(ctx, poolId, scalingFactors, sender)
 }
 
+func (k Keeper) AdjustStableSwapFees(ctx sdk.Context, poolId uint64, swapFee, exitFee sdk.Dec, sender string) error {
+	return k.adjustStableSwapFees(ctx, poolId, swapFee, exitFee, sender)
+}
+
+func (k Keeper) ScheduleSmoothWeightChange(ctx sdk.Context, poolId uint64, params balancer.SmoothWeightChangeParams, sender string) error {
+	return k.scheduleSmoothWeightChange(ctx, poolId, params, sender)
+}
+
+func (k Keeper) SetBalancerSwapsPaused(ctx sdk.Context, poolId uint64, paused bool, sender string) error {
+	return k.setBalancerSwapsPaused(ctx, poolId, paused, sender)
+}
+
 func ConvertToCFMMPool(pool poolmanagertypes.PoolI) (types.CFMMPoolI, error) {
 	return convertToCFMMPool(pool)
 }
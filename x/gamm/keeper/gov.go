@@ -13,3 +13,11 @@ func (k Keeper) HandleReplaceMigrationRecordsProposal(ctx sdk.Context, p *types.
 func (k Keeper) HandleUpdateMigrationRecordsProposal(ctx sdk.Context, p *types.UpdateMigrationRecordsProposal) error {
 	return k.UpdateMigrationRecords(ctx, p.Records)
 }
+
+func (k Keeper) HandleSetStableSwapFeesProposal(ctx sdk.Context, p *types.SetStableSwapFeesProposal) error {
+	stableswapPool, err := k.getStableswapPoolAndPoke(ctx, p.PoolId)
+	if err != nil {
+		return err
+	}
+	return k.setStableSwapFees(ctx, stableswapPool, p.SwapFee, p.ExitFee)
+}
@@ -163,6 +163,28 @@ func (q Querier) CalcJoinPoolShares(ctx context.Context, req *types.QueryCalcJoi
 	}, nil
 }
 
+// EstimateJoinPoolWithArbitraryTokens queries the amount of shares you get and the pool assets joined
+// by joining a pool with an arbitrary basket of tokens.
+func (q Querier) EstimateJoinPoolWithArbitraryTokens(ctx context.Context, req *types.QueryEstimateJoinPoolWithArbitraryTokensRequest) (*types.QueryEstimateJoinPoolWithArbitraryTokensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.TokensIn == nil {
+		return nil, status.Error(codes.InvalidArgument, "no tokens in")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	numShares, tokensJoined, err := q.Keeper.CalcJoinPoolWithArbitraryTokens(sdkCtx, req.PoolId, req.TokensIn, req.TokenInRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimateJoinPoolWithArbitraryTokensResponse{
+		ShareOutAmount: numShares,
+		TokensJoined:   tokensJoined,
+	}, nil
+}
+
 // PoolsWithFilter query allows to query pools with specific parameters
 func (q Querier) PoolsWithFilter(ctx context.Context, req *types.QueryPoolsWithFilterRequest) (*types.QueryPoolsWithFilterResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -279,6 +301,28 @@ func (q Querier) CalcJoinPoolNoSwapShares(ctx context.Context, req *types.QueryC
 	}, nil
 }
 
+// CalcMigration returns the result of migrating the given gamm shares to a full range position in the linked
+// concentrated liquidity pool, including the exited tokens that would be refunded as dust, without migrating them.
+func (q Querier) CalcMigration(ctx context.Context, req *types.QueryCalcMigrationRequest) (*types.QueryCalcMigrationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	poolIdEntering, amount0, amount1, liquidity, refundedDust, err := q.Keeper.calcMigration(sdkCtx, req.SharesToMigrate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryCalcMigrationResponse{
+		PoolIdEntering:   poolIdEntering,
+		Amount0:          amount0,
+		Amount1:          amount1,
+		LiquidityCreated: liquidity,
+		RefundedDust:     refundedDust,
+	}, nil
+}
+
 // PoolParams queries a specified pool for its params.
 func (q Querier) PoolParams(ctx context.Context, req *types.QueryPoolParamsRequest) (*types.QueryPoolParamsResponse, error) {
 	if req == nil {
@@ -309,6 +353,90 @@ func (q Querier) PoolParams(ctx context.Context, req *types.QueryPoolParamsReque
 	}
 }
 
+// LBPStatus returns the current and target weights of a balancer pool, the time left on
+// its scheduled smooth weight change and whether swaps against it are paused.
+func (q Querier) LBPStatus(ctx context.Context, req *types.QueryLBPStatusRequest) (*types.QueryLBPStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.getBalancerPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryLBPStatusResponse{
+		CurrentWeights: normalizedDenomWeights(pool.GetAllPoolAssets()),
+		TargetWeights:  []types.DenomWeight{},
+		SwapsPaused:    pool.SwapsPaused,
+		PoolController: pool.PoolController,
+	}
+
+	params := pool.PoolParams.SmoothWeightChangeParams
+	if params == nil {
+		return res, nil
+	}
+
+	res.TargetWeights = normalizedDenomWeights(params.TargetPoolWeights)
+	res.StartTime = params.StartTime
+	if endTime := params.StartTime.Add(params.Duration); endTime.After(sdkCtx.BlockTime()) {
+		res.TimeRemaining = endTime.Sub(sdkCtx.BlockTime())
+	}
+	return res, nil
+}
+
+// ScalingFactorRamp returns the current and target scaling factors of a stableswap pool and the time left
+// on its scaling factor ramp.
+func (q Querier) ScalingFactorRamp(ctx context.Context, req *types.QueryScalingFactorRampRequest) (*types.QueryScalingFactorRampResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.getStableswapPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryScalingFactorRampResponse{
+		CurrentScalingFactors: pool.GetScalingFactors(),
+		TargetScalingFactors:  []uint64{},
+	}
+
+	ramp := pool.ScalingFactorRamp
+	if ramp == nil {
+		return res, nil
+	}
+
+	res.TargetScalingFactors = ramp.TargetScalingFactors
+	res.StartTime = ramp.StartTime
+	if endTime := ramp.StartTime.Add(ramp.Duration); endTime.After(sdkCtx.BlockTime()) {
+		res.TimeRemaining = endTime.Sub(sdkCtx.BlockTime())
+	}
+	return res, nil
+}
+
+// normalizedDenomWeights returns the weight of each asset divided by the total weight of all assets.
+func normalizedDenomWeights(assets []balancer.PoolAsset) []types.DenomWeight {
+	totalWeight := sdk.ZeroInt()
+	for _, asset := range assets {
+		totalWeight = totalWeight.Add(asset.Weight)
+	}
+
+	weights := make([]types.DenomWeight, 0, len(assets))
+	for _, asset := range assets {
+		weight := sdk.ZeroDec()
+		if totalWeight.IsPositive() {
+			weight = asset.Weight.ToDec().QuoInt(totalWeight)
+		}
+		weights = append(weights, types.DenomWeight{Denom: asset.Token.Denom, Weight: weight})
+	}
+	return weights
+}
+
 // TotalPoolLiquidity returns total liquidity in pool.
 func (q Querier) TotalPoolLiquidity(ctx context.Context, req *types.QueryTotalPoolLiquidityRequest) (*types.QueryTotalPoolLiquidityResponse, error) {
 	if req == nil {
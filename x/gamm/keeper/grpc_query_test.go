@@ -3,11 +3,13 @@ package keeper_test
 import (
 	gocontext "context"
 	"errors"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/osmosis-labs/osmosis/v14/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v14/x/gamm/pool-models/balancer"
 	balancertypes "github.com/osmosis-labs/osmosis/v14/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v14/x/gamm/pool-models/stableswap"
@@ -528,6 +530,108 @@ func (suite *KeeperTestSuite) TestPoolType() {
 	suite.Require().Equal(stableswap.PoolTypeName, res.PoolType)
 }
 
+func (suite *KeeperTestSuite) TestQueryLBPStatus() {
+	controllerAddr := suite.TestAccs[0]
+	poolId := suite.prepareControlledBalancerPool(controllerAddr)
+	stableswapPoolId := suite.PrepareBasicStableswapPool()
+
+	// querying a pool that is not a balancer pool fails.
+	_, err := suite.queryClient.LBPStatus(gocontext.Background(), &types.QueryLBPStatusRequest{PoolId: stableswapPoolId})
+	suite.Require().ErrorContains(err, types.ErrNotBalancerPool.Error())
+
+	res, err := suite.queryClient.LBPStatus(gocontext.Background(), &types.QueryLBPStatusRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomWeight{
+		{Denom: "bar", Weight: sdk.MustNewDecFromStr("0.5")},
+		{Denom: "foo", Weight: sdk.MustNewDecFromStr("0.5")},
+	}, res.CurrentWeights)
+	suite.Require().Empty(res.TargetWeights)
+	suite.Require().Equal(time.Duration(0), res.TimeRemaining)
+	suite.Require().Equal(controllerAddr.String(), res.PoolController)
+	suite.Require().False(res.SwapsPaused)
+
+	err = suite.App.GAMMKeeper.ScheduleSmoothWeightChange(suite.Ctx, poolId, balancer.SmoothWeightChangeParams{
+		Duration: time.Hour,
+		TargetPoolWeights: []balancer.PoolAsset{
+			{Weight: sdk.NewInt(300), Token: sdk.NewCoin("foo", sdk.ZeroInt())},
+			{Weight: sdk.NewInt(100), Token: sdk.NewCoin("bar", sdk.ZeroInt())},
+		},
+	}, controllerAddr.String())
+	suite.Require().NoError(err)
+	err = suite.App.GAMMKeeper.SetBalancerSwapsPaused(suite.Ctx, poolId, true, controllerAddr.String())
+	suite.Require().NoError(err)
+	startTime := suite.Ctx.BlockTime()
+	querier := keeper.NewQuerier(*suite.App.GAMMKeeper)
+
+	// halfway through the weight change.
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+	res, err = querier.LBPStatus(sdk.WrapSDKContext(suite.Ctx), &types.QueryLBPStatusRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.CurrentWeights, 2)
+	suite.Require().Equal("foo", res.CurrentWeights[1].Denom)
+	suite.Require().True(res.CurrentWeights[1].Weight.GT(sdk.MustNewDecFromStr("0.5")))
+	suite.Require().True(res.CurrentWeights[1].Weight.LT(sdk.MustNewDecFromStr("0.75")))
+	suite.Require().Equal([]types.DenomWeight{
+		{Denom: "bar", Weight: sdk.MustNewDecFromStr("0.25")},
+		{Denom: "foo", Weight: sdk.MustNewDecFromStr("0.75")},
+	}, res.TargetWeights)
+	suite.Require().Equal(startTime.Unix(), res.StartTime.Unix())
+	suite.Require().Equal(res.StartTime.Add(time.Hour).Sub(suite.Ctx.BlockTime()), res.TimeRemaining)
+	suite.Require().True(res.SwapsPaused)
+
+	// the weight change is over.
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(2 * time.Hour))
+	res, err = querier.LBPStatus(sdk.WrapSDKContext(suite.Ctx), &types.QueryLBPStatusRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomWeight{
+		{Denom: "bar", Weight: sdk.MustNewDecFromStr("0.25")},
+		{Denom: "foo", Weight: sdk.MustNewDecFromStr("0.75")},
+	}, res.CurrentWeights)
+	suite.Require().Empty(res.TargetWeights)
+	suite.Require().Equal(time.Duration(0), res.TimeRemaining)
+}
+
+func (suite *KeeperTestSuite) TestQueryScalingFactorRamp() {
+	controllerAddr := suite.TestAccs[0]
+	poolId := suite.prepareControlledStableswapPool(controllerAddr)
+	balancerPoolId := suite.PrepareBalancerPool()
+
+	// querying a pool that is not a stableswap pool fails.
+	_, err := suite.queryClient.ScalingFactorRamp(gocontext.Background(), &types.QueryScalingFactorRampRequest{PoolId: balancerPoolId})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.ScalingFactorRamp(gocontext.Background(), &types.QueryScalingFactorRampRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{1, 1}, res.CurrentScalingFactors)
+	suite.Require().Empty(res.TargetScalingFactors)
+	suite.Require().Equal(time.Duration(0), res.TimeRemaining)
+
+	params := suite.App.GAMMKeeper.GetParams(suite.Ctx)
+	params.ScalingFactorRampDuration = time.Hour
+	suite.App.GAMMKeeper.SetParams(suite.Ctx, params)
+	err = suite.App.GAMMKeeper.SetStableSwapScalingFactors(suite.Ctx, poolId, []uint64{3, 1}, controllerAddr.String())
+	suite.Require().NoError(err)
+	startTime := suite.Ctx.BlockTime()
+	querier := keeper.NewQuerier(*suite.App.GAMMKeeper)
+
+	// halfway through the ramp.
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+	res, err = querier.ScalingFactorRamp(sdk.WrapSDKContext(suite.Ctx), &types.QueryScalingFactorRampRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{2, 1}, res.CurrentScalingFactors)
+	suite.Require().Equal([]uint64{3, 1}, res.TargetScalingFactors)
+	suite.Require().True(startTime.Equal(res.StartTime))
+	suite.Require().Equal(30*time.Minute, res.TimeRemaining)
+
+	// the ramp is over.
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(2 * time.Hour))
+	res, err = querier.ScalingFactorRamp(sdk.WrapSDKContext(suite.Ctx), &types.QueryScalingFactorRampRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{3, 1}, res.CurrentScalingFactors)
+	suite.Require().Empty(res.TargetScalingFactors)
+	suite.Require().Equal(time.Duration(0), res.TimeRemaining)
+}
+
 func (suite *KeeperTestSuite) TestQueryNumPools1() {
 	res, err := suite.queryClient.NumPools(gocontext.Background(), &types.QueryNumPoolsRequest{})
 	suite.Require().NoError(err)
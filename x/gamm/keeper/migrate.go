@@ -14,6 +14,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Migrate migrates the sender's unlocked gamm shares to a full range position in poolIdEntering, which must be the
+// concentrated liquidity pool linked to the shares' balancer pool by the migration records.
 func (k Keeper) Migrate(ctx sdk.Context, sender sdk.AccAddress, sharesToMigrate sdk.Coin, poolIdEntering uint64) (amount0, amount1 sdk.Int, liquidity sdk.Dec, poolIdLeaving uint64, err error) {
 	// Get the balancer poolId by parsing the gamm share denom.
 	poolIdLeaving, err = getPoolIdFromSharesDenom(sharesToMigrate.Denom)
@@ -21,49 +23,123 @@ func (k Keeper) Migrate(ctx sdk.Context, sender sdk.AccAddress, sharesToMigrate
 		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, err
 	}
 
-	// Ensure a governance sanctioned link exists between the balancer pool and the concentrated pool.
-	migrationInfo := k.GetMigrationInfo(ctx)
-	matchFound := false
-	for _, info := range migrationInfo.BalancerToConcentratedPoolLinks {
-		if info.BalancerPoolId == poolIdLeaving {
-			if info.ClPoolId != poolIdEntering {
-				return sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, types.InvalidPoolMigrationLinkError{PoolIdEntering: poolIdEntering, CanonicalId: info.ClPoolId}
-			}
-			matchFound = true
-			break
-		}
+	// Ensure the given concentrated pool is the one linked to the balancer pool.
+	canonicalId, err := k.GetLinkedConcentratedPoolID(ctx, poolIdLeaving)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, err
 	}
-	if !matchFound {
-		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, types.PoolMigrationLinkNotFoundError{PoolIdLeaving: poolIdLeaving}
+	if canonicalId != poolIdEntering {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, types.InvalidPoolMigrationLinkError{PoolIdEntering: poolIdEntering, CanonicalId: canonicalId}
 	}
 
-	// Get the concentrated pool from the message and type cast it to ConcentratedPoolExtension.
-	poolI, err := k.clKeeper.GetPool(ctx, poolIdEntering)
+	_, amount0, amount1, liquidity, poolIdLeaving, _, err = k.MigrateSharesToFullRangeConcentratedPosition(ctx, sender, sharesToMigrate, time.Time{})
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, err
 	}
-	concentratedPool, ok := poolI.(cltypes.ConcentratedPoolExtension)
-	if !ok {
-		// If the conversion fails, return an error.
-		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, fmt.Errorf("given pool does not implement ConcentratedPoolExtension, implements %T", poolI)
+	return amount0, amount1, liquidity, poolIdLeaving, nil
+}
+
+// MigrateSharesToFullRangeConcentratedPosition exits the balancer pool with the sender's unlocked gamm shares and
+// creates a full range position, frozen until frozenUntil, in the concentrated liquidity pool linked to the balancer
+// pool by the migration records. Migrating only some of the sender's shares is supported.
+// The exited tokens that do not fit the concentrated pool's price are left with the sender.
+func (k Keeper) MigrateSharesToFullRangeConcentratedPosition(ctx sdk.Context, sender sdk.AccAddress, sharesToMigrate sdk.Coin, frozenUntil time.Time) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, poolIdLeaving, poolIdEntering uint64, err error) {
+	poolIdLeaving, concentratedPool, err := k.getMigrationPools(ctx, sharesToMigrate)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
 	}
+	poolIdEntering = concentratedPool.GetId()
 
 	// Exit the balancer pool position.
 	exitCoins, err := k.ExitPool(ctx, sender, poolIdLeaving, sharesToMigrate.Amount, sdk.NewCoins())
 	if err != nil {
-		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, err
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
 	}
 
 	// Determine the max and min ticks for the concentrated pool we are migrating to.
 	minTick, maxTick := cl.GetMinAndMaxTicksFromExponentAtPriceOne(concentratedPool.GetPrecisionFactorAtPriceOne())
 
 	// Create a full range (min to max tick) concentrated liquidity position.
-	// TODO: Will need to implement lock breaking logic and add the corresponding freeze duration here.
-	amount0, amount1, liquidity, err = k.clKeeper.CreatePosition(ctx, poolIdEntering, sender, exitCoins.AmountOf(concentratedPool.GetToken0()), exitCoins.AmountOf(concentratedPool.GetToken1()), sdk.ZeroInt(), sdk.ZeroInt(), minTick, maxTick, time.Time{})
+	positionId, amount0, amount1, liquidity, err = k.clKeeper.CreatePosition(ctx, poolIdEntering, sender, exitCoins.AmountOf(concentratedPool.GetToken0()), exitCoins.AmountOf(concentratedPool.GetToken1()), sdk.ZeroInt(), sdk.ZeroInt(), minTick, maxTick, frozenUntil)
 	if err != nil {
-		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, err
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, 0, err
 	}
-	return amount0, amount1, liquidity, poolIdLeaving, nil
+	return positionId, amount0, amount1, liquidity, poolIdLeaving, poolIdEntering, nil
+}
+
+// calcMigration returns the concentrated liquidity pool that the given gamm shares would be migrated to, the amount
+// of each token that the full range position would hold, the liquidity it would be created with, and the exited
+// tokens that would be refunded to the owner of the shares because they do not fit the concentrated pool's price.
+// No state is changed.
+func (k Keeper) calcMigration(ctx sdk.Context, sharesToMigrate sdk.Coin) (poolIdEntering uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, refundedDust sdk.Coins, err error) {
+	poolIdLeaving, concentratedPool, err := k.getMigrationPools(ctx, sharesToMigrate)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdk.Coins{}, err
+	}
+	poolIdEntering = concentratedPool.GetId()
+
+	if !sharesToMigrate.Amount.IsPositive() {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdk.Coins{}, fmt.Errorf("shares to migrate must be positive, got %s", sharesToMigrate)
+	}
+
+	balancerPool, err := k.GetPoolAndPoke(ctx, poolIdLeaving)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdk.Coins{}, err
+	}
+	exitCoins, err := balancerPool.CalcExitPoolCoinsFromShares(ctx, sharesToMigrate.Amount, balancerPool.GetExitFee(ctx))
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdk.Coins{}, err
+	}
+
+	token0, token1 := concentratedPool.GetToken0(), concentratedPool.GetToken1()
+	amount0, amount1, liquidity, err = k.clKeeper.CalcFullRangePosition(ctx, poolIdEntering, exitCoins.AmountOf(token0), exitCoins.AmountOf(token1))
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdk.Coins{}, err
+	}
+
+	refundedDust = exitCoins.Sub(sdk.NewCoins(sdk.NewCoin(token0, amount0), sdk.NewCoin(token1, amount1)))
+	return poolIdEntering, amount0, amount1, liquidity, refundedDust, nil
+}
+
+// getMigrationPools returns the id of the balancer pool of the given gamm shares and the concentrated liquidity
+// pool linked to it by the migration records.
+func (k Keeper) getMigrationPools(ctx sdk.Context, sharesToMigrate sdk.Coin) (uint64, cltypes.ConcentratedPoolExtension, error) {
+	// Get the balancer poolId by parsing the gamm share denom.
+	poolIdLeaving, err := getPoolIdFromSharesDenom(sharesToMigrate.Denom)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Ensure a governance sanctioned link exists between the balancer pool and the concentrated pool.
+	poolIdEntering, err := k.GetLinkedConcentratedPoolID(ctx, poolIdLeaving)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Get the concentrated pool and type cast it to ConcentratedPoolExtension.
+	poolI, err := k.clKeeper.GetPool(ctx, poolIdEntering)
+	if err != nil {
+		return 0, nil, err
+	}
+	concentratedPool, ok := poolI.(cltypes.ConcentratedPoolExtension)
+	if !ok {
+		// If the conversion fails, return an error.
+		return 0, nil, fmt.Errorf("given pool does not implement ConcentratedPoolExtension, implements %T", poolI)
+	}
+	return poolIdLeaving, concentratedPool, nil
+}
+
+// GetLinkedConcentratedPoolID returns the id of the concentrated liquidity pool that the given balancer pool is
+// linked to by the migration records.
+// Returns PoolMigrationLinkNotFoundError if the balancer pool is not linked to a concentrated liquidity pool.
+func (k Keeper) GetLinkedConcentratedPoolID(ctx sdk.Context, poolIdLeaving uint64) (uint64, error) {
+	migrationInfo := k.GetMigrationInfo(ctx)
+	for _, info := range migrationInfo.BalancerToConcentratedPoolLinks {
+		if info.BalancerPoolId == poolIdLeaving {
+			return info.ClPoolId, nil
+		}
+	}
+	return 0, types.PoolMigrationLinkNotFoundError{PoolIdLeaving: poolIdLeaving}
 }
 
 // GetMigrationInfo returns the balancer to gamm pool migration info from the store
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	gocontext "context"
 	"fmt"
 	"time"
 
@@ -179,9 +180,9 @@ func (suite *KeeperTestSuite) TestMigrate() {
 			suite.Require().Equal(sdk.NewInt(0), clPoolUsdcBalanceAfterFailedMigration.Amount)
 
 			// Assure the position was not created.
-			// TODO: When we implement lock breaking, we need to change time.Time{} to the lock's end time.
-			_, err := suite.App.ConcentratedLiquidityKeeper.GetPosition(suite.Ctx, clPool.GetId(), test.param.sender, minTick, maxTick, time.Time{})
-			suite.Require().Error(err)
+			positions, err := suite.App.ConcentratedLiquidityKeeper.GetUserPositions(suite.Ctx, test.param.sender, clPool.GetId())
+			suite.Require().NoError(err)
+			suite.Require().Empty(positions)
 			continue
 		}
 		suite.Require().NoError(err)
@@ -194,10 +195,13 @@ func (suite *KeeperTestSuite) TestMigrate() {
 		suite.Require().Equal(userBalancesBeforeMigration.AmountOf(USDC).Add(expectedUserFinalUsdcBalanceDiff).String(), userBalancesAfterMigration.AmountOf(USDC).String())
 
 		// Assure the expected position was created.
-		// TODO: When we implement lock breaking, we need to change time.Time{} to the lock's end time.
-		position, err := suite.App.ConcentratedLiquidityKeeper.GetPosition(suite.Ctx, clPool.GetId(), test.param.sender, minTick, maxTick, time.Time{})
+		positions, err := suite.App.ConcentratedLiquidityKeeper.GetUserPositions(suite.Ctx, test.param.sender, clPool.GetId())
 		suite.Require().NoError(err)
-		suite.Require().Equal(test.expectedPosition, position)
+		suite.Require().Len(positions, 1)
+		suite.Require().Equal(test.expectedPosition.Liquidity, positions[0].Liquidity)
+		suite.Require().Equal(minTick, positions[0].LowerTick)
+		suite.Require().Equal(maxTick, positions[0].UpperTick)
+		suite.Require().Equal(time.Time{}, positions[0].FrozenUntil)
 
 		// Note gamm pool balance after migration
 		gammPoolEthBalancePostMigrate := suite.App.BankKeeper.GetBalance(suite.Ctx, balancerPoolAddress, ETH)
@@ -223,6 +227,125 @@ func (suite *KeeperTestSuite) TestMigrate() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCalcMigration() {
+	defaultAccount := suite.TestAccs[0]
+	defaultGammShares := sdk.NewCoin("gamm/pool/1", sdk.MustNewDecFromStr("100000000000000000000").RoundInt())
+	defaultAccountFunds := sdk.NewCoins(sdk.NewCoin("eth", sdk.NewInt(200000000000)), sdk.NewCoin("usdc", sdk.NewInt(200000000000)))
+
+	tests := map[string]struct {
+		sharesToMigrate        sdk.Coin
+		existingClPosition     bool
+		setupPoolMigrationLink bool
+		expectErr              bool
+	}{
+		"migrate all of the shares into an empty pool": {
+			sharesToMigrate:        defaultGammShares,
+			setupPoolMigrationLink: true,
+		},
+		"migrate half of the shares into a pool with a different price": {
+			sharesToMigrate:        sdk.NewCoin(defaultGammShares.Denom, defaultGammShares.Amount.QuoRaw(2)),
+			existingClPosition:     true,
+			setupPoolMigrationLink: true,
+		},
+		"error: no pool migration link": {
+			sharesToMigrate: defaultGammShares,
+			expectErr:       true,
+		},
+		"error: zero shares": {
+			sharesToMigrate:        sdk.NewCoin(defaultGammShares.Denom, sdk.ZeroInt()),
+			setupPoolMigrationLink: true,
+			expectErr:              true,
+		},
+		"error: not a gamm share": {
+			sharesToMigrate:        sdk.NewInt64Coin("eth", 100),
+			setupPoolMigrationLink: true,
+			expectErr:              true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			keeper := suite.App.GAMMKeeper
+
+			suite.FundAcc(defaultAccount, defaultAccountFunds)
+			balancerPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewCoin("eth", sdk.NewInt(100000000000)), sdk.NewCoin("usdc", sdk.NewInt(100000000000)))
+			clPool := suite.PrepareConcentratedPool()
+			if tc.existingClPosition {
+				// the concentrated pool's price differs from the balancer pool's, so some exited tokens are refunded.
+				suite.FundAcc(suite.TestAccs[1], sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(1000000)), sdk.NewCoin(USDC, sdk.NewInt(5000000000))))
+				_, _, _, _, err := suite.App.ConcentratedLiquidityKeeper.CreatePosition(suite.Ctx, clPool.GetId(), suite.TestAccs[1], sdk.NewInt(1000000), sdk.NewInt(5000000000), sdk.ZeroInt(), sdk.ZeroInt(), -1620000, 3420000, time.Time{})
+				suite.Require().NoError(err)
+			}
+			if tc.setupPoolMigrationLink {
+				record := types.BalancerToConcentratedPoolLink{BalancerPoolId: balancerPoolId, ClPoolId: clPool.GetId()}
+				err := keeper.ReplaceMigrationRecords(suite.Ctx, []types.BalancerToConcentratedPoolLink{record})
+				suite.Require().NoError(err)
+			}
+			_, _, err := keeper.JoinPoolNoSwap(suite.Ctx, defaultAccount, balancerPoolId, defaultGammShares.Amount, sdk.Coins{})
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.CalcMigration(gocontext.Background(), &types.QueryCalcMigrationRequest{SharesToMigrate: tc.sharesToMigrate})
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(clPool.GetId(), res.PoolIdEntering)
+			if tc.existingClPosition {
+				suite.Require().False(res.RefundedDust.IsZero())
+			}
+
+			// the calculation matches the migration.
+			userBalancesBeforeMigration := suite.App.BankKeeper.GetAllBalances(suite.Ctx, defaultAccount)
+			amount0, amount1, liquidity, _, err := keeper.Migrate(suite.Ctx, defaultAccount, tc.sharesToMigrate, clPool.GetId())
+			suite.Require().NoError(err)
+			suite.Require().Equal(amount0, res.Amount0)
+			suite.Require().Equal(amount1, res.Amount1)
+			suite.Require().Equal(liquidity, res.LiquidityCreated)
+
+			// the refunded dust is left with the user.
+			userBalancesAfterMigration := suite.App.BankKeeper.GetAllBalances(suite.Ctx, defaultAccount)
+			suite.Require().Equal(userBalancesBeforeMigration.AmountOf(ETH).Add(res.RefundedDust.AmountOf(ETH)), userBalancesAfterMigration.AmountOf(ETH))
+			suite.Require().Equal(userBalancesBeforeMigration.AmountOf(USDC).Add(res.RefundedDust.AmountOf(USDC)), userBalancesAfterMigration.AmountOf(USDC))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrateSharesToFullRangeConcentratedPosition() {
+	defaultAccount := suite.TestAccs[0]
+	defaultGammShares := sdk.NewCoin("gamm/pool/1", sdk.MustNewDecFromStr("100000000000000000000").RoundInt())
+	suite.FundAcc(defaultAccount, sdk.NewCoins(sdk.NewCoin("eth", sdk.NewInt(200000000000)), sdk.NewCoin("usdc", sdk.NewInt(200000000000))))
+	balancerPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewCoin("eth", sdk.NewInt(100000000000)), sdk.NewCoin("usdc", sdk.NewInt(100000000000)))
+	clPool := suite.PrepareConcentratedPool()
+	keeper := suite.App.GAMMKeeper
+
+	_, _, err := keeper.JoinPoolNoSwap(suite.Ctx, defaultAccount, balancerPoolId, defaultGammShares.Amount, sdk.Coins{})
+	suite.Require().NoError(err)
+	frozenUntil := suite.Ctx.BlockTime().Add(time.Hour * 24 * 14)
+
+	// the pools are not linked yet.
+	_, _, _, _, _, _, err = keeper.MigrateSharesToFullRangeConcentratedPosition(suite.Ctx, defaultAccount, defaultGammShares, frozenUntil)
+	suite.Require().ErrorIs(err, types.PoolMigrationLinkNotFoundError{PoolIdLeaving: balancerPoolId})
+
+	record := types.BalancerToConcentratedPoolLink{BalancerPoolId: balancerPoolId, ClPoolId: clPool.GetId()}
+	err = keeper.ReplaceMigrationRecords(suite.Ctx, []types.BalancerToConcentratedPoolLink{record})
+	suite.Require().NoError(err)
+	linkedPoolId, err := keeper.GetLinkedConcentratedPoolID(suite.Ctx, balancerPoolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(clPool.GetId(), linkedPoolId)
+
+	positionId, _, _, liquidity, poolIdLeaving, poolIdEntering, err := keeper.MigrateSharesToFullRangeConcentratedPosition(suite.Ctx, defaultAccount, defaultGammShares, frozenUntil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(balancerPoolId, poolIdLeaving)
+	suite.Require().Equal(clPool.GetId(), poolIdEntering)
+
+	position, err := suite.App.ConcentratedLiquidityKeeper.GetPosition(suite.Ctx, positionId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(liquidity, position.Liquidity)
+	suite.Require().Equal(frozenUntil, position.FrozenUntil)
+}
+
 func (suite *KeeperTestSuite) TestReplaceMigrationRecords() {
 	tests := []struct {
 		name                        string
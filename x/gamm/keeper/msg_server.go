@@ -64,6 +64,42 @@ func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, ms
 	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
 }
 
+// StableSwapAdjustFees sets the swap and exit fees of a stableswap pool.
+// The sender must be the pool's scaling factor controller.
+func (server msgServer) StableSwapAdjustFees(goCtx context.Context, msg *stableswap.MsgStableSwapAdjustFees) (*stableswap.MsgStableSwapAdjustFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.adjustStableSwapFees(ctx, msg.PoolID, msg.SwapFee, msg.ExitFee, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapAdjustFeesResponse{}, nil
+}
+
+// ScheduleSmoothWeightChange schedules a smooth weight change on a balancer pool.
+// The sender must be the pool's controller.
+func (server msgServer) ScheduleSmoothWeightChange(goCtx context.Context, msg *balancer.MsgScheduleSmoothWeightChange) (*balancer.MsgScheduleSmoothWeightChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.scheduleSmoothWeightChange(ctx, msg.PoolID, msg.SmoothWeightChangeParams, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &balancer.MsgScheduleSmoothWeightChangeResponse{}, nil
+}
+
+// SetSwapsPaused pauses or resumes swaps against a balancer pool.
+// The sender must be the pool's controller.
+func (server msgServer) SetSwapsPaused(goCtx context.Context, msg *balancer.MsgSetSwapsPaused) (*balancer.MsgSetSwapsPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.setBalancerSwapsPaused(ctx, msg.PoolID, msg.SwapsPaused, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &balancer.MsgSetSwapsPausedResponse{}, nil
+}
+
 // CreatePool attempts to create a pool returning the newly created pool ID or an error upon failure.
 // The pool creation fee is used to fund the community pool.
 // It will create a dedicated module account for the pool and sends the initial liquidity to the created module account.
@@ -235,6 +271,31 @@ func (server msgServer) JoinSwapExternAmountIn(goCtx context.Context, msg *types
 	return &types.MsgJoinSwapExternAmountInResponse{ShareOutAmount: shareOutAmount}, nil
 }
 
+func (server msgServer) JoinPoolWithArbitraryTokens(goCtx context.Context, msg *types.MsgJoinPoolWithArbitraryTokens) (*types.MsgJoinPoolWithArbitraryTokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	shareOutAmount, tokensJoined, err := server.keeper.JoinPoolWithArbitraryTokens(ctx, sender, msg.PoolId, msg.TokensIn, msg.TokenInRoutes, msg.ShareOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap and LP events are handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgJoinPoolWithArbitraryTokensResponse{ShareOutAmount: shareOutAmount, TokensJoined: tokensJoined}, nil
+}
+
 func (server msgServer) JoinSwapShareAmountOut(goCtx context.Context, msg *types.MsgJoinSwapShareAmountOut) (*types.MsgJoinSwapShareAmountOutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
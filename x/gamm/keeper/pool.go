@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -17,6 +18,12 @@ import (
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
 )
 
+// pokablePool is a pool whose state changes over time, such as the weights of a balancer pool
+// or the scaling factors of a stableswap pool. PokePool brings that state up to date.
+type pokablePool interface {
+	PokePool(blockTime time.Time)
+}
+
 func (k Keeper) MarshalPool(pool poolmanagertypes.PoolI) ([]byte, error) {
 	return k.cdc.MarshalInterface(pool)
 }
@@ -32,7 +39,8 @@ func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI,
 }
 
 // GetPoolAndPoke returns a PoolI based on it's identifier if one exists. If poolId corresponds
-// to a pool with weights (e.g. balancer), the weights of the pool are updated via PokePool prior to returning.
+// to a pool with weights (e.g. balancer) or a scaling factor ramp (e.g. stableswap), the pool is updated
+// via PokePool prior to returning.
 // TODO: Consider rename to GetPool due to downstream API confusion.
 func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.CFMMPoolI, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -48,7 +56,7 @@ func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.CFMMPoolI,
 		return nil, err
 	}
 
-	if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
+	if pokePool, ok := pool.(pokablePool); ok {
 		pokePool.PokePool(ctx.BlockTime())
 	}
 
@@ -85,7 +93,7 @@ func (k Keeper) GetPoolsAndPoke(ctx sdk.Context) (res []types.CFMMPoolI, err err
 			return nil, err
 		}
 
-		if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
+		if pokePool, ok := pool.(pokablePool); ok {
 			pokePool.PokePool(ctx.BlockTime())
 		}
 		res = append(res, pool)
@@ -267,23 +275,157 @@ func (k Keeper) GetPoolType(ctx sdk.Context, poolId uint64) (poolmanagertypes.Po
 	}
 }
 
-// setStableSwapScalingFactors sets the stable swap scaling factors.
+// getStableswapPoolAndPoke returns the stableswap pool with the given id after poking it.
+// errors if the pool does not exist or is not a stableswap pool.
+func (k Keeper) getStableswapPoolAndPoke(ctx sdk.Context, poolId uint64) (*stableswap.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return nil, fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+	return stableswapPool, nil
+}
+
+// setStableSwapScalingFactors moves the stable swap scaling factors to the given scaling factors over the
+// scaling factor ramp duration param, replacing any ramp in progress.
 // errors if the pool does not exist, the sender is not the scaling factor controller, or due to other
 // internal errors.
 func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scalingFactors []uint64, sender string) error {
-	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	stableswapPool, err := k.getStableswapPoolAndPoke(ctx, poolId)
 	if err != nil {
 		return err
 	}
-	stableswapPool, ok := pool.(*stableswap.Pool)
+	rampDuration := k.GetParams(ctx).ScalingFactorRampDuration
+	if err := stableswapPool.RampScalingFactors(ctx, scalingFactors, sender, rampDuration); err != nil {
+		return err
+	}
+	if err := k.setPool(ctx, stableswapPool); err != nil {
+		return err
+	}
+
+	targetScalingFactors := stableswapPool.ScalingFactors
+	if stableswapPool.ScalingFactorRamp != nil {
+		targetScalingFactors = stableswapPool.ScalingFactorRamp.TargetScalingFactors
+	}
+	targetScalingFactorStrs := make([]string, len(targetScalingFactors))
+	for i, scalingFactor := range targetScalingFactors {
+		targetScalingFactorStrs[i] = strconv.FormatUint(scalingFactor, 10)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtScalingFactorRampScheduled,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTargetScalingFactors, strings.Join(targetScalingFactorStrs, ",")),
+		sdk.NewAttribute(types.AttributeKeyDuration, rampDuration.String()),
+	))
+	return nil
+}
+
+// adjustStableSwapFees sets the swap and exit fees of a stableswap pool.
+// errors if the pool does not exist or is not a stableswap pool, the sender is not the scaling factor
+// controller, or the fees are invalid.
+func (k Keeper) adjustStableSwapFees(ctx sdk.Context, poolId uint64, swapFee, exitFee sdk.Dec, sender string) error {
+	stableswapPool, err := k.getStableswapPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if stableswapPool.ScalingFactorController == "" || sender != stableswapPool.ScalingFactorController {
+		return types.ErrNotScalingFactorGovernor
+	}
+	return k.setStableSwapFees(ctx, stableswapPool, swapFee, exitFee)
+}
+
+// setStableSwapFees sets the swap and exit fees of the given stableswap pool and stores it.
+func (k Keeper) setStableSwapFees(ctx sdk.Context, stableswapPool *stableswap.Pool, swapFee, exitFee sdk.Dec) error {
+	if err := stableswapPool.SetFees(swapFee, exitFee); err != nil {
+		return err
+	}
+	if err := k.setPool(ctx, stableswapPool); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtStableSwapFeesSet,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(stableswapPool.GetId(), 10)),
+		sdk.NewAttribute(types.AttributeKeySwapFee, swapFee.String()),
+		sdk.NewAttribute(types.AttributeKeyExitFee, exitFee.String()),
+	))
+	return nil
+}
+
+// getBalancerPoolAndPoke returns the balancer pool with the given id after poking it.
+// errors if the pool does not exist or is not a balancer pool.
+func (k Keeper) getBalancerPoolAndPoke(ctx sdk.Context, poolId uint64) (*balancer.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	balancerPool, ok := pool.(*balancer.Pool)
 	if !ok {
-		return fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+		return nil, sdkerrors.Wrapf(types.ErrNotBalancerPool, "pool id %d is of type %T", poolId, pool)
+	}
+	return balancerPool, nil
+}
+
+// scheduleSmoothWeightChange schedules a smooth weight change on a balancer pool, replacing any change in progress.
+// errors if the pool does not exist or is not a balancer pool, the sender is not the pool controller, or
+// the params are invalid for the pool.
+func (k Keeper) scheduleSmoothWeightChange(ctx sdk.Context, poolId uint64, params balancer.SmoothWeightChangeParams, sender string) error {
+	balancerPool, err := k.getBalancerPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
 	}
-	if err := stableswapPool.SetScalingFactors(ctx, scalingFactors, sender); err != nil {
+	if err := balancerPool.ScheduleSmoothWeightChange(params, sender, ctx.BlockTime()); err != nil {
 		return err
 	}
+	if err := k.setPool(ctx, balancerPool); err != nil {
+		return err
+	}
+
+	scheduledParams := balancerPool.PoolParams.SmoothWeightChangeParams
+	targetWeights := make([]string, len(scheduledParams.TargetPoolWeights))
+	for i, asset := range scheduledParams.TargetPoolWeights {
+		targetWeights[i] = asset.Weight.String() + asset.Token.Denom
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSmoothWeightChangeScheduled,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyStartTime, scheduledParams.StartTime.String()),
+		sdk.NewAttribute(types.AttributeKeyDuration, scheduledParams.Duration.String()),
+		sdk.NewAttribute(types.AttributeKeyTargetWeights, strings.Join(targetWeights, ",")),
+	))
+	return nil
+}
 
-	return k.setPool(ctx, stableswapPool)
+// setBalancerSwapsPaused pauses or resumes swaps against a balancer pool.
+// errors if the pool does not exist or is not a balancer pool, or the sender is not the pool controller.
+func (k Keeper) setBalancerSwapsPaused(ctx sdk.Context, poolId uint64, paused bool, sender string) error {
+	balancerPool, err := k.getBalancerPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if err := balancerPool.SetSwapsPaused(paused, sender); err != nil {
+		return err
+	}
+	if err := k.setPool(ctx, balancerPool); err != nil {
+		return err
+	}
+
+	eventType := types.TypeEvtSwapsResumed
+	if paused {
+		eventType = types.TypeEvtSwapsPaused
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+	))
+	return nil
 }
 
 // convertToCFMMPool converts PoolI to CFMMPoolI by casting the input.
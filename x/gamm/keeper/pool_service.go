@@ -290,6 +290,194 @@ func (k Keeper) JoinSwapShareAmountOut(
 	return tokenInAmount, nil
 }
 
+// JoinPoolWithArbitraryTokens is an LP transaction that joins pool #{poolId} with any basket of tokensIn.
+// Tokens that are not pool assets are first swapped into pool assets along their tokenInRoutes.
+// The pool assets are then joined at the pool ratio, and the excess of every pool asset is joined
+// by swapping it through the pool itself, as in a single asset join.
+// Excess that the pool can't join, e.g. too little to mint any shares, remains in the sender account.
+// An error is returned if the amount of LP shares obtained is less than shareOutMinAmount.
+// Otherwise, returns the shares obtained and the pool assets that were joined.
+func (k Keeper) JoinPoolWithArbitraryTokens(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokensIn sdk.Coins,
+	tokenInRoutes []types.TokenInRoutes,
+	shareOutMinAmount sdk.Int,
+) (sharesOut sdk.Int, tokensJoined sdk.Coins, err error) {
+	// defer to catch panics, in case something internal overflows.
+	defer func() {
+		if r := recover(); r != nil {
+			sharesOut = sdk.Int{}
+			tokensJoined = sdk.Coins{}
+			err = fmt.Errorf("function JoinPoolWithArbitraryTokens failed due to internal reason: %v", r)
+		}
+	}()
+
+	pool, err := k.getPoolForSwap(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Coins{}, err
+	}
+
+	// the routes never go through the joined pool, so swapping along them leaves it unchanged.
+	poolAssetsIn, err := k.convertTokensInToPoolAssets(ctx, pool, tokensIn, tokenInRoutes,
+		func(routes []poolmanagertypes.SwapAmountInRoute, tokenIn sdk.Coin) (sdk.Int, error) {
+			return k.poolManager.RouteExactAmountIn(ctx, sender, routes, tokenIn, sdk.OneInt())
+		})
+	if err != nil {
+		return sdk.Int{}, sdk.Coins{}, err
+	}
+
+	sharesOut, tokensJoined, err = joinPoolWithPoolAssets(ctx, pool, poolAssetsIn)
+	switch {
+	case err != nil:
+		return sdk.Int{}, sdk.Coins{}, err
+
+	case sharesOut.LT(shareOutMinAmount):
+		return sdk.Int{}, sdk.Coins{}, sdkerrors.Wrapf(
+			types.ErrLimitMinAmount,
+			"too much slippage; needed a minimum of %s shares to pass, got %s",
+			shareOutMinAmount, sharesOut,
+		)
+
+	case !sharesOut.IsPositive():
+		return sdk.Int{}, sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "share amount is zero or negative")
+	}
+
+	if err := k.applyJoinPoolStateChange(ctx, pool, sender, sharesOut, tokensJoined); err != nil {
+		return sdk.Int{}, sdk.Coins{}, err
+	}
+	return sharesOut, tokensJoined, nil
+}
+
+// CalcJoinPoolWithArbitraryTokens returns the shares that JoinPoolWithArbitraryTokens would obtain
+// and the pool assets it would join, without joining the pool.
+func (k Keeper) CalcJoinPoolWithArbitraryTokens(
+	ctx sdk.Context,
+	poolId uint64,
+	tokensIn sdk.Coins,
+	tokenInRoutes []types.TokenInRoutes,
+) (sharesOut sdk.Int, tokensJoined sdk.Coins, err error) {
+	// defer to catch panics, in case something internal overflows.
+	defer func() {
+		if r := recover(); r != nil {
+			sharesOut = sdk.Int{}
+			tokensJoined = sdk.Coins{}
+			err = fmt.Errorf("function CalcJoinPoolWithArbitraryTokens failed due to internal reason: %v", r)
+		}
+	}()
+
+	if err := types.ValidateTokenInRoutes(tokenInRoutes, tokensIn); err != nil {
+		return sdk.Int{}, sdk.Coins{}, err
+	}
+
+	pool, err := k.getPoolForSwap(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Coins{}, err
+	}
+
+	poolAssetsIn, err := k.convertTokensInToPoolAssets(ctx, pool, tokensIn, tokenInRoutes,
+		func(routes []poolmanagertypes.SwapAmountInRoute, tokenIn sdk.Coin) (sdk.Int, error) {
+			return k.poolManager.MultihopEstimateOutGivenExactAmountIn(ctx, routes, tokenIn)
+		})
+	if err != nil {
+		return sdk.Int{}, sdk.Coins{}, err
+	}
+
+	// the pool is never written to state, so it is safe to join it here.
+	return joinPoolWithPoolAssets(ctx, pool, poolAssetsIn)
+}
+
+// convertTokensInToPoolAssets returns tokensIn, with every token that is not a pool asset replaced by the pool asset
+// that swapFn returns for swapping it along its routes. Returns an error if:
+// * a token that is not a pool asset has no routes
+// * a pool asset has routes
+// * routes go through the pool, or do not end in a pool asset
+func (k Keeper) convertTokensInToPoolAssets(
+	ctx sdk.Context,
+	pool types.CFMMPoolI,
+	tokensIn sdk.Coins,
+	tokenInRoutes []types.TokenInRoutes,
+	swapFn func(routes []poolmanagertypes.SwapAmountInRoute, tokenIn sdk.Coin) (sdk.Int, error),
+) (sdk.Coins, error) {
+	poolLiquidity := pool.GetTotalPoolLiquidity(ctx)
+	routesByDenom := make(map[string][]poolmanagertypes.SwapAmountInRoute, len(tokenInRoutes))
+	for _, tokenInRoute := range tokenInRoutes {
+		routesByDenom[tokenInRoute.TokenInDenom] = tokenInRoute.Routes
+	}
+
+	poolAssetsIn := sdk.NewCoins()
+	for _, tokenIn := range tokensIn {
+		routes, hasRoutes := routesByDenom[tokenIn.Denom]
+		if poolLiquidity.AmountOf(tokenIn.Denom).IsPositive() {
+			if hasRoutes {
+				return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidTokenInRoutes, "token %s is a pool asset and can't be swapped", tokenIn.Denom)
+			}
+			poolAssetsIn = poolAssetsIn.Add(tokenIn)
+			continue
+		}
+
+		if !hasRoutes {
+			return sdk.Coins{}, sdkerrors.Wrapf(types.ErrTokenInRoutesNotFound, "token %s", tokenIn.Denom)
+		}
+		for _, route := range routes {
+			if route.PoolId == pool.GetId() {
+				return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidTokenInRoutes, "routes of token %s go through the joined pool %d", tokenIn.Denom, pool.GetId())
+			}
+		}
+		tokenOutDenom := routes[len(routes)-1].TokenOutDenom
+		if !poolLiquidity.AmountOf(tokenOutDenom).IsPositive() {
+			return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidTokenInRoutes, "routes of token %s end in %s, which is not a pool asset", tokenIn.Denom, tokenOutDenom)
+		}
+
+		tokenOutAmount, err := swapFn(routes, tokenIn)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		poolAssetsIn = poolAssetsIn.Add(sdk.NewCoin(tokenOutDenom, tokenOutAmount))
+	}
+	return poolAssetsIn, nil
+}
+
+// joinPoolWithPoolAssets joins the pool with as many of the given pool assets as it can, and updates the pool,
+// but not the state. It first joins at the pool ratio if all pool assets are given, and then joins the excess
+// of every asset with a single asset join. Excess that can't be joined is skipped.
+// Returns the shares obtained and the assets joined.
+func joinPoolWithPoolAssets(ctx sdk.Context, pool types.CFMMPoolI, poolAssetsIn sdk.Coins) (sharesOut sdk.Int, tokensJoined sdk.Coins, err error) {
+	swapFee := pool.GetSwapFee(ctx)
+	sharesOut = sdk.ZeroInt()
+	tokensJoined = sdk.NewCoins()
+
+	// 1) join as much as possible at the pool ratio, without any swap.
+	if poolAssetsIn.Len() == pool.GetTotalPoolLiquidity(ctx).Len() {
+		_, noSwapTokensJoined, err := pool.CalcJoinPoolNoSwapShares(ctx, poolAssetsIn, swapFee)
+		if err != nil {
+			return sdk.Int{}, sdk.Coins{}, err
+		}
+		noSwapShares, err := pool.JoinPoolNoSwap(ctx, poolAssetsIn, swapFee)
+		if err != nil {
+			return sdk.Int{}, sdk.Coins{}, err
+		}
+		sharesOut = sharesOut.Add(noSwapShares)
+		tokensJoined = tokensJoined.Add(noSwapTokensJoined...)
+	}
+
+	// 2) join the excess of every asset by swapping it through the pool.
+	for _, excess := range poolAssetsIn.Sub(tokensJoined) {
+		excessIn := sdk.NewCoins(excess)
+		if shares, _, err := pool.CalcJoinPoolShares(ctx, excessIn, swapFee); err != nil || !shares.IsPositive() {
+			continue
+		}
+		excessShares, err := pool.JoinPool(ctx, excessIn, swapFee)
+		if err != nil {
+			return sdk.Int{}, sdk.Coins{}, err
+		}
+		sharesOut = sharesOut.Add(excessShares)
+		tokensJoined = tokensJoined.Add(excess)
+	}
+	return sharesOut, tokensJoined, nil
+}
+
 func (k Keeper) ExitPool(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
@@ -303,8 +303,12 @@ func (suite *KeeperTestSuite) TestInitializePool() {
 				sender := testAccount
 				senderBalBeforeNewPool := bankKeeper.GetAllBalances(suite.Ctx, sender)
 
+				// poolmanager sets the pool route before initializing a pool.
+				pool := test.createPool()
+				suite.App.PoolManagerKeeper.SetPoolRoute(suite.Ctx, pool.GetId(), pool.GetType())
+
 				// initializePool with a poolI
-				err := gammKeeper.InitializePool(suite.Ctx, test.createPool(), sender)
+				err := gammKeeper.InitializePool(suite.Ctx, pool, sender)
 
 				if test.expectPass {
 					suite.Require().NoError(err, "test: %v", test.name)
@@ -544,6 +548,149 @@ func (suite *KeeperTestSuite) TestJoinPoolNoSwap() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestJoinPoolWithArbitraryTokens() {
+	var (
+		fooBarPoolId  uint64 = 1
+		bazFooPoolId  uint64 = 2
+		bazOsmoPoolId uint64 = 3
+	)
+	bazToFoo := []types.TokenInRoutes{{
+		TokenInDenom: "baz",
+		Routes:       []poolmanagertypes.SwapAmountInRoute{{PoolId: bazFooPoolId, TokenOutDenom: "foo"}},
+	}}
+
+	tests := []struct {
+		name                 string
+		tokensIn             sdk.Coins
+		tokenInRoutes        []types.TokenInRoutes
+		shareOutMinAmount    sdk.Int
+		expectedSharesOut    sdk.Int
+		expectedTokensJoined sdk.Coins
+		expectedErr          error
+	}{
+		{
+			name:                 "all pool assets at the pool ratio",
+			tokensIn:             sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(5000)), sdk.NewCoin("bar", sdk.NewInt(5000))),
+			shareOutMinAmount:    sdk.OneInt(),
+			expectedSharesOut:    types.OneShare.MulRaw(50),
+			expectedTokensJoined: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(5000)), sdk.NewCoin("bar", sdk.NewInt(5000))),
+		},
+		{
+			name:                 "all pool assets with excess",
+			tokensIn:             sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(6000)), sdk.NewCoin("bar", sdk.NewInt(5000))),
+			shareOutMinAmount:    types.OneShare.MulRaw(50),
+			expectedTokensJoined: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(6000)), sdk.NewCoin("bar", sdk.NewInt(5000))),
+		},
+		{
+			name:                 "single pool asset",
+			tokensIn:             sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(5000))),
+			shareOutMinAmount:    sdk.OneInt(),
+			expectedTokensJoined: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(5000))),
+		},
+		{
+			name:              "pool asset and token swapped into a pool asset",
+			tokensIn:          sdk.NewCoins(sdk.NewCoin("baz", sdk.NewInt(5000)), sdk.NewCoin("bar", sdk.NewInt(5000))),
+			tokenInRoutes:     bazToFoo,
+			shareOutMinAmount: sdk.OneInt(),
+		},
+		{
+			name:              "token without routes",
+			tokensIn:          sdk.NewCoins(sdk.NewCoin("baz", sdk.NewInt(5000))),
+			shareOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrTokenInRoutesNotFound,
+		},
+		{
+			name:     "routes through the joined pool",
+			tokensIn: sdk.NewCoins(sdk.NewCoin("baz", sdk.NewInt(5000))),
+			tokenInRoutes: []types.TokenInRoutes{{
+				TokenInDenom: "baz",
+				Routes: []poolmanagertypes.SwapAmountInRoute{
+					{PoolId: bazFooPoolId, TokenOutDenom: "foo"},
+					{PoolId: fooBarPoolId, TokenOutDenom: "bar"},
+				},
+			}},
+			shareOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrInvalidTokenInRoutes,
+		},
+		{
+			name:     "routes ending in a token that is not a pool asset",
+			tokensIn: sdk.NewCoins(sdk.NewCoin("baz", sdk.NewInt(5000))),
+			tokenInRoutes: []types.TokenInRoutes{{
+				TokenInDenom: "baz",
+				Routes:       []poolmanagertypes.SwapAmountInRoute{{PoolId: bazOsmoPoolId, TokenOutDenom: "uosmo"}},
+			}},
+			shareOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrInvalidTokenInRoutes,
+		},
+		{
+			name:     "routes for a pool asset",
+			tokensIn: sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(5000))),
+			tokenInRoutes: []types.TokenInRoutes{{
+				TokenInDenom: "bar",
+				Routes:       []poolmanagertypes.SwapAmountInRoute{{PoolId: fooBarPoolId, TokenOutDenom: "foo"}},
+			}},
+			shareOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrInvalidTokenInRoutes,
+		},
+		{
+			name:              "too much slippage",
+			tokensIn:          sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(5000)), sdk.NewCoin("bar", sdk.NewInt(5000))),
+			shareOutMinAmount: types.OneShare.MulRaw(51),
+			expectedErr:       types.ErrLimitMinAmount,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			gammKeeper := suite.App.GAMMKeeper
+			bankKeeper := suite.App.BankKeeper
+			sender := suite.TestAccs[1]
+
+			suite.Require().Equal(fooBarPoolId, suite.PrepareCustomBalancerPoolFromCoins(
+				sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(10000)), sdk.NewCoin("bar", sdk.NewInt(10000))), defaultPoolParams))
+			suite.Require().Equal(bazFooPoolId, suite.PrepareCustomBalancerPoolFromCoins(
+				sdk.NewCoins(sdk.NewCoin("baz", sdk.NewInt(100000)), sdk.NewCoin("foo", sdk.NewInt(100000))), defaultPoolParams))
+			suite.Require().Equal(bazOsmoPoolId, suite.PrepareCustomBalancerPoolFromCoins(
+				sdk.NewCoins(sdk.NewCoin("baz", sdk.NewInt(100000)), sdk.NewCoin("uosmo", sdk.NewInt(100000))), defaultPoolParams))
+			suite.FundAcc(sender, tc.tokensIn)
+
+			estimatedSharesOut, estimatedTokensJoined, estimateErr := gammKeeper.CalcJoinPoolWithArbitraryTokens(suite.Ctx, fooBarPoolId, tc.tokensIn, tc.tokenInRoutes)
+			sharesOut, tokensJoined, err := gammKeeper.JoinPoolWithArbitraryTokens(suite.Ctx, sender, fooBarPoolId, tc.tokensIn, tc.tokenInRoutes, tc.shareOutMinAmount)
+
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				suite.Require().True(bankKeeper.GetBalance(suite.Ctx, sender, types.GetPoolShareDenom(fooBarPoolId)).IsZero())
+				suite.Require().Equal(tc.tokensIn, bankKeeper.GetAllBalances(suite.Ctx, sender))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(sharesOut.GTE(tc.shareOutMinAmount))
+			if !tc.expectedSharesOut.IsNil() {
+				suite.Require().Equal(tc.expectedSharesOut, sharesOut)
+			}
+			if tc.expectedTokensJoined != nil {
+				suite.Require().Equal(tc.expectedTokensJoined, tokensJoined)
+			}
+
+			// the estimate matches the join.
+			suite.Require().NoError(estimateErr)
+			suite.Require().Equal(sharesOut, estimatedSharesOut)
+			suite.Require().Equal(tokensJoined, estimatedTokensJoined)
+
+			// the sender received the shares, and the pool the joined tokens.
+			suite.Require().Equal(sharesOut, bankKeeper.GetBalance(suite.Ctx, sender, types.GetPoolShareDenom(fooBarPoolId)).Amount)
+			pool, err := gammKeeper.GetPoolAndPoke(suite.Ctx, fooBarPoolId)
+			suite.Require().NoError(err)
+			expectedLiquidity := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(10000)), sdk.NewCoin("bar", sdk.NewInt(10000))).Add(tokensJoined...)
+			suite.Require().Equal(expectedLiquidity, pool.GetTotalPoolLiquidity(suite.Ctx))
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtPoolJoined, 1)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestExitPool() {
 	fiveKFooAndBar := sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(5000)), sdk.NewCoin("foo", sdk.NewInt(5000)))
 	tests := []struct {
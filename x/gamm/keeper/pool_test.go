@@ -528,3 +528,278 @@ func (suite *KeeperTestSuite) TestSetStableSwapScalingFactors() {
 	}
 
 }
+
+func (suite *KeeperTestSuite) TestScheduleSmoothWeightChange() {
+	controllerAddr := suite.TestAccs[0]
+	failAddr := suite.TestAccs[1]
+	targetWeights := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(300), Token: sdk.NewCoin("foo", sdk.ZeroInt())},
+		{Weight: sdk.NewInt(100), Token: sdk.NewCoin("bar", sdk.ZeroInt())},
+	}
+
+	testcases := []struct {
+		name             string
+		poolId           uint64
+		sender           sdk.AccAddress
+		expError         error
+		isStableSwapPool bool
+	}{
+		{
+			name:     "Error: Pool does not exist",
+			poolId:   2,
+			sender:   controllerAddr,
+			expError: types.PoolDoesNotExistError{PoolId: defaultPoolId + 1},
+		},
+		{
+			name:             "Error: Pool id is not of type balancer pool",
+			poolId:           1,
+			sender:           controllerAddr,
+			expError:         types.ErrNotBalancerPool,
+			isStableSwapPool: true,
+		},
+		{
+			name:     "Error: Sender is not the pool controller",
+			poolId:   1,
+			sender:   failAddr,
+			expError: types.ErrNotPoolController,
+		},
+		{
+			name:   "Valid case",
+			poolId: 1,
+			sender: controllerAddr,
+		},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			if tc.isStableSwapPool {
+				suite.prepareCustomStableswapPool(
+					defaultAcctFunds,
+					stableswap.PoolParams{
+						SwapFee: defaultSwapFee,
+						ExitFee: defaultExitFee,
+					},
+					sdk.NewCoins(sdk.NewCoin(defaultAcctFunds[0].Denom, defaultAcctFunds[0].Amount.QuoRaw(2)), sdk.NewCoin(defaultAcctFunds[1].Denom, defaultAcctFunds[1].Amount.QuoRaw(2))),
+					[]uint64{1, 1},
+				)
+			} else {
+				suite.prepareControlledBalancerPool(controllerAddr)
+			}
+			params := balancer.SmoothWeightChangeParams{Duration: time.Hour, TargetPoolWeights: targetWeights}
+
+			err := suite.App.GAMMKeeper.ScheduleSmoothWeightChange(suite.Ctx, tc.poolId, params, tc.sender.String())
+			if tc.expError != nil {
+				suite.Require().ErrorContains(err, tc.expError.Error())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSmoothWeightChangeScheduled, 1)
+
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, tc.poolId)
+			suite.Require().NoError(err)
+			scheduledParams := pool.(*balancer.Pool).PoolParams.SmoothWeightChangeParams
+			suite.Require().NotNil(scheduledParams)
+			suite.Require().Equal(time.Hour, scheduledParams.Duration)
+			suite.Require().Equal(suite.Ctx.BlockTime().Unix(), scheduledParams.StartTime.Unix())
+
+			// weights reach the target once the change is over.
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour + time.Second))
+			pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, tc.poolId)
+			suite.Require().NoError(err)
+			fooAsset, err := pool.(*balancer.Pool).GetPoolAsset("foo")
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(300).MulRaw(balancer.GuaranteedWeightPrecision), fooAsset.Weight)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetBalancerSwapsPaused() {
+	controllerAddr := suite.TestAccs[0]
+	failAddr := suite.TestAccs[1]
+	suite.SetupTest()
+	poolId := suite.prepareControlledBalancerPool(controllerAddr)
+
+	swap := func() error {
+		_, err := suite.App.PoolManagerKeeper.RouteExactAmountIn(suite.Ctx, suite.TestAccs[0],
+			[]poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "bar"}},
+			sdk.NewCoin("foo", sdk.NewInt(100)), sdk.OneInt())
+		return err
+	}
+
+	err := suite.App.GAMMKeeper.SetBalancerSwapsPaused(suite.Ctx, poolId, true, failAddr.String())
+	suite.Require().ErrorIs(err, types.ErrNotPoolController)
+	suite.Require().NoError(swap())
+
+	err = suite.App.GAMMKeeper.SetBalancerSwapsPaused(suite.Ctx, poolId, true, controllerAddr.String())
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSwapsPaused, 1)
+	suite.Require().Error(swap())
+
+	// joins are still allowed while swaps are paused.
+	_, _, err = suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[1], poolId, types.OneShare.MulRaw(10), sdk.Coins{})
+	suite.Require().NoError(err)
+
+	err = suite.App.GAMMKeeper.SetBalancerSwapsPaused(suite.Ctx, poolId, false, controllerAddr.String())
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSwapsResumed, 1)
+	suite.Require().NoError(swap())
+}
+
+// prepareControlledBalancerPool creates a balancer pool with the default assets that is controlled by the given address.
+func (suite *KeeperTestSuite) prepareControlledBalancerPool(controller sdk.AccAddress) uint64 {
+	suite.fundAllAccountsWith(defaultAcctFunds)
+
+	msg := balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], defaultPoolParams, defaultPoolAssets, "")
+	msg.PoolController = controller.String()
+	poolId, err := suite.App.PoolManagerKeeper.CreatePool(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	return poolId
+}
+
+func (suite *KeeperTestSuite) TestSetStableSwapScalingFactorsRamp() {
+	controllerAddr := suite.TestAccs[0]
+
+	testcases := map[string]struct {
+		rampDuration                  time.Duration
+		expectedScalingFactorsAtStart []uint64
+		expectedScalingFactorsHalfway []uint64
+	}{
+		"zero ramp duration sets scaling factors immediately": {
+			rampDuration:                  0,
+			expectedScalingFactorsAtStart: []uint64{3, 1},
+			expectedScalingFactorsHalfway: []uint64{3, 1},
+		},
+		"scaling factors ramp linearly over the ramp duration": {
+			rampDuration:                  time.Hour,
+			expectedScalingFactorsAtStart: []uint64{1, 1},
+			expectedScalingFactorsHalfway: []uint64{2, 1},
+		},
+	}
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			params := suite.App.GAMMKeeper.GetParams(suite.Ctx)
+			params.ScalingFactorRampDuration = tc.rampDuration
+			suite.App.GAMMKeeper.SetParams(suite.Ctx, params)
+			poolId := suite.prepareControlledStableswapPool(controllerAddr)
+
+			getScalingFactors := func(ctx sdk.Context) []uint64 {
+				pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
+				suite.Require().NoError(err)
+				return pool.(*stableswap.Pool).GetScalingFactors()
+			}
+
+			err := suite.App.GAMMKeeper.SetStableSwapScalingFactors(suite.Ctx, poolId, []uint64{3, 1}, controllerAddr.String())
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtScalingFactorRampScheduled, 1)
+
+			suite.Require().Equal(tc.expectedScalingFactorsAtStart, getScalingFactors(suite.Ctx))
+			suite.Require().Equal(tc.expectedScalingFactorsHalfway, getScalingFactors(suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(30*time.Minute))))
+			suite.Require().Equal([]uint64{3, 1}, getScalingFactors(suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAdjustStableSwapFees() {
+	controllerAddr := suite.TestAccs[0]
+	failAddr := suite.TestAccs[1]
+	validSwapFee := sdk.MustNewDecFromStr("0.003")
+	validExitFee := sdk.MustNewDecFromStr("0.001")
+
+	testcases := map[string]struct {
+		sender           sdk.AccAddress
+		swapFee          sdk.Dec
+		exitFee          sdk.Dec
+		isStableSwapPool bool
+		expError         bool
+	}{
+		"valid case": {
+			sender:           controllerAddr,
+			swapFee:          validSwapFee,
+			exitFee:          validExitFee,
+			isStableSwapPool: true,
+		},
+		"sender is not scaling factor controller": {
+			sender:           failAddr,
+			swapFee:          validSwapFee,
+			exitFee:          validExitFee,
+			isStableSwapPool: true,
+			expError:         true,
+		},
+		"invalid swap fee": {
+			sender:           controllerAddr,
+			swapFee:          sdk.OneDec(),
+			exitFee:          validExitFee,
+			isStableSwapPool: true,
+			expError:         true,
+		},
+		"pool is not a stableswap pool": {
+			sender:   controllerAddr,
+			swapFee:  validSwapFee,
+			exitFee:  validExitFee,
+			expError: true,
+		},
+	}
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			var poolId uint64
+			if tc.isStableSwapPool {
+				poolId = suite.prepareControlledStableswapPool(controllerAddr)
+			} else {
+				poolId = suite.prepareControlledBalancerPool(controllerAddr)
+			}
+
+			err := suite.App.GAMMKeeper.AdjustStableSwapFees(suite.Ctx, poolId, tc.swapFee, tc.exitFee, tc.sender.String())
+			if tc.expError {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtStableSwapFeesSet, 1)
+
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.swapFee, pool.GetSwapFee(suite.Ctx))
+			suite.Require().Equal(tc.exitFee, pool.GetExitFee(suite.Ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleSetStableSwapFeesProposal() {
+	suite.SetupTest()
+	// governance can set fees on pools without a scaling factor controller.
+	poolId := suite.prepareControlledStableswapPool(sdk.AccAddress{})
+	swapFee := sdk.MustNewDecFromStr("0.003")
+	exitFee := sdk.ZeroDec()
+
+	proposal := types.NewSetStableSwapFeesProposal("title", "description", poolId, swapFee, exitFee).(*types.SetStableSwapFeesProposal)
+	err := suite.App.GAMMKeeper.HandleSetStableSwapFeesProposal(suite.Ctx, proposal)
+	suite.Require().NoError(err)
+
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(swapFee, pool.GetSwapFee(suite.Ctx))
+	suite.Require().Equal(exitFee, pool.GetExitFee(suite.Ctx))
+
+	proposal.SwapFee = sdk.OneDec()
+	err = suite.App.GAMMKeeper.HandleSetStableSwapFeesProposal(suite.Ctx, proposal)
+	suite.Require().Error(err)
+
+	proposal.PoolId = poolId + 1
+	proposal.SwapFee = swapFee
+	err = suite.App.GAMMKeeper.HandleSetStableSwapFeesProposal(suite.Ctx, proposal)
+	suite.Require().Error(err)
+}
+
+// prepareControlledStableswapPool creates a two asset stableswap pool whose scaling factors are controlled by the given address.
+func (suite *KeeperTestSuite) prepareControlledStableswapPool(controller sdk.AccAddress) uint64 {
+	suite.fundAllAccountsWith(defaultAcctFunds)
+
+	initialLiquidity := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(5000000)), sdk.NewCoin("bar", sdk.NewInt(5000000)))
+	msg := stableswap.NewMsgCreateStableswapPool(suite.TestAccs[0], defaultPoolParamsStableSwap, initialLiquidity, []uint64{1, 1}, "")
+	msg.ScalingFactorController = controller.String()
+	poolId, err := suite.App.PoolManagerKeeper.CreatePool(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	return poolId
+}
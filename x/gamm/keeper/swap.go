@@ -7,11 +7,20 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v14/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v14/x/poolmanager/events"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
 )
 
+var (
+	// priceLimitErrTolerance bounds how far from the price limit a price-limited swap may stop.
+	// Rounding down on the negated spot price guarantees that the limit is never crossed.
+	priceLimitErrTolerance = osmomath.ErrTolerance{AdditiveTolerance: sdk.Dec{}, MultiplicativeTolerance: sdk.NewDecWithPrec(1, 4), RoundingDir: osmomath.RoundDown}
+	// should be guaranteed to converge if above 256 since sdk.Int has 256 bits
+	priceLimitMaxIterations = 300
+)
+
 // swapExactAmountIn is an internal method for swapping an exact amount of tokens
 // as input to a pool, using the provided swapFee. This is intended to allow
 // different swap fees as determined by multi-hops, or when recovering from
@@ -161,6 +170,292 @@ func (k Keeper) CalcInAmtGivenOut(
 	return cfmmPool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), tokenInDenom, swapFee)
 }
 
+// SimulateSwapExactAmountIn applies swapping tokenIn through the pool to the pool's assets without
+// transferring any tokens, and returns the resulting tokenOut. It is only meant to be called on a
+// cache context that is never written.
+func (k Keeper) SimulateSwapExactAmountIn(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+) (tokenOut sdk.Coin, err error) {
+	cfmmPool, err := convertToCFMMPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenOut, err = cfmmPool.SwapOutAmtGivenIn(ctx, sdk.NewCoins(tokenIn), tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.setPool(ctx, cfmmPool); err != nil {
+		return sdk.Coin{}, err
+	}
+	return tokenOut, nil
+}
+
+// SimulateSwapExactAmountOut applies swapping for tokenOut through the pool to the pool's assets without
+// transferring any tokens, and returns the required tokenIn. It is only meant to be called on a
+// cache context that is never written.
+func (k Keeper) SimulateSwapExactAmountOut(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (tokenIn sdk.Coin, err error) {
+	cfmmPool, err := convertToCFMMPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenIn, err = cfmmPool.SwapInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.setPool(ctx, cfmmPool); err != nil {
+		return sdk.Coin{}, err
+	}
+	return tokenIn, nil
+}
+
+// SwapExactAmountInWithPriceLimit swaps up to tokenIn through the pool, stopping early once the
+// spot price of tokenIn quoted in tokenOutDenom falls to priceLimit. Since balancer and stableswap
+// pools have no native notion of a price limit, the largest amount of tokenIn that respects the
+// limit is found by binary searching over the post-swap spot price. That amount is then swapped
+// with SwapExactAmountIn. Returns the amounts of tokenIn swapped and tokenOut received.
+func (k Keeper) SwapExactAmountInWithPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
+	priceLimit sdk.Dec,
+) (tokenInAmount, tokenOutAmount sdk.Int, err error) {
+	tokenInUsed, _, err := k.CalcOutAmtGivenInWithPriceLimit(ctx, pool, tokenIn, tokenOutDenom, swapFee, priceLimit)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	tokenOutAmount, err = k.SwapExactAmountIn(ctx, sender, pool, tokenInUsed, tokenOutDenom, tokenOutMinAmount, swapFee)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	return tokenInUsed.Amount, tokenOutAmount, nil
+}
+
+// CalcOutAmtGivenInWithPriceLimit calculates how much of tokenIn can be swapped before the spot price
+// of tokenIn quoted in tokenOutDenom falls to priceLimit, along with the resulting tokenOut.
+// If swapping all of tokenIn does not reach the limit, all of tokenIn is used.
+// Returns error if the given pool is not a CFMM pool, if the price limit is not below the current
+// spot price, or if the binary search does not converge.
+func (k Keeper) CalcOutAmtGivenInWithPriceLimit(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+	priceLimit sdk.Dec,
+) (tokenInUsed, tokenOut sdk.Coin, err error) {
+	if tokenIn.Denom == tokenOutDenom {
+		return sdk.Coin{}, sdk.Coin{}, errors.New("cannot trade same denomination in and out")
+	}
+	if priceLimit.IsNil() || !priceLimit.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidPriceLimit, "got %s", priceLimit)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tokenInUsed, tokenOut = sdk.Coin{}, sdk.Coin{}
+			err = fmt.Errorf("function CalcOutAmtGivenInWithPriceLimit failed due to internal reason: %v", r)
+		}
+	}()
+
+	cfmmPool, err := convertToCFMMPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// The pool is serialized once so that every step of the search below
+	// can swap against a fresh copy without touching the store.
+	poolBz, err := k.MarshalPool(cfmmPool)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// Returns the spot price of tokenIn quoted in tokenOutDenom after swapping amountIn.
+	// This is monotonically decreasing in amountIn.
+	spotPriceAfterSwap := func(amountIn sdk.Int) (sdk.Dec, error) {
+		poolCopy, err := k.UnmarshalPool(poolBz)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		if amountIn.IsPositive() {
+			if _, err := poolCopy.SwapOutAmtGivenIn(ctx, sdk.NewCoins(sdk.NewCoin(tokenIn.Denom, amountIn)), tokenOutDenom, swapFee); err != nil {
+				return sdk.Dec{}, err
+			}
+		}
+		return poolCopy.SpotPrice(ctx, tokenOutDenom, tokenIn.Denom)
+	}
+
+	tokenInAmount, err := searchAmountUpToPriceLimit(spotPriceAfterSwap, tokenIn.Amount, priceLimit)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	tokenInUsed = sdk.NewCoin(tokenIn.Denom, tokenInAmount)
+	tokenOut, err = cfmmPool.CalcOutAmtGivenIn(ctx, sdk.NewCoins(tokenInUsed), tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	return tokenInUsed, tokenOut, nil
+}
+
+// SwapExactAmountOutWithPriceLimit swaps for up to tokenOut through the pool, stopping early once the
+// spot price of tokenInDenom quoted in tokenOut's denom falls to priceLimit. The largest amount of
+// tokenOut that respects the limit is found by binary searching over the post-swap spot price.
+// That amount is then swapped for with SwapExactAmountOut. Returns the amounts of tokenIn paid and
+// tokenOut received.
+func (k Keeper) SwapExactAmountOutWithPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool poolmanagertypes.PoolI,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
+	priceLimit sdk.Dec,
+) (tokenInAmount, tokenOutAmount sdk.Int, err error) {
+	_, tokenOutUsed, err := k.CalcInAmtGivenOutWithPriceLimit(ctx, pool, tokenOut, tokenInDenom, swapFee, priceLimit)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	tokenInAmount, err = k.SwapExactAmountOut(ctx, sender, pool, tokenInDenom, tokenInMaxAmount, tokenOutUsed, swapFee)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	return tokenInAmount, tokenOutUsed.Amount, nil
+}
+
+// CalcInAmtGivenOutWithPriceLimit calculates how much of tokenOut can be swapped for before the spot price
+// of tokenInDenom quoted in tokenOut's denom falls to priceLimit, along with the tokenIn required.
+// If swapping for all of tokenOut does not reach the limit, all of tokenOut is used.
+// Returns error if the given pool is not a CFMM pool, if the price limit is not below the current
+// spot price, or if the binary search does not converge.
+func (k Keeper) CalcInAmtGivenOutWithPriceLimit(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+	priceLimit sdk.Dec,
+) (tokenIn, tokenOutUsed sdk.Coin, err error) {
+	if tokenOut.Denom == tokenInDenom {
+		return sdk.Coin{}, sdk.Coin{}, errors.New("cannot trade same denomination in and out")
+	}
+	if priceLimit.IsNil() || !priceLimit.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidPriceLimit, "got %s", priceLimit)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tokenIn, tokenOutUsed = sdk.Coin{}, sdk.Coin{}
+			err = fmt.Errorf("function CalcInAmtGivenOutWithPriceLimit failed due to internal reason: %v", r)
+		}
+	}()
+
+	cfmmPool, err := convertToCFMMPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	poolBz, err := k.MarshalPool(cfmmPool)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// Returns the spot price of tokenInDenom quoted in tokenOut's denom after swapping for amountOut.
+	// This is monotonically decreasing in amountOut.
+	spotPriceAfterSwap := func(amountOut sdk.Int) (sdk.Dec, error) {
+		poolCopy, err := k.UnmarshalPool(poolBz)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		if amountOut.IsPositive() {
+			if _, err := poolCopy.SwapInAmtGivenOut(ctx, sdk.NewCoins(sdk.NewCoin(tokenOut.Denom, amountOut)), tokenInDenom, swapFee); err != nil {
+				return sdk.Dec{}, err
+			}
+		}
+		return poolCopy.SpotPrice(ctx, tokenOut.Denom, tokenInDenom)
+	}
+
+	tokenOutAmount, err := searchAmountUpToPriceLimit(spotPriceAfterSwap, tokenOut.Amount, priceLimit)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	tokenOutUsed = sdk.NewCoin(tokenOut.Denom, tokenOutAmount)
+	tokenIn, err = cfmmPool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOutUsed), tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	return tokenIn, tokenOutUsed, nil
+}
+
+// searchAmountUpToPriceLimit returns the largest swapped amount in [0, maxAmount] whose post-swap spot price,
+// as given by the monotonically decreasing spotPriceAfterSwap, does not fall below priceLimit.
+// The swapped amount is the amount in for exact amount in swaps and the amount out for exact amount out swaps.
+// The result is found with osmomath.BinarySearch, and is accurate up to priceLimitErrTolerance.
+func searchAmountUpToPriceLimit(spotPriceAfterSwap func(sdk.Int) (sdk.Dec, error), maxAmount sdk.Int, priceLimit sdk.Dec) (sdk.Int, error) {
+	spotPriceBefore, err := spotPriceAfterSwap(sdk.ZeroInt())
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if priceLimit.GTE(spotPriceBefore) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidPriceLimit, "price limit (%s), current spot price (%s)", priceLimit, spotPriceBefore)
+	}
+
+	// If swapping everything does not cross the limit, there is nothing to search for.
+	spotPriceAfterFullSwap, err := spotPriceAfterSwap(maxAmount)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if spotPriceAfterFullSwap.GTE(priceLimit) {
+		return maxAmount, nil
+	}
+
+	// osmomath.BinarySearch expects a monotonically increasing function over sdk.Int,
+	// so we search over the negated spot price in its raw 18 decimal representation.
+	negatedSpotPriceAfterSwap := func(amount sdk.Int) (sdk.Int, error) {
+		spotPrice, err := spotPriceAfterSwap(amount)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		return sdk.NewIntFromBigInt(spotPrice.BigInt()).Neg(), nil
+	}
+	targetOutput := sdk.NewIntFromBigInt(priceLimit.BigInt()).Neg()
+
+	amount, err := osmomath.BinarySearch(negatedSpotPriceAfterSwap, sdk.ZeroInt(), maxAmount, targetOutput, priceLimitErrTolerance, priceLimitMaxIterations)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if !amount.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidPriceLimit, "no amount can be swapped before reaching price limit (%s)", priceLimit)
+	}
+
+	return amount, nil
+}
+
 // updatePoolForSwap takes a pool, sender, and tokenIn, tokenOut amounts
 // It then updates the pool's balances to the new reserve amounts, and
 // sends the in tokens from the sender to the pool, and the out tokens from the pool to the sender.
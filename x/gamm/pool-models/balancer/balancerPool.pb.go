@@ -252,6 +252,11 @@ type Pool struct {
 	PoolAssets []PoolAsset `protobuf:"bytes,6,rep,name=pool_assets,json=poolAssets,proto3" json:"pool_assets" yaml:"pool_assets"`
 	// sum of all non-normalized pool weights
 	TotalWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_weight" yaml:"total_weight"`
+	// pool_controller is the address that can schedule smooth weight changes
+	// and pause swaps of the pool
+	PoolController string `protobuf:"bytes,8,opt,name=pool_controller,json=poolController,proto3" json:"pool_controller,omitempty" yaml:"pool_controller"`
+	// swaps_paused shows if swaps against the pool are paused by its controller
+	SwapsPaused bool `protobuf:"varint,9,opt,name=swaps_paused,json=swapsPaused,proto3" json:"swaps_paused,omitempty" yaml:"swaps_paused"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -298,59 +303,63 @@ func init() {
 }
 
 var fileDescriptor_7e991f749f68c2a4 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x4f, 0xe3, 0x46,
-	0x14, 0x8f, 0x93, 0x40, 0x96, 0xc9, 0x76, 0x2b, 0x66, 0x73, 0x30, 0x41, 0x8d, 0xd1, 0x54, 0xaa,
-	0x56, 0xd5, 0xc6, 0x56, 0xb6, 0x7b, 0xda, 0xcb, 0x6a, 0x03, 0xb4, 0xe2, 0x46, 0x4d, 0x25, 0x4a,
-	0x85, 0x64, 0x4d, 0x92, 0x89, 0x6d, 0x61, 0x7b, 0x2c, 0xcf, 0x24, 0xc0, 0x37, 0xe8, 0x91, 0x23,
-	0xbd, 0x71, 0xef, 0xb5, 0xfd, 0x0e, 0xa8, 0xbd, 0x70, 0xac, 0x7a, 0x70, 0x2b, 0xe8, 0xa9, 0xc7,
-	0x7c, 0x82, 0x6a, 0xfe, 0x38, 0x09, 0x34, 0x51, 0x41, 0x3d, 0x65, 0xde, 0x9b, 0xf7, 0x7e, 0xef,
-	0xf7, 0xde, 0xfb, 0x8d, 0x03, 0xde, 0x52, 0x16, 0x53, 0x16, 0x32, 0xc7, 0xc7, 0x71, 0xec, 0xa4,
-	0x94, 0x46, 0xed, 0x98, 0x0e, 0x48, 0xc4, 0x9c, 0x1e, 0x8e, 0x70, 0xd2, 0x27, 0xd9, 0xf4, 0xb0,
-	0x4f, 0x69, 0x64, 0xa7, 0x19, 0xe5, 0x14, 0x36, 0x74, 0x96, 0x2d, 0xb2, 0xec, 0x71, 0xa7, 0x47,
-	0x38, 0xee, 0x34, 0x37, 0xfa, 0xd2, 0xed, 0xc9, 0x18, 0x47, 0x19, 0x2a, 0xa1, 0xd9, 0xf0, 0xa9,
-	0x4f, 0x95, 0x5f, 0x9c, 0xb4, 0xb7, 0xe5, 0x53, 0xea, 0x47, 0xc4, 0x91, 0x56, 0x6f, 0x34, 0x74,
-	0x06, 0xa3, 0x0c, 0xf3, 0x90, 0x26, 0xfa, 0xde, 0x7a, 0x78, 0xcf, 0xc3, 0x98, 0x30, 0x8e, 0xe3,
-	0xb4, 0x00, 0x50, 0x45, 0x1c, 0x3c, 0xe2, 0x81, 0xa3, 0x69, 0x48, 0xe3, 0xc1, 0x7d, 0x0f, 0x33,
-	0x32, 0xbd, 0xef, 0xd3, 0x50, 0x17, 0x40, 0xbf, 0x56, 0x80, 0x79, 0x10, 0x53, 0xca, 0x83, 0x43,
-	0x12, 0xfa, 0x01, 0xdf, 0x0e, 0x70, 0xe2, 0x93, 0x7d, 0x9c, 0xe1, 0x98, 0xc1, 0x6f, 0x01, 0x60,
-	0x1c, 0x67, 0xdc, 0x13, 0x55, 0x4d, 0x63, 0xcb, 0x78, 0x55, 0x7f, 0xd3, 0xb4, 0x15, 0x25, 0xbb,
-	0xa0, 0x64, 0x7f, 0x53, 0x50, 0xea, 0x7e, 0x72, 0x9d, 0x5b, 0xa5, 0x49, 0x6e, 0xad, 0x9f, 0xe3,
-	0x38, 0x7a, 0x87, 0x66, 0xb9, 0xe8, 0xe2, 0x0f, 0xcb, 0x70, 0xd7, 0xa4, 0x43, 0x84, 0xc3, 0x00,
-	0x3c, 0x2b, 0x3a, 0x35, 0xcb, 0x12, 0x77, 0xe3, 0x5f, 0xb8, 0x3b, 0x3a, 0xa0, 0xdb, 0x11, 0xb0,
-	0x7f, 0xe7, 0x16, 0x2c, 0x52, 0x5e, 0xd3, 0x38, 0xe4, 0x24, 0x4e, 0xf9, 0xf9, 0x24, 0xb7, 0x3e,
-	0x56, 0xc5, 0x8a, 0x3b, 0x74, 0x29, 0x4a, 0x4d, 0xd1, 0xe1, 0x18, 0x34, 0xc2, 0x24, 0xe4, 0x21,
-	0x8e, 0x3c, 0xb1, 0x5b, 0xef, 0x54, 0xb6, 0xc9, 0xcc, 0xca, 0x56, 0xe5, 0x55, 0xfd, 0x8d, 0x65,
-	0x2f, 0xda, 0xa3, 0x2d, 0x16, 0xfd, 0x81, 0x31, 0xc2, 0xbb, 0x9f, 0xea, 0x96, 0x36, 0x55, 0x95,
-	0x45, 0x50, 0xc8, 0x85, 0xda, 0x2d, 0xd2, 0xd4, 0x18, 0x19, 0x64, 0xe0, 0x25, 0xc7, 0x99, 0x4f,
-	0xf8, 0xfd, 0xb2, 0xd5, 0xc7, 0x95, 0x45, 0xba, 0x6c, 0x53, 0x95, 0x5d, 0x80, 0x84, 0xdc, 0x75,
-	0xe5, 0x9d, 0x2b, 0x8a, 0xfe, 0x2a, 0x03, 0x20, 0x6c, 0xbd, 0xbf, 0x63, 0xf0, 0x8c, 0x9d, 0xe2,
-	0xd4, 0x1b, 0x12, 0xb5, 0xbd, 0xb5, 0xee, 0x07, 0x81, 0xfb, 0x7b, 0x6e, 0x7d, 0xe6, 0x87, 0x3c,
-	0x18, 0xf5, 0xec, 0x3e, 0x8d, 0xb5, 0x4c, 0xf5, 0x4f, 0x9b, 0x0d, 0x4e, 0x1c, 0x7e, 0x9e, 0x12,
-	0x66, 0xef, 0x90, 0xfe, 0x6c, 0xbc, 0x05, 0x0e, 0x72, 0x6b, 0xe2, 0xf8, 0x25, 0x21, 0x02, 0x9d,
-	0x9c, 0x85, 0x5c, 0xa2, 0x97, 0xff, 0x1f, 0x7a, 0x81, 0x83, 0xdc, 0x9a, 0x38, 0x0a, 0xf4, 0x1f,
-	0x0c, 0xb0, 0xc9, 0xa4, 0x30, 0x75, 0xc7, 0x5e, 0x5f, 0x4a, 0xd3, 0x4b, 0x65, 0x6f, 0x66, 0x45,
-	0xaa, 0xc6, 0x5e, 0x3c, 0xc8, 0x65, 0x8a, 0xee, 0x7e, 0x7e, 0x9d, 0x5b, 0xc6, 0x24, 0xb7, 0x90,
-	0xee, 0x6a, 0x79, 0x01, 0xe4, 0x9a, 0x6c, 0x09, 0x0a, 0xfa, 0xd1, 0x00, 0x6b, 0xd3, 0x5d, 0xc1,
-	0x5d, 0xb0, 0xc2, 0xe9, 0x09, 0x49, 0xf4, 0x03, 0xd9, 0xb0, 0xf5, 0xbb, 0x17, 0x4f, 0x6e, 0xca,
-	0x68, 0x9b, 0x86, 0x49, 0xb7, 0xa1, 0xb7, 0xfa, 0x5c, 0x6f, 0x55, 0x64, 0x21, 0x57, 0x65, 0xc3,
-	0x43, 0xb0, 0xaa, 0x78, 0xe8, 0x61, 0xbe, 0x7f, 0xc2, 0x30, 0xf7, 0x12, 0x3e, 0xc9, 0xad, 0x8f,
-	0x14, 0xac, 0x42, 0x41, 0xae, 0x86, 0x43, 0x3f, 0x57, 0x41, 0x55, 0xb0, 0x85, 0xaf, 0x41, 0x0d,
-	0x0f, 0x06, 0x19, 0x61, 0x4c, 0xab, 0x01, 0x4e, 0x72, 0xeb, 0x85, 0x4a, 0xd2, 0x17, 0xc8, 0x2d,
-	0x42, 0xe0, 0x0b, 0x50, 0x0e, 0x07, 0x92, 0x4b, 0xd5, 0x2d, 0x87, 0x03, 0x38, 0x04, 0x75, 0xa9,
-	0xbf, 0x7b, 0xf3, 0xdf, 0x5a, 0x2e, 0x64, 0x3d, 0xf1, 0x07, 0x0f, 0xa8, 0xf8, 0x94, 0x7a, 0x73,
-	0x58, 0xc8, 0x05, 0xe9, 0x4c, 0xb4, 0x5f, 0x83, 0xc6, 0x70, 0xc4, 0x47, 0x19, 0x51, 0x21, 0x3e,
-	0x1d, 0x93, 0x2c, 0xa1, 0x99, 0x59, 0x95, 0x94, 0xad, 0x19, 0xd4, 0xa2, 0x28, 0xe4, 0x42, 0xe5,
-	0x16, 0x0c, 0xbe, 0xd2, 0x4e, 0x78, 0x04, 0x9e, 0x73, 0xca, 0x71, 0xe4, 0xb1, 0x00, 0x67, 0x84,
-	0x99, 0x2b, 0xff, 0xb5, 0xa8, 0x4d, 0x4d, 0xfa, 0x65, 0xb1, 0xa8, 0x59, 0x32, 0x72, 0xeb, 0xd2,
-	0x3c, 0x90, 0x16, 0x3c, 0xd6, 0x53, 0xc1, 0x42, 0x0a, 0xcc, 0x5c, 0x7d, 0xdc, 0xf3, 0x6e, 0x6a,
-	0x7c, 0xa8, 0xf0, 0xe7, 0x10, 0xf4, 0x2c, 0x64, 0x18, 0x83, 0x41, 0x41, 0x5c, 0x2b, 0xa3, 0x26,
-	0x67, 0xb0, 0xfb, 0x64, 0x65, 0xdc, 0xeb, 0xa3, 0xd0, 0x87, 0xea, 0x43, 0xc9, 0xfb, 0xdd, 0xfa,
-	0xf7, 0x57, 0x56, 0xe9, 0xf2, 0xca, 0x2a, 0xfd, 0xf2, 0x53, 0x7b, 0x45, 0x10, 0xdd, 0xeb, 0x1e,
-	0x5d, 0xdf, 0xb6, 0x8c, 0x9b, 0xdb, 0x96, 0xf1, 0xe7, 0x6d, 0xcb, 0xb8, 0xb8, 0x6b, 0x95, 0x6e,
-	0xee, 0x5a, 0xa5, 0xdf, 0xee, 0x5a, 0xa5, 0xef, 0xde, 0xcf, 0x15, 0xd6, 0x9d, 0xb6, 0x23, 0xdc,
-	0x63, 0x85, 0xe1, 0x8c, 0x3b, 0x6f, 0x9d, 0xb3, 0xe5, 0x7f, 0xa8, 0xbd, 0x55, 0xf9, 0x91, 0xff,
-	0xe2, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x42, 0xd3, 0x19, 0x8d, 0x7c, 0x07, 0x00, 0x00,
+	// 889 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0xe5, 0xff, 0x95, 0xeb, 0xc0, 0x1b, 0xa1, 0xa5, 0x65, 0x54, 0x6b, 0x6c, 0x81, 0xc2,
+	0x28, 0x62, 0x12, 0x4e, 0x73, 0xf2, 0x25, 0x08, 0x95, 0xb4, 0xc8, 0xcd, 0x65, 0x0a, 0xa4, 0x29,
+	0x02, 0x10, 0x2b, 0x71, 0x45, 0x12, 0x21, 0xb9, 0x04, 0x77, 0xa5, 0xc4, 0x6f, 0xd0, 0x63, 0x8e,
+	0xe9, 0x2d, 0xf7, 0x5e, 0x8b, 0x3e, 0x83, 0xd1, 0x5e, 0x72, 0x2c, 0x7a, 0x60, 0x0b, 0xbb, 0xa7,
+	0x1e, 0xf5, 0x04, 0xc5, 0xfe, 0x50, 0x92, 0x5d, 0x09, 0x8d, 0x91, 0x93, 0x76, 0x66, 0x67, 0xbe,
+	0xf9, 0x66, 0xe6, 0x5b, 0x11, 0xdc, 0x63, 0x3c, 0x63, 0x3c, 0xe1, 0x6e, 0x44, 0xb2, 0xcc, 0x2d,
+	0x18, 0x4b, 0x8f, 0x32, 0x16, 0xd2, 0x94, 0xbb, 0x7d, 0x92, 0x92, 0x7c, 0x40, 0xcb, 0xe9, 0xe1,
+	0x94, 0xb1, 0xd4, 0x29, 0x4a, 0x26, 0x18, 0x6c, 0x9b, 0x2c, 0x47, 0x66, 0x39, 0xe3, 0xe3, 0x3e,
+	0x15, 0xe4, 0xb8, 0xb3, 0x37, 0x50, 0xee, 0x40, 0xc5, 0xb8, 0xda, 0xd0, 0x09, 0x9d, 0x76, 0xc4,
+	0x22, 0xa6, 0xfd, 0xf2, 0x64, 0xbc, 0xdd, 0x88, 0xb1, 0x28, 0xa5, 0xae, 0xb2, 0xfa, 0xa3, 0xa1,
+	0x1b, 0x8e, 0x4a, 0x22, 0x12, 0x96, 0x9b, 0x7b, 0x74, 0xfd, 0x5e, 0x24, 0x19, 0xe5, 0x82, 0x64,
+	0x45, 0x0d, 0xa0, 0x8b, 0xb8, 0x64, 0x24, 0x62, 0xd7, 0xd0, 0x50, 0xc6, 0xb5, 0xfb, 0x3e, 0xe1,
+	0x74, 0x7a, 0x3f, 0x60, 0x89, 0x29, 0x80, 0x7f, 0x5b, 0x01, 0xf6, 0x93, 0x8c, 0x31, 0x11, 0x3f,
+	0xa5, 0x49, 0x14, 0x8b, 0x5e, 0x4c, 0xf2, 0x88, 0x9e, 0x92, 0x92, 0x64, 0x1c, 0x7e, 0x07, 0x00,
+	0x17, 0xa4, 0x14, 0x81, 0xac, 0x6a, 0x5b, 0x07, 0xd6, 0x61, 0xeb, 0x6e, 0xc7, 0xd1, 0x94, 0x9c,
+	0x9a, 0x92, 0xf3, 0x6d, 0x4d, 0xc9, 0xfb, 0xf4, 0xbc, 0x42, 0x8d, 0x49, 0x85, 0x76, 0xcf, 0x48,
+	0x96, 0x9e, 0xe0, 0x59, 0x2e, 0x7e, 0xfd, 0x27, 0xb2, 0xfc, 0x2d, 0xe5, 0x90, 0xe1, 0x30, 0x06,
+	0x9b, 0x75, 0xa7, 0x76, 0x53, 0xe1, 0xee, 0xfd, 0x07, 0xf7, 0xa1, 0x09, 0xf0, 0x8e, 0x25, 0xec,
+	0x3f, 0x15, 0x82, 0x75, 0xca, 0x1d, 0x96, 0x25, 0x82, 0x66, 0x85, 0x38, 0x9b, 0x54, 0xe8, 0x96,
+	0x2e, 0x56, 0xdf, 0xe1, 0x37, 0xb2, 0xd4, 0x14, 0x1d, 0x8e, 0x41, 0x3b, 0xc9, 0x13, 0x91, 0x90,
+	0x34, 0x90, 0xbb, 0x0d, 0x5e, 0xaa, 0x36, 0xb9, 0xbd, 0x72, 0xb0, 0x72, 0xd8, 0xba, 0x8b, 0x9c,
+	0x45, 0x7b, 0x74, 0xe4, 0xa2, 0x1f, 0x70, 0x4e, 0x85, 0xf7, 0x99, 0x69, 0x69, 0x5f, 0x57, 0x59,
+	0x04, 0x85, 0x7d, 0x68, 0xdc, 0x32, 0x4d, 0x8f, 0x91, 0x43, 0x0e, 0x6e, 0x0b, 0x52, 0x46, 0x54,
+	0x5c, 0x2d, 0xbb, 0xfa, 0x7e, 0x65, 0xb1, 0x29, 0xdb, 0xd1, 0x65, 0x17, 0x20, 0x61, 0x7f, 0x57,
+	0x7b, 0xe7, 0x8a, 0xe2, 0xbf, 0x9b, 0x00, 0x48, 0xdb, 0xec, 0xef, 0x39, 0xd8, 0xe4, 0x2f, 0x49,
+	0x11, 0x0c, 0xa9, 0xde, 0xde, 0x96, 0xf7, 0x40, 0xe2, 0xfe, 0x51, 0xa1, 0xcf, 0xa3, 0x44, 0xc4,
+	0xa3, 0xbe, 0x33, 0x60, 0x99, 0x91, 0xa9, 0xf9, 0x39, 0xe2, 0xe1, 0x0b, 0x57, 0x9c, 0x15, 0x94,
+	0x3b, 0x0f, 0xe9, 0x60, 0x36, 0xde, 0x1a, 0x07, 0xfb, 0x1b, 0xf2, 0xf8, 0x15, 0xa5, 0x12, 0x9d,
+	0xbe, 0x4a, 0x84, 0x42, 0x6f, 0x7e, 0x18, 0x7a, 0x8d, 0x83, 0xfd, 0x0d, 0x79, 0x94, 0xe8, 0x3f,
+	0x5a, 0x60, 0x9f, 0x2b, 0x61, 0x9a, 0x8e, 0x83, 0x81, 0x92, 0x66, 0x50, 0xa8, 0xde, 0xec, 0x15,
+	0xa5, 0x1a, 0x67, 0xf1, 0x20, 0x97, 0x29, 0xda, 0xfb, 0xe2, 0xbc, 0x42, 0xd6, 0xa4, 0x42, 0xd8,
+	0x74, 0xb5, 0xbc, 0x00, 0xf6, 0x6d, 0xbe, 0x04, 0x05, 0xff, 0x64, 0x81, 0xad, 0xe9, 0xae, 0xe0,
+	0x23, 0xb0, 0x26, 0xd8, 0x0b, 0x9a, 0x9b, 0x07, 0xb2, 0xe7, 0x98, 0x77, 0x2f, 0x9f, 0xdc, 0x94,
+	0x51, 0x8f, 0x25, 0xb9, 0xd7, 0x36, 0x5b, 0xdd, 0x36, 0x5b, 0x95, 0x59, 0xd8, 0xd7, 0xd9, 0xf0,
+	0x29, 0x58, 0xd7, 0x3c, 0xcc, 0x30, 0xef, 0xdf, 0x60, 0x98, 0x8f, 0x73, 0x31, 0xa9, 0xd0, 0x47,
+	0x1a, 0x56, 0xa3, 0x60, 0xdf, 0xc0, 0xe1, 0x5f, 0xd6, 0xc0, 0xaa, 0x64, 0x0b, 0xef, 0x80, 0x0d,
+	0x12, 0x86, 0x25, 0xe5, 0xdc, 0xa8, 0x01, 0x4e, 0x2a, 0xb4, 0xa3, 0x93, 0xcc, 0x05, 0xf6, 0xeb,
+	0x10, 0xb8, 0x03, 0x9a, 0x49, 0xa8, 0xb8, 0xac, 0xfa, 0xcd, 0x24, 0x84, 0x43, 0xd0, 0x52, 0xfa,
+	0xbb, 0x32, 0xff, 0x83, 0xe5, 0x42, 0x36, 0x13, 0xbf, 0xf6, 0x80, 0xea, 0xbf, 0xd2, 0x60, 0x0e,
+	0x0b, 0xfb, 0xa0, 0x98, 0x89, 0xf6, 0x1b, 0xd0, 0x1e, 0x8e, 0xc4, 0xa8, 0xa4, 0x3a, 0x24, 0x62,
+	0x63, 0x5a, 0xe6, 0xac, 0xb4, 0x57, 0x15, 0x65, 0x34, 0x83, 0x5a, 0x14, 0x85, 0x7d, 0xa8, 0xdd,
+	0x92, 0xc1, 0xd7, 0xc6, 0x09, 0x9f, 0x81, 0x6d, 0xc1, 0x04, 0x49, 0x03, 0x1e, 0x93, 0x92, 0x72,
+	0x7b, 0xed, 0xff, 0x16, 0xb5, 0x6f, 0x48, 0xdf, 0xae, 0x17, 0x35, 0x4b, 0xc6, 0x7e, 0x4b, 0x99,
+	0x4f, 0x94, 0x05, 0x9f, 0x9b, 0xa9, 0x10, 0x29, 0x05, 0x6e, 0xaf, 0xbf, 0xdf, 0xf3, 0xee, 0x18,
+	0x7c, 0xa8, 0xf1, 0xe7, 0x10, 0xcc, 0x2c, 0x54, 0x18, 0x87, 0x71, 0x4d, 0xdc, 0x28, 0x63, 0x43,
+	0xcd, 0xe0, 0xd1, 0x8d, 0x95, 0x71, 0xa5, 0x8f, 0x5a, 0x1f, 0xba, 0x0f, 0x2d, 0x6f, 0xd8, 0x03,
+	0xb7, 0x14, 0x8b, 0x01, 0xcb, 0x45, 0xc9, 0xd2, 0x94, 0x96, 0xf6, 0xa6, 0x2a, 0xd6, 0x99, 0x54,
+	0xe8, 0xe3, 0x39, 0x9a, 0xb3, 0x00, 0xec, 0xef, 0x48, 0x4f, 0x6f, 0xea, 0x80, 0x27, 0x60, 0x5b,
+	0xfe, 0x39, 0xf0, 0xa0, 0x20, 0x23, 0x4e, 0x43, 0x7b, 0xeb, 0xc0, 0x3a, 0xdc, 0xf4, 0x3e, 0x99,
+	0x11, 0x98, 0xbf, 0xc5, 0x7e, 0x4b, 0x99, 0xa7, 0xca, 0x3a, 0xd9, 0xfd, 0xe1, 0x2d, 0x6a, 0xbc,
+	0x79, 0x8b, 0x1a, 0xbf, 0xfe, 0x7c, 0xb4, 0x26, 0x27, 0xf5, 0xd8, 0x7b, 0x76, 0x7e, 0xd1, 0xb5,
+	0xde, 0x5d, 0x74, 0xad, 0xbf, 0x2e, 0xba, 0xd6, 0xeb, 0xcb, 0x6e, 0xe3, 0xdd, 0x65, 0xb7, 0xf1,
+	0xfb, 0x65, 0xb7, 0xf1, 0xfd, 0xfd, 0xb9, 0xce, 0xcd, 0xa8, 0x8f, 0x52, 0xd2, 0xe7, 0xb5, 0xe1,
+	0x8e, 0x8f, 0xef, 0xb9, 0xaf, 0x96, 0x7f, 0xd1, 0xfb, 0xeb, 0xea, 0x2b, 0xf3, 0xe5, 0xbf, 0x03,
+	0x00, 0xc0, 0xb2, 0x3e, 0x36, 0xfd, 0x07, 0x00, 0x00,
 }
 
 func (m *SmoothWeightChangeParams) Marshal() (dAtA []byte, err error) {
@@ -538,6 +547,23 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SwapsPaused {
+		i--
+		if m.SwapsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PoolController) > 0 {
+		i -= len(m.PoolController)
+		copy(dAtA[i:], m.PoolController)
+		i = encodeVarintBalancerPool(dAtA, i, uint64(len(m.PoolController)))
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size := m.TotalWeight.Size()
 		i -= size
@@ -699,6 +725,13 @@ func (m *Pool) Size() (n int) {
 	}
 	l = m.TotalWeight.Size()
 	n += 1 + l + sovBalancerPool(uint64(l))
+	l = len(m.PoolController)
+	if l > 0 {
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
+	if m.SwapsPaused {
+		n += 2
+	}
 	return n
 }
 
@@ -1409,6 +1442,58 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolController", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolController = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapsPaused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
@@ -18,6 +18,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateBalancerPool{}, "osmosis/gamm/create-balancer-pool", nil)
 	cdc.RegisterConcrete(&PoolParams{}, "osmosis/gamm/BalancerPoolParams", nil)
 	cdc.RegisterConcrete(&MsgMigrateSharesToFullRangeConcentratedPosition{}, "osmosis/gamm/MigratePosition", nil)
+	cdc.RegisterConcrete(&MsgScheduleSmoothWeightChange{}, "osmosis/gamm/schedule-smooth-weight-change", nil)
+	cdc.RegisterConcrete(&MsgSetSwapsPaused{}, "osmosis/gamm/set-swaps-paused", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -35,6 +37,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateBalancerPool{},
 		&MsgMigrateSharesToFullRangeConcentratedPosition{},
+		&MsgScheduleSmoothWeightChange{},
+		&MsgSetSwapsPaused{},
 	)
 	registry.RegisterImplementations(
 		(*proto.Message)(nil),
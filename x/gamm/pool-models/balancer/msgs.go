@@ -9,8 +9,10 @@ import (
 )
 
 const (
-	TypeMsgCreateBalancerPool = "create_balancer_pool"
-	TypeMsgMigrateShares      = "migrate_shares"
+	TypeMsgCreateBalancerPool         = "create_balancer_pool"
+	TypeMsgMigrateShares              = "migrate_shares"
+	TypeMsgScheduleSmoothWeightChange = "schedule_smooth_weight_change"
+	TypeMsgSetSwapsPaused             = "set_swaps_paused"
 )
 
 var (
@@ -55,6 +57,13 @@ func (msg MsgCreateBalancerPool) ValidateBasic() error {
 		return err
 	}
 
+	// validation for pool controller
+	if msg.PoolController != "" {
+		if _, err = sdk.AccAddressFromBech32(msg.PoolController); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid pool controller address (%s)", err)
+		}
+	}
+
 	return nil
 }
 
@@ -98,6 +107,7 @@ func (msg MsgCreateBalancerPool) InitialLiquidity() sdk.Coins {
 
 func (msg MsgCreateBalancerPool) CreatePool(ctx sdk.Context, poolID uint64) (poolmanagertypes.PoolI, error) {
 	poolI, err := NewBalancerPool(poolID, *msg.PoolParams, msg.PoolAssets, msg.FuturePoolGovernor, ctx.BlockTime())
+	poolI.PoolController = msg.PoolController
 	return &poolI, err
 }
 
@@ -129,3 +139,79 @@ func (msg MsgMigrateSharesToFullRangeConcentratedPosition) GetSigners() []sdk.Ac
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgScheduleSmoothWeightChange{}
+
+func NewMsgScheduleSmoothWeightChange(
+	sender string,
+	poolID uint64,
+	params SmoothWeightChangeParams,
+) MsgScheduleSmoothWeightChange {
+	return MsgScheduleSmoothWeightChange{
+		Sender:                   sender,
+		PoolID:                   poolID,
+		SmoothWeightChangeParams: params,
+	}
+}
+
+func (msg MsgScheduleSmoothWeightChange) Route() string { return types.RouterKey }
+func (msg MsgScheduleSmoothWeightChange) Type() string  { return TypeMsgScheduleSmoothWeightChange }
+func (msg MsgScheduleSmoothWeightChange) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if len(msg.SmoothWeightChangeParams.TargetPoolWeights) == 0 {
+		return types.ErrPoolParamsInvalidNumDenoms
+	}
+
+	// the target weights are validated against the pool's assets when the change is scheduled.
+	poolParams := PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec(), SmoothWeightChangeParams: &msg.SmoothWeightChangeParams}
+	return poolParams.Validate(msg.SmoothWeightChangeParams.TargetPoolWeights)
+}
+
+func (msg MsgScheduleSmoothWeightChange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgScheduleSmoothWeightChange) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetSwapsPaused{}
+
+func NewMsgSetSwapsPaused(sender string, poolID uint64, swapsPaused bool) MsgSetSwapsPaused {
+	return MsgSetSwapsPaused{
+		Sender:      sender,
+		PoolID:      poolID,
+		SwapsPaused: swapsPaused,
+	}
+}
+
+func (msg MsgSetSwapsPaused) Route() string { return types.RouterKey }
+func (msg MsgSetSwapsPaused) Type() string  { return TypeMsgSetSwapsPaused }
+func (msg MsgSetSwapsPaused) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgSetSwapsPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetSwapsPaused) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -2,6 +2,7 @@ package balancer_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -79,6 +80,22 @@ func TestMsgCreateBalancerPool_ValidateBasic(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "valid pool controller",
+			msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
+				msg.PoolController = addr1
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid pool controller",
+			msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
+				msg.PoolController = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
 		{
 			name: "has no PoolAsset",
 			msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
@@ -291,6 +308,103 @@ func (suite *KeeperTestSuite) TestMsgCreateBalancerPool() {
 	}
 }
 
+func TestMsgScheduleSmoothWeightChange(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg balancer.MsgScheduleSmoothWeightChange) balancer.MsgScheduleSmoothWeightChange) balancer.MsgScheduleSmoothWeightChange {
+		properMsg := balancer.NewMsgScheduleSmoothWeightChange(addr1, 1, balancer.SmoothWeightChangeParams{
+			Duration: time.Hour,
+			TargetPoolWeights: []balancer.PoolAsset{
+				{Weight: sdk.NewInt(1), Token: sdk.NewCoin("test", sdk.ZeroInt())},
+				{Weight: sdk.NewInt(3), Token: sdk.NewCoin("test2", sdk.ZeroInt())},
+			},
+		})
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg balancer.MsgScheduleSmoothWeightChange) balancer.MsgScheduleSmoothWeightChange {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "schedule_smooth_weight_change")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        balancer.MsgScheduleSmoothWeightChange
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg balancer.MsgScheduleSmoothWeightChange) balancer.MsgScheduleSmoothWeightChange {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg balancer.MsgScheduleSmoothWeightChange) balancer.MsgScheduleSmoothWeightChange {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "no target weights",
+			msg: createMsg(func(msg balancer.MsgScheduleSmoothWeightChange) balancer.MsgScheduleSmoothWeightChange {
+				msg.SmoothWeightChangeParams.TargetPoolWeights = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero target weight",
+			msg: createMsg(func(msg balancer.MsgScheduleSmoothWeightChange) balancer.MsgScheduleSmoothWeightChange {
+				msg.SmoothWeightChangeParams.TargetPoolWeights = []balancer.PoolAsset{
+					{Weight: sdk.ZeroInt(), Token: sdk.NewCoin("test", sdk.ZeroInt())},
+					{Weight: sdk.NewInt(3), Token: sdk.NewCoin("test2", sdk.ZeroInt())},
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSetSwapsPaused(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	msg := balancer.NewMsgSetSwapsPaused(addr1, 1, true)
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "set_swaps_paused")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Sender = invalidAddr.String()
+	require.Error(t, msg.ValidateBasic())
+}
+
 func TestMsgMigrateSharesToFullRangeConcentratedPosition(t *testing.T) {
 	appParams.SetAddressPrefixes()
 	pk1 := ed25519.GenPrivKey().PubKey()
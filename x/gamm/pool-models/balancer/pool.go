@@ -478,8 +478,44 @@ func (p Pool) NumAssets() int {
 	return len(p.PoolAssets)
 }
 
+// IsActive returns false if swaps against the pool are paused by its controller.
 func (p Pool) IsActive(ctx sdk.Context) bool {
-	return true
+	return !p.SwapsPaused
+}
+
+// ScheduleSmoothWeightChange schedules a smooth change from the pool's current weights
+// to the target weights of the given params, replacing any change in progress.
+// The sender must be the pool controller, and the change can not start in the past.
+// If the start time is not set, the change starts at the current block time.
+func (p *Pool) ScheduleSmoothWeightChange(params SmoothWeightChangeParams, sender string, blockTime time.Time) error {
+	if p.PoolController == "" || sender != p.PoolController {
+		return types.ErrNotPoolController
+	}
+
+	if params.StartTime.Unix() > 0 && params.StartTime.Before(blockTime) {
+		return fmt.Errorf("smooth weight change start time %s is before the current block time %s", params.StartTime, blockTime)
+	}
+
+	// target weights are sorted and scaled in place, so they are copied from the caller's params.
+	params.TargetPoolWeights = append([]PoolAsset(nil), params.TargetPoolWeights...)
+	poolParams := p.PoolParams
+	poolParams.SmoothWeightChangeParams = &params
+	sortedPoolAssets := p.GetAllPoolAssets()
+	if err := poolParams.Validate(sortedPoolAssets); err != nil {
+		return err
+	}
+
+	return p.setInitialPoolParams(poolParams, sortedPoolAssets, blockTime)
+}
+
+// SetSwapsPaused pauses or resumes swaps against the pool. The sender must be the pool controller.
+func (p *Pool) SetSwapsPaused(paused bool, sender string) error {
+	if p.PoolController == "" || sender != p.PoolController {
+		return types.ErrNotPoolController
+	}
+
+	p.SwapsPaused = paused
+	return nil
 }
 
 func (p Pool) GetType() poolmanagertypes.PoolType {
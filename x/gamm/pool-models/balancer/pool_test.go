@@ -1188,15 +1188,20 @@ func TestBalancerPoolPokeTokenWeights(t *testing.T) {
 	}
 }
 
-// This test (currently trivially) checks to make sure that `IsActive` returns true for balancer pools.
+// This test checks that `IsActive` returns true for balancer pools unless their controller paused swaps.
 // This is mainly to make sure that if IsActive is ever used as an emergency switch, it is not accidentally left off for any (or all) pools.
 func TestIsActive(t *testing.T) {
 	tests := map[string]struct {
+		swapsPaused      bool
 		expectedIsActive bool
 	}{
 		"IsActive is true": {
 			expectedIsActive: true,
 		},
+		"IsActive is false when swaps are paused": {
+			swapsPaused:      true,
+			expectedIsActive: false,
+		},
 	}
 
 	for name, tc := range tests {
@@ -1206,6 +1211,7 @@ func TestIsActive(t *testing.T) {
 			// Initialize a pool
 			pool, err := balancer.NewBalancerPool(defaultPoolId, defaultBalancerPoolParams, dummyPoolAssets, defaultFutureGovernor, defaultCurBlockTime)
 			require.NoError(t, err, "test %v", name)
+			pool.SwapsPaused = tc.swapsPaused
 
 			isActive := pool.IsActive(ctx)
 			require.Equal(t, tc.expectedIsActive, isActive)
@@ -1213,6 +1219,121 @@ func TestIsActive(t *testing.T) {
 	}
 }
 
+func TestScheduleSmoothWeightChange(t *testing.T) {
+	const controller = "controller"
+	poolAssets := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset1", sdk.NewInt(1000))},
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset2", sdk.NewInt(1000))},
+	}
+	targetWeights := func(weight1, weight2 int64) []balancer.PoolAsset {
+		return []balancer.PoolAsset{
+			{Weight: sdk.NewInt(weight2), Token: sdk.NewCoin("asset2", sdk.ZeroInt())},
+			{Weight: sdk.NewInt(weight1), Token: sdk.NewCoin("asset1", sdk.ZeroInt())},
+		}
+	}
+
+	tests := map[string]struct {
+		poolController    string
+		sender            string
+		params            balancer.SmoothWeightChangeParams
+		expectedStartTime time.Time
+		expectErr         error
+	}{
+		"start time defaults to block time": {
+			poolController:    controller,
+			sender:            controller,
+			params:            balancer.SmoothWeightChangeParams{Duration: time.Hour, TargetPoolWeights: targetWeights(1, 3)},
+			expectedStartTime: defaultCurBlockTime,
+		},
+		"start time in the future": {
+			poolController:    controller,
+			sender:            controller,
+			params:            balancer.SmoothWeightChangeParams{StartTime: defaultCurBlockTime.Add(time.Hour), Duration: time.Hour, TargetPoolWeights: targetWeights(1, 3)},
+			expectedStartTime: defaultCurBlockTime.Add(time.Hour),
+		},
+		"sender is not the pool controller": {
+			poolController: controller,
+			sender:         "other",
+			params:         balancer.SmoothWeightChangeParams{Duration: time.Hour, TargetPoolWeights: targetWeights(1, 3)},
+			expectErr:      types.ErrNotPoolController,
+		},
+		"pool has no controller": {
+			params:    balancer.SmoothWeightChangeParams{Duration: time.Hour, TargetPoolWeights: targetWeights(1, 3)},
+			expectErr: types.ErrNotPoolController,
+		},
+		"start time in the past": {
+			poolController: controller,
+			sender:         controller,
+			params:         balancer.SmoothWeightChangeParams{StartTime: defaultCurBlockTime.Add(-time.Hour), Duration: time.Hour, TargetPoolWeights: targetWeights(1, 3)},
+			expectErr:      fmt.Errorf("smooth weight change start time %s is before the current block time %s", defaultCurBlockTime.Add(-time.Hour), defaultCurBlockTime),
+		},
+		"target weights do not match the pool assets": {
+			poolController: controller,
+			sender:         controller,
+			params: balancer.SmoothWeightChangeParams{Duration: time.Hour, TargetPoolWeights: []balancer.PoolAsset{
+				{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset1", sdk.ZeroInt())},
+				{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset3", sdk.ZeroInt())},
+			}},
+			expectErr: types.ErrPoolParamsInvalidDenom,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool, err := balancer.NewBalancerPool(defaultPoolId, defaultBalancerPoolParams, poolAssets, defaultFutureGovernor, defaultCurBlockTime)
+			require.NoError(t, err)
+			pool.PoolController = tc.poolController
+			requestedTargetWeights := append([]balancer.PoolAsset(nil), tc.params.TargetPoolWeights...)
+
+			err = pool.ScheduleSmoothWeightChange(tc.params, tc.sender, defaultCurBlockTime)
+			if tc.expectErr != nil {
+				require.ErrorContains(t, err, tc.expectErr.Error())
+				require.Nil(t, pool.PoolParams.SmoothWeightChangeParams)
+				return
+			}
+			require.NoError(t, err)
+
+			// the caller's target weights are not modified.
+			require.Equal(t, requestedTargetWeights, tc.params.TargetPoolWeights)
+
+			params := pool.PoolParams.SmoothWeightChangeParams
+			require.NotNil(t, params)
+			require.Equal(t, tc.expectedStartTime, params.StartTime)
+			require.Equal(t, tc.params.Duration, params.Duration)
+			require.Equal(t, pool.GetAllPoolAssets()[0].Weight, params.InitialPoolWeights[0].Weight)
+			require.Equal(t, "asset1", params.TargetPoolWeights[0].Token.Denom)
+			require.Equal(t, sdk.NewInt(1).MulRaw(balancer.GuaranteedWeightPrecision), params.TargetPoolWeights[0].Weight)
+			require.Equal(t, sdk.NewInt(3).MulRaw(balancer.GuaranteedWeightPrecision), params.TargetPoolWeights[1].Weight)
+
+			// weights reach the target once the change is over.
+			pool.PokePool(tc.expectedStartTime.Add(tc.params.Duration).Add(time.Second))
+			require.Nil(t, pool.PoolParams.SmoothWeightChangeParams)
+			require.Equal(t, sdk.NewInt(3).MulRaw(balancer.GuaranteedWeightPrecision), pool.GetAllPoolAssets()[1].Weight)
+		})
+	}
+}
+
+func TestSetSwapsPaused(t *testing.T) {
+	const controller = "controller"
+	pool, err := balancer.NewBalancerPool(defaultPoolId, defaultBalancerPoolParams, dummyPoolAssets, defaultFutureGovernor, defaultCurBlockTime)
+	require.NoError(t, err)
+
+	// a pool without a controller can not be paused.
+	require.ErrorIs(t, pool.SetSwapsPaused(true, controller), types.ErrNotPoolController)
+
+	pool.PoolController = controller
+	require.ErrorIs(t, pool.SetSwapsPaused(true, "other"), types.ErrNotPoolController)
+	require.False(t, pool.SwapsPaused)
+
+	require.NoError(t, pool.SetSwapsPaused(true, controller))
+	require.True(t, pool.SwapsPaused)
+	require.False(t, pool.IsActive(sdk.Context{}))
+
+	require.NoError(t, pool.SetSwapsPaused(false, controller))
+	require.False(t, pool.SwapsPaused)
+	require.True(t, pool.IsActive(sdk.Context{}))
+}
+
 func TestCalcJoinPoolNoSwapShares(t *testing.T) {
 	balancerPoolAssets := []balancer.PoolAsset{
 		{Token: sdk.NewInt64Coin("foo", 100), Weight: sdk.NewIntFromUint64(5)},
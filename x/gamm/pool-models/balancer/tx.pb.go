@@ -36,6 +36,7 @@ type MsgCreateBalancerPool struct {
 	PoolParams         *PoolParams `protobuf:"bytes,2,opt,name=pool_params,json=poolParams,proto3" json:"pool_params,omitempty" yaml:"pool_params"`
 	PoolAssets         []PoolAsset `protobuf:"bytes,3,rep,name=pool_assets,json=poolAssets,proto3" json:"pool_assets"`
 	FuturePoolGovernor string      `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
+	PoolController     string      `protobuf:"bytes,5,opt,name=pool_controller,json=poolController,proto3" json:"pool_controller,omitempty" yaml:"pool_controller"`
 }
 
 func (m *MsgCreateBalancerPool) Reset()         { *m = MsgCreateBalancerPool{} }
@@ -99,6 +100,13 @@ func (m *MsgCreateBalancerPool) GetFuturePoolGovernor() string {
 	return ""
 }
 
+func (m *MsgCreateBalancerPool) GetPoolController() string {
+	if m != nil {
+		return m.PoolController
+	}
+	return ""
+}
+
 // Returns the poolID
 type MsgCreateBalancerPoolResponse struct {
 	PoolID uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
@@ -253,11 +261,217 @@ func (m *MsgMigrateSharesToFullRangeConcentratedPositionResponse) XXX_DiscardUnk
 
 var xxx_messageInfo_MsgMigrateSharesToFullRangeConcentratedPositionResponse proto.InternalMessageInfo
 
+// ===================== MsgScheduleSmoothWeightChange
+// Sender must be the pool's pool_controller in order for the tx to succeed.
+// Schedules a smooth change from the pool's current weights to the target
+// weights, replacing any change in progress.
+type MsgScheduleSmoothWeightChange struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// The initial pool weights of the params are ignored, as they are set to
+	// the pool's current weights. If the start time is not set, the change
+	// starts at the current block time.
+	SmoothWeightChangeParams SmoothWeightChangeParams `protobuf:"bytes,3,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params" yaml:"smooth_weight_change_params"`
+}
+
+func (m *MsgScheduleSmoothWeightChange) Reset()         { *m = MsgScheduleSmoothWeightChange{} }
+func (m *MsgScheduleSmoothWeightChange) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleSmoothWeightChange) ProtoMessage()    {}
+func (*MsgScheduleSmoothWeightChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{4}
+}
+func (m *MsgScheduleSmoothWeightChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleSmoothWeightChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleSmoothWeightChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleSmoothWeightChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleSmoothWeightChange.Merge(m, src)
+}
+func (m *MsgScheduleSmoothWeightChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleSmoothWeightChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleSmoothWeightChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleSmoothWeightChange proto.InternalMessageInfo
+
+func (m *MsgScheduleSmoothWeightChange) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgScheduleSmoothWeightChange) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgScheduleSmoothWeightChange) GetSmoothWeightChangeParams() SmoothWeightChangeParams {
+	if m != nil {
+		return m.SmoothWeightChangeParams
+	}
+	return SmoothWeightChangeParams{}
+}
+
+type MsgScheduleSmoothWeightChangeResponse struct {
+}
+
+func (m *MsgScheduleSmoothWeightChangeResponse) Reset()         { *m = MsgScheduleSmoothWeightChangeResponse{} }
+func (m *MsgScheduleSmoothWeightChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleSmoothWeightChangeResponse) ProtoMessage()    {}
+func (*MsgScheduleSmoothWeightChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{5}
+}
+func (m *MsgScheduleSmoothWeightChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleSmoothWeightChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleSmoothWeightChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleSmoothWeightChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleSmoothWeightChangeResponse.Merge(m, src)
+}
+func (m *MsgScheduleSmoothWeightChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleSmoothWeightChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleSmoothWeightChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleSmoothWeightChangeResponse proto.InternalMessageInfo
+
+// ===================== MsgSetSwapsPaused
+// Sender must be the pool's pool_controller in order for the tx to succeed.
+// Pauses or resumes swaps against the pool.
+type MsgSetSwapsPaused struct {
+	Sender      string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID      uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	SwapsPaused bool   `protobuf:"varint,3,opt,name=swaps_paused,json=swapsPaused,proto3" json:"swaps_paused,omitempty" yaml:"swaps_paused"`
+}
+
+func (m *MsgSetSwapsPaused) Reset()         { *m = MsgSetSwapsPaused{} }
+func (m *MsgSetSwapsPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSwapsPaused) ProtoMessage()    {}
+func (*MsgSetSwapsPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{6}
+}
+func (m *MsgSetSwapsPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSwapsPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSwapsPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSwapsPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSwapsPaused.Merge(m, src)
+}
+func (m *MsgSetSwapsPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSwapsPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSwapsPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSwapsPaused proto.InternalMessageInfo
+
+func (m *MsgSetSwapsPaused) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetSwapsPaused) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgSetSwapsPaused) GetSwapsPaused() bool {
+	if m != nil {
+		return m.SwapsPaused
+	}
+	return false
+}
+
+type MsgSetSwapsPausedResponse struct {
+}
+
+func (m *MsgSetSwapsPausedResponse) Reset()         { *m = MsgSetSwapsPausedResponse{} }
+func (m *MsgSetSwapsPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSwapsPausedResponse) ProtoMessage()    {}
+func (*MsgSetSwapsPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{7}
+}
+func (m *MsgSetSwapsPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSwapsPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSwapsPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSwapsPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSwapsPausedResponse.Merge(m, src)
+}
+func (m *MsgSetSwapsPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSwapsPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSwapsPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSwapsPausedResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateBalancerPool)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPool")
 	proto.RegisterType((*MsgCreateBalancerPoolResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPoolResponse")
 	proto.RegisterType((*MsgMigrateSharesToFullRangeConcentratedPosition)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgMigrateSharesToFullRangeConcentratedPosition")
 	proto.RegisterType((*MsgMigrateSharesToFullRangeConcentratedPositionResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgMigrateSharesToFullRangeConcentratedPositionResponse")
+	proto.RegisterType((*MsgScheduleSmoothWeightChange)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgScheduleSmoothWeightChange")
+	proto.RegisterType((*MsgScheduleSmoothWeightChangeResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgScheduleSmoothWeightChangeResponse")
+	proto.RegisterType((*MsgSetSwapsPaused)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgSetSwapsPaused")
+	proto.RegisterType((*MsgSetSwapsPausedResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgSetSwapsPausedResponse")
 }
 
 func init() {
@@ -265,50 +479,63 @@ func init() {
 }
 
 var fileDescriptor_0647ee155de97433 = []byte{
-	// 680 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0xb3, 0x4d, 0x49, 0xe9, 0x94, 0x5f, 0x7f, 0xed, 0x52, 0x25, 0x46, 0xdc, 0x0d, 0x2b,
-	0x48, 0x04, 0x33, 0x63, 0x6a, 0x41, 0xf0, 0x52, 0xdd, 0xd6, 0x96, 0x0a, 0x81, 0xba, 0x7a, 0x69,
-	0x2f, 0x61, 0xb2, 0x3b, 0x6e, 0x17, 0x77, 0x67, 0xe2, 0xce, 0xa4, 0xb6, 0xef, 0xc2, 0x8b, 0x57,
-	0x5f, 0x87, 0x2f, 0xa1, 0xc7, 0x1e, 0xc5, 0xc3, 0x22, 0xe9, 0x4d, 0x04, 0x21, 0xaf, 0x40, 0xe6,
-	0x4f, 0xd2, 0xaa, 0x29, 0x1a, 0x8a, 0xa7, 0x6e, 0x9f, 0xf9, 0x3e, 0x9f, 0xe7, 0xf9, 0x3e, 0xf3,
-	0xec, 0x06, 0x34, 0x19, 0xcf, 0x18, 0x4f, 0x38, 0x8a, 0x71, 0x96, 0xa1, 0x1e, 0x63, 0x69, 0x33,
-	0x63, 0x11, 0x49, 0x39, 0xea, 0xe2, 0x14, 0xd3, 0x90, 0xe4, 0x48, 0x1c, 0x21, 0x71, 0x04, 0x7b,
-	0x39, 0x13, 0xcc, 0x6e, 0x18, 0x39, 0x94, 0x72, 0x28, 0xe5, 0x5a, 0x0d, 0x47, 0x6a, 0x78, 0xd8,
-	0xea, 0x12, 0x81, 0x5b, 0xb5, 0x95, 0x98, 0xc5, 0x4c, 0x25, 0x21, 0xf9, 0xa4, 0xf3, 0x6b, 0x6b,
-	0x7f, 0x2e, 0x37, 0x7a, 0xd8, 0x65, 0x2c, 0x35, 0x59, 0x4e, 0xa8, 0xd2, 0x50, 0x17, 0x73, 0x82,
-	0x4c, 0x01, 0x14, 0xb2, 0x84, 0xea, 0x73, 0xef, 0xe3, 0x0c, 0xb8, 0xd6, 0xe6, 0xf1, 0x46, 0x4e,
-	0xb0, 0x20, 0xfe, 0x85, 0x7c, 0xfb, 0x2e, 0xa8, 0x70, 0x42, 0x23, 0x92, 0x57, 0xad, 0xba, 0xd5,
-	0x98, 0xf7, 0x97, 0x87, 0x85, 0xfb, 0xdf, 0x31, 0xce, 0xd2, 0x47, 0x9e, 0x8e, 0x7b, 0x81, 0x11,
-	0xd8, 0x7b, 0x60, 0x41, 0xf6, 0xd3, 0xe9, 0xe1, 0x1c, 0x67, 0xbc, 0x3a, 0x53, 0xb7, 0x1a, 0x0b,
-	0xab, 0x75, 0xf8, 0x93, 0x61, 0x53, 0x1b, 0x4a, 0xf6, 0xae, 0xd2, 0xf9, 0xd7, 0x87, 0x85, 0x6b,
-	0x6b, 0xe2, 0x85, 0x74, 0x2f, 0x00, 0xbd, 0xb1, 0xc6, 0xde, 0x32, 0x68, 0xcc, 0x39, 0x11, 0xbc,
-	0x5a, 0xae, 0x97, 0x1b, 0x0b, 0xab, 0xee, 0xe5, 0xe8, 0x27, 0x52, 0xe7, 0xcf, 0x9e, 0x14, 0x6e,
-	0x49, 0x73, 0x54, 0x80, 0xdb, 0xcf, 0xc1, 0xca, 0xab, 0xbe, 0xe8, 0xe7, 0xa4, 0xa3, 0x70, 0x31,
-	0x3b, 0x24, 0x39, 0x65, 0x79, 0x75, 0x56, 0x79, 0x73, 0x87, 0x85, 0x7b, 0x53, 0x77, 0x32, 0x49,
-	0xe5, 0x05, 0xb6, 0x0e, 0xcb, 0x0a, 0xdb, 0xa3, 0xe0, 0x26, 0xb8, 0x35, 0x71, 0x72, 0x01, 0xe1,
-	0x3d, 0x46, 0x39, 0xb1, 0x6f, 0x83, 0x39, 0x85, 0x49, 0x22, 0x35, 0xc2, 0x59, 0x1f, 0x0c, 0x0a,
-	0xb7, 0x22, 0x25, 0x3b, 0x9b, 0x41, 0x45, 0x1e, 0xed, 0x44, 0xde, 0x77, 0x0b, 0xa0, 0x36, 0x8f,
-	0xdb, 0x49, 0x9c, 0x63, 0x41, 0x5e, 0x1c, 0xe0, 0x9c, 0xf0, 0x97, 0x6c, 0xab, 0x9f, 0xa6, 0x01,
-	0xa6, 0x31, 0xd9, 0x60, 0x34, 0x24, 0x54, 0xc8, 0xb3, 0x68, 0x97, 0xf1, 0x44, 0x24, 0x8c, 0x4e,
-	0x73, 0x35, 0x31, 0x58, 0xe6, 0x8a, 0xd9, 0x11, 0xac, 0x93, 0xe9, 0x22, 0xe6, 0x82, 0x6e, 0x40,
-	0xbd, 0x1b, 0x50, 0xee, 0xc6, 0x78, 0x88, 0x1b, 0x2c, 0xa1, 0x7e, 0x5d, 0xce, 0x6f, 0x58, 0xb8,
-	0x55, 0x03, 0xfd, 0x95, 0xe0, 0x05, 0xff, 0x73, 0xd3, 0xa9, 0x69, 0xdc, 0x6e, 0x80, 0x25, 0x63,
-	0xb6, 0x43, 0xa8, 0x20, 0x79, 0x42, 0xe3, 0x6a, 0x59, 0xba, 0x0e, 0x16, 0xb5, 0xd3, 0xa7, 0x26,
-	0xea, 0x7d, 0x9d, 0x01, 0x0f, 0xa7, 0x74, 0x3c, 0x1e, 0xe9, 0x3e, 0x98, 0xc3, 0x19, 0xeb, 0x53,
-	0x71, 0xdf, 0x58, 0x7f, 0x2c, 0x3b, 0xfd, 0x5c, 0xb8, 0x77, 0xe2, 0x44, 0x1c, 0xf4, 0xbb, 0x30,
-	0x64, 0x19, 0x32, 0x2b, 0xaf, 0xff, 0x34, 0x79, 0xf4, 0x1a, 0x89, 0xe3, 0x1e, 0xe1, 0x70, 0x87,
-	0x8a, 0x61, 0xe1, 0x2e, 0x6a, 0x4f, 0x06, 0xe3, 0x05, 0x23, 0xe0, 0x39, 0xbb, 0xa5, 0x06, 0x74,
-	0x65, 0x76, 0x6b, 0xcc, 0x6e, 0xd9, 0x6f, 0xc1, 0x72, 0x9a, 0xbc, 0xe9, 0x27, 0x51, 0x22, 0x8e,
-	0x3b, 0xa1, 0x5a, 0x99, 0x48, 0x8d, 0x67, 0xde, 0x7f, 0x36, 0x45, 0x95, 0x4d, 0x12, 0x9e, 0xdf,
-	0xca, 0x6f, 0x40, 0x2f, 0x58, 0x1a, 0xc7, 0xf4, 0x5a, 0x46, 0xab, 0xef, 0xcb, 0xa0, 0xdc, 0xe6,
-	0xb1, 0xfd, 0xc1, 0x02, 0xf6, 0x84, 0x97, 0x7c, 0x1d, 0xfe, 0xed, 0x57, 0x09, 0x4e, 0xdc, 0xf5,
-	0xda, 0xf6, 0x15, 0x01, 0xe3, 0x9b, 0xfd, 0x66, 0x81, 0x7b, 0x53, 0xbd, 0x04, 0x7b, 0x53, 0x55,
-	0x9e, 0x06, 0x5d, 0xc3, 0xff, 0x0c, 0x3d, 0xb2, 0xeb, 0xef, 0x9d, 0x0c, 0x1c, 0xeb, 0x74, 0xe0,
-	0x58, 0x5f, 0x06, 0x8e, 0xf5, 0xee, 0xcc, 0x29, 0x9d, 0x9e, 0x39, 0xa5, 0x4f, 0x67, 0x4e, 0x69,
-	0x7f, 0xfd, 0xc2, 0x1e, 0x98, 0x36, 0x9a, 0x29, 0xee, 0xf2, 0xd1, 0x3f, 0xe8, 0xb0, 0xb5, 0x86,
-	0x8e, 0x2e, 0xff, 0x15, 0xe8, 0x56, 0xd4, 0x97, 0xfd, 0xc1, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x24, 0xf2, 0xad, 0xbb, 0xa0, 0x06, 0x00, 0x00,
+	// 888 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0xc7, 0xe3, 0xdd, 0x34, 0xa5, 0x13, 0x48, 0x1b, 0x53, 0x8a, 0xeb, 0x88, 0xf5, 0x6a, 0x10,
+	0xb0, 0x20, 0x62, 0xb3, 0xa1, 0x12, 0x52, 0x39, 0x14, 0xec, 0xd0, 0x2a, 0x48, 0x11, 0xc1, 0x41,
+	0x42, 0xe9, 0xc5, 0x9a, 0xb5, 0x07, 0xaf, 0x85, 0xed, 0x31, 0x9e, 0x71, 0x5e, 0x3e, 0x03, 0x17,
+	0xc4, 0x8d, 0x0b, 0x7c, 0x0b, 0x3e, 0x43, 0x8f, 0x3d, 0x22, 0x0e, 0x16, 0xca, 0xde, 0x10, 0x12,
+	0xd2, 0xde, 0x91, 0xd0, 0x8c, 0xc7, 0xde, 0x6d, 0xb3, 0x5b, 0xb0, 0x9a, 0x9e, 0xd6, 0xfb, 0xcc,
+	0xff, 0xf9, 0x3d, 0x2f, 0xf3, 0xcc, 0xd8, 0x60, 0x9b, 0xd0, 0x84, 0xd0, 0x88, 0x5a, 0x21, 0x4a,
+	0x12, 0x2b, 0x23, 0x24, 0xde, 0x4e, 0x48, 0x80, 0x63, 0x6a, 0x8d, 0x50, 0x8c, 0x52, 0x1f, 0xe7,
+	0x16, 0x3b, 0xb5, 0xd8, 0xa9, 0x99, 0xe5, 0x84, 0x11, 0x75, 0x20, 0xe5, 0x26, 0x97, 0x9b, 0x5c,
+	0x5e, 0xa9, 0xcd, 0x5a, 0x6d, 0x1e, 0x0f, 0x47, 0x98, 0xa1, 0xa1, 0x7e, 0x33, 0x24, 0x21, 0x11,
+	0x4e, 0x16, 0x7f, 0xaa, 0xfc, 0xf5, 0x3b, 0xff, 0x1d, 0xae, 0x7e, 0x38, 0x20, 0x24, 0x96, 0x5e,
+	0x3d, 0x5f, 0xb8, 0x59, 0x23, 0x44, 0xb1, 0x25, 0x03, 0x58, 0x3e, 0x89, 0xd2, 0x6a, 0x1d, 0xfe,
+	0xd3, 0x01, 0xaf, 0xed, 0xd3, 0xd0, 0xc9, 0x31, 0x62, 0xd8, 0x9e, 0xf3, 0x57, 0xdf, 0x05, 0x6b,
+	0x14, 0xa7, 0x01, 0xce, 0x35, 0xa5, 0xaf, 0x0c, 0xae, 0xd9, 0x9b, 0xd3, 0xd2, 0x78, 0xe5, 0x0c,
+	0x25, 0xf1, 0x5d, 0x58, 0xd9, 0xa1, 0x2b, 0x05, 0xea, 0x11, 0x58, 0xe7, 0xf9, 0x78, 0x19, 0xca,
+	0x51, 0x42, 0xb5, 0x4e, 0x5f, 0x19, 0xac, 0xef, 0xf4, 0xcd, 0x27, 0x0a, 0x96, 0xb1, 0x4d, 0xce,
+	0x3e, 0x10, 0x3a, 0xfb, 0xd6, 0xb4, 0x34, 0xd4, 0x8a, 0x38, 0xe7, 0x0e, 0x5d, 0x90, 0x35, 0x1a,
+	0xf5, 0xbe, 0x44, 0x23, 0x4a, 0x31, 0xa3, 0x5a, 0xb7, 0xdf, 0x1d, 0xac, 0xef, 0x18, 0xcb, 0xd1,
+	0x9f, 0x72, 0x9d, 0xbd, 0xfa, 0xa8, 0x34, 0x56, 0x2a, 0x8e, 0x30, 0x50, 0xf5, 0x4b, 0x70, 0xf3,
+	0x9b, 0x82, 0x15, 0x39, 0xf6, 0x04, 0x2e, 0x24, 0xc7, 0x38, 0x4f, 0x49, 0xae, 0xad, 0x8a, 0xda,
+	0x8c, 0x69, 0x69, 0x6c, 0x55, 0x99, 0x2c, 0x52, 0x41, 0x57, 0xad, 0xcc, 0x3c, 0xc2, 0x03, 0x69,
+	0x54, 0x1d, 0x70, 0x5d, 0xa8, 0x7c, 0x92, 0xb2, 0x9c, 0xc4, 0x31, 0xce, 0xb5, 0x2b, 0x82, 0xa6,
+	0x4f, 0x4b, 0xe3, 0xd6, 0x5c, 0x5d, 0x33, 0x01, 0x74, 0x37, 0xb8, 0xc5, 0x99, 0x19, 0x76, 0xc1,
+	0x1b, 0x0b, 0xdb, 0xef, 0x62, 0x9a, 0x91, 0x94, 0x62, 0xf5, 0x4d, 0x70, 0x55, 0x40, 0xa2, 0x40,
+	0xec, 0xc3, 0xaa, 0x0d, 0xce, 0x4b, 0x63, 0x8d, 0x4b, 0xf6, 0x76, 0xdd, 0x35, 0xbe, 0xb4, 0x17,
+	0xc0, 0xbf, 0x15, 0x60, 0xed, 0xd3, 0x70, 0x3f, 0x0a, 0x73, 0xc4, 0xf0, 0xe1, 0x18, 0xe5, 0x98,
+	0x7e, 0x45, 0xee, 0x17, 0x71, 0xec, 0xa2, 0x34, 0xc4, 0x0e, 0x49, 0x7d, 0x9c, 0x32, 0xbe, 0x16,
+	0x1c, 0x10, 0x1a, 0xb1, 0x88, 0xa4, 0x6d, 0xf6, 0x37, 0x04, 0x9b, 0x54, 0x30, 0x3d, 0x46, 0xbc,
+	0xa4, 0x0a, 0x22, 0x77, 0xf9, 0xb6, 0x59, 0x0d, 0x98, 0xc9, 0x07, 0xac, 0xd9, 0x09, 0x87, 0x44,
+	0xa9, 0xdd, 0xe7, 0x9b, 0x30, 0x2d, 0x0d, 0x4d, 0x42, 0x9f, 0x26, 0x40, 0xf7, 0x3a, 0x95, 0x99,
+	0xca, 0xc4, 0xd5, 0x01, 0xb8, 0x21, 0x8b, 0xf5, 0x70, 0xca, 0x70, 0x1e, 0xa5, 0xa1, 0xd6, 0xe5,
+	0x55, 0x57, 0x7d, 0xdb, 0x0b, 0x3e, 0x93, 0x56, 0xf8, 0x67, 0x07, 0x7c, 0xd4, 0xb2, 0xe2, 0xa6,
+	0xa5, 0x0f, 0xc1, 0x55, 0x94, 0x90, 0x22, 0x65, 0x1f, 0xc8, 0xd2, 0x3f, 0xe1, 0x99, 0xfe, 0x5e,
+	0x1a, 0x6f, 0x87, 0x11, 0x1b, 0x17, 0x23, 0xd3, 0x27, 0x89, 0x25, 0xcf, 0x4d, 0xf5, 0xb3, 0x4d,
+	0x83, 0x6f, 0x2d, 0x76, 0x96, 0x61, 0x6a, 0xee, 0xa5, 0x6c, 0x5a, 0x1a, 0x1b, 0x55, 0x4d, 0x12,
+	0x03, 0xdd, 0x1a, 0x38, 0x63, 0x0f, 0xb5, 0xce, 0x65, 0xb0, 0x87, 0x0d, 0x7b, 0xa8, 0x9e, 0x80,
+	0xcd, 0x38, 0xfa, 0xae, 0x88, 0x82, 0x88, 0x9d, 0x79, 0xbe, 0x18, 0x99, 0x40, 0xb4, 0xe7, 0x9a,
+	0xfd, 0x79, 0x8b, 0x28, 0xbb, 0xd8, 0x9f, 0xed, 0xca, 0x05, 0x20, 0x74, 0x6f, 0x34, 0x36, 0x47,
+	0x9a, 0xbe, 0xef, 0x88, 0x29, 0x3d, 0xf4, 0xc7, 0x38, 0x28, 0x62, 0x7c, 0x98, 0x10, 0xc2, 0xc6,
+	0x5f, 0xe3, 0x28, 0x1c, 0x33, 0x67, 0xcc, 0xdb, 0xdd, 0x66, 0x98, 0xe6, 0x06, 0xba, 0xb3, 0x6c,
+	0xa0, 0xd5, 0x9f, 0x14, 0xb0, 0x45, 0x45, 0x18, 0xef, 0x44, 0xc4, 0xf1, 0x7c, 0x11, 0xa8, 0xbe,
+	0x62, 0xba, 0x62, 0xf8, 0xcc, 0xc5, 0xf7, 0xc0, 0xc5, 0xfc, 0xe4, 0x85, 0xf3, 0x9e, 0x9c, 0x48,
+	0x28, 0x33, 0x5b, 0x1e, 0x00, 0xba, 0x1a, 0x5d, 0x42, 0x81, 0xef, 0x80, 0xb7, 0x9e, 0xd9, 0x8c,
+	0x7a, 0xce, 0xe0, 0x2f, 0x0a, 0xd8, 0xe4, 0x4a, 0xcc, 0x0e, 0x4f, 0x50, 0x46, 0x0f, 0x50, 0x41,
+	0x71, 0x70, 0xe9, 0xad, 0xba, 0x0b, 0x5e, 0xa6, 0x1c, 0xef, 0x65, 0x82, 0x2f, 0x5a, 0xf3, 0x92,
+	0xfd, 0xfa, 0xb4, 0x34, 0x5e, 0x95, 0xd4, 0xb9, 0x55, 0xe8, 0xae, 0xd3, 0x59, 0x2e, 0x70, 0x0b,
+	0xdc, 0xbe, 0x90, 0x60, 0x9d, 0xfe, 0xce, 0xe4, 0x0a, 0xe8, 0xee, 0xd3, 0x50, 0xfd, 0x59, 0x01,
+	0xea, 0x82, 0xf7, 0xc3, 0x3d, 0xf3, 0xff, 0xbe, 0xd0, 0xcc, 0x85, 0x37, 0x9c, 0xfe, 0xe0, 0x39,
+	0x01, 0xcd, 0x79, 0xfe, 0x4b, 0x01, 0xef, 0xb7, 0xba, 0xfa, 0x8e, 0x5a, 0x45, 0x6e, 0x83, 0xd6,
+	0xd1, 0x0b, 0x43, 0x37, 0xe5, 0xfe, 0xaa, 0x00, 0xfd, 0x19, 0x47, 0xb1, 0x5d, 0x5b, 0x97, 0x83,
+	0xf4, 0x2f, 0x2e, 0x09, 0xd4, 0x24, 0xfe, 0xa3, 0x02, 0x36, 0x9e, 0x3a, 0x0c, 0x1f, 0xb7, 0x8b,
+	0xf1, 0x84, 0xb3, 0xee, 0x3c, 0x87, 0x73, 0x9d, 0x94, 0x7d, 0xf4, 0xe8, 0xbc, 0xa7, 0x3c, 0x3e,
+	0xef, 0x29, 0x7f, 0x9c, 0xf7, 0x94, 0x1f, 0x26, 0xbd, 0x95, 0xc7, 0x93, 0xde, 0xca, 0x6f, 0x93,
+	0xde, 0xca, 0xc3, 0x7b, 0x73, 0x77, 0xa9, 0x0c, 0xb4, 0x1d, 0xa3, 0x11, 0xad, 0xff, 0x58, 0xc7,
+	0xc3, 0x3b, 0xd6, 0xe9, 0xf2, 0xcf, 0xb1, 0xd1, 0x9a, 0xf8, 0xc4, 0xfa, 0xf0, 0xdf, 0x01, 0x00,
+	0xe2, 0x16, 0x4f, 0x75, 0x29, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -325,6 +552,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateBalancerPool(ctx context.Context, in *MsgCreateBalancerPool, opts ...grpc.CallOption) (*MsgCreateBalancerPoolResponse, error)
 	MigrateSharesToFullRangeConcentratedPosition(ctx context.Context, in *MsgMigrateSharesToFullRangeConcentratedPosition, opts ...grpc.CallOption) (*MsgMigrateSharesToFullRangeConcentratedPositionResponse, error)
+	ScheduleSmoothWeightChange(ctx context.Context, in *MsgScheduleSmoothWeightChange, opts ...grpc.CallOption) (*MsgScheduleSmoothWeightChangeResponse, error)
+	SetSwapsPaused(ctx context.Context, in *MsgSetSwapsPaused, opts ...grpc.CallOption) (*MsgSetSwapsPausedResponse, error)
 }
 
 type msgClient struct {
@@ -353,10 +582,30 @@ func (c *msgClient) MigrateSharesToFullRangeConcentratedPosition(ctx context.Con
 	return out, nil
 }
 
+func (c *msgClient) ScheduleSmoothWeightChange(ctx context.Context, in *MsgScheduleSmoothWeightChange, opts ...grpc.CallOption) (*MsgScheduleSmoothWeightChangeResponse, error) {
+	out := new(MsgScheduleSmoothWeightChangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/ScheduleSmoothWeightChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSwapsPaused(ctx context.Context, in *MsgSetSwapsPaused, opts ...grpc.CallOption) (*MsgSetSwapsPausedResponse, error) {
+	out := new(MsgSetSwapsPausedResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/SetSwapsPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateBalancerPool(context.Context, *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error)
 	MigrateSharesToFullRangeConcentratedPosition(context.Context, *MsgMigrateSharesToFullRangeConcentratedPosition) (*MsgMigrateSharesToFullRangeConcentratedPositionResponse, error)
+	ScheduleSmoothWeightChange(context.Context, *MsgScheduleSmoothWeightChange) (*MsgScheduleSmoothWeightChangeResponse, error)
+	SetSwapsPaused(context.Context, *MsgSetSwapsPaused) (*MsgSetSwapsPausedResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -369,6 +618,12 @@ func (*UnimplementedMsgServer) CreateBalancerPool(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) MigrateSharesToFullRangeConcentratedPosition(ctx context.Context, req *MsgMigrateSharesToFullRangeConcentratedPosition) (*MsgMigrateSharesToFullRangeConcentratedPositionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MigrateSharesToFullRangeConcentratedPosition not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleSmoothWeightChange(ctx context.Context, req *MsgScheduleSmoothWeightChange) (*MsgScheduleSmoothWeightChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleSmoothWeightChange not implemented")
+}
+func (*UnimplementedMsgServer) SetSwapsPaused(ctx context.Context, req *MsgSetSwapsPaused) (*MsgSetSwapsPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSwapsPaused not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -410,6 +665,42 @@ func _Msg_MigrateSharesToFullRangeConcentratedPosition_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleSmoothWeightChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleSmoothWeightChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleSmoothWeightChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/ScheduleSmoothWeightChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleSmoothWeightChange(ctx, req.(*MsgScheduleSmoothWeightChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSwapsPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSwapsPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSwapsPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/SetSwapsPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSwapsPaused(ctx, req.(*MsgSetSwapsPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.balancer.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -422,6 +713,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MigrateSharesToFullRangeConcentratedPosition",
 			Handler:    _Msg_MigrateSharesToFullRangeConcentratedPosition_Handler,
 		},
+		{
+			MethodName: "ScheduleSmoothWeightChange",
+			Handler:    _Msg_ScheduleSmoothWeightChange_Handler,
+		},
+		{
+			MethodName: "SetSwapsPaused",
+			Handler:    _Msg_SetSwapsPaused_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/balancer/tx/tx.proto",
@@ -447,6 +746,13 @@ func (m *MsgCreateBalancerPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolController) > 0 {
+		i -= len(m.PoolController)
+		copy(dAtA[i:], m.PoolController)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolController)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.FuturePoolGovernor) > 0 {
 		i -= len(m.FuturePoolGovernor)
 		copy(dAtA[i:], m.FuturePoolGovernor)
@@ -616,54 +922,194 @@ func (m *MsgMigrateSharesToFullRangeConcentratedPositionResponse) MarshalToSized
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgScheduleSmoothWeightChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateBalancerPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgScheduleSmoothWeightChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleSmoothWeightChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolParams != nil {
-		l = m.PoolParams.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.PoolAssets) > 0 {
-		for _, e := range m.PoolAssets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.FuturePoolGovernor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBalancerPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScheduleSmoothWeightChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleSmoothWeightChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleSmoothWeightChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovTx(uint64(m.PoolID))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSwapsPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSwapsPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSwapsPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapsPaused {
+		i--
+		if m.SwapsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSwapsPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSwapsPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSwapsPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateBalancerPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolParams != nil {
+		l = m.PoolParams.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PoolAssets) > 0 {
+		for _, e := range m.PoolAssets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.FuturePoolGovernor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PoolController)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateBalancerPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	return n
 }
 
 func (m *MsgMigrateSharesToFullRangeConcentratedPosition) Size() (n int) {
@@ -699,6 +1145,61 @@ func (m *MsgMigrateSharesToFullRangeConcentratedPositionResponse) Size() (n int)
 	return n
 }
 
+func (m *MsgScheduleSmoothWeightChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = m.SmoothWeightChangeParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleSmoothWeightChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSwapsPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if m.SwapsPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetSwapsPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -868,6 +1369,38 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 			}
 			m.FuturePoolGovernor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolController", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolController = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1244,6 +1777,361 @@ func (m *MsgMigrateSharesToFullRangeConcentratedPositionResponse) Unmarshal(dAtA
 	}
 	return nil
 }
+func (m *MsgScheduleSmoothWeightChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleSmoothWeightChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleSmoothWeightChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SmoothWeightChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleSmoothWeightChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleSmoothWeightChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleSmoothWeightChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSwapsPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSwapsPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSwapsPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapsPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSwapsPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSwapsPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSwapsPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package stableswap
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -11,6 +13,7 @@ import (
 const (
 	TypeMsgCreateStableswapPool           = "create_stableswap_pool"
 	TypeMsgStableSwapAdjustScalingFactors = "stable_swap_adjust_scaling_factors"
+	TypeMsgStableSwapAdjustFees           = "stable_swap_adjust_fees"
 )
 
 var (
@@ -168,3 +171,48 @@ func (msg MsgStableSwapAdjustScalingFactors) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{scalingFactorGovernor}
 }
+
+var _ sdk.Msg = &MsgStableSwapAdjustFees{}
+
+func NewMsgStableSwapAdjustFees(
+	sender string,
+	poolID uint64,
+	swapFee sdk.Dec,
+	exitFee sdk.Dec,
+) MsgStableSwapAdjustFees {
+	return MsgStableSwapAdjustFees{
+		Sender:  sender,
+		PoolID:  poolID,
+		SwapFee: swapFee,
+		ExitFee: exitFee,
+	}
+}
+
+func (msg MsgStableSwapAdjustFees) Route() string { return types.RouterKey }
+func (msg MsgStableSwapAdjustFees) Type() string  { return TypeMsgStableSwapAdjustFees }
+func (msg MsgStableSwapAdjustFees) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.SwapFee.IsNil() || msg.ExitFee.IsNil() {
+		return fmt.Errorf("swap fee and exit fee must be set")
+	}
+
+	poolParams := PoolParams{SwapFee: msg.SwapFee, ExitFee: msg.ExitFee}
+	return poolParams.Validate()
+}
+
+func (msg MsgStableSwapAdjustFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapAdjustFees) GetSigners() []sdk.AccAddress {
+	scalingFactorController, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorController}
+}
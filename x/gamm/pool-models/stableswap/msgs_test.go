@@ -296,6 +296,79 @@ func TestMsgCreateStableswapPoolValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgStableSwapAdjustFeesValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	invalidAddr := sdk.AccAddress("invalid")
+
+	default_msg := stableswap.NewMsgStableSwapAdjustFees(addr1.String(), 1, sdk.NewDecWithPrec(1, 2), sdk.ZeroDec())
+	updateMsg := func(f func(msg stableswap.MsgStableSwapAdjustFees) stableswap.MsgStableSwapAdjustFees) stableswap.MsgStableSwapAdjustFees {
+		return f(default_msg)
+	}
+
+	require.Equal(t, default_msg.Route(), types.RouterKey)
+	require.Equal(t, default_msg.Type(), "stable_swap_adjust_fees")
+	signers := default_msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        stableswap.MsgStableSwapAdjustFees
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapAdjustFees) stableswap.MsgStableSwapAdjustFees {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapAdjustFees) stableswap.MsgStableSwapAdjustFees {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "unset swap fee",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapAdjustFees) stableswap.MsgStableSwapAdjustFees {
+				msg.SwapFee = sdk.Dec{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative swap fee",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapAdjustFees) stableswap.MsgStableSwapAdjustFees {
+				msg.SwapFee = sdk.NewDecWithPrec(-1, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "exit fee of one",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapAdjustFees) stableswap.MsgStableSwapAdjustFees {
+				msg.ExitFee = sdk.OneDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func (suite *TestSuite) TestMsgCreateStableswapPool() {
 	suite.SetupTest()
 
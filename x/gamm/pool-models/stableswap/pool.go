@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -415,6 +416,70 @@ func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, sende
 	return nil
 }
 
+// RampScalingFactors moves the pool's scaling factors linearly to the given scaling factors over rampDuration,
+// starting at the current block time and replacing any ramp in progress. A zero rampDuration sets them immediately.
+// It should only be able to be successfully called by the pool's ScalingFactorGovernor
+func (p *Pool) RampScalingFactors(ctx sdk.Context, scalingFactors []uint64, sender string, rampDuration time.Duration) error {
+	initialScalingFactors := p.ScalingFactors
+	if err := p.SetScalingFactors(ctx, scalingFactors, sender); err != nil {
+		return err
+	}
+
+	if rampDuration <= 0 {
+		p.ScalingFactorRamp = nil
+		return nil
+	}
+
+	p.ScalingFactorRamp = &ScalingFactorRamp{
+		InitialScalingFactors: initialScalingFactors,
+		TargetScalingFactors:  p.ScalingFactors,
+		StartTime:             ctx.BlockTime(),
+		Duration:              rampDuration,
+	}
+	p.ScalingFactors = initialScalingFactors
+	return nil
+}
+
+// PokePool moves the pool's scaling factors along its scaling factor ramp, if any.
+// Once the ramp is over, the scaling factors are set to the target scaling factors and the ramp is removed.
+func (p *Pool) PokePool(blockTime time.Time) {
+	ramp := p.ScalingFactorRamp
+	if ramp == nil {
+		return
+	}
+
+	elapsed := blockTime.Sub(ramp.StartTime)
+	switch {
+	case elapsed <= 0:
+		return
+	case elapsed >= ramp.Duration:
+		p.ScalingFactors = ramp.TargetScalingFactors
+		p.ScalingFactorRamp = nil
+		return
+	}
+
+	// s(t) = initial + (target - initial) * elapsed / duration
+	scalingFactors := make([]uint64, len(ramp.TargetScalingFactors))
+	for i := range scalingFactors {
+		initial := sdk.NewIntFromUint64(ramp.InitialScalingFactors[i])
+		target := sdk.NewIntFromUint64(ramp.TargetScalingFactors[i])
+		delta := target.Sub(initial).Mul(sdk.NewInt(int64(elapsed))).Quo(sdk.NewInt(int64(ramp.Duration)))
+		scalingFactors[i] = initial.Add(delta).Uint64()
+	}
+	p.ScalingFactors = scalingFactors
+}
+
+// SetFees sets the swap and exit fees of the pool.
+func (p *Pool) SetFees(swapFee, exitFee sdk.Dec) error {
+	poolParams := PoolParams{SwapFee: swapFee, ExitFee: exitFee}
+	if err := poolParams.Validate(); err != nil {
+		return err
+	}
+
+	p.PoolParams = poolParams
+	return nil
+}
+
 func validateScalingFactorController(scalingFactorController string) error {
 	if len(scalingFactorController) == 0 {
 		return nil
@@ -3,6 +3,7 @@ package stableswap
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -1197,6 +1198,179 @@ func TestSetScalingFactors(t *testing.T) {
 	}
 }
 
+func TestRampScalingFactors(t *testing.T) {
+	pk := ed25519.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pk.Address())
+
+	failPk := ed25519.GenPrivKey().PubKey()
+	failAddr := sdk.AccAddress(failPk.Address())
+
+	blockTime := time.Unix(1_000_000, 0).UTC()
+	initialScalingFactors := []uint64{1, 1}
+	targetScalingFactors := []uint64{2, 1}
+	expectedTargetScalingFactors, _ := applyScalingFactorMultiplier(targetScalingFactors)
+	expectedInitialScalingFactors, _ := applyScalingFactorMultiplier(initialScalingFactors)
+
+	tests := map[string]struct {
+		sender                 string
+		rampDuration           time.Duration
+		expectedScalingFactors []uint64
+		expectedRamp           *ScalingFactorRamp
+		expError               error
+	}{
+		"zero ramp duration sets scaling factors immediately": {
+			sender:                 addr.String(),
+			rampDuration:           0,
+			expectedScalingFactors: expectedTargetScalingFactors,
+		},
+		"positive ramp duration schedules a ramp": {
+			sender:                 addr.String(),
+			rampDuration:           time.Hour,
+			expectedScalingFactors: expectedInitialScalingFactors,
+			expectedRamp: &ScalingFactorRamp{
+				InitialScalingFactors: expectedInitialScalingFactors,
+				TargetScalingFactors:  expectedTargetScalingFactors,
+				StartTime:             blockTime,
+				Duration:              time.Hour,
+			},
+		},
+		"sender is not scaling factor governor": {
+			sender:                 failAddr.String(),
+			rampDuration:           time.Hour,
+			expectedScalingFactors: expectedInitialScalingFactors,
+			expError:               types.ErrNotScalingFactorGovernor,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithBlockTime(blockTime)
+			pool := poolStructFromAssets(twoUnevenStablePoolAssets, initialScalingFactors)
+			pool.ScalingFactorController = addr.String()
+
+			err := pool.RampScalingFactors(ctx, targetScalingFactors, tc.sender, tc.rampDuration)
+			if tc.expError != nil {
+				require.ErrorIs(t, err, tc.expError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedScalingFactors, pool.ScalingFactors)
+			require.Equal(t, tc.expectedRamp, pool.ScalingFactorRamp)
+		})
+	}
+}
+
+func TestPokePoolScalingFactorRamp(t *testing.T) {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	ramp := ScalingFactorRamp{
+		InitialScalingFactors: []uint64{100, 400},
+		TargetScalingFactors:  []uint64{300, 200},
+		StartTime:             startTime,
+		Duration:              100 * time.Second,
+	}
+
+	tests := map[string]struct {
+		blockTime              time.Time
+		expectedScalingFactors []uint64
+		expectRampRemoved      bool
+	}{
+		"before ramp start": {
+			blockTime:              startTime.Add(-time.Second),
+			expectedScalingFactors: []uint64{100, 400},
+		},
+		"at ramp start": {
+			blockTime:              startTime,
+			expectedScalingFactors: []uint64{100, 400},
+		},
+		"quarter of the way through ramp": {
+			blockTime:              startTime.Add(25 * time.Second),
+			expectedScalingFactors: []uint64{150, 350},
+		},
+		"halfway through ramp": {
+			blockTime:              startTime.Add(50 * time.Second),
+			expectedScalingFactors: []uint64{200, 300},
+		},
+		"interpolation rounds towards initial scaling factors": {
+			blockTime:              startTime.Add(1*time.Second + 500*time.Millisecond),
+			expectedScalingFactors: []uint64{103, 397},
+		},
+		"at ramp end": {
+			blockTime:              startTime.Add(100 * time.Second),
+			expectedScalingFactors: []uint64{300, 200},
+			expectRampRemoved:      true,
+		},
+		"after ramp end": {
+			blockTime:              startTime.Add(time.Hour),
+			expectedScalingFactors: []uint64{300, 200},
+			expectRampRemoved:      true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rampCopy := ramp
+			pool := Pool{
+				ScalingFactors:    ramp.InitialScalingFactors,
+				ScalingFactorRamp: &rampCopy,
+			}
+
+			pool.PokePool(tc.blockTime)
+
+			require.Equal(t, tc.expectedScalingFactors, pool.ScalingFactors)
+			if tc.expectRampRemoved {
+				require.Nil(t, pool.ScalingFactorRamp)
+			} else {
+				require.Equal(t, &ramp, pool.ScalingFactorRamp)
+			}
+		})
+	}
+
+	// a pool without a ramp is left untouched
+	pool := Pool{ScalingFactors: []uint64{1, 1}}
+	pool.PokePool(startTime)
+	require.Equal(t, []uint64{1, 1}, pool.ScalingFactors)
+}
+
+func TestSetFees(t *testing.T) {
+	tests := map[string]struct {
+		swapFee     sdk.Dec
+		exitFee     sdk.Dec
+		expectedErr error
+	}{
+		"valid fees": {
+			swapFee: sdk.MustNewDecFromStr("0.003"),
+			exitFee: sdk.MustNewDecFromStr("0.001"),
+		},
+		"zero fees": {
+			swapFee: sdk.ZeroDec(),
+			exitFee: sdk.ZeroDec(),
+		},
+		"negative swap fee": {
+			swapFee:     sdk.NewDec(-1),
+			exitFee:     sdk.ZeroDec(),
+			expectedErr: types.ErrNegativeSwapFee,
+		},
+		"exit fee too large": {
+			swapFee:     sdk.ZeroDec(),
+			exitFee:     sdk.OneDec(),
+			expectedErr: types.ErrTooMuchExitFee,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors)
+
+			err := pool.SetFees(tc.swapFee, tc.exitFee)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Equal(t, defaultStableswapPoolParams, pool.PoolParams)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.swapFee, pool.GetSwapFee(sdk.Context{}))
+			require.Equal(t, tc.exitFee, pool.GetExitFee(sdk.Context{}))
+		})
+	}
+}
+
 func TestStableswapSpotPrice(t *testing.T) {
 	type testcase struct {
 		baseDenom      string
@@ -7,20 +7,23 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -70,6 +73,77 @@ func (m *PoolParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PoolParams proto.InternalMessageInfo
 
+// ScalingFactorRamp is a linear change of a stableswap pool's scaling factors
+// from initial_scaling_factors to target_scaling_factors, starting at
+// start_time and lasting duration.
+type ScalingFactorRamp struct {
+	InitialScalingFactors []uint64      `protobuf:"varint,1,rep,packed,name=initial_scaling_factors,json=initialScalingFactors,proto3" json:"initial_scaling_factors,omitempty" yaml:"initial_scaling_factors"`
+	TargetScalingFactors  []uint64      `protobuf:"varint,2,rep,packed,name=target_scaling_factors,json=targetScalingFactors,proto3" json:"target_scaling_factors,omitempty" yaml:"target_scaling_factors"`
+	StartTime             time.Time     `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	Duration              time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+}
+
+func (m *ScalingFactorRamp) Reset()         { *m = ScalingFactorRamp{} }
+func (m *ScalingFactorRamp) String() string { return proto.CompactTextString(m) }
+func (*ScalingFactorRamp) ProtoMessage()    {}
+func (*ScalingFactorRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae0f054436f9999a, []int{1}
+}
+func (m *ScalingFactorRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingFactorRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScalingFactorRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScalingFactorRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingFactorRamp.Merge(m, src)
+}
+func (m *ScalingFactorRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingFactorRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingFactorRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalingFactorRamp proto.InternalMessageInfo
+
+func (m *ScalingFactorRamp) GetInitialScalingFactors() []uint64 {
+	if m != nil {
+		return m.InitialScalingFactors
+	}
+	return nil
+}
+
+func (m *ScalingFactorRamp) GetTargetScalingFactors() []uint64 {
+	if m != nil {
+		return m.TargetScalingFactors
+	}
+	return nil
+}
+
+func (m *ScalingFactorRamp) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ScalingFactorRamp) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
 // Pool is the stableswap Pool struct
 type Pool struct {
 	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
@@ -85,19 +159,21 @@ type Pool struct {
 	// would need to be locked up to count in governance. 0w means no lockup.
 	FuturePoolGovernor string `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
 	// sum of all LP shares
-	TotalShares types.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+	TotalShares types1.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
 	// assets in the pool
 	PoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=pool_liquidity,json=poolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_liquidity"`
 	// for calculation amognst assets with different precisions
 	ScalingFactors []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factors"`
 	// scaling_factor_controller is the address can adjust pool scaling factors
 	ScalingFactorController string `protobuf:"bytes,8,opt,name=scaling_factor_controller,json=scalingFactorController,proto3" json:"scaling_factor_controller,omitempty" yaml:"scaling_factor_controller"`
+	// scaling_factor_ramp is the change of scaling factors in progress, if any
+	ScalingFactorRamp *ScalingFactorRamp `protobuf:"bytes,9,opt,name=scaling_factor_ramp,json=scalingFactorRamp,proto3" json:"scaling_factor_ramp,omitempty" yaml:"scaling_factor_ramp"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
 func (*Pool) ProtoMessage() {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae0f054436f9999a, []int{1}
+	return fileDescriptor_ae0f054436f9999a, []int{2}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,6 +204,7 @@ var xxx_messageInfo_Pool proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.PoolParams")
+	proto.RegisterType((*ScalingFactorRamp)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.ScalingFactorRamp")
 	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.Pool")
 }
 
@@ -136,48 +213,58 @@ func init() {
 }
 
 var fileDescriptor_ae0f054436f9999a = []byte{
-	// 642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0xdb, 0xb4, 0x29, 0x5b, 0x48, 0x85, 0xa9, 0x84, 0xdb, 0x0a, 0x6f, 0xb0, 0x28, 0x8a,
-	0x10, 0xb1, 0x29, 0x20, 0x24, 0x7a, 0x6b, 0x8a, 0x8a, 0x90, 0x10, 0x2a, 0xe6, 0xc4, 0x8f, 0x14,
-	0xd6, 0xf6, 0xc6, 0x5d, 0x61, 0x67, 0xcd, 0xee, 0xba, 0xb4, 0x17, 0xce, 0x1c, 0x39, 0x72, 0xec,
-	0x19, 0xae, 0x3c, 0x44, 0xc5, 0xa9, 0x47, 0xc4, 0xc1, 0xa0, 0xf6, 0x0d, 0xf2, 0x04, 0x68, 0xd7,
-	0xeb, 0xfe, 0x04, 0xa8, 0x2a, 0x71, 0xca, 0xce, 0xcc, 0x37, 0xdf, 0xcc, 0x37, 0x33, 0x31, 0xb8,
-	0x4f, 0x79, 0x4a, 0x39, 0xe1, 0x5e, 0x8c, 0xd2, 0xd4, 0xcb, 0x28, 0x4d, 0x3a, 0x29, 0x8d, 0x70,
-	0xc2, 0x3d, 0x2e, 0x50, 0x90, 0x60, 0xfe, 0x0e, 0x65, 0xc7, 0x9e, 0x3d, 0x89, 0x70, 0x33, 0x46,
-	0x05, 0x35, 0x6f, 0xe8, 0x54, 0x57, 0xa6, 0xba, 0x32, 0x50, 0x66, 0xba, 0x47, 0x70, 0x77, 0x73,
-	0x29, 0xc0, 0x02, 0x2d, 0xcd, 0xcf, 0x85, 0x0a, 0xdc, 0x53, 0x99, 0x5e, 0x69, 0x94, 0x34, 0xf3,
-	0xb3, 0x31, 0x8d, 0x69, 0xe9, 0x97, 0x2f, 0xed, 0xb5, 0x63, 0x4a, 0xe3, 0x04, 0x7b, 0xca, 0x0a,
-	0xf2, 0xbe, 0x17, 0xe5, 0x0c, 0x09, 0x42, 0x07, 0x3a, 0x0e, 0x47, 0xe3, 0x82, 0xa4, 0x98, 0x0b,
-	0x94, 0x66, 0x15, 0x41, 0x59, 0xc4, 0x43, 0xb9, 0xd8, 0xf0, 0x74, 0x1b, 0xca, 0x18, 0x89, 0x07,
-	0x88, 0xe3, 0xc3, 0x78, 0x48, 0x89, 0x2e, 0xe0, 0xec, 0x1a, 0x00, 0xac, 0x53, 0x9a, 0xac, 0x23,
-	0x86, 0x52, 0x6e, 0xbe, 0x02, 0x53, 0x4a, 0x7f, 0x1f, 0x63, 0xcb, 0x68, 0x19, 0xed, 0x73, 0xdd,
-	0x95, 0xdd, 0x02, 0xd6, 0x7e, 0x14, 0xf0, 0x7a, 0x4c, 0xc4, 0x46, 0x1e, 0xb8, 0x21, 0x4d, 0xb5,
-	0x30, 0xfd, 0xd3, 0xe1, 0xd1, 0x1b, 0x4f, 0x6c, 0x67, 0x98, 0xbb, 0x0f, 0x70, 0x38, 0x2c, 0xe0,
-	0xcc, 0x36, 0x4a, 0x93, 0x65, 0xa7, 0xe2, 0x71, 0xfc, 0x86, 0x7c, 0xae, 0x61, 0x2c, 0xd9, 0xf1,
-	0x16, 0x11, 0x8a, 0x7d, 0xec, 0xff, 0xd8, 0x2b, 0x1e, 0xc7, 0x6f, 0xc8, 0xe7, 0x1a, 0xc6, 0xce,
-	0x97, 0x09, 0x50, 0x97, 0x52, 0xcc, 0x9b, 0xa0, 0x81, 0xa2, 0x88, 0x61, 0xce, 0xb5, 0x06, 0x73,
-	0x58, 0xc0, 0x66, 0x99, 0xa7, 0x03, 0x8e, 0x5f, 0x41, 0xcc, 0x26, 0x18, 0x23, 0x91, 0x6a, 0xa7,
-	0xee, 0x8f, 0x91, 0xc8, 0x7c, 0x0f, 0xa6, 0xe5, 0x92, 0x7b, 0x99, 0x9a, 0x88, 0x35, 0xde, 0x32,
-	0xda, 0xd3, 0xb7, 0xef, 0xb9, 0x67, 0xbf, 0x02, 0xf7, 0x68, 0x9e, 0xdd, 0x45, 0xa9, 0x6f, 0x58,
-	0xc0, 0x2b, 0x7a, 0x26, 0x27, 0x2f, 0x4c, 0xd7, 0x70, 0x7c, 0x90, 0x1d, 0xad, 0xe0, 0x29, 0x98,
-	0xed, 0xe7, 0x22, 0x67, 0xb8, 0x84, 0xc4, 0x74, 0x13, 0xb3, 0x01, 0x65, 0x56, 0x5d, 0x49, 0x81,
-	0xc3, 0x02, 0x2e, 0x94, 0x64, 0x7f, 0x43, 0x39, 0xbe, 0x59, 0xba, 0x65, 0x0f, 0x0f, 0xb5, 0xd3,
-	0x7c, 0x0e, 0xce, 0x0b, 0x2a, 0x50, 0xd2, 0xe3, 0x1b, 0x88, 0x61, 0x6e, 0x4d, 0x28, 0x4d, 0x73,
-	0xae, 0x3e, 0x50, 0x79, 0x1b, 0x87, 0xcd, 0xaf, 0x52, 0x32, 0xe8, 0x2e, 0xe8, 0xb6, 0x2f, 0x95,
-	0x95, 0x8e, 0x27, 0x3b, 0xfe, 0xb4, 0x32, 0x9f, 0x29, 0xcb, 0x64, 0xa0, 0xa9, 0x1a, 0x48, 0xc8,
-	0xdb, 0x9c, 0x44, 0x44, 0x6c, 0x5b, 0x93, 0xad, 0xf1, 0xd3, 0xc9, 0x6f, 0x49, 0xf2, 0xcf, 0x3f,
-	0x61, 0xfb, 0x0c, 0x3b, 0x97, 0x09, 0xdc, 0xbf, 0x20, 0x4b, 0x3c, 0xae, 0x2a, 0x98, 0x4f, 0xc0,
-	0x0c, 0x0f, 0x51, 0x42, 0x06, 0x71, 0xaf, 0x8f, 0x42, 0x41, 0x19, 0xb7, 0x1a, 0xad, 0xf1, 0x76,
-	0xbd, 0xbb, 0x38, 0x2c, 0xe0, 0xd5, 0x3f, 0x26, 0x3d, 0x82, 0x75, 0xfc, 0xa6, 0xf6, 0xac, 0x95,
-	0x0e, 0xf3, 0x35, 0x98, 0x3b, 0x89, 0xe9, 0x85, 0x74, 0x20, 0x18, 0x4d, 0x12, 0xcc, 0xac, 0x29,
-	0x35, 0xf6, 0x6b, 0xc3, 0x02, 0xb6, 0x34, 0xf3, 0xbf, 0xa0, 0x8e, 0x7f, 0xf9, 0x04, 0xf1, 0xea,
-	0x61, 0x64, 0xf9, 0xe2, 0x87, 0x1d, 0x58, 0xfb, 0xb4, 0x03, 0x6b, 0xdf, 0xbe, 0x76, 0x26, 0xe4,
-	0x6a, 0x1e, 0x75, 0x5f, 0xee, 0xee, 0xdb, 0xc6, 0xde, 0xbe, 0x6d, 0xfc, 0xda, 0xb7, 0x8d, 0x8f,
-	0x07, 0x76, 0x6d, 0xef, 0xc0, 0xae, 0x7d, 0x3f, 0xb0, 0x6b, 0x2f, 0x56, 0x8e, 0xcd, 0x45, 0x5f,
-	0x5d, 0x27, 0x41, 0x01, 0xaf, 0x0c, 0x6f, 0x73, 0xe9, 0xae, 0xb7, 0x75, 0xda, 0x97, 0x2c, 0x98,
-	0x54, 0x7f, 0xee, 0x3b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xc3, 0x9c, 0x8a, 0xf7, 0x04,
-	0x00, 0x00,
+	// 814 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0x34, 0xdb, 0x34, 0x53, 0xc8, 0x2a, 0xde, 0xc2, 0xba, 0x59, 0xd5, 0x93, 0x1d,
+	0xb1, 0x28, 0x42, 0xc4, 0xa6, 0x80, 0x90, 0x58, 0x89, 0xc3, 0x7a, 0x57, 0x45, 0x48, 0x08, 0x2d,
+	0x5e, 0x24, 0x60, 0x41, 0x0a, 0x93, 0x78, 0xe2, 0x8e, 0xb0, 0x33, 0xc6, 0x33, 0x29, 0xdb, 0x0b,
+	0x67, 0x2e, 0x48, 0x7b, 0xec, 0xb1, 0x67, 0xce, 0xdc, 0xf8, 0x07, 0x2a, 0x4e, 0x3d, 0x22, 0x0e,
+	0x2e, 0x6a, 0xff, 0x03, 0x1f, 0x38, 0xa3, 0x19, 0x8f, 0xf3, 0xb3, 0xad, 0x8a, 0x38, 0x65, 0x66,
+	0xde, 0xf7, 0x7d, 0xe6, 0xf9, 0xf9, 0xfb, 0x1c, 0xf0, 0x21, 0xe3, 0x31, 0xe3, 0x94, 0xbb, 0x21,
+	0x8e, 0x63, 0x37, 0x61, 0x2c, 0xea, 0xc5, 0x2c, 0x20, 0x11, 0x77, 0xb9, 0xc0, 0x83, 0x88, 0xf0,
+	0x1f, 0x71, 0x32, 0xb7, 0xec, 0x4b, 0x85, 0x93, 0xa4, 0x4c, 0x30, 0xf3, 0x2d, 0x9d, 0xea, 0xc8,
+	0x54, 0x47, 0x06, 0x8a, 0x4c, 0x67, 0x26, 0x77, 0x0e, 0x76, 0x07, 0x44, 0xe0, 0xdd, 0xf6, 0xf6,
+	0x50, 0x89, 0xfb, 0x2a, 0xd3, 0x2d, 0x36, 0x05, 0xa6, 0xbd, 0x15, 0xb2, 0x90, 0x15, 0xe7, 0x72,
+	0xa5, 0x4f, 0xed, 0x90, 0xb1, 0x30, 0x22, 0xae, 0xda, 0x0d, 0x26, 0x23, 0x37, 0x98, 0xa4, 0x58,
+	0x50, 0x36, 0xd6, 0x71, 0xb8, 0x1c, 0x17, 0x34, 0x26, 0x5c, 0xe0, 0x38, 0x29, 0x01, 0xc5, 0x25,
+	0x2e, 0x9e, 0x88, 0x7d, 0x57, 0x97, 0xa1, 0x36, 0x4b, 0xf1, 0x01, 0xe6, 0x64, 0x1a, 0x1f, 0x32,
+	0xaa, 0x2f, 0x40, 0x27, 0x06, 0x00, 0x4f, 0x19, 0x8b, 0x9e, 0xe2, 0x14, 0xc7, 0xdc, 0xfc, 0x16,
+	0x6c, 0xa8, 0xe7, 0x1f, 0x11, 0x62, 0x19, 0x1d, 0xa3, 0xdb, 0xf0, 0x1e, 0x9d, 0x64, 0xb0, 0xf2,
+	0x57, 0x06, 0xdf, 0x0c, 0xa9, 0xd8, 0x9f, 0x0c, 0x9c, 0x21, 0x8b, 0xf5, 0x83, 0xe9, 0x9f, 0x1e,
+	0x0f, 0xbe, 0x77, 0xc5, 0x61, 0x42, 0xb8, 0xf3, 0x84, 0x0c, 0xf3, 0x0c, 0xde, 0x3e, 0xc4, 0x71,
+	0xf4, 0x10, 0x95, 0x1c, 0xe4, 0xd7, 0xe5, 0x72, 0x8f, 0x10, 0x49, 0x27, 0x2f, 0xa8, 0x50, 0xf4,
+	0xea, 0xff, 0xa3, 0x97, 0x1c, 0xe4, 0xd7, 0xe5, 0x72, 0x8f, 0x10, 0xf4, 0x4f, 0x15, 0xb4, 0x9e,
+	0x0d, 0x71, 0x44, 0xc7, 0xe1, 0x1e, 0x1e, 0x0a, 0x96, 0xfa, 0x38, 0x4e, 0xcc, 0xe7, 0xe0, 0x2e,
+	0x1d, 0x53, 0x41, 0x71, 0xd4, 0xe7, 0x45, 0xb0, 0x3f, 0x52, 0x51, 0x6e, 0x19, 0x9d, 0xb5, 0x6e,
+	0xcd, 0x43, 0x79, 0x06, 0xed, 0x02, 0x7a, 0x85, 0x10, 0xf9, 0xaf, 0xe9, 0xc8, 0x02, 0x9e, 0x9b,
+	0x5f, 0x82, 0xd7, 0x05, 0x4e, 0x43, 0x22, 0x56, 0xd0, 0x55, 0x85, 0xbe, 0x9f, 0x67, 0x70, 0xa7,
+	0x40, 0x5f, 0xae, 0x43, 0xfe, 0x56, 0x11, 0x58, 0x02, 0x7f, 0x05, 0x00, 0x17, 0x38, 0x15, 0x7d,
+	0xf9, 0xba, 0xad, 0xb5, 0x8e, 0xd1, 0xdd, 0x7c, 0xb7, 0xed, 0x14, 0x5e, 0x70, 0x4a, 0x2f, 0x38,
+	0x5f, 0x94, 0x5e, 0xf0, 0x76, 0x64, 0x1b, 0xf3, 0x0c, 0xb6, 0x74, 0xeb, 0xa7, 0xb9, 0xe8, 0xe5,
+	0x19, 0x34, 0xfc, 0x86, 0x3a, 0x90, 0x72, 0xd3, 0x07, 0x1b, 0xa5, 0xc5, 0xac, 0x9a, 0xe2, 0x6e,
+	0xaf, 0x70, 0x9f, 0x68, 0x81, 0x77, 0x4f, 0x63, 0x75, 0xcf, 0xcb, 0x44, 0x74, 0x24, 0xa1, 0x53,
+	0x0e, 0xfa, 0x7d, 0x1d, 0xd4, 0xa4, 0x87, 0xcc, 0xb7, 0x41, 0x1d, 0x07, 0x41, 0x4a, 0x38, 0xd7,
+	0xe6, 0x31, 0xf3, 0x0c, 0x36, 0x8b, 0x64, 0x1d, 0x40, 0x7e, 0x29, 0x31, 0x9b, 0xa0, 0x4a, 0x03,
+	0xe5, 0x83, 0x9a, 0x5f, 0xa5, 0x81, 0xf9, 0x13, 0xd8, 0x94, 0xd3, 0xd5, 0x4f, 0x94, 0x15, 0xf5,
+	0x53, 0x7f, 0xe0, 0xdc, 0x7c, 0xfc, 0x9c, 0x99, 0x91, 0xbd, 0x07, 0xba, 0xf4, 0x9d, 0x69, 0x47,
+	0xe6, 0x47, 0x5b, 0xdf, 0x81, 0x7c, 0x90, 0xcc, 0xbc, 0xff, 0x39, 0xd8, 0x1a, 0x4d, 0xc4, 0x24,
+	0x25, 0x85, 0x24, 0x64, 0x07, 0x24, 0x1d, 0xb3, 0x54, 0xb5, 0xa9, 0xe1, 0xc1, 0x3c, 0x83, 0xf7,
+	0x0a, 0xd8, 0x65, 0x2a, 0xe4, 0x9b, 0xc5, 0xb1, 0xac, 0xe1, 0x63, 0x7d, 0x68, 0x7e, 0x0d, 0x5e,
+	0x11, 0x4c, 0x48, 0x47, 0xed, 0xe3, 0x94, 0x70, 0xeb, 0x96, 0xee, 0xb8, 0xfe, 0x32, 0xc8, 0xa1,
+	0x9c, 0x16, 0xff, 0x98, 0xd1, 0x59, 0xc7, 0xef, 0x68, 0xd7, 0xcc, 0x25, 0x23, 0x7f, 0x53, 0x6d,
+	0x9f, 0xa9, 0x9d, 0x99, 0x82, 0xa6, 0x2a, 0x20, 0xa2, 0x3f, 0x4c, 0x68, 0x40, 0xc5, 0xa1, 0xb5,
+	0xde, 0x59, 0xbb, 0x1e, 0xfe, 0x8e, 0x84, 0xff, 0x7a, 0x06, 0xbb, 0x37, 0x18, 0x36, 0x99, 0xc0,
+	0xfd, 0x57, 0xe5, 0x15, 0x9f, 0x96, 0x37, 0x98, 0x9f, 0x81, 0xdb, 0xcb, 0x46, 0xaf, 0x2b, 0xa3,
+	0x3f, 0xc8, 0x33, 0x78, 0x7f, 0xa5, 0xd3, 0x2b, 0x66, 0x6f, 0xf2, 0x45, 0x9b, 0x7f, 0x07, 0xb6,
+	0x17, 0x35, 0xfd, 0x21, 0x1b, 0x8b, 0x94, 0x45, 0x11, 0x49, 0xad, 0x0d, 0xd5, 0xf6, 0x37, 0xf2,
+	0x0c, 0x76, 0x34, 0xf9, 0x2a, 0x29, 0xf2, 0xef, 0x2e, 0x80, 0x1f, 0x4f, 0x23, 0xe6, 0x2f, 0x06,
+	0xb8, 0xb3, 0x94, 0x97, 0xe2, 0x38, 0xb1, 0x1a, 0xea, 0x45, 0x7c, 0xf4, 0x5f, 0xcc, 0xb5, 0xf2,
+	0x69, 0xf1, 0xec, 0x3c, 0x83, 0xed, 0x4b, 0x6b, 0x93, 0x77, 0x20, 0xbf, 0xc5, 0x97, 0x53, 0x1e,
+	0xb6, 0x7e, 0x3e, 0x86, 0x95, 0xa3, 0x63, 0x58, 0xf9, 0xe3, 0xb7, 0xde, 0x2d, 0x69, 0x95, 0x4f,
+	0xbc, 0x6f, 0x4e, 0xce, 0x6d, 0xe3, 0xf4, 0xdc, 0x36, 0xfe, 0x3e, 0xb7, 0x8d, 0x97, 0x17, 0x76,
+	0xe5, 0xf4, 0xc2, 0xae, 0xfc, 0x79, 0x61, 0x57, 0x9e, 0x3f, 0x9a, 0x7b, 0x4f, 0xba, 0xd0, 0x5e,
+	0x84, 0x07, 0xbc, 0xdc, 0xb8, 0x07, 0xbb, 0xef, 0xbb, 0x2f, 0xae, 0xfb, 0x4b, 0x1b, 0xac, 0xab,
+	0xa1, 0x7e, 0xef, 0xdf, 0x01, 0x00, 0xe4, 0x24, 0xcc, 0xab, 0x00, 0x07, 0x00, 0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -223,6 +310,81 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScalingFactorRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingFactorRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingFactorRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TargetScalingFactors) > 0 {
+		dAtA4 := make([]byte, len(m.TargetScalingFactors)*10)
+		var j3 int
+		for _, num := range m.TargetScalingFactors {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InitialScalingFactors) > 0 {
+		dAtA6 := make([]byte, len(m.InitialScalingFactors)*10)
+		var j5 int
+		for _, num := range m.InitialScalingFactors {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Pool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -243,6 +405,18 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ScalingFactorRamp != nil {
+		{
+			size, err := m.ScalingFactorRamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.ScalingFactorController) > 0 {
 		i -= len(m.ScalingFactorController)
 		copy(dAtA[i:], m.ScalingFactorController)
@@ -251,20 +425,20 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if len(m.ScalingFactors) > 0 {
-		dAtA2 := make([]byte, len(m.ScalingFactors)*10)
-		var j1 int
+		dAtA9 := make([]byte, len(m.ScalingFactors)*10)
+		var j8 int
 		for _, num := range m.ScalingFactors {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j8++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j8))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -348,6 +522,33 @@ func (m *PoolParams) Size() (n int) {
 	return n
 }
 
+func (m *ScalingFactorRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InitialScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.InitialScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
+	if len(m.TargetScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.TargetScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	return n
+}
+
 func (m *Pool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -386,6 +587,10 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStableswapPool(uint64(l))
 	}
+	if m.ScalingFactorRamp != nil {
+		l = m.ScalingFactorRamp.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
 	return n
 }
 
@@ -513,6 +718,274 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScalingFactorRamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScalingFactorRamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScalingFactorRamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.InitialScalingFactors) == 0 {
+					m.InitialScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialScalingFactors", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetScalingFactors) == 0 {
+					m.TargetScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetScalingFactors", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Pool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -720,7 +1193,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolLiquidity = append(m.PoolLiquidity, types.Coin{})
+			m.PoolLiquidity = append(m.PoolLiquidity, types1.Coin{})
 			if err := m.PoolLiquidity[len(m.PoolLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -833,6 +1306,42 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 			m.ScalingFactorController = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorRamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScalingFactorRamp == nil {
+				m.ScalingFactorRamp = &ScalingFactorRamp{}
+			}
+			if err := m.ScalingFactorRamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
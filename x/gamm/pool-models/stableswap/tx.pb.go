@@ -262,11 +262,105 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse proto.InternalMessageInfo
 
+// Sender must be the pool's scaling_factor_controller in order for the tx to
+// succeed. Adjusts stableswap swap and exit fees.
+type MsgStableSwapAdjustFees struct {
+	Sender  string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID  uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+}
+
+func (m *MsgStableSwapAdjustFees) Reset()         { *m = MsgStableSwapAdjustFees{} }
+func (m *MsgStableSwapAdjustFees) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapAdjustFees) ProtoMessage()    {}
+func (*MsgStableSwapAdjustFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{4}
+}
+func (m *MsgStableSwapAdjustFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapAdjustFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapAdjustFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapAdjustFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapAdjustFees.Merge(m, src)
+}
+func (m *MsgStableSwapAdjustFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapAdjustFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapAdjustFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapAdjustFees proto.InternalMessageInfo
+
+func (m *MsgStableSwapAdjustFees) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapAdjustFees) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+type MsgStableSwapAdjustFeesResponse struct {
+}
+
+func (m *MsgStableSwapAdjustFeesResponse) Reset()         { *m = MsgStableSwapAdjustFeesResponse{} }
+func (m *MsgStableSwapAdjustFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapAdjustFeesResponse) ProtoMessage()    {}
+func (*MsgStableSwapAdjustFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{5}
+}
+func (m *MsgStableSwapAdjustFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapAdjustFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapAdjustFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapAdjustFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapAdjustFeesResponse.Merge(m, src)
+}
+func (m *MsgStableSwapAdjustFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapAdjustFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapAdjustFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapAdjustFeesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateStableswapPool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPool")
 	proto.RegisterType((*MsgCreateStableswapPoolResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPoolResponse")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactors")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactorsResponse")
+	proto.RegisterType((*MsgStableSwapAdjustFees)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustFees")
+	proto.RegisterType((*MsgStableSwapAdjustFeesResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustFeesResponse")
 }
 
 func init() {
@@ -274,46 +368,52 @@ func init() {
 }
 
 var fileDescriptor_46b7c8a0f24de97c = []byte{
-	// 622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x6f, 0xd6, 0x52, 0x84, 0x27, 0x40, 0x44, 0xd5, 0x96, 0x15, 0x29, 0x29, 0x81, 0x43, 0x07,
-	0x2c, 0x66, 0x03, 0x21, 0xc1, 0x6d, 0x29, 0x1a, 0x9a, 0xa0, 0xd2, 0xc8, 0xc4, 0x05, 0x0e, 0xc5,
-	0x49, 0xbc, 0x60, 0x48, 0xe2, 0x10, 0xbb, 0xdd, 0x7a, 0xe4, 0x1b, 0xf0, 0x31, 0x10, 0xdf, 0x81,
-	0x0b, 0x07, 0xb4, 0xe3, 0x8e, 0x9c, 0x02, 0x6a, 0xbf, 0x41, 0x6f, 0xdc, 0x50, 0xe2, 0xf4, 0x9f,
-	0xd4, 0x6e, 0x2b, 0xda, 0x29, 0xce, 0xf3, 0xef, 0xfd, 0x7e, 0xef, 0xfd, 0x9e, 0x6d, 0x70, 0x9f,
-	0xb2, 0x80, 0x32, 0xc2, 0xa0, 0x87, 0x82, 0x00, 0x46, 0x94, 0xfa, 0x1b, 0x01, 0x75, 0xb1, 0xcf,
-	0x20, 0xe3, 0xc8, 0xf6, 0x31, 0x3b, 0x44, 0x11, 0xe4, 0x47, 0x46, 0x14, 0x53, 0x4e, 0xe5, 0xbb,
-	0x39, 0xda, 0x48, 0xd1, 0x46, 0x8a, 0x16, 0x60, 0x63, 0x0c, 0x36, 0x3a, 0x9b, 0x36, 0xe6, 0x68,
-	0xb3, 0xaa, 0x3a, 0x19, 0x18, 0xda, 0x88, 0x61, 0x98, 0x07, 0xa1, 0x43, 0x49, 0x28, 0xb8, 0xaa,
-	0x15, 0x8f, 0x7a, 0x34, 0x5b, 0xc2, 0x74, 0x95, 0x47, 0x9f, 0x9c, 0xa7, 0x9e, 0xf1, 0xb2, 0x95,
-	0x22, 0x44, 0xaa, 0xfe, 0xbd, 0x04, 0x56, 0x9b, 0xcc, 0x6b, 0xc4, 0x18, 0x71, 0xbc, 0x3f, 0x82,
-	0xec, 0x51, 0xea, 0xcb, 0xeb, 0xa0, 0xcc, 0x70, 0xe8, 0xe2, 0x58, 0x91, 0x6a, 0x52, 0xfd, 0x8a,
-	0x79, 0x63, 0x90, 0x68, 0x57, 0xbb, 0x28, 0xf0, 0x9f, 0xea, 0x22, 0xae, 0x5b, 0x39, 0x40, 0xa6,
-	0x60, 0x39, 0x25, 0x6d, 0x45, 0x28, 0x46, 0x01, 0x53, 0x96, 0x6a, 0x52, 0x7d, 0x79, 0xeb, 0xb1,
-	0x71, 0xfe, 0xce, 0x8d, 0x54, 0x71, 0x2f, 0xcb, 0x36, 0x57, 0x06, 0x89, 0x26, 0x0b, 0x9d, 0x09,
-	0x52, 0xdd, 0x02, 0xd1, 0x08, 0x23, 0x7f, 0x96, 0xc0, 0x0a, 0x09, 0x09, 0x27, 0xc8, 0xcf, 0xda,
-	0x69, 0xf9, 0xe4, 0x53, 0x9b, 0xb8, 0x84, 0x77, 0x95, 0x62, 0xad, 0x58, 0x5f, 0xde, 0x5a, 0x33,
-	0x84, 0x95, 0x46, 0x6a, 0xe5, 0x48, 0xa5, 0x41, 0x49, 0x68, 0x3e, 0x38, 0x4e, 0xb4, 0xc2, 0xb7,
-	0xdf, 0x5a, 0xdd, 0x23, 0xfc, 0x7d, 0xdb, 0x36, 0x1c, 0x1a, 0xc0, 0xdc, 0x77, 0xf1, 0xd9, 0x60,
-	0xee, 0x47, 0xc8, 0xbb, 0x11, 0x66, 0x59, 0x02, 0xb3, 0x2a, 0xb9, 0x54, 0x5a, 0xe4, 0xcb, 0xa1,
-	0x90, 0xdc, 0x04, 0xd7, 0x99, 0x83, 0x7c, 0x12, 0x7a, 0xad, 0x03, 0xe4, 0x70, 0x1a, 0x33, 0xa5,
-	0x54, 0x2b, 0xd6, 0x4b, 0xe6, 0x9d, 0x41, 0xa2, 0xd5, 0x72, 0xa3, 0xc6, 0xae, 0x4f, 0x63, 0x75,
-	0xeb, 0x5a, 0x1e, 0xd8, 0x11, 0xb9, 0xf2, 0x2b, 0x50, 0x39, 0x68, 0xf3, 0x76, 0x8c, 0x45, 0x43,
-	0x1e, 0xed, 0xe0, 0x38, 0xa4, 0xb1, 0x72, 0x29, 0x33, 0x5f, 0x1b, 0x24, 0xda, 0x4d, 0xc1, 0x39,
-	0x0b, 0xa5, 0x5b, 0xb2, 0x08, 0xa7, 0x25, 0x3e, 0xcf, 0x83, 0xf2, 0x3b, 0xb0, 0x36, 0xad, 0xda,
-	0x72, 0x68, 0xc8, 0x63, 0xea, 0xfb, 0x38, 0x56, 0xca, 0x19, 0xef, 0x64, 0xad, 0xf3, 0xa0, 0xba,
-	0xb5, 0x3a, 0x55, 0x6b, 0x63, 0xbc, 0xb3, 0x03, 0xb4, 0x39, 0xc7, 0xc7, 0xc2, 0x2c, 0xa2, 0x21,
-	0xc3, 0xf2, 0x6d, 0x70, 0x39, 0x2b, 0x95, 0xb8, 0xd9, 0x39, 0x2a, 0x99, 0xa0, 0x97, 0x68, 0xe5,
-	0x14, 0xb2, 0xfb, 0xcc, 0x2a, 0xa7, 0x5b, 0xbb, 0xae, 0xfe, 0x43, 0x02, 0xb7, 0x9a, 0xcc, 0x13,
-	0x14, 0xfb, 0x87, 0x28, 0xda, 0x76, 0x3f, 0xb4, 0x19, 0xdf, 0x9f, 0xb6, 0x68, 0x81, 0x13, 0x39,
-	0xa1, 0xba, 0x34, 0x4f, 0x75, 0xd6, 0x04, 0x8b, 0xff, 0x3f, 0x41, 0xfd, 0x1e, 0x58, 0x3f, 0xb3,
-	0x87, 0xa1, 0x2d, 0x5b, 0x7f, 0x97, 0x40, 0xb1, 0xc9, 0x3c, 0xf9, 0xab, 0x04, 0x2a, 0x33, 0xaf,
-	0x5f, 0x63, 0x91, 0xeb, 0x33, 0x67, 0x08, 0xd5, 0x17, 0x17, 0x40, 0x32, 0x9a, 0xe4, 0x4f, 0x09,
-	0xa8, 0x67, 0x4c, 0xa8, 0xb9, 0xa0, 0xde, 0xe9, 0x74, 0xd5, 0xd7, 0x17, 0x4a, 0x37, 0x6c, 0xc4,
-	0x7c, 0x7b, 0xdc, 0x53, 0xa5, 0x93, 0x9e, 0x2a, 0xfd, 0xe9, 0xa9, 0xd2, 0x97, 0xbe, 0x5a, 0x38,
-	0xe9, 0xab, 0x85, 0x5f, 0x7d, 0xb5, 0xf0, 0x66, 0x7b, 0xe2, 0x4d, 0xc8, 0xa5, 0x37, 0x7c, 0x64,
-	0xb3, 0xe1, 0x0f, 0xec, 0x6c, 0x3e, 0x82, 0x47, 0xa7, 0x3d, 0xb4, 0x76, 0x39, 0x7b, 0x59, 0x1f,
-	0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x6f, 0xf1, 0xc7, 0x26, 0x06, 0x00, 0x00,
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x9b, 0x90, 0xc2, 0x54, 0x50, 0x61, 0x45, 0xad, 0x1b, 0x24, 0x3b, 0x35, 0x08, 0xa5,
+	0x40, 0x6d, 0x5a, 0x10, 0x12, 0xec, 0x9a, 0x54, 0x41, 0x55, 0x89, 0x54, 0x5c, 0xb1, 0x01, 0xa4,
+	0x30, 0xb1, 0xa7, 0x66, 0xc0, 0xf6, 0x18, 0xcf, 0xa4, 0x8f, 0x25, 0x7f, 0xc0, 0x47, 0x80, 0x84,
+	0xf8, 0x07, 0x36, 0x2c, 0x50, 0x97, 0x5d, 0x22, 0x16, 0x06, 0xa5, 0x7f, 0x90, 0x2f, 0x40, 0x33,
+	0x76, 0x5e, 0x52, 0xd2, 0x07, 0xcd, 0x2a, 0x37, 0xd7, 0xe7, 0x9e, 0x73, 0xef, 0xf1, 0x1d, 0x0f,
+	0xb8, 0x47, 0xa8, 0x4f, 0x28, 0xa6, 0xa6, 0x0b, 0x7d, 0xdf, 0x0c, 0x09, 0xf1, 0x96, 0x7d, 0xe2,
+	0x20, 0x8f, 0x9a, 0x94, 0xc1, 0xa6, 0x87, 0xe8, 0x1e, 0x0c, 0x4d, 0xb6, 0x6f, 0x84, 0x11, 0x61,
+	0x44, 0xbe, 0x93, 0xa2, 0x0d, 0x8e, 0x36, 0x38, 0x3a, 0x01, 0x1b, 0x7d, 0xb0, 0xb1, 0xbb, 0xd2,
+	0x44, 0x0c, 0xae, 0x14, 0x55, 0x5b, 0x80, 0xcd, 0x26, 0xa4, 0xc8, 0x4c, 0x93, 0xa6, 0x4d, 0x70,
+	0x90, 0x70, 0x15, 0x0b, 0x2e, 0x71, 0x89, 0x08, 0x4d, 0x1e, 0xa5, 0xd9, 0xc7, 0x67, 0xe9, 0xa7,
+	0x1f, 0x36, 0x38, 0x22, 0x29, 0xd5, 0xbf, 0xe7, 0xc0, 0x7c, 0x9d, 0xba, 0xd5, 0x08, 0x41, 0x86,
+	0xb6, 0x7b, 0x90, 0x2d, 0x42, 0x3c, 0x79, 0x09, 0xe4, 0x29, 0x0a, 0x1c, 0x14, 0x29, 0x52, 0x49,
+	0x2a, 0x5f, 0xa9, 0x5c, 0xef, 0xc4, 0xda, 0xd5, 0x03, 0xe8, 0x7b, 0x4f, 0xf4, 0x24, 0xaf, 0x5b,
+	0x29, 0x40, 0x26, 0x60, 0x86, 0x93, 0x36, 0x42, 0x18, 0x41, 0x9f, 0x2a, 0x53, 0x25, 0xa9, 0x3c,
+	0xb3, 0xfa, 0xc8, 0x38, 0xfb, 0xe4, 0x06, 0x57, 0xdc, 0x12, 0xd5, 0x95, 0xb9, 0x4e, 0xac, 0xc9,
+	0x89, 0xce, 0x00, 0xa9, 0x6e, 0x81, 0xb0, 0x87, 0x91, 0x3f, 0x4a, 0x60, 0x0e, 0x07, 0x98, 0x61,
+	0xe8, 0x89, 0x71, 0x1a, 0x1e, 0xfe, 0xd0, 0xc2, 0x0e, 0x66, 0x07, 0x4a, 0xb6, 0x94, 0x2d, 0xcf,
+	0xac, 0x2e, 0x18, 0x89, 0x95, 0x06, 0xb7, 0xb2, 0xa7, 0x52, 0x25, 0x38, 0xa8, 0xdc, 0x3f, 0x8c,
+	0xb5, 0xcc, 0xb7, 0x3f, 0x5a, 0xd9, 0xc5, 0xec, 0x6d, 0xab, 0x69, 0xd8, 0xc4, 0x37, 0x53, 0xdf,
+	0x93, 0x9f, 0x65, 0xea, 0xbc, 0x37, 0xd9, 0x41, 0x88, 0xa8, 0x28, 0xa0, 0x56, 0x21, 0x95, 0xe2,
+	0x4d, 0x3e, 0xeb, 0x0a, 0xc9, 0x75, 0x30, 0x4b, 0x6d, 0xe8, 0xe1, 0xc0, 0x6d, 0xec, 0x40, 0x9b,
+	0x91, 0x88, 0x2a, 0xb9, 0x52, 0xb6, 0x9c, 0xab, 0xdc, 0xea, 0xc4, 0x5a, 0x29, 0x35, 0xaa, 0xef,
+	0xfa, 0x30, 0x56, 0xb7, 0xae, 0xa5, 0x89, 0x5a, 0x52, 0x2b, 0x3f, 0x07, 0x85, 0x9d, 0x16, 0x6b,
+	0x45, 0x28, 0x19, 0xc8, 0x25, 0xbb, 0x28, 0x0a, 0x48, 0xa4, 0x5c, 0x12, 0xe6, 0x6b, 0x9d, 0x58,
+	0xbb, 0x91, 0x70, 0x8e, 0x42, 0xe9, 0x96, 0x9c, 0xa4, 0x79, 0x8b, 0x4f, 0xd3, 0xa4, 0xfc, 0x06,
+	0x2c, 0x0c, 0xab, 0x36, 0x6c, 0x12, 0xb0, 0x88, 0x78, 0x1e, 0x8a, 0x94, 0xbc, 0xe0, 0x1d, 0xec,
+	0x75, 0x1c, 0x54, 0xb7, 0xe6, 0x87, 0x7a, 0xad, 0xf6, 0x9f, 0xd4, 0x80, 0x36, 0x66, 0x7d, 0x2c,
+	0x44, 0x43, 0x12, 0x50, 0x24, 0xdf, 0x04, 0xd3, 0xa2, 0x55, 0xec, 0x88, 0x3d, 0xca, 0x55, 0x40,
+	0x3b, 0xd6, 0xf2, 0x1c, 0xb2, 0xb1, 0x6e, 0xe5, 0xf9, 0xa3, 0x0d, 0x47, 0xff, 0x21, 0x81, 0xc5,
+	0x3a, 0x75, 0x13, 0x8a, 0xed, 0x3d, 0x18, 0xae, 0x39, 0xef, 0x5a, 0x94, 0x6d, 0x0f, 0x5b, 0x74,
+	0x8e, 0x8d, 0x1c, 0x50, 0x9d, 0x1a, 0xa7, 0x3a, 0xea, 0x0d, 0x66, 0xff, 0xff, 0x0d, 0xea, 0x77,
+	0xc1, 0xd2, 0xa9, 0x33, 0x74, 0x6d, 0xd1, 0xbf, 0x4c, 0x81, 0xf9, 0x11, 0xe8, 0x1a, 0x42, 0x93,
+	0x9f, 0xf3, 0x35, 0xb8, 0x2c, 0x06, 0xd8, 0x41, 0x48, 0xc9, 0x0a, 0xc6, 0x35, 0x7e, 0x06, 0x7e,
+	0xc7, 0xda, 0xed, 0x33, 0x9c, 0x81, 0x75, 0x64, 0x77, 0x62, 0x6d, 0x36, 0xd5, 0x4f, 0x79, 0x74,
+	0x6b, 0x9a, 0x87, 0x35, 0x84, 0x38, 0x3b, 0xda, 0xc7, 0x4c, 0xb0, 0xe7, 0x2e, 0xc6, 0xde, 0xe5,
+	0xd1, 0xad, 0x69, 0x1e, 0xd6, 0x10, 0xd2, 0x17, 0x81, 0x36, 0xc6, 0xa6, 0xae, 0x95, 0xab, 0x9f,
+	0x73, 0x20, 0x5b, 0xa7, 0xae, 0xfc, 0x55, 0x02, 0x85, 0x91, 0x5f, 0xb2, 0xea, 0x79, 0xbe, 0x44,
+	0x63, 0xf6, 0xb9, 0xb8, 0x39, 0x01, 0x92, 0xde, 0xa1, 0xf8, 0x29, 0x01, 0xf5, 0x94, 0x65, 0xaf,
+	0x9f, 0x53, 0xef, 0x64, 0xba, 0xe2, 0x8b, 0x89, 0xd2, 0xf5, 0x06, 0xe1, 0x9e, 0x8f, 0xdc, 0xe1,
+	0xea, 0x05, 0xf5, 0x38, 0x49, 0x71, 0x73, 0x02, 0x24, 0xdd, 0x56, 0x2b, 0xaf, 0x0e, 0xdb, 0xaa,
+	0x74, 0xd4, 0x56, 0xa5, 0xbf, 0x6d, 0x55, 0xfa, 0x74, 0xac, 0x66, 0x8e, 0x8e, 0xd5, 0xcc, 0xaf,
+	0x63, 0x35, 0xf3, 0x72, 0x6d, 0x60, 0x4f, 0x53, 0xc1, 0x65, 0x0f, 0x36, 0x69, 0xf7, 0x8f, 0xb9,
+	0xbb, 0xf2, 0xd0, 0xdc, 0x3f, 0xe9, 0x7a, 0x6d, 0xe6, 0xc5, 0x7d, 0xfa, 0xe0, 0xdf, 0x00, 0x22,
+	0xa3, 0xe4, 0x5f, 0x1c, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -330,6 +430,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateStableswapPool(ctx context.Context, in *MsgCreateStableswapPool, opts ...grpc.CallOption) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(ctx context.Context, in *MsgStableSwapAdjustScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapAdjustFees(ctx context.Context, in *MsgStableSwapAdjustFees, opts ...grpc.CallOption) (*MsgStableSwapAdjustFeesResponse, error)
 }
 
 type msgClient struct {
@@ -358,10 +459,20 @@ func (c *msgClient) StableSwapAdjustScalingFactors(ctx context.Context, in *MsgS
 	return out, nil
 }
 
+func (c *msgClient) StableSwapAdjustFees(ctx context.Context, in *MsgStableSwapAdjustFees, opts ...grpc.CallOption) (*MsgStableSwapAdjustFeesResponse, error) {
+	out := new(MsgStableSwapAdjustFeesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapAdjustFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateStableswapPool(context.Context, *MsgCreateStableswapPool) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(context.Context, *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapAdjustFees(context.Context, *MsgStableSwapAdjustFees) (*MsgStableSwapAdjustFeesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -374,6 +485,9 @@ func (*UnimplementedMsgServer) CreateStableswapPool(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) StableSwapAdjustScalingFactors(ctx context.Context, req *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAdjustScalingFactors not implemented")
 }
+func (*UnimplementedMsgServer) StableSwapAdjustFees(ctx context.Context, req *MsgStableSwapAdjustFees) (*MsgStableSwapAdjustFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAdjustFees not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -415,6 +529,24 @@ func _Msg_StableSwapAdjustScalingFactors_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StableSwapAdjustFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapAdjustFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapAdjustFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapAdjustFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapAdjustFees(ctx, req.(*MsgStableSwapAdjustFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -427,6 +559,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StableSwapAdjustScalingFactors",
 			Handler:    _Msg_StableSwapAdjustScalingFactors_Handler,
 		},
+		{
+			MethodName: "StableSwapAdjustFees",
+			Handler:    _Msg_StableSwapAdjustFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/stableswap/tx.proto",
@@ -624,6 +760,84 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgStableSwapAdjustFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapAdjustFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapAdjustFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExitFee.Size()
+		i -= size
+		if _, err := m.ExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapAdjustFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapAdjustFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapAdjustFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -717,6 +931,35 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgStableSwapAdjustFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStableSwapAdjustFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1311,6 +1554,225 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgStableSwapAdjustFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapAdjustFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapAdjustFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapAdjustFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapAdjustFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapAdjustFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -78,6 +78,9 @@ var (
 	ErrTooManyTokensOut         = sdkerrors.Register(ModuleName, 31, "tx is trying to get more tokens out of the pool than exist")
 	ErrSpotPriceOverflow        = sdkerrors.Register(ModuleName, 32, "invalid spot price (overflowed)")
 	ErrSpotPriceInternal        = sdkerrors.Register(ModuleName, 33, "internal spot price error")
+	ErrInvalidPriceLimit        = sdkerrors.Register(ModuleName, 34, "price limit must be positive and below the current spot price of token in")
+	ErrTokenInRoutesNotFound    = sdkerrors.Register(ModuleName, 35, "token in is not in the pool and has no routes to swap it into a pool asset")
+	ErrInvalidTokenInRoutes     = sdkerrors.Register(ModuleName, 36, "invalid token in routes")
 
 	ErrPoolParamsInvalidDenom     = sdkerrors.Register(ModuleName, 50, "pool params' LBP params has an invalid denomination")
 	ErrPoolParamsInvalidNumDenoms = sdkerrors.Register(ModuleName, 51, "pool params' LBP doesn't have same number of params as underlying pool")
@@ -90,4 +93,7 @@ var (
 	ErrInvalidScalingFactors      = sdkerrors.Register(ModuleName, 64, "scaling factors cannot be 0 or use more than 63 bits")
 	ErrHitMaxScaledAssets         = sdkerrors.Register(ModuleName, 65, "post-scaled pool assets can not exceed 10^34")
 	ErrHitMinScaledAssets         = sdkerrors.Register(ModuleName, 66, "post-scaled pool assets can not be less than 1")
+
+	ErrNotBalancerPool   = sdkerrors.Register(ModuleName, 70, "not balancer pool")
+	ErrNotPoolController = sdkerrors.Register(ModuleName, 71, "not pool controller")
 )
@@ -7,11 +7,22 @@ const (
 	TypeEvtTokenSwapped  = "token_swapped"
 	TypeEvtMigrateShares = "migrate_shares"
 
-	AttributeValueCategory     = ModuleName
-	AttributeKeyPoolId         = "pool_id"
-	AttributeKeyPoolIdEntering = "pool_id_entering"
-	AttributeKeyPoolIdLeaving  = "pool_id_leaving"
-	AttributeKeySwapFee        = "swap_fee"
-	AttributeKeyTokensIn       = "tokens_in"
-	AttributeKeyTokensOut      = "tokens_out"
+	TypeEvtSmoothWeightChangeScheduled = "smooth_weight_change_scheduled"
+	TypeEvtSwapsPaused                 = "swaps_paused"
+	TypeEvtSwapsResumed                = "swaps_resumed"
+	TypeEvtScalingFactorRampScheduled  = "scaling_factor_ramp_scheduled"
+	TypeEvtStableSwapFeesSet           = "stableswap_fees_set"
+
+	AttributeValueCategory           = ModuleName
+	AttributeKeyPoolId               = "pool_id"
+	AttributeKeyPoolIdEntering       = "pool_id_entering"
+	AttributeKeyPoolIdLeaving        = "pool_id_leaving"
+	AttributeKeySwapFee              = "swap_fee"
+	AttributeKeyExitFee              = "exit_fee"
+	AttributeKeyTokensIn             = "tokens_in"
+	AttributeKeyTokensOut            = "tokens_out"
+	AttributeKeyStartTime            = "start_time"
+	AttributeKeyDuration             = "duration"
+	AttributeKeyTargetWeights        = "target_weights"
+	AttributeKeyTargetScalingFactors = "target_scaling_factors"
 )
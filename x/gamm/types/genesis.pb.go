@@ -6,20 +6,24 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	types2 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +34,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params holds parameters for the incentives module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// scaling_factor_ramp_duration is how long it takes a stableswap pool to
+	// move from its current scaling factors to newly set ones. Zero sets them
+	// immediately.
+	ScalingFactorRampDuration time.Duration `protobuf:"bytes,2,opt,name=scaling_factor_ramp_duration,json=scalingFactorRampDuration,proto3,stdduration" json:"scaling_factor_ramp_duration" yaml:"scaling_factor_ramp_duration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -72,9 +80,16 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetScalingFactorRampDuration() time.Duration {
+	if m != nil {
+		return m.ScalingFactorRampDuration
+	}
+	return 0
+}
+
 // GenesisState defines the gamm module's genesis state.
 type GenesisState struct {
-	Pools []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	Pools []*types2.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 	// will be renamed to next_pool_id in an upcoming version
 	NextPoolNumber   uint64            `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
 	Params           Params            `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
@@ -114,7 +129,7 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
-func (m *GenesisState) GetPools() []*types1.Any {
+func (m *GenesisState) GetPools() []*types2.Any {
 	if m != nil {
 		return m.Pools
 	}
@@ -258,41 +273,44 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 536 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x73, 0x6d, 0x1a, 0xfd, 0x7e, 0x57, 0x04, 0xa9, 0x95, 0xc1, 0xad, 0x2a, 0x27, 0x32,
-	0x12, 0xca, 0x92, 0x33, 0x29, 0x65, 0xc9, 0x46, 0x22, 0x81, 0x40, 0x05, 0x55, 0x2e, 0x13, 0x8b,
-	0x75, 0x76, 0xae, 0xc6, 0x8a, 0x7d, 0x4f, 0xe4, 0xbb, 0x54, 0xcd, 0xc6, 0xc8, 0x88, 0xc4, 0x0c,
-	0x62, 0x66, 0xe6, 0x45, 0x54, 0x4c, 0x1d, 0x99, 0x0a, 0x4a, 0x16, 0x66, 0x5e, 0x01, 0xba, 0x3f,
-	0x8e, 0xaa, 0x12, 0x75, 0xb2, 0x9f, 0xe7, 0xf9, 0x3c, 0x5f, 0x3f, 0xcf, 0xf7, 0xce, 0xd8, 0x07,
-	0x51, 0x80, 0xc8, 0x44, 0x90, 0xd2, 0xa2, 0x08, 0xce, 0xfa, 0x31, 0x93, 0xb4, 0x1f, 0xa4, 0x8c,
-	0x33, 0x91, 0x09, 0x32, 0x2d, 0x41, 0x82, 0xd3, 0xb2, 0x0c, 0x51, 0x0c, 0xb1, 0xcc, 0x5e, 0x2b,
-	0x85, 0x14, 0x34, 0x10, 0xa8, 0x37, 0xc3, 0xee, 0xed, 0xa6, 0x00, 0x69, 0xce, 0x02, 0x1d, 0xc5,
-	0xb3, 0xd3, 0x80, 0xf2, 0x79, 0x55, 0x4a, 0xb4, 0x4e, 0x64, 0x7a, 0x4c, 0x60, 0x4b, 0x9e, 0x89,
-	0x82, 0x98, 0x0a, 0xb6, 0x1a, 0x22, 0x81, 0x8c, 0x9b, 0xba, 0xff, 0x19, 0xe1, 0xc6, 0x31, 0x2d,
-	0x69, 0x21, 0x9c, 0x8f, 0x08, 0xef, 0x4c, 0x01, 0xf2, 0x28, 0x29, 0x19, 0x95, 0x19, 0xf0, 0xe8,
-	0x94, 0x31, 0x17, 0x75, 0x36, 0xbb, 0xdb, 0x07, 0xbb, 0xc4, 0xaa, 0x2a, 0x9d, 0x6a, 0x50, 0x32,
-	0x82, 0x8c, 0x0f, 0x8f, 0x2e, 0xae, 0xda, 0xb5, 0x3f, 0x57, 0x6d, 0x77, 0x4e, 0x8b, 0x7c, 0xe0,
-	0xff, 0xa3, 0xe0, 0x7f, 0xfd, 0xd9, 0xee, 0xa6, 0x99, 0x7c, 0x3b, 0x8b, 0x49, 0x02, 0x85, 0x1d,
-	0xcf, 0x3e, 0x7a, 0x62, 0x3c, 0x09, 0xe4, 0x7c, 0xca, 0x84, 0x16, 0x13, 0xe1, 0x3d, 0xd5, 0x3f,
-	0xb2, 0xed, 0x4f, 0x19, 0xf3, 0xdf, 0x6d, 0xe0, 0x3b, 0xcf, 0x8c, 0x69, 0x27, 0x92, 0x4a, 0xe6,
-	0x3c, 0xc6, 0x5b, 0x8a, 0x11, 0x76, 0xb2, 0x16, 0x31, 0xbe, 0x90, 0xca, 0x17, 0xf2, 0x84, 0xcf,
-	0x87, 0xff, 0x7f, 0xff, 0xd6, 0xdb, 0x3a, 0x06, 0xc8, 0x9f, 0x87, 0x86, 0x76, 0xba, 0xb8, 0xc9,
-	0xd9, 0xb9, 0x8c, 0xf4, 0x7c, 0x7c, 0x56, 0xc4, 0xac, 0x74, 0x37, 0x3a, 0xa8, 0x5b, 0x0f, 0xef,
-	0xaa, 0xbc, 0x62, 0x5f, 0xe9, 0xac, 0x33, 0xc0, 0x8d, 0xa9, 0x76, 0xc4, 0xdd, 0xec, 0xa0, 0xee,
-	0xf6, 0xc1, 0x3e, 0x59, 0x77, 0x4a, 0xc4, 0xb8, 0x36, 0xac, 0xab, 0xf5, 0x43, 0xdb, 0xe1, 0x9c,
-	0xe0, 0x9d, 0x22, 0x4b, 0x4b, 0xb3, 0x7c, 0xc9, 0x12, 0x28, 0xc7, 0xc2, 0xad, 0x6b, 0x99, 0x07,
-	0xeb, 0x65, 0x5e, 0x56, 0x78, 0x68, 0xe8, 0xb0, 0x59, 0xdc, 0xc8, 0xf8, 0x9f, 0x10, 0x6e, 0xde,
-	0xc4, 0x9c, 0xf7, 0x08, 0xdf, 0x8f, 0x69, 0x4e, 0x79, 0xc2, 0xca, 0x48, 0x42, 0x94, 0x00, 0x4f,
-	0x18, 0x97, 0x25, 0x95, 0x6c, 0x6c, 0x96, 0xcc, 0x33, 0x3e, 0xa9, 0x5c, 0x3a, 0x5c, 0xff, 0xf1,
-	0xa1, 0x15, 0x78, 0x0d, 0xa3, 0x6b, 0xed, 0xca, 0x8b, 0xa3, 0x8c, 0x4f, 0xec, 0x6e, 0xed, 0xf8,
-	0x56, 0x4a, 0xf8, 0x1c, 0x7b, 0xb7, 0x0b, 0x29, 0xf3, 0x57, 0xb3, 0xea, 0xd9, 0xb2, 0xb1, 0x8b,
-	0x8c, 0xf9, 0x55, 0x5e, 0x1f, 0xd6, 0xd8, 0xd9, 0xc7, 0x38, 0xc9, 0x57, 0x8c, 0x39, 0xa0, 0xff,
-	0x92, 0xdc, 0x54, 0x07, 0xf5, 0xdf, 0x5f, 0xda, 0x68, 0xf8, 0xe2, 0x62, 0xe1, 0xa1, 0xcb, 0x85,
-	0x87, 0x7e, 0x2d, 0x3c, 0xf4, 0x61, 0xe9, 0xd5, 0x2e, 0x97, 0x5e, 0xed, 0xc7, 0xd2, 0xab, 0xbd,
-	0x79, 0x78, 0xed, 0x9e, 0xd9, 0x85, 0x7b, 0x39, 0x8d, 0x45, 0x15, 0x04, 0x67, 0xfd, 0xc3, 0xe0,
-	0xdc, 0xfc, 0x91, 0xfa, 0xd6, 0xc5, 0x0d, 0x7d, 0x6d, 0x1e, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0x26, 0x92, 0xf5, 0x95, 0xae, 0x03, 0x00, 0x00,
+	// 592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xb1, 0x6f, 0xd3, 0x40,
+	0x14, 0xc6, 0x73, 0x6d, 0x5a, 0xc1, 0x81, 0xa0, 0xb5, 0x3a, 0x38, 0x55, 0x64, 0x57, 0xae, 0x84,
+	0xb2, 0xc4, 0x26, 0x50, 0x96, 0x6c, 0x38, 0xa8, 0x08, 0x54, 0x50, 0xe5, 0x32, 0xb1, 0x58, 0xe7,
+	0xf3, 0xc5, 0x58, 0xb1, 0xef, 0x2c, 0xdf, 0xa5, 0x6a, 0x36, 0x46, 0x24, 0x16, 0x24, 0x16, 0x16,
+	0x24, 0x66, 0xc4, 0xc8, 0x1f, 0x51, 0x31, 0x75, 0x64, 0x6a, 0x51, 0xb2, 0x30, 0xf3, 0x17, 0x20,
+	0xdf, 0x9d, 0xa3, 0x2a, 0x8d, 0x32, 0x25, 0x77, 0xf7, 0x7b, 0xdf, 0x7d, 0xef, 0x7b, 0x97, 0x40,
+	0x87, 0xf1, 0x9c, 0xf1, 0x94, 0x7b, 0x09, 0xca, 0x73, 0xef, 0xb4, 0x17, 0x11, 0x81, 0x7a, 0x5e,
+	0x42, 0x28, 0xe1, 0x29, 0x77, 0x8b, 0x92, 0x09, 0x66, 0xec, 0x68, 0xc6, 0xad, 0x18, 0x57, 0x33,
+	0xbb, 0x3b, 0x09, 0x4b, 0x98, 0x04, 0xbc, 0xea, 0x9b, 0x62, 0x77, 0x5b, 0x09, 0x63, 0x49, 0x46,
+	0x3c, 0xb9, 0x8a, 0xc6, 0x43, 0x0f, 0xd1, 0x89, 0x3e, 0xb2, 0x16, 0x8f, 0xe2, 0x71, 0x89, 0x44,
+	0xca, 0x68, 0x5d, 0x8a, 0xe5, 0x3d, 0xa1, 0xd2, 0x54, 0x8b, 0xba, 0x54, 0xad, 0xbc, 0x08, 0x71,
+	0x32, 0x37, 0x89, 0x59, 0xaa, 0x4b, 0x9d, 0x1f, 0x6b, 0x70, 0xf3, 0x18, 0x95, 0x28, 0xe7, 0xc6,
+	0x67, 0x00, 0xb7, 0x0b, 0xc6, 0xb2, 0x10, 0x97, 0x44, 0xaa, 0x87, 0x43, 0x42, 0x4c, 0xb0, 0xb7,
+	0xde, 0xb9, 0xf3, 0xa8, 0xe5, 0x6a, 0xd5, 0x4a, 0xa7, 0x6e, 0xc4, 0x1d, 0xb0, 0x94, 0xfa, 0x47,
+	0xe7, 0x97, 0x76, 0xe3, 0xdf, 0xa5, 0x6d, 0x4e, 0x50, 0x9e, 0xf5, 0x9d, 0x1b, 0x0a, 0xce, 0xf7,
+	0x2b, 0xbb, 0x93, 0xa4, 0xe2, 0xdd, 0x38, 0x72, 0x31, 0xcb, 0xb5, 0x3d, 0xfd, 0xd1, 0xe5, 0xf1,
+	0xc8, 0x13, 0x93, 0x82, 0x70, 0x29, 0xc6, 0x83, 0xfb, 0x55, 0xfd, 0x40, 0x97, 0x1f, 0x12, 0x62,
+	0x7c, 0x04, 0xb0, 0xcd, 0x31, 0xca, 0x52, 0x9a, 0x84, 0x43, 0x84, 0x05, 0x2b, 0xc3, 0x12, 0xe5,
+	0x45, 0x58, 0x47, 0x60, 0xae, 0xed, 0x01, 0x69, 0x50, 0x65, 0xe4, 0xd6, 0x19, 0xb9, 0xcf, 0x34,
+	0xe0, 0x7b, 0xda, 0xe0, 0xbe, 0x32, 0xb8, 0x4a, 0xcc, 0xf9, 0x72, 0x65, 0x83, 0xa0, 0xa5, 0x91,
+	0x43, 0x49, 0x04, 0x28, 0x2f, 0x6a, 0x2d, 0xe7, 0xfd, 0x1a, 0xbc, 0xfb, 0x5c, 0x8d, 0xf8, 0x44,
+	0x20, 0x41, 0x8c, 0x27, 0x70, 0xa3, 0x72, 0xcc, 0x75, 0x4e, 0x3b, 0x37, 0x6c, 0x3c, 0xa5, 0x13,
+	0xff, 0xf6, 0xaf, 0x9f, 0xdd, 0x8d, 0x63, 0xc6, 0xb2, 0x17, 0x81, 0xa2, 0x8d, 0x0e, 0xdc, 0xa2,
+	0xe4, 0x4c, 0x84, 0x32, 0x2d, 0x3a, 0xce, 0x23, 0x52, 0xca, 0x46, 0x9a, 0xc1, 0xbd, 0x6a, 0xbf,
+	0x62, 0x5f, 0xcb, 0x5d, 0xa3, 0x0f, 0x37, 0x0b, 0x39, 0x1f, 0x73, 0x5d, 0x36, 0xda, 0x76, 0x97,
+	0xbd, 0x29, 0x57, 0xcd, 0xd0, 0x6f, 0x56, 0xbd, 0x06, 0xba, 0xc2, 0x38, 0x81, 0xdb, 0x79, 0x9a,
+	0x28, 0xeb, 0x61, 0x49, 0x30, 0x2b, 0x63, 0x6e, 0x36, 0xa5, 0xcc, 0x83, 0xe5, 0x32, 0xaf, 0x6a,
+	0x3c, 0x50, 0x74, 0xb0, 0x95, 0x2f, 0xec, 0x38, 0x5f, 0x01, 0xdc, 0x5a, 0xc4, 0x8c, 0x0f, 0x00,
+	0xee, 0x47, 0x28, 0x43, 0x14, 0x93, 0x32, 0x14, 0x2c, 0xc4, 0x8c, 0x62, 0x42, 0x45, 0x89, 0x04,
+	0x89, 0x55, 0x93, 0x59, 0x4a, 0x47, 0x75, 0x4a, 0x07, 0xcb, 0x2f, 0xf7, 0xb5, 0xc0, 0x1b, 0x36,
+	0xb8, 0x56, 0x5e, 0x65, 0x71, 0x94, 0xd2, 0x91, 0xee, 0xcd, 0x8e, 0x56, 0x52, 0xdc, 0xa1, 0xd0,
+	0x5a, 0x2d, 0x54, 0x85, 0x3f, 0xf7, 0x2a, 0xbd, 0xa5, 0xb1, 0x09, 0x54, 0xf8, 0xf5, 0xbe, 0x1c,
+	0x56, 0x6c, 0xb4, 0x21, 0xc4, 0xd9, 0x9c, 0x51, 0x03, 0xba, 0x85, 0x33, 0x75, 0xda, 0x6f, 0xfe,
+	0xfd, 0x66, 0x03, 0xff, 0xe5, 0xf9, 0xd4, 0x02, 0x17, 0x53, 0x0b, 0xfc, 0x99, 0x5a, 0xe0, 0xd3,
+	0xcc, 0x6a, 0x5c, 0xcc, 0xac, 0xc6, 0xef, 0x99, 0xd5, 0x78, 0xfb, 0xf0, 0xda, 0xab, 0xd7, 0x0d,
+	0x77, 0x33, 0x14, 0xf1, 0x7a, 0xe1, 0x9d, 0xf6, 0x0e, 0xbc, 0x33, 0xf5, 0xff, 0x21, 0x7f, 0x03,
+	0xd1, 0xa6, 0x7c, 0x36, 0x8f, 0xff, 0x0f, 0x00, 0x3e, 0x25, 0x15, 0xdc, 0x5c, 0x04, 0x00, 0x00,
 }
 
 func (this *BalancerToConcentratedPoolLink) Equal(that interface{}) bool {
@@ -342,6 +360,14 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ScalingFactorRampDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ScalingFactorRampDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
 	if len(m.PoolCreationFee) > 0 {
 		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -516,6 +542,8 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ScalingFactorRampDuration)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -642,6 +670,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorRampDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ScalingFactorRampDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -721,7 +782,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types1.Any{})
+			m.Pools = append(m.Pools, &types2.Any{})
 			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4,25 +4,30 @@ import (
 	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
 	ProposalTypeUpdateMigrationRecords  = "UpdateMigrationRecords"
 	ProposalTypeReplaceMigrationRecords = "ReplaceMigrationRecords"
+	ProposalTypeSetStableSwapFees       = "SetStableSwapFees"
 )
 
-// Init registers proposals to update and replace migration records.
+// Init registers proposals to update and replace migration records and to set stableswap fees.
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeUpdateMigrationRecords)
 	govtypes.RegisterProposalTypeCodec(&UpdateMigrationRecordsProposal{}, "osmosis/UpdateMigrationRecordsProposal")
 	govtypes.RegisterProposalType(ProposalTypeReplaceMigrationRecords)
 	govtypes.RegisterProposalTypeCodec(&ReplaceMigrationRecordsProposal{}, "osmosis/ReplaceMigrationRecordsProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetStableSwapFees)
+	govtypes.RegisterProposalTypeCodec(&SetStableSwapFeesProposal{}, "osmosis/SetStableSwapFeesProposal")
 }
 
 var (
 	_ govtypes.Content = &UpdateMigrationRecordsProposal{}
 	_ govtypes.Content = &ReplaceMigrationRecordsProposal{}
+	_ govtypes.Content = &SetStableSwapFeesProposal{}
 )
 
 // NewReplacePoolIncentivesProposal returns a new instance of a replace migration record's proposal struct.
@@ -129,3 +134,58 @@ func (p UpdateMigrationRecordsProposal) String() string {
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+// NewSetStableSwapFeesProposal returns a new instance of a set stableswap fees proposal struct.
+func NewSetStableSwapFeesProposal(title, description string, poolId uint64, swapFee, exitFee sdk.Dec) govtypes.Content {
+	return &SetStableSwapFeesProposal{
+		Title:       title,
+		Description: description,
+		PoolId:      poolId,
+		SwapFee:     swapFee,
+		ExitFee:     exitFee,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SetStableSwapFeesProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetStableSwapFeesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetStableSwapFeesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetStableSwapFeesProposal) ProposalType() string {
+	return ProposalTypeSetStableSwapFees
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+// The fees are validated against the stableswap pool params when the proposal is executed.
+func (p *SetStableSwapFeesProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.PoolId == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+	if p.SwapFee.IsNil() || p.ExitFee.IsNil() {
+		return fmt.Errorf("swap fee and exit fee must be set")
+	}
+
+	return nil
+}
+
+// String returns a string containing the set stableswap fees proposal.
+func (p SetStableSwapFeesProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set StableSwap Fees Proposal:
+  Title:       %s
+  Description: %s
+  Pool ID:     %d
+  Swap Fee:    %s
+  Exit Fee:    %s
+`, p.Title, p.Description, p.PoolId, p.SwapFee, p.ExitFee))
+	return b.String()
+}
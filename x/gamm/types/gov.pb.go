@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -112,35 +113,87 @@ func (m *UpdateMigrationRecordsProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateMigrationRecordsProposal proto.InternalMessageInfo
 
+// SetStableSwapFeesProposal is a gov Content type for changing the swap and
+// exit fees of a stableswap pool. If a SetStableSwapFeesProposal passes, the
+// pool's fees are set to the proposal's fees.
+type SetStableSwapFeesProposal struct {
+	Title       string                                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolId      uint64                                 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	SwapFee     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	ExitFee     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+}
+
+func (m *SetStableSwapFeesProposal) Reset()      { *m = SetStableSwapFeesProposal{} }
+func (*SetStableSwapFeesProposal) ProtoMessage() {}
+func (*SetStableSwapFeesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31b9a6c0dbbdfa3, []int{2}
+}
+func (m *SetStableSwapFeesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetStableSwapFeesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetStableSwapFeesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetStableSwapFeesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStableSwapFeesProposal.Merge(m, src)
+}
+func (m *SetStableSwapFeesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetStableSwapFeesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStableSwapFeesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetStableSwapFeesProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ReplaceMigrationRecordsProposal)(nil), "osmosis.gamm.v1beta1.ReplaceMigrationRecordsProposal")
 	proto.RegisterType((*UpdateMigrationRecordsProposal)(nil), "osmosis.gamm.v1beta1.UpdateMigrationRecordsProposal")
+	proto.RegisterType((*SetStableSwapFeesProposal)(nil), "osmosis.gamm.v1beta1.SetStableSwapFeesProposal")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/gov.proto", fileDescriptor_f31b9a6c0dbbdfa3) }
 
 var fileDescriptor_f31b9a6c0dbbdfa3 = []byte{
-	// 314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x91, 0xbd, 0x4e, 0xf3, 0x30,
-	0x14, 0x86, 0xe3, 0xaf, 0x1f, 0x20, 0x52, 0xa6, 0xa8, 0x43, 0xd5, 0xc1, 0xa9, 0x3a, 0x75, 0x21,
-	0xa6, 0xd0, 0x89, 0xb1, 0x6c, 0x08, 0xa4, 0x2a, 0x2a, 0x0b, 0x9b, 0x93, 0x1c, 0x05, 0x0b, 0xc7,
-	0xc7, 0xb2, 0x4d, 0x05, 0x77, 0xc0, 0xc8, 0xc8, 0xd8, 0x0b, 0x81, 0xbd, 0x63, 0x47, 0x26, 0x84,
-	0xda, 0x85, 0xcb, 0x40, 0xf9, 0xa9, 0xc4, 0xd0, 0x1b, 0x60, 0xf3, 0xf1, 0xf3, 0xfa, 0xf1, 0x91,
-	0x5e, 0x9f, 0xa2, 0x2d, 0xd0, 0x0a, 0xcb, 0x72, 0x5e, 0x14, 0x6c, 0x3e, 0x4a, 0xc0, 0xf1, 0x11,
-	0xcb, 0x71, 0x1e, 0x69, 0x83, 0x0e, 0x83, 0x4e, 0xc3, 0xa3, 0x92, 0x47, 0x0d, 0xef, 0x75, 0x72,
-	0xcc, 0xb1, 0x0a, 0xb0, 0xf2, 0x54, 0x67, 0x7b, 0x83, 0xdd, 0x2e, 0x50, 0x50, 0x0a, 0xaa, 0xcc,
-	0xe0, 0x9d, 0xf8, 0x61, 0x0c, 0x5a, 0xf2, 0x14, 0xae, 0x45, 0x6e, 0xb8, 0x13, 0xa8, 0x62, 0x48,
-	0xd1, 0x64, 0x76, 0x6a, 0x50, 0xa3, 0xe5, 0x32, 0xe8, 0xf8, 0x7b, 0x4e, 0x38, 0x09, 0x5d, 0xd2,
-	0x27, 0xc3, 0xc3, 0xb8, 0x1e, 0x82, 0xbe, 0xdf, 0xce, 0xc0, 0xa6, 0x46, 0xe8, 0xf2, 0x4d, 0xf7,
-	0x5f, 0xc5, 0x7e, 0x5f, 0x05, 0x33, 0xff, 0xc0, 0xd4, 0xaa, 0x6e, 0xab, 0xdf, 0x1a, 0xb6, 0x4f,
-	0xc7, 0xd1, 0xae, 0xed, 0xa3, 0x09, 0x97, 0x5c, 0xa5, 0x60, 0x66, 0x78, 0x81, 0x2a, 0x05, 0xe5,
-	0x0c, 0x77, 0x90, 0x4d, 0x11, 0xe5, 0x95, 0x50, 0xf7, 0x93, 0xff, 0xcb, 0xcf, 0xd0, 0x8b, 0xb7,
-	0xaa, 0xf3, 0xa3, 0xe7, 0x45, 0xe8, 0xbd, 0x2e, 0x42, 0xef, 0x7b, 0x11, 0x92, 0xc1, 0x1b, 0xf1,
-	0xe9, 0x8d, 0xce, 0xb8, 0xfb, 0x93, 0xeb, 0x4f, 0x2e, 0x97, 0x6b, 0x4a, 0x56, 0x6b, 0x4a, 0xbe,
-	0xd6, 0x94, 0xbc, 0x6c, 0xa8, 0xb7, 0xda, 0x50, 0xef, 0x63, 0x43, 0xbd, 0xdb, 0x93, 0x5c, 0xb8,
-	0xbb, 0x87, 0x24, 0x4a, 0xb1, 0x60, 0xcd, 0xb7, 0xc7, 0x92, 0x27, 0x76, 0x3b, 0xb0, 0xf9, 0x68,
-	0xcc, 0x1e, 0xeb, 0x6a, 0xdd, 0x93, 0x06, 0x9b, 0xec, 0x57, 0x8d, 0x9e, 0xfd, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0xc7, 0xb2, 0x70, 0xe7, 0x43, 0x02, 0x00, 0x00,
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x93, 0xbf, 0x6e, 0xd4, 0x40,
+	0x10, 0xc6, 0xbd, 0xb9, 0x4b, 0x0e, 0x36, 0x08, 0x24, 0xeb, 0x0a, 0x93, 0xc2, 0x3e, 0xb9, 0x40,
+	0x27, 0xa1, 0xd8, 0x1c, 0xa4, 0x4a, 0x87, 0x41, 0x91, 0x40, 0x20, 0x45, 0xbe, 0xd0, 0x20, 0xa4,
+	0x68, 0x6d, 0x0f, 0x66, 0x95, 0xb5, 0x67, 0xe5, 0x5d, 0x2e, 0xc9, 0x1b, 0x50, 0x52, 0x52, 0xde,
+	0x83, 0x40, 0x1f, 0x51, 0xa5, 0x44, 0x14, 0x27, 0x74, 0xd7, 0x50, 0xe7, 0x09, 0xd0, 0xfa, 0x8f,
+	0x14, 0xa4, 0x74, 0x57, 0xa5, 0xf2, 0x8c, 0xbf, 0xd9, 0xdf, 0x7c, 0xb3, 0xab, 0xa1, 0x2e, 0xaa,
+	0x02, 0x15, 0x57, 0x61, 0xce, 0x8a, 0x22, 0x9c, 0x4d, 0x12, 0xd0, 0x6c, 0x12, 0xe6, 0x38, 0x0b,
+	0x64, 0x85, 0x1a, 0xed, 0x61, 0xab, 0x07, 0x46, 0x0f, 0x5a, 0x7d, 0x67, 0x98, 0x63, 0x8e, 0x75,
+	0x41, 0x68, 0xa2, 0xa6, 0x76, 0xc7, 0xbf, 0x99, 0x05, 0x25, 0x18, 0x40, 0x5d, 0xe3, 0xff, 0x20,
+	0xd4, 0x8b, 0x41, 0x0a, 0x96, 0xc2, 0x5b, 0x9e, 0x57, 0x4c, 0x73, 0x2c, 0x63, 0x48, 0xb1, 0xca,
+	0xd4, 0x61, 0x85, 0x12, 0x15, 0x13, 0xf6, 0x90, 0x6e, 0x6a, 0xae, 0x05, 0x38, 0x64, 0x44, 0xc6,
+	0x77, 0xe3, 0x26, 0xb1, 0x47, 0x74, 0x3b, 0x03, 0x95, 0x56, 0x5c, 0x9a, 0x33, 0xce, 0x46, 0xad,
+	0x5d, 0xff, 0x65, 0x1f, 0xd1, 0x41, 0xd5, 0xa0, 0x9c, 0xde, 0xa8, 0x37, 0xde, 0x7e, 0xba, 0x17,
+	0xdc, 0xe4, 0x3e, 0x88, 0x98, 0x60, 0x65, 0x0a, 0xd5, 0x11, 0xbe, 0xc0, 0x32, 0x85, 0x52, 0x57,
+	0x4c, 0x43, 0x76, 0x88, 0x28, 0xde, 0xf0, 0xf2, 0x24, 0xea, 0x5f, 0x2c, 0x3c, 0x2b, 0xee, 0x50,
+	0xfb, 0xf7, 0xbe, 0xcc, 0x3d, 0xeb, 0xdb, 0xdc, 0xb3, 0xfe, 0xce, 0x3d, 0xe2, 0x7f, 0x27, 0xd4,
+	0x7d, 0x27, 0x33, 0xa6, 0x6f, 0xa7, 0xfd, 0x9f, 0x1b, 0xf4, 0xe1, 0x14, 0xf4, 0x54, 0xb3, 0x44,
+	0xc0, 0xf4, 0x94, 0xc9, 0x03, 0x80, 0xf5, 0x9d, 0x3f, 0xa6, 0x03, 0x89, 0x28, 0x8e, 0x79, 0xe6,
+	0xf4, 0x46, 0x64, 0xdc, 0x8f, 0xec, 0xab, 0x85, 0x77, 0xff, 0x9c, 0x15, 0x62, 0xdf, 0x6f, 0x05,
+	0x3f, 0xde, 0x32, 0xd1, 0xab, 0xcc, 0xfe, 0x40, 0xef, 0xa8, 0x53, 0x26, 0x8f, 0x3f, 0x02, 0x38,
+	0x7d, 0xc3, 0x8a, 0x9e, 0x1b, 0xc7, 0xbf, 0x17, 0xde, 0xa3, 0x9c, 0xeb, 0x4f, 0x9f, 0x93, 0x20,
+	0xc5, 0x22, 0x4c, 0xeb, 0xd1, 0xdb, 0xcf, 0xae, 0xca, 0x4e, 0x42, 0x7d, 0x2e, 0x41, 0x05, 0x2f,
+	0x21, 0xbd, 0x5a, 0x78, 0x0f, 0x1a, 0x76, 0xc7, 0xf1, 0xe3, 0x81, 0x6a, 0x66, 0x31, 0x74, 0x38,
+	0xe3, 0xba, 0xa6, 0x6f, 0xae, 0x47, 0xef, 0x38, 0x7e, 0x3c, 0x30, 0xe1, 0x01, 0xc0, 0xff, 0x97,
+	0x19, 0xbd, 0xbe, 0x58, 0xba, 0xe4, 0x72, 0xe9, 0x92, 0x3f, 0x4b, 0x97, 0x7c, 0x5d, 0xb9, 0xd6,
+	0xe5, 0xca, 0xb5, 0x7e, 0xad, 0x5c, 0xeb, 0xfd, 0x93, 0x6b, 0xbd, 0xda, 0x37, 0xdc, 0x15, 0x2c,
+	0x51, 0x5d, 0x12, 0xce, 0x26, 0x7b, 0xe1, 0x59, 0xb3, 0x27, 0x75, 0xe7, 0x64, 0xab, 0x5e, 0x8f,
+	0x67, 0xff, 0x06, 0x00, 0xb1, 0x46, 0x2d, 0x1a, 0x90, 0x03, 0x00, 0x00,
 }
 
 func (this *ReplaceMigrationRecordsProposal) Equal(that interface{}) bool {
@@ -213,6 +266,42 @@ func (this *UpdateMigrationRecordsProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SetStableSwapFeesProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetStableSwapFeesProposal)
+	if !ok {
+		that2, ok := that.(SetStableSwapFeesProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.SwapFee.Equal(that1.SwapFee) {
+		return false
+	}
+	if !this.ExitFee.Equal(that1.ExitFee) {
+		return false
+	}
+	return true
+}
 func (m *ReplaceMigrationRecordsProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -315,6 +404,68 @@ func (m *UpdateMigrationRecordsProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *SetStableSwapFeesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetStableSwapFeesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetStableSwapFeesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExitFee.Size()
+		i -= size
+		if _, err := m.ExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -372,6 +523,30 @@ func (m *UpdateMigrationRecordsProposal) Size() (n int) {
 	return n
 }
 
+func (m *SetStableSwapFeesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -674,6 +849,207 @@ func (m *UpdateMigrationRecordsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetStableSwapFeesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetStableSwapFeesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetStableSwapFeesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
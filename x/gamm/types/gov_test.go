@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	proto "github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 
@@ -73,3 +75,56 @@ func TestUpdateMigrationRecordsProposalMarshalUnmarshal(t *testing.T) {
 		require.Equal(t, *test.proposal, decoded)
 	}
 }
+
+func TestSetStableSwapFeesProposalMarshalUnmarshal(t *testing.T) {
+	proposal := types.SetStableSwapFeesProposal{
+		Title:       "title",
+		Description: "proposal to set stableswap fees",
+		PoolId:      1,
+		SwapFee:     sdk.MustNewDecFromStr("0.003"),
+		ExitFee:     sdk.ZeroDec(),
+	}
+
+	bz, err := proto.Marshal(&proposal)
+	require.NoError(t, err)
+	decoded := types.SetStableSwapFeesProposal{}
+	err = proto.Unmarshal(bz, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, proposal, decoded)
+}
+
+func TestSetStableSwapFeesProposalValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		proposal  govtypes.Content
+		expectErr bool
+	}{
+		"valid proposal": {
+			proposal: types.NewSetStableSwapFeesProposal("title", "description", 1, sdk.MustNewDecFromStr("0.003"), sdk.ZeroDec()),
+		},
+		"empty title": {
+			proposal:  types.NewSetStableSwapFeesProposal("", "description", 1, sdk.MustNewDecFromStr("0.003"), sdk.ZeroDec()),
+			expectErr: true,
+		},
+		"zero pool id": {
+			proposal:  types.NewSetStableSwapFeesProposal("title", "description", 0, sdk.MustNewDecFromStr("0.003"), sdk.ZeroDec()),
+			expectErr: true,
+		},
+		"unset exit fee": {
+			proposal:  types.NewSetStableSwapFeesProposal("title", "description", 1, sdk.MustNewDecFromStr("0.003"), sdk.Dec{}),
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, types.ProposalTypeSetStableSwapFees, tc.proposal.ProposalType())
+			require.Equal(t, types.RouterKey, tc.proposal.ProposalRoute())
+		})
+	}
+}
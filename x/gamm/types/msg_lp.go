@@ -23,6 +23,7 @@ var (
 	_ LiquidityChangeMsg = MsgJoinPool{}
 	_ LiquidityChangeMsg = MsgJoinSwapExternAmountIn{}
 	_ LiquidityChangeMsg = MsgJoinSwapShareAmountOut{}
+	_ LiquidityChangeMsg = MsgJoinPoolWithArbitraryTokens{}
 )
 
 func (msg MsgExitPool) LiquidityChangeType() LiquidityChangeType {
@@ -48,3 +49,7 @@ func (msg MsgJoinSwapExternAmountIn) LiquidityChangeType() LiquidityChangeType {
 func (msg MsgJoinSwapShareAmountOut) LiquidityChangeType() LiquidityChangeType {
 	return AddLiquidity
 }
+
+func (msg MsgJoinPoolWithArbitraryTokens) LiquidityChangeType() LiquidityChangeType {
+	return AddLiquidity
+}
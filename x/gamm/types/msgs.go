@@ -11,14 +11,15 @@ import (
 
 // constants.
 const (
-	TypeMsgSwapExactAmountIn       = "swap_exact_amount_in"
-	TypeMsgSwapExactAmountOut      = "swap_exact_amount_out"
-	TypeMsgJoinPool                = "join_pool"
-	TypeMsgExitPool                = "exit_pool"
-	TypeMsgJoinSwapExternAmountIn  = "join_swap_extern_amount_in"
-	TypeMsgJoinSwapShareAmountOut  = "join_swap_share_amount_out"
-	TypeMsgExitSwapExternAmountOut = "exit_swap_extern_amount_out"
-	TypeMsgExitSwapShareAmountIn   = "exit_swap_share_amount_in"
+	TypeMsgSwapExactAmountIn           = "swap_exact_amount_in"
+	TypeMsgSwapExactAmountOut          = "swap_exact_amount_out"
+	TypeMsgJoinPool                    = "join_pool"
+	TypeMsgExitPool                    = "exit_pool"
+	TypeMsgJoinSwapExternAmountIn      = "join_swap_extern_amount_in"
+	TypeMsgJoinSwapShareAmountOut      = "join_swap_share_amount_out"
+	TypeMsgExitSwapExternAmountOut     = "exit_swap_extern_amount_out"
+	TypeMsgExitSwapShareAmountIn       = "exit_swap_share_amount_in"
+	TypeMsgJoinPoolWithArbitraryTokens = "join_pool_with_arbitrary_tokens"
 )
 
 func ValidateFutureGovernor(governor string) error {
@@ -347,3 +348,41 @@ func (msg MsgExitSwapShareAmountIn) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgJoinPoolWithArbitraryTokens{}
+
+func (msg MsgJoinPoolWithArbitraryTokens) Route() string { return RouterKey }
+func (msg MsgJoinPoolWithArbitraryTokens) Type() string  { return TypeMsgJoinPoolWithArbitraryTokens }
+func (msg MsgJoinPoolWithArbitraryTokens) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.TokensIn.Empty() || !msg.TokensIn.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokensIn.String())
+	}
+
+	err = ValidateTokenInRoutes(msg.TokenInRoutes, msg.TokensIn)
+	if err != nil {
+		return err
+	}
+
+	if !msg.ShareOutMinAmount.IsPositive() {
+		return sdkerrors.Wrap(ErrNotPositiveCriteria, msg.ShareOutMinAmount.String())
+	}
+
+	return nil
+}
+
+func (msg MsgJoinPoolWithArbitraryTokens) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgJoinPoolWithArbitraryTokens) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -573,6 +573,142 @@ func TestMsgJoinSwapExternAmountIn(t *testing.T) {
 	}
 }
 
+func TestMsgJoinPoolWithArbitraryTokens(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg gammtypes.MsgJoinPoolWithArbitraryTokens) gammtypes.MsgJoinPoolWithArbitraryTokens) gammtypes.MsgJoinPoolWithArbitraryTokens {
+		properMsg := gammtypes.MsgJoinPoolWithArbitraryTokens{
+			Sender:   addr1,
+			PoolId:   1,
+			TokensIn: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100)), sdk.NewCoin("test", sdk.NewInt(100))),
+			TokenInRoutes: []gammtypes.TokenInRoutes{{
+				TokenInDenom: "foo",
+				Routes:       []poolmanagertypes.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "test"}},
+			}},
+			ShareOutMinAmount: sdk.NewInt(100),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg gammtypes.MsgJoinPoolWithArbitraryTokens) gammtypes.MsgJoinPoolWithArbitraryTokens {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), gammtypes.RouterKey)
+	require.Equal(t, msg.Type(), "join_pool_with_arbitrary_tokens")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        gammtypes.MsgJoinPoolWithArbitraryTokens
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithArbitraryTokens) gammtypes.MsgJoinPoolWithArbitraryTokens {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "no token in routes",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithArbitraryTokens) gammtypes.MsgJoinPoolWithArbitraryTokens {
+				msg.TokenInRoutes = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithArbitraryTokens) gammtypes.MsgJoinPoolWithArbitraryTokens {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "no tokens in",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithArbitraryTokens) gammtypes.MsgJoinPoolWithArbitraryTokens {
+				msg.TokensIn = sdk.Coins{}
+				msg.TokenInRoutes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithArbitraryTokens) gammtypes.MsgJoinPoolWithArbitraryTokens {
+				msg.TokensIn[1].Amount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "unsorted tokens in",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithArbitraryTokens) gammtypes.MsgJoinPoolWithArbitraryTokens {
+				msg.TokensIn = sdk.Coins{msg.TokensIn[1], msg.TokensIn[0]}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "routes for a denom that is not a token in",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithArbitraryTokens) gammtypes.MsgJoinPoolWithArbitraryTokens {
+				msg.TokenInRoutes[0].TokenInDenom = "bar"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate routes for a token in",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithArbitraryTokens) gammtypes.MsgJoinPoolWithArbitraryTokens {
+				msg.TokenInRoutes = append(msg.TokenInRoutes, msg.TokenInRoutes[0])
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithArbitraryTokens) gammtypes.MsgJoinPoolWithArbitraryTokens {
+				msg.TokenInRoutes[0].Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid route denom",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithArbitraryTokens) gammtypes.MsgJoinPoolWithArbitraryTokens {
+				msg.TokenInRoutes[0].Routes[0].TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero criteria",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolWithArbitraryTokens) gammtypes.MsgJoinPoolWithArbitraryTokens {
+				msg.ShareOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestMsgJoinSwapShareAmountOut(t *testing.T) {
 	appParams.SetAddressPrefixes()
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -928,6 +1064,15 @@ func TestAuthzMsg(t *testing.T) {
 				ShareOutMinAmount: sdk.NewInt(1),
 			},
 		},
+		{
+			name: "MsgJoinPoolWithArbitraryTokens",
+			gammMsg: &gammtypes.MsgJoinPoolWithArbitraryTokens{
+				Sender:            addr1,
+				PoolId:            1,
+				TokensIn:          sdk.NewCoins(coin),
+				ShareOutMinAmount: sdk.NewInt(1),
+			},
+		},
 		{
 			name: "MsgJoinSwapShareAmountOut",
 			gammMsg: &gammtypes.MsgSwapExactAmountIn{
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	appparams "github.com/osmosis-labs/osmosis/v14/app/params"
 
@@ -11,7 +12,12 @@ import (
 
 // Parameter store keys.
 var (
-	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyPoolCreationFee           = []byte("PoolCreationFee")
+	KeyScalingFactorRampDuration = []byte("ScalingFactorRampDuration")
+
+	// DefaultScalingFactorRampDuration is the default time it takes a stableswap pool
+	// to move to newly set scaling factors.
+	DefaultScalingFactorRampDuration = 24 * time.Hour
 )
 
 // ParamTable for gamm module.
@@ -19,16 +25,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(poolCreationFee sdk.Coins) Params {
+func NewParams(poolCreationFee sdk.Coins, scalingFactorRampDuration time.Duration) Params {
 	return Params{
-		PoolCreationFee: poolCreationFee,
+		PoolCreationFee:           poolCreationFee,
+		ScalingFactorRampDuration: scalingFactorRampDuration,
 	}
 }
 
 // default gamm module parameters.
 func DefaultParams() Params {
 	return Params{
-		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		PoolCreationFee:           sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		ScalingFactorRampDuration: DefaultScalingFactorRampDuration,
 	}
 }
 
@@ -38,6 +46,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateScalingFactorRampDuration(p.ScalingFactorRampDuration); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -45,6 +57,7 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyScalingFactorRampDuration, &p.ScalingFactorRampDuration, validateScalingFactorRampDuration),
 	}
 }
 
@@ -60,3 +73,16 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateScalingFactorRampDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("scaling factor ramp duration must not be negative: %s", v)
+	}
+
+	return nil
+}
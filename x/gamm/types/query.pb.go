@@ -14,7 +14,9 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
-	types2 "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types3 "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -22,12 +24,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -494,6 +498,120 @@ func (m *QueryCalcJoinPoolSharesResponse) GetTokensOut() github_com_cosmos_cosmo
 	return nil
 }
 
+// =============================== EstimateJoinPoolWithArbitraryTokens
+type QueryEstimateJoinPoolWithArbitraryTokensRequest struct {
+	PoolId        uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokensIn      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tokens_in,json=tokensIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_in"`
+	TokenInRoutes []TokenInRoutes                          `protobuf:"bytes,3,rep,name=token_in_routes,json=tokenInRoutes,proto3" json:"token_in_routes"`
+}
+
+func (m *QueryEstimateJoinPoolWithArbitraryTokensRequest) Reset() {
+	*m = QueryEstimateJoinPoolWithArbitraryTokensRequest{}
+}
+func (m *QueryEstimateJoinPoolWithArbitraryTokensRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryEstimateJoinPoolWithArbitraryTokensRequest) ProtoMessage() {}
+func (*QueryEstimateJoinPoolWithArbitraryTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{10}
+}
+func (m *QueryEstimateJoinPoolWithArbitraryTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateJoinPoolWithArbitraryTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateJoinPoolWithArbitraryTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateJoinPoolWithArbitraryTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateJoinPoolWithArbitraryTokensRequest.Merge(m, src)
+}
+func (m *QueryEstimateJoinPoolWithArbitraryTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateJoinPoolWithArbitraryTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateJoinPoolWithArbitraryTokensRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateJoinPoolWithArbitraryTokensRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateJoinPoolWithArbitraryTokensRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryEstimateJoinPoolWithArbitraryTokensRequest) GetTokensIn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensIn
+	}
+	return nil
+}
+
+func (m *QueryEstimateJoinPoolWithArbitraryTokensRequest) GetTokenInRoutes() []TokenInRoutes {
+	if m != nil {
+		return m.TokenInRoutes
+	}
+	return nil
+}
+
+type QueryEstimateJoinPoolWithArbitraryTokensResponse struct {
+	ShareOutAmount github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,1,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_amount" yaml:"share_out_amount"`
+	TokensJoined   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tokens_joined,json=tokensJoined,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_joined"`
+}
+
+func (m *QueryEstimateJoinPoolWithArbitraryTokensResponse) Reset() {
+	*m = QueryEstimateJoinPoolWithArbitraryTokensResponse{}
+}
+func (m *QueryEstimateJoinPoolWithArbitraryTokensResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryEstimateJoinPoolWithArbitraryTokensResponse) ProtoMessage() {}
+func (*QueryEstimateJoinPoolWithArbitraryTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{11}
+}
+func (m *QueryEstimateJoinPoolWithArbitraryTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateJoinPoolWithArbitraryTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateJoinPoolWithArbitraryTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateJoinPoolWithArbitraryTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateJoinPoolWithArbitraryTokensResponse.Merge(m, src)
+}
+func (m *QueryEstimateJoinPoolWithArbitraryTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateJoinPoolWithArbitraryTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateJoinPoolWithArbitraryTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateJoinPoolWithArbitraryTokensResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateJoinPoolWithArbitraryTokensResponse) GetTokensJoined() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensJoined
+	}
+	return nil
+}
+
 // =============================== CalcExitPoolCoinsFromShares
 type QueryCalcExitPoolCoinsFromSharesRequest struct {
 	PoolId        uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
@@ -506,7 +624,7 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Reset() {
 func (m *QueryCalcExitPoolCoinsFromSharesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCalcExitPoolCoinsFromSharesRequest) ProtoMessage()    {}
 func (*QueryCalcExitPoolCoinsFromSharesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{10}
+	return fileDescriptor_d9a717df9ca609ef, []int{12}
 }
 func (m *QueryCalcExitPoolCoinsFromSharesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -552,7 +670,7 @@ func (m *QueryCalcExitPoolCoinsFromSharesResponse) Reset() {
 func (m *QueryCalcExitPoolCoinsFromSharesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCalcExitPoolCoinsFromSharesResponse) ProtoMessage()    {}
 func (*QueryCalcExitPoolCoinsFromSharesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{11}
+	return fileDescriptor_d9a717df9ca609ef, []int{13}
 }
 func (m *QueryCalcExitPoolCoinsFromSharesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -597,7 +715,7 @@ func (m *QueryPoolParamsRequest) Reset()         { *m = QueryPoolParamsRequest{}
 func (m *QueryPoolParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolParamsRequest) ProtoMessage()    {}
 func (*QueryPoolParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{12}
+	return fileDescriptor_d9a717df9ca609ef, []int{14}
 }
 func (m *QueryPoolParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -641,7 +759,7 @@ func (m *QueryPoolParamsResponse) Reset()         { *m = QueryPoolParamsResponse
 func (m *QueryPoolParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolParamsResponse) ProtoMessage()    {}
 func (*QueryPoolParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{13}
+	return fileDescriptor_d9a717df9ca609ef, []int{15}
 }
 func (m *QueryPoolParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,7 +804,7 @@ func (m *QueryTotalPoolLiquidityRequest) Reset()         { *m = QueryTotalPoolLi
 func (m *QueryTotalPoolLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalPoolLiquidityRequest) ProtoMessage()    {}
 func (*QueryTotalPoolLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{14}
+	return fileDescriptor_d9a717df9ca609ef, []int{16}
 }
 func (m *QueryTotalPoolLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +848,7 @@ func (m *QueryTotalPoolLiquidityResponse) Reset()         { *m = QueryTotalPoolL
 func (m *QueryTotalPoolLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalPoolLiquidityResponse) ProtoMessage()    {}
 func (*QueryTotalPoolLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{15}
+	return fileDescriptor_d9a717df9ca609ef, []int{17}
 }
 func (m *QueryTotalPoolLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +893,7 @@ func (m *QueryTotalSharesRequest) Reset()         { *m = QueryTotalSharesRequest
 func (m *QueryTotalSharesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSharesRequest) ProtoMessage()    {}
 func (*QueryTotalSharesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{16}
+	return fileDescriptor_d9a717df9ca609ef, []int{18}
 }
 func (m *QueryTotalSharesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -819,7 +937,7 @@ func (m *QueryTotalSharesResponse) Reset()         { *m = QueryTotalSharesRespon
 func (m *QueryTotalSharesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSharesResponse) ProtoMessage()    {}
 func (*QueryTotalSharesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{17}
+	return fileDescriptor_d9a717df9ca609ef, []int{19}
 }
 func (m *QueryTotalSharesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,24 +973,23 @@ func (m *QueryTotalSharesResponse) GetTotalShares() types1.Coin {
 	return types1.Coin{}
 }
 
-// =============================== CalcJoinPoolNoSwapShares
-type QueryCalcJoinPoolNoSwapSharesRequest struct {
-	PoolId   uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	TokensIn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tokens_in,json=tokensIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_in"`
+// =============================== LBPStatus
+type QueryLBPStatusRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) Reset()         { *m = QueryCalcJoinPoolNoSwapSharesRequest{} }
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCalcJoinPoolNoSwapSharesRequest) ProtoMessage()    {}
-func (*QueryCalcJoinPoolNoSwapSharesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{18}
+func (m *QueryLBPStatusRequest) Reset()         { *m = QueryLBPStatusRequest{} }
+func (m *QueryLBPStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLBPStatusRequest) ProtoMessage()    {}
+func (*QueryLBPStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{20}
 }
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLBPStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLBPStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLBPStatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -882,49 +999,44 @@ func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.Merge(m, src)
+func (m *QueryLBPStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLBPStatusRequest.Merge(m, src)
 }
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Size() int {
+func (m *QueryLBPStatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.DiscardUnknown(m)
+func (m *QueryLBPStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLBPStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLBPStatusRequest proto.InternalMessageInfo
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) GetPoolId() uint64 {
+func (m *QueryLBPStatusRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) GetTokensIn() github_com_cosmos_cosmos_sdk_types.Coins {
-	if m != nil {
-		return m.TokensIn
-	}
-	return nil
-}
-
-type QueryCalcJoinPoolNoSwapSharesResponse struct {
-	TokensOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tokens_out,json=tokensOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_out" yaml:"tokens_out"`
-	SharesOut github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,2,opt,name=shares_out,json=sharesOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shares_out"`
+// DenomWeight is the weight of a denom in a balancer pool, normalized by the
+// total weight of the pool.
+type DenomWeight struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) Reset()         { *m = QueryCalcJoinPoolNoSwapSharesResponse{} }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCalcJoinPoolNoSwapSharesResponse) ProtoMessage()    {}
-func (*QueryCalcJoinPoolNoSwapSharesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{19}
+func (m *DenomWeight) Reset()         { *m = DenomWeight{} }
+func (m *DenomWeight) String() string { return proto.CompactTextString(m) }
+func (*DenomWeight) ProtoMessage()    {}
+func (*DenomWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{21}
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Unmarshal(b []byte) error {
+func (m *DenomWeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DenomWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DenomWeight.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -934,47 +1046,48 @@ func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.Merge(m, src)
+func (m *DenomWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomWeight.Merge(m, src)
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Size() int {
+func (m *DenomWeight) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.DiscardUnknown(m)
+func (m *DenomWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomWeight.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse proto.InternalMessageInfo
+var xxx_messageInfo_DenomWeight proto.InternalMessageInfo
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) GetTokensOut() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *DenomWeight) GetDenom() string {
 	if m != nil {
-		return m.TokensOut
+		return m.Denom
 	}
-	return nil
+	return ""
 }
 
-// QuerySpotPriceRequest defines the gRPC request structure for a SpotPrice
-// query.
-//
-// Deprecated: Do not use.
-type QuerySpotPriceRequest struct {
-	PoolId          uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	BaseAssetDenom  string `protobuf:"bytes,2,opt,name=base_asset_denom,json=baseAssetDenom,proto3" json:"base_asset_denom,omitempty" yaml:"base_asset_denom"`
-	QuoteAssetDenom string `protobuf:"bytes,3,opt,name=quote_asset_denom,json=quoteAssetDenom,proto3" json:"quote_asset_denom,omitempty" yaml:"quote_asset_denom"`
+type QueryLBPStatusResponse struct {
+	CurrentWeights []DenomWeight `protobuf:"bytes,1,rep,name=current_weights,json=currentWeights,proto3" json:"current_weights" yaml:"current_weights"`
+	// target_weights are empty if no smooth weight change is scheduled
+	TargetWeights []DenomWeight `protobuf:"bytes,2,rep,name=target_weights,json=targetWeights,proto3" json:"target_weights" yaml:"target_weights"`
+	StartTime     time.Time     `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// time_remaining is the time left until the target weights are reached
+	TimeRemaining  time.Duration `protobuf:"bytes,4,opt,name=time_remaining,json=timeRemaining,proto3,stdduration" json:"time_remaining" yaml:"time_remaining"`
+	SwapsPaused    bool          `protobuf:"varint,5,opt,name=swaps_paused,json=swapsPaused,proto3" json:"swaps_paused,omitempty" yaml:"swaps_paused"`
+	PoolController string        `protobuf:"bytes,6,opt,name=pool_controller,json=poolController,proto3" json:"pool_controller,omitempty" yaml:"pool_controller"`
 }
 
-func (m *QuerySpotPriceRequest) Reset()         { *m = QuerySpotPriceRequest{} }
-func (m *QuerySpotPriceRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySpotPriceRequest) ProtoMessage()    {}
-func (*QuerySpotPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{20}
+func (m *QueryLBPStatusResponse) Reset()         { *m = QueryLBPStatusResponse{} }
+func (m *QueryLBPStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLBPStatusResponse) ProtoMessage()    {}
+func (*QueryLBPStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{22}
 }
-func (m *QuerySpotPriceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLBPStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySpotPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLBPStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySpotPriceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLBPStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -984,59 +1097,77 @@ func (m *QuerySpotPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QuerySpotPriceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySpotPriceRequest.Merge(m, src)
+func (m *QueryLBPStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLBPStatusResponse.Merge(m, src)
 }
-func (m *QuerySpotPriceRequest) XXX_Size() int {
+func (m *QueryLBPStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySpotPriceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySpotPriceRequest.DiscardUnknown(m)
+func (m *QueryLBPStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLBPStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySpotPriceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLBPStatusResponse proto.InternalMessageInfo
 
-func (m *QuerySpotPriceRequest) GetPoolId() uint64 {
+func (m *QueryLBPStatusResponse) GetCurrentWeights() []DenomWeight {
 	if m != nil {
-		return m.PoolId
+		return m.CurrentWeights
+	}
+	return nil
+}
+
+func (m *QueryLBPStatusResponse) GetTargetWeights() []DenomWeight {
+	if m != nil {
+		return m.TargetWeights
+	}
+	return nil
+}
+
+func (m *QueryLBPStatusResponse) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryLBPStatusResponse) GetTimeRemaining() time.Duration {
+	if m != nil {
+		return m.TimeRemaining
 	}
 	return 0
 }
 
-func (m *QuerySpotPriceRequest) GetBaseAssetDenom() string {
+func (m *QueryLBPStatusResponse) GetSwapsPaused() bool {
 	if m != nil {
-		return m.BaseAssetDenom
+		return m.SwapsPaused
 	}
-	return ""
+	return false
 }
 
-func (m *QuerySpotPriceRequest) GetQuoteAssetDenom() string {
+func (m *QueryLBPStatusResponse) GetPoolController() string {
 	if m != nil {
-		return m.QuoteAssetDenom
+		return m.PoolController
 	}
 	return ""
 }
 
-type QueryPoolsWithFilterRequest struct {
-	// String of the coins in single string seperated by comma. Ex)
-	// 10uatom,100uosmo
-	MinLiquidity string             `protobuf:"bytes,1,opt,name=min_liquidity,json=minLiquidity,proto3" json:"min_liquidity,omitempty" yaml:"min_liquidity"`
-	PoolType     string             `protobuf:"bytes,2,opt,name=pool_type,json=poolType,proto3" json:"pool_type,omitempty"`
-	Pagination   *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// =============================== ScalingFactorRamp
+type QueryScalingFactorRampRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (m *QueryPoolsWithFilterRequest) Reset()         { *m = QueryPoolsWithFilterRequest{} }
-func (m *QueryPoolsWithFilterRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolsWithFilterRequest) ProtoMessage()    {}
-func (*QueryPoolsWithFilterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{21}
+func (m *QueryScalingFactorRampRequest) Reset()         { *m = QueryScalingFactorRampRequest{} }
+func (m *QueryScalingFactorRampRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScalingFactorRampRequest) ProtoMessage()    {}
+func (*QueryScalingFactorRampRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{23}
 }
-func (m *QueryPoolsWithFilterRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryScalingFactorRampRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolsWithFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryScalingFactorRampRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolsWithFilterRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryScalingFactorRampRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1046,57 +1177,47 @@ func (m *QueryPoolsWithFilterRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolsWithFilterRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolsWithFilterRequest.Merge(m, src)
+func (m *QueryScalingFactorRampRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScalingFactorRampRequest.Merge(m, src)
 }
-func (m *QueryPoolsWithFilterRequest) XXX_Size() int {
+func (m *QueryScalingFactorRampRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolsWithFilterRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolsWithFilterRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryPoolsWithFilterRequest proto.InternalMessageInfo
-
-func (m *QueryPoolsWithFilterRequest) GetMinLiquidity() string {
-	if m != nil {
-		return m.MinLiquidity
-	}
-	return ""
+func (m *QueryScalingFactorRampRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScalingFactorRampRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPoolsWithFilterRequest) GetPoolType() string {
-	if m != nil {
-		return m.PoolType
-	}
-	return ""
-}
+var xxx_messageInfo_QueryScalingFactorRampRequest proto.InternalMessageInfo
 
-func (m *QueryPoolsWithFilterRequest) GetPagination() *query.PageRequest {
+func (m *QueryScalingFactorRampRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.PoolId
 	}
-	return nil
+	return 0
 }
 
-type QueryPoolsWithFilterResponse struct {
-	Pools []*types.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryScalingFactorRampResponse struct {
+	CurrentScalingFactors []uint64 `protobuf:"varint,1,rep,packed,name=current_scaling_factors,json=currentScalingFactors,proto3" json:"current_scaling_factors,omitempty" yaml:"current_scaling_factors"`
+	// target_scaling_factors are empty if no scaling factor ramp is in progress
+	TargetScalingFactors []uint64  `protobuf:"varint,2,rep,packed,name=target_scaling_factors,json=targetScalingFactors,proto3" json:"target_scaling_factors,omitempty" yaml:"target_scaling_factors"`
+	StartTime            time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// time_remaining is the time left until the target scaling factors are
+	// reached
+	TimeRemaining time.Duration `protobuf:"bytes,4,opt,name=time_remaining,json=timeRemaining,proto3,stdduration" json:"time_remaining" yaml:"time_remaining"`
 }
 
-func (m *QueryPoolsWithFilterResponse) Reset()         { *m = QueryPoolsWithFilterResponse{} }
-func (m *QueryPoolsWithFilterResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolsWithFilterResponse) ProtoMessage()    {}
-func (*QueryPoolsWithFilterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{22}
+func (m *QueryScalingFactorRampResponse) Reset()         { *m = QueryScalingFactorRampResponse{} }
+func (m *QueryScalingFactorRampResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScalingFactorRampResponse) ProtoMessage()    {}
+func (*QueryScalingFactorRampResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{24}
 }
-func (m *QueryPoolsWithFilterResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryScalingFactorRampResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolsWithFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryScalingFactorRampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolsWithFilterResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryScalingFactorRampResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1106,53 +1227,63 @@ func (m *QueryPoolsWithFilterResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolsWithFilterResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolsWithFilterResponse.Merge(m, src)
+func (m *QueryScalingFactorRampResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScalingFactorRampResponse.Merge(m, src)
 }
-func (m *QueryPoolsWithFilterResponse) XXX_Size() int {
+func (m *QueryScalingFactorRampResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolsWithFilterResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolsWithFilterResponse.DiscardUnknown(m)
+func (m *QueryScalingFactorRampResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScalingFactorRampResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolsWithFilterResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryScalingFactorRampResponse proto.InternalMessageInfo
 
-func (m *QueryPoolsWithFilterResponse) GetPools() []*types.Any {
+func (m *QueryScalingFactorRampResponse) GetCurrentScalingFactors() []uint64 {
 	if m != nil {
-		return m.Pools
+		return m.CurrentScalingFactors
 	}
 	return nil
 }
 
-func (m *QueryPoolsWithFilterResponse) GetPagination() *query.PageResponse {
+func (m *QueryScalingFactorRampResponse) GetTargetScalingFactors() []uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.TargetScalingFactors
 	}
 	return nil
 }
 
-// QuerySpotPriceResponse defines the gRPC response structure for a SpotPrice
-// query.
-//
-// Deprecated: Do not use.
-type QuerySpotPriceResponse struct {
-	// String of the Dec. Ex) 10.203uatom
-	SpotPrice string `protobuf:"bytes,1,opt,name=spot_price,json=spotPrice,proto3" json:"spot_price,omitempty" yaml:"spot_price"`
+func (m *QueryScalingFactorRampResponse) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
 }
 
-func (m *QuerySpotPriceResponse) Reset()         { *m = QuerySpotPriceResponse{} }
-func (m *QuerySpotPriceResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySpotPriceResponse) ProtoMessage()    {}
-func (*QuerySpotPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{23}
+func (m *QueryScalingFactorRampResponse) GetTimeRemaining() time.Duration {
+	if m != nil {
+		return m.TimeRemaining
+	}
+	return 0
 }
-func (m *QuerySpotPriceResponse) XXX_Unmarshal(b []byte) error {
+
+// =============================== CalcMigration
+type QueryCalcMigrationRequest struct {
+	SharesToMigrate types1.Coin `protobuf:"bytes,1,opt,name=shares_to_migrate,json=sharesToMigrate,proto3" json:"shares_to_migrate" yaml:"shares_to_migrate"`
+}
+
+func (m *QueryCalcMigrationRequest) Reset()         { *m = QueryCalcMigrationRequest{} }
+func (m *QueryCalcMigrationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCalcMigrationRequest) ProtoMessage()    {}
+func (*QueryCalcMigrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{25}
+}
+func (m *QueryCalcMigrationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySpotPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCalcMigrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySpotPriceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCalcMigrationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1162,47 +1293,47 @@ func (m *QuerySpotPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QuerySpotPriceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySpotPriceResponse.Merge(m, src)
+func (m *QueryCalcMigrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalcMigrationRequest.Merge(m, src)
 }
-func (m *QuerySpotPriceResponse) XXX_Size() int {
+func (m *QueryCalcMigrationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySpotPriceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySpotPriceResponse.DiscardUnknown(m)
+func (m *QueryCalcMigrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalcMigrationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySpotPriceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCalcMigrationRequest proto.InternalMessageInfo
 
-func (m *QuerySpotPriceResponse) GetSpotPrice() string {
+func (m *QueryCalcMigrationRequest) GetSharesToMigrate() types1.Coin {
 	if m != nil {
-		return m.SpotPrice
+		return m.SharesToMigrate
 	}
-	return ""
+	return types1.Coin{}
 }
 
-// =============================== EstimateSwapExactAmountIn
-//
-// Deprecated: Do not use.
-type QuerySwapExactAmountInRequest struct {
-	Sender  string                     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	PoolId  uint64                     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	TokenIn string                     `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
-	Routes  []types2.SwapAmountInRoute `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+type QueryCalcMigrationResponse struct {
+	PoolIdEntering   uint64                                 `protobuf:"varint,1,opt,name=pool_id_entering,json=poolIdEntering,proto3" json:"pool_id_entering,omitempty" yaml:"pool_id_entering"`
+	Amount0          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount0" yaml:"amount0"`
+	Amount1          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount1" yaml:"amount1"`
+	LiquidityCreated github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=liquidity_created,json=liquidityCreated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_created" yaml:"liquidity_created"`
+	// refunded_dust are the exited tokens that do not fit the concentrated
+	// pool's price and are left with the owner of the shares
+	RefundedDust github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=refunded_dust,json=refundedDust,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_dust" yaml:"refunded_dust"`
 }
 
-func (m *QuerySwapExactAmountInRequest) Reset()         { *m = QuerySwapExactAmountInRequest{} }
-func (m *QuerySwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySwapExactAmountInRequest) ProtoMessage()    {}
-func (*QuerySwapExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{24}
+func (m *QueryCalcMigrationResponse) Reset()         { *m = QueryCalcMigrationResponse{} }
+func (m *QueryCalcMigrationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCalcMigrationResponse) ProtoMessage()    {}
+func (*QueryCalcMigrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{26}
 }
-func (m *QuerySwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCalcMigrationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySwapExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCalcMigrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySwapExactAmountInRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCalcMigrationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1212,63 +1343,102 @@ func (m *QuerySwapExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QuerySwapExactAmountInRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySwapExactAmountInRequest.Merge(m, src)
+func (m *QueryCalcMigrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalcMigrationResponse.Merge(m, src)
 }
-func (m *QuerySwapExactAmountInRequest) XXX_Size() int {
+func (m *QueryCalcMigrationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySwapExactAmountInRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySwapExactAmountInRequest.DiscardUnknown(m)
+func (m *QueryCalcMigrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalcMigrationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySwapExactAmountInRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCalcMigrationResponse proto.InternalMessageInfo
 
-func (m *QuerySwapExactAmountInRequest) GetSender() string {
+func (m *QueryCalcMigrationResponse) GetPoolIdEntering() uint64 {
 	if m != nil {
-		return m.Sender
+		return m.PoolIdEntering
 	}
-	return ""
+	return 0
 }
 
-func (m *QuerySwapExactAmountInRequest) GetPoolId() uint64 {
+func (m *QueryCalcMigrationResponse) GetRefundedDust() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.PoolId
+		return m.RefundedDust
 	}
-	return 0
+	return nil
 }
 
-func (m *QuerySwapExactAmountInRequest) GetTokenIn() string {
+// =============================== CalcJoinPoolNoSwapShares
+type QueryCalcJoinPoolNoSwapSharesRequest struct {
+	PoolId   uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokensIn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tokens_in,json=tokensIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_in"`
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) Reset()         { *m = QueryCalcJoinPoolNoSwapSharesRequest{} }
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCalcJoinPoolNoSwapSharesRequest) ProtoMessage()    {}
+func (*QueryCalcJoinPoolNoSwapSharesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{27}
+}
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.Merge(m, src)
+}
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest proto.InternalMessageInfo
+
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.TokenIn
+		return m.PoolId
 	}
-	return ""
+	return 0
 }
 
-func (m *QuerySwapExactAmountInRequest) GetRoutes() []types2.SwapAmountInRoute {
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) GetTokensIn() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Routes
+		return m.TokensIn
 	}
 	return nil
 }
 
-// Deprecated: Do not use.
-type QuerySwapExactAmountInResponse struct {
-	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+type QueryCalcJoinPoolNoSwapSharesResponse struct {
+	TokensOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tokens_out,json=tokensOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_out" yaml:"tokens_out"`
+	SharesOut github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,2,opt,name=shares_out,json=sharesOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shares_out"`
 }
 
-func (m *QuerySwapExactAmountInResponse) Reset()         { *m = QuerySwapExactAmountInResponse{} }
-func (m *QuerySwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySwapExactAmountInResponse) ProtoMessage()    {}
-func (*QuerySwapExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{25}
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) Reset()         { *m = QueryCalcJoinPoolNoSwapSharesResponse{} }
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCalcJoinPoolNoSwapSharesResponse) ProtoMessage()    {}
+func (*QueryCalcJoinPoolNoSwapSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{28}
 }
-func (m *QuerySwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySwapExactAmountInResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1278,40 +1448,47 @@ func (m *QuerySwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QuerySwapExactAmountInResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySwapExactAmountInResponse.Merge(m, src)
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.Merge(m, src)
 }
-func (m *QuerySwapExactAmountInResponse) XXX_Size() int {
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySwapExactAmountInResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySwapExactAmountInResponse.DiscardUnknown(m)
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySwapExactAmountInResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse proto.InternalMessageInfo
 
-// =============================== EstimateSwapExactAmountOut
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) GetTokensOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensOut
+	}
+	return nil
+}
+
+// QuerySpotPriceRequest defines the gRPC request structure for a SpotPrice
+// query.
 //
 // Deprecated: Do not use.
-type QuerySwapExactAmountOutRequest struct {
-	Sender   string                      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	PoolId   uint64                      `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	Routes   []types2.SwapAmountOutRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes" yaml:"routes"`
-	TokenOut string                      `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty" yaml:"token_out"`
+type QuerySpotPriceRequest struct {
+	PoolId          uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	BaseAssetDenom  string `protobuf:"bytes,2,opt,name=base_asset_denom,json=baseAssetDenom,proto3" json:"base_asset_denom,omitempty" yaml:"base_asset_denom"`
+	QuoteAssetDenom string `protobuf:"bytes,3,opt,name=quote_asset_denom,json=quoteAssetDenom,proto3" json:"quote_asset_denom,omitempty" yaml:"quote_asset_denom"`
 }
 
-func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAmountOutRequest{} }
-func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
-func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{26}
+func (m *QuerySpotPriceRequest) Reset()         { *m = QuerySpotPriceRequest{} }
+func (m *QuerySpotPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpotPriceRequest) ProtoMessage()    {}
+func (*QuerySpotPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{29}
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySpotPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySpotPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySwapExactAmountOutRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySpotPriceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1321,63 +1498,59 @@ func (m *QuerySwapExactAmountOutRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySwapExactAmountOutRequest.Merge(m, src)
+func (m *QuerySpotPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpotPriceRequest.Merge(m, src)
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Size() int {
+func (m *QuerySpotPriceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySwapExactAmountOutRequest.DiscardUnknown(m)
+func (m *QuerySpotPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpotPriceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySwapExactAmountOutRequest proto.InternalMessageInfo
-
-func (m *QuerySwapExactAmountOutRequest) GetSender() string {
-	if m != nil {
-		return m.Sender
-	}
-	return ""
-}
+var xxx_messageInfo_QuerySpotPriceRequest proto.InternalMessageInfo
 
-func (m *QuerySwapExactAmountOutRequest) GetPoolId() uint64 {
+func (m *QuerySpotPriceRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *QuerySwapExactAmountOutRequest) GetRoutes() []types2.SwapAmountOutRoute {
+func (m *QuerySpotPriceRequest) GetBaseAssetDenom() string {
 	if m != nil {
-		return m.Routes
+		return m.BaseAssetDenom
 	}
-	return nil
+	return ""
 }
 
-func (m *QuerySwapExactAmountOutRequest) GetTokenOut() string {
+func (m *QuerySpotPriceRequest) GetQuoteAssetDenom() string {
 	if m != nil {
-		return m.TokenOut
+		return m.QuoteAssetDenom
 	}
 	return ""
 }
 
-// Deprecated: Do not use.
-type QuerySwapExactAmountOutResponse struct {
-	TokenInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount" yaml:"token_in_amount"`
+type QueryPoolsWithFilterRequest struct {
+	// String of the coins in single string seperated by comma. Ex)
+	// 10uatom,100uosmo
+	MinLiquidity string             `protobuf:"bytes,1,opt,name=min_liquidity,json=minLiquidity,proto3" json:"min_liquidity,omitempty" yaml:"min_liquidity"`
+	PoolType     string             `protobuf:"bytes,2,opt,name=pool_type,json=poolType,proto3" json:"pool_type,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactAmountOutResponse{} }
-func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
-func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{27}
+func (m *QueryPoolsWithFilterRequest) Reset()         { *m = QueryPoolsWithFilterRequest{} }
+func (m *QueryPoolsWithFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsWithFilterRequest) ProtoMessage()    {}
+func (*QueryPoolsWithFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{30}
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolsWithFilterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolsWithFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySwapExactAmountOutResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolsWithFilterRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1387,33 +1560,57 @@ func (m *QuerySwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySwapExactAmountOutResponse.Merge(m, src)
+func (m *QueryPoolsWithFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsWithFilterRequest.Merge(m, src)
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_Size() int {
+func (m *QueryPoolsWithFilterRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySwapExactAmountOutResponse.DiscardUnknown(m)
+func (m *QueryPoolsWithFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsWithFilterRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySwapExactAmountOutResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolsWithFilterRequest proto.InternalMessageInfo
 
-type QueryTotalLiquidityRequest struct {
+func (m *QueryPoolsWithFilterRequest) GetMinLiquidity() string {
+	if m != nil {
+		return m.MinLiquidity
+	}
+	return ""
 }
 
-func (m *QueryTotalLiquidityRequest) Reset()         { *m = QueryTotalLiquidityRequest{} }
-func (m *QueryTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalLiquidityRequest) ProtoMessage()    {}
-func (*QueryTotalLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{28}
-}
-func (m *QueryTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *QueryPoolsWithFilterRequest) GetPoolType() string {
+	if m != nil {
+		return m.PoolType
+	}
+	return ""
 }
-func (m *QueryTotalLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+
+func (m *QueryPoolsWithFilterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPoolsWithFilterResponse struct {
+	Pools []*types.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolsWithFilterResponse) Reset()         { *m = QueryPoolsWithFilterResponse{} }
+func (m *QueryPoolsWithFilterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsWithFilterResponse) ProtoMessage()    {}
+func (*QueryPoolsWithFilterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{31}
+}
+func (m *QueryPoolsWithFilterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsWithFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalLiquidityRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolsWithFilterResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1423,34 +1620,53 @@ func (m *QueryTotalLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalLiquidityRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalLiquidityRequest.Merge(m, src)
+func (m *QueryPoolsWithFilterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsWithFilterResponse.Merge(m, src)
 }
-func (m *QueryTotalLiquidityRequest) XXX_Size() int {
+func (m *QueryPoolsWithFilterResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalLiquidityRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalLiquidityRequest.DiscardUnknown(m)
+func (m *QueryPoolsWithFilterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsWithFilterResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalLiquidityRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolsWithFilterResponse proto.InternalMessageInfo
 
-type QueryTotalLiquidityResponse struct {
-	Liquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
+func (m *QueryPoolsWithFilterResponse) GetPools() []*types.Any {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
 }
 
-func (m *QueryTotalLiquidityResponse) Reset()         { *m = QueryTotalLiquidityResponse{} }
-func (m *QueryTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalLiquidityResponse) ProtoMessage()    {}
-func (*QueryTotalLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{29}
+func (m *QueryPoolsWithFilterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *QueryTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
+
+// QuerySpotPriceResponse defines the gRPC response structure for a SpotPrice
+// query.
+//
+// Deprecated: Do not use.
+type QuerySpotPriceResponse struct {
+	// String of the Dec. Ex) 10.203uatom
+	SpotPrice string `protobuf:"bytes,1,opt,name=spot_price,json=spotPrice,proto3" json:"spot_price,omitempty" yaml:"spot_price"`
+}
+
+func (m *QuerySpotPriceResponse) Reset()         { *m = QuerySpotPriceResponse{} }
+func (m *QuerySpotPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpotPriceResponse) ProtoMessage()    {}
+func (*QuerySpotPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{32}
+}
+func (m *QuerySpotPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySpotPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalLiquidityResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySpotPriceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1460,699 +1676,1203 @@ func (m *QueryTotalLiquidityResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalLiquidityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalLiquidityResponse.Merge(m, src)
+func (m *QuerySpotPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpotPriceResponse.Merge(m, src)
 }
-func (m *QueryTotalLiquidityResponse) XXX_Size() int {
+func (m *QuerySpotPriceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalLiquidityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalLiquidityResponse.DiscardUnknown(m)
+func (m *QuerySpotPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpotPriceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalLiquidityResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySpotPriceResponse proto.InternalMessageInfo
 
-func (m *QueryTotalLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QuerySpotPriceResponse) GetSpotPrice() string {
 	if m != nil {
-		return m.Liquidity
+		return m.SpotPrice
 	}
-	return nil
-}
-
-func init() {
-	proto.RegisterType((*QueryPoolRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolRequest")
-	proto.RegisterType((*QueryPoolResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolResponse")
-	proto.RegisterType((*QueryPoolsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsRequest")
-	proto.RegisterType((*QueryPoolsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsResponse")
-	proto.RegisterType((*QueryNumPoolsRequest)(nil), "osmosis.gamm.v1beta1.QueryNumPoolsRequest")
-	proto.RegisterType((*QueryNumPoolsResponse)(nil), "osmosis.gamm.v1beta1.QueryNumPoolsResponse")
-	proto.RegisterType((*QueryPoolTypeRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolTypeRequest")
-	proto.RegisterType((*QueryPoolTypeResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolTypeResponse")
-	proto.RegisterType((*QueryCalcJoinPoolSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolSharesRequest")
-	proto.RegisterType((*QueryCalcJoinPoolSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolSharesResponse")
-	proto.RegisterType((*QueryCalcExitPoolCoinsFromSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcExitPoolCoinsFromSharesRequest")
-	proto.RegisterType((*QueryCalcExitPoolCoinsFromSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcExitPoolCoinsFromSharesResponse")
-	proto.RegisterType((*QueryPoolParamsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolParamsRequest")
-	proto.RegisterType((*QueryPoolParamsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolParamsResponse")
-	proto.RegisterType((*QueryTotalPoolLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityRequest")
-	proto.RegisterType((*QueryTotalPoolLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityResponse")
-	proto.RegisterType((*QueryTotalSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesRequest")
-	proto.RegisterType((*QueryTotalSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesResponse")
-	proto.RegisterType((*QueryCalcJoinPoolNoSwapSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolNoSwapSharesRequest")
-	proto.RegisterType((*QueryCalcJoinPoolNoSwapSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolNoSwapSharesResponse")
-	proto.RegisterType((*QuerySpotPriceRequest)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceRequest")
-	proto.RegisterType((*QueryPoolsWithFilterRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsWithFilterRequest")
-	proto.RegisterType((*QueryPoolsWithFilterResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsWithFilterResponse")
-	proto.RegisterType((*QuerySpotPriceResponse)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceResponse")
-	proto.RegisterType((*QuerySwapExactAmountInRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInRequest")
-	proto.RegisterType((*QuerySwapExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInResponse")
-	proto.RegisterType((*QuerySwapExactAmountOutRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutRequest")
-	proto.RegisterType((*QuerySwapExactAmountOutResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutResponse")
-	proto.RegisterType((*QueryTotalLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityRequest")
-	proto.RegisterType((*QueryTotalLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityResponse")
-}
-
-func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
-
-var fileDescriptor_d9a717df9ca609ef = []byte{
-	// 1832 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x49, 0x6c, 0x1c, 0xc5,
-	0x1a, 0x76, 0x8d, 0x97, 0x78, 0xca, 0xf1, 0x56, 0x71, 0x92, 0xc9, 0xd8, 0x99, 0xc9, 0xab, 0x97,
-	0xd8, 0x4e, 0x62, 0xf7, 0xc4, 0x8e, 0xa3, 0xf7, 0xe4, 0xf7, 0xb2, 0xd8, 0x89, 0x1d, 0x8f, 0x45,
-	0x62, 0xd3, 0x89, 0x40, 0x80, 0x60, 0xd4, 0xb6, 0x3b, 0xe3, 0x4e, 0x66, 0xba, 0xda, 0xd3, 0xd5,
-	0xb1, 0x2d, 0x14, 0x45, 0xca, 0x01, 0x45, 0x5c, 0x82, 0x04, 0x04, 0x81, 0x10, 0x70, 0x40, 0x08,
-	0x71, 0x05, 0x89, 0x13, 0x07, 0x84, 0x90, 0x22, 0x4e, 0x91, 0xe0, 0x80, 0x38, 0x0c, 0x28, 0x81,
-	0x1b, 0x27, 0x5f, 0xb8, 0xa2, 0x5a, 0x7a, 0x99, 0x7d, 0x81, 0x48, 0xe1, 0x64, 0x4f, 0xd5, 0xbf,
-	0x7c, 0xff, 0xf7, 0xff, 0xf5, 0xf7, 0x5f, 0x05, 0x0f, 0x11, 0x3b, 0x4b, 0x6c, 0xc3, 0x4e, 0xa4,
-	0xb5, 0x6c, 0x36, 0x71, 0x73, 0x62, 0x45, 0xa7, 0xda, 0x44, 0x62, 0xc3, 0xd1, 0x73, 0xdb, 0x8a,
-	0x95, 0x23, 0x94, 0xa0, 0x01, 0x29, 0xa1, 0x30, 0x09, 0x45, 0x4a, 0x44, 0x07, 0xd2, 0x24, 0x4d,
-	0xb8, 0x40, 0x82, 0xfd, 0x27, 0x64, 0xa3, 0x07, 0xcb, 0x5a, 0xa3, 0x5b, 0x72, 0x7b, 0xcc, 0xdd,
-	0xb6, 0x08, 0xc9, 0x64, 0x35, 0x53, 0x4b, 0xeb, 0x39, 0x4f, 0xca, 0xde, 0xd4, 0xac, 0x54, 0x8e,
-	0x38, 0x54, 0x97, 0xd2, 0xb1, 0x55, 0x2e, 0x9e, 0x58, 0xd1, 0x6c, 0xdd, 0x93, 0x5a, 0x25, 0x86,
-	0x29, 0xf7, 0x8f, 0x05, 0xf7, 0x39, 0x62, 0x4f, 0xca, 0xd2, 0xd2, 0x86, 0xa9, 0x51, 0x83, 0xb8,
-	0xb2, 0x43, 0x69, 0x42, 0xd2, 0x19, 0x3d, 0xa1, 0x59, 0x46, 0x42, 0x33, 0x4d, 0x42, 0xf9, 0xa6,
-	0x2d, 0x77, 0x0f, 0xc8, 0x5d, 0xfe, 0x6b, 0xc5, 0xb9, 0x96, 0xd0, 0xcc, 0x6d, 0x77, 0x4b, 0x38,
-	0x49, 0x89, 0x50, 0xc5, 0x0f, 0xb1, 0x85, 0xcf, 0xc2, 0xbe, 0x67, 0x99, 0xd7, 0x65, 0x42, 0x32,
-	0xaa, 0xbe, 0xe1, 0xe8, 0x36, 0x45, 0xc7, 0xe1, 0x2e, 0x16, 0x5b, 0xca, 0x58, 0x8b, 0x80, 0x43,
-	0x60, 0xb4, 0x6d, 0x16, 0xed, 0xe4, 0xe3, 0x3d, 0xdb, 0x5a, 0x36, 0x33, 0x8d, 0xe5, 0x06, 0x56,
-	0x3b, 0xd8, 0x7f, 0xc9, 0x35, 0xbc, 0x00, 0xfb, 0x03, 0x06, 0x6c, 0x8b, 0x98, 0xb6, 0x8e, 0x4e,
-	0xc2, 0x36, 0xb6, 0xcd, 0xd5, 0xbb, 0x26, 0x07, 0x14, 0x01, 0x4d, 0x71, 0xa1, 0x29, 0x33, 0xe6,
-	0xf6, 0x6c, 0xf8, 0xbb, 0x2f, 0xc6, 0xdb, 0x99, 0x56, 0x52, 0xe5, 0xc2, 0xf8, 0xa5, 0x80, 0x25,
-	0xdb, 0xc5, 0x32, 0x0f, 0xa1, 0xcf, 0x43, 0x24, 0xc4, 0xed, 0x0d, 0x2b, 0x32, 0x04, 0x46, 0x9a,
-	0x22, 0xd2, 0x2c, 0x49, 0x53, 0x96, 0xb5, 0xb4, 0x2e, 0x75, 0xd5, 0x80, 0x26, 0x7e, 0x0b, 0x40,
-	0x14, 0xb4, 0x2e, 0x81, 0x9e, 0x82, 0xed, 0xcc, 0xb7, 0x1d, 0x01, 0x87, 0x5a, 0xeb, 0x41, 0x2a,
-	0xa4, 0xd1, 0xc5, 0x32, 0xa8, 0x46, 0x6a, 0xa2, 0x12, 0x3e, 0x0b, 0x60, 0x45, 0xe1, 0x00, 0x47,
-	0x75, 0xd9, 0xc9, 0x06, 0xc3, 0x9e, 0x0e, 0x45, 0x00, 0xbe, 0x0c, 0xf7, 0x16, 0xed, 0x49, 0xd0,
-	0x13, 0x30, 0x6c, 0x3a, 0xd9, 0x94, 0x0b, 0x9c, 0x65, 0x68, 0x60, 0x27, 0x1f, 0xef, 0x13, 0x19,
-	0xf2, 0xb6, 0xb0, 0xda, 0x69, 0x4a, 0x55, 0x6e, 0xef, 0xbc, 0xf4, 0xc5, 0x56, 0xae, 0x6e, 0x5b,
-	0x7a, 0x53, 0xe9, 0x5e, 0x94, 0xa0, 0x7c, 0x23, 0x3e, 0x28, 0x2e, 0x4c, 0xb7, 0x2d, 0x9d, 0xdb,
-	0x09, 0x07, 0x41, 0x79, 0x5b, 0x58, 0xed, 0xb4, 0xa4, 0x2a, 0xfe, 0x12, 0xc0, 0x18, 0x37, 0x76,
-	0x5e, 0xcb, 0xac, 0x2e, 0x12, 0xc3, 0x64, 0x46, 0xaf, 0xac, 0x6b, 0x39, 0xdd, 0x6e, 0x06, 0x1b,
-	0x5a, 0x87, 0x61, 0x4a, 0x6e, 0xe8, 0xa6, 0x9d, 0x32, 0x58, 0x52, 0x58, 0x42, 0x0f, 0x14, 0x24,
-	0xc5, 0x4d, 0xc7, 0x79, 0x62, 0x98, 0xb3, 0x27, 0x1e, 0xe4, 0xe3, 0x2d, 0x9f, 0xfd, 0x1c, 0x1f,
-	0x4d, 0x1b, 0x74, 0xdd, 0x59, 0x51, 0x56, 0x49, 0x56, 0x1e, 0x0d, 0xf9, 0x67, 0xdc, 0x5e, 0xbb,
-	0x91, 0x60, 0x98, 0x6d, 0xae, 0x60, 0xab, 0x9d, 0xc2, 0x7a, 0xd2, 0xc4, 0x77, 0x42, 0x30, 0x5e,
-	0x11, 0xb9, 0x24, 0xc4, 0x86, 0x7d, 0x36, 0x5b, 0x49, 0x11, 0x87, 0xa6, 0xb4, 0x2c, 0x71, 0x4c,
-	0x2a, 0x79, 0x49, 0x32, 0xcf, 0x3f, 0xe5, 0xe3, 0xc3, 0x75, 0x78, 0x4e, 0x9a, 0x74, 0x27, 0x1f,
-	0xdf, 0x2f, 0x22, 0x2e, 0xb6, 0x87, 0xd5, 0x1e, 0xbe, 0xb4, 0xe4, 0xd0, 0x19, 0xbe, 0x80, 0xae,
-	0x43, 0x28, 0x29, 0x20, 0x0e, 0x7d, 0x12, 0x1c, 0x48, 0x86, 0x97, 0x1c, 0x8a, 0xdf, 0x03, 0x70,
-	0xc4, 0x23, 0x61, 0x6e, 0xcb, 0xa0, 0x8c, 0x04, 0x2e, 0x35, 0x9f, 0x23, 0xd9, 0xc2, 0x3c, 0xee,
-	0x2f, 0xca, 0xa3, 0x97, 0xb3, 0xe7, 0x60, 0xaf, 0x88, 0xca, 0x30, 0x5d, 0x92, 0x42, 0x9c, 0x24,
-	0xa5, 0x31, 0x92, 0xd4, 0x6e, 0x6e, 0x26, 0x69, 0x0a, 0x22, 0xf0, 0x7d, 0x00, 0x47, 0x6b, 0x83,
-	0x93, 0xa9, 0x2a, 0x64, 0x0d, 0x3c, 0x51, 0xd6, 0xe6, 0xe0, 0x3e, 0xef, 0x00, 0x2d, 0x6b, 0x39,
-	0x2d, 0xdb, 0x54, 0xad, 0xe3, 0x8b, 0x70, 0x7f, 0x89, 0x19, 0x19, 0xcd, 0x18, 0xec, 0xb0, 0xf8,
-	0x4a, 0xb5, 0xf6, 0xab, 0x4a, 0x19, 0x7c, 0x49, 0x9e, 0xc1, 0xab, 0x84, 0x6a, 0x19, 0x66, 0xed,
-	0x19, 0x63, 0xc3, 0x31, 0xd6, 0x0c, 0xba, 0xdd, 0x14, 0xae, 0x8f, 0x80, 0x3c, 0x19, 0xe5, 0xec,
-	0x49, 0x80, 0xb7, 0x60, 0x38, 0xe3, 0x2e, 0xd6, 0x66, 0xfb, 0x02, 0x63, 0xdb, 0xef, 0x24, 0x9e,
-	0x26, 0x6e, 0x2c, 0x03, 0xbe, 0xde, 0xbc, 0xa4, 0x8e, 0x23, 0x6c, 0xbe, 0xdd, 0x60, 0x07, 0x46,
-	0x4a, 0xed, 0xc8, 0x10, 0x5f, 0x80, 0xbb, 0x29, 0x5b, 0x4e, 0xf1, 0xaa, 0x74, 0x33, 0x51, 0x25,
-	0xca, 0x41, 0x19, 0xe5, 0x1e, 0xe1, 0x2c, 0xa8, 0x8c, 0xd5, 0x2e, 0xea, 0xbb, 0xc0, 0x5f, 0x01,
-	0x78, 0xb8, 0xa4, 0xf7, 0x5c, 0x26, 0x57, 0x36, 0x35, 0xeb, 0x1f, 0xd1, 0x3b, 0xff, 0x00, 0xf0,
-	0x48, 0x0d, 0xfc, 0x92, 0xc4, 0xdb, 0x8d, 0x1d, 0xcb, 0x39, 0x49, 0x61, 0xbf, 0x4b, 0xa1, 0xab,
-	0x8a, 0x9b, 0x3c, 0xab, 0xe8, 0x12, 0x84, 0x22, 0x05, 0xb2, 0x9b, 0x36, 0xd3, 0x97, 0xc2, 0xc2,
-	0x02, 0x3b, 0xfa, 0xbf, 0x03, 0xf9, 0xf1, 0xbc, 0x62, 0x11, 0xba, 0x9c, 0x33, 0x56, 0x9b, 0xfa,
-	0x04, 0xa3, 0x39, 0xd8, 0xc7, 0x82, 0x4f, 0x69, 0xb6, 0xad, 0xd3, 0xd4, 0x9a, 0x6e, 0x92, 0xac,
-	0xc4, 0x36, 0xe8, 0x7f, 0x2a, 0x8a, 0x25, 0xb0, 0xda, 0xc3, 0x96, 0x66, 0xd8, 0xca, 0x05, 0xb6,
-	0x80, 0x16, 0x60, 0xff, 0x86, 0x43, 0x68, 0xa1, 0x9d, 0x56, 0x6e, 0x67, 0x68, 0x27, 0x1f, 0x8f,
-	0x08, 0x3b, 0x25, 0x22, 0x58, 0xed, 0xe5, 0x6b, 0xbe, 0x25, 0x36, 0x5c, 0x2c, 0xb6, 0x75, 0xb6,
-	0xf5, 0xb5, 0xab, 0x5d, 0x9b, 0x06, 0x5d, 0x67, 0x99, 0x9c, 0xd7, 0x75, 0xfc, 0x35, 0x80, 0x83,
-	0xfe, 0xc8, 0xf5, 0xbc, 0x41, 0xd7, 0xe7, 0x8d, 0x0c, 0xd5, 0x73, 0x6e, 0xd0, 0xa7, 0x61, 0x77,
-	0xd6, 0x30, 0x53, 0xc1, 0x56, 0xc0, 0x9c, 0x47, 0x76, 0xf2, 0xf1, 0x01, 0xe1, 0xbc, 0x60, 0x1b,
-	0xab, 0xbb, 0xb3, 0x86, 0xe9, 0x75, 0x13, 0x34, 0x18, 0x1c, 0x38, 0x78, 0xfc, 0xfe, 0x68, 0x51,
-	0x34, 0x36, 0xb6, 0x36, 0x3d, 0x36, 0x7e, 0x00, 0xe0, 0x50, 0xf9, 0x18, 0x9e, 0x92, 0x01, 0x52,
-	0x95, 0x9f, 0x93, 0x40, 0x49, 0x49, 0x64, 0x53, 0x10, 0xda, 0x16, 0xa1, 0x29, 0x8b, 0xad, 0x4a,
-	0x6e, 0xf7, 0xfa, 0xc7, 0xc3, 0xdf, 0xc3, 0x6a, 0xd8, 0x76, 0xb5, 0xf9, 0xa0, 0xf8, 0x7a, 0x08,
-	0x1e, 0x14, 0x46, 0x37, 0x35, 0x6b, 0x6e, 0x4b, 0x5b, 0x95, 0xd3, 0x45, 0xd2, 0x74, 0x53, 0x77,
-	0x14, 0x76, 0xd8, 0xba, 0xb9, 0xa6, 0xe7, 0xa4, 0xdd, 0xfe, 0x9d, 0x7c, 0xbc, 0x5b, 0xda, 0xe5,
-	0xeb, 0x58, 0x95, 0x02, 0xc1, 0xd2, 0x0e, 0xd5, 0x2c, 0x6d, 0x05, 0x8a, 0x3e, 0xc1, 0x9a, 0x90,
-	0x28, 0xc5, 0x3d, 0x3b, 0xf9, 0x78, 0x6f, 0xe0, 0x40, 0xa7, 0x0c, 0x13, 0xab, 0xbb, 0xf8, 0xbf,
-	0x49, 0x13, 0xbd, 0x0c, 0x3b, 0xf8, 0x65, 0xcb, 0x8e, 0xb4, 0x71, 0xfa, 0x15, 0xc5, 0xbd, 0xe7,
-	0x05, 0x2e, 0x67, 0x1e, 0x89, 0x2c, 0x1c, 0x2f, 0x12, 0xa6, 0x36, 0xbb, 0x57, 0xb6, 0x0c, 0x89,
-	0x5d, 0xd8, 0xc2, 0xaa, 0x34, 0xca, 0xc9, 0x78, 0xd7, 0x1d, 0x52, 0xcb, 0x90, 0xe1, 0x4f, 0x7a,
-	0x02, 0xdb, 0xdf, 0x37, 0xe9, 0x15, 0xdb, 0xc3, 0x6a, 0x0f, 0x5f, 0xf2, 0x26, 0x3d, 0x8e, 0xed,
-	0x5e, 0xa8, 0x3c, 0xb6, 0x25, 0x87, 0x3e, 0xe9, 0x4c, 0xbd, 0xe2, 0x31, 0xdf, 0xca, 0x99, 0x4f,
-	0xd4, 0xc9, 0x3c, 0x83, 0x56, 0x07, 0xf5, 0xec, 0x3a, 0xe1, 0x71, 0x10, 0x69, 0x2b, 0xbe, 0x4e,
-	0x78, 0x5b, 0x58, 0x7e, 0x58, 0x96, 0x1c, 0xc1, 0xc8, 0x3b, 0xee, 0xf8, 0x51, 0x8e, 0x11, 0x99,
-	0x2e, 0x0b, 0xf6, 0xba, 0xa5, 0x54, 0x98, 0xad, 0x85, 0x86, 0xb3, 0xb5, 0xaf, 0xb0, 0x32, 0xbd,
-	0x64, 0x75, 0xcb, 0x02, 0x0d, 0xe4, 0x6a, 0x08, 0x46, 0xfd, 0x69, 0xa1, 0x78, 0xc6, 0xc2, 0xef,
-	0xbb, 0xbd, 0xb2, 0x78, 0xfb, 0xa9, 0x18, 0x99, 0x26, 0x3f, 0x1f, 0x80, 0xed, 0x1c, 0x1e, 0xba,
-	0x0d, 0x79, 0x23, 0xb3, 0xd1, 0x88, 0x52, 0xee, 0x49, 0x45, 0x29, 0xb9, 0xc1, 0x47, 0x47, 0x6b,
-	0x0b, 0x8a, 0x20, 0xf1, 0xbf, 0xef, 0x7c, 0xff, 0xeb, 0x9b, 0xa1, 0x83, 0x68, 0x30, 0x51, 0xf6,
-	0x05, 0x46, 0x74, 0xce, 0x7b, 0x00, 0x76, 0xba, 0x37, 0x62, 0x74, 0xac, 0x8a, 0xed, 0xa2, 0x2b,
-	0x75, 0xf4, 0x78, 0x5d, 0xb2, 0x12, 0xca, 0x31, 0x0e, 0xe5, 0x5f, 0x28, 0x5e, 0x1e, 0x8a, 0x77,
-	0xc7, 0xbe, 0x1b, 0x02, 0xe8, 0x63, 0x00, 0x7b, 0x0a, 0xd3, 0x86, 0x4e, 0x54, 0xf1, 0x55, 0xb6,
-	0x00, 0xa2, 0x13, 0x0d, 0x68, 0x48, 0x8c, 0xe3, 0x1c, 0xe3, 0x08, 0x3a, 0x52, 0x1e, 0xa3, 0x18,
-	0x21, 0xbd, 0x1c, 0xa2, 0x4f, 0x00, 0xec, 0x2d, 0xfa, 0x8a, 0xa1, 0x89, 0x5a, 0xb9, 0x29, 0xf9,
-	0x6a, 0x47, 0x27, 0x1b, 0x51, 0x91, 0x48, 0xc7, 0x38, 0xd2, 0x61, 0x74, 0xb8, 0x3c, 0xd2, 0x6b,
-	0x5c, 0x5a, 0x5f, 0x13, 0x94, 0xa2, 0xd7, 0x00, 0x6c, 0x63, 0x96, 0xd0, 0x70, 0x0d, 0x57, 0x2e,
-	0xa4, 0x91, 0x9a, 0x72, 0xf5, 0x31, 0xc6, 0xdd, 0x27, 0x5e, 0x95, 0xbd, 0xee, 0x16, 0xba, 0x0f,
-	0x60, 0xa7, 0xfb, 0xce, 0x51, 0xb5, 0xd4, 0x8a, 0x5e, 0x54, 0xaa, 0x96, 0x5a, 0xf1, 0xc3, 0x09,
-	0x9e, 0xe0, 0xa0, 0x8e, 0xa3, 0xa3, 0x95, 0x41, 0xf1, 0x19, 0x27, 0x00, 0xec, 0x6d, 0x00, 0x23,
-	0x95, 0xa6, 0x67, 0x34, 0x5d, 0xc5, 0x79, 0x8d, 0x2b, 0x43, 0xf4, 0x7f, 0x4d, 0xe9, 0xca, 0x40,
-	0x5a, 0xd0, 0x37, 0x00, 0xa2, 0xd2, 0x17, 0x11, 0x34, 0x55, 0xa7, 0xd5, 0x42, 0x2c, 0xa7, 0x1a,
-	0xd4, 0x92, 0x28, 0xce, 0x71, 0x3a, 0xa7, 0xd1, 0x7f, 0xeb, 0xca, 0x71, 0xe2, 0x3a, 0x31, 0xcc,
-	0x14, 0x7f, 0xb5, 0xd5, 0xd9, 0xd7, 0x22, 0x65, 0x98, 0xe8, 0x37, 0x00, 0x07, 0xab, 0xbc, 0x1a,
-	0xa0, 0xd3, 0x35, 0x80, 0x55, 0x7f, 0x0a, 0x89, 0x9e, 0x69, 0x56, 0x5d, 0x06, 0x78, 0x91, 0x07,
-	0x38, 0x83, 0xce, 0xd6, 0x17, 0xa0, 0xbe, 0x65, 0x50, 0x11, 0xa0, 0x78, 0x67, 0x11, 0x9f, 0x28,
-	0x16, 0xe7, 0x87, 0x00, 0x42, 0xff, 0xf9, 0x00, 0x8d, 0xd5, 0x28, 0xda, 0x82, 0xc7, 0x8a, 0xe8,
-	0x78, 0x9d, 0xd2, 0x12, 0xf4, 0x14, 0x07, 0xad, 0xa0, 0xb1, 0xfa, 0x40, 0x8b, 0xb7, 0x09, 0xf4,
-	0x2d, 0x80, 0xa8, 0xf4, 0x1d, 0xa1, 0x6a, 0x3d, 0x55, 0x7c, 0xc6, 0xa8, 0x5a, 0x4f, 0x95, 0x1f,
-	0x2b, 0xf0, 0x2c, 0x47, 0xfe, 0x7f, 0x34, 0x5d, 0x1f, 0x72, 0xd1, 0x75, 0xf9, 0x4f, 0xbf, 0xf5,
-	0x7e, 0x0a, 0x60, 0x57, 0xe0, 0x95, 0x00, 0x8d, 0xd7, 0x82, 0x52, 0x58, 0x31, 0x4a, 0xbd, 0xe2,
-	0x12, 0xf2, 0x34, 0x87, 0x3c, 0x85, 0x26, 0x1b, 0x81, 0x2c, 0xae, 0xa9, 0xac, 0x28, 0xc2, 0xde,
-	0x5d, 0x02, 0x55, 0x6b, 0x64, 0xc5, 0x97, 0xd8, 0xe8, 0x58, 0x7d, 0xc2, 0x12, 0xe4, 0x7f, 0x1a,
-	0xac, 0x08, 0xa6, 0xcc, 0x3f, 0xb7, 0x0f, 0x01, 0x3c, 0x30, 0x67, 0x53, 0x23, 0xab, 0x51, 0xbd,
-	0x64, 0x26, 0x47, 0x27, 0xab, 0x81, 0xa8, 0x70, 0x9d, 0x89, 0x4e, 0x35, 0xa6, 0x24, 0x23, 0x58,
-	0xe0, 0x11, 0x9c, 0x45, 0xa7, 0xcb, 0x47, 0x10, 0x38, 0x82, 0x12, 0x6d, 0x22, 0xd0, 0x67, 0xbc,
-	0x63, 0xc8, 0x42, 0xfa, 0x01, 0xc0, 0x68, 0x85, 0x90, 0x96, 0x1c, 0x8a, 0x1a, 0x80, 0xe7, 0x4f,
-	0xfe, 0x55, 0xeb, 0xbd, 0xf2, 0x74, 0x8c, 0x93, 0x3c, 0xaa, 0x73, 0xe8, 0xcc, 0x5f, 0x88, 0x8a,
-	0x38, 0xf4, 0x6e, 0x08, 0xcc, 0x2e, 0x3e, 0x78, 0x14, 0x03, 0x0f, 0x1f, 0xc5, 0xc0, 0x2f, 0x8f,
-	0x62, 0xe0, 0x8d, 0xc7, 0xb1, 0x96, 0x87, 0x8f, 0x63, 0x2d, 0x3f, 0x3e, 0x8e, 0xb5, 0xbc, 0x78,
-	0x22, 0x30, 0x84, 0x4a, 0x37, 0xe3, 0x19, 0x6d, 0xc5, 0xf6, 0x7c, 0xde, 0x9c, 0x98, 0x4a, 0x6c,
-	0x09, 0xcf, 0x7c, 0x24, 0x5d, 0xe9, 0xe0, 0x17, 0xea, 0x93, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0x44, 0x81, 0x1f, 0x8c, 0xeb, 0x1b, 0x00, 0x00,
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
+// =============================== EstimateSwapExactAmountIn
 //
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error)
-	TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error)
-	// PoolsWithFilter allows you to query specific pools with requested
-	// parameters
-	PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFilterRequest, opts ...grpc.CallOption) (*QueryPoolsWithFilterResponse, error)
-	// Per Pool gRPC Endpoints
-	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
-	// PoolType returns the type of the pool.
-	// Returns "Balancer" as a string literal when the pool is a balancer pool.
-	// Errors if the pool is failed to be type caseted.
-	PoolType(ctx context.Context, in *QueryPoolTypeRequest, opts ...grpc.CallOption) (*QueryPoolTypeResponse, error)
-	// Simulates joining pool without a swap. Returns the amount of shares you'd
-	// get and tokens needed to provide
-	CalcJoinPoolNoSwapShares(ctx context.Context, in *QueryCalcJoinPoolNoSwapSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolNoSwapSharesResponse, error)
-	CalcJoinPoolShares(ctx context.Context, in *QueryCalcJoinPoolSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolSharesResponse, error)
-	CalcExitPoolCoinsFromShares(ctx context.Context, in *QueryCalcExitPoolCoinsFromSharesRequest, opts ...grpc.CallOption) (*QueryCalcExitPoolCoinsFromSharesResponse, error)
-	PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error)
-	TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error)
-	TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
-	// SpotPrice defines a gRPC query handler that returns the spot price given
-	// a base denomination and a quote denomination.
-	SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error)
+// Deprecated: Do not use.
+type QuerySwapExactAmountInRequest struct {
+	Sender  string                     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId  uint64                     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenIn string                     `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	Routes  []types3.SwapAmountInRoute `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes" yaml:"routes"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySwapExactAmountInRequest) Reset()         { *m = QuerySwapExactAmountInRequest{} }
+func (m *QuerySwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountInRequest) ProtoMessage()    {}
+func (*QuerySwapExactAmountInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{33}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
-	out := new(QueryPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/Pools", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountInRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySwapExactAmountInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountInRequest.Merge(m, src)
+}
+func (m *QuerySwapExactAmountInRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapExactAmountInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountInRequest.DiscardUnknown(m)
 }
 
-// Deprecated: Do not use.
-func (c *queryClient) NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error) {
-	out := new(QueryNumPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/NumPools", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySwapExactAmountInRequest proto.InternalMessageInfo
+
+func (m *QuerySwapExactAmountInRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error) {
-	out := new(QueryTotalLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountInRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFilterRequest, opts ...grpc.CallOption) (*QueryPoolsWithFilterResponse, error) {
-	out := new(QueryPoolsWithFilterResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolsWithFilter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountInRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
-	out := new(QueryPoolResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/Pool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountInRequest) GetRoutes() []types3.SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PoolType(ctx context.Context, in *QueryPoolTypeRequest, opts ...grpc.CallOption) (*QueryPoolTypeResponse, error) {
-	out := new(QueryPoolTypeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolType", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Deprecated: Do not use.
+type QuerySwapExactAmountInResponse struct {
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
 }
 
-func (c *queryClient) CalcJoinPoolNoSwapShares(ctx context.Context, in *QueryCalcJoinPoolNoSwapSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolNoSwapSharesResponse, error) {
-	out := new(QueryCalcJoinPoolNoSwapSharesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CalcJoinPoolNoSwapShares", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySwapExactAmountInResponse) Reset()         { *m = QuerySwapExactAmountInResponse{} }
+func (m *QuerySwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountInResponse) ProtoMessage()    {}
+func (*QuerySwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{34}
 }
-
-func (c *queryClient) CalcJoinPoolShares(ctx context.Context, in *QueryCalcJoinPoolSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolSharesResponse, error) {
-	out := new(QueryCalcJoinPoolSharesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CalcJoinPoolShares", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) CalcExitPoolCoinsFromShares(ctx context.Context, in *QueryCalcExitPoolCoinsFromSharesRequest, opts ...grpc.CallOption) (*QueryCalcExitPoolCoinsFromSharesResponse, error) {
-	out := new(QueryCalcExitPoolCoinsFromSharesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CalcExitPoolCoinsFromShares", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountInResponse.Merge(m, src)
+}
+func (m *QuerySwapExactAmountInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountInResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error) {
-	out := new(QueryPoolParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QuerySwapExactAmountInResponse proto.InternalMessageInfo
+
+// =============================== EstimateSwapExactAmountOut
+//
+// Deprecated: Do not use.
+type QuerySwapExactAmountOutRequest struct {
+	Sender   string                      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId   uint64                      `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Routes   []types3.SwapAmountOutRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	TokenOut string                      `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty" yaml:"token_out"`
 }
 
-func (c *queryClient) TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error) {
-	out := new(QueryTotalPoolLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAmountOutRequest{} }
+func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
+func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{35}
+}
+func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapExactAmountOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountOutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySwapExactAmountOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountOutRequest.Merge(m, src)
+}
+func (m *QuerySwapExactAmountOutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapExactAmountOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountOutRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error) {
-	out := new(QueryTotalSharesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalShares", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySwapExactAmountOutRequest proto.InternalMessageInfo
+
+func (m *QuerySwapExactAmountOutRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-// Deprecated: Do not use.
-func (c *queryClient) SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error) {
-	out := new(QuerySpotPriceResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/SpotPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountOutRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-// Deprecated: Do not use.
-func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error) {
-	out := new(QuerySwapExactAmountInResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountOutRequest) GetRoutes() []types3.SwapAmountOutRoute {
+	if m != nil {
+		return m.Routes
 	}
-	return out, nil
+	return nil
 }
 
-// Deprecated: Do not use.
-func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error) {
-	out := new(QuerySwapExactAmountOutResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountOutRequest) GetTokenOut() string {
+	if m != nil {
+		return m.TokenOut
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	NumPools(context.Context, *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error)
-	TotalLiquidity(context.Context, *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error)
-	// PoolsWithFilter allows you to query specific pools with requested
-	// parameters
-	PoolsWithFilter(context.Context, *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error)
-	// Per Pool gRPC Endpoints
-	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
-	// PoolType returns the type of the pool.
-	// Returns "Balancer" as a string literal when the pool is a balancer pool.
-	// Errors if the pool is failed to be type caseted.
-	PoolType(context.Context, *QueryPoolTypeRequest) (*QueryPoolTypeResponse, error)
-	// Simulates joining pool without a swap. Returns the amount of shares you'd
-	// get and tokens needed to provide
-	CalcJoinPoolNoSwapShares(context.Context, *QueryCalcJoinPoolNoSwapSharesRequest) (*QueryCalcJoinPoolNoSwapSharesResponse, error)
-	CalcJoinPoolShares(context.Context, *QueryCalcJoinPoolSharesRequest) (*QueryCalcJoinPoolSharesResponse, error)
-	CalcExitPoolCoinsFromShares(context.Context, *QueryCalcExitPoolCoinsFromSharesRequest) (*QueryCalcExitPoolCoinsFromSharesResponse, error)
-	PoolParams(context.Context, *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error)
-	TotalPoolLiquidity(context.Context, *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error)
-	TotalShares(context.Context, *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error)
-	// SpotPrice defines a gRPC query handler that returns the spot price given
-	// a base denomination and a quote denomination.
-	SpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	EstimateSwapExactAmountIn(context.Context, *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	EstimateSwapExactAmountOut(context.Context, *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error)
+// Deprecated: Do not use.
+type QuerySwapExactAmountOutResponse struct {
+	TokenInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount" yaml:"token_in_amount"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactAmountOutResponse{} }
+func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
+func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{36}
 }
-
-func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NumPools not implemented")
+func (m *QuerySwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidity not implemented")
+func (m *QuerySwapExactAmountOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountOutResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) PoolsWithFilter(ctx context.Context, req *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolsWithFilter not implemented")
+func (m *QuerySwapExactAmountOutResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+func (m *QuerySwapExactAmountOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountOutResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) PoolType(ctx context.Context, req *QueryPoolTypeRequest) (*QueryPoolTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolType not implemented")
+
+var xxx_messageInfo_QuerySwapExactAmountOutResponse proto.InternalMessageInfo
+
+type QueryTotalLiquidityRequest struct {
 }
-func (*UnimplementedQueryServer) CalcJoinPoolNoSwapShares(ctx context.Context, req *QueryCalcJoinPoolNoSwapSharesRequest) (*QueryCalcJoinPoolNoSwapSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalcJoinPoolNoSwapShares not implemented")
+
+func (m *QueryTotalLiquidityRequest) Reset()         { *m = QueryTotalLiquidityRequest{} }
+func (m *QueryTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidityRequest) ProtoMessage()    {}
+func (*QueryTotalLiquidityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{37}
 }
-func (*UnimplementedQueryServer) CalcJoinPoolShares(ctx context.Context, req *QueryCalcJoinPoolSharesRequest) (*QueryCalcJoinPoolSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalcJoinPoolShares not implemented")
+func (m *QueryTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) CalcExitPoolCoinsFromShares(ctx context.Context, req *QueryCalcExitPoolCoinsFromSharesRequest) (*QueryCalcExitPoolCoinsFromSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalcExitPoolCoinsFromShares not implemented")
+func (m *QueryTotalLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PoolParams(ctx context.Context, req *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolParams not implemented")
+func (m *QueryTotalLiquidityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidityRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalPoolLiquidity not implemented")
+func (m *QueryTotalLiquidityRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) TotalShares(ctx context.Context, req *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalShares not implemented")
+func (m *QueryTotalLiquidityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidityRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
+
+var xxx_messageInfo_QueryTotalLiquidityRequest proto.InternalMessageInfo
+
+type QueryTotalLiquidityResponse struct {
+	Liquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
 }
-func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
+
+func (m *QueryTotalLiquidityResponse) Reset()         { *m = QueryTotalLiquidityResponse{} }
+func (m *QueryTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidityResponse) ProtoMessage()    {}
+func (*QueryTotalLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{38}
 }
-func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
+func (m *QueryTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidityResponse.Merge(m, src)
+}
+func (m *QueryTotalLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidityResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryTotalLiquidityResponse proto.InternalMessageInfo
+
+func (m *QueryTotalLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Liquidity
+	}
+	return nil
 }
 
-func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolsRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*QueryPoolRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolRequest")
+	proto.RegisterType((*QueryPoolResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolResponse")
+	proto.RegisterType((*QueryPoolsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsRequest")
+	proto.RegisterType((*QueryPoolsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsResponse")
+	proto.RegisterType((*QueryNumPoolsRequest)(nil), "osmosis.gamm.v1beta1.QueryNumPoolsRequest")
+	proto.RegisterType((*QueryNumPoolsResponse)(nil), "osmosis.gamm.v1beta1.QueryNumPoolsResponse")
+	proto.RegisterType((*QueryPoolTypeRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolTypeRequest")
+	proto.RegisterType((*QueryPoolTypeResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolTypeResponse")
+	proto.RegisterType((*QueryCalcJoinPoolSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolSharesRequest")
+	proto.RegisterType((*QueryCalcJoinPoolSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolSharesResponse")
+	proto.RegisterType((*QueryEstimateJoinPoolWithArbitraryTokensRequest)(nil), "osmosis.gamm.v1beta1.QueryEstimateJoinPoolWithArbitraryTokensRequest")
+	proto.RegisterType((*QueryEstimateJoinPoolWithArbitraryTokensResponse)(nil), "osmosis.gamm.v1beta1.QueryEstimateJoinPoolWithArbitraryTokensResponse")
+	proto.RegisterType((*QueryCalcExitPoolCoinsFromSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcExitPoolCoinsFromSharesRequest")
+	proto.RegisterType((*QueryCalcExitPoolCoinsFromSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcExitPoolCoinsFromSharesResponse")
+	proto.RegisterType((*QueryPoolParamsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolParamsRequest")
+	proto.RegisterType((*QueryPoolParamsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolParamsResponse")
+	proto.RegisterType((*QueryTotalPoolLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityRequest")
+	proto.RegisterType((*QueryTotalPoolLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityResponse")
+	proto.RegisterType((*QueryTotalSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesRequest")
+	proto.RegisterType((*QueryTotalSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesResponse")
+	proto.RegisterType((*QueryLBPStatusRequest)(nil), "osmosis.gamm.v1beta1.QueryLBPStatusRequest")
+	proto.RegisterType((*DenomWeight)(nil), "osmosis.gamm.v1beta1.DenomWeight")
+	proto.RegisterType((*QueryLBPStatusResponse)(nil), "osmosis.gamm.v1beta1.QueryLBPStatusResponse")
+	proto.RegisterType((*QueryScalingFactorRampRequest)(nil), "osmosis.gamm.v1beta1.QueryScalingFactorRampRequest")
+	proto.RegisterType((*QueryScalingFactorRampResponse)(nil), "osmosis.gamm.v1beta1.QueryScalingFactorRampResponse")
+	proto.RegisterType((*QueryCalcMigrationRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcMigrationRequest")
+	proto.RegisterType((*QueryCalcMigrationResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcMigrationResponse")
+	proto.RegisterType((*QueryCalcJoinPoolNoSwapSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolNoSwapSharesRequest")
+	proto.RegisterType((*QueryCalcJoinPoolNoSwapSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolNoSwapSharesResponse")
+	proto.RegisterType((*QuerySpotPriceRequest)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceRequest")
+	proto.RegisterType((*QueryPoolsWithFilterRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsWithFilterRequest")
+	proto.RegisterType((*QueryPoolsWithFilterResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsWithFilterResponse")
+	proto.RegisterType((*QuerySpotPriceResponse)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceResponse")
+	proto.RegisterType((*QuerySwapExactAmountInRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInRequest")
+	proto.RegisterType((*QuerySwapExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInResponse")
+	proto.RegisterType((*QuerySwapExactAmountOutRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutRequest")
+	proto.RegisterType((*QuerySwapExactAmountOutResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutResponse")
+	proto.RegisterType((*QueryTotalLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityRequest")
+	proto.RegisterType((*QueryTotalLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityResponse")
+}
+
+func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
+
+var fileDescriptor_d9a717df9ca609ef = []byte{
+	// 2589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x5b, 0x6c, 0x1c, 0x57,
+	0x19, 0xce, 0xac, 0xd7, 0xae, 0xfd, 0xfb, 0x7e, 0xea, 0xcb, 0x66, 0x9c, 0x78, 0x93, 0xd3, 0x36,
+	0x49, 0x13, 0x7b, 0xd7, 0x4e, 0x1c, 0x51, 0x5c, 0xd2, 0xc4, 0x1b, 0xdb, 0x89, 0xad, 0x5c, 0xdc,
+	0x49, 0x44, 0x20, 0x08, 0x46, 0xe3, 0xdd, 0xc9, 0x7a, 0xda, 0xdd, 0x99, 0xcd, 0xcc, 0x99, 0x3a,
+	0x16, 0xaa, 0x2a, 0x55, 0x08, 0x45, 0x3c, 0xd0, 0x4a, 0x94, 0x42, 0x01, 0x91, 0x3c, 0x20, 0x84,
+	0x90, 0x78, 0x43, 0xe2, 0x09, 0xa4, 0x0a, 0x21, 0x55, 0x3c, 0x45, 0x82, 0x07, 0xc4, 0x83, 0x8b,
+	0x12, 0x78, 0xe3, 0xc9, 0x2f, 0xbc, 0x21, 0x74, 0x2e, 0x73, 0xdd, 0xf5, 0xee, 0xec, 0xb6, 0x11,
+	0xe9, 0xd3, 0xee, 0xfc, 0xe7, 0xbf, 0x7c, 0xff, 0xe5, 0xdc, 0xfe, 0x03, 0x47, 0x2c, 0xa7, 0x6a,
+	0x39, 0x86, 0x93, 0x2f, 0x6b, 0xd5, 0x6a, 0xfe, 0xad, 0xf9, 0x4d, 0x9d, 0x68, 0xf3, 0xf9, 0xbb,
+	0xae, 0x6e, 0xef, 0xe4, 0x6a, 0xb6, 0x45, 0x2c, 0x34, 0x26, 0x38, 0x72, 0x94, 0x23, 0x27, 0x38,
+	0xe4, 0xb1, 0xb2, 0x55, 0xb6, 0x18, 0x43, 0x9e, 0xfe, 0xe3, 0xbc, 0xf2, 0xe1, 0x86, 0xda, 0xc8,
+	0x3d, 0x31, 0x3c, 0xe3, 0x0d, 0xd7, 0x2c, 0xab, 0x52, 0xd5, 0x4c, 0xad, 0xac, 0xdb, 0x3e, 0x97,
+	0xb3, 0xad, 0xd5, 0x54, 0xdb, 0x72, 0x89, 0x2e, 0xb8, 0xa7, 0x8b, 0x8c, 0x3d, 0xbf, 0xa9, 0x39,
+	0xba, 0xcf, 0x55, 0xb4, 0x0c, 0x53, 0x8c, 0x9f, 0x0c, 0x8f, 0x33, 0xc4, 0x3e, 0x57, 0x4d, 0x2b,
+	0x1b, 0xa6, 0x46, 0x0c, 0xcb, 0xe3, 0x3d, 0x54, 0xb6, 0xac, 0x72, 0x45, 0xcf, 0x6b, 0x35, 0x23,
+	0xaf, 0x99, 0xa6, 0x45, 0xd8, 0xa0, 0x23, 0x46, 0x0f, 0x8a, 0x51, 0xf6, 0xb5, 0xe9, 0xde, 0xc9,
+	0x6b, 0xe6, 0x8e, 0x07, 0x22, 0x3e, 0x54, 0x72, 0xed, 0xb0, 0xe2, 0x6c, 0x7c, 0x9c, 0x18, 0x55,
+	0xdd, 0x21, 0x5a, 0xb5, 0xe6, 0xe9, 0xe6, 0x28, 0x55, 0x1e, 0x2b, 0xfe, 0xc1, 0x87, 0xf0, 0x79,
+	0x18, 0x79, 0x9d, 0xc2, 0xde, 0xb0, 0xac, 0x8a, 0xa2, 0xdf, 0x75, 0x75, 0x87, 0xa0, 0x53, 0xf0,
+	0x1c, 0x0d, 0x8e, 0x6a, 0x94, 0x32, 0xd2, 0x11, 0xe9, 0x44, 0xba, 0x80, 0xf6, 0x76, 0xb3, 0x43,
+	0x3b, 0x5a, 0xb5, 0xb2, 0x88, 0xc5, 0x00, 0x56, 0x7a, 0xe8, 0xbf, 0xb5, 0x12, 0xbe, 0x0c, 0xa3,
+	0x21, 0x05, 0x4e, 0xcd, 0x32, 0x1d, 0x1d, 0x9d, 0x81, 0x34, 0x1d, 0x66, 0xe2, 0xfd, 0xa7, 0xc7,
+	0x72, 0x1c, 0x60, 0xce, 0x03, 0x98, 0x5b, 0x32, 0x77, 0x0a, 0x7d, 0x7f, 0xfe, 0xed, 0x6c, 0x37,
+	0x95, 0x5a, 0x53, 0x18, 0x33, 0xfe, 0x46, 0x48, 0x93, 0xe3, 0x61, 0x59, 0x05, 0x08, 0x02, 0x99,
+	0x49, 0x31, 0x7d, 0xc7, 0x72, 0xc2, 0x05, 0x1a, 0xf5, 0x1c, 0xaf, 0x13, 0x11, 0xf5, 0xdc, 0x86,
+	0x56, 0xd6, 0x85, 0xac, 0x12, 0x92, 0xc4, 0x1f, 0x48, 0x80, 0xc2, 0xda, 0x05, 0xd0, 0xb3, 0xd0,
+	0x4d, 0x6d, 0x3b, 0x19, 0xe9, 0x48, 0x57, 0x12, 0xa4, 0x9c, 0x1b, 0x5d, 0x6a, 0x80, 0xea, 0x78,
+	0x4b, 0x54, 0xdc, 0x66, 0x04, 0x96, 0x0c, 0x63, 0x0c, 0xd5, 0x35, 0xb7, 0x1a, 0x76, 0x7b, 0x31,
+	0x95, 0x91, 0xf0, 0x35, 0x18, 0x8f, 0x8d, 0x09, 0xd0, 0xf3, 0xd0, 0x67, 0xba, 0x55, 0xd5, 0x03,
+	0x4e, 0x33, 0x34, 0xb6, 0xb7, 0x9b, 0x1d, 0xe1, 0x19, 0xf2, 0x87, 0xb0, 0xd2, 0x6b, 0x0a, 0x51,
+	0xa6, 0xef, 0xa2, 0xb0, 0x45, 0x29, 0x37, 0x77, 0x6a, 0x7a, 0x47, 0xe9, 0x5e, 0x87, 0xf1, 0x98,
+	0x92, 0x00, 0x14, 0x63, 0x26, 0x3b, 0x35, 0x9d, 0xe9, 0xe9, 0x0b, 0x83, 0xf2, 0x87, 0xb0, 0xd2,
+	0x5b, 0x13, 0xa2, 0xf8, 0x77, 0x12, 0x4c, 0x33, 0x65, 0x17, 0xb5, 0x4a, 0x71, 0xdd, 0x32, 0x4c,
+	0xaa, 0xf4, 0xc6, 0x96, 0x66, 0xeb, 0x4e, 0x27, 0xd8, 0xd0, 0x16, 0xf4, 0x11, 0xeb, 0x4d, 0xdd,
+	0x74, 0x54, 0x83, 0x26, 0x85, 0x26, 0xf4, 0x60, 0x24, 0x29, 0x5e, 0x3a, 0x2e, 0x5a, 0x86, 0x59,
+	0x98, 0xfb, 0x64, 0x37, 0x7b, 0xe0, 0xd7, 0x9f, 0x66, 0x4f, 0x94, 0x0d, 0xb2, 0xe5, 0x6e, 0xe6,
+	0x8a, 0x56, 0x55, 0x4c, 0x0d, 0xf1, 0x33, 0xeb, 0x94, 0xde, 0xcc, 0x53, 0xcc, 0x0e, 0x13, 0x70,
+	0x94, 0x5e, 0xae, 0x7d, 0xcd, 0xc4, 0xef, 0xa6, 0x20, 0xbb, 0x2f, 0x72, 0x11, 0x10, 0x07, 0x46,
+	0x1c, 0x4a, 0x51, 0x2d, 0x97, 0xa8, 0x5a, 0xd5, 0x72, 0x4d, 0x22, 0xe2, 0xb2, 0x46, 0x2d, 0xff,
+	0x7d, 0x37, 0x7b, 0x2c, 0x81, 0xe5, 0x35, 0x93, 0xec, 0xed, 0x66, 0x27, 0xb9, 0xc7, 0x71, 0x7d,
+	0x58, 0x19, 0x62, 0xa4, 0xeb, 0x2e, 0x59, 0x62, 0x04, 0xf4, 0x06, 0x80, 0x08, 0x81, 0xe5, 0x92,
+	0xa7, 0x11, 0x03, 0x11, 0xe1, 0xeb, 0x2e, 0xc1, 0x3f, 0x4d, 0x41, 0x9e, 0x05, 0x61, 0xc5, 0x21,
+	0x46, 0x55, 0x23, 0xba, 0x17, 0x88, 0x5b, 0x06, 0xd9, 0x5a, 0xb2, 0x37, 0x0d, 0x62, 0x6b, 0xf6,
+	0xce, 0x4d, 0xc6, 0xff, 0x6c, 0xe7, 0x13, 0xbd, 0x0e, 0xc3, 0xec, 0xbf, 0x6a, 0x98, 0x7c, 0xf9,
+	0x77, 0x32, 0x5d, 0xcc, 0xde, 0x0b, 0xb9, 0x46, 0x3b, 0x4f, 0x8e, 0x39, 0xb5, 0x66, 0x2a, 0x8c,
+	0xb5, 0x90, 0xa6, 0x96, 0x95, 0x41, 0x12, 0x26, 0xe2, 0x8f, 0x52, 0x30, 0x97, 0x3c, 0x3a, 0xff,
+	0xcf, 0x9a, 0xa9, 0xc1, 0xa0, 0x08, 0xf3, 0x1b, 0x96, 0x61, 0xea, 0xa5, 0xa7, 0x11, 0xea, 0x01,
+	0x6e, 0x61, 0x9d, 0x19, 0xc0, 0x3f, 0x91, 0xe0, 0xb8, 0x3f, 0x7d, 0x56, 0xee, 0x19, 0x84, 0xc6,
+	0x85, 0x31, 0xae, 0xda, 0x56, 0x35, 0xba, 0x02, 0x4c, 0xc6, 0x2a, 0xc6, 0xaf, 0x8e, 0xaf, 0xc2,
+	0x30, 0xf7, 0xcd, 0x30, 0xbd, 0x50, 0xa5, 0x58, 0xa8, 0x72, 0xed, 0x85, 0x4a, 0x19, 0x64, 0x6a,
+	0xd6, 0x4c, 0x1e, 0x0e, 0xfc, 0xa1, 0x04, 0x27, 0x5a, 0x83, 0x13, 0x09, 0x8b, 0xce, 0x37, 0xe9,
+	0xa9, 0xce, 0xb7, 0x15, 0x98, 0xf0, 0x97, 0xde, 0x0d, 0xcd, 0xd6, 0xaa, 0x1d, 0xcd, 0x2a, 0x7c,
+	0x09, 0x26, 0xeb, 0xd4, 0x08, 0x6f, 0x66, 0xa0, 0xa7, 0xc6, 0x28, 0xcd, 0x36, 0x6e, 0x45, 0xf0,
+	0xe0, 0xab, 0x62, 0xf5, 0xbe, 0x69, 0x11, 0xad, 0x42, 0xb5, 0x5d, 0x31, 0xee, 0xba, 0x46, 0xc9,
+	0x20, 0x3b, 0x1d, 0xe1, 0x7a, 0x28, 0x41, 0x76, 0x5f, 0x7d, 0x02, 0xe0, 0xdb, 0xd0, 0x57, 0xf1,
+	0x88, 0xad, 0xa3, 0xbd, 0x4c, 0xa3, 0x1d, 0xec, 0x41, 0xbe, 0x24, 0x6e, 0x2f, 0x03, 0x81, 0xdc,
+	0x2a, 0x4c, 0x06, 0x08, 0x3b, 0xdf, 0xa8, 0xb0, 0x0b, 0x99, 0x7a, 0x3d, 0xc2, 0xc5, 0xaf, 0xc3,
+	0x00, 0xa1, 0x64, 0x95, 0x55, 0xa5, 0x97, 0x89, 0x26, 0x5e, 0x4e, 0x09, 0x2f, 0x9f, 0xe7, 0xc6,
+	0xc2, 0xc2, 0x58, 0xe9, 0x27, 0x81, 0x09, 0xbc, 0x2c, 0xf6, 0xee, 0x2b, 0x85, 0x8d, 0x1b, 0x44,
+	0x23, 0x6e, 0x67, 0xe0, 0xbf, 0x2f, 0x41, 0xff, 0xb2, 0x6e, 0x5a, 0xd5, 0x5b, 0xba, 0x51, 0xde,
+	0x22, 0xe8, 0x18, 0x74, 0x97, 0xe8, 0xa7, 0x58, 0xa8, 0x46, 0xf6, 0x76, 0xb3, 0x03, 0x5c, 0x94,
+	0x91, 0xb1, 0xc2, 0x87, 0xd1, 0x2d, 0xe8, 0xd9, 0x66, 0x12, 0x62, 0x9a, 0x9e, 0x6f, 0x63, 0x9a,
+	0x2e, 0xeb, 0xc5, 0xbd, 0xdd, 0xec, 0x20, 0x57, 0xcb, 0xb5, 0x60, 0x45, 0xa8, 0xc3, 0xbf, 0x49,
+	0xc3, 0x44, 0xdc, 0x2f, 0x7f, 0x7a, 0x0e, 0x17, 0x5d, 0xdb, 0xd6, 0x4d, 0xa2, 0x72, 0x66, 0xef,
+	0xa0, 0x77, 0xb4, 0xf1, 0xba, 0x1e, 0xf2, 0xab, 0x30, 0x2d, 0xe2, 0x3a, 0xc1, 0xad, 0xc6, 0xf4,
+	0x60, 0x65, 0x48, 0x50, 0x38, 0xbb, 0x83, 0xca, 0x30, 0x44, 0x34, 0xbb, 0xac, 0x07, 0xa6, 0x52,
+	0x49, 0x4d, 0x1d, 0x16, 0xa6, 0xc6, 0x45, 0x0a, 0x23, 0x6a, 0xb0, 0x32, 0xc8, 0x09, 0x9e, 0xa1,
+	0xaf, 0x01, 0x38, 0x44, 0xb3, 0x89, 0x4a, 0x8f, 0xf9, 0x99, 0x2e, 0x56, 0x1f, 0x72, 0xdd, 0x4c,
+	0xbd, 0xe9, 0xdd, 0x01, 0x7c, 0xed, 0xa3, 0x5c, 0x7b, 0x20, 0x8b, 0xdf, 0xff, 0x34, 0x2b, 0x29,
+	0x7d, 0x8c, 0x40, 0xd9, 0x51, 0x11, 0x86, 0x28, 0x5d, 0xb5, 0xf5, 0xaa, 0x66, 0x98, 0x86, 0x59,
+	0xce, 0xa4, 0x45, 0xf5, 0xc5, 0xb5, 0x2f, 0x8b, 0x1b, 0x48, 0xe1, 0x68, 0x0c, 0x7a, 0x44, 0x1c,
+	0xff, 0x98, 0x1a, 0x18, 0xa4, 0x44, 0xc5, 0xa3, 0xa1, 0x45, 0x18, 0xa0, 0xd7, 0x2c, 0x47, 0xad,
+	0x69, 0xae, 0xa3, 0x97, 0x32, 0xdd, 0x47, 0xa4, 0x13, 0xbd, 0x85, 0xc9, 0xa0, 0x82, 0xc3, 0xa3,
+	0x58, 0xe9, 0x67, 0x9f, 0x1b, 0xec, 0x0b, 0x5d, 0x84, 0x61, 0x56, 0x8f, 0x45, 0xcb, 0x24, 0xb6,
+	0x55, 0xa9, 0xe8, 0x76, 0xa6, 0x87, 0x15, 0x93, 0x1c, 0x24, 0x2a, 0xc6, 0x80, 0x95, 0xa1, 0x1a,
+	0x5b, 0xc0, 0x7d, 0xc2, 0x15, 0x38, 0xcc, 0xca, 0xe5, 0x46, 0x51, 0xab, 0x18, 0x66, 0x79, 0x55,
+	0x2b, 0x12, 0xcb, 0x56, 0xb4, 0x6a, 0xad, 0xa3, 0xe9, 0xf0, 0x41, 0x17, 0x4c, 0xef, 0xa7, 0x4e,
+	0x54, 0xe1, 0x6d, 0x98, 0xf4, 0xaa, 0xc7, 0xe1, 0x4c, 0xea, 0x1d, 0xc6, 0xc5, 0xab, 0x31, 0x5d,
+	0xc0, 0x7b, 0xbb, 0xd9, 0xe9, 0x68, 0x99, 0xc5, 0x18, 0xb1, 0x32, 0x2e, 0x46, 0x22, 0x66, 0x1c,
+	0x74, 0x0b, 0x26, 0x44, 0xb9, 0xc4, 0x55, 0xa7, 0x98, 0xea, 0xa3, 0x7b, 0xbb, 0xd9, 0xc3, 0x91,
+	0xb2, 0xaa, 0xd3, 0x3c, 0xc6, 0x07, 0x62, 0x8a, 0xbf, 0xd8, 0x55, 0x86, 0xbf, 0x23, 0xc1, 0x41,
+	0x7f, 0x17, 0xbf, 0x6a, 0x94, 0xb9, 0x22, 0x2f, 0xc3, 0x65, 0x18, 0xe5, 0x2b, 0xa4, 0x4a, 0x2c,
+	0xb5, 0xca, 0x46, 0xf5, 0xd6, 0x2b, 0xed, 0x11, 0x81, 0x22, 0x13, 0x3a, 0x59, 0x85, 0x35, 0x60,
+	0x85, 0x9f, 0x48, 0x9c, 0x9b, 0xd6, 0x55, 0x41, 0x79, 0x90, 0x06, 0xb9, 0x11, 0x0c, 0x51, 0x19,
+	0x2b, 0x30, 0x22, 0x0a, 0x4a, 0xd5, 0x4d, 0xa2, 0xdb, 0x34, 0x18, 0xbc, 0xe4, 0xa6, 0x82, 0x13,
+	0x5c, 0x9c, 0x43, 0x54, 0xf4, 0x5a, 0x69, 0x45, 0x10, 0xd0, 0x6d, 0x78, 0x8e, 0x9f, 0x80, 0xe6,
+	0xc4, 0xda, 0x7a, 0xa1, 0xed, 0xd3, 0xa2, 0x28, 0x6f, 0xa1, 0x06, 0x2b, 0x9e, 0xc2, 0x40, 0xf7,
+	0x7c, 0xa6, 0xeb, 0xf3, 0xd0, 0x3d, 0xef, 0xeb, 0x9e, 0x47, 0xdb, 0x30, 0xea, 0x6f, 0xae, 0x6a,
+	0xd1, 0xd6, 0x35, 0xa2, 0x97, 0x58, 0x31, 0xf4, 0x15, 0xd6, 0xdb, 0xde, 0x1d, 0x32, 0xb1, 0x5d,
+	0xde, 0x53, 0x88, 0x95, 0x11, 0x9f, 0x76, 0x91, 0x93, 0xd0, 0x7d, 0x09, 0x06, 0x6d, 0xfd, 0x8e,
+	0x6b, 0x96, 0xf4, 0x92, 0x5a, 0x72, 0x1d, 0x92, 0xe9, 0x6e, 0x75, 0x98, 0xb8, 0x2c, 0x92, 0x3f,
+	0xc6, 0xcd, 0x44, 0xa4, 0xdb, 0x3b, 0x50, 0x0c, 0x78, 0xb2, 0xcb, 0x54, 0xf4, 0xf7, 0x12, 0xbc,
+	0x58, 0x77, 0x95, 0xbc, 0x66, 0xdd, 0xd8, 0xd6, 0x6a, 0x5f, 0x88, 0xab, 0xf0, 0x7f, 0x24, 0x78,
+	0xa9, 0x05, 0x7e, 0x51, 0xec, 0xef, 0xb4, 0x77, 0x56, 0x5e, 0x89, 0x2e, 0x28, 0x81, 0x28, 0xee,
+	0xf0, 0x00, 0x8d, 0xae, 0x02, 0x88, 0x39, 0xcb, 0x2f, 0xc7, 0x9d, 0x5c, 0x16, 0xfa, 0xb8, 0x06,
+	0x7a, 0x1e, 0xff, 0xb7, 0x24, 0xce, 0x53, 0x37, 0x6a, 0x16, 0xd9, 0xb0, 0x8d, 0x62, 0x47, 0x1d,
+	0x15, 0xba, 0x06, 0x50, 0xe7, 0x55, 0xcd, 0x71, 0x74, 0xa2, 0xf2, 0xa3, 0x14, 0xc7, 0x16, 0x5a,
+	0x03, 0xe2, 0x1c, 0x58, 0x19, 0xa2, 0xa4, 0x25, 0x4a, 0x61, 0x27, 0x09, 0x74, 0x19, 0x46, 0xef,
+	0xba, 0x16, 0x89, 0xea, 0xe1, 0x33, 0xf6, 0x50, 0x30, 0x3b, 0xea, 0x58, 0xb0, 0x32, 0xcc, 0x68,
+	0x81, 0x26, 0xda, 0x2b, 0x5a, 0x4f, 0xf7, 0xa6, 0x47, 0xba, 0x95, 0xfe, 0x6d, 0x83, 0x6c, 0xd1,
+	0x4c, 0xae, 0xea, 0x3a, 0xfe, 0x58, 0x82, 0xa9, 0xa0, 0x83, 0x46, 0x6f, 0xb1, 0xab, 0x46, 0x85,
+	0xe8, 0xb6, 0xe7, 0xf4, 0x39, 0x18, 0xac, 0x1a, 0xa6, 0x1a, 0x3e, 0x9f, 0x53, 0xe3, 0x99, 0x60,
+	0xce, 0x44, 0x86, 0xb1, 0x32, 0x50, 0x35, 0x4c, 0xff, 0x88, 0x8f, 0xa6, 0xc2, 0xfd, 0x23, 0xe6,
+	0x7f, 0xd0, 0x29, 0x8a, 0x75, 0x01, 0xbb, 0x3a, 0xee, 0x02, 0xfe, 0x5c, 0x82, 0x43, 0x8d, 0x7d,
+	0x78, 0x46, 0xfa, 0x81, 0x0a, 0x4c, 0xc4, 0x4b, 0x4a, 0x20, 0x5b, 0x00, 0x70, 0x6a, 0x16, 0x51,
+	0x6b, 0x94, 0x2a, 0x62, 0x3b, 0x1e, 0xda, 0x6f, 0xfd, 0x31, 0xac, 0xf4, 0x39, 0x9e, 0x34, 0xeb,
+	0xfb, 0x7d, 0x2f, 0xe5, 0x1d, 0x78, 0xb6, 0xb5, 0xda, 0xca, 0x3d, 0xad, 0x28, 0x2e, 0xfe, 0x6b,
+	0xfe, 0x76, 0xf8, 0x32, 0xf4, 0x38, 0xba, 0x59, 0xd2, 0x6d, 0xa1, 0x77, 0x34, 0x38, 0x6c, 0x73,
+	0x3a, 0x56, 0x04, 0x43, 0xb8, 0xb4, 0x53, 0x2d, 0x4b, 0x3b, 0x07, 0xbd, 0x5e, 0x5b, 0x45, 0x94,
+	0xe2, 0xf3, 0x7b, 0xbb, 0xd9, 0xe1, 0xd0, 0x84, 0x56, 0x0d, 0x13, 0x2b, 0xcf, 0x89, 0xce, 0x09,
+	0xfa, 0x26, 0xf4, 0x88, 0xee, 0x4b, 0x9a, 0x85, 0x3f, 0xe7, 0x1f, 0x9d, 0x43, 0xcd, 0x7a, 0x3f,
+	0x88, 0xd4, 0x1d, 0xdf, 0x13, 0x2a, 0x56, 0x18, 0x17, 0x4b, 0x86, 0xc0, 0xce, 0x75, 0x61, 0x45,
+	0x28, 0x65, 0xc1, 0xf8, 0xc8, 0xeb, 0x39, 0x36, 0x08, 0x46, 0xd0, 0x84, 0xe1, 0xd8, 0x3e, 0xbf,
+	0x26, 0x4c, 0x5c, 0x1f, 0x56, 0x86, 0x18, 0xc9, 0x6f, 0xc2, 0x30, 0x6c, 0xef, 0xa5, 0x1a, 0x63,
+	0xbb, 0xee, 0x92, 0xa7, 0x9d, 0xa9, 0x6f, 0x41, 0x4f, 0xa4, 0xef, 0x95, 0x4f, 0x18, 0x79, 0x0a,
+	0x2d, 0x41, 0xe8, 0x69, 0x77, 0xd8, 0x8f, 0x41, 0x26, 0x1d, 0xef, 0x0e, 0xfb, 0x43, 0x58, 0x6c,
+	0x2c, 0xd7, 0x5d, 0x1e, 0x91, 0x1f, 0x79, 0x3d, 0x81, 0x46, 0x11, 0x11, 0xe9, 0xaa, 0x85, 0x7a,
+	0x77, 0x91, 0x6c, 0x5d, 0x6e, 0x3b, 0x5b, 0x13, 0xd1, 0xca, 0xf4, 0x93, 0xe5, 0xb5, 0xf6, 0x42,
+	0xb9, 0x3a, 0x04, 0x72, 0x70, 0x85, 0x8f, 0x37, 0x3e, 0xf0, 0xcf, 0xbc, 0xb5, 0x32, 0x3e, 0xfc,
+	0x4c, 0xf4, 0x31, 0x4e, 0xff, 0x41, 0x86, 0x6e, 0x06, 0x0f, 0xbd, 0x03, 0x6c, 0x21, 0x73, 0xd0,
+	0xf1, 0xc6, 0xb7, 0xd4, 0xba, 0x07, 0x19, 0xf9, 0x44, 0x6b, 0x46, 0xee, 0x24, 0x7e, 0xe1, 0xdd,
+	0xbf, 0xfc, 0xf3, 0x07, 0xa9, 0xc3, 0x68, 0x2a, 0xdf, 0xf0, 0x45, 0x8e, 0xaf, 0x9c, 0xef, 0x49,
+	0xd0, 0xeb, 0x3d, 0x70, 0xa0, 0x93, 0x4d, 0x74, 0xc7, 0x5e, 0x48, 0xe4, 0x53, 0x89, 0x78, 0x05,
+	0x94, 0x93, 0x0c, 0xca, 0x51, 0x94, 0x6d, 0x0c, 0xc5, 0x7f, 0x32, 0xb9, 0x9f, 0x92, 0xd0, 0x2f,
+	0x24, 0x18, 0x8a, 0xa6, 0x0d, 0xcd, 0x35, 0xb1, 0xd5, 0xb0, 0x00, 0xe4, 0xf9, 0x36, 0x24, 0x04,
+	0xc6, 0x59, 0x86, 0xf1, 0x38, 0x7a, 0xa9, 0x31, 0x46, 0xde, 0xd7, 0xf1, 0x73, 0x88, 0x7e, 0x29,
+	0xc1, 0x70, 0x6c, 0x17, 0x43, 0xf3, 0xad, 0x72, 0x53, 0xb7, 0x6b, 0xcb, 0xa7, 0xdb, 0x11, 0x11,
+	0x48, 0x67, 0x18, 0xd2, 0x63, 0xe8, 0xc5, 0xc6, 0x48, 0xef, 0x30, 0x6e, 0xbd, 0xc4, 0x43, 0x8a,
+	0xbe, 0x2b, 0x41, 0x9a, 0x6a, 0x42, 0xc7, 0x5a, 0x98, 0xf2, 0x20, 0x1d, 0x6f, 0xc9, 0x97, 0x2c,
+	0x62, 0xcc, 0x7c, 0xfe, 0xdb, 0x62, 0xad, 0x7b, 0x1b, 0x7d, 0x28, 0x41, 0xaf, 0xf7, 0x6c, 0xd5,
+	0xb4, 0xd4, 0x62, 0x0f, 0x64, 0xf2, 0xa9, 0x44, 0xbc, 0x02, 0xd4, 0x3c, 0x03, 0x75, 0x0a, 0xbd,
+	0xbc, 0x3f, 0x28, 0x76, 0xc6, 0x09, 0x01, 0xfb, 0xa1, 0x04, 0x99, 0xfd, 0x4e, 0xcf, 0x68, 0xb1,
+	0x89, 0xf1, 0x16, 0x57, 0x06, 0xf9, 0xd5, 0x8e, 0x64, 0x85, 0x23, 0x07, 0xd0, 0x1f, 0x25, 0x40,
+	0xf5, 0x0f, 0x5c, 0x68, 0x21, 0xa1, 0xd6, 0x28, 0x96, 0xb3, 0x6d, 0x4a, 0x09, 0x14, 0x17, 0x58,
+	0x38, 0x17, 0xd1, 0x2b, 0x89, 0x72, 0x9c, 0xa7, 0x2f, 0x18, 0x2a, 0x7b, 0xc5, 0xd7, 0xe9, 0x6e,
+	0xa1, 0x1a, 0x26, 0xfa, 0xaf, 0x04, 0x2f, 0x24, 0x78, 0x83, 0x41, 0x2b, 0x4d, 0x00, 0x26, 0x7f,
+	0xe1, 0x92, 0x57, 0x3f, 0xab, 0x1a, 0xe1, 0xf8, 0x65, 0xe6, 0x78, 0x01, 0x5d, 0x68, 0xc3, 0x71,
+	0x7a, 0x5c, 0x57, 0x35, 0x4f, 0xa1, 0xca, 0x6f, 0x3e, 0xe8, 0x5f, 0x12, 0x4c, 0x35, 0x79, 0xcb,
+	0x40, 0xe7, 0x5a, 0x64, 0xa6, 0xf9, 0x03, 0x8d, 0xfc, 0x5a, 0xa7, 0xe2, 0xc2, 0xd1, 0x4b, 0xcc,
+	0xd1, 0x25, 0x74, 0x3e, 0x99, 0xa3, 0xfa, 0x3d, 0x83, 0xf0, 0x0c, 0xf3, 0xd7, 0x1f, 0xbe, 0x47,
+	0xd3, 0x44, 0x3f, 0x90, 0x00, 0x82, 0x47, 0x0d, 0x34, 0xd3, 0x62, 0xd6, 0x46, 0x9e, 0x50, 0xe4,
+	0xd9, 0x84, 0xdc, 0x02, 0xf4, 0x02, 0x03, 0x9d, 0x43, 0x33, 0xc9, 0x40, 0xf3, 0x17, 0x13, 0xf4,
+	0x27, 0x09, 0x50, 0xfd, 0xeb, 0x46, 0xd3, 0x09, 0xb5, 0xef, 0xe3, 0x8a, 0x7c, 0xb6, 0x4d, 0x29,
+	0x81, 0xbc, 0xc0, 0x90, 0x7f, 0x05, 0x2d, 0x26, 0x43, 0xce, 0xb7, 0x1d, 0xf6, 0x19, 0xec, 0x3d,
+	0xbf, 0x92, 0xa0, 0x3f, 0xf4, 0x76, 0x81, 0x66, 0x5b, 0x41, 0x89, 0x56, 0x4c, 0x2e, 0x29, 0xbb,
+	0x80, 0xbc, 0xc8, 0x20, 0x2f, 0xa0, 0xd3, 0xed, 0x40, 0xe6, 0xf7, 0x74, 0xf4, 0x50, 0x82, 0x3e,
+	0xff, 0x5d, 0x00, 0x35, 0x5b, 0xc9, 0xe3, 0xaf, 0x22, 0xf2, 0x4c, 0x32, 0x66, 0x01, 0xf2, 0x15,
+	0x06, 0xf2, 0x34, 0x9a, 0x4b, 0x06, 0xb2, 0xb2, 0x59, 0x53, 0x1d, 0x0e, 0xea, 0x63, 0x09, 0x46,
+	0xeb, 0x9a, 0xc7, 0xe8, 0x4c, 0x13, 0xeb, 0xfb, 0x75, 0xae, 0xe5, 0x85, 0xf6, 0x84, 0x04, 0xf4,
+	0x25, 0x06, 0xfd, 0x55, 0xf4, 0xe5, 0x64, 0xd0, 0xa3, 0x0d, 0x64, 0xd5, 0xa6, 0x68, 0x1f, 0x4a,
+	0x30, 0x18, 0x69, 0x71, 0xa2, 0x7c, 0x8b, 0x65, 0x21, 0xde, 0x93, 0x95, 0xe7, 0x92, 0x0b, 0x24,
+	0x3b, 0x87, 0x14, 0xb5, 0x4a, 0x51, 0xad, 0xfa, 0x80, 0x1e, 0x48, 0xd0, 0xe7, 0x5f, 0xab, 0x9b,
+	0x56, 0x42, 0xbc, 0x9f, 0x23, 0xcf, 0x24, 0x63, 0x16, 0xb0, 0xbe, 0xd4, 0xe6, 0xda, 0x40, 0x85,
+	0xd9, 0xc9, 0xf3, 0x91, 0x04, 0x07, 0xbd, 0x2d, 0xa2, 0xee, 0x7a, 0xda, 0xbc, 0x20, 0xf6, 0xb9,
+	0xd9, 0xcb, 0x0b, 0xed, 0x09, 0x45, 0xf6, 0x9e, 0xf3, 0xe8, 0x5c, 0x63, 0x0f, 0x02, 0xec, 0xba,
+	0x40, 0x9b, 0x0f, 0x6d, 0xb9, 0xfe, 0x82, 0x4c, 0x5d, 0xfa, 0xab, 0x04, 0xf2, 0x3e, 0x2e, 0xd1,
+	0x8e, 0x5c, 0x1b, 0xf0, 0x82, 0x4b, 0xb0, 0x7c, 0xb6, 0x4d, 0x29, 0xe1, 0xd5, 0x1a, 0xf3, 0xea,
+	0x02, 0x7a, 0xed, 0x33, 0x78, 0x65, 0xb9, 0xe4, 0x7e, 0x4a, 0x2a, 0xac, 0x7f, 0xf2, 0x78, 0x5a,
+	0x7a, 0xf4, 0x78, 0x5a, 0xfa, 0xc7, 0xe3, 0x69, 0xe9, 0xfd, 0x27, 0xd3, 0x07, 0x1e, 0x3d, 0x99,
+	0x3e, 0xf0, 0xb7, 0x27, 0xd3, 0x07, 0x6e, 0xcf, 0x85, 0xee, 0x63, 0xc2, 0xcc, 0x6c, 0x45, 0xdb,
+	0x74, 0x7c, 0x9b, 0x6f, 0xcd, 0x2f, 0xe4, 0xef, 0x71, 0xcb, 0xec, 0x76, 0xb6, 0xd9, 0xc3, 0x7a,
+	0x4b, 0x67, 0xfe, 0x37, 0x00, 0xe2, 0x23, 0x43, 0x54, 0x06, 0x29, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error)
+	TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error)
+	// PoolsWithFilter allows you to query specific pools with requested
+	// parameters
+	PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFilterRequest, opts ...grpc.CallOption) (*QueryPoolsWithFilterResponse, error)
+	// Per Pool gRPC Endpoints
+	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	// PoolType returns the type of the pool.
+	// Returns "Balancer" as a string literal when the pool is a balancer pool.
+	// Errors if the pool is failed to be type caseted.
+	PoolType(ctx context.Context, in *QueryPoolTypeRequest, opts ...grpc.CallOption) (*QueryPoolTypeResponse, error)
+	// Simulates joining pool without a swap. Returns the amount of shares you'd
+	// get and tokens needed to provide
+	CalcJoinPoolNoSwapShares(ctx context.Context, in *QueryCalcJoinPoolNoSwapSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolNoSwapSharesResponse, error)
+	CalcJoinPoolShares(ctx context.Context, in *QueryCalcJoinPoolSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolSharesResponse, error)
+	// EstimateJoinPoolWithArbitraryTokens simulates joining a pool with any
+	// basket of tokens. Returns the amount of shares you'd get and the pool
+	// assets that would be joined.
+	EstimateJoinPoolWithArbitraryTokens(ctx context.Context, in *QueryEstimateJoinPoolWithArbitraryTokensRequest, opts ...grpc.CallOption) (*QueryEstimateJoinPoolWithArbitraryTokensResponse, error)
+	CalcExitPoolCoinsFromShares(ctx context.Context, in *QueryCalcExitPoolCoinsFromSharesRequest, opts ...grpc.CallOption) (*QueryCalcExitPoolCoinsFromSharesResponse, error)
+	PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error)
+	TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error)
+	TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
+	// LBPStatus returns the current and target weights of a balancer pool, the
+	// time left until its smooth weight change completes, and whether its swaps
+	// are paused.
+	LBPStatus(ctx context.Context, in *QueryLBPStatusRequest, opts ...grpc.CallOption) (*QueryLBPStatusResponse, error)
+	// ScalingFactorRamp returns the current and target scaling factors of a
+	// stableswap pool and the time left until its scaling factor ramp completes.
+	ScalingFactorRamp(ctx context.Context, in *QueryScalingFactorRampRequest, opts ...grpc.CallOption) (*QueryScalingFactorRampResponse, error)
+	// CalcMigration returns the result of migrating the given gamm shares to a
+	// full range position in the linked concentrated liquidity pool, without
+	// migrating them.
+	CalcMigration(ctx context.Context, in *QueryCalcMigrationRequest, opts ...grpc.CallOption) (*QueryCalcMigrationResponse, error)
+	// SpotPrice defines a gRPC query handler that returns the spot price given
+	// a base denomination and a quote denomination.
+	SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/Pools", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/Pools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_NumPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNumPoolsRequest)
-	if err := dec(in); err != nil {
+// Deprecated: Do not use.
+func (c *queryClient) NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error) {
+	out := new(QueryNumPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/NumPools", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).NumPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/NumPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NumPools(ctx, req.(*QueryNumPoolsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalLiquidityRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error) {
+	out := new(QueryTotalLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalLiquidity", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalLiquidity(ctx, req.(*QueryTotalLiquidityRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_PoolsWithFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolsWithFilterRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFilterRequest, opts ...grpc.CallOption) (*QueryPoolsWithFilterResponse, error) {
+	out := new(QueryPoolsWithFilterResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolsWithFilter", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolsWithFilter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolsWithFilter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolsWithFilter(ctx, req.(*QueryPoolsWithFilterRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
+	out := new(QueryPoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/Pool", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/Pool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_PoolType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolTypeRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) PoolType(ctx context.Context, in *QueryPoolTypeRequest, opts ...grpc.CallOption) (*QueryPoolTypeResponse, error) {
+	out := new(QueryPoolTypeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolType", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolType(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolType",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolType(ctx, req.(*QueryPoolTypeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CalcJoinPoolNoSwapShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCalcJoinPoolNoSwapSharesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) CalcJoinPoolNoSwapShares(ctx context.Context, in *QueryCalcJoinPoolNoSwapSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolNoSwapSharesResponse, error) {
+	out := new(QueryCalcJoinPoolNoSwapSharesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CalcJoinPoolNoSwapShares", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CalcJoinPoolNoSwapShares(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) CalcJoinPoolShares(ctx context.Context, in *QueryCalcJoinPoolSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolSharesResponse, error) {
+	out := new(QueryCalcJoinPoolSharesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CalcJoinPoolShares", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/CalcJoinPoolNoSwapShares",
+	return out, nil
+}
+
+func (c *queryClient) EstimateJoinPoolWithArbitraryTokens(ctx context.Context, in *QueryEstimateJoinPoolWithArbitraryTokensRequest, opts ...grpc.CallOption) (*QueryEstimateJoinPoolWithArbitraryTokensResponse, error) {
+	out := new(QueryEstimateJoinPoolWithArbitraryTokensResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateJoinPoolWithArbitraryTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalcJoinPoolNoSwapShares(ctx, req.(*QueryCalcJoinPoolNoSwapSharesRequest))
+	return out, nil
+}
+
+func (c *queryClient) CalcExitPoolCoinsFromShares(ctx context.Context, in *QueryCalcExitPoolCoinsFromSharesRequest, opts ...grpc.CallOption) (*QueryCalcExitPoolCoinsFromSharesResponse, error) {
+	out := new(QueryCalcExitPoolCoinsFromSharesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CalcExitPoolCoinsFromShares", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CalcJoinPoolShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCalcJoinPoolSharesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error) {
+	out := new(QueryPoolParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolParams", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CalcJoinPoolShares(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error) {
+	out := new(QueryTotalPoolLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/CalcJoinPoolShares",
+	return out, nil
+}
+
+func (c *queryClient) TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error) {
+	out := new(QueryTotalSharesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalShares", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalcJoinPoolShares(ctx, req.(*QueryCalcJoinPoolSharesRequest))
+	return out, nil
+}
+
+func (c *queryClient) LBPStatus(ctx context.Context, in *QueryLBPStatusRequest, opts ...grpc.CallOption) (*QueryLBPStatusResponse, error) {
+	out := new(QueryLBPStatusResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/LBPStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CalcExitPoolCoinsFromShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCalcExitPoolCoinsFromSharesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ScalingFactorRamp(ctx context.Context, in *QueryScalingFactorRampRequest, opts ...grpc.CallOption) (*QueryScalingFactorRampResponse, error) {
+	out := new(QueryScalingFactorRampResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/ScalingFactorRamp", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CalcExitPoolCoinsFromShares(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) CalcMigration(ctx context.Context, in *QueryCalcMigrationRequest, opts ...grpc.CallOption) (*QueryCalcMigrationResponse, error) {
+	out := new(QueryCalcMigrationResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CalcMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/CalcExitPoolCoinsFromShares",
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *queryClient) SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error) {
+	out := new(QuerySpotPriceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/SpotPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalcExitPoolCoinsFromShares(ctx, req.(*QueryCalcExitPoolCoinsFromSharesRequest))
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error) {
+	out := new(QuerySwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_PoolParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolParamsRequest)
+// Deprecated: Do not use.
+func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error) {
+	out := new(QuerySwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	NumPools(context.Context, *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error)
+	TotalLiquidity(context.Context, *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error)
+	// PoolsWithFilter allows you to query specific pools with requested
+	// parameters
+	PoolsWithFilter(context.Context, *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error)
+	// Per Pool gRPC Endpoints
+	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	// PoolType returns the type of the pool.
+	// Returns "Balancer" as a string literal when the pool is a balancer pool.
+	// Errors if the pool is failed to be type caseted.
+	PoolType(context.Context, *QueryPoolTypeRequest) (*QueryPoolTypeResponse, error)
+	// Simulates joining pool without a swap. Returns the amount of shares you'd
+	// get and tokens needed to provide
+	CalcJoinPoolNoSwapShares(context.Context, *QueryCalcJoinPoolNoSwapSharesRequest) (*QueryCalcJoinPoolNoSwapSharesResponse, error)
+	CalcJoinPoolShares(context.Context, *QueryCalcJoinPoolSharesRequest) (*QueryCalcJoinPoolSharesResponse, error)
+	// EstimateJoinPoolWithArbitraryTokens simulates joining a pool with any
+	// basket of tokens. Returns the amount of shares you'd get and the pool
+	// assets that would be joined.
+	EstimateJoinPoolWithArbitraryTokens(context.Context, *QueryEstimateJoinPoolWithArbitraryTokensRequest) (*QueryEstimateJoinPoolWithArbitraryTokensResponse, error)
+	CalcExitPoolCoinsFromShares(context.Context, *QueryCalcExitPoolCoinsFromSharesRequest) (*QueryCalcExitPoolCoinsFromSharesResponse, error)
+	PoolParams(context.Context, *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error)
+	TotalPoolLiquidity(context.Context, *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error)
+	TotalShares(context.Context, *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error)
+	// LBPStatus returns the current and target weights of a balancer pool, the
+	// time left until its smooth weight change completes, and whether its swaps
+	// are paused.
+	LBPStatus(context.Context, *QueryLBPStatusRequest) (*QueryLBPStatusResponse, error)
+	// ScalingFactorRamp returns the current and target scaling factors of a
+	// stableswap pool and the time left until its scaling factor ramp completes.
+	ScalingFactorRamp(context.Context, *QueryScalingFactorRampRequest) (*QueryScalingFactorRampResponse, error)
+	// CalcMigration returns the result of migrating the given gamm shares to a
+	// full range position in the linked concentrated liquidity pool, without
+	// migrating them.
+	CalcMigration(context.Context, *QueryCalcMigrationRequest) (*QueryCalcMigrationResponse, error)
+	// SpotPrice defines a gRPC query handler that returns the spot price given
+	// a base denomination and a quote denomination.
+	SpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	EstimateSwapExactAmountIn(context.Context, *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	EstimateSwapExactAmountOut(context.Context, *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+}
+func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NumPools not implemented")
+}
+func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) PoolsWithFilter(ctx context.Context, req *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolsWithFilter not implemented")
+}
+func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (*UnimplementedQueryServer) PoolType(ctx context.Context, req *QueryPoolTypeRequest) (*QueryPoolTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolType not implemented")
+}
+func (*UnimplementedQueryServer) CalcJoinPoolNoSwapShares(ctx context.Context, req *QueryCalcJoinPoolNoSwapSharesRequest) (*QueryCalcJoinPoolNoSwapSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcJoinPoolNoSwapShares not implemented")
+}
+func (*UnimplementedQueryServer) CalcJoinPoolShares(ctx context.Context, req *QueryCalcJoinPoolSharesRequest) (*QueryCalcJoinPoolSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcJoinPoolShares not implemented")
+}
+func (*UnimplementedQueryServer) EstimateJoinPoolWithArbitraryTokens(ctx context.Context, req *QueryEstimateJoinPoolWithArbitraryTokensRequest) (*QueryEstimateJoinPoolWithArbitraryTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateJoinPoolWithArbitraryTokens not implemented")
+}
+func (*UnimplementedQueryServer) CalcExitPoolCoinsFromShares(ctx context.Context, req *QueryCalcExitPoolCoinsFromSharesRequest) (*QueryCalcExitPoolCoinsFromSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcExitPoolCoinsFromShares not implemented")
+}
+func (*UnimplementedQueryServer) PoolParams(ctx context.Context, req *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolParams not implemented")
+}
+func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalPoolLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) TotalShares(ctx context.Context, req *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalShares not implemented")
+}
+func (*UnimplementedQueryServer) LBPStatus(ctx context.Context, req *QueryLBPStatusRequest) (*QueryLBPStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LBPStatus not implemented")
+}
+func (*UnimplementedQueryServer) ScalingFactorRamp(ctx context.Context, req *QueryScalingFactorRampRequest) (*QueryScalingFactorRampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScalingFactorRamp not implemented")
+}
+func (*UnimplementedQueryServer) CalcMigration(ctx context.Context, req *QueryCalcMigrationRequest) (*QueryCalcMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcMigration not implemented")
+}
+func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).PoolParams(ctx, in)
+		return srv.(QueryServer).Pools(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolParams",
+		FullMethod: "/osmosis.gamm.v1beta1.Query/Pools",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolParams(ctx, req.(*QueryPoolParamsRequest))
+		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_TotalPoolLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalPoolLiquidityRequest)
+func _Query_NumPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNumPoolsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).TotalPoolLiquidity(ctx, in)
+		return srv.(QueryServer).NumPools(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity",
+		FullMethod: "/osmosis.gamm.v1beta1.Query/NumPools",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalPoolLiquidity(ctx, req.(*QueryTotalPoolLiquidityRequest))
+		return srv.(QueryServer).NumPools(ctx, req.(*QueryNumPoolsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_TotalShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalSharesRequest)
+func _Query_TotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).TotalShares(ctx, in)
+		return srv.(QueryServer).TotalLiquidity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalShares",
+		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalLiquidity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalShares(ctx, req.(*QueryTotalSharesRequest))
+		return srv.(QueryServer).TotalLiquidity(ctx, req.(*QueryTotalLiquidityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpotPriceRequest)
+func _Query_PoolsWithFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsWithFilterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).SpotPrice(ctx, in)
+		return srv.(QueryServer).PoolsWithFilter(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/SpotPrice",
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolsWithFilter",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SpotPrice(ctx, req.(*QuerySpotPriceRequest))
+		return srv.(QueryServer).PoolsWithFilter(ctx, req.(*QueryPoolsWithFilterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySwapExactAmountInRequest)
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, in)
+		return srv.(QueryServer).Pool(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountIn",
+		FullMethod: "/osmosis.gamm.v1beta1.Query/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolType(ctx, req.(*QueryPoolTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CalcJoinPoolNoSwapShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalcJoinPoolNoSwapSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CalcJoinPoolNoSwapShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/CalcJoinPoolNoSwapShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalcJoinPoolNoSwapShares(ctx, req.(*QueryCalcJoinPoolNoSwapSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CalcJoinPoolShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalcJoinPoolSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CalcJoinPoolShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/CalcJoinPoolShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalcJoinPoolShares(ctx, req.(*QueryCalcJoinPoolSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateJoinPoolWithArbitraryTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateJoinPoolWithArbitraryTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateJoinPoolWithArbitraryTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/EstimateJoinPoolWithArbitraryTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateJoinPoolWithArbitraryTokens(ctx, req.(*QueryEstimateJoinPoolWithArbitraryTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CalcExitPoolCoinsFromShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalcExitPoolCoinsFromSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CalcExitPoolCoinsFromShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/CalcExitPoolCoinsFromShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalcExitPoolCoinsFromShares(ctx, req.(*QueryCalcExitPoolCoinsFromSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolParams(ctx, req.(*QueryPoolParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalPoolLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalPoolLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalPoolLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalPoolLiquidity(ctx, req.(*QueryTotalPoolLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalShares(ctx, req.(*QueryTotalSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LBPStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLBPStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LBPStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/LBPStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LBPStatus(ctx, req.(*QueryLBPStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScalingFactorRamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScalingFactorRampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScalingFactorRamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/ScalingFactorRamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScalingFactorRamp(ctx, req.(*QueryScalingFactorRampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CalcMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalcMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CalcMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/CalcMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalcMigration(ctx, req.(*QueryCalcMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpotPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpotPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/SpotPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpotPrice(ctx, req.(*QuerySpotPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapExactAmountInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountIn",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, req.(*QuerySwapExactAmountInRequest))
@@ -2214,6 +2934,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CalcJoinPoolShares",
 			Handler:    _Query_CalcJoinPoolShares_Handler,
 		},
+		{
+			MethodName: "EstimateJoinPoolWithArbitraryTokens",
+			Handler:    _Query_EstimateJoinPoolWithArbitraryTokens_Handler,
+		},
 		{
 			MethodName: "CalcExitPoolCoinsFromShares",
 			Handler:    _Query_CalcExitPoolCoinsFromShares_Handler,
@@ -2230,6 +2954,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalShares",
 			Handler:    _Query_TotalShares_Handler,
 		},
+		{
+			MethodName: "LBPStatus",
+			Handler:    _Query_LBPStatus_Handler,
+		},
+		{
+			MethodName: "ScalingFactorRamp",
+			Handler:    _Query_ScalingFactorRamp_Handler,
+		},
+		{
+			MethodName: "CalcMigration",
+			Handler:    _Query_CalcMigration_Handler,
+		},
 		{
 			MethodName: "SpotPrice",
 			Handler:    _Query_SpotPrice_Handler,
@@ -2592,7 +3328,7 @@ func (m *QueryCalcJoinPoolSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryEstimateJoinPoolWithArbitraryTokensRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2602,39 +3338,142 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEstimateJoinPoolWithArbitraryTokensRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEstimateJoinPoolWithArbitraryTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.ShareInAmount.Size()
-		i -= size
-		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.TokenInRoutes) > 0 {
+		for iNdEx := len(m.TokenInRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenInRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryCalcExitPoolCoinsFromSharesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+	if len(m.TokensIn) > 0 {
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateJoinPoolWithArbitraryTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateJoinPoolWithArbitraryTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateJoinPoolWithArbitraryTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensJoined) > 0 {
+		for iNdEx := len(m.TokensJoined) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensJoined[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareInAmount.Size()
+		i -= size
+		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalcExitPoolCoinsFromSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
@@ -2856,7 +3695,7 @@ func (m *QueryTotalSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryLBPStatusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2866,30 +3705,16 @@ func (m *QueryCalcJoinPoolNoSwapSharesRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLBPStatusRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLBPStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokensIn) > 0 {
-		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
@@ -2898,7 +3723,7 @@ func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) Marshal() (dAtA []byte, err error) {
+func (m *DenomWeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2908,30 +3733,107 @@ func (m *QueryCalcJoinPoolNoSwapSharesResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DenomWeight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DenomWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.SharesOut.Size()
+		size := m.Weight.Size()
 		i -= size
-		if _, err := m.SharesOut.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.TokensOut) > 0 {
-		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLBPStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLBPStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLBPStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolController) > 0 {
+		i -= len(m.PoolController)
+		copy(dAtA[i:], m.PoolController)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolController)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SwapsPaused {
+		i--
+		if m.SwapsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TimeRemaining, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeRemaining):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TargetWeights) > 0 {
+		for iNdEx := len(m.TargetWeights) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TargetWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CurrentWeights) > 0 {
+		for iNdEx := len(m.CurrentWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2945,7 +3847,7 @@ func (m *QueryCalcJoinPoolNoSwapSharesResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryScalingFactorRampRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2955,30 +3857,16 @@ func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryScalingFactorRampRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryScalingFactorRampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.QuoteAssetDenom) > 0 {
-		i -= len(m.QuoteAssetDenom)
-		copy(dAtA[i:], m.QuoteAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BaseAssetDenom) > 0 {
-		i -= len(m.BaseAssetDenom)
-		copy(dAtA[i:], m.BaseAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
@@ -2987,7 +3875,7 @@ func (m *QuerySpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolsWithFilterRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryScalingFactorRampResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2997,46 +3885,72 @@ func (m *QueryPoolsWithFilterRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPoolsWithFilterRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryScalingFactorRampResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolsWithFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryScalingFactorRampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TimeRemaining, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeRemaining):])
+	if err8 != nil {
+		return 0, err8
 	}
-	if len(m.PoolType) > 0 {
-		i -= len(m.PoolType)
-		copy(dAtA[i:], m.PoolType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolType)))
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TargetScalingFactors) > 0 {
+		dAtA11 := make([]byte, len(m.TargetScalingFactors)*10)
+		var j10 int
+		for _, num := range m.TargetScalingFactors {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintQuery(dAtA, i, uint64(j10))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.MinLiquidity) > 0 {
-		i -= len(m.MinLiquidity)
-		copy(dAtA[i:], m.MinLiquidity)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinLiquidity)))
+	if len(m.CurrentScalingFactors) > 0 {
+		dAtA13 := make([]byte, len(m.CurrentScalingFactors)*10)
+		var j12 int
+		for _, num := range m.CurrentScalingFactors {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintQuery(dAtA, i, uint64(j12))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolsWithFilterResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCalcMigrationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3046,46 +3960,30 @@ func (m *QueryPoolsWithFilterResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPoolsWithFilterResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCalcMigrationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolsWithFilterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCalcMigrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.SharesToMigrate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCalcMigrationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3095,27 +3993,69 @@ func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCalcMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCalcMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpotPrice) > 0 {
-		i -= len(m.SpotPrice)
-		copy(dAtA[i:], m.SpotPrice)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
+	if len(m.RefundedDust) > 0 {
+		for iNdEx := len(m.RefundedDust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedDust[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.LiquidityCreated.Size()
+		i -= size
+		if _, err := m.LiquidityCreated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolIdEntering != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolIdEntering))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3125,20 +4065,20 @@ func (m *QuerySwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TokensIn) > 0 {
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3146,32 +4086,18 @@ func (m *QuerySwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3181,30 +4107,44 @@ func (m *QuerySwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.TokenOutAmount.Size()
+		size := m.SharesOut.Size()
 		i -= size
-		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SharesOut.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3214,53 +4154,88 @@ func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+	if len(m.QuoteAssetDenom) > 0 {
+		i -= len(m.QuoteAssetDenom)
+		copy(dAtA[i:], m.QuoteAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if len(m.BaseAssetDenom) > 0 {
+		i -= len(m.BaseAssetDenom)
+		copy(dAtA[i:], m.BaseAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x8
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsWithFilterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsWithFilterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsWithFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PoolType) > 0 {
+		i -= len(m.PoolType)
+		copy(dAtA[i:], m.PoolType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinLiquidity) > 0 {
+		i -= len(m.MinLiquidity)
+		copy(dAtA[i:], m.MinLiquidity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinLiquidity)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolsWithFilterResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3270,30 +4245,46 @@ func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolsWithFilterResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolsWithFilterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.TokenInAmount.Size()
-		i -= size
-		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3303,20 +4294,27 @@ func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpotPrice) > 0 {
+		i -= len(m.SpotPrice)
+		copy(dAtA[i:], m.SpotPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3326,20 +4324,20 @@ func (m *QueryTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3347,21 +4345,222 @@ func (m *QueryTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x22
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *QueryPoolRequest) Size() (n int) {
@@ -3502,6 +4701,47 @@ func (m *QueryCalcJoinPoolSharesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEstimateJoinPoolWithArbitraryTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TokenInRoutes) > 0 {
+		for _, e := range m.TokenInRoutes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateJoinPoolWithArbitraryTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokensJoined) > 0 {
+		for _, e := range m.TokensJoined {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryCalcExitPoolCoinsFromSharesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3606,7 +4846,7 @@ func (m *QueryTotalSharesResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) Size() (n int) {
+func (m *QueryLBPStatusRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3615,16 +4855,149 @@ func (m *QueryCalcJoinPoolNoSwapSharesRequest) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovQuery(uint64(m.PoolId))
 	}
-	if len(m.TokensIn) > 0 {
-		for _, e := range m.TokensIn {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) Size() (n int) {
+func (m *DenomWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLBPStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrentWeights) > 0 {
+		for _, e := range m.CurrentWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TargetWeights) > 0 {
+		for _, e := range m.TargetWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeRemaining)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SwapsPaused {
+		n += 2
+	}
+	l = len(m.PoolController)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScalingFactorRampRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryScalingFactorRampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrentScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.CurrentScalingFactors {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.TargetScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.TargetScalingFactors {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeRemaining)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCalcMigrationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SharesToMigrate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCalcMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolIdEntering != 0 {
+		n += 1 + sovQuery(uint64(m.PoolIdEntering))
+	}
+	l = m.Amount0.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LiquidityCreated.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.RefundedDust) > 0 {
+		for _, e := range m.RefundedDust {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3770,55 +5143,1044 @@ func (m *QuerySwapExactAmountOutRequest) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QuerySwapExactAmountOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryTotalLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &types.Any{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNumPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNumPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
+			}
+			m.NumPools = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPools |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensIn = append(m.TokensIn, types1.Coin{})
+			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOut = append(m.TokensOut, types1.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateJoinPoolWithArbitraryTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateJoinPoolWithArbitraryTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateJoinPoolWithArbitraryTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensIn = append(m.TokensIn, types1.Coin{})
+			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInRoutes = append(m.TokenInRoutes, TokenInRoutes{})
+			if err := m.TokenInRoutes[len(m.TokenInRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryTotalLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateJoinPoolWithArbitraryTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3841,17 +6203,17 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateJoinPoolWithArbitraryTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateJoinPoolWithArbitraryTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3861,11 +6223,60 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensJoined", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensJoined = append(m.TokensJoined, types1.Coin{})
+			if err := m.TokensJoined[len(m.TokensJoined)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3887,7 +6298,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3910,17 +6321,36 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3930,25 +6360,23 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &types.Any{}
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3973,7 +6401,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3996,15 +6424,15 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4031,10 +6459,8 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokensOut = append(m.TokensOut, types1.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4059,7 +6485,7 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4082,17 +6508,17 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4102,29 +6528,64 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4151,10 +6612,10 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Params == nil {
+				m.Params = &types.Any{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4179,7 +6640,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4202,12 +6663,31 @@ func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNumPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4229,7 +6709,7 @@ func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4252,17 +6732,17 @@ func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNumPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
-			m.NumPools = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4272,11 +6752,26 @@ func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumPools |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidity = append(m.Liquidity, types1.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4298,7 +6793,7 @@ func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolTypeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4321,10 +6816,10 @@ func (m *QueryPoolTypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalSharesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4367,7 +6862,7 @@ func (m *QueryPoolTypeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolTypeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4390,17 +6885,17 @@ func (m *QueryPoolTypeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolTypeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4410,23 +6905,24 @@ func (m *QueryPoolTypeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolType = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4449,7 +6945,7 @@ func (m *QueryPoolTypeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLBPStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4472,10 +6968,10 @@ func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLBPStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLBPStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4497,40 +6993,6 @@ func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokensIn = append(m.TokensIn, types1.Coin{})
-			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4552,7 +7014,7 @@ func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *DenomWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4575,15 +7037,15 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomWeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4611,15 +7073,13 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4629,23 +7089,23 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensOut = append(m.TokensOut, types1.Coin{})
-			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4670,7 +7130,7 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLBPStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4693,17 +7153,17 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLBPStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLBPStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWeights", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4713,16 +7173,31 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentWeights = append(m.CurrentWeights, DenomWeight{})
+			if err := m.CurrentWeights[len(m.CurrentWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeights", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4732,81 +7207,117 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TargetWeights = append(m.TargetWeights, DenomWeight{})
+			if err := m.TargetWeights[len(m.TargetWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TimeRemaining, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapsPaused = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolController", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4816,25 +7327,23 @@ func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensOut = append(m.TokensOut, types1.Coin{})
-			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PoolController = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4857,7 +7366,7 @@ func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScalingFactorRampRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4880,10 +7389,10 @@ func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScalingFactorRampRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScalingFactorRampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4926,7 +7435,7 @@ func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScalingFactorRampResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4949,15 +7458,167 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScalingFactorRampResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScalingFactorRampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CurrentScalingFactors = append(m.CurrentScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CurrentScalingFactors) == 0 {
+					m.CurrentScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CurrentScalingFactors = append(m.CurrentScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentScalingFactors", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetScalingFactors) == 0 {
+					m.TargetScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetScalingFactors", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4984,68 +7645,15 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &types.Any{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeRemaining", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5055,11 +7663,25 @@ func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TimeRemaining, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5081,7 +7703,7 @@ func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcMigrationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5104,15 +7726,15 @@ func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcMigrationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcMigrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesToMigrate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5139,8 +7761,7 @@ func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, types1.Coin{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SharesToMigrate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5165,7 +7786,7 @@ func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcMigrationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5188,17 +7809,17 @@ func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcMigrationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdEntering", wireType)
 			}
-			m.PoolId = 0
+			m.PoolIdEntering = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5208,64 +7829,116 @@ func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.PoolIdEntering |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCreated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LiquidityCreated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSharesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedDust", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5292,7 +7965,8 @@ func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RefundedDust = append(m.RefundedDust, types1.Coin{})
+			if err := m.RefundedDust[len(m.RefundedDust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6164,7 +8838,7 @@ func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, types2.SwapAmountInRoute{})
+			m.Routes = append(m.Routes, types3.SwapAmountInRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -6383,7 +9057,7 @@ func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, types2.SwapAmountOutRoute{})
+			m.Routes = append(m.Routes, types3.SwapAmountOutRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
 )
@@ -93,3 +94,24 @@ type MultihopRoute interface {
 	PoolIds() []uint64
 	IntermediateDenoms() []string
 }
+
+// ValidateTokenInRoutes validates that every token in routes are valid routes for a token in tokensIn,
+// and that no token has more than one set of routes.
+func ValidateTokenInRoutes(tokenInRoutes []TokenInRoutes, tokensIn sdk.Coins) error {
+	seenDenoms := make(map[string]bool, len(tokenInRoutes))
+	for _, tokenInRoute := range tokenInRoutes {
+		if seenDenoms[tokenInRoute.TokenInDenom] {
+			return sdkerrors.Wrapf(ErrInvalidTokenInRoutes, "token %s has more than one set of routes", tokenInRoute.TokenInDenom)
+		}
+		seenDenoms[tokenInRoute.TokenInDenom] = true
+
+		if tokensIn.AmountOf(tokenInRoute.TokenInDenom).IsZero() {
+			return sdkerrors.Wrapf(ErrInvalidTokenInRoutes, "token %s has routes but is not a token in", tokenInRoute.TokenInDenom)
+		}
+
+		if err := SwapAmountInRoutes(tokenInRoute.Routes).Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
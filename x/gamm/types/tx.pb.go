@@ -841,6 +841,183 @@ func (m *MsgExitSwapExternAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExitSwapExternAmountOutResponse proto.InternalMessageInfo
 
+// ===================== MsgJoinPoolWithArbitraryTokens
+// TokenInRoutes are the routes used to swap a token that is not in the pool
+// into one of the pool's assets.
+type TokenInRoutes struct {
+	TokenInDenom string                     `protobuf:"bytes,1,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	Routes       []types1.SwapAmountInRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+}
+
+func (m *TokenInRoutes) Reset()         { *m = TokenInRoutes{} }
+func (m *TokenInRoutes) String() string { return proto.CompactTextString(m) }
+func (*TokenInRoutes) ProtoMessage()    {}
+func (*TokenInRoutes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{16}
+}
+func (m *TokenInRoutes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenInRoutes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenInRoutes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenInRoutes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenInRoutes.Merge(m, src)
+}
+func (m *TokenInRoutes) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenInRoutes) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenInRoutes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenInRoutes proto.InternalMessageInfo
+
+func (m *TokenInRoutes) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *TokenInRoutes) GetRoutes() []types1.SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+// MsgJoinPoolWithArbitraryTokens joins a pool with any basket of tokens.
+// Tokens that are not in the pool are first swapped into pool assets along
+// their token_in_routes. The pool assets are then joined at the pool ratio,
+// and the excess is joined by swapping it through the pool itself.
+type MsgJoinPoolWithArbitraryTokens struct {
+	Sender            string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId            uint64                                   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokensIn          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=tokens_in,json=tokensIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_in" yaml:"tokens_in"`
+	TokenInRoutes     []TokenInRoutes                          `protobuf:"bytes,4,rep,name=token_in_routes,json=tokenInRoutes,proto3" json:"token_in_routes" yaml:"token_in_routes"`
+	ShareOutMinAmount github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,5,opt,name=share_out_min_amount,json=shareOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_min_amount" yaml:"share_out_min_amount"`
+}
+
+func (m *MsgJoinPoolWithArbitraryTokens) Reset()         { *m = MsgJoinPoolWithArbitraryTokens{} }
+func (m *MsgJoinPoolWithArbitraryTokens) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinPoolWithArbitraryTokens) ProtoMessage()    {}
+func (*MsgJoinPoolWithArbitraryTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{17}
+}
+func (m *MsgJoinPoolWithArbitraryTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinPoolWithArbitraryTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinPoolWithArbitraryTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgJoinPoolWithArbitraryTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinPoolWithArbitraryTokens.Merge(m, src)
+}
+func (m *MsgJoinPoolWithArbitraryTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinPoolWithArbitraryTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinPoolWithArbitraryTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgJoinPoolWithArbitraryTokens proto.InternalMessageInfo
+
+func (m *MsgJoinPoolWithArbitraryTokens) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgJoinPoolWithArbitraryTokens) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgJoinPoolWithArbitraryTokens) GetTokensIn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensIn
+	}
+	return nil
+}
+
+func (m *MsgJoinPoolWithArbitraryTokens) GetTokenInRoutes() []TokenInRoutes {
+	if m != nil {
+		return m.TokenInRoutes
+	}
+	return nil
+}
+
+type MsgJoinPoolWithArbitraryTokensResponse struct {
+	ShareOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_amount" yaml:"share_out_amount"`
+	// tokens_joined are the pool assets that were joined into the pool, after
+	// swapping the tokens that are not in the pool.
+	TokensJoined github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tokens_joined,json=tokensJoined,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_joined" yaml:"tokens_joined"`
+}
+
+func (m *MsgJoinPoolWithArbitraryTokensResponse) Reset() {
+	*m = MsgJoinPoolWithArbitraryTokensResponse{}
+}
+func (m *MsgJoinPoolWithArbitraryTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinPoolWithArbitraryTokensResponse) ProtoMessage()    {}
+func (*MsgJoinPoolWithArbitraryTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{18}
+}
+func (m *MsgJoinPoolWithArbitraryTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinPoolWithArbitraryTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinPoolWithArbitraryTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgJoinPoolWithArbitraryTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinPoolWithArbitraryTokensResponse.Merge(m, src)
+}
+func (m *MsgJoinPoolWithArbitraryTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinPoolWithArbitraryTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinPoolWithArbitraryTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgJoinPoolWithArbitraryTokensResponse proto.InternalMessageInfo
+
+func (m *MsgJoinPoolWithArbitraryTokensResponse) GetTokensJoined() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensJoined
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgJoinPool)(nil), "osmosis.gamm.v1beta1.MsgJoinPool")
 	proto.RegisterType((*MsgJoinPoolResponse)(nil), "osmosis.gamm.v1beta1.MsgJoinPoolResponse")
@@ -858,82 +1035,95 @@ func init() {
 	proto.RegisterType((*MsgExitSwapShareAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapShareAmountInResponse")
 	proto.RegisterType((*MsgExitSwapExternAmountOut)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOut")
 	proto.RegisterType((*MsgExitSwapExternAmountOutResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOutResponse")
+	proto.RegisterType((*TokenInRoutes)(nil), "osmosis.gamm.v1beta1.TokenInRoutes")
+	proto.RegisterType((*MsgJoinPoolWithArbitraryTokens)(nil), "osmosis.gamm.v1beta1.MsgJoinPoolWithArbitraryTokens")
+	proto.RegisterType((*MsgJoinPoolWithArbitraryTokensResponse)(nil), "osmosis.gamm.v1beta1.MsgJoinPoolWithArbitraryTokensResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/tx.proto", fileDescriptor_cfc8fd3ac7df3247) }
 
 var fileDescriptor_cfc8fd3ac7df3247 = []byte{
-	// 1109 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5f, 0x6f, 0xdb, 0x54,
-	0x14, 0xaf, 0x93, 0xac, 0xed, 0x4e, 0xe9, 0x3f, 0xaf, 0x5d, 0x5d, 0x6f, 0x4b, 0xba, 0x0b, 0x42,
-	0x1d, 0x63, 0xf6, 0xda, 0x21, 0x40, 0xbc, 0x20, 0x02, 0x93, 0xc8, 0xb4, 0x28, 0x93, 0xf7, 0x32,
-	0xf1, 0x12, 0x39, 0x8d, 0xe5, 0x59, 0xab, 0xef, 0x8d, 0x72, 0xaf, 0xbb, 0x4c, 0x48, 0x20, 0x21,
-	0xf1, 0x0e, 0x42, 0xfc, 0xf9, 0x04, 0x88, 0xaf, 0xc0, 0x03, 0x3c, 0xc0, 0xcb, 0x1e, 0xf7, 0x08,
-	0x3c, 0x44, 0x53, 0xfb, 0x0d, 0xf2, 0x09, 0x90, 0xed, 0x6b, 0xc7, 0x76, 0xec, 0x26, 0xee, 0x92,
-	0xf5, 0xa9, 0x8d, 0xef, 0xb9, 0xe7, 0xef, 0xef, 0xfc, 0xce, 0xb1, 0xe1, 0x1a, 0xa1, 0x36, 0xa1,
-	0x16, 0x55, 0x4d, 0xdd, 0xb6, 0xd5, 0xa3, 0xbd, 0x96, 0xc1, 0xf4, 0x3d, 0x95, 0xf5, 0x94, 0x4e,
-	0x97, 0x30, 0x22, 0x6e, 0xf0, 0x63, 0xc5, 0x3d, 0x56, 0xf8, 0xb1, 0xbc, 0x61, 0x12, 0x93, 0x78,
-	0x02, 0xaa, 0xfb, 0x9f, 0x2f, 0x2b, 0x97, 0x0f, 0x3c, 0x61, 0xb5, 0xa5, 0x53, 0x23, 0xd4, 0x74,
-	0x40, 0x2c, 0xcc, 0xcf, 0xdf, 0x0d, 0x4c, 0x75, 0x08, 0x39, 0xb4, 0x75, 0xac, 0x9b, 0x46, 0x37,
-	0x94, 0xa3, 0x4f, 0xf5, 0x4e, 0xb3, 0x4b, 0x1c, 0x66, 0xf8, 0xd2, 0xe8, 0x8f, 0x02, 0x2c, 0xd5,
-	0xa9, 0x79, 0x8f, 0x58, 0xf8, 0x01, 0x21, 0x87, 0xe2, 0x0d, 0x98, 0xa7, 0x06, 0x6e, 0x1b, 0x5d,
-	0x49, 0xd8, 0x11, 0x76, 0x2f, 0x56, 0xd7, 0x07, 0xfd, 0xca, 0xf2, 0x33, 0xdd, 0x3e, 0xfc, 0x08,
-	0xf9, 0xcf, 0x91, 0xc6, 0x05, 0xc4, 0x9b, 0xb0, 0xe0, 0x9a, 0x68, 0x5a, 0x6d, 0xa9, 0xb0, 0x23,
-	0xec, 0x96, 0xaa, 0xe2, 0xa0, 0x5f, 0x59, 0xf1, 0x65, 0xf9, 0x01, 0xd2, 0xe6, 0xdd, 0xff, 0x6a,
-	0x6d, 0xb1, 0x0b, 0x6b, 0xf4, 0xb1, 0xde, 0x35, 0x9a, 0xc4, 0x61, 0x4d, 0xdd, 0x26, 0x0e, 0x66,
-	0x52, 0xd1, 0xb3, 0xf0, 0xf9, 0xf3, 0x7e, 0x65, 0xee, 0xbf, 0x7e, 0xe5, 0x6d, 0xd3, 0x62, 0x8f,
-	0x9d, 0x96, 0x72, 0x40, 0x6c, 0x95, 0x87, 0xe8, 0xff, 0xb9, 0x45, 0xdb, 0x4f, 0x54, 0xf6, 0xac,
-	0x63, 0x50, 0xa5, 0x86, 0xd9, 0xa0, 0x5f, 0xb9, 0x1c, 0xb1, 0xe1, 0xab, 0x72, 0xb5, 0x22, 0x6d,
-	0xc5, 0xb3, 0xd0, 0x70, 0xd8, 0x27, 0xde, 0x43, 0xb1, 0x05, 0xcb, 0x8c, 0x3c, 0x31, 0x70, 0xd3,
-	0xc2, 0x4d, 0x5b, 0xef, 0x51, 0xa9, 0xb4, 0x53, 0xdc, 0x5d, 0xda, 0xdf, 0x56, 0x7c, 0xbd, 0x8a,
-	0x9b, 0xc1, 0x20, 0xd9, 0xca, 0xa7, 0xc4, 0xc2, 0xd5, 0x37, 0x5d, 0x5f, 0x06, 0xfd, 0xca, 0x15,
-	0xdf, 0x42, 0xf4, 0x36, 0xb7, 0x44, 0x91, 0xb6, 0xe4, 0x3d, 0xae, 0xe1, 0xba, 0xde, 0xa3, 0xe8,
-	0x5f, 0x01, 0x2e, 0x45, 0xf2, 0xa7, 0x19, 0xb4, 0x43, 0x30, 0x35, 0x44, 0x9a, 0x12, 0xaf, 0x9f,
-	0xd1, 0x5a, 0xee, 0x78, 0xb7, 0x78, 0xfe, 0x13, 0xfa, 0x46, 0x03, 0xae, 0xc3, 0x62, 0xe0, 0xb2,
-	0x54, 0x18, 0x17, 0xeb, 0x16, 0x8f, 0x75, 0x35, 0x1e, 0x2b, 0xd2, 0x16, 0x78, 0x7c, 0xe8, 0x4f,
-	0x1f, 0x1b, 0x77, 0x7b, 0x16, 0x9b, 0x29, 0x36, 0x3a, 0xb0, 0xea, 0xc7, 0x66, 0xe1, 0x29, 0x41,
-	0x23, 0xa1, 0x0e, 0x69, 0xcb, 0xde, 0x93, 0x1a, 0xe6, 0x89, 0x32, 0x60, 0xc5, 0x8f, 0xd7, 0xcd,
-	0xa6, 0x6d, 0xe1, 0x09, 0xa0, 0xf1, 0x16, 0x4f, 0xd7, 0xd5, 0x68, 0xba, 0xf8, 0xf5, 0x21, 0x36,
-	0xde, 0xf0, 0x9e, 0x37, 0x1c, 0x56, 0xb7, 0x30, 0x45, 0xa6, 0x87, 0x8d, 0x20, 0x7f, 0x21, 0x36,
-	0x1e, 0xc0, 0xc5, 0xf0, 0xba, 0x24, 0x8c, 0x33, 0x2c, 0x71, 0xc3, 0x6b, 0x09, 0xc3, 0x48, 0x5b,
-	0x0c, 0x8c, 0xa1, 0x7e, 0x01, 0x36, 0xea, 0xd4, 0x7c, 0xf8, 0x54, 0xef, 0xdc, 0xed, 0xe9, 0x07,
-	0x1c, 0x0f, 0x35, 0x9c, 0xa7, 0x64, 0xf7, 0x61, 0xde, 0x23, 0x06, 0xca, 0xa1, 0xa3, 0x28, 0x01,
-	0x29, 0x45, 0x88, 0x24, 0x74, 0xcd, 0x35, 0x15, 0x58, 0xd1, 0xdc, 0x6b, 0xd5, 0x92, 0xeb, 0xa7,
-	0xc6, 0x75, 0xc4, 0xa0, 0xe8, 0x16, 0xf3, 0xd5, 0xa0, 0x28, 0x7e, 0x05, 0x1b, 0x69, 0x19, 0x97,
-	0x4a, 0x5e, 0x54, 0xf5, 0xdc, 0x38, 0xb9, 0x92, 0x5d, 0x45, 0xa4, 0xad, 0x47, 0x8a, 0xe8, 0xc7,
-	0x88, 0x7e, 0x10, 0xe0, 0x6a, 0x5a, 0x82, 0xa3, 0xfd, 0x3e, 0x54, 0x36, 0x9d, 0x7e, 0x4f, 0xea,
-	0x43, 0xda, 0x4a, 0xe0, 0x18, 0xf7, 0xea, 0x65, 0x01, 0x36, 0x47, 0xbd, 0x6a, 0x38, 0x2c, 0x4f,
-	0xdd, 0xeb, 0x89, 0xba, 0xab, 0x13, 0xd6, 0xbd, 0xe1, 0xb0, 0xb4, 0xc2, 0x7f, 0x09, 0x97, 0x52,
-	0x68, 0x93, 0x37, 0xf4, 0xfd, 0xdc, 0xb9, 0x90, 0x33, 0x99, 0x18, 0x69, 0x6b, 0x43, 0x22, 0xe6,
-	0x7d, 0x1d, 0xeb, 0xac, 0xd2, 0x38, 0xd8, 0x4d, 0xd2, 0x59, 0xdf, 0x0b, 0x70, 0x2d, 0x35, 0xc5,
-	0x61, 0xe5, 0x3b, 0xb0, 0x1a, 0x7a, 0x17, 0x2b, 0xfc, 0x99, 0xd9, 0x2b, 0xa1, 0x0e, 0x69, 0xcb,
-	0x3c, 0x50, 0x5e, 0xf6, 0xbf, 0x0a, 0xb0, 0xcd, 0x67, 0x8e, 0xef, 0x17, 0x33, 0xba, 0xf8, 0x2c,
-	0x2d, 0x9f, 0x8b, 0xa5, 0xa7, 0xdf, 0xd1, 0xc3, 0x81, 0x36, 0xbd, 0x8e, 0x4e, 0xd3, 0x89, 0xb4,
-	0xf5, 0x60, 0x50, 0x0e, 0x3b, 0xfa, 0x17, 0x01, 0xae, 0x67, 0x26, 0xf1, 0x5c, 0xc7, 0x38, 0xfa,
-	0xb5, 0x18, 0xab, 0xef, 0x43, 0xf7, 0xf4, 0x4c, 0xad, 0x9d, 0xab, 0xbe, 0x1f, 0x07, 0x33, 0xd1,
-	0xc2, 0xcd, 0xb6, 0x81, 0x89, 0xcd, 0x7b, 0x76, 0x7b, 0xd0, 0xaf, 0x6c, 0x26, 0x80, 0xe9, 0x9d,
-	0x07, 0xd3, 0xae, 0x86, 0x3f, 0x73, 0x7f, 0xa6, 0xe6, 0xaa, 0x34, 0xeb, 0x95, 0x27, 0x83, 0x6e,
-	0x2e, 0xbc, 0x0e, 0xba, 0x41, 0x3f, 0xc6, 0x31, 0x14, 0x2f, 0xd4, 0x39, 0x12, 0xc4, 0x6f, 0x45,
-	0x90, 0xf8, 0xe2, 0x91, 0xf0, 0x6b, 0x86, 0xfc, 0x50, 0x0d, 0xc2, 0x74, 0xcb, 0x15, 0x05, 0x90,
-	0x9c, 0x74, 0x3c, 0x14, 0x08, 0x1c, 0x6f, 0x38, 0xcc, 0x87, 0x50, 0xca, 0x26, 0x58, 0x9a, 0xed,
-	0x26, 0x98, 0xb5, 0x58, 0x5c, 0x78, 0x4d, 0x8b, 0xc5, 0xcf, 0x02, 0xec, 0x64, 0x95, 0xea, 0x7c,
-	0x97, 0x8b, 0xbf, 0x0b, 0x20, 0x47, 0x3c, 0x8b, 0x12, 0xe4, 0x2c, 0x69, 0x28, 0x36, 0xc2, 0x8b,
-	0x53, 0x18, 0xe1, 0x2e, 0x45, 0x84, 0x28, 0x88, 0x50, 0x44, 0xe9, 0xd5, 0x28, 0x22, 0x45, 0x25,
-	0xd2, 0xd6, 0x38, 0xb8, 0x86, 0x14, 0xf1, 0x93, 0x00, 0x28, 0x3b, 0x8b, 0x51, 0x8e, 0x48, 0x02,
-	0x5f, 0x98, 0x29, 0xf0, 0xf7, 0x7f, 0x5f, 0x80, 0x62, 0x9d, 0x9a, 0xe2, 0x23, 0x58, 0x0c, 0x5f,
-	0xfe, 0xaf, 0x2b, 0x69, 0xdf, 0x21, 0x94, 0xc8, 0xfb, 0xad, 0x7c, 0x63, 0xac, 0x48, 0x18, 0xd3,
-	0x23, 0x58, 0x0c, 0x5f, 0x1d, 0xb3, 0x35, 0x07, 0x22, 0xa7, 0x68, 0x1e, 0x79, 0x81, 0xa2, 0xb0,
-	0x3e, 0xfa, 0xaa, 0xf3, 0x4e, 0xe6, 0xfd, 0x11, 0x59, 0x79, 0x7f, 0x72, 0xd9, 0xd0, 0xe8, 0x11,
-	0x88, 0x29, 0x8b, 0xf6, 0xcd, 0x49, 0x35, 0x35, 0x1c, 0x26, 0xdf, 0xc9, 0x21, 0x1c, 0xda, 0xfd,
-	0x46, 0x80, 0xcb, 0x19, 0xab, 0x9e, 0x7a, 0x6a, 0x31, 0x46, 0x2f, 0xc8, 0x1f, 0xe4, 0xbc, 0x90,
-	0xea, 0x44, 0x62, 0x1f, 0x19, 0xef, 0x44, 0xfc, 0xc2, 0x04, 0x4e, 0x64, 0x0c, 0xd2, 0x6f, 0x05,
-	0xd8, 0xca, 0xa2, 0xa3, 0xdb, 0xa7, 0xa2, 0x27, 0xe5, 0x86, 0xfc, 0x61, 0xde, 0x1b, 0xa1, 0x1f,
-	0x5f, 0xc3, 0x66, 0xfa, 0x68, 0x55, 0xc6, 0xaa, 0x8c, 0xc9, 0xcb, 0xef, 0xe7, 0x93, 0x0f, 0x1c,
-	0xa8, 0xde, 0x7b, 0x7e, 0x5c, 0x16, 0x5e, 0x1c, 0x97, 0x85, 0x97, 0xc7, 0x65, 0xe1, 0xbb, 0x93,
-	0xf2, 0xdc, 0x8b, 0x93, 0xf2, 0xdc, 0x3f, 0x27, 0xe5, 0xb9, 0x2f, 0x6e, 0x47, 0x68, 0x82, 0xeb,
-	0xbe, 0x75, 0xa8, 0xb7, 0x68, 0xf0, 0x43, 0x3d, 0xda, 0x7b, 0x4f, 0xed, 0xf9, 0x5f, 0x21, 0x3d,
-	0xd2, 0x68, 0xcd, 0x7b, 0xdf, 0x01, 0xef, 0xfc, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x80, 0x57, 0xbc,
-	0x30, 0xa2, 0x14, 0x00, 0x00,
+	// 1277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4b, 0x6f, 0x1b, 0x45,
+	0x1c, 0xcf, 0xd8, 0x4e, 0x9a, 0x4e, 0xc8, 0x6b, 0x93, 0x34, 0xce, 0xa6, 0xb5, 0xd3, 0x29, 0xaa,
+	0x12, 0x4a, 0x77, 0x9b, 0xb4, 0xa2, 0x08, 0x21, 0xa1, 0x9a, 0x56, 0xaa, 0xa3, 0x5a, 0xae, 0xb6,
+	0x48, 0x54, 0x5c, 0xac, 0x75, 0xbc, 0x72, 0x96, 0xc4, 0x33, 0x96, 0x67, 0x9c, 0x3a, 0x02, 0x81,
+	0x84, 0x84, 0xc4, 0x91, 0x0a, 0xf1, 0x38, 0x71, 0x44, 0x15, 0xdf, 0x01, 0x0e, 0x70, 0xe9, 0x05,
+	0xa9, 0x47, 0xe0, 0x60, 0xaa, 0xe4, 0x1b, 0xe4, 0x13, 0xa0, 0xdd, 0x99, 0x5d, 0xef, 0xae, 0x77,
+	0x63, 0x6f, 0x62, 0x27, 0xa7, 0x24, 0x3b, 0xff, 0xf7, 0xff, 0xf7, 0x7f, 0xcc, 0x04, 0x5e, 0x21,
+	0xb4, 0x46, 0xa8, 0x49, 0xd5, 0xaa, 0x5e, 0xab, 0xa9, 0x7b, 0xeb, 0x65, 0x83, 0xe9, 0xeb, 0x2a,
+	0x6b, 0x29, 0xf5, 0x06, 0x61, 0x44, 0x9a, 0x17, 0xc7, 0x8a, 0x75, 0xac, 0x88, 0x63, 0x79, 0xbe,
+	0x4a, 0xaa, 0xc4, 0x26, 0x50, 0xad, 0xdf, 0x38, 0xad, 0x9c, 0xd9, 0xb2, 0x89, 0xd5, 0xb2, 0x4e,
+	0x0d, 0x57, 0xd2, 0x16, 0x31, 0xb1, 0x38, 0x7f, 0xdb, 0x51, 0x55, 0x27, 0x64, 0xb7, 0xa6, 0x63,
+	0xbd, 0x6a, 0x34, 0x5c, 0x3a, 0xfa, 0x4c, 0xaf, 0x97, 0x1a, 0xa4, 0xc9, 0x0c, 0x4e, 0x8d, 0x7e,
+	0x4b, 0xc0, 0x89, 0x02, 0xad, 0x6e, 0x12, 0x13, 0x3f, 0x26, 0x64, 0x57, 0x5a, 0x83, 0x63, 0xd4,
+	0xc0, 0x15, 0xa3, 0x91, 0x06, 0x2b, 0x60, 0xf5, 0x62, 0x6e, 0xf6, 0xa8, 0x9d, 0x9d, 0xdc, 0xd7,
+	0x6b, 0xbb, 0xef, 0x21, 0xfe, 0x1d, 0x69, 0x82, 0x40, 0xba, 0x01, 0x2f, 0x58, 0x2a, 0x4a, 0x66,
+	0x25, 0x9d, 0x58, 0x01, 0xab, 0xa9, 0x9c, 0x74, 0xd4, 0xce, 0x4e, 0x71, 0x5a, 0x71, 0x80, 0xb4,
+	0x31, 0xeb, 0xb7, 0x7c, 0x45, 0x6a, 0xc0, 0x19, 0xba, 0xad, 0x37, 0x8c, 0x12, 0x69, 0xb2, 0x92,
+	0x5e, 0x23, 0x4d, 0xcc, 0xd2, 0x49, 0x5b, 0xc3, 0xc3, 0x97, 0xed, 0xec, 0xc8, 0xbf, 0xed, 0xec,
+	0xf5, 0xaa, 0xc9, 0xb6, 0x9b, 0x65, 0x65, 0x8b, 0xd4, 0x54, 0xe1, 0x22, 0xff, 0x71, 0x93, 0x56,
+	0x76, 0x54, 0xb6, 0x5f, 0x37, 0xa8, 0x92, 0xc7, 0xec, 0xa8, 0x9d, 0xbd, 0xe4, 0xd1, 0xc1, 0x45,
+	0x59, 0x52, 0x91, 0x36, 0x65, 0x6b, 0x28, 0x36, 0xd9, 0x3d, 0xfb, 0xa3, 0x54, 0x86, 0x93, 0x8c,
+	0xec, 0x18, 0xb8, 0x64, 0xe2, 0x52, 0x4d, 0x6f, 0xd1, 0x74, 0x6a, 0x25, 0xb9, 0x3a, 0xb1, 0xb1,
+	0xa4, 0x70, 0xb9, 0x8a, 0x15, 0x41, 0x27, 0xd8, 0xca, 0x87, 0xc4, 0xc4, 0xb9, 0x6b, 0x96, 0x2d,
+	0x47, 0xed, 0xec, 0x32, 0xd7, 0xe0, 0xe5, 0x16, 0x9a, 0x28, 0xd2, 0x26, 0xec, 0xcf, 0x79, 0x5c,
+	0xd0, 0x5b, 0x14, 0xfd, 0x03, 0xe0, 0x9c, 0x27, 0x7e, 0x9a, 0x41, 0xeb, 0x04, 0x53, 0x43, 0xa2,
+	0x21, 0xfe, 0xf2, 0x88, 0xe6, 0x63, 0xfb, 0xbb, 0x28, 0xe2, 0x1f, 0x90, 0xd7, 0xed, 0x70, 0x01,
+	0x8e, 0x3b, 0x26, 0xa7, 0x13, 0xbd, 0x7c, 0x5d, 0x14, 0xbe, 0x4e, 0xfb, 0x7d, 0x45, 0xda, 0x05,
+	0xe1, 0x1f, 0xfa, 0x9d, 0x63, 0xe3, 0x41, 0xcb, 0x64, 0x43, 0xc5, 0x46, 0x1d, 0x4e, 0x73, 0xdf,
+	0x4c, 0x3c, 0x20, 0x68, 0x04, 0xc4, 0x21, 0x6d, 0xd2, 0xfe, 0x92, 0xc7, 0x22, 0x50, 0x06, 0x9c,
+	0xe2, 0xfe, 0x5a, 0xd1, 0xac, 0x99, 0xb8, 0x0f, 0x68, 0xbc, 0x29, 0xc2, 0x75, 0xd9, 0x1b, 0x2e,
+	0xc1, 0xde, 0xc1, 0xc6, 0x1b, 0xf6, 0xf7, 0x62, 0x93, 0x15, 0x4c, 0x4c, 0x51, 0x15, 0xce, 0x79,
+	0xe2, 0xe7, 0x62, 0xe3, 0x31, 0xbc, 0xe8, 0xb2, 0xa7, 0x41, 0x2f, 0xc5, 0x69, 0xa1, 0x78, 0x26,
+	0xa0, 0x18, 0x69, 0xe3, 0x8e, 0x32, 0xd4, 0x4e, 0xc0, 0xf9, 0x02, 0xad, 0x3e, 0x79, 0xa6, 0xd7,
+	0x1f, 0xb4, 0xf4, 0x2d, 0x81, 0x87, 0x3c, 0x8e, 0x93, 0xb2, 0x47, 0x70, 0xcc, 0x6e, 0x0c, 0x54,
+	0x40, 0x47, 0x51, 0x9c, 0xa6, 0xe4, 0x69, 0x24, 0xae, 0x69, 0x96, 0x2a, 0x47, 0x8b, 0x66, 0xb1,
+	0xe5, 0x52, 0x96, 0x9d, 0x9a, 0x90, 0xe1, 0x83, 0xa2, 0x95, 0xcc, 0xd3, 0x41, 0x51, 0xfa, 0x02,
+	0xce, 0x87, 0x45, 0x3c, 0x9d, 0xb2, 0xbd, 0x2a, 0xc4, 0xc6, 0xc9, 0x72, 0x74, 0x16, 0x91, 0x36,
+	0xeb, 0x49, 0x22, 0xf7, 0x11, 0x7d, 0x07, 0xe0, 0xe5, 0xb0, 0x00, 0x7b, 0xeb, 0xbd, 0x23, 0x6c,
+	0x30, 0xf5, 0x1e, 0x94, 0x87, 0xb4, 0x29, 0xc7, 0x30, 0x61, 0xd5, 0xeb, 0x04, 0x5c, 0xe8, 0xb6,
+	0xaa, 0xd8, 0x64, 0x71, 0xf2, 0x5e, 0x08, 0xe4, 0x5d, 0xed, 0x33, 0xef, 0xc5, 0x26, 0x0b, 0x4b,
+	0xfc, 0x67, 0x70, 0x2e, 0xa4, 0x6d, 0x8a, 0x82, 0x7e, 0x14, 0x3b, 0x16, 0x72, 0x64, 0x27, 0x46,
+	0xda, 0x4c, 0xa7, 0x11, 0x8b, 0xba, 0xf6, 0x55, 0x56, 0x6a, 0x05, 0x9c, 0xbe, 0xb2, 0x9e, 0x03,
+	0x78, 0x25, 0x34, 0xc4, 0x6e, 0xe6, 0xeb, 0x70, 0xda, 0xb5, 0xce, 0x97, 0xf8, 0x13, 0x77, 0xaf,
+	0x80, 0x38, 0xa4, 0x4d, 0x0a, 0x47, 0x45, 0xda, 0xff, 0x48, 0xc0, 0x25, 0x31, 0x73, 0xb8, 0x5d,
+	0xcc, 0x68, 0xe0, 0x93, 0x94, 0x7c, 0xac, 0x2e, 0x3d, 0xf8, 0x8a, 0xee, 0x0c, 0xb4, 0xc1, 0x55,
+	0x74, 0x98, 0x4c, 0xa4, 0xcd, 0x3a, 0x83, 0xb2, 0x53, 0xd1, 0x3f, 0x01, 0x78, 0x35, 0x32, 0x88,
+	0xe7, 0x3a, 0xc6, 0xd1, 0x2f, 0x49, 0x5f, 0x7e, 0x9f, 0x58, 0xa7, 0x27, 0x2a, 0xed, 0x58, 0xf9,
+	0xfd, 0xc0, 0x99, 0x89, 0x26, 0x2e, 0x55, 0x0c, 0x4c, 0x6a, 0xa2, 0x66, 0x97, 0x8e, 0xda, 0xd9,
+	0x85, 0x00, 0x30, 0xed, 0x73, 0x67, 0xda, 0xe5, 0xf1, 0x7d, 0xeb, 0xcf, 0xd0, 0x58, 0xa5, 0x86,
+	0xbd, 0xf2, 0x44, 0xb4, 0x9b, 0xd1, 0xb3, 0x68, 0x37, 0xe8, 0x7b, 0x3f, 0x86, 0xfc, 0x89, 0x3a,
+	0xc7, 0x06, 0xf1, 0x22, 0x09, 0xd3, 0x62, 0xf1, 0x08, 0xd8, 0x35, 0xc4, 0xfe, 0x90, 0x73, 0xdc,
+	0xb4, 0xd2, 0xe5, 0x05, 0x90, 0x1c, 0x34, 0xdc, 0x25, 0x70, 0x0c, 0x2f, 0x36, 0x19, 0x87, 0x50,
+	0xc8, 0x26, 0x98, 0x1a, 0xee, 0x26, 0x18, 0xb5, 0x58, 0x8c, 0x9e, 0xd1, 0x62, 0xf1, 0x23, 0x80,
+	0x2b, 0x51, 0xa9, 0x3a, 0xdf, 0xe5, 0xe2, 0xcf, 0x04, 0x94, 0x3d, 0x96, 0x79, 0x1b, 0xe4, 0x30,
+	0xdb, 0x90, 0x6f, 0x84, 0x27, 0x07, 0x30, 0xc2, 0xad, 0x16, 0xe1, 0xa2, 0xc0, 0xd3, 0x22, 0x52,
+	0xa7, 0x6b, 0x11, 0x21, 0x22, 0x91, 0x36, 0x23, 0xc0, 0xd5, 0x69, 0x11, 0x3f, 0x00, 0x88, 0xa2,
+	0xa3, 0xe8, 0xed, 0x11, 0x41, 0xe0, 0x83, 0xa1, 0x02, 0x1f, 0xfd, 0x0c, 0xe0, 0xe4, 0x47, 0xbc,
+	0x6b, 0x68, 0x7c, 0x73, 0xeb, 0x1e, 0x00, 0x20, 0xde, 0x00, 0x18, 0xe8, 0x0d, 0x02, 0x1d, 0x26,
+	0x61, 0xc6, 0x73, 0xb3, 0xfe, 0xd8, 0x64, 0xdb, 0xf7, 0x1a, 0x65, 0x93, 0x35, 0xf4, 0xc6, 0xbe,
+	0x6d, 0x38, 0x1d, 0x1a, 0x06, 0x3f, 0x17, 0x18, 0xa4, 0x7c, 0xd7, 0xe9, 0x71, 0x41, 0xbb, 0x1f,
+	0x82, 0x41, 0x8b, 0x13, 0xfd, 0xfa, 0x5f, 0x76, 0xb5, 0x8f, 0xb4, 0x59, 0x42, 0xa8, 0xc0, 0x2b,
+	0xcd, 0x63, 0x69, 0xc7, 0x33, 0x2f, 0x44, 0x3c, 0xf9, 0xed, 0xf4, 0x9a, 0x12, 0xf6, 0x4c, 0xa4,
+	0xf8, 0xb2, 0x98, 0xcb, 0x08, 0x6b, 0x82, 0xa3, 0x42, 0x44, 0xd5, 0x1d, 0x15, 0x22, 0xe9, 0x51,
+	0x6b, 0xd8, 0xe8, 0x19, 0xad, 0x61, 0x2f, 0x12, 0xf0, 0xfa, 0xf1, 0x59, 0x3e, 0xdf, 0x27, 0x95,
+	0x6f, 0x80, 0x78, 0x44, 0xa2, 0xa5, 0x4f, 0x89, 0x89, 0x8d, 0x4a, 0xef, 0x87, 0x95, 0x87, 0x22,
+	0x03, 0xf3, 0x3e, 0x3c, 0x70, 0xee, 0x78, 0x98, 0xe0, 0xe5, 0x45, 0x37, 0x6d, 0xd6, 0x8d, 0xbf,
+	0xc6, 0x61, 0xb2, 0x40, 0xab, 0xd2, 0x53, 0x38, 0xee, 0x3e, 0xd7, 0x5d, 0x0d, 0x87, 0x84, 0x27,
+	0xa2, 0xf2, 0x5a, 0x4f, 0x12, 0x37, 0xc2, 0x4f, 0xe1, 0xb8, 0xfb, 0xd8, 0x13, 0x2d, 0xd9, 0x21,
+	0x91, 0xd7, 0x7a, 0x92, 0x78, 0x72, 0x37, 0xdb, 0xfd, 0x38, 0xf1, 0x56, 0x24, 0x7f, 0x17, 0xad,
+	0xbc, 0xd1, 0x3f, 0xad, 0xab, 0x74, 0x0f, 0x4a, 0x21, 0x57, 0xe3, 0x1b, 0xfd, 0x4a, 0x2a, 0x36,
+	0x99, 0x7c, 0x3b, 0x06, 0xb1, 0xab, 0xf7, 0x2b, 0x00, 0x2f, 0x45, 0x5c, 0xce, 0xd4, 0x63, 0x93,
+	0xd1, 0xcd, 0x20, 0xdf, 0x8d, 0xc9, 0x10, 0x6a, 0x44, 0xe0, 0x06, 0xd1, 0xdb, 0x08, 0x3f, 0x83,
+	0x7c, 0x37, 0x26, 0x83, 0x6b, 0xc4, 0xd7, 0x00, 0x2e, 0x46, 0x2d, 0x10, 0xb7, 0x8e, 0x45, 0x4f,
+	0x08, 0x87, 0xfc, 0x6e, 0x5c, 0x0e, 0xd7, 0x8e, 0x2f, 0xe1, 0x42, 0xf8, 0x32, 0xac, 0xf4, 0x14,
+	0xe9, 0xa3, 0x97, 0xdf, 0x89, 0x47, 0xef, 0x1a, 0xf0, 0x1c, 0xc0, 0xe5, 0xe3, 0x26, 0xd9, 0x9d,
+	0x9e, 0x45, 0x1a, 0xc2, 0x25, 0xbf, 0x7f, 0x12, 0x2e, 0xc7, 0xa6, 0xdc, 0xe6, 0xcb, 0x83, 0x0c,
+	0x78, 0x75, 0x90, 0x01, 0xaf, 0x0f, 0x32, 0xe0, 0xdb, 0xc3, 0xcc, 0xc8, 0xab, 0xc3, 0xcc, 0xc8,
+	0xdf, 0x87, 0x99, 0x91, 0x4f, 0x6e, 0x79, 0x3a, 0x94, 0xd0, 0x70, 0x73, 0x57, 0x2f, 0x53, 0xe7,
+	0x0f, 0x75, 0x6f, 0xfd, 0x8e, 0xda, 0xe2, 0xff, 0xcb, 0xb0, 0xfb, 0x55, 0x79, 0xcc, 0xfe, 0x6f,
+	0xc2, 0xed, 0xff, 0x07, 0x00, 0xb0, 0x37, 0x5b, 0x5e, 0xe8, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -956,6 +1146,7 @@ type MsgClient interface {
 	JoinSwapShareAmountOut(ctx context.Context, in *MsgJoinSwapShareAmountOut, opts ...grpc.CallOption) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(ctx context.Context, in *MsgExitSwapExternAmountOut, opts ...grpc.CallOption) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapShareAmountIn, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInResponse, error)
+	JoinPoolWithArbitraryTokens(ctx context.Context, in *MsgJoinPoolWithArbitraryTokens, opts ...grpc.CallOption) (*MsgJoinPoolWithArbitraryTokensResponse, error)
 }
 
 type msgClient struct {
@@ -1038,6 +1229,15 @@ func (c *msgClient) ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapSh
 	return out, nil
 }
 
+func (c *msgClient) JoinPoolWithArbitraryTokens(ctx context.Context, in *MsgJoinPoolWithArbitraryTokens, opts ...grpc.CallOption) (*MsgJoinPoolWithArbitraryTokensResponse, error) {
+	out := new(MsgJoinPoolWithArbitraryTokensResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/JoinPoolWithArbitraryTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
@@ -1048,6 +1248,7 @@ type MsgServer interface {
 	JoinSwapShareAmountOut(context.Context, *MsgJoinSwapShareAmountOut) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(context.Context, *MsgExitSwapExternAmountOut) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(context.Context, *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error)
+	JoinPoolWithArbitraryTokens(context.Context, *MsgJoinPoolWithArbitraryTokens) (*MsgJoinPoolWithArbitraryTokensResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1078,6 +1279,9 @@ func (*UnimplementedMsgServer) ExitSwapExternAmountOut(ctx context.Context, req
 func (*UnimplementedMsgServer) ExitSwapShareAmountIn(ctx context.Context, req *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExitSwapShareAmountIn not implemented")
 }
+func (*UnimplementedMsgServer) JoinPoolWithArbitraryTokens(ctx context.Context, req *MsgJoinPoolWithArbitraryTokens) (*MsgJoinPoolWithArbitraryTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinPoolWithArbitraryTokens not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1227,6 +1431,24 @@ func _Msg_ExitSwapShareAmountIn_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_JoinPoolWithArbitraryTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinPoolWithArbitraryTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).JoinPoolWithArbitraryTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/JoinPoolWithArbitraryTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinPoolWithArbitraryTokens(ctx, req.(*MsgJoinPoolWithArbitraryTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1263,6 +1485,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExitSwapShareAmountIn",
 			Handler:    _Msg_ExitSwapShareAmountIn_Handler,
 		},
+		{
+			MethodName: "JoinPoolWithArbitraryTokens",
+			Handler:    _Msg_JoinPoolWithArbitraryTokens_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/tx.proto",
@@ -2030,112 +2256,276 @@ func (m *MsgExitSwapExternAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TokenInRoutes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgJoinPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
-	}
-	l = m.ShareOutAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.TokenInMaxs) > 0 {
-		for _, e := range m.TokenInMaxs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+
+func (m *TokenInRoutes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TokenInRoutes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ShareOutAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.TokenIn) > 0 {
-		for _, e := range m.TokenIn {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExitPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
-	}
-	l = m.ShareInAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.TokenOutMins) > 0 {
-		for _, e := range m.TokenOutMins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgJoinPoolWithArbitraryTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgExitPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TokenOut) > 0 {
-		for _, e := range m.TokenOut {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgJoinPoolWithArbitraryTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSwapExactAmountIn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgJoinPoolWithArbitraryTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	{
+		size := m.ShareOutMinAmount.Size()
+		i -= size
+		if _, err := m.ShareOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenInRoutes) > 0 {
+		for iNdEx := len(m.TokenInRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenInRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TokensIn) > 0 {
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinPoolWithArbitraryTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinPoolWithArbitraryTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinPoolWithArbitraryTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensJoined) > 0 {
+		for iNdEx := len(m.TokensJoined) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensJoined[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgJoinPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenInMaxs) > 0 {
+		for _, e := range m.TokenInMaxs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgJoinPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenIn) > 0 {
+		for _, e := range m.TokenIn {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExitPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenOutMins) > 0 {
+		for _, e := range m.TokenOutMins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExitPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenOut) > 0 {
+		for _, e := range m.TokenOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
 	l = m.TokenIn.Size()
 	n += 1 + l + sovTx(uint64(l))
@@ -2321,6 +2711,72 @@ func (m *MsgExitSwapExternAmountOutResponse) Size() (n int) {
 	return n
 }
 
+func (m *TokenInRoutes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgJoinPoolWithArbitraryTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TokenInRoutes) > 0 {
+		for _, e := range m.TokenInRoutes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ShareOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgJoinPoolWithArbitraryTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokensJoined) > 0 {
+		for _, e := range m.TokensJoined {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4475,6 +4931,443 @@ func (m *MsgExitSwapExternAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenInRoutes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenInRoutes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenInRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types1.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinPoolWithArbitraryTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinPoolWithArbitraryTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinPoolWithArbitraryTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensIn = append(m.TokensIn, types.Coin{})
+			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInRoutes = append(m.TokenInRoutes, TokenInRoutes{})
+			if err := m.TokenInRoutes[len(m.TokenInRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinPoolWithArbitraryTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinPoolWithArbitraryTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinPoolWithArbitraryTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensJoined", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensJoined = append(m.TokensJoined, types.Coin{})
+			if err := m.TokensJoined[len(m.TokensJoined)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
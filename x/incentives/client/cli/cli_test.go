@@ -94,3 +94,56 @@ func TestGetCmdUpcomingGaugesPerDenom(t *testing.T) {
 	}
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
+
+func TestGetCmdLockRewards(t *testing.T) {
+	desc, _ := GetCmdLockRewards()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.LockRewardsRequest]{
+		"basic test": {
+			Cmd: "1", ExpectedQuery: &types.LockRewardsRequest{LockId: 1},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestNewClaimRewardsCmd(t *testing.T) {
+	desc, _ := NewClaimRewardsCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgClaimRewards]{
+		"claim rewards of some locks": {
+			Cmd: "--lock-ids=1,5 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgClaimRewards{
+				Owner:   testAddresses[0].String(),
+				LockIds: []uint64{1, 5},
+			},
+		},
+		"claim rewards of all locks": {
+			Cmd: "--from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgClaimRewards{
+				Owner:   testAddresses[0].String(),
+				LockIds: []uint64{},
+			},
+		},
+		"invalid lock id": {
+			Cmd:         "--lock-ids=1,a --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewCancelGaugeCmd(t *testing.T) {
+	desc, _ := NewCancelGaugeCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgCancelGauge]{
+		"cancel gauge": {
+			Cmd: "3 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgCancelGauge{
+				Owner:   testAddresses[0].String(),
+				GaugeId: 3,
+			},
+		},
+		"invalid gauge id": {
+			Cmd:         "a --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
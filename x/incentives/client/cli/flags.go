@@ -13,6 +13,7 @@ const (
 	FlagEpochs    = "epochs"
 	FlagPerpetual = "perpetual"
 	FlagTimestamp = "timestamp"
+	FlagClaimable = "claimable"
 	FlagOwner     = "owner"
 	FlagLockIds   = "lock-ids"
 	FlagEndEpoch  = "end-epoch"
@@ -27,5 +28,15 @@ func FlagSetCreateGauge() *flag.FlagSet {
 	fs.String(FlagStartTime, "", "Timestamp to begin distribution")
 	fs.Uint64(FlagEpochs, 0, "Total epochs to distribute tokens")
 	fs.Bool(FlagPerpetual, false, "Perpetual distribution")
+	fs.String(FlagTimestamp, "", "Distribute to locks created before this timestamp instead of by duration")
+	fs.Bool(FlagClaimable, false, "Accrue rewards to locks, to be claimed by their owners, instead of sending them every epoch")
+	return fs
+}
+
+// FlagSetClaimRewards returns flags for claiming rewards.
+func FlagSetClaimRewards() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagLockIds, "", "Comma separated ids of the locks to claim rewards for, when it is empty, all locks of the sender are used")
 	return fs
 }
@@ -29,6 +29,7 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdActiveGaugesPerDenom)
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdUpcomingGauges)
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdUpcomingGaugesPerDenom)
+	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdLockRewards)
 	cmd.AddCommand(GetCmdRewardsEst())
 
 	return cmd
@@ -94,6 +95,16 @@ func GetCmdUpcomingGaugesPerDenom() (*osmocli.QueryDescriptor, *types.UpcomingGa
 		Long:  `{{.Short}}`}, &types.UpcomingGaugesPerDenomRequest{}
 }
 
+// GetCmdLockRewards returns the pending and claimed rewards of a lock from claimable gauges.
+func GetCmdLockRewards() (*osmocli.QueryDescriptor, *types.LockRewardsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "lock-rewards [lock_id]",
+		Short: "Query the pending and claimed rewards of a lock from claimable gauges",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} lock-rewards 1
+`}, &types.LockRewardsRequest{}
+}
+
 // GetCmdRewardsEst returns rewards estimation.
 func GetCmdRewardsEst() *cobra.Command {
 	cmd := &cobra.Command{
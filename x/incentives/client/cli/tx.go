@@ -3,9 +3,11 @@ package cli
 import (
 	"errors"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v14/x/incentives/types"
@@ -15,6 +17,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // GetTxCmd returns the transaction commands for this module.
@@ -24,6 +28,8 @@ func GetTxCmd() *cobra.Command {
 		NewCreateGaugeCmd(),
 		NewAddToGaugeCmd(),
 	)
+	osmocli.AddTxCmd(cmd, NewClaimRewardsCmd)
+	osmocli.AddTxCmd(cmd, NewCancelGaugeCmd)
 
 	return cmd
 }
@@ -48,19 +54,16 @@ func NewCreateGaugeCmd() *cobra.Command {
 				return err
 			}
 
-			var startTime time.Time
+			startTime := time.Unix(0, 0) // empty start time
 			timeStr, err := cmd.Flags().GetString(FlagStartTime)
 			if err != nil {
 				return err
 			}
-			if timeStr == "" { // empty start time
-				startTime = time.Unix(0, 0)
-			} else if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
-				startTime = time.Unix(timeUnix, 0)
-			} else if timeRFC, err := time.Parse(time.RFC3339, timeStr); err == nil { // RFC time
-				startTime = timeRFC
-			} else { // invalid input
-				return errors.New("invalid start time format")
+			if timeStr != "" {
+				startTime, err = parseTime(timeStr)
+				if err != nil {
+					return errors.New("invalid start time format")
+				}
 			}
 
 			epochs, err := cmd.Flags().GetUint64(FlagEpochs)
@@ -89,6 +92,23 @@ func NewCreateGaugeCmd() *cobra.Command {
 				Timestamp:     time.Unix(0, 0), // XXX check
 			}
 
+			// distribute to locks created before the timestamp, if one is given
+			timestampStr, err := cmd.Flags().GetString(FlagTimestamp)
+			if err != nil {
+				return err
+			}
+			if timestampStr != "" {
+				timestamp, err := parseTime(timestampStr)
+				if err != nil {
+					return errors.New("invalid timestamp format")
+				}
+				distributeTo = lockuptypes.QueryCondition{
+					LockQueryType: lockuptypes.ByTime,
+					Denom:         denom,
+					Timestamp:     timestamp,
+				}
+			}
+
 			msg := types.NewMsgCreateGauge(
 				epochs == 1,
 				clientCtx.GetFromAddress(),
@@ -98,6 +118,11 @@ func NewCreateGaugeCmd() *cobra.Command {
 				epochs,
 			)
 
+			msg.IsClaimable, err = cmd.Flags().GetBool(FlagClaimable)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
@@ -107,9 +132,189 @@ func NewCreateGaugeCmd() *cobra.Command {
 	return cmd
 }
 
+// parseTime parses either a unix time or an RFC3339 time.
+func parseTime(timeStr string) (time.Time, error) {
+	if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil {
+		return time.Unix(timeUnix, 0), nil
+	}
+	return time.Parse(time.RFC3339, timeStr)
+}
+
 func NewAddToGaugeCmd() *cobra.Command {
 	return osmocli.BuildTxCli[*types.MsgAddToGauge](&osmocli.TxCliDesc{
 		Use:   "add-to-gauge [gauge_id] [rewards] [flags]",
 		Short: "add coins to gauge to distribute more rewards to users",
 	})
 }
+
+func NewClaimRewardsCmd() (*osmocli.TxCliDesc, *types.MsgClaimRewards) {
+	return &osmocli.TxCliDesc{
+		Use:   "claim-rewards [flags]",
+		Short: "claim the rewards accrued to your locks from claimable gauges",
+		Long: `Claim the rewards accrued to your locks from claimable gauges.
+If no lock ids are given, rewards are claimed for all of your locks.`,
+		Example: "claim-rewards --lock-ids=1,2 --from=val --chain-id=osmosis-1",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"LockIds": osmocli.FlagOnlyParser(parseLockIds),
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetClaimRewards()}},
+	}, &types.MsgClaimRewards{}
+}
+
+func NewCancelGaugeCmd() (*osmocli.TxCliDesc, *types.MsgCancelGauge) {
+	return &osmocli.TxCliDesc{
+		Use:   "cancel-gauge [gauge_id] [flags]",
+		Short: "cancel a gauge you own and refund the coins it has not distributed yet",
+		Long: `Cancel a non-perpetual gauge you own. The gauge stops distributing and the coins
+it has not distributed yet are refunded to you.`,
+		Example: "cancel-gauge 1 --from=val --chain-id=osmosis-1",
+	}, &types.MsgCancelGauge{}
+}
+
+// NewCmdSubmitCancelGaugeProposal implements a command handler for submitting a proposal to cancel a gauge.
+func NewCmdSubmitCancelGaugeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-gauge [gauge_id] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel a gauge",
+		Long: "This proposal will cancel the given non-perpetual gauge if passed. " +
+			"The coins the gauge has not distributed yet are refunded to its owner, or to the community pool if it has no owner.",
+		Example: "osmosisd tx gov submit-proposal cancel-gauge 1 --title \"Title\" --description \"Description\"",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCancelGaugeProposal(title, description, gaugeId)
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
+
+// NewCmdSubmitEnableGaugeClaimsProposal implements a command handler for submitting a proposal to make the rewards of a gauge claimable.
+func NewCmdSubmitEnableGaugeClaimsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable-gauge-claims [gauge_id] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to make the rewards of a gauge claimable",
+		Long: "This proposal will migrate the given gauge to claimable rewards if passed. " +
+			"From the next distribution on, its rewards accrue to the eligible locks and are claimed by their owners. " +
+			"Rewards distributed before are unaffected.",
+		Example: "osmosisd tx gov submit-proposal enable-gauge-claims 1 --title \"Title\" --description \"Description\"",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewEnableGaugeClaimsProposal(title, description, gaugeId)
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
+
+// parseLockIds parses the comma separated lock ids of the lock ids flag.
+func parseLockIds(fs *flag.FlagSet) ([]uint64, error) {
+	lockIdsCombined, err := fs.GetString(FlagLockIds)
+	if err != nil {
+		return nil, err
+	}
+
+	lockIds := []uint64{}
+	if lockIdsCombined == "" {
+		return lockIds, nil
+	}
+	for _, lockIdStr := range strings.Split(lockIdsCombined, ",") {
+		lockId, err := strconv.ParseUint(lockIdStr, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		lockIds = append(lockIds, lockId)
+	}
+	return lockIds, nil
+}
@@ -0,0 +1,26 @@
+package incentives
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v14/x/incentives/keeper"
+	"github.com/osmosis-labs/osmosis/v14/x/incentives/types"
+)
+
+// NewIncentivesProposalHandler returns a governance handler for the incentives proposals.
+func NewIncentivesProposalHandler(k *keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.CancelGaugeProposal:
+			_, err := k.CancelGauge(ctx, c.GaugeId)
+			return err
+		case *types.EnableGaugeClaimsProposal:
+			return k.EnableGaugeClaims(ctx, c.GaugeId)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized incentives proposal content type: %T", c)
+		}
+	}
+}
@@ -53,7 +53,7 @@ func genQueryCondition(
 	coins sdk.Coins,
 	durationOptions []time.Duration,
 ) lockuptypes.QueryCondition {
-	// only use lockQueryType ByDuration (0) since ByTime (1) is deprecated
+	// only use lockQueryType ByDuration (0) since ByTime (1) gauges require a timestamp
 	lockQueryType := 0
 	denom := coins[r.Intn(len(coins))].Denom
 	durationOption := r.Intn(len(durationOptions))
@@ -0,0 +1,399 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v14/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	gaugeAccumPrefix = "gauge"
+	keySeparator     = "/"
+	uintBase         = 10
+)
+
+// getGaugeAccumulatorName returns the name of the reward accumulator of the given gauge.
+func getGaugeAccumulatorName(gaugeID uint64) string {
+	return strings.Join([]string{gaugeAccumPrefix, strconv.FormatUint(gaugeID, uintBase)}, keySeparator)
+}
+
+// formatLockPositionName returns the name of the given lock's position in a gauge accumulator.
+func formatLockPositionName(lockID uint64) string {
+	return strconv.FormatUint(lockID, uintBase)
+}
+
+// lockRewardsStoreKey returns the key of the rewards the given lock has claimed from the given gauge.
+func lockRewardsStoreKey(lockID, gaugeID uint64) []byte {
+	return combineKeys(types.KeyPrefixLockRewards, sdk.Uint64ToBigEndian(lockID), sdk.Uint64ToBigEndian(gaugeID))
+}
+
+// gaugeLockPositionStoreKey returns the reference key from the given gauge to a lock with a position in its accumulator.
+func gaugeLockPositionStoreKey(gaugeID, lockID uint64) []byte {
+	return combineKeys(types.KeyPrefixGaugeLockPositions, sdk.Uint64ToBigEndian(gaugeID), sdk.Uint64ToBigEndian(lockID))
+}
+
+// EnableGaugeClaims makes the rewards of the given gauge accrue to the eligible locks in an accumulator,
+// from where lock owners claim them, instead of sending them to the lock owners at every distribution.
+// It is used when creating claimable gauges and as the migration path for existing gauges, through an
+// EnableGaugeClaimsProposal or an upgrade handler. Rewards distributed by the gauge before the call are unaffected:
+// they were already sent, and later distributions only split the coins the gauge has not distributed yet.
+// Returns error if the gauge does not exist, is finished, is already claimable or distributes to a synthetic denom.
+func (k Keeper) EnableGaugeClaims(ctx sdk.Context, gaugeID uint64) error {
+	gauge, err := k.GetGaugeByID(ctx, gaugeID)
+	if err != nil {
+		return err
+	}
+	if gauge.IsClaimable {
+		return fmt.Errorf("gauge %d is already claimable", gaugeID)
+	}
+	if !gauge.IsPerpetual && gauge.FilledEpochs >= gauge.NumEpochsPaidOver {
+		return fmt.Errorf("gauge %d has finished its distribution", gaugeID)
+	}
+	if lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) {
+		return fmt.Errorf("gauge %d distributes to synthetic denom %s and cannot be claimable", gaugeID, gauge.DistributeTo.Denom)
+	}
+	if gauge.DistributeTo.LockQueryType == lockuptypes.NoLock {
+		return fmt.Errorf("gauge %d distributes to a concentrated liquidity pool and cannot be claimable", gaugeID)
+	}
+
+	if err := accum.MakeAccumulator(ctx.KVStore(k.storeKey), getGaugeAccumulatorName(gaugeID)); err != nil {
+		return err
+	}
+
+	gauge.IsClaimable = true
+	return k.setGauge(ctx, gauge)
+}
+
+// getGaugeAccumulator returns the reward accumulator of the given claimable gauge.
+func (k Keeper) getGaugeAccumulator(ctx sdk.Context, gaugeID uint64) (accum.AccumulatorObject, error) {
+	return accum.GetAccumulator(ctx.KVStore(k.storeKey), getGaugeAccumulatorName(gaugeID))
+}
+
+// distributeClaimableInternal runs the distribution logic for a claimable gauge.
+// Instead of sending the rewards, it updates the positions of the locks in the gauge accumulator
+// and adds the rewards per share to it. The rewards stay in the module account until they are claimed.
+// It also updates the gauge for the distribution.
+// Locks is expected to be the correct set of lock recipients for this gauge.
+func (k Keeper) distributeClaimableInternal(ctx sdk.Context, gauge types.Gauge, locks []lockuptypes.PeriodLock) (sdk.Coins, error) {
+	totalDistrCoins := sdk.NewCoins()
+	denom := gauge.DistributeTo.Denom
+	lockSum := lockuptypes.SumLocksByDenom(locks, denom)
+
+	if lockSum.IsZero() {
+		return nil, nil
+	}
+
+	if err := k.syncGaugeLockPositions(ctx, gauge.Id, denom, locks); err != nil {
+		return nil, err
+	}
+
+	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+	// if its a perpetual gauge, we set remaining epochs to 1.
+	// otherwise is is a non perpetual gauge and we determine how many epoch payouts are left
+	remainEpochs := uint64(1)
+	if !gauge.IsPerpetual {
+		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
+	}
+
+	// rewards per share = gauge_size / (total_denom_lock_amount * remain_epochs)
+	totalShares := lockSum.Mul(sdk.NewInt(int64(remainEpochs))).ToDec()
+	rewardsPerShare := sdk.NewDecCoins()
+	for _, coin := range remainCoins {
+		perShare := coin.Amount.ToDec().QuoTruncate(totalShares)
+		if !perShare.IsPositive() {
+			continue
+		}
+		rewardsPerShare = rewardsPerShare.Add(sdk.NewDecCoinFromDec(coin.Denom, perShare))
+		totalDistrCoins = totalDistrCoins.Add(sdk.NewCoin(coin.Denom, perShare.MulInt(lockSum).TruncateInt()))
+	}
+
+	// the accumulator is fetched after syncing positions, since syncing changes its total shares.
+	accumulator, err := k.getGaugeAccumulator(ctx, gauge.Id)
+	if err != nil {
+		return nil, err
+	}
+	accumulator.AddToAccumulator(rewardsPerShare)
+
+	err = k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins)
+	return totalDistrCoins, err
+}
+
+// syncGaugeLockPositions sets the shares of every lock position in the gauge accumulator to the lock's amount of the denom.
+// Locks that became eligible get a new position, while locks that are no longer eligible keep their position
+// and pending rewards, but with zero shares.
+func (k Keeper) syncGaugeLockPositions(ctx sdk.Context, gaugeID uint64, denom string, locks []lockuptypes.PeriodLock) error {
+	accumulator, err := k.getGaugeAccumulator(ctx, gaugeID)
+	if err != nil {
+		return err
+	}
+
+	eligibleLocks := make(map[uint64]bool, len(locks))
+	for _, lock := range locks {
+		shares := lock.Coins.AmountOfNoDenomValidation(denom).ToDec()
+		if !shares.IsPositive() {
+			continue
+		}
+		eligibleLocks[lock.ID] = true
+		if err := k.setLockPositionShares(ctx, accumulator, gaugeID, lock.ID, shares); err != nil {
+			return err
+		}
+	}
+
+	for _, lockID := range k.getGaugeLockPositions(ctx, gaugeID) {
+		if eligibleLocks[lockID] {
+			continue
+		}
+		if err := k.setLockPositionShares(ctx, accumulator, gaugeID, lockID, sdk.ZeroDec()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setLockPositionShares sets the shares of the lock's position in the gauge accumulator,
+// creating the position if the lock has none.
+func (k Keeper) setLockPositionShares(ctx sdk.Context, accumulator accum.AccumulatorObject, gaugeID, lockID uint64, shares sdk.Dec) error {
+	positionName := formatLockPositionName(lockID)
+	hasPosition, err := accumulator.HasPosition(positionName)
+	if err != nil {
+		return err
+	}
+
+	if !hasPosition {
+		if shares.IsZero() {
+			return nil
+		}
+		if err := accumulator.NewPosition(positionName, shares, nil); err != nil {
+			return err
+		}
+		k.setGaugeLockPosition(ctx, gaugeID, lockID)
+		if _, found := k.getLockRewards(ctx, lockID, gaugeID); !found {
+			k.setLockRewards(ctx, types.LockRewards{LockId: lockID, GaugeId: gaugeID})
+		}
+		return nil
+	}
+
+	curShares, err := accumulator.GetPositionSize(positionName)
+	if err != nil {
+		return err
+	}
+	if curShares.Equal(shares) {
+		return nil
+	}
+	return accumulator.UpdatePosition(positionName, shares.Sub(curShares))
+}
+
+// ClaimRewards sends the rewards the given locks have accrued from claimable gauges to their owner.
+// If no lock IDs are provided, rewards are claimed for all of the owner's locks.
+// Returns error if any of the locks does not exist or is not owned by the owner.
+func (k Keeper) ClaimRewards(ctx sdk.Context, owner sdk.AccAddress, lockIDs []uint64) (sdk.Coins, error) {
+	if len(lockIDs) == 0 {
+		for _, lock := range k.lk.GetAccountPeriodLocks(ctx, owner) {
+			lockIDs = append(lockIDs, lock.ID)
+		}
+	}
+
+	totalClaimed := sdk.NewCoins()
+	for _, lockID := range lockIDs {
+		lock, err := k.lk.GetLockByID(ctx, lockID)
+		if err != nil {
+			return nil, err
+		}
+		if lock.Owner != owner.String() {
+			return nil, fmt.Errorf("lock %d is not owned by %s", lockID, owner)
+		}
+
+		claimed, err := k.claimLockRewards(ctx, lockID, false)
+		if err != nil {
+			return nil, err
+		}
+		if claimed.Empty() {
+			continue
+		}
+		totalClaimed = totalClaimed.Add(claimed...)
+		emitClaimRewardsEvent(ctx, lockID, owner, claimed)
+	}
+
+	if !totalClaimed.Empty() {
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, totalClaimed); err != nil {
+			return nil, err
+		}
+	}
+	return totalClaimed, nil
+}
+
+// claimUnlockedLockRewards sends the rewards the given lock has accrued from claimable gauges to its owner
+// and removes the lock from the gauge accumulators. It is called once the lock has matured and been deleted.
+func (k Keeper) claimUnlockedLockRewards(ctx sdk.Context, owner sdk.AccAddress, lockID uint64) error {
+	claimed, err := k.claimLockRewards(ctx, lockID, true)
+	if err != nil {
+		return err
+	}
+	if claimed.Empty() {
+		return nil
+	}
+
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, claimed); err != nil {
+		return err
+	}
+	emitClaimRewardsEvent(ctx, lockID, owner, claimed)
+	return nil
+}
+
+// claimLockRewards claims the rewards accrued to the given lock from all claimable gauges and records them as claimed.
+// If exitPositions is true, the lock's shares are removed from the gauge accumulators before claiming and its records are deleted.
+// It does not send the claimed rewards.
+func (k Keeper) claimLockRewards(ctx sdk.Context, lockID uint64, exitPositions bool) (sdk.Coins, error) {
+	totalClaimed := sdk.NewCoins()
+	positionName := formatLockPositionName(lockID)
+	for _, lockRewards := range k.GetLockRewards(ctx, lockID) {
+		accumulator, err := k.getGaugeAccumulator(ctx, lockRewards.GaugeId)
+		if err != nil {
+			return nil, err
+		}
+
+		hasPosition, err := accumulator.HasPosition(positionName)
+		if err != nil {
+			return nil, err
+		}
+		if hasPosition {
+			if exitPositions {
+				if err := k.setLockPositionShares(ctx, accumulator, lockRewards.GaugeId, lockID, sdk.ZeroDec()); err != nil {
+					return nil, err
+				}
+			}
+
+			// positions with zero shares are deleted once their rewards are claimed.
+			claimed, err := accumulator.ClaimRewards(positionName)
+			if err != nil {
+				return nil, err
+			}
+			if hasPosition, err = accumulator.HasPosition(positionName); err != nil {
+				return nil, err
+			} else if !hasPosition {
+				k.deleteGaugeLockPosition(ctx, lockRewards.GaugeId, lockID)
+			}
+
+			lockRewards.ClaimedRewards = lockRewards.ClaimedRewards.Add(claimed...)
+			totalClaimed = totalClaimed.Add(claimed...)
+		}
+
+		if exitPositions {
+			k.deleteLockRewards(ctx, lockID, lockRewards.GaugeId)
+		} else {
+			k.setLockRewards(ctx, lockRewards)
+		}
+	}
+	return totalClaimed, nil
+}
+
+// GetGaugeLockRewards returns the pending and claimed rewards of the given lock for every claimable gauge it has accrued rewards from.
+func (k Keeper) GetGaugeLockRewards(ctx sdk.Context, lockID uint64) ([]types.GaugeLockRewards, error) {
+	gaugeLockRewards := []types.GaugeLockRewards{}
+	positionName := formatLockPositionName(lockID)
+	for _, lockRewards := range k.GetLockRewards(ctx, lockID) {
+		accumulator, err := k.getGaugeAccumulator(ctx, lockRewards.GaugeId)
+		if err != nil {
+			return nil, err
+		}
+
+		pendingRewards := sdk.NewCoins()
+		hasPosition, err := accumulator.HasPosition(positionName)
+		if err != nil {
+			return nil, err
+		}
+		if hasPosition {
+			position, err := accum.GetPosition(accumulator, positionName)
+			if err != nil {
+				return nil, err
+			}
+			// pending rewards = unclaimed_rewards + (accumulator_value - position_init_value) * shares
+			accruedRewards := accumulator.GetValue().Sub(position.InitAccumValue).MulDec(position.NumShares)
+			pendingRewards, _ = position.UnclaimedRewards.Add(accruedRewards...).TruncateDecimal()
+		}
+
+		gaugeLockRewards = append(gaugeLockRewards, types.GaugeLockRewards{
+			GaugeId:        lockRewards.GaugeId,
+			PendingRewards: pendingRewards,
+			ClaimedRewards: lockRewards.ClaimedRewards,
+		})
+	}
+	return gaugeLockRewards, nil
+}
+
+// GetLockRewards returns the claimed rewards records of the given lock, one for every claimable gauge it has accrued rewards from.
+func (k Keeper) GetLockRewards(ctx sdk.Context, lockID uint64) []types.LockRewards {
+	return k.getLockRewardsFromPrefix(ctx, combineKeys(types.KeyPrefixLockRewards, sdk.Uint64ToBigEndian(lockID)))
+}
+
+// GetAllLockRewards returns the claimed rewards records of all locks.
+func (k Keeper) GetAllLockRewards(ctx sdk.Context) []types.LockRewards {
+	return k.getLockRewardsFromPrefix(ctx, types.KeyPrefixLockRewards)
+}
+
+func (k Keeper) getLockRewardsFromPrefix(ctx sdk.Context, prefix []byte) []types.LockRewards {
+	lockRewards, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), prefix, func(bz []byte) (types.LockRewards, error) {
+		lockRewards := types.LockRewards{}
+		err := lockRewards.Unmarshal(bz)
+		return lockRewards, err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return lockRewards
+}
+
+func (k Keeper) getLockRewards(ctx sdk.Context, lockID, gaugeID uint64) (types.LockRewards, bool) {
+	lockRewards := types.LockRewards{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), lockRewardsStoreKey(lockID, gaugeID), &lockRewards)
+	if err != nil {
+		panic(err)
+	}
+	return lockRewards, found
+}
+
+func (k Keeper) setLockRewards(ctx sdk.Context, lockRewards types.LockRewards) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), lockRewardsStoreKey(lockRewards.LockId, lockRewards.GaugeId), &lockRewards)
+}
+
+func (k Keeper) deleteLockRewards(ctx sdk.Context, lockID, gaugeID uint64) {
+	ctx.KVStore(k.storeKey).Delete(lockRewardsStoreKey(lockID, gaugeID))
+}
+
+// getGaugeLockPositions returns the IDs of the locks with a position in the given gauge's accumulator.
+func (k Keeper) getGaugeLockPositions(ctx sdk.Context, gaugeID uint64) []uint64 {
+	prefix := combineKeys(types.KeyPrefixGaugeLockPositions, sdk.Uint64ToBigEndian(gaugeID))
+	lockIDs, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), prefix, func(bz []byte) (uint64, error) {
+		return sdk.BigEndianToUint64(bz), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return lockIDs
+}
+
+func (k Keeper) setGaugeLockPosition(ctx sdk.Context, gaugeID, lockID uint64) {
+	ctx.KVStore(k.storeKey).Set(gaugeLockPositionStoreKey(gaugeID, lockID), sdk.Uint64ToBigEndian(lockID))
+}
+
+func (k Keeper) deleteGaugeLockPosition(ctx sdk.Context, gaugeID, lockID uint64) {
+	ctx.KVStore(k.storeKey).Delete(gaugeLockPositionStoreKey(gaugeID, lockID))
+}
+
+func emitClaimRewardsEvent(ctx sdk.Context, lockID uint64, receiver sdk.AccAddress, claimed sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEvtClaimRewards,
+			sdk.NewAttribute(types.AttributeLockID, osmoutils.Uint64ToString(lockID)),
+			sdk.NewAttribute(types.AttributeReceiver, receiver.String()),
+			sdk.NewAttribute(types.AttributeAmount, claimed.String()),
+		),
+	)
+}
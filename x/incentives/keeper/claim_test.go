@@ -0,0 +1,341 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v14/x/incentives"
+	"github.com/osmosis-labs/osmosis/v14/x/incentives/keeper"
+	"github.com/osmosis-labs/osmosis/v14/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
+)
+
+var defaultDistrTo = lockuptypes.QueryCondition{
+	LockQueryType: lockuptypes.ByDuration,
+	Denom:         defaultLPDenom,
+	Duration:      defaultLockDuration,
+}
+
+// setupClaimableGauge creates an active claimable gauge distributing the given coins over numEpochs.
+func (suite *KeeperTestSuite) setupClaimableGauge(coins sdk.Coins, distrTo lockuptypes.QueryCondition, numEpochs uint64) uint64 {
+	gaugeCreator := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	gaugeID, _ := suite.CreateGauge(false, gaugeCreator, coins, distrTo, suite.Ctx.BlockTime(), numEpochs)
+	err := suite.App.IncentivesKeeper.EnableGaugeClaims(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+	suite.Require().NoError(err)
+	return gaugeID
+}
+
+// distributeGauge runs a distribution of the given gauge.
+func (suite *KeeperTestSuite) distributeGauge(gaugeID uint64) sdk.Coins {
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	return distrCoins
+}
+
+// requireLockRewards checks the pending and claimed rewards of a lock through the LockRewards query.
+func (suite *KeeperTestSuite) requireLockRewards(lockID uint64, expectedPending, expectedClaimed int64) {
+	querier := keeper.NewQuerier(*suite.App.IncentivesKeeper)
+	res, err := querier.LockRewards(sdk.WrapSDKContext(suite.Ctx), &types.LockRewardsRequest{LockId: lockID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedPending, res.TotalPendingRewards.AmountOf(defaultRewardDenom).Int64(), "lock %d pending rewards", lockID)
+	suite.Require().Equal(expectedClaimed, res.TotalClaimedRewards.AmountOf(defaultRewardDenom).Int64(), "lock %d claimed rewards", lockID)
+}
+
+// TestClaimableGaugeDistribution tests that claimable gauges accrue rewards to locks over several epochs
+// in the same proportions as gauges sending rewards, and that only lock owners can claim them.
+func (suite *KeeperTestSuite) TestClaimableGaugeDistribution() {
+	suite.SetupTest()
+
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1---------------")),
+		sdk.AccAddress([]byte("addr2---------------")),
+		sdk.AccAddress([]byte("addr3---------------")),
+	}
+	suite.LockTokens(addrs[0], defaultLPTokens, defaultLockDuration)
+	suite.LockTokens(addrs[1], defaultLPTokens, defaultLockDuration)
+	suite.LockTokens(addrs[2], sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 20)}, 2*defaultLockDuration)
+
+	gaugeID := suite.setupClaimableGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 4000)}, defaultDistrTo, 2)
+
+	// first epoch: 2000 accrue over all 40 locked tokens, nothing is sent.
+	distrCoins := suite.distributeGauge(gaugeID)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}, distrCoins)
+	for _, addr := range addrs {
+		suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, addr, defaultRewardDenom).IsZero())
+	}
+	suite.requireLockRewards(1, 500, 0)
+	suite.requireLockRewards(2, 500, 0)
+	suite.requireLockRewards(3, 1000, 0)
+
+	// only the owner of a lock can claim its rewards.
+	_, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addrs[1], []uint64{1})
+	suite.Require().Error(err)
+	claimed, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addrs[0], []uint64{1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 500)}, claimed)
+	suite.Require().Equal(int64(500), suite.App.BankKeeper.GetBalance(suite.Ctx, addrs[0], defaultRewardDenom).Amount.Int64())
+	suite.requireLockRewards(1, 0, 500)
+
+	// adding tokens to lock 1 increases its share of the next distribution.
+	suite.FundAcc(addrs[0], defaultLPTokens)
+	_, err = suite.App.LockupKeeper.AddTokensToLockByID(suite.Ctx, 1, addrs[0], defaultLPTokens[0])
+	suite.Require().NoError(err)
+
+	// second epoch: 2000 accrue over all 50 locked tokens.
+	suite.distributeGauge(gaugeID)
+	suite.requireLockRewards(1, 800, 500)
+	suite.requireLockRewards(2, 500+400, 0)
+	suite.requireLockRewards(3, 1000+800, 0)
+
+	// claiming without lock IDs claims for all of the owner's locks.
+	claimed, err = suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addrs[2], nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1800)}, claimed)
+	suite.requireLockRewards(3, 0, 1800)
+
+	// the gauge is fully distributed, and the unclaimed rewards stay in the module account.
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(gauge.Coins, gauge.DistributedCoins)
+	moduleAddr := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(int64(800+900), suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddr, defaultRewardDenom).Amount.Int64())
+}
+
+// TestClaimableGaugeIneligibleLock tests that a lock that is no longer eligible for a claimable gauge
+// keeps its pending rewards without accruing new ones.
+func (suite *KeeperTestSuite) TestClaimableGaugeIneligibleLock() {
+	suite.SetupTest()
+
+	t0 := suite.Ctx.BlockTime()
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1---------------")),
+		sdk.AccAddress([]byte("addr2---------------")),
+	}
+	suite.LockTokens(addrs[0], defaultLPTokens, defaultLockDuration)
+	suite.LockTokens(addrs[1], defaultLPTokens, defaultLockDuration)
+	suite.Ctx = suite.Ctx.WithBlockTime(t0.Add(time.Hour))
+
+	// the gauge distributes to locks created before t0 + 1s.
+	gaugeID := suite.setupClaimableGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}, lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByTime,
+		Denom:         defaultLPDenom,
+		Timestamp:     t0.Add(time.Second),
+	}, 2)
+
+	suite.distributeGauge(gaugeID)
+	suite.requireLockRewards(1, 500, 0)
+	suite.requireLockRewards(2, 500, 0)
+
+	// adding tokens to lock 1 resets its creation time, so it is no longer eligible.
+	suite.FundAcc(addrs[0], defaultLPTokens)
+	_, err := suite.App.LockupKeeper.AddTokensToLockByID(suite.Ctx, 1, addrs[0], defaultLPTokens[0])
+	suite.Require().NoError(err)
+
+	suite.distributeGauge(gaugeID)
+	suite.requireLockRewards(1, 500, 0)
+	suite.requireLockRewards(2, 1500, 0)
+
+	// claiming the rewards of the ineligible lock removes its position from the gauge.
+	claimed, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addrs[0], []uint64{1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 500)}, claimed)
+	genesis := suite.App.IncentivesKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Len(genesis.GaugeAccumulators, 1)
+	suite.Require().Len(genesis.GaugeAccumulators[0].Positions, 1)
+	suite.Require().Equal(uint64(2), genesis.GaugeAccumulators[0].Positions[0].LockId)
+}
+
+// TestClaimableGaugeUnlock tests that the pending rewards of a lock are sent to its owner once it is unlocked.
+func (suite *KeeperTestSuite) TestClaimableGaugeUnlock() {
+	suite.SetupTest()
+
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1---------------")),
+		sdk.AccAddress([]byte("addr2---------------")),
+	}
+	suite.LockTokens(addrs[0], defaultLPTokens, defaultLockDuration)
+	suite.LockTokens(addrs[1], defaultLPTokens, defaultLockDuration)
+	gaugeID := suite.setupClaimableGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, defaultDistrTo, 1)
+	suite.distributeGauge(gaugeID)
+
+	err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 1, nil)
+	suite.Require().NoError(err)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(defaultLockDuration))
+	suite.App.LockupKeeper.WithdrawAllMaturedLocks(suite.Ctx)
+
+	suite.Require().Equal(int64(500), suite.App.BankKeeper.GetBalance(suite.Ctx, addrs[0], defaultRewardDenom).Amount.Int64())
+	suite.requireLockRewards(1, 0, 0)
+	suite.requireLockRewards(2, 500, 0)
+}
+
+// TestClaimableGaugeCancel tests that the rewards a claimable gauge accrued before it was cancelled stay claimable.
+func (suite *KeeperTestSuite) TestClaimableGaugeCancel() {
+	suite.SetupTest()
+
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	suite.LockTokens(addr, defaultLPTokens, defaultLockDuration)
+	gaugeID := suite.setupClaimableGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}, defaultDistrTo, 3)
+	suite.distributeGauge(gaugeID)
+
+	refund, err := suite.App.IncentivesKeeper.CancelGauge(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}, refund)
+	suite.requireLockRewards(1, 1000, 0)
+
+	claimed, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addr, []uint64{1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, claimed)
+	suite.requireLockRewards(1, 0, 1000)
+}
+
+// TestEnableGaugeClaims tests migrating an existing gauge to accrue its rewards instead of sending them.
+func (suite *KeeperTestSuite) TestEnableGaugeClaims() {
+	suite.SetupTest()
+
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	suite.LockTokens(addr, defaultLPTokens, defaultLockDuration)
+	gaugeCreator := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	gaugeID, gauge := suite.CreateGauge(false, gaugeCreator, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}, defaultDistrTo, suite.Ctx.BlockTime(), 3)
+	err := suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+	suite.Require().NoError(err)
+
+	// the first distribution sends the rewards.
+	suite.distributeGauge(gaugeID)
+	suite.Require().Equal(int64(1000), suite.App.BankKeeper.GetBalance(suite.Ctx, addr, defaultRewardDenom).Amount.Int64())
+
+	// once claims are enabled, the rewards accrue to the lock.
+	err = suite.App.IncentivesKeeper.EnableGaugeClaims(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	suite.distributeGauge(gaugeID)
+	suite.Require().Equal(int64(1000), suite.App.BankKeeper.GetBalance(suite.Ctx, addr, defaultRewardDenom).Amount.Int64())
+	suite.requireLockRewards(1, 1000, 0)
+
+	// claims can't be enabled twice.
+	err = suite.App.IncentivesKeeper.EnableGaugeClaims(suite.Ctx, gaugeID)
+	suite.Require().Error(err)
+
+	// claims can't be enabled for finished gauges.
+	gaugeID, gauge = suite.CreateGauge(false, gaugeCreator, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, defaultDistrTo, suite.Ctx.BlockTime(), 1)
+	err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+	suite.Require().NoError(err)
+	suite.distributeGauge(gaugeID)
+	err = suite.App.IncentivesKeeper.EnableGaugeClaims(suite.Ctx, gaugeID)
+	suite.Require().Error(err)
+
+	// claims can't be enabled for gauges distributing to synthetic denoms.
+	suite.FundAcc(addr, defaultLPSyntheticTokens)
+	gaugeID, _ = suite.CreateGauge(false, gaugeCreator, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         defaultLPSyntheticDenom,
+		Duration:      defaultLockDuration,
+	}, suite.Ctx.BlockTime(), 1)
+	err = suite.App.IncentivesKeeper.EnableGaugeClaims(suite.Ctx, gaugeID)
+	suite.Require().Error(err)
+
+	// claims can't be enabled for gauges distributing to concentrated liquidity pools.
+	clPool := suite.PrepareConcentratedPool()
+	gaugeID, _ = suite.CreateGauge(false, gaugeCreator, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.NoLock,
+		Denom:         types.GetNoLockGaugeDenom(clPool.GetId()),
+	}, suite.Ctx.BlockTime(), 1)
+	err = suite.App.IncentivesKeeper.EnableGaugeClaims(suite.Ctx, gaugeID)
+	suite.Require().Error(err)
+
+	// claims can't be enabled for gauges that don't exist.
+	err = suite.App.IncentivesKeeper.EnableGaugeClaims(suite.Ctx, 100)
+	suite.Require().Error(err)
+}
+
+// TestEnableGaugeClaimsProposal tests that governance can migrate an existing gauge to claimable rewards
+// mid-distribution, and that the rewards distributed before and after the migration add up to the gauge's coins.
+func (suite *KeeperTestSuite) TestEnableGaugeClaimsProposal() {
+	suite.SetupTest()
+
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1---------------")),
+		sdk.AccAddress([]byte("addr2---------------")),
+	}
+	suite.LockTokens(addrs[0], defaultLPTokens, defaultLockDuration)
+	suite.LockTokens(addrs[1], sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 30)}, defaultLockDuration)
+	gaugeCreator := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	gaugeID, gauge := suite.CreateGauge(false, gaugeCreator, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}, defaultDistrTo, suite.Ctx.BlockTime(), 3)
+	err := suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+	suite.Require().NoError(err)
+
+	// the first distribution sends 1000 over all 40 locked tokens.
+	suite.distributeGauge(gaugeID)
+	suite.Require().Equal(int64(250), suite.App.BankKeeper.GetBalance(suite.Ctx, addrs[0], defaultRewardDenom).Amount.Int64())
+	suite.Require().Equal(int64(750), suite.App.BankKeeper.GetBalance(suite.Ctx, addrs[1], defaultRewardDenom).Amount.Int64())
+
+	handler := incentives.NewIncentivesProposalHandler(suite.App.IncentivesKeeper)
+	proposal := types.NewEnableGaugeClaimsProposal("title", "description", gaugeID)
+	suite.Require().NoError(proposal.ValidateBasic())
+	err = handler(suite.Ctx, &proposal)
+	suite.Require().NoError(err)
+
+	// the rewards already sent are not accounted for as pending, and the remaining ones accrue to the locks.
+	suite.requireLockRewards(1, 0, 0)
+	suite.requireLockRewards(2, 0, 0)
+	suite.distributeGauge(gaugeID)
+	suite.distributeGauge(gaugeID)
+	suite.Require().Equal(int64(250), suite.App.BankKeeper.GetBalance(suite.Ctx, addrs[0], defaultRewardDenom).Amount.Int64())
+	suite.Require().Equal(int64(750), suite.App.BankKeeper.GetBalance(suite.Ctx, addrs[1], defaultRewardDenom).Amount.Int64())
+	suite.requireLockRewards(1, 500, 0)
+	suite.requireLockRewards(2, 1500, 0)
+
+	// the gauge is fully distributed and the module account holds exactly the pending rewards.
+	gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(gauge.Coins, gauge.DistributedCoins)
+	moduleAddr := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(int64(2000), suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddr, defaultRewardDenom).Amount.Int64())
+
+	// once claimed, every lock has received its share of all of the gauge's coins.
+	for _, addr := range addrs {
+		_, err = suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addr, nil)
+		suite.Require().NoError(err)
+	}
+	suite.Require().Equal(int64(750), suite.App.BankKeeper.GetBalance(suite.Ctx, addrs[0], defaultRewardDenom).Amount.Int64())
+	suite.Require().Equal(int64(2250), suite.App.BankKeeper.GetBalance(suite.Ctx, addrs[1], defaultRewardDenom).Amount.Int64())
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddr, defaultRewardDenom).IsZero())
+
+	// a proposal for a gauge that is already claimable fails.
+	err = handler(suite.Ctx, &proposal)
+	suite.Require().Error(err)
+}
+
+// TestClaimableGaugeGenesis tests that the accumulators and claimed rewards of claimable gauges
+// survive an export and import of genesis.
+func (suite *KeeperTestSuite) TestClaimableGaugeGenesis() {
+	suite.SetupTest()
+
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1---------------")),
+		sdk.AccAddress([]byte("addr2---------------")),
+	}
+	suite.LockTokens(addrs[0], defaultLPTokens, defaultLockDuration)
+	suite.LockTokens(addrs[1], sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 30)}, defaultLockDuration)
+	gaugeID := suite.setupClaimableGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}, defaultDistrTo, 2)
+	suite.distributeGauge(gaugeID)
+	_, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addrs[0], nil)
+	suite.Require().NoError(err)
+	suite.distributeGauge(gaugeID)
+
+	genesis := suite.App.IncentivesKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Len(genesis.GaugeAccumulators, 1)
+	suite.Require().Len(genesis.LockRewards, 2)
+
+	// the gauge is finished, so only its accumulator and the lock rewards carry it over.
+	suite.SetupTest()
+	suite.App.IncentivesKeeper.InitGenesis(suite.Ctx, *genesis)
+	suite.Require().Equal(genesis, suite.App.IncentivesKeeper.ExportGenesis(suite.Ctx))
+	suite.requireLockRewards(1, 250, 250)
+	suite.requireLockRewards(2, 1500, 0)
+}
@@ -6,10 +6,13 @@ import (
 
 	db "github.com/tendermint/tm-db"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	cltypes "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v14/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // getDistributedCoinsFromGauges returns coins that have been distributed already from the provided gauges
@@ -89,7 +92,7 @@ func (k Keeper) getLocksToDistributionWithMaxDuration(ctx sdk.Context, distrTo l
 		}
 		return k.lk.GetLocksLongerThanDurationDenom(ctx, distrTo.Denom, distrTo.Duration)
 	case lockuptypes.ByTime:
-		panic("Gauge by time is present, however is no longer supported. This should have been blocked in ValidateBasic")
+		return k.lk.GetLocksCreatedBeforeTimeDenom(ctx, distrTo.Denom, distrTo.Timestamp)
 	default:
 	}
 	return []lockuptypes.PeriodLock{}
@@ -135,6 +138,10 @@ func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge,
 		filteredDistrCoins = remainCoinsPerEpoch
 	}
 	for _, lock := range filteredLocks {
+		// locks created at or after the timestamp of a ByTime gauge receive no rewards from it.
+		if gauge.DistributeTo.LockQueryType == lockuptypes.ByTime && !lock.CreationTime.Before(gauge.DistributeTo.Timestamp) {
+			continue
+		}
 		denomLockAmt := lock.Coins.AmountOf(gauge.DistributeTo.Denom)
 
 		for _, coin := range remainCoinsPerEpoch {
@@ -308,6 +315,46 @@ func (k Keeper) distributeInternal(
 	return totalDistrCoins, err
 }
 
+// distributeConcentratedLiquidity runs the distribution logic for a NoLock gauge. The coins the gauge distributes
+// this epoch are added to the incentive records the incentives module funds in the concentrated liquidity pool
+// the gauge is for, and are emitted to the pool's positions over the next distribution epoch.
+// Coins that fail to be added, for example because the pool no longer exists, stay in the gauge.
+func (k Keeper) distributeConcentratedLiquidity(ctx sdk.Context, gauge types.Gauge) (sdk.Coins, error) {
+	poolId, err := types.GetPoolIdFromNoLockGaugeDenom(gauge.DistributeTo.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+	// if its a perpetual gauge, we set remaining epochs to 1.
+	// otherwise is is a non perpetual gauge and we determine how many epoch payouts are left
+	remainEpochs := uint64(1)
+	if !gauge.IsPerpetual {
+		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
+	}
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	emissionDuration := k.GetEpochInfo(ctx).Duration
+	totalDistrCoins := sdk.NewCoins()
+	for _, coin := range remainCoins {
+		distrCoin := sdk.NewCoin(coin.Denom, coin.Amount.Quo(sdk.NewInt(int64(remainEpochs))))
+		if !distrCoin.IsPositive() {
+			continue
+		}
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			_, err := k.clk.AddToIncentive(cacheCtx, poolId, moduleAddr, distrCoin.Denom, distrCoin.Amount, cltypes.SupportedUptimes[0], emissionDuration)
+			return err
+		})
+		if err != nil {
+			continue
+		}
+		totalDistrCoins = totalDistrCoins.Add(distrCoin)
+	}
+
+	err = k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins)
+	return totalDistrCoins, err
+}
+
 // updateGaugePostDistribute increments the gauge's filled epochs field.
 // Also adds the coins that were just distributed to the gauge's distributed coins field.
 func (k Keeper) updateGaugePostDistribute(ctx sdk.Context, gauge types.Gauge, newlyDistributedCoins sdk.Coins) error {
@@ -321,12 +368,17 @@ func (k Keeper) updateGaugePostDistribute(ctx sdk.Context, gauge types.Gauge, ne
 
 // getDistributeToBaseLocks takes a gauge along with cached period locks by denom and returns locks that must be distributed to
 func (k Keeper) getDistributeToBaseLocks(ctx sdk.Context, gauge types.Gauge, cache map[string][]lockuptypes.PeriodLock) []lockuptypes.PeriodLock {
-	// if gauge is empty, don't get the locks
-	if gauge.Coins.Empty() {
+	// if gauge is empty or distributes to a concentrated liquidity pool, don't get the locks
+	if gauge.Coins.Empty() || gauge.DistributeTo.LockQueryType == lockuptypes.NoLock {
 		return []lockuptypes.PeriodLock{}
 	}
+	// ByTime gauges are distributed to locks created before their own timestamp,
+	// so their locks can't be shared with other gauges through the cache.
+	if gauge.DistributeTo.LockQueryType == lockuptypes.ByTime {
+		return k.getLocksToDistributionWithMaxDuration(ctx, gauge.DistributeTo, time.Millisecond)
+	}
 	// Confusingly, there is no way to get all synthetic lockups. Thus we use a separate method `distributeSyntheticInternal` to separately get lockSum for synthetic lockups.
-	// All gauges have a precondition of being ByDuration.
+	// All other gauges are ByDuration.
 	distributeBaseDenom := lockuptypes.NativeDenom(gauge.DistributeTo.Denom)
 	if _, ok := cache[distributeBaseDenom]; !ok {
 		cache[distributeBaseDenom] = k.getLocksToDistributionWithMaxDuration(
@@ -349,8 +401,13 @@ func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, er
 		// send based on synthetic lockup coins if it's distributing to synthetic lockups
 		var gaugeDistributedCoins sdk.Coins
 		var err error
-		if lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) {
+		if gauge.DistributeTo.LockQueryType == lockuptypes.NoLock {
+			gaugeDistributedCoins, err = k.distributeConcentratedLiquidity(ctx, gauge)
+		} else if lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) {
 			gaugeDistributedCoins, err = k.distributeSyntheticInternal(ctx, gauge, filteredLocks, &distrInfo)
+		} else if gauge.IsClaimable {
+			// claimable gauges accrue rewards to locks instead of sending them.
+			gaugeDistributedCoins, err = k.distributeClaimableInternal(ctx, gauge, filteredLocks)
 		} else {
 			gaugeDistributedCoins, err = k.distributeInternal(ctx, gauge, filteredLocks, &distrInfo)
 		}
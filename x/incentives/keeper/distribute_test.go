@@ -4,8 +4,10 @@ import (
 	"strings"
 	"time"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/suite"
 
+	cltypes "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v14/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
 
@@ -92,6 +94,85 @@ func (suite *KeeperTestSuite) TestDistribute() {
 	}
 }
 
+// TestDistributeByTimeAndByDuration tests that ByDuration and ByTime gauges distributed together over several epochs
+// reward their respective locks, and that ByTime gauges only reward locks created before their timestamp.
+func (suite *KeeperTestSuite) TestDistributeByTimeAndByDuration() {
+	suite.SetupTest()
+
+	t0 := suite.Ctx.BlockTime()
+	t1 := t0.Add(time.Hour)
+	t2 := t1.Add(time.Hour)
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1---------------")),
+		sdk.AccAddress([]byte("addr2---------------")),
+		sdk.AccAddress([]byte("addr3---------------")),
+	}
+
+	// lock 1 and lock 2 are created at t0, lock 3 is created at t1
+	suite.LockTokens(addrs[0], defaultLPTokens, defaultLockDuration)
+	suite.LockTokens(addrs[1], defaultLPTokens, 2*defaultLockDuration)
+	suite.Ctx = suite.Ctx.WithBlockTime(t1)
+	suite.LockTokens(addrs[2], sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 20)}, defaultLockDuration)
+
+	gaugeCreator := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	byDurationGaugeID, _ := suite.CreateGauge(false, gaugeCreator, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 8000)}, lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         defaultLPDenom,
+		Duration:      defaultLockDuration,
+	}, t0, 2)
+	byTimeGaugeID, _ := suite.CreateGauge(false, gaugeCreator, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}, lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByTime,
+		Denom:         defaultLPDenom,
+		Timestamp:     t1,
+	}, t0, 2)
+	for _, gaugeID := range []uint64{byDurationGaugeID, byTimeGaugeID} {
+		gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+		suite.Require().NoError(err)
+		err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+		suite.Require().NoError(err)
+	}
+
+	distribute := func() {
+		gauges := []types.Gauge{}
+		for _, gaugeID := range []uint64{byDurationGaugeID, byTimeGaugeID} {
+			gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+			suite.Require().NoError(err)
+			gauges = append(gauges, *gauge)
+		}
+		_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, gauges)
+		suite.Require().NoError(err)
+	}
+	checkRewards := func(expectedRewards []int64) {
+		for i, addr := range addrs {
+			bal := suite.App.BankKeeper.GetBalance(suite.Ctx, addr, defaultRewardDenom)
+			suite.Require().Equal(expectedRewards[i], bal.Amount.Int64(), "person %d", i)
+		}
+	}
+
+	// first epoch: the ByDuration gauge distributes 4000 over all 40 locked tokens,
+	// the ByTime gauge distributes 1000 over the 20 tokens of lock 1 and lock 2.
+	distribute()
+	checkRewards([]int64{1000 + 500, 1000 + 500, 2000})
+
+	// adding tokens to lock 1 at t2 makes it ineligible for the ByTime gauge
+	suite.Ctx = suite.Ctx.WithBlockTime(t2)
+	suite.FundAcc(addrs[0], defaultLPTokens)
+	_, err := suite.App.LockupKeeper.AddTokensToLockByID(suite.Ctx, 1, addrs[0], defaultLPTokens[0])
+	suite.Require().NoError(err)
+
+	// second epoch: the ByDuration gauge distributes 4000 over all 50 locked tokens,
+	// the ByTime gauge distributes 1000 to lock 2 only.
+	distribute()
+	checkRewards([]int64{1500 + 1600, 1500 + 800 + 1000, 2000 + 1600})
+
+	// both gauges are fully distributed after their two epochs.
+	for _, gaugeID := range []uint64{byDurationGaugeID, byTimeGaugeID} {
+		gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+		suite.Require().NoError(err)
+		suite.Require().Equal(gauge.Coins, gauge.DistributedCoins)
+	}
+}
+
 // TestSyntheticDistribute tests that when the distribute command is executed on a provided gauge
 // the correct amount of rewards is sent to the correct synthetic lock owners.
 func (suite *KeeperTestSuite) TestSyntheticDistribute() {
@@ -278,6 +359,7 @@ func (suite *KeeperTestSuite) TestNoLockPerpetualGaugeDistribution() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -325,6 +407,7 @@ func (suite *KeeperTestSuite) TestNoLockNonPerpetualGaugeDistribution() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -345,3 +428,65 @@ func (suite *KeeperTestSuite) TestNoLockNonPerpetualGaugeDistribution() {
 	suite.Require().Len(gauges, 1)
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 }
+
+// TestConcentratedLiquidityGaugeDistribution tests that distributing a NoLock gauge adds the coins it pays out
+// this epoch to the incentive record the incentives module funds in the gauge's concentrated liquidity pool.
+func (suite *KeeperTestSuite) TestConcentratedLiquidityGaugeDistribution() {
+	tests := map[string]struct {
+		isPerpetual           bool
+		numEpochsPaidOver     uint64
+		expectedDistribution  sdk.Int
+		expectedFilledEpochs  uint64
+		expectedRemainingCoin sdk.Int
+	}{
+		"perpetual gauge distributes everything": {
+			isPerpetual:           true,
+			numEpochsPaidOver:     1,
+			expectedDistribution:  sdk.NewInt(1000),
+			expectedFilledEpochs:  1,
+			expectedRemainingCoin: sdk.ZeroInt(),
+		},
+		"non-perpetual gauge distributes its coins over its epochs": {
+			isPerpetual:           false,
+			numEpochsPaidOver:     2,
+			expectedDistribution:  sdk.NewInt(500),
+			expectedFilledEpochs:  1,
+			expectedRemainingCoin: sdk.NewInt(500),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+
+			pool := suite.PrepareConcentratedPool()
+			coins := sdk.Coins{sdk.NewInt64Coin("stake", 1000)}
+			distrTo := lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.NoLock,
+				Denom:         types.GetNoLockGaugeDenom(pool.GetId()),
+			}
+			gaugeID, gauge := suite.CreateGauge(tc.isPerpetual, defaultGaugeOwner, coins, distrTo, suite.Ctx.BlockTime(), tc.numEpochsPaidOver)
+
+			err := suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+			suite.Require().NoError(err)
+
+			distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", tc.expectedDistribution)), distrCoins)
+
+			// the distributed coins are emitted to the pool's positions over the next distribution epoch
+			record, err := suite.App.ConcentratedLiquidityKeeper.GetIncentiveRecord(suite.Ctx, pool.GetId(), "stake", cltypes.SupportedUptimes[0], authtypes.NewModuleAddress(types.ModuleName))
+			suite.Require().NoError(err)
+			epochSeconds := int64(suite.App.IncentivesKeeper.GetEpochInfo(suite.Ctx).Duration / time.Second)
+			suite.Require().Equal(tc.expectedDistribution.ToDec(), record.RemainingAmount)
+			suite.Require().Equal(tc.expectedDistribution.ToDec().QuoInt64(epochSeconds), record.EmissionRate)
+			suite.Require().Equal(tc.expectedDistribution, suite.App.BankKeeper.GetBalance(suite.Ctx, pool.GetIncentivesAddress(), "stake").Amount)
+
+			gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedFilledEpochs, gauge.FilledEpochs)
+			suite.Require().Equal(distrCoins, gauge.DistributedCoins)
+			suite.Require().Equal(tc.expectedRemainingCoin, suite.App.BankKeeper.GetBalance(suite.Ctx, authtypes.NewModuleAddress(types.ModuleName), "stake").Amount)
+		})
+	}
+}
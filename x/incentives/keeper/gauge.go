@@ -8,9 +8,12 @@ import (
 	"time"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/gogo/protobuf/proto"
 	db "github.com/tendermint/tm-db"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	epochtypes "github.com/osmosis-labs/osmosis/v14/x/epochs/types"
 	"github.com/osmosis-labs/osmosis/v14/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
@@ -107,8 +110,17 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		}
 	}
 
-	// Ensure that the denom this gauge pays out to exists on-chain
-	if !k.bk.HasSupply(ctx, distrTo.Denom) && !strings.Contains(distrTo.Denom, "osmovaloper") {
+	// Ensure that the denom this gauge pays out to exists on-chain,
+	// or for NoLock gauges, that the concentrated liquidity pool they pay out to exists.
+	if distrTo.LockQueryType == lockuptypes.NoLock {
+		poolId, err := types.GetPoolIdFromNoLockGaugeDenom(distrTo.Denom)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := k.clk.GetPool(ctx, poolId); err != nil {
+			return 0, err
+		}
+	} else if !k.bk.HasSupply(ctx, distrTo.Denom) && !strings.Contains(distrTo.Denom, "osmovaloper") {
 		return 0, fmt.Errorf("denom does not exist: %s", distrTo.Denom)
 	}
 
@@ -119,6 +131,7 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		Coins:             coins,
 		StartTime:         startTime,
 		NumEpochsPaidOver: numEpochsPaidOver,
+		Owner:             owner.String(),
 	}
 
 	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, gauge.Coins); err != nil {
@@ -161,6 +174,75 @@ func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins s
 	return nil
 }
 
+// CancelGauge cancels a non-perpetual gauge that has not finished yet. The gauge is moved from its upcoming
+// or active status to a finished status, and the coins it has not distributed yet are refunded to its owner.
+// Gauges that have no owner refund to the community pool. Rewards already accrued to locks by a claimable
+// gauge stay claimable. Returns the refunded coins.
+func (k Keeper) CancelGauge(ctx sdk.Context, gaugeID uint64) (sdk.Coins, error) {
+	gauge, err := k.GetGaugeByID(ctx, gaugeID)
+	if err != nil {
+		return nil, err
+	}
+	if gauge.IsPerpetual {
+		return nil, fmt.Errorf("gauge %d is perpetual and cannot be cancelled", gaugeID)
+	}
+
+	// the gauge is still referenced by the status it had at the last epoch, which may lag behind its start time.
+	timeKey := getTimeKey(gauge.StartTime)
+	upcomingKey := combineKeys(types.KeyPrefixUpcomingGauges, timeKey)
+	activeKey := combineKeys(types.KeyPrefixActiveGauges, timeKey)
+	var refKey []byte
+	if findIndex(k.getGaugeRefs(ctx, upcomingKey), gauge.Id) > -1 {
+		refKey = upcomingKey
+	} else if findIndex(k.getGaugeRefs(ctx, activeKey), gauge.Id) > -1 {
+		refKey = activeKey
+	} else {
+		return nil, fmt.Errorf("gauge %d has already finished", gaugeID)
+	}
+
+	if err := k.deleteGaugeRefByKey(ctx, refKey, gauge.Id); err != nil {
+		return nil, err
+	}
+	if err := k.addGaugeRefByKey(ctx, combineKeys(types.KeyPrefixFinishedGauges, timeKey), gauge.Id); err != nil {
+		return nil, err
+	}
+	if err := k.deleteGaugeIDForDenom(ctx, gauge.Id, gauge.DistributeTo.Denom); err != nil {
+		return nil, err
+	}
+
+	refund := gauge.Coins.Sub(gauge.DistributedCoins)
+	receiver := authtypes.NewModuleAddress(distrtypes.ModuleName)
+	if gauge.Owner != "" {
+		receiver, err = sdk.AccAddressFromBech32(gauge.Owner)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, refund); err != nil {
+			return nil, err
+		}
+	} else if err := k.ck.FundCommunityPool(ctx, refund, authtypes.NewModuleAddress(types.ModuleName)); err != nil {
+		return nil, err
+	}
+
+	// the gauge is finished with what it has distributed so far.
+	gauge.Coins = gauge.DistributedCoins
+	gauge.NumEpochsPaidOver = gauge.FilledEpochs
+	if err := k.setGauge(ctx, gauge); err != nil {
+		return nil, err
+	}
+	k.hooks.AfterFinishDistribution(ctx, gauge.Id)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelGauge,
+			sdk.NewAttribute(types.AttributeGaugeID, osmoutils.Uint64ToString(gauge.Id)),
+			sdk.NewAttribute(types.AttributeReceiver, receiver.String()),
+			sdk.NewAttribute(types.AttributeAmount, refund.String()),
+		),
+	})
+	return refund, nil
+}
+
 // GetGaugeByID returns gauge from gauge ID.
 func (k Keeper) GetGaugeByID(ctx sdk.Context, gaugeID uint64) (*types.Gauge, error) {
 	gauge := types.Gauge{}
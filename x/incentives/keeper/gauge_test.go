@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/osmosis-labs/osmosis/v14/x/incentives"
 	"github.com/osmosis-labs/osmosis/v14/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
 
@@ -111,6 +112,7 @@ func (suite *KeeperTestSuite) TestGaugeOperations() {
 			FilledEpochs:      0,
 			DistributedCoins:  sdk.Coins{},
 			StartTime:         startTime,
+			Owner:             defaultGaugeOwner.String(),
 		}
 		suite.Require().Equal(expectedGauge.String(), gauges[0].String())
 
@@ -238,6 +240,127 @@ func (suite *KeeperTestSuite) TestGaugeOperations() {
 	}
 }
 
+// TestCancelGauge tests that cancelling a gauge finishes it and refunds the coins it has not distributed yet.
+func (suite *KeeperTestSuite) TestCancelGauge() {
+	tests := map[string]struct {
+		isPerpetual    bool
+		isActive       bool
+		isFinished     bool
+		noOwner        bool
+		expectedRefund sdk.Coins
+		expectErr      bool
+	}{
+		"upcoming gauge refunds all of its coins": {
+			expectedRefund: sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+		},
+		"active gauge refunds the coins it has not distributed yet": {
+			isActive:       true,
+			expectedRefund: sdk.Coins{sdk.NewInt64Coin("stake", 5)},
+		},
+		"gauge without owner refunds to the community pool": {
+			isActive:       true,
+			noOwner:        true,
+			expectedRefund: sdk.Coins{sdk.NewInt64Coin("stake", 5)},
+		},
+		"perpetual gauge cannot be cancelled": {
+			isPerpetual: true,
+			expectErr:   true,
+		},
+		"finished gauge cannot be cancelled": {
+			isActive:   true,
+			isFinished: true,
+			expectErr:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.SetupManyLocks(1, defaultLiquidTokens, defaultLPTokens, defaultLockDuration)
+			gaugeID, gauge, _, startTime := suite.SetupNewGauge(tc.isPerpetual, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+			if tc.noOwner {
+				gauge.Owner = ""
+				suite.Require().NoError(suite.App.IncentivesKeeper.SetGauge(suite.Ctx, gauge))
+			}
+
+			if tc.isActive {
+				suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(time.Second))
+				suite.Require().NoError(suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge))
+				_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+				suite.Require().NoError(err)
+			}
+			if tc.isFinished {
+				gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+				suite.Require().NoError(err)
+				suite.Require().NoError(suite.App.IncentivesKeeper.MoveActiveGaugeToFinishedGauge(suite.Ctx, *gauge))
+			}
+
+			ownerBalanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, defaultGaugeOwner)
+			communityPoolBefore := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+
+			// System under test.
+			refund, err := suite.App.IncentivesKeeper.CancelGauge(suite.Ctx, gaugeID)
+
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedRefund, refund)
+
+			// the gauge is only referenced as finished.
+			suite.Require().Len(suite.App.IncentivesKeeper.GetUpcomingGauges(suite.Ctx), 0)
+			suite.Require().Len(suite.App.IncentivesKeeper.GetActiveGauges(suite.Ctx), 0)
+			suite.Require().Len(suite.App.IncentivesKeeper.GetFinishedGauges(suite.Ctx), 1)
+			suite.Require().Len(suite.App.IncentivesKeeper.GetAllGaugeIDsByDenom(suite.Ctx, defaultLPDenom), 0)
+
+			// the gauge only keeps the coins it has distributed.
+			cancelledGauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(cancelledGauge.DistributedCoins, cancelledGauge.Coins)
+			suite.Require().Equal(cancelledGauge.FilledEpochs, cancelledGauge.NumEpochsPaidOver)
+
+			ownerBalanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, defaultGaugeOwner)
+			communityPoolAfter := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+			if tc.noOwner {
+				suite.Require().Equal(ownerBalanceBefore, ownerBalanceAfter)
+				suite.Require().Equal(sdk.NewDecCoinsFromCoins(tc.expectedRefund...), communityPoolAfter.Sub(communityPoolBefore))
+			} else {
+				suite.Require().Equal(tc.expectedRefund, ownerBalanceAfter.Sub(ownerBalanceBefore))
+				suite.Require().Equal(communityPoolBefore, communityPoolAfter)
+			}
+
+			// a cancelled gauge cannot be cancelled again.
+			_, err = suite.App.IncentivesKeeper.CancelGauge(suite.Ctx, gaugeID)
+			suite.Require().Error(err)
+		})
+	}
+}
+
+// TestCancelGaugeProposal tests that governance can cancel a gauge regardless of its owner.
+func (suite *KeeperTestSuite) TestCancelGaugeProposal() {
+	suite.SetupTest()
+
+	gaugeID, _, coins, _ := suite.SetupNewGauge(false, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	ownerBalanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, defaultGaugeOwner)
+
+	handler := incentives.NewIncentivesProposalHandler(suite.App.IncentivesKeeper)
+	proposal := types.NewCancelGaugeProposal("title", "description", gaugeID)
+	suite.Require().NoError(proposal.ValidateBasic())
+	err := handler(suite.Ctx, &proposal)
+	suite.Require().NoError(err)
+
+	suite.Require().Len(suite.App.IncentivesKeeper.GetUpcomingGauges(suite.Ctx), 0)
+	suite.Require().Len(suite.App.IncentivesKeeper.GetFinishedGauges(suite.Ctx), 1)
+	ownerBalanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, defaultGaugeOwner)
+	suite.Require().Equal(coins, ownerBalanceAfter.Sub(ownerBalanceBefore))
+
+	// a proposal for a gauge that does not exist fails.
+	proposal = types.NewCancelGaugeProposal("title", "description", gaugeID+1)
+	err = handler(suite.Ctx, &proposal)
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestChargeFeeIfSufficientFeeDenomBalance() {
 	const baseFee = int64(100)
 
@@ -332,3 +455,36 @@ func (suite *KeeperTestSuite) TestChargeFeeIfSufficientFeeDenomBalance() {
 		})
 	}
 }
+
+// TestConcentratedLiquidityGaugeCreation tests that NoLock gauges can only be created for existing concentrated liquidity pools.
+func (suite *KeeperTestSuite) TestConcentratedLiquidityGaugeCreation() {
+	suite.SetupTest()
+
+	clPool := suite.PrepareConcentratedPool()
+	balancerPoolId := suite.PrepareBalancerPool()
+	owner := suite.TestAccs[0]
+
+	tests := map[string]struct {
+		denom       string
+		expectError bool
+	}{
+		"concentrated liquidity pool": {denom: types.GetNoLockGaugeDenom(clPool.GetId())},
+		"balancer pool":               {denom: types.GetNoLockGaugeDenom(balancerPoolId), expectError: true},
+		"non-existent pool":           {denom: types.GetNoLockGaugeDenom(clPool.GetId() + 100), expectError: true},
+		"denom without a pool id":     {denom: defaultLPDenom, expectError: true},
+	}
+
+	for name, tc := range tests {
+		distrTo := lockuptypes.QueryCondition{
+			LockQueryType: lockuptypes.NoLock,
+			Denom:         tc.denom,
+		}
+		suite.FundAcc(owner, defaultLiquidTokens)
+		_, err := suite.App.IncentivesKeeper.CreateGauge(suite.Ctx, true, owner, defaultLiquidTokens, distrTo, time.Time{}, 1)
+		if tc.expectError {
+			suite.Require().Error(err, name)
+		} else {
+			suite.Require().NoError(err, name)
+		}
+	}
+}
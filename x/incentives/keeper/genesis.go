@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	"github.com/osmosis-labs/osmosis/v14/x/incentives/types"
 )
 
@@ -18,14 +21,96 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 	}
 	k.SetLastGaugeID(ctx, genState.LastGaugeId)
+	for _, gaugeAccumulator := range genState.GaugeAccumulators {
+		if err := k.initGaugeAccumulator(ctx, gaugeAccumulator); err != nil {
+			panic(err)
+		}
+	}
+	for _, lockRewards := range genState.LockRewards {
+		k.setLockRewards(ctx, lockRewards)
+	}
 }
 
 // ExportGenesis returns the x/incentives module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	gaugeAccumulators, err := k.getGaugeAccumulators(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		Params:            k.GetParams(ctx),
 		LockableDurations: k.GetLockableDurations(ctx),
 		Gauges:            k.GetNotFinishedGauges(ctx),
 		LastGaugeId:       k.GetLastGaugeID(ctx),
+		GaugeAccumulators: gaugeAccumulators,
+		LockRewards:       k.GetAllLockRewards(ctx),
+	}
+}
+
+// initGaugeAccumulator restores the accumulator of a claimable gauge along with its lock positions.
+func (k Keeper) initGaugeAccumulator(ctx sdk.Context, gaugeAccumulator types.GaugeAccumulator) error {
+	accumName := getGaugeAccumulatorName(gaugeAccumulator.GaugeId)
+	content := gaugeAccumulator.Accumulator
+	if err := accum.MakeAccumulatorWithValueAndShare(ctx.KVStore(k.storeKey), accumName, content.AccumValue, content.TotalShares); err != nil {
+		return err
+	}
+
+	accumulator, err := accum.GetAccumulator(ctx.KVStore(k.storeKey), accumName)
+	if err != nil {
+		return err
+	}
+	for _, position := range gaugeAccumulator.Positions {
+		if err := accumulator.SetPositionRecord(formatLockPositionName(position.LockId), position.Position); err != nil {
+			return err
+		}
+		k.setGaugeLockPosition(ctx, gaugeAccumulator.GaugeId, position.LockId)
+	}
+	return nil
+}
+
+// getGaugeAccumulators returns the accumulators of all claimable gauges, including finished ones,
+// since their lock positions may still hold rewards that have not been claimed.
+// Finished gauges are not exported, so their accumulators are found through the lock rewards records.
+func (k Keeper) getGaugeAccumulators(ctx sdk.Context) ([]types.GaugeAccumulator, error) {
+	claimableGauges := map[uint64]bool{}
+	for _, gauge := range k.GetGauges(ctx) {
+		if gauge.IsClaimable {
+			claimableGauges[gauge.Id] = true
+		}
+	}
+	for _, lockRewards := range k.GetAllLockRewards(ctx) {
+		claimableGauges[lockRewards.GaugeId] = true
+	}
+	gaugeIDs := make([]uint64, 0, len(claimableGauges))
+	for gaugeID := range claimableGauges {
+		gaugeIDs = append(gaugeIDs, gaugeID)
+	}
+	sort.Slice(gaugeIDs, func(i, j int) bool { return gaugeIDs[i] < gaugeIDs[j] })
+
+	gaugeAccumulators := []types.GaugeAccumulator{}
+	for _, gaugeID := range gaugeIDs {
+		accumulator, err := k.getGaugeAccumulator(ctx, gaugeID)
+		if err != nil {
+			return nil, err
+		}
+		positions := []types.LockPosition{}
+		for _, lockID := range k.getGaugeLockPositions(ctx, gaugeID) {
+			position, err := accum.GetPosition(accumulator, formatLockPositionName(lockID))
+			if err != nil {
+				return nil, err
+			}
+			positions = append(positions, types.LockPosition{LockId: lockID, Position: position})
+		}
+
+		gaugeAccumulators = append(gaugeAccumulators, types.GaugeAccumulator{
+			GaugeId: gaugeID,
+			Accumulator: accum.AccumulatorContent{
+				AccumValue:  accumulator.GetValue(),
+				TotalShares: accumulator.GetTotalShares(),
+			},
+			Positions: positions,
+		})
 	}
+	return gaugeAccumulators, nil
 }
@@ -174,6 +174,31 @@ func (q Querier) LockableDurations(ctx context.Context, _ *types.QueryLockableDu
 	return &types.QueryLockableDurationsResponse{LockableDurations: q.Keeper.GetLockableDurations(sdkCtx)}, nil
 }
 
+// LockRewards returns the pending and claimed rewards of a lock from claimable gauges.
+func (q Querier) LockRewards(goCtx context.Context, req *types.LockRewardsRequest) (*types.LockRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rewards, err := q.Keeper.GetGaugeLockRewards(ctx, req.LockId)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPendingRewards, totalClaimedRewards := sdk.NewCoins(), sdk.NewCoins()
+	for _, gaugeRewards := range rewards {
+		totalPendingRewards = totalPendingRewards.Add(gaugeRewards.PendingRewards...)
+		totalClaimedRewards = totalClaimedRewards.Add(gaugeRewards.ClaimedRewards...)
+	}
+
+	return &types.LockRewardsResponse{
+		Rewards:             rewards,
+		TotalPendingRewards: totalPendingRewards,
+		TotalClaimedRewards: totalClaimedRewards,
+	}, nil
+}
+
 // getGaugeFromIDJsonBytes returns gauges from the json bytes of gaugeIDs.
 func (q Querier) getGaugeFromIDJsonBytes(ctx sdk.Context, refValue []byte) ([]types.Gauge, error) {
 	gauges := []types.Gauge{}
@@ -44,6 +44,7 @@ func (suite *KeeperTestSuite) TestGRPCGaugeByID() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Gauge.String(), expectedGauge.String())
 }
@@ -77,6 +78,7 @@ func (suite *KeeperTestSuite) TestGRPCGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -124,6 +126,7 @@ func (suite *KeeperTestSuite) TestGRPCActiveGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -178,6 +181,7 @@ func (suite *KeeperTestSuite) TestGRPCActiveGaugesPerDenom() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -234,6 +238,7 @@ func (suite *KeeperTestSuite) TestGRPCUpcomingGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -288,6 +293,7 @@ func (suite *KeeperTestSuite) TestGRPCUpcomingGaugesPerDenom() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.UpcomingGauges[0].String(), expectedGauge.String())
 
@@ -350,6 +356,41 @@ func (suite *KeeperTestSuite) TestGRPCRewardsEst() {
 	suite.Require().Equal(res.Coins, coins)
 }
 
+// TestGRPCRewardsEstByTime tests that rewards estimation of a ByTime gauge only includes locks created before its timestamp.
+func (suite *KeeperTestSuite) TestGRPCRewardsEstByTime() {
+	suite.SetupTest()
+
+	t1 := suite.Ctx.BlockTime().Add(time.Hour)
+	oldLockOwner := sdk.AccAddress([]byte("addr1---------------"))
+	newLockOwner := sdk.AccAddress([]byte("addr2---------------"))
+	suite.LockTokens(oldLockOwner, defaultLPTokens, defaultLockDuration)
+	suite.Ctx = suite.Ctx.WithBlockTime(t1)
+	suite.LockTokens(newLockOwner, defaultLPTokens, defaultLockDuration)
+
+	coins := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}
+	suite.CreateGauge(false, sdk.AccAddress([]byte("Gauge_Creation_Addr_")), coins, lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByTime,
+		Denom:         defaultLPDenom,
+		Timestamp:     t1,
+	}, suite.Ctx.BlockTime(), 2)
+
+	// the old lock is the only lock the gauge is paying out to, so its future rewards equal the entirety of the gauge
+	res, err := suite.querier.RewardsEst(sdk.WrapSDKContext(suite.Ctx), &types.RewardsEstRequest{
+		Owner:    oldLockOwner.String(),
+		EndEpoch: 100,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, res.Coins)
+
+	// the new lock was created at the gauge timestamp, so it is not paid out to
+	res, err = suite.querier.RewardsEst(sdk.WrapSDKContext(suite.Ctx), &types.RewardsEstRequest{
+		Owner:    newLockOwner.String(),
+		EndEpoch: 100,
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Coins.Empty())
+}
+
 // TestRewardsEstWithPoolIncentives tests querying rewards estimation at a future specific time (by epoch) via gRPC returns the correct response.
 // Also changes distribution records for the pool incentives to distribute to the respective lock owner.
 func (suite *KeeperTestSuite) TestRewardsEstWithPoolIncentives() {
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	epochstypes "github.com/osmosis-labs/osmosis/v14/x/epochs/types"
 	"github.com/osmosis-labs/osmosis/v14/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
@@ -58,7 +61,10 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.EpochHooks  = Hooks{}
+	_ lockuptypes.LockupHooks = Hooks{}
+)
 
 // Hooks returns the hook wrapper struct.
 func (k Keeper) Hooks() Hooks {
@@ -74,3 +80,39 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	return h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+// AfterAddTokensToLock is the lockup hook called after tokens are added to a lock.
+func (h Hooks) AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins) {
+}
+
+// OnTokenLocked is the lockup hook called when tokens are locked.
+func (h Hooks) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+}
+
+// OnStartUnlock is the lockup hook called when a lock starts unlocking.
+func (h Hooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+}
+
+// OnTokenUnlocked is the lockup hook called once a lock has matured and its tokens have been returned.
+// Rewards the lock has accrued from claimable gauges are sent to its owner, since the lock can't claim them anymore.
+func (h Hooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return h.k.claimUnlockedLockRewards(ctx, address, lockID)
+	})
+}
+
+// OnTokenSlashed is the lockup hook called when a lock is slashed.
+func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins) {
+}
+
+// OnLockupExtend is the lockup hook called when a lock's duration is extended.
+func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration, newDuration time.Duration) {
+}
+
+// OnLockTransfer is the lockup hook called when a lock is transferred.
+func (h Hooks) OnLockTransfer(ctx sdk.Context, lockID uint64, prevOwner sdk.AccAddress, newOwner sdk.AccAddress) {
+}
+
+// OnLockSplit is the lockup hook called when a lock is split.
+func (h Hooks) OnLockSplit(ctx sdk.Context, lockID uint64, newLockID uint64, amount sdk.Coins) {
+}
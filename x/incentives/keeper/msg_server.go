@@ -42,6 +42,12 @@ func (server msgServer) CreateGauge(goCtx context.Context, msg *types.MsgCreateG
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
+	if msg.IsClaimable {
+		if err := server.keeper.EnableGaugeClaims(ctx, gaugeID); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeEvtCreateGauge,
@@ -78,3 +84,41 @@ func (server msgServer) AddToGauge(goCtx context.Context, msg *types.MsgAddToGau
 
 	return &types.MsgAddToGaugeResponse{}, nil
 }
+
+// ClaimRewards sends the rewards accrued to the owner's locks from claimable gauges to the owner.
+// Emits claim rewards events and returns the claimed rewards.
+func (server msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	claimedRewards, err := server.keeper.ClaimRewards(ctx, owner, msg.LockIds)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgClaimRewardsResponse{ClaimedRewards: claimedRewards}, nil
+}
+
+// CancelGauge cancels a gauge owned by the message sender and refunds its undistributed coins to the sender.
+// Emits cancel gauge event and returns the refunded coins.
+func (server msgServer) CancelGauge(goCtx context.Context, msg *types.MsgCancelGauge) (*types.MsgCancelGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	gauge, err := server.keeper.GetGaugeByID(ctx, msg.GaugeId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if gauge.Owner != msg.Owner {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "gauge %d is not owned by %s", msg.GaugeId, msg.Owner)
+	}
+
+	refundedCoins, err := server.keeper.CancelGauge(ctx, msg.GaugeId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgCancelGaugeResponse{RefundedCoins: refundedCoins}, nil
+}
@@ -239,3 +239,44 @@ func (suite *KeeperTestSuite) TestAddToGauge_Fee() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestCancelGauge_Owner() {
+	tests := []struct {
+		name      string
+		sender    sdk.AccAddress
+		expectErr bool
+	}{
+		{
+			name:   "owner cancels the gauge",
+			sender: defaultGaugeOwner,
+		},
+		{
+			name:      "another account tries to cancel the gauge",
+			sender:    sdk.AccAddress([]byte("addr1---------------")),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.SetupTest()
+
+		ctx := suite.Ctx
+		msgServer := keeper.NewMsgServerImpl(suite.App.IncentivesKeeper)
+		gaugeID, _, coins, _ := suite.SetupNewGauge(false, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+		balanceBefore := suite.App.BankKeeper.GetAllBalances(ctx, tc.sender)
+
+		res, err := msgServer.CancelGauge(sdk.WrapSDKContext(ctx), types.NewMsgCancelGauge(tc.sender, gaugeID))
+
+		balanceAfter := suite.App.BankKeeper.GetAllBalances(ctx, tc.sender)
+		if tc.expectErr {
+			suite.Require().Error(err, "test: %v", tc.name)
+			suite.Require().Equal(balanceBefore, balanceAfter, "test: %v", tc.name)
+			suite.Require().Len(suite.App.IncentivesKeeper.GetUpcomingGauges(ctx), 1, "test: %v", tc.name)
+		} else {
+			suite.Require().NoError(err, "test: %v", tc.name)
+			suite.Require().Equal(coins, res.RefundedCoins, "test: %v", tc.name)
+			suite.Require().Equal(coins, balanceAfter.Sub(balanceBefore), "test: %v", tc.name)
+			suite.Require().Len(suite.App.IncentivesKeeper.GetFinishedGauges(ctx), 1, "test: %v", tc.name)
+		}
+	}
+}
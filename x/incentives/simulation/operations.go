@@ -90,7 +90,7 @@ func genRewardCoins(r *rand.Rand, coins sdk.Coins, fee sdk.Int) (res sdk.Coins)
 
 // genQueryCondition returns a single lockup QueryCondition, which is generated from a single coin randomly selected from the provided coin array
 func genQueryCondition(r *rand.Rand, blocktime time.Time, coins sdk.Coins, durations []time.Duration) lockuptypes.QueryCondition {
-	lockQueryType := 0
+	lockQueryType := r.Intn(2) // ByDuration or ByTime
 	denom := coins[r.Intn(len(coins))].Denom
 	durationIndex := r.Intn(len(durations))
 	duration := durations[durationIndex]
@@ -5,8 +5,11 @@ const (
 	TypeEvtCreateGauge  = "create_gauge"
 	TypeEvtAddToGauge   = "add_to_gauge"
 	TypeEvtDistribution = "distribution"
+	TypeEvtClaimRewards = "claim_rewards"
+	TypeEvtCancelGauge  = "cancel_gauge"
 
 	AttributeGaugeID     = "gauge_id"
+	AttributeLockID      = "lock_id"
 	AttributeLockedDenom = "denom"
 	AttributeReceiver    = "receiver"
 	AttributeAmount      = "amount"
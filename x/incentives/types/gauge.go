@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	time "time"
 
 	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
@@ -15,6 +18,28 @@ var (
 	AddToGaugeFee = sdk.NewInt(25 * 1_000_000)
 )
 
+// NoLockGaugeDenomPrefix is the prefix of the denom of NoLock gauges, followed by the id of the
+// concentrated liquidity pool they distribute to.
+const NoLockGaugeDenomPrefix = "cl/pool/"
+
+// GetNoLockGaugeDenom returns the denom of the NoLock gauges distributing to the given concentrated liquidity pool.
+func GetNoLockGaugeDenom(poolId uint64) string {
+	return fmt.Sprintf("%s%d", NoLockGaugeDenomPrefix, poolId)
+}
+
+// GetPoolIdFromNoLockGaugeDenom returns the id of the concentrated liquidity pool
+// that NoLock gauges of the given denom distribute to.
+func GetPoolIdFromNoLockGaugeDenom(denom string) (uint64, error) {
+	if !strings.HasPrefix(denom, NoLockGaugeDenomPrefix) {
+		return 0, fmt.Errorf("NoLock gauge denom %s does not start with %s", denom, NoLockGaugeDenomPrefix)
+	}
+	poolId, err := strconv.ParseUint(strings.TrimPrefix(denom, NoLockGaugeDenomPrefix), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("NoLock gauge denom %s does not end with a pool id: %w", denom, err)
+	}
+	return poolId, nil
+}
+
 // NewGauge creates a new gauge struct given the required gauge parameters.
 func NewGauge(id uint64, isPerpetual bool, distrTo lockuptypes.QueryCondition, coins sdk.Coins, startTime time.Time, numEpochsPaidOver uint64, filledEpochs uint64, distrCoins sdk.Coins) Gauge {
 	return Gauge{
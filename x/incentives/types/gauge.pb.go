@@ -58,6 +58,14 @@ type Gauge struct {
 	FilledEpochs uint64 `protobuf:"varint,7,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
 	// distributed_coins are coins that have been distributed already
 	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// is_claimable shows if the gauge's rewards accrue to eligible locks in an
+	// accumulator, to be claimed by the lock owners, instead of being sent to
+	// them at every distribution
+	IsClaimable bool `protobuf:"varint,9,opt,name=is_claimable,json=isClaimable,proto3" json:"is_claimable,omitempty"`
+	// owner is the address of the gauge creator, who is allowed to cancel the
+	// gauge. Gauges created before owners were recorded have no owner and can
+	// only be cancelled through governance
+	Owner string `protobuf:"bytes,10,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 }
 
 func (m *Gauge) Reset()         { *m = Gauge{} }
@@ -149,6 +157,84 @@ func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Gauge) GetIsClaimable() bool {
+	if m != nil {
+		return m.IsClaimable
+	}
+	return false
+}
+
+func (m *Gauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// LockRewards tracks the rewards a lock has claimed from a claimable gauge.
+type LockRewards struct {
+	// lock_id is the ID of the lock the rewards are accrued to
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	// gauge_id is the ID of the claimable gauge the rewards come from
+	GaugeId uint64 `protobuf:"varint,2,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+	// claimed_rewards are the rewards that have already been claimed
+	ClaimedRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=claimed_rewards,json=claimedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_rewards"`
+}
+
+func (m *LockRewards) Reset()         { *m = LockRewards{} }
+func (m *LockRewards) String() string { return proto.CompactTextString(m) }
+func (*LockRewards) ProtoMessage()    {}
+func (*LockRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{1}
+}
+func (m *LockRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRewards.Merge(m, src)
+}
+func (m *LockRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRewards proto.InternalMessageInfo
+
+func (m *LockRewards) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *LockRewards) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+func (m *LockRewards) GetClaimedRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimedRewards
+	}
+	return nil
+}
+
 type LockableDurationsInfo struct {
 	// List of incentivised durations that gauges will pay out to
 	LockableDurations []time.Duration `protobuf:"bytes,1,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
@@ -158,7 +244,7 @@ func (m *LockableDurationsInfo) Reset()         { *m = LockableDurationsInfo{} }
 func (m *LockableDurationsInfo) String() string { return proto.CompactTextString(m) }
 func (*LockableDurationsInfo) ProtoMessage()    {}
 func (*LockableDurationsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0304e2bb0159901, []int{1}
+	return fileDescriptor_c0304e2bb0159901, []int{2}
 }
 func (m *LockableDurationsInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,48 +282,55 @@ func (m *LockableDurationsInfo) GetLockableDurations() []time.Duration {
 
 func init() {
 	proto.RegisterType((*Gauge)(nil), "osmosis.incentives.Gauge")
+	proto.RegisterType((*LockRewards)(nil), "osmosis.incentives.LockRewards")
 	proto.RegisterType((*LockableDurationsInfo)(nil), "osmosis.incentives.LockableDurationsInfo")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/gauge.proto", fileDescriptor_c0304e2bb0159901) }
 
 var fileDescriptor_c0304e2bb0159901 = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc7, 0x9b, 0xad, 0x1d, 0xc3, 0xed, 0x10, 0xb5, 0x86, 0x94, 0x56, 0x22, 0x2d, 0x45, 0x48,
-	0xbd, 0xcc, 0xa6, 0x03, 0x71, 0xe0, 0xd8, 0x81, 0xd0, 0x24, 0x24, 0x4a, 0xb5, 0x03, 0xe2, 0x12,
-	0x39, 0x89, 0x9b, 0x59, 0x4d, 0xf2, 0x8b, 0x62, 0xa7, 0x5a, 0xdf, 0x80, 0xe3, 0xc4, 0x89, 0x67,
-	0xe0, 0x49, 0x76, 0xdc, 0x91, 0xd3, 0x86, 0xda, 0x37, 0xe0, 0x09, 0x50, 0xec, 0x44, 0xad, 0xca,
-	0x95, 0x93, 0xe3, 0xdf, 0xf7, 0xf7, 0xef, 0xfb, 0x91, 0x83, 0x1c, 0x90, 0x31, 0x48, 0x21, 0xa9,
-	0x48, 0x7c, 0x9e, 0x28, 0xb1, 0xe0, 0x92, 0x86, 0x2c, 0x0f, 0x39, 0x49, 0x33, 0x50, 0x80, 0x71,
-	0xa9, 0x93, 0x8d, 0xde, 0x3d, 0x0e, 0x21, 0x04, 0x2d, 0xd3, 0xe2, 0xcb, 0x64, 0x76, 0x9d, 0x10,
-	0x20, 0x8c, 0x38, 0xd5, 0x37, 0x2f, 0x9f, 0xd1, 0x20, 0xcf, 0x98, 0x12, 0x90, 0x94, 0x7a, 0x6f,
-	0x57, 0x57, 0x22, 0xe6, 0x52, 0xb1, 0x38, 0xad, 0x1a, 0xf8, 0x7a, 0x16, 0xf5, 0x98, 0xe4, 0x74,
-	0x31, 0xf2, 0xb8, 0x62, 0x23, 0xea, 0x83, 0xa8, 0x1a, 0x74, 0xaa, 0x55, 0x23, 0xf0, 0xe7, 0x79,
-	0xaa, 0x0f, 0x23, 0x0d, 0xbe, 0xd7, 0x51, 0xe3, 0x43, 0xb1, 0x35, 0x7e, 0x84, 0xf6, 0x44, 0x60,
-	0x5b, 0x7d, 0x6b, 0x58, 0x9f, 0xee, 0x89, 0x00, 0x3f, 0x43, 0x2d, 0x21, 0xdd, 0x94, 0x67, 0x29,
-	0x57, 0x39, 0x8b, 0xec, 0xbd, 0xbe, 0x35, 0x3c, 0x9c, 0x36, 0x85, 0x9c, 0x54, 0x21, 0x7c, 0x8e,
-	0x8e, 0x02, 0x21, 0x55, 0x26, 0xbc, 0x5c, 0x71, 0x57, 0x81, 0xbd, 0xdf, 0xb7, 0x86, 0xcd, 0x53,
-	0x87, 0x54, 0xd6, 0xcd, 0x3c, 0xf2, 0x39, 0xe7, 0xd9, 0xf2, 0x0c, 0x92, 0x40, 0x14, 0xae, 0xc6,
-	0xf5, 0x9b, 0xbb, 0x5e, 0x6d, 0xda, 0xda, 0x94, 0x5e, 0x00, 0x66, 0xa8, 0x51, 0x2c, 0x2c, 0xed,
-	0x7a, 0x7f, 0x7f, 0xd8, 0x3c, 0xed, 0x10, 0x63, 0x89, 0x14, 0x96, 0x48, 0x69, 0x89, 0x9c, 0x81,
-	0x48, 0xc6, 0x2f, 0x8b, 0xea, 0x9f, 0xf7, 0xbd, 0x61, 0x28, 0xd4, 0x65, 0xee, 0x11, 0x1f, 0x62,
-	0x5a, 0xfa, 0x37, 0xc7, 0x89, 0x0c, 0xe6, 0x54, 0x2d, 0x53, 0x2e, 0x75, 0x81, 0x9c, 0x9a, 0xce,
-	0xf8, 0x0b, 0x42, 0x52, 0xb1, 0x4c, 0xb9, 0x05, 0x3e, 0xbb, 0xa1, 0x57, 0xed, 0x12, 0xc3, 0x96,
-	0x54, 0x6c, 0xc9, 0x45, 0xc5, 0x76, 0xfc, 0xb4, 0x18, 0xf4, 0xe7, 0xae, 0xd7, 0x5e, 0xb2, 0x38,
-	0x7a, 0x3b, 0xd8, 0xd4, 0x0e, 0xae, 0xef, 0x7b, 0xd6, 0xf4, 0xa1, 0x0e, 0x14, 0xe9, 0x98, 0xa2,
-	0xe3, 0x24, 0x8f, 0x5d, 0x9e, 0x82, 0x7f, 0x29, 0xdd, 0x94, 0x89, 0xc0, 0x85, 0x05, 0xcf, 0xec,
-	0x03, 0x0d, 0xb3, 0x9d, 0xe4, 0xf1, 0x7b, 0x2d, 0x4d, 0x98, 0x08, 0x3e, 0x2d, 0x78, 0x86, 0x9f,
-	0xa3, 0xa3, 0x99, 0x88, 0x22, 0x1e, 0x94, 0x35, 0xf6, 0x03, 0x9d, 0xd9, 0x32, 0x41, 0x93, 0x8c,
-	0xaf, 0x50, 0x7b, 0x83, 0x28, 0x70, 0x0d, 0x9e, 0xc3, 0xff, 0x8f, 0xe7, 0xf1, 0xd6, 0x14, 0x1d,
-	0x19, 0x7c, 0xb3, 0xd0, 0x93, 0x8f, 0xe0, 0xcf, 0x99, 0x17, 0xf1, 0x77, 0xe5, 0x5b, 0x94, 0xe7,
-	0xc9, 0x0c, 0x30, 0x20, 0x1c, 0x95, 0x82, 0x5b, 0xbd, 0x52, 0x69, 0x5b, 0xe5, 0x52, 0xbb, 0x2c,
-	0xab, 0xda, 0xf1, 0x8b, 0x12, 0x65, 0xc7, 0xa0, 0xfc, 0xb7, 0xc5, 0xe0, 0x47, 0x81, 0xb4, 0x1d,
-	0xed, 0x0e, 0x1d, 0x4f, 0x6e, 0x56, 0x8e, 0x75, 0xbb, 0x72, 0xac, 0xdf, 0x2b, 0xc7, 0xba, 0x5e,
-	0x3b, 0xb5, 0xdb, 0xb5, 0x53, 0xfb, 0xb5, 0x76, 0x6a, 0x5f, 0xdf, 0x6c, 0x19, 0x2c, 0xdf, 0xdb,
-	0x49, 0xc4, 0x3c, 0x59, 0x5d, 0xe8, 0x62, 0xf4, 0x9a, 0x5e, 0x6d, 0xff, 0x9d, 0xda, 0xb4, 0x77,
-	0xa0, 0xd7, 0x7b, 0xf5, 0x37, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xc5, 0xb9, 0xbb, 0xc0, 0x03, 0x00,
-	0x00,
+	// 643 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x8d, 0x43, 0xc2, 0xcf, 0x24, 0xf0, 0x91, 0x11, 0x9f, 0xea, 0x20, 0xd5, 0x49, 0x53, 0xb5,
+	0xca, 0x06, 0x4f, 0xa1, 0x55, 0x17, 0x5d, 0x86, 0x56, 0x55, 0xa4, 0x4a, 0xa5, 0x16, 0x8b, 0xaa,
+	0x1b, 0x6b, 0xec, 0x19, 0xcc, 0x08, 0xdb, 0x63, 0x79, 0xc6, 0x01, 0xde, 0xa0, 0x4b, 0x96, 0x7d,
+	0x86, 0xbe, 0x40, 0x5f, 0x81, 0x25, 0xcb, 0x6e, 0x0a, 0x15, 0xbc, 0x01, 0x4f, 0x50, 0xcd, 0x8f,
+	0x1b, 0x44, 0xb7, 0xac, 0x9c, 0xb9, 0x67, 0xee, 0xbd, 0xe7, 0x9c, 0xb9, 0x37, 0xc0, 0xe3, 0x22,
+	0xe3, 0x82, 0x09, 0xc4, 0xf2, 0x98, 0xe6, 0x92, 0xcd, 0xa8, 0x40, 0x09, 0xae, 0x12, 0xea, 0x17,
+	0x25, 0x97, 0x1c, 0x42, 0x8b, 0xfb, 0x73, 0x7c, 0x73, 0x23, 0xe1, 0x09, 0xd7, 0x30, 0x52, 0xbf,
+	0xcc, 0xcd, 0x4d, 0x2f, 0xe1, 0x3c, 0x49, 0x29, 0xd2, 0xa7, 0xa8, 0x3a, 0x40, 0xa4, 0x2a, 0xb1,
+	0x64, 0x3c, 0xb7, 0xf8, 0xe0, 0x3e, 0x2e, 0x59, 0x46, 0x85, 0xc4, 0x59, 0x51, 0x17, 0x88, 0x75,
+	0x2f, 0x14, 0x61, 0x41, 0xd1, 0x6c, 0x3b, 0xa2, 0x12, 0x6f, 0xa3, 0x98, 0xb3, 0xba, 0x40, 0xbf,
+	0xa6, 0x9a, 0xf2, 0xf8, 0xa8, 0x2a, 0xf4, 0xc7, 0x40, 0xa3, 0x5f, 0x2d, 0xd0, 0x7e, 0xaf, 0x58,
+	0xc3, 0x35, 0xd0, 0x64, 0xc4, 0x75, 0x86, 0xce, 0xb8, 0x15, 0x34, 0x19, 0x81, 0x4f, 0x40, 0x97,
+	0x89, 0xb0, 0xa0, 0x65, 0x41, 0x65, 0x85, 0x53, 0xb7, 0x39, 0x74, 0xc6, 0xcb, 0x41, 0x87, 0x89,
+	0xbd, 0x3a, 0x04, 0xa7, 0x60, 0x95, 0x30, 0x21, 0x4b, 0x16, 0x55, 0x92, 0x86, 0x92, 0xbb, 0x0b,
+	0x43, 0x67, 0xdc, 0xd9, 0xf1, 0xfc, 0x5a, 0xba, 0xe9, 0xe7, 0x7f, 0xaa, 0x68, 0x79, 0xba, 0xcb,
+	0x73, 0xc2, 0x94, 0xaa, 0x49, 0xeb, 0xfc, 0x72, 0xd0, 0x08, 0xba, 0xf3, 0xd4, 0x7d, 0x0e, 0x31,
+	0x68, 0x2b, 0xc2, 0xc2, 0x6d, 0x0d, 0x17, 0xc6, 0x9d, 0x9d, 0xbe, 0x6f, 0x24, 0xf9, 0x4a, 0x92,
+	0x6f, 0x25, 0xf9, 0xbb, 0x9c, 0xe5, 0x93, 0x17, 0x2a, 0xfb, 0xfb, 0xd5, 0x60, 0x9c, 0x30, 0x79,
+	0x58, 0x45, 0x7e, 0xcc, 0x33, 0x64, 0xf5, 0x9b, 0xcf, 0x96, 0x20, 0x47, 0x48, 0x9e, 0x16, 0x54,
+	0xe8, 0x04, 0x11, 0x98, 0xca, 0xf0, 0x33, 0x00, 0x42, 0xe2, 0x52, 0x86, 0xca, 0x3e, 0xb7, 0xad,
+	0xa9, 0x6e, 0xfa, 0xc6, 0x5b, 0xbf, 0xf6, 0xd6, 0xdf, 0xaf, 0xbd, 0x9d, 0x3c, 0x56, 0x8d, 0x6e,
+	0x2f, 0x07, 0xbd, 0x53, 0x9c, 0xa5, 0x6f, 0x46, 0xf3, 0xdc, 0xd1, 0xd9, 0xd5, 0xc0, 0x09, 0x56,
+	0x74, 0x40, 0x5d, 0x87, 0x08, 0x6c, 0xe4, 0x55, 0x16, 0xd2, 0x82, 0xc7, 0x87, 0x22, 0x2c, 0x30,
+	0x23, 0x21, 0x9f, 0xd1, 0xd2, 0x5d, 0xd4, 0x66, 0xf6, 0xf2, 0x2a, 0x7b, 0xa7, 0xa1, 0x3d, 0xcc,
+	0xc8, 0xc7, 0x19, 0x2d, 0xe1, 0x53, 0xb0, 0x7a, 0xc0, 0xd2, 0x94, 0x12, 0x9b, 0xe3, 0x2e, 0xe9,
+	0x9b, 0x5d, 0x13, 0x34, 0x97, 0xe1, 0x09, 0xe8, 0xcd, 0x2d, 0x22, 0xa1, 0xb1, 0x67, 0xf9, 0xe1,
+	0xed, 0x59, 0xbf, 0xd3, 0x45, 0x47, 0xec, 0xd3, 0xc7, 0x29, 0x66, 0x19, 0x8e, 0x52, 0xea, 0xae,
+	0xd4, 0x4f, 0xbf, 0x5b, 0x87, 0xe0, 0x73, 0xd0, 0xe6, 0xc7, 0x39, 0x2d, 0x5d, 0x30, 0x74, 0xc6,
+	0x2b, 0x93, 0xf5, 0xdb, 0xcb, 0x41, 0xd7, 0xf8, 0xa4, 0xc3, 0xa3, 0xc0, 0xc0, 0xa3, 0x1f, 0x0e,
+	0xe8, 0x7c, 0xe0, 0xf1, 0x51, 0x40, 0x8f, 0x71, 0x49, 0x04, 0x7c, 0x04, 0x96, 0xd4, 0x50, 0x84,
+	0x7f, 0x47, 0x6d, 0x51, 0x1d, 0xa7, 0x04, 0xf6, 0xc1, 0xb2, 0xde, 0x1e, 0x85, 0x34, 0x35, 0xb2,
+	0xa4, 0xcf, 0x53, 0x02, 0x25, 0xf8, 0x4f, 0x73, 0xa1, 0x24, 0x2c, 0x4d, 0x19, 0x77, 0xe1, 0xe1,
+	0x6d, 0x58, 0xb3, 0x3d, 0x2c, 0xd3, 0xd1, 0x57, 0x07, 0xfc, 0xaf, 0x98, 0x2b, 0xb9, 0x6f, 0xed,
+	0x42, 0x8a, 0x69, 0x7e, 0xc0, 0x21, 0x07, 0x30, 0xb5, 0x40, 0x58, 0xaf, 0xaa, 0x70, 0x1d, 0x4b,
+	0xe9, 0xfe, 0x40, 0xd5, 0xb9, 0x93, 0x67, 0x76, 0x9e, 0xfa, 0xc6, 0xa7, 0x7f, 0x4b, 0x8c, 0xbe,
+	0xa9, 0xb9, 0xea, 0xa5, 0xf7, 0x9b, 0x4e, 0xf6, 0xce, 0xaf, 0x3d, 0xe7, 0xe2, 0xda, 0x73, 0x7e,
+	0x5f, 0x7b, 0xce, 0xd9, 0x8d, 0xd7, 0xb8, 0xb8, 0xf1, 0x1a, 0x3f, 0x6f, 0xbc, 0xc6, 0x97, 0xd7,
+	0x77, 0xe4, 0xd9, 0xa5, 0xdb, 0x4a, 0x71, 0x24, 0xea, 0x03, 0x9a, 0x6d, 0xbf, 0x42, 0x27, 0x77,
+	0xff, 0xa2, 0xb4, 0xe4, 0x68, 0x51, 0xd3, 0x7b, 0xf9, 0x67, 0x00, 0x1c, 0x63, 0xaf, 0xdf, 0xc5,
+	0x04, 0x00, 0x00,
 }
 
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
@@ -260,6 +353,23 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.IsClaimable {
+		i--
+		if m.IsClaimable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.DistributedCoins) > 0 {
 		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -334,6 +444,53 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LockRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimedRewards) > 0 {
+		for iNdEx := len(m.ClaimedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GaugeId != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LockId != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LockableDurationsInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -414,6 +571,34 @@ func (m *Gauge) Size() (n int) {
 			n += 1 + l + sovGauge(uint64(l))
 		}
 	}
+	if m.IsClaimable {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	return n
+}
+
+func (m *LockRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovGauge(uint64(m.LockId))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovGauge(uint64(m.GaugeId))
+	}
+	if len(m.ClaimedRewards) > 0 {
+		for _, e := range m.ClaimedRewards {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -678,6 +863,180 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsClaimable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsClaimable = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimedRewards = append(m.ClaimedRewards, types1.Coin{})
+			if err := m.ClaimedRewards[len(m.ClaimedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGauge(dAtA[iNdEx:])
@@ -9,6 +9,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	accum "github.com/osmosis-labs/osmosis/osmoutils/accum"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -40,6 +41,10 @@ type GenesisState struct {
 	// last_gauge_id is what the gauge number will increment from when creating
 	// the next gauge after genesis
 	LastGaugeId uint64 `protobuf:"varint,4,opt,name=last_gauge_id,json=lastGaugeId,proto3" json:"last_gauge_id,omitempty"`
+	// gauge_accumulators are the reward accumulators of all claimable gauges
+	GaugeAccumulators []GaugeAccumulator `protobuf:"bytes,5,rep,name=gauge_accumulators,json=gaugeAccumulators,proto3" json:"gauge_accumulators"`
+	// lock_rewards are the rewards locks have claimed from claimable gauges
+	LockRewards []LockRewards `protobuf:"bytes,6,rep,name=lock_rewards,json=lockRewards,proto3" json:"lock_rewards"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -103,36 +108,183 @@ func (m *GenesisState) GetLastGaugeId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetGaugeAccumulators() []GaugeAccumulator {
+	if m != nil {
+		return m.GaugeAccumulators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockRewards() []LockRewards {
+	if m != nil {
+		return m.LockRewards
+	}
+	return nil
+}
+
+// GaugeAccumulator is the reward accumulator of a claimable gauge along with
+// the positions of the locks it distributes to
+type GaugeAccumulator struct {
+	// gauge_id is the ID of the claimable gauge
+	GaugeId uint64 `protobuf:"varint,1,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+	// accumulator is the content of the gauge's accumulator
+	Accumulator accum.AccumulatorContent `protobuf:"bytes,2,opt,name=accumulator,proto3" json:"accumulator"`
+	// positions are the lock positions in the accumulator
+	Positions []LockPosition `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions"`
+}
+
+func (m *GaugeAccumulator) Reset()         { *m = GaugeAccumulator{} }
+func (m *GaugeAccumulator) String() string { return proto.CompactTextString(m) }
+func (*GaugeAccumulator) ProtoMessage()    {}
+func (*GaugeAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a288ccc95d977d2d, []int{1}
+}
+func (m *GaugeAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaugeAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaugeAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaugeAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaugeAccumulator.Merge(m, src)
+}
+func (m *GaugeAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaugeAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaugeAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaugeAccumulator proto.InternalMessageInfo
+
+func (m *GaugeAccumulator) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+func (m *GaugeAccumulator) GetAccumulator() accum.AccumulatorContent {
+	if m != nil {
+		return m.Accumulator
+	}
+	return accum.AccumulatorContent{}
+}
+
+func (m *GaugeAccumulator) GetPositions() []LockPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+// LockPosition is the position of a lock in a gauge accumulator
+type LockPosition struct {
+	// lock_id is the ID of the lock
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	// position is the lock's accumulator position record
+	Position accum.Record `protobuf:"bytes,2,opt,name=position,proto3" json:"position"`
+}
+
+func (m *LockPosition) Reset()         { *m = LockPosition{} }
+func (m *LockPosition) String() string { return proto.CompactTextString(m) }
+func (*LockPosition) ProtoMessage()    {}
+func (*LockPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a288ccc95d977d2d, []int{2}
+}
+func (m *LockPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockPosition.Merge(m, src)
+}
+func (m *LockPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockPosition proto.InternalMessageInfo
+
+func (m *LockPosition) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *LockPosition) GetPosition() accum.Record {
+	if m != nil {
+		return m.Position
+	}
+	return accum.Record{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.incentives.GenesisState")
+	proto.RegisterType((*GaugeAccumulator)(nil), "osmosis.incentives.GaugeAccumulator")
+	proto.RegisterType((*LockPosition)(nil), "osmosis.incentives.LockPosition")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/genesis.proto", fileDescriptor_a288ccc95d977d2d) }
 
 var fileDescriptor_a288ccc95d977d2d = []byte{
-	// 342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xfb, 0x30,
-	0x10, 0xc6, 0x93, 0xb6, 0xea, 0x90, 0xfe, 0xff, 0x03, 0x16, 0x43, 0xda, 0xc1, 0x89, 0x22, 0x21,
-	0x75, 0xc1, 0x16, 0x05, 0x01, 0x62, 0xac, 0x90, 0x2a, 0xb6, 0xaa, 0x6c, 0x2c, 0x95, 0xd3, 0x1a,
-	0x63, 0x91, 0xc4, 0x55, 0xcf, 0xa9, 0xe8, 0x5b, 0x30, 0x21, 0x1e, 0xa9, 0x63, 0x47, 0xa6, 0x82,
-	0xda, 0x37, 0xe0, 0x09, 0x50, 0x9c, 0x58, 0x20, 0xb5, 0x9b, 0xcf, 0xdf, 0xef, 0xbe, 0xfb, 0xee,
-	0xbc, 0x50, 0x41, 0xaa, 0x40, 0x02, 0x95, 0xd9, 0x84, 0x67, 0x5a, 0x2e, 0x38, 0x50, 0xc1, 0x33,
-	0x0e, 0x12, 0xc8, 0x6c, 0xae, 0xb4, 0x42, 0xa8, 0x22, 0xc8, 0x2f, 0xd1, 0x39, 0x16, 0x4a, 0x28,
-	0x23, 0xd3, 0xe2, 0x55, 0x92, 0x1d, 0x2c, 0x94, 0x12, 0x09, 0xa7, 0xa6, 0x8a, 0xf3, 0x47, 0x3a,
-	0xcd, 0xe7, 0x4c, 0x4b, 0x95, 0x55, 0x7a, 0x70, 0x60, 0xd6, 0x8c, 0xcd, 0x59, 0x0a, 0xd6, 0xe0,
-	0x50, 0x18, 0x96, 0x0b, 0x5e, 0xea, 0xd1, 0x5b, 0xcd, 0xfb, 0x37, 0x28, 0xc3, 0xdd, 0x6b, 0xa6,
-	0x39, 0xba, 0xf6, 0x9a, 0xa5, 0x81, 0xef, 0x86, 0x6e, 0xb7, 0xd5, 0xeb, 0x90, 0xfd, 0xb0, 0x64,
-	0x68, 0x88, 0x7e, 0x63, 0xb5, 0x09, 0x9c, 0x51, 0xc5, 0xa3, 0x2b, 0xaf, 0x69, 0x9c, 0xc1, 0xaf,
-	0x85, 0xf5, 0x6e, 0xab, 0xd7, 0x3e, 0xd4, 0x39, 0x28, 0x08, 0xdb, 0x58, 0xe2, 0x48, 0x79, 0x28,
-	0x51, 0x93, 0x67, 0x16, 0x27, 0x7c, 0x6c, 0xf7, 0x03, 0xbf, 0x5e, 0x99, 0x94, 0x17, 0x20, 0xf6,
-	0x02, 0xe4, 0xb6, 0x22, 0xfa, 0x27, 0x85, 0xc9, 0xf7, 0x26, 0x68, 0x2f, 0x59, 0x9a, 0xdc, 0x44,
-	0xfb, 0x16, 0xd1, 0xfb, 0x67, 0xe0, 0x8e, 0x8e, 0xac, 0x60, 0x1b, 0x01, 0x45, 0xde, 0xff, 0x84,
-	0x81, 0x1e, 0x9b, 0xf9, 0x63, 0x39, 0xf5, 0x1b, 0xa1, 0xdb, 0x6d, 0x8c, 0x5a, 0xc5, 0xa7, 0x09,
-	0x78, 0x37, 0xed, 0x0f, 0x57, 0x5b, 0xec, 0xae, 0xb7, 0xd8, 0xfd, 0xda, 0x62, 0xf7, 0x75, 0x87,
-	0x9d, 0xf5, 0x0e, 0x3b, 0x1f, 0x3b, 0xec, 0x3c, 0x5c, 0x0a, 0xa9, 0x9f, 0xf2, 0x98, 0x4c, 0x54,
-	0x4a, 0xab, 0x0d, 0x4f, 0x13, 0x16, 0x83, 0x2d, 0xe8, 0xe2, 0xec, 0x82, 0xbe, 0xfc, 0x3d, 0xb8,
-	0x5e, 0xce, 0x38, 0xc4, 0x4d, 0xb3, 0xc2, 0xf9, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x24, 0x40,
-	0x07, 0x4c, 0x20, 0x02, 0x00, 0x00,
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x6f, 0xd6, 0xd2, 0x0d, 0xa7, 0x48, 0xcc, 0x42, 0x22, 0xad, 0x44, 0x12, 0x22, 0x90, 0xca,
+	0x81, 0x44, 0x1d, 0x08, 0x10, 0x17, 0x44, 0x99, 0x34, 0x26, 0x71, 0x28, 0xe1, 0x04, 0x97, 0xca,
+	0x49, 0x8c, 0x17, 0x2d, 0x89, 0xab, 0xd8, 0x29, 0xec, 0x2d, 0x38, 0xf2, 0x2e, 0xdc, 0xd1, 0x8e,
+	0x3b, 0x72, 0x1a, 0xa8, 0x7d, 0x03, 0x9e, 0x60, 0x8a, 0xff, 0xb4, 0xd1, 0xd6, 0xde, 0xf2, 0xf9,
+	0xfb, 0x7d, 0xbf, 0x3f, 0x9f, 0x1d, 0xe0, 0x52, 0x96, 0x53, 0x96, 0xb2, 0x20, 0x2d, 0x62, 0x5c,
+	0xf0, 0x74, 0x8e, 0x59, 0x40, 0x70, 0x81, 0x59, 0xca, 0xfc, 0x59, 0x49, 0x39, 0x85, 0x50, 0x21,
+	0xfc, 0x35, 0x62, 0x70, 0x8f, 0x50, 0x42, 0x45, 0x3b, 0xa8, 0xbf, 0x24, 0x72, 0x60, 0x13, 0x4a,
+	0x49, 0x86, 0x03, 0x51, 0x45, 0xd5, 0xd7, 0x20, 0xa9, 0x4a, 0xc4, 0x53, 0x5a, 0xa8, 0xbe, 0xb3,
+	0x41, 0x6b, 0x86, 0x4a, 0x94, 0x33, 0x4d, 0xb0, 0xc9, 0x0c, 0xaa, 0x08, 0x56, 0xfd, 0x87, 0xba,
+	0x8f, 0xe2, 0xb8, 0xca, 0x83, 0xf9, 0x28, 0xc2, 0x1c, 0x8d, 0x64, 0x25, 0x21, 0xde, 0xaf, 0x36,
+	0xe8, 0x1d, 0x49, 0xff, 0x9f, 0x38, 0xe2, 0x18, 0xbe, 0x02, 0x5d, 0xa9, 0x61, 0x19, 0xae, 0x31,
+	0x34, 0x0f, 0x06, 0xfe, 0xcd, 0x3c, 0xfe, 0x44, 0x20, 0xc6, 0x9d, 0xf3, 0x4b, 0xa7, 0x15, 0x2a,
+	0x3c, 0x7c, 0x09, 0xba, 0x42, 0x9c, 0x59, 0x3b, 0x6e, 0x7b, 0x68, 0x1e, 0xf4, 0x37, 0x4d, 0x1e,
+	0xd5, 0x08, 0x3d, 0x28, 0xe1, 0x90, 0x02, 0x98, 0xd1, 0xf8, 0x14, 0x45, 0x19, 0x9e, 0xea, 0x15,
+	0x30, 0xab, 0xad, 0x48, 0xe4, 0x92, 0x7c, 0xbd, 0x24, 0xff, 0x50, 0x21, 0xc6, 0x8f, 0x6b, 0x92,
+	0xff, 0x97, 0x4e, 0xff, 0x0c, 0xe5, 0xd9, 0x6b, 0xef, 0x26, 0x85, 0xf7, 0xf3, 0xaf, 0x63, 0x84,
+	0xfb, 0xba, 0xa1, 0x07, 0x19, 0xf4, 0xc0, 0x9d, 0x0c, 0x31, 0x3e, 0x15, 0xfa, 0xd3, 0x34, 0xb1,
+	0x3a, 0xae, 0x31, 0xec, 0x84, 0x66, 0x7d, 0x28, 0x0c, 0x1e, 0x27, 0xf0, 0x33, 0x80, 0xb2, 0x2d,
+	0xb6, 0x55, 0x65, 0x88, 0xd3, 0x92, 0x59, 0xb7, 0x84, 0xa9, 0x47, 0x5b, 0x93, 0xbd, 0x5d, 0x83,
+	0x55, 0xc8, 0x7d, 0x72, 0xed, 0x9c, 0xc1, 0xf7, 0xa0, 0x57, 0x7b, 0x9a, 0x96, 0xf8, 0x1b, 0x2a,
+	0x13, 0x66, 0x75, 0x05, 0xa9, 0xb3, 0x89, 0xf4, 0x03, 0x8d, 0x4f, 0x43, 0x09, 0x53, 0x7c, 0x66,
+	0xb6, 0x3e, 0xf2, 0x7e, 0x1b, 0xe0, 0xee, 0x75, 0x5d, 0xd8, 0x07, 0x7b, 0xab, 0x60, 0x86, 0x08,
+	0xb6, 0x4b, 0x54, 0xa8, 0x8f, 0xc0, 0x6c, 0xc4, 0xb1, 0x76, 0xc4, 0x0d, 0x3f, 0x59, 0x09, 0x8b,
+	0x9e, 0xaf, 0x9e, 0x89, 0xdf, 0xe0, 0x7c, 0x47, 0x0b, 0x8e, 0x0b, 0xae, 0x2d, 0x34, 0x38, 0xe0,
+	0x21, 0xb8, 0x3d, 0xa3, 0x2c, 0x6d, 0xde, 0x99, 0xbb, 0x2d, 0xc9, 0x44, 0x01, 0x15, 0xcf, 0x7a,
+	0xd0, 0x3b, 0x01, 0xbd, 0x26, 0x00, 0xde, 0x07, 0xbb, 0x62, 0x45, 0xab, 0x08, 0xdd, 0xba, 0x3c,
+	0x4e, 0xe0, 0x1b, 0xb0, 0xa7, 0xa7, 0x94, 0xfd, 0x07, 0x5b, 0xec, 0x87, 0x38, 0xa6, 0x65, 0xa2,
+	0xa4, 0x56, 0x43, 0xe3, 0xc9, 0xf9, 0xc2, 0x36, 0x2e, 0x16, 0xb6, 0xf1, 0x6f, 0x61, 0x1b, 0x3f,
+	0x96, 0x76, 0xeb, 0x62, 0x69, 0xb7, 0xfe, 0x2c, 0xed, 0xd6, 0x97, 0x17, 0x24, 0xe5, 0x27, 0x55,
+	0xe4, 0xc7, 0x34, 0x0f, 0x14, 0xe5, 0xd3, 0x0c, 0x45, 0x4c, 0x17, 0xc1, 0x7c, 0xf4, 0x3c, 0xf8,
+	0xde, 0xfc, 0xd7, 0xf8, 0xd9, 0x0c, 0xb3, 0xa8, 0x2b, 0x9e, 0xe6, 0xb3, 0xab, 0x01, 0x00, 0xa7,
+	0xf0, 0xf4, 0xa8, 0x1b, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -155,6 +307,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockRewards) > 0 {
+		for iNdEx := len(m.LockRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.GaugeAccumulators) > 0 {
+		for iNdEx := len(m.GaugeAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GaugeAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.LastGaugeId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastGaugeId))
 		i--
@@ -199,6 +379,96 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GaugeAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaugeAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaugeAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Accumulator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.GaugeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.LockId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -233,6 +503,52 @@ func (m *GenesisState) Size() (n int) {
 	if m.LastGaugeId != 0 {
 		n += 1 + sovGenesis(uint64(m.LastGaugeId))
 	}
+	if len(m.GaugeAccumulators) > 0 {
+		for _, e := range m.GaugeAccumulators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockRewards) > 0 {
+		for _, e := range m.LockRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GaugeAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovGenesis(uint64(m.GaugeId))
+	}
+	l = m.Accumulator.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovGenesis(uint64(m.LockId))
+	}
+	l = m.Position.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -391,6 +707,312 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GaugeAccumulators = append(m.GaugeAccumulators, GaugeAccumulator{})
+			if err := m.GaugeAccumulators[len(m.GaugeAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockRewards = append(m.LockRewards, LockRewards{})
+			if err := m.LockRewards[len(m.LockRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugeAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, LockPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
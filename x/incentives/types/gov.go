@@ -0,0 +1,106 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeCancelGauge       = "CancelGauge"
+	ProposalTypeEnableGaugeClaims = "EnableGaugeClaims"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCancelGauge)
+	govtypes.RegisterProposalTypeCodec(&CancelGaugeProposal{}, "osmosis/CancelGaugeProposal")
+	govtypes.RegisterProposalType(ProposalTypeEnableGaugeClaims)
+	govtypes.RegisterProposalTypeCodec(&EnableGaugeClaimsProposal{}, "osmosis/EnableGaugeClaimsProposal")
+}
+
+var (
+	_ govtypes.Content = &CancelGaugeProposal{}
+	_ govtypes.Content = &EnableGaugeClaimsProposal{}
+)
+
+// NewCancelGaugeProposal returns a new proposal to cancel the gauge with the provided ID.
+func NewCancelGaugeProposal(title, description string, gaugeId uint64) CancelGaugeProposal {
+	return CancelGaugeProposal{
+		Title:       title,
+		Description: description,
+		GaugeId:     gaugeId,
+	}
+}
+
+func (p *CancelGaugeProposal) GetTitle() string { return p.Title }
+
+func (p *CancelGaugeProposal) GetDescription() string { return p.Description }
+
+func (p *CancelGaugeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *CancelGaugeProposal) ProposalType() string { return ProposalTypeCancelGauge }
+
+func (p *CancelGaugeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.GaugeId == 0 {
+		return errors.New("gauge id should be positive")
+	}
+
+	return nil
+}
+
+func (p CancelGaugeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Gauge Proposal:
+  Title:       %s
+  Description: %s
+  Gauge ID:    %d
+`, p.Title, p.Description, p.GaugeId))
+	return b.String()
+}
+
+// NewEnableGaugeClaimsProposal returns a new proposal to make the rewards of the gauge with the provided ID claimable.
+func NewEnableGaugeClaimsProposal(title, description string, gaugeId uint64) EnableGaugeClaimsProposal {
+	return EnableGaugeClaimsProposal{
+		Title:       title,
+		Description: description,
+		GaugeId:     gaugeId,
+	}
+}
+
+func (p *EnableGaugeClaimsProposal) GetTitle() string { return p.Title }
+
+func (p *EnableGaugeClaimsProposal) GetDescription() string { return p.Description }
+
+func (p *EnableGaugeClaimsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *EnableGaugeClaimsProposal) ProposalType() string { return ProposalTypeEnableGaugeClaims }
+
+func (p *EnableGaugeClaimsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.GaugeId == 0 {
+		return errors.New("gauge id should be positive")
+	}
+
+	return nil
+}
+
+func (p EnableGaugeClaimsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Enable Gauge Claims Proposal:
+  Title:       %s
+  Description: %s
+  Gauge ID:    %d
+`, p.Title, p.Description, p.GaugeId))
+	return b.String()
+}
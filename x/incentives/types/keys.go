@@ -43,6 +43,12 @@ var (
 	// KeyIndexSeparator defines key for merging bytes.
 	KeyIndexSeparator = []byte{0x07}
 
+	// KeyPrefixLockRewards defines prefix key for storing the rewards locks have claimed from claimable gauges.
+	KeyPrefixLockRewards = []byte{0x08}
+
+	// KeyPrefixGaugeLockPositions defines prefix key for storing reference keys from claimable gauges to the locks with a position in them.
+	KeyPrefixGaugeLockPositions = []byte{0x09}
+
 	// LockableDurationsKey defines key for storing valid durations for giving incentives.
 	LockableDurationsKey = []byte("lockable_durations")
 )
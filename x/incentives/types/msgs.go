@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
@@ -10,8 +11,10 @@ import (
 )
 
 const (
-	TypeMsgCreateGauge = "create_gauge"
-	TypeMsgAddToGauge  = "add_to_gauge"
+	TypeMsgCreateGauge  = "create_gauge"
+	TypeMsgAddToGauge   = "add_to_gauge"
+	TypeMsgClaimRewards = "claim_rewards"
+	TypeMsgCancelGauge  = "cancel_gauge"
 )
 
 var _ sdk.Msg = &MsgCreateGauge{}
@@ -55,8 +58,24 @@ func (m MsgCreateGauge) ValidateBasic() error {
 		return errors.New("distribution period should be 1 epoch for perpetual gauge")
 	}
 
-	if lockuptypes.LockQueryType_name[int32(m.DistributeTo.LockQueryType)] != "ByDuration" {
-		return errors.New("only duration query condition is allowed. Start time distr conditions is an obsolete codepath slated for deletion")
+	if m.DistributeTo.LockQueryType == lockuptypes.ByTime {
+		if m.DistributeTo.Timestamp.Equal(time.Time{}) {
+			return errors.New("timestamp should be set for time query condition")
+		}
+		if lockuptypes.IsSyntheticDenom(m.DistributeTo.Denom) {
+			return errors.New("time query condition is not allowed for synthetic denoms")
+		}
+	}
+	if m.IsClaimable && lockuptypes.IsSyntheticDenom(m.DistributeTo.Denom) {
+		return errors.New("claimable gauges are not allowed for synthetic denoms")
+	}
+	if m.DistributeTo.LockQueryType == lockuptypes.NoLock {
+		if _, err := GetPoolIdFromNoLockGaugeDenom(m.DistributeTo.Denom); err != nil {
+			return err
+		}
+		if m.IsClaimable {
+			return errors.New("claimable gauges are not allowed for no lock query condition")
+		}
 	}
 
 	return nil
@@ -112,3 +131,85 @@ func (m MsgAddToGauge) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgClaimRewards{}
+
+// NewMsgClaimRewards creates a message to claim the rewards accrued to locks from claimable gauges.
+func NewMsgClaimRewards(owner sdk.AccAddress, lockIds []uint64) *MsgClaimRewards {
+	return &MsgClaimRewards{
+		Owner:   owner.String(),
+		LockIds: lockIds,
+	}
+}
+
+// Route takes a claim rewards message, then returns the RouterKey used for slashing.
+func (m MsgClaimRewards) Route() string { return RouterKey }
+
+// Type takes a claim rewards message, then returns a claim rewards message type.
+func (m MsgClaimRewards) Type() string { return TypeMsgClaimRewards }
+
+// ValidateBasic checks that the claim rewards message is valid.
+func (m MsgClaimRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return fmt.Errorf("invalid owner address (%s)", err)
+	}
+	seenLockIds := make(map[uint64]bool, len(m.LockIds))
+	for _, lockId := range m.LockIds {
+		if seenLockIds[lockId] {
+			return fmt.Errorf("duplicate lock id %d", lockId)
+		}
+		seenLockIds[lockId] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes takes a claim rewards message and turns it into a byte array.
+func (m MsgClaimRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a claim rewards message and returns the owner in a byte array.
+func (m MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgCancelGauge{}
+
+// NewMsgCancelGauge creates a message to cancel the gauge with the provided ID.
+func NewMsgCancelGauge(owner sdk.AccAddress, gaugeId uint64) *MsgCancelGauge {
+	return &MsgCancelGauge{
+		Owner:   owner.String(),
+		GaugeId: gaugeId,
+	}
+}
+
+// Route takes a cancel gauge message, then returns the RouterKey used for slashing.
+func (m MsgCancelGauge) Route() string { return RouterKey }
+
+// Type takes a cancel gauge message, then returns a cancel gauge message type.
+func (m MsgCancelGauge) Type() string { return TypeMsgCancelGauge }
+
+// ValidateBasic checks that the cancel gauge message is valid.
+func (m MsgCancelGauge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return fmt.Errorf("invalid owner address (%s)", err)
+	}
+	if m.GaugeId == 0 {
+		return errors.New("gauge id should be positive")
+	}
+
+	return nil
+}
+
+// GetSignBytes takes a cancel gauge message and turns it into a byte array.
+func (m MsgCancelGauge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a cancel gauge message and returns the owner in a byte array.
+func (m MsgCancelGauge) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
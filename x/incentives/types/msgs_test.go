@@ -139,6 +139,77 @@ func TestMsgCreatePool(t *testing.T) {
 			}),
 			expectPass: true,
 		},
+		{
+			name: "valid time query condition",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				msg.DistributeTo.Timestamp = time.Now()
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "time query condition without timestamp",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "time query condition with synthetic denom",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				msg.DistributeTo.Denom = "lptoken/superbonding"
+				msg.DistributeTo.Timestamp = time.Now()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "valid claimable gauge",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.IsClaimable = true
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "claimable gauge with synthetic denom",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.IsClaimable = true
+				msg.DistributeTo.Denom = "lptoken/superbonding"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "valid no lock query condition",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				msg.DistributeTo.Denom = incentivestypes.GetNoLockGaugeDenom(1)
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "no lock query condition without pool id in denom",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "claimable gauge with no lock query condition",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.IsClaimable = true
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				msg.DistributeTo.Denom = incentivestypes.GetNoLockGaugeDenom(1)
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -216,7 +287,138 @@ func TestMsgAddToGauge(t *testing.T) {
 	}
 }
 
+// TestMsgClaimRewards tests if valid/invalid claim rewards messages are properly validated/invalidated
+func TestMsgClaimRewards(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper claimRewards message
+	createMsg := func(after func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+		properMsg := *incentivestypes.NewMsgClaimRewards(addr1, []uint64{1, 2})
+
+		return after(properMsg)
+	}
+
+	// validate claimRewards message was created as intended
+	msg := createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+		return msg
+	})
+	require.Equal(t, msg.Route(), incentivestypes.RouterKey)
+	require.Equal(t, msg.Type(), "claim_rewards")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        incentivestypes.MsgClaimRewards
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "no lock ids",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				msg.LockIds = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				msg.Owner = "invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate lock ids",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				msg.LockIds = []uint64{1, 2, 1}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 // // Test authz serialize and de-serializes for incentives msg.
+func TestMsgCancelGauge(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper cancelGauge message
+	createMsg := func(after func(msg incentivestypes.MsgCancelGauge) incentivestypes.MsgCancelGauge) incentivestypes.MsgCancelGauge {
+		properMsg := *incentivestypes.NewMsgCancelGauge(addr1, 1)
+
+		return after(properMsg)
+	}
+
+	// validate cancelGauge message was created as intended
+	msg := createMsg(func(msg incentivestypes.MsgCancelGauge) incentivestypes.MsgCancelGauge {
+		return msg
+	})
+	require.Equal(t, msg.Route(), incentivestypes.RouterKey)
+	require.Equal(t, msg.Type(), "cancel_gauge")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        incentivestypes.MsgCancelGauge
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg incentivestypes.MsgCancelGauge) incentivestypes.MsgCancelGauge {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: createMsg(func(msg incentivestypes.MsgCancelGauge) incentivestypes.MsgCancelGauge {
+				msg.Owner = "invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero gauge id",
+			msg: createMsg(func(msg incentivestypes.MsgCancelGauge) incentivestypes.MsgCancelGauge {
+				msg.GaugeId = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestAuthzMsg(t *testing.T) {
 	appParams.SetAddressPrefixes()
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -256,6 +458,20 @@ func TestAuthzMsg(t *testing.T) {
 				NumEpochsPaidOver: 1,
 			},
 		},
+		{
+			name: "MsgClaimRewards",
+			incentivesMsg: &incentivestypes.MsgClaimRewards{
+				Owner:   addr1,
+				LockIds: []uint64{1},
+			},
+		},
+		{
+			name: "MsgCancelGauge",
+			incentivesMsg: &incentivestypes.MsgCancelGauge{
+				Owner:   addr1,
+				GaugeId: 1,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
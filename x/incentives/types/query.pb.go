@@ -912,6 +912,178 @@ func (m *QueryLockableDurationsResponse) GetLockableDurations() []time.Duration
 	return nil
 }
 
+type LockRewardsRequest struct {
+	// ID of the lock being queried
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+}
+
+func (m *LockRewardsRequest) Reset()         { *m = LockRewardsRequest{} }
+func (m *LockRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*LockRewardsRequest) ProtoMessage()    {}
+func (*LockRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{18}
+}
+func (m *LockRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRewardsRequest.Merge(m, src)
+}
+func (m *LockRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRewardsRequest proto.InternalMessageInfo
+
+func (m *LockRewardsRequest) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+type LockRewardsResponse struct {
+	// Rewards of the lock per claimable gauge
+	Rewards []GaugeLockRewards `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// Rewards that can currently be claimed, summed over all gauges
+	TotalPendingRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_pending_rewards,json=totalPendingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_pending_rewards"`
+	// Rewards that have already been claimed, summed over all gauges
+	TotalClaimedRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_claimed_rewards,json=totalClaimedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_claimed_rewards"`
+}
+
+func (m *LockRewardsResponse) Reset()         { *m = LockRewardsResponse{} }
+func (m *LockRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*LockRewardsResponse) ProtoMessage()    {}
+func (*LockRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{19}
+}
+func (m *LockRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRewardsResponse.Merge(m, src)
+}
+func (m *LockRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRewardsResponse proto.InternalMessageInfo
+
+func (m *LockRewardsResponse) GetRewards() []GaugeLockRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *LockRewardsResponse) GetTotalPendingRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalPendingRewards
+	}
+	return nil
+}
+
+func (m *LockRewardsResponse) GetTotalClaimedRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalClaimedRewards
+	}
+	return nil
+}
+
+// GaugeLockRewards are the rewards a lock has accrued from a claimable gauge
+type GaugeLockRewards struct {
+	// ID of the claimable gauge
+	GaugeId uint64 `protobuf:"varint,1,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+	// Rewards that can currently be claimed
+	PendingRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=pending_rewards,json=pendingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending_rewards"`
+	// Rewards that have already been claimed
+	ClaimedRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=claimed_rewards,json=claimedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_rewards"`
+}
+
+func (m *GaugeLockRewards) Reset()         { *m = GaugeLockRewards{} }
+func (m *GaugeLockRewards) String() string { return proto.CompactTextString(m) }
+func (*GaugeLockRewards) ProtoMessage()    {}
+func (*GaugeLockRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{20}
+}
+func (m *GaugeLockRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaugeLockRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaugeLockRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaugeLockRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaugeLockRewards.Merge(m, src)
+}
+func (m *GaugeLockRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaugeLockRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaugeLockRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaugeLockRewards proto.InternalMessageInfo
+
+func (m *GaugeLockRewards) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+func (m *GaugeLockRewards) GetPendingRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PendingRewards
+	}
+	return nil
+}
+
+func (m *GaugeLockRewards) GetClaimedRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimedRewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ModuleToDistributeCoinsRequest)(nil), "osmosis.incentives.ModuleToDistributeCoinsRequest")
 	proto.RegisterType((*ModuleToDistributeCoinsResponse)(nil), "osmosis.incentives.ModuleToDistributeCoinsResponse")
@@ -931,79 +1103,91 @@ func init() {
 	proto.RegisterType((*RewardsEstResponse)(nil), "osmosis.incentives.RewardsEstResponse")
 	proto.RegisterType((*QueryLockableDurationsRequest)(nil), "osmosis.incentives.QueryLockableDurationsRequest")
 	proto.RegisterType((*QueryLockableDurationsResponse)(nil), "osmosis.incentives.QueryLockableDurationsResponse")
+	proto.RegisterType((*LockRewardsRequest)(nil), "osmosis.incentives.LockRewardsRequest")
+	proto.RegisterType((*LockRewardsResponse)(nil), "osmosis.incentives.LockRewardsResponse")
+	proto.RegisterType((*GaugeLockRewards)(nil), "osmosis.incentives.GaugeLockRewards")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/query.proto", fileDescriptor_8124258a89427f98) }
 
 var fileDescriptor_8124258a89427f98 = []byte{
-	// 1058 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x4e, 0x52, 0x9a, 0xd7, 0x12, 0x9a, 0x21, 0x40, 0xe2, 0xb6, 0x6b, 0xb3, 0x6a,
-	0x53, 0x37, 0x25, 0x3b, 0x71, 0xdc, 0xa6, 0x08, 0x04, 0x12, 0xc6, 0xa5, 0x54, 0x02, 0x29, 0xac,
-	0x40, 0x48, 0x48, 0x68, 0xb5, 0xf6, 0x0e, 0xdb, 0x55, 0xec, 0x1d, 0x77, 0x67, 0x37, 0xc1, 0x8a,
-	0x72, 0x41, 0x9c, 0x2b, 0x10, 0x11, 0xe2, 0xd0, 0xbf, 0x80, 0x23, 0x48, 0x1c, 0x39, 0x70, 0xea,
-	0xb1, 0x12, 0x12, 0xe2, 0x94, 0xa2, 0x84, 0xbf, 0xa0, 0x7f, 0x01, 0xda, 0xd9, 0x59, 0x7b, 0x6d,
-	0xaf, 0xd7, 0x09, 0x6a, 0xab, 0x9c, 0x9c, 0xc9, 0xfb, 0xf5, 0x79, 0x5f, 0x8f, 0xdf, 0x1b, 0x50,
-	0x18, 0x6f, 0x31, 0xee, 0x70, 0xe2, 0xb8, 0x0d, 0xea, 0xfa, 0xce, 0x16, 0xe5, 0xe4, 0x5e, 0x40,
-	0xbd, 0x8e, 0xd6, 0xf6, 0x98, 0xcf, 0x30, 0x96, 0x76, 0xad, 0x67, 0xcf, 0xcf, 0xdb, 0xcc, 0x66,
-	0xc2, 0x4c, 0xc2, 0xbf, 0x22, 0xcf, 0xfc, 0x05, 0x9b, 0x31, 0xbb, 0x49, 0x89, 0xd9, 0x76, 0x88,
-	0xe9, 0xba, 0xcc, 0x37, 0x7d, 0x87, 0xb9, 0x5c, 0x5a, 0x15, 0x69, 0x15, 0xa7, 0x7a, 0xf0, 0x15,
-	0xb1, 0x02, 0x4f, 0x38, 0xc4, 0xf6, 0x86, 0x28, 0x44, 0xea, 0x26, 0xa7, 0x64, 0xab, 0x5c, 0xa7,
-	0xbe, 0x59, 0x26, 0x0d, 0xe6, 0xc4, 0xf6, 0xe5, 0xa4, 0x5d, 0x00, 0x76, 0xbd, 0xda, 0xa6, 0xed,
-	0xb8, 0x7d, 0xb9, 0x52, 0x7a, 0xb2, 0xcd, 0xc0, 0xa6, 0xd2, 0xbe, 0x18, 0xdb, 0x9b, 0xac, 0xb1,
-	0x19, 0xb4, 0xc5, 0x47, 0x64, 0x52, 0x8b, 0xa0, 0x7c, 0xcc, 0xac, 0xa0, 0x49, 0x3f, 0x65, 0x35,
-	0x87, 0xfb, 0x9e, 0x53, 0x0f, 0x7c, 0xfa, 0x3e, 0x73, 0x5c, 0xae, 0xd3, 0x7b, 0x01, 0xe5, 0xbe,
-	0xfa, 0x2d, 0x82, 0xc2, 0x48, 0x17, 0xde, 0x66, 0x2e, 0xa7, 0xd8, 0x84, 0xe9, 0x10, 0x9d, 0x2f,
-	0xa0, 0xe2, 0x64, 0xe9, 0xcc, 0xda, 0xa2, 0x16, 0xc1, 0x6b, 0x21, 0xbc, 0x26, 0xb1, 0xb5, 0x30,
-	0xa4, 0xba, 0xfa, 0x70, 0xbf, 0x30, 0xf1, 0xf3, 0xe3, 0x42, 0xc9, 0x76, 0xfc, 0xbb, 0x41, 0x5d,
-	0x6b, 0xb0, 0x16, 0x91, 0x9d, 0x46, 0x1f, 0x2b, 0xdc, 0xda, 0x24, 0x7e, 0xa7, 0x4d, 0xb9, 0x16,
-	0xd5, 0x88, 0x32, 0xab, 0x2a, 0x9c, 0xbb, 0x1d, 0xb6, 0x54, 0xed, 0xdc, 0xa9, 0x49, 0x34, 0x3c,
-	0x0b, 0x39, 0xc7, 0x5a, 0x40, 0x45, 0x54, 0x9a, 0xd2, 0x73, 0x8e, 0xa5, 0xd6, 0x60, 0x2e, 0xe1,
-	0x23, 0xd9, 0x08, 0x4c, 0x0b, 0x2d, 0x84, 0x5f, 0xc8, 0x36, 0xfc, 0x05, 0x6b, 0x22, 0x4a, 0x8f,
-	0xfc, 0xd4, 0xcf, 0xe1, 0x45, 0x71, 0x8e, 0x15, 0xc0, 0x1f, 0x00, 0xf4, 0x24, 0x97, 0x69, 0x96,
-	0xfa, 0x5a, 0x8c, 0x2e, 0x50, 0xdc, 0xe8, 0x86, 0x69, 0x53, 0x19, 0xab, 0x27, 0x22, 0xd5, 0xfb,
-	0x08, 0x66, 0xe3, 0xcc, 0x12, 0xae, 0x02, 0x53, 0x96, 0xe9, 0x9b, 0x5d, 0xdd, 0x46, 0xb1, 0x55,
-	0xa7, 0x42, 0xdd, 0x74, 0xe1, 0x8c, 0x6f, 0xf7, 0xf1, 0xe4, 0x04, 0xcf, 0x95, 0xb1, 0x3c, 0x51,
-	0xc5, 0x3e, 0xa0, 0x2f, 0xe1, 0xe5, 0xf7, 0x1a, 0x61, 0x95, 0x67, 0xd3, 0xef, 0x1e, 0x82, 0xf9,
-	0xfe, 0xfc, 0x27, 0xa2, 0xeb, 0x1d, 0x38, 0x9f, 0xa4, 0xda, 0xa0, 0x5e, 0x8d, 0xba, 0xac, 0x15,
-	0x77, 0x3f, 0x0f, 0xd3, 0x56, 0x78, 0x16, 0x8d, 0xcf, 0xe8, 0xd1, 0x61, 0x40, 0x93, 0xdc, 0xff,
-	0xd6, 0xe4, 0x01, 0x82, 0x0b, 0xe9, 0xd5, 0x4f, 0x84, 0x36, 0x06, 0xbc, 0xf2, 0x59, 0xbb, 0xc1,
-	0x5a, 0x8e, 0x6b, 0x3f, 0x9b, 0x3b, 0xf1, 0x23, 0x82, 0x57, 0x07, 0x2b, 0x9c, 0x88, 0xce, 0x77,
-	0xe1, 0x62, 0x3f, 0xd7, 0xf3, 0xbd, 0x17, 0xbf, 0x22, 0x50, 0x46, 0xd5, 0x97, 0xfa, 0x7c, 0x08,
-	0x2f, 0x05, 0xd2, 0xc3, 0x10, 0x93, 0x8a, 0x1f, 0x55, 0xaa, 0xd9, 0xa0, 0x2f, 0xf3, 0xd3, 0x13,
-	0x8d, 0xc3, 0x9c, 0x4e, 0xb7, 0x4d, 0xcf, 0xe2, 0xb7, 0xb8, 0x1f, 0x0b, 0xb5, 0x04, 0xd3, 0x6c,
-	0xdb, 0xa5, 0x5e, 0x24, 0x54, 0xf5, 0xdc, 0x93, 0xfd, 0xc2, 0xd9, 0x8e, 0xd9, 0x6a, 0xbe, 0xa5,
-	0x8a, 0x7f, 0xab, 0x7a, 0x64, 0xc6, 0x8b, 0x70, 0x3a, 0x5c, 0x44, 0x86, 0x63, 0xf1, 0x85, 0x5c,
-	0x71, 0xb2, 0x34, 0xa5, 0xbf, 0x10, 0x9e, 0xef, 0x58, 0x1c, 0x9f, 0x87, 0x19, 0xea, 0x5a, 0x06,
-	0x6d, 0xb3, 0xc6, 0xdd, 0x85, 0xc9, 0x22, 0x2a, 0x4d, 0xea, 0xa7, 0xa9, 0x6b, 0xdd, 0x0a, 0xcf,
-	0xea, 0x36, 0xe0, 0x64, 0xd1, 0xe7, 0xb7, 0x82, 0x0a, 0x70, 0xf1, 0x93, 0x50, 0x97, 0x8f, 0x58,
-	0x63, 0xd3, 0xac, 0x37, 0x69, 0x4d, 0x6e, 0xf4, 0xee, 0xaa, 0xfc, 0x1e, 0x81, 0x32, 0xca, 0x43,
-	0x62, 0x32, 0xc0, 0x4d, 0x69, 0x34, 0xe2, 0x17, 0x41, 0x8f, 0x39, 0x7a, 0x33, 0x68, 0xf1, 0x9b,
-	0x41, 0x8b, 0xe3, 0xab, 0x97, 0x43, 0xe6, 0x27, 0xfb, 0x85, 0xc5, 0x48, 0xc8, 0xe1, 0x14, 0xea,
-	0x4f, 0x8f, 0x0b, 0x48, 0x9f, 0x6b, 0x0e, 0x16, 0x5e, 0xfb, 0xeb, 0x0c, 0x4c, 0x0b, 0x26, 0xfc,
-	0x07, 0x82, 0xd7, 0x46, 0x2c, 0x72, 0xbc, 0x96, 0x76, 0x85, 0xb2, 0x1f, 0x06, 0xf9, 0xca, 0xb1,
-	0x62, 0xa2, 0xfe, 0xd5, 0x77, 0xbf, 0xf9, 0xf3, 0xdf, 0x1f, 0x72, 0x6f, 0xe2, 0x75, 0x92, 0xf2,
-	0x66, 0x89, 0x1f, 0x38, 0x2d, 0x91, 0xc4, 0xf0, 0x99, 0x61, 0x75, 0xd3, 0x18, 0xe2, 0x3b, 0xc0,
-	0xf7, 0x11, 0xcc, 0x74, 0x77, 0x3c, 0xbe, 0x34, 0xfa, 0xe6, 0xf7, 0x9e, 0x09, 0xf9, 0xcb, 0x63,
-	0xbc, 0x24, 0xda, 0x75, 0x81, 0xa6, 0xe1, 0x37, 0xb2, 0xd0, 0xc4, 0x0f, 0xcf, 0xa8, 0x77, 0x0c,
-	0xc7, 0x22, 0x3b, 0x8e, 0xb5, 0x8b, 0x77, 0xe0, 0x94, 0xfc, 0x55, 0xbd, 0x3e, 0xb2, 0x4c, 0x57,
-	0x32, 0x35, 0xcb, 0x45, 0x62, 0x2c, 0x0b, 0x8c, 0x4b, 0x58, 0x1d, 0x8b, 0xc1, 0xf1, 0x1e, 0x82,
-	0xb3, 0xc9, 0x6d, 0x82, 0xaf, 0xa4, 0x15, 0x48, 0xd9, 0xf1, 0xf9, 0xd2, 0x78, 0x47, 0xc9, 0x53,
-	0x16, 0x3c, 0xd7, 0xf0, 0xd5, 0x2c, 0x1e, 0x53, 0x44, 0xca, 0xb1, 0x84, 0x7f, 0x1b, 0x58, 0xfc,
-	0xf1, 0x28, 0xc3, 0x64, 0x5c, 0xd5, 0x81, 0xa1, 0x9b, 0x5f, 0x3d, 0x7a, 0x80, 0xc4, 0x7d, 0x5b,
-	0xe0, 0xde, 0xc0, 0x95, 0x23, 0xe3, 0x1a, 0x6d, 0xea, 0x19, 0xd1, 0x34, 0x7f, 0x80, 0x60, 0xb6,
-	0x7f, 0x0a, 0xe3, 0xab, 0x69, 0x04, 0xa9, 0x3b, 0x32, 0xbf, 0x7c, 0x14, 0x57, 0x89, 0x59, 0x11,
-	0x98, 0x2b, 0xf8, 0x5a, 0x16, 0xe6, 0xc0, 0xb8, 0xc7, 0xbf, 0x0f, 0x2d, 0xcf, 0xae, 0xb2, 0xe5,
-	0xf1, 0xb5, 0x07, 0xb5, 0x5d, 0x3b, 0x4e, 0x88, 0xc4, 0x7e, 0x47, 0x60, 0xdf, 0xc4, 0x37, 0x8e,
-	0x81, 0x9d, 0xd0, 0x77, 0x0f, 0x01, 0xf4, 0x66, 0x37, 0x4e, 0xfd, 0x61, 0x0e, 0x2d, 0x94, 0xfc,
-	0xd2, 0x38, 0x37, 0x09, 0x77, 0x53, 0xc0, 0x95, 0x31, 0xc9, 0x82, 0xf3, 0xa2, 0x38, 0x83, 0x72,
-	0x9f, 0xec, 0x88, 0x45, 0xb4, 0x8b, 0x7f, 0x41, 0x30, 0x37, 0x34, 0xb2, 0xd3, 0x25, 0xcd, 0x5c,
-	0x00, 0xe9, 0x92, 0x66, 0x6f, 0x04, 0x75, 0x5d, 0x50, 0xaf, 0x62, 0x2d, 0x8b, 0x7a, 0x78, 0xe0,
-	0x57, 0x37, 0x1e, 0x1e, 0x28, 0xe8, 0xd1, 0x81, 0x82, 0xfe, 0x39, 0x50, 0xd0, 0x77, 0x87, 0xca,
-	0xc4, 0xa3, 0x43, 0x65, 0xe2, 0xef, 0x43, 0x65, 0xe2, 0x8b, 0xf5, 0xc4, 0x62, 0x93, 0x39, 0x57,
-	0x9a, 0x66, 0x9d, 0x77, 0x0b, 0x6c, 0x95, 0xaf, 0x93, 0xaf, 0x93, 0x65, 0xc4, 0xb2, 0xab, 0x9f,
-	0x12, 0x7b, 0xa7, 0xf2, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x1c, 0xf9, 0x25, 0x23, 0x0f,
-	0x00, 0x00,
+	// 1214 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x51, 0x6f, 0xdb, 0x54,
+	0x14, 0xc7, 0x7b, 0xd3, 0x76, 0x5d, 0xcf, 0x4a, 0xda, 0xde, 0x76, 0xac, 0xcd, 0xb6, 0xa4, 0x58,
+	0x5b, 0x9b, 0x75, 0xd4, 0x6e, 0xd2, 0xae, 0x43, 0x43, 0x20, 0x91, 0x65, 0x1b, 0x95, 0x40, 0x2a,
+	0x16, 0x08, 0x09, 0x09, 0x59, 0x8e, 0x7d, 0xc9, 0xac, 0x26, 0xbe, 0x5e, 0xae, 0xdd, 0x52, 0x55,
+	0x15, 0x12, 0xe2, 0x79, 0x02, 0x51, 0x21, 0x40, 0xfb, 0x04, 0x3c, 0x82, 0xc4, 0x23, 0x0f, 0x3c,
+	0xed, 0x71, 0x12, 0x2f, 0xbc, 0xd0, 0xa1, 0x96, 0x4f, 0x30, 0xbe, 0x00, 0xf2, 0xf5, 0x75, 0x6a,
+	0x27, 0x4e, 0xd2, 0xa2, 0xb5, 0xea, 0x53, 0x7b, 0x73, 0xce, 0xb9, 0xe7, 0x77, 0xff, 0xbe, 0xf6,
+	0xf9, 0x43, 0x96, 0xb2, 0x3a, 0x65, 0x16, 0x53, 0x2c, 0xdb, 0x20, 0xb6, 0x6b, 0x6d, 0x10, 0xa6,
+	0x3c, 0xf2, 0x48, 0x63, 0x4b, 0x76, 0x1a, 0xd4, 0xa5, 0x18, 0x8b, 0xb8, 0x7c, 0x18, 0xcf, 0x4c,
+	0x56, 0x69, 0x95, 0xf2, 0xb0, 0xe2, 0xff, 0x17, 0x64, 0x66, 0xae, 0x54, 0x29, 0xad, 0xd6, 0x88,
+	0xa2, 0x3b, 0x96, 0xa2, 0xdb, 0x36, 0x75, 0x75, 0xd7, 0xa2, 0x36, 0x13, 0xd1, 0xac, 0x88, 0xf2,
+	0x55, 0xc5, 0xfb, 0x4c, 0x31, 0xbd, 0x06, 0x4f, 0x08, 0xe3, 0x06, 0x6f, 0xa4, 0x54, 0x74, 0x46,
+	0x94, 0x8d, 0x42, 0x85, 0xb8, 0x7a, 0x41, 0x31, 0xa8, 0x15, 0xc6, 0xe7, 0xa3, 0x71, 0x0e, 0xd8,
+	0xcc, 0x72, 0xf4, 0xaa, 0x65, 0xc7, 0xf6, 0x4a, 0x38, 0x53, 0x55, 0xf7, 0xaa, 0x44, 0xc4, 0xa7,
+	0xc3, 0x78, 0x8d, 0x1a, 0xeb, 0x9e, 0xc3, 0xff, 0x04, 0x21, 0x69, 0x06, 0xb2, 0xef, 0x53, 0xd3,
+	0xab, 0x91, 0x0f, 0x69, 0xd9, 0x62, 0x6e, 0xc3, 0xaa, 0x78, 0x2e, 0xb9, 0x4b, 0x2d, 0x9b, 0xa9,
+	0xe4, 0x91, 0x47, 0x98, 0x2b, 0x7d, 0x85, 0x20, 0xd7, 0x31, 0x85, 0x39, 0xd4, 0x66, 0x04, 0xeb,
+	0x30, 0xe8, 0xa3, 0xb3, 0x29, 0x34, 0xd3, 0x9f, 0xbf, 0x50, 0x9c, 0x96, 0x03, 0x78, 0xd9, 0x87,
+	0x97, 0x05, 0xb6, 0xec, 0x97, 0x94, 0x16, 0x9f, 0xee, 0xe5, 0xfa, 0x7e, 0x7a, 0x9e, 0xcb, 0x57,
+	0x2d, 0xf7, 0xa1, 0x57, 0x91, 0x0d, 0x5a, 0x57, 0xc4, 0x49, 0x83, 0x3f, 0x0b, 0xcc, 0x5c, 0x57,
+	0xdc, 0x2d, 0x87, 0x30, 0x39, 0xe8, 0x11, 0xec, 0x2c, 0x49, 0x30, 0xf6, 0xc0, 0x3f, 0x52, 0x69,
+	0x6b, 0xb5, 0x2c, 0xd0, 0x70, 0x1a, 0x52, 0x96, 0x39, 0x85, 0x66, 0x50, 0x7e, 0x40, 0x4d, 0x59,
+	0xa6, 0x54, 0x86, 0xf1, 0x48, 0x8e, 0x60, 0x53, 0x60, 0x90, 0x6b, 0xc1, 0xf3, 0x7c, 0xb6, 0xf6,
+	0x07, 0x2c, 0xf3, 0x2a, 0x35, 0xc8, 0x93, 0x3e, 0x86, 0x57, 0xf8, 0x3a, 0x54, 0x00, 0xdf, 0x07,
+	0x38, 0x94, 0x5c, 0x6c, 0x33, 0x1b, 0x3b, 0x62, 0x70, 0x81, 0xc2, 0x83, 0xae, 0xe9, 0x55, 0x22,
+	0x6a, 0xd5, 0x48, 0xa5, 0xf4, 0x18, 0x41, 0x3a, 0xdc, 0x59, 0xc0, 0x2d, 0xc1, 0x80, 0xa9, 0xbb,
+	0x7a, 0x53, 0xb7, 0x4e, 0x6c, 0xa5, 0x01, 0x5f, 0x37, 0x95, 0x27, 0xe3, 0x07, 0x31, 0x9e, 0x14,
+	0xe7, 0x99, 0xeb, 0xc9, 0x13, 0x74, 0x8c, 0x01, 0x7d, 0x0a, 0x13, 0xef, 0x18, 0x7e, 0x97, 0x93,
+	0x39, 0xef, 0x2e, 0x82, 0xc9, 0xf8, 0xfe, 0x67, 0xe2, 0xd4, 0xdb, 0x70, 0x39, 0x4a, 0xb5, 0x46,
+	0x1a, 0x65, 0x62, 0xd3, 0x7a, 0x78, 0xfa, 0x49, 0x18, 0x34, 0xfd, 0x35, 0x3f, 0xf8, 0xb0, 0x1a,
+	0x2c, 0xf0, 0xfd, 0x84, 0xee, 0xff, 0x47, 0x93, 0x27, 0x08, 0xae, 0x24, 0x77, 0x3f, 0x13, 0xda,
+	0x68, 0x70, 0xf1, 0x23, 0xc7, 0xa0, 0x75, 0xcb, 0xae, 0x9e, 0xcc, 0x9d, 0xf8, 0x0e, 0xc1, 0xab,
+	0xad, 0x1d, 0xce, 0xc4, 0xc9, 0x77, 0xe0, 0x6a, 0x9c, 0xeb, 0x74, 0xef, 0xc5, 0x2f, 0x08, 0xb2,
+	0x9d, 0xfa, 0x0b, 0x7d, 0xde, 0x85, 0x51, 0x4f, 0x64, 0x68, 0xfc, 0x4b, 0xc5, 0x8e, 0x2a, 0x55,
+	0xda, 0x8b, 0xed, 0xfc, 0xf2, 0x44, 0x63, 0x30, 0xae, 0x92, 0x4d, 0xbd, 0x61, 0xb2, 0x7b, 0xcc,
+	0x0d, 0x85, 0x9a, 0x85, 0x41, 0xba, 0x69, 0x93, 0x46, 0x20, 0x54, 0x69, 0xec, 0xc5, 0x5e, 0x6e,
+	0x64, 0x4b, 0xaf, 0xd7, 0xee, 0x48, 0xfc, 0x67, 0x49, 0x0d, 0xc2, 0x78, 0x1a, 0xce, 0xfb, 0x83,
+	0x48, 0xb3, 0x4c, 0x36, 0x95, 0x9a, 0xe9, 0xcf, 0x0f, 0xa8, 0x43, 0xfe, 0x7a, 0xd5, 0x64, 0xf8,
+	0x32, 0x0c, 0x13, 0xdb, 0xd4, 0x88, 0x43, 0x8d, 0x87, 0x53, 0xfd, 0x33, 0x28, 0xdf, 0xaf, 0x9e,
+	0x27, 0xb6, 0x79, 0xcf, 0x5f, 0x4b, 0x9b, 0x80, 0xa3, 0x4d, 0x4f, 0x6f, 0x04, 0xe5, 0xe0, 0xea,
+	0x07, 0xbe, 0x2e, 0xef, 0x51, 0x63, 0x5d, 0xaf, 0xd4, 0x48, 0x59, 0x4c, 0xf4, 0xe6, 0xa8, 0xfc,
+	0x06, 0x41, 0xb6, 0x53, 0x86, 0xc0, 0xa4, 0x80, 0x6b, 0x22, 0xa8, 0x85, 0x8e, 0xe0, 0x90, 0x39,
+	0xf0, 0x0c, 0x72, 0xe8, 0x19, 0xe4, 0xb0, 0xbe, 0x74, 0xdd, 0x67, 0x7e, 0xb1, 0x97, 0x9b, 0x0e,
+	0x84, 0x6c, 0xdf, 0x42, 0xfa, 0xfe, 0x79, 0x0e, 0xa9, 0xe3, 0xb5, 0xd6, 0xc6, 0xd2, 0x02, 0x60,
+	0x9f, 0x46, 0x28, 0x16, 0x3e, 0xa3, 0x4b, 0x30, 0x24, 0xb4, 0x17, 0xe3, 0xf3, 0x5c, 0x20, 0xbd,
+	0xf4, 0x57, 0x0a, 0x26, 0x62, 0xf9, 0x82, 0xbb, 0x0c, 0x43, 0x8d, 0xe0, 0x27, 0x01, 0x7b, 0xad,
+	0xe3, 0xa5, 0x8b, 0x94, 0x8b, 0xfb, 0x17, 0x96, 0xe2, 0x2f, 0xe0, 0xa2, 0x4b, 0x5d, 0xbd, 0xa6,
+	0x39, 0xc4, 0x36, 0xfd, 0x7b, 0x1c, 0xee, 0x99, 0x7a, 0xf9, 0x0f, 0x6d, 0x82, 0x77, 0x5a, 0x0b,
+	0x1a, 0xa9, 0xad, 0x00, 0x46, 0x4d, 0xb7, 0xea, 0xc4, 0x6c, 0x02, 0xf4, 0x9f, 0x14, 0xc0, 0xdd,
+	0xa0, 0x91, 0x00, 0x90, 0x7e, 0x48, 0xc1, 0x58, 0xab, 0x4a, 0xfe, 0x9b, 0xc0, 0x5f, 0xe8, 0xc3,
+	0xc7, 0x31, 0xc4, 0xd7, 0xab, 0x26, 0x76, 0x61, 0xf4, 0x14, 0xb4, 0x4a, 0x3b, 0x71, 0x99, 0x5c,
+	0x18, 0x3d, 0x05, 0x81, 0xd2, 0x46, 0x4c, 0x9b, 0xe2, 0xbf, 0x23, 0x30, 0xc8, 0x5f, 0x1f, 0xfc,
+	0x3b, 0x82, 0x4b, 0x1d, 0x3c, 0x27, 0x2e, 0x26, 0x5d, 0xbc, 0xee, 0x1e, 0x36, 0xb3, 0x74, 0xac,
+	0x9a, 0xe0, 0xca, 0x4b, 0x6f, 0x7f, 0xf9, 0xc7, 0x3f, 0xdf, 0xa6, 0xde, 0xc0, 0x2b, 0x4a, 0x82,
+	0xbd, 0x0e, 0xbd, 0x78, 0x9d, 0x6f, 0xa2, 0xb9, 0x54, 0x33, 0x9b, 0xdb, 0x68, 0xfc, 0x73, 0x81,
+	0x1f, 0x23, 0x18, 0x6e, 0xda, 0x51, 0xdc, 0xf9, 0x7d, 0x89, 0x38, 0xda, 0xcc, 0xf5, 0x1e, 0x59,
+	0x02, 0x6d, 0x99, 0xa3, 0xc9, 0xf8, 0xf5, 0x6e, 0x68, 0xc1, 0x95, 0xaa, 0x6c, 0x69, 0x96, 0xa9,
+	0x6c, 0x5b, 0xe6, 0x0e, 0xde, 0x86, 0x73, 0x62, 0x00, 0xbc, 0xd6, 0xb1, 0x4d, 0x53, 0x32, 0xa9,
+	0x5b, 0x8a, 0xc0, 0x98, 0xe7, 0x18, 0xd7, 0xb0, 0xd4, 0x13, 0x83, 0xe1, 0x5d, 0x04, 0x23, 0x51,
+	0xe3, 0x83, 0xe7, 0x92, 0x1a, 0x24, 0xd8, 0xd1, 0x4c, 0xbe, 0x77, 0xa2, 0xe0, 0x29, 0x70, 0x9e,
+	0x9b, 0xf8, 0x46, 0x37, 0x1e, 0x9d, 0x57, 0x8a, 0x09, 0x8a, 0x7f, 0x6d, 0xf1, 0xa8, 0xe1, 0xd4,
+	0xc5, 0x4a, 0xaf, 0xae, 0x2d, 0xfe, 0x20, 0xb3, 0x78, 0xf4, 0x02, 0x81, 0xfb, 0x26, 0xc7, 0xbd,
+	0x85, 0x97, 0x8e, 0x8c, 0xab, 0x39, 0xa4, 0xa1, 0x05, 0xc6, 0xe3, 0x09, 0x82, 0x74, 0xdc, 0x30,
+	0xe0, 0x1b, 0x49, 0x04, 0x89, 0x76, 0x2e, 0x33, 0x7f, 0x94, 0x54, 0x81, 0xb9, 0xc4, 0x31, 0x17,
+	0xf0, 0xcd, 0x6e, 0x98, 0x2d, 0xce, 0x04, 0xff, 0xd6, 0xe6, 0xf3, 0x9a, 0xca, 0x16, 0x7a, 0xf7,
+	0x6e, 0xd5, 0xb6, 0x78, 0x9c, 0x12, 0x81, 0xfd, 0x16, 0xc7, 0xbe, 0x8d, 0x6f, 0x1d, 0x03, 0x3b,
+	0xa2, 0xef, 0x2e, 0x02, 0x38, 0xb4, 0x19, 0x38, 0xf1, 0xc5, 0x6c, 0xf3, 0x3e, 0x99, 0xd9, 0x5e,
+	0x69, 0x02, 0xee, 0x36, 0x87, 0x2b, 0x60, 0xa5, 0x1b, 0x9c, 0xf8, 0xf4, 0x6a, 0x84, 0xb9, 0xca,
+	0x36, 0xf7, 0x4c, 0x3b, 0xf8, 0x67, 0x04, 0xe3, 0x6d, 0xee, 0x22, 0x59, 0xd2, 0xae, 0x5e, 0x25,
+	0x53, 0x3c, 0x4e, 0x89, 0xa0, 0x5e, 0xe1, 0xd4, 0x8b, 0x58, 0xee, 0x46, 0xdd, 0xee, 0x4d, 0xf0,
+	0x8f, 0x08, 0x2e, 0x44, 0xe7, 0x5d, 0xa2, 0x4a, 0xed, 0x2e, 0x25, 0x33, 0xd7, 0x33, 0x4f, 0x80,
+	0xdd, 0xe1, 0x60, 0xcb, 0xb8, 0xd8, 0x0b, 0x2c, 0x1c, 0x67, 0xca, 0xb6, 0xb0, 0x3f, 0x3b, 0xa5,
+	0xb5, 0xa7, 0xfb, 0x59, 0xf4, 0x6c, 0x3f, 0x8b, 0xfe, 0xde, 0xcf, 0xa2, 0xaf, 0x0f, 0xb2, 0x7d,
+	0xcf, 0x0e, 0xb2, 0x7d, 0x7f, 0x1e, 0x64, 0xfb, 0x3e, 0x59, 0x89, 0x4c, 0x32, 0xb1, 0xef, 0x42,
+	0x4d, 0xaf, 0xb0, 0x66, 0x93, 0x8d, 0xc2, 0xb2, 0xf2, 0x79, 0xb4, 0x15, 0x9f, 0x6e, 0x95, 0x73,
+	0xdc, 0xbf, 0x2d, 0xfd, 0x37, 0x00, 0xcc, 0x53, 0xd8, 0x15, 0x6b, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1040,6 +1224,9 @@ type QueryClient interface {
 	// LockableDurations returns lockable durations that are valid to distribute
 	// incentives for
 	LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error)
+	// LockRewards returns the pending and claimed rewards of a lock from
+	// claimable gauges
+	LockRewards(ctx context.Context, in *LockRewardsRequest, opts ...grpc.CallOption) (*LockRewardsResponse, error)
 }
 
 type queryClient struct {
@@ -1131,6 +1318,15 @@ func (c *queryClient) LockableDurations(ctx context.Context, in *QueryLockableDu
 	return out, nil
 }
 
+func (c *queryClient) LockRewards(ctx context.Context, in *LockRewardsRequest, opts ...grpc.CallOption) (*LockRewardsResponse, error) {
+	out := new(LockRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/LockRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// ModuleToDistributeCoins returns coins that are going to be distributed
@@ -1155,6 +1351,9 @@ type QueryServer interface {
 	// LockableDurations returns lockable durations that are valid to distribute
 	// incentives for
 	LockableDurations(context.Context, *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error)
+	// LockRewards returns the pending and claimed rewards of a lock from
+	// claimable gauges
+	LockRewards(context.Context, *LockRewardsRequest) (*LockRewardsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1188,6 +1387,9 @@ func (*UnimplementedQueryServer) RewardsEst(ctx context.Context, req *RewardsEst
 func (*UnimplementedQueryServer) LockableDurations(ctx context.Context, req *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockableDurations not implemented")
 }
+func (*UnimplementedQueryServer) LockRewards(ctx context.Context, req *LockRewardsRequest) (*LockRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockRewards not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1355,6 +1557,24 @@ func _Query_LockableDurations_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LockRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/LockRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockRewards(ctx, req.(*LockRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1395,6 +1615,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LockableDurations",
 			Handler:    _Query_LockableDurations_Handler,
 		},
+		{
+			MethodName: "LockRewards",
+			Handler:    _Query_LockRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/query.proto",
@@ -2105,6 +2329,155 @@ func (m *QueryLockableDurationsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *LockRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalClaimedRewards) > 0 {
+		for iNdEx := len(m.TotalClaimedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalClaimedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalPendingRewards) > 0 {
+		for iNdEx := len(m.TotalPendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalPendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GaugeLockRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaugeLockRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaugeLockRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimedRewards) > 0 {
+		for iNdEx := len(m.ClaimedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PendingRewards) > 0 {
+		for iNdEx := len(m.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GaugeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2395,19 +2768,82 @@ func (m *QueryLockableDurationsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *LockRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovQuery(uint64(m.LockId))
+	}
+	return n
 }
-func (m *ModuleToDistributeCoinsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+
+func (m *LockRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalPendingRewards) > 0 {
+		for _, e := range m.TotalPendingRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalClaimedRewards) > 0 {
+		for _, e := range m.TotalClaimedRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GaugeLockRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovQuery(uint64(m.GaugeId))
+	}
+	if len(m.PendingRewards) > 0 {
+		for _, e := range m.PendingRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ClaimedRewards) > 0 {
+		for _, e := range m.ClaimedRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ModuleToDistributeCoinsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
@@ -4179,6 +4615,364 @@ func (m *QueryLockableDurationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LockRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, GaugeLockRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPendingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalPendingRewards = append(m.TotalPendingRewards, types.Coin{})
+			if err := m.TotalPendingRewards[len(m.TotalPendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalClaimedRewards = append(m.TotalClaimedRewards, types.Coin{})
+			if err := m.TotalClaimedRewards[len(m.TotalClaimedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugeLockRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeLockRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeLockRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRewards = append(m.PendingRewards, types.Coin{})
+			if err := m.PendingRewards[len(m.PendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimedRewards = append(m.ClaimedRewards, types.Coin{})
+			if err := m.ClaimedRewards[len(m.ClaimedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
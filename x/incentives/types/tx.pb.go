@@ -55,6 +55,9 @@ type MsgCreateGauge struct {
 	// num_epochs_paid_over is the number of epochs distribution will be completed
 	// over
 	NumEpochsPaidOver uint64 `protobuf:"varint,6,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
+	// is_claimable shows if the gauge's rewards should be claimed by lock owners
+	// through MsgClaimRewards instead of being sent to them every epoch
+	IsClaimable bool `protobuf:"varint,7,opt,name=is_claimable,json=isClaimable,proto3" json:"is_claimable,omitempty"`
 }
 
 func (m *MsgCreateGauge) Reset()         { *m = MsgCreateGauge{} }
@@ -132,6 +135,13 @@ func (m *MsgCreateGauge) GetNumEpochsPaidOver() uint64 {
 	return 0
 }
 
+func (m *MsgCreateGauge) GetIsClaimable() bool {
+	if m != nil {
+		return m.IsClaimable
+	}
+	return false
+}
+
 type MsgCreateGaugeResponse struct {
 }
 
@@ -268,55 +278,271 @@ func (m *MsgAddToGaugeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddToGaugeResponse proto.InternalMessageInfo
 
+// MsgClaimRewards claims the rewards accrued to locks from claimable gauges
+type MsgClaimRewards struct {
+	// owner is the address of the owner of the locks
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// lock_ids are the IDs of the locks to claim rewards for. If empty, rewards
+	// are claimed for all of the owner's locks
+	LockIds []uint64 `protobuf:"varint,2,rep,packed,name=lock_ids,json=lockIds,proto3" json:"lock_ids,omitempty" yaml:"lock_ids"`
+}
+
+func (m *MsgClaimRewards) Reset()         { *m = MsgClaimRewards{} }
+func (m *MsgClaimRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewards) ProtoMessage()    {}
+func (*MsgClaimRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{4}
+}
+func (m *MsgClaimRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewards.Merge(m, src)
+}
+func (m *MsgClaimRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewards proto.InternalMessageInfo
+
+func (m *MsgClaimRewards) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgClaimRewards) GetLockIds() []uint64 {
+	if m != nil {
+		return m.LockIds
+	}
+	return nil
+}
+
+type MsgClaimRewardsResponse struct {
+	// claimed_rewards are the rewards that were sent to the owner
+	ClaimedRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=claimed_rewards,json=claimedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_rewards"`
+}
+
+func (m *MsgClaimRewardsResponse) Reset()         { *m = MsgClaimRewardsResponse{} }
+func (m *MsgClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{5}
+}
+func (m *MsgClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimRewardsResponse) GetClaimedRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimedRewards
+	}
+	return nil
+}
+
+// MsgCancelGauge cancels a non-perpetual gauge, finishing it and refunding the
+// coins it has not distributed yet to its owner
+type MsgCancelGauge struct {
+	// owner is the gauge owner's address
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// gauge_id is the ID of the gauge to cancel
+	GaugeId uint64 `protobuf:"varint,2,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+}
+
+func (m *MsgCancelGauge) Reset()         { *m = MsgCancelGauge{} }
+func (m *MsgCancelGauge) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelGauge) ProtoMessage()    {}
+func (*MsgCancelGauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{6}
+}
+func (m *MsgCancelGauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelGauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelGauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelGauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelGauge.Merge(m, src)
+}
+func (m *MsgCancelGauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelGauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelGauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelGauge proto.InternalMessageInfo
+
+func (m *MsgCancelGauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgCancelGauge) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+type MsgCancelGaugeResponse struct {
+	// refunded_coins are the undistributed coins that were sent back to the
+	// owner
+	RefundedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refunded_coins,json=refundedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_coins"`
+}
+
+func (m *MsgCancelGaugeResponse) Reset()         { *m = MsgCancelGaugeResponse{} }
+func (m *MsgCancelGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelGaugeResponse) ProtoMessage()    {}
+func (*MsgCancelGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{7}
+}
+func (m *MsgCancelGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelGaugeResponse.Merge(m, src)
+}
+func (m *MsgCancelGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelGaugeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelGaugeResponse proto.InternalMessageInfo
+
+func (m *MsgCancelGaugeResponse) GetRefundedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RefundedCoins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateGauge)(nil), "osmosis.incentives.MsgCreateGauge")
 	proto.RegisterType((*MsgCreateGaugeResponse)(nil), "osmosis.incentives.MsgCreateGaugeResponse")
 	proto.RegisterType((*MsgAddToGauge)(nil), "osmosis.incentives.MsgAddToGauge")
 	proto.RegisterType((*MsgAddToGaugeResponse)(nil), "osmosis.incentives.MsgAddToGaugeResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "osmosis.incentives.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "osmosis.incentives.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgCancelGauge)(nil), "osmosis.incentives.MsgCancelGauge")
+	proto.RegisterType((*MsgCancelGaugeResponse)(nil), "osmosis.incentives.MsgCancelGaugeResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/tx.proto", fileDescriptor_8ea120e22291556e) }
 
 var fileDescriptor_8ea120e22291556e = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xae, 0x69, 0xf7, 0xcf, 0xdd, 0xd0, 0x88, 0x06, 0x64, 0x05, 0x25, 0x5d, 0x0e, 0x28, 0x20,
-	0xcd, 0x66, 0x03, 0x71, 0xe0, 0x46, 0x27, 0x84, 0x76, 0xa8, 0x28, 0x51, 0x25, 0xa4, 0x49, 0x28,
-	0x72, 0x12, 0x93, 0x59, 0x6b, 0xe2, 0x28, 0x76, 0xba, 0xf5, 0x2d, 0x26, 0xf1, 0x16, 0xbc, 0x01,
-	0x37, 0x8e, 0x3b, 0xee, 0xc8, 0xa9, 0x43, 0xed, 0x1b, 0xec, 0x09, 0x50, 0x9c, 0x64, 0x6d, 0xc5,
-	0x9f, 0x5d, 0x38, 0xb9, 0xf6, 0xf7, 0xfd, 0x3e, 0xff, 0xfc, 0x7d, 0xbf, 0x06, 0x3e, 0xe2, 0x22,
-	0xe2, 0x82, 0x09, 0xcc, 0x62, 0x9f, 0xc6, 0x92, 0x0d, 0xa9, 0xc0, 0xf2, 0x0c, 0x25, 0x29, 0x97,
-	0x5c, 0xd3, 0x4a, 0x10, 0xcd, 0xc0, 0xd6, 0x56, 0xc8, 0x43, 0xae, 0x60, 0x9c, 0xff, 0x2a, 0x98,
-	0x2d, 0x33, 0xe4, 0x3c, 0x1c, 0x50, 0xac, 0x76, 0x5e, 0xf6, 0x19, 0x4b, 0x16, 0x51, 0x21, 0x49,
-	0x94, 0x94, 0x04, 0xc3, 0x57, 0x5a, 0xd8, 0x23, 0x82, 0xe2, 0xe1, 0x9e, 0x47, 0x25, 0xd9, 0xc3,
-	0x3e, 0x67, 0x71, 0x85, 0xff, 0xa1, 0x8f, 0x90, 0x64, 0x21, 0x2d, 0xf1, 0xed, 0x0a, 0x1f, 0x70,
-	0xff, 0x24, 0x4b, 0xd4, 0x52, 0x40, 0xd6, 0x97, 0x3a, 0xbc, 0xdb, 0x15, 0xe1, 0x41, 0x4a, 0x89,
-	0xa4, 0xef, 0xf2, 0x1a, 0x6d, 0x07, 0xae, 0x33, 0xe1, 0x26, 0x34, 0x4d, 0xa8, 0xcc, 0xc8, 0x40,
-	0x07, 0x6d, 0x60, 0xaf, 0x3a, 0x4d, 0x26, 0x7a, 0xd5, 0x91, 0xf6, 0x04, 0x2e, 0xf1, 0xd3, 0x98,
-	0xa6, 0xfa, 0x9d, 0x36, 0xb0, 0xd7, 0x3a, 0x9b, 0xd7, 0x63, 0x73, 0x7d, 0x44, 0xa2, 0xc1, 0x6b,
-	0x4b, 0x1d, 0x5b, 0x4e, 0x01, 0x6b, 0x87, 0x70, 0x23, 0x60, 0x42, 0xa6, 0xcc, 0xcb, 0x24, 0x75,
-	0x25, 0xd7, 0xeb, 0x6d, 0x60, 0x37, 0xf7, 0x0d, 0x54, 0x79, 0x53, 0x34, 0x84, 0x3e, 0x64, 0x34,
-	0x1d, 0x1d, 0xf0, 0x38, 0x60, 0x92, 0xf1, 0xb8, 0xd3, 0xb8, 0x18, 0x9b, 0x35, 0x67, 0x7d, 0x56,
-	0xda, 0xe7, 0x1a, 0x81, 0x4b, 0xf9, 0x8b, 0x85, 0xde, 0x68, 0xd7, 0xed, 0xe6, 0xfe, 0x36, 0x2a,
-	0x3c, 0x41, 0xb9, 0x27, 0xa8, 0xf4, 0x04, 0x1d, 0x70, 0x16, 0x77, 0x9e, 0xe7, 0xd5, 0x5f, 0xaf,
-	0x4c, 0x3b, 0x64, 0xf2, 0x38, 0xf3, 0x90, 0xcf, 0x23, 0x5c, 0x1a, 0x58, 0x2c, 0xbb, 0x22, 0x38,
-	0xc1, 0x72, 0x94, 0x50, 0xa1, 0x0a, 0x84, 0x53, 0x28, 0x6b, 0x1f, 0x21, 0x14, 0x92, 0xa4, 0xd2,
-	0xcd, 0xfd, 0xd7, 0x97, 0x54, 0xab, 0x2d, 0x54, 0x84, 0x83, 0xaa, 0x70, 0x50, 0xbf, 0x0a, 0xa7,
-	0xf3, 0x38, 0xbf, 0xe8, 0x7a, 0x6c, 0x6e, 0x16, 0x4f, 0xbf, 0x49, 0xcd, 0x3a, 0xbf, 0x32, 0x81,
-	0xb3, 0xa6, 0xb4, 0x72, 0xb6, 0x86, 0xe1, 0x56, 0x9c, 0x45, 0x2e, 0x4d, 0xb8, 0x7f, 0x2c, 0xdc,
-	0x84, 0xb0, 0xc0, 0xe5, 0x43, 0x9a, 0xea, 0xcb, 0x6d, 0x60, 0x37, 0x9c, 0x7b, 0x71, 0x16, 0xbd,
-	0x55, 0x50, 0x8f, 0xb0, 0xe0, 0xfd, 0x90, 0xa6, 0x96, 0x0e, 0x1f, 0x2c, 0x86, 0xe2, 0x50, 0x91,
-	0xf0, 0x58, 0x50, 0xeb, 0x1b, 0x80, 0x1b, 0x5d, 0x11, 0xbe, 0x09, 0x82, 0x3e, 0x2f, 0xe2, 0xba,
-	0xc9, 0x02, 0xfc, 0x3b, 0x8b, 0x6d, 0xb8, 0xaa, 0x66, 0xc2, 0x65, 0x81, 0x8a, 0xad, 0xe1, 0xac,
-	0xa8, 0xfd, 0x61, 0xa0, 0x51, 0xb8, 0x92, 0xd2, 0x53, 0x92, 0x06, 0x42, 0xaf, 0xff, 0x7f, 0x77,
-	0x2b, 0x6d, 0xeb, 0x21, 0xbc, 0xbf, 0xd0, 0x7a, 0xf5, 0xa8, 0xfd, 0xef, 0x00, 0xd6, 0xbb, 0x22,
-	0xd4, 0x3e, 0xc1, 0xe6, 0xfc, 0x20, 0x5a, 0xe8, 0xf7, 0xbf, 0x10, 0x5a, 0xf4, 0xa5, 0xf5, 0xec,
-	0x76, 0x4e, 0x75, 0x8d, 0x76, 0x04, 0xe1, 0x9c, 0x6f, 0x3b, 0x7f, 0xa9, 0x9c, 0x51, 0x5a, 0x4f,
-	0x6f, 0xa5, 0x54, 0xda, 0x9d, 0xde, 0xc5, 0xc4, 0x00, 0x97, 0x13, 0x03, 0xfc, 0x9c, 0x18, 0xe0,
-	0x7c, 0x6a, 0xd4, 0x2e, 0xa7, 0x46, 0xed, 0xc7, 0xd4, 0xa8, 0x1d, 0xbd, 0x9a, 0x33, 0xaa, 0x94,
-	0xdb, 0x1d, 0x10, 0x4f, 0x54, 0x1b, 0x3c, 0xdc, 0x7b, 0x89, 0xcf, 0x16, 0x3e, 0x21, 0xb9, 0x79,
-	0xde, 0xb2, 0x9a, 0xb8, 0x17, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x0f, 0xf1, 0x52, 0x92, 0x65,
-	0x04, 0x00, 0x00,
+	// 751 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x4e, 0xdb, 0x48,
+	0x1c, 0x8e, 0x49, 0x20, 0x30, 0x09, 0x7f, 0xd6, 0xcb, 0x82, 0xc9, 0xae, 0x9c, 0xe0, 0x95, 0x56,
+	0x59, 0x56, 0xd8, 0x0b, 0xad, 0x7a, 0xe8, 0xad, 0x89, 0xaa, 0x8a, 0x03, 0x2a, 0x75, 0x91, 0x2a,
+	0x21, 0x55, 0xee, 0xd8, 0x33, 0x98, 0x11, 0xb6, 0xc7, 0xf2, 0x8c, 0x03, 0x1c, 0x7b, 0xaf, 0x54,
+	0x9e, 0xa3, 0x0f, 0x50, 0xa9, 0x6f, 0xc0, 0x91, 0x63, 0x4f, 0xa1, 0x82, 0x37, 0xe0, 0x09, 0x2a,
+	0x8f, 0x3d, 0xf9, 0xd3, 0x96, 0xc2, 0x81, 0x9e, 0xe2, 0x99, 0xef, 0x9b, 0xdf, 0x9f, 0xef, 0xfb,
+	0xcd, 0x04, 0xfc, 0x49, 0x59, 0x48, 0x19, 0x61, 0x16, 0x89, 0x3c, 0x1c, 0x71, 0xd2, 0xc3, 0xcc,
+	0xe2, 0xc7, 0x66, 0x9c, 0x50, 0x4e, 0x55, 0xb5, 0x00, 0xcd, 0x21, 0xd8, 0x58, 0xf4, 0xa9, 0x4f,
+	0x05, 0x6c, 0x65, 0x5f, 0x39, 0xb3, 0xd1, 0xf4, 0x29, 0xf5, 0x03, 0x6c, 0x89, 0x95, 0x9b, 0xee,
+	0x5b, 0x9c, 0x84, 0x98, 0x71, 0x18, 0xc6, 0x05, 0x41, 0xf7, 0x44, 0x2c, 0xcb, 0x85, 0x0c, 0x5b,
+	0xbd, 0x0d, 0x17, 0x73, 0xb8, 0x61, 0x79, 0x94, 0x44, 0x12, 0xff, 0x41, 0x1d, 0x3e, 0x4c, 0x7d,
+	0x5c, 0xe0, 0x2b, 0x12, 0x0f, 0xa8, 0x77, 0x98, 0xc6, 0xe2, 0x27, 0x87, 0x8c, 0x8f, 0x65, 0x30,
+	0xb7, 0xcd, 0xfc, 0x6e, 0x82, 0x21, 0xc7, 0xcf, 0xb2, 0x33, 0xea, 0x2a, 0xa8, 0x13, 0xe6, 0xc4,
+	0x38, 0x89, 0x31, 0x4f, 0x61, 0xa0, 0x29, 0x2d, 0xa5, 0x3d, 0x6d, 0xd7, 0x08, 0xdb, 0x91, 0x5b,
+	0xea, 0x3f, 0x60, 0x92, 0x1e, 0x45, 0x38, 0xd1, 0x26, 0x5a, 0x4a, 0x7b, 0xa6, 0xb3, 0x70, 0xdd,
+	0x6f, 0xd6, 0x4f, 0x60, 0x18, 0x3c, 0x36, 0xc4, 0xb6, 0x61, 0xe7, 0xb0, 0xba, 0x05, 0x66, 0x11,
+	0x61, 0x3c, 0x21, 0x6e, 0xca, 0xb1, 0xc3, 0xa9, 0x56, 0x6e, 0x29, 0xed, 0xda, 0xa6, 0x6e, 0x4a,
+	0x6d, 0xf2, 0x82, 0xcc, 0x17, 0x29, 0x4e, 0x4e, 0xba, 0x34, 0x42, 0x84, 0x13, 0x1a, 0x75, 0x2a,
+	0x67, 0xfd, 0x66, 0xc9, 0xae, 0x0f, 0x8f, 0xee, 0x52, 0x15, 0x82, 0xc9, 0xac, 0x63, 0xa6, 0x55,
+	0x5a, 0xe5, 0x76, 0x6d, 0x73, 0xc5, 0xcc, 0x35, 0x31, 0x33, 0x4d, 0xcc, 0x42, 0x13, 0xb3, 0x4b,
+	0x49, 0xd4, 0xf9, 0x3f, 0x3b, 0xfd, 0xe1, 0xa2, 0xd9, 0xf6, 0x09, 0x3f, 0x48, 0x5d, 0xd3, 0xa3,
+	0xa1, 0x55, 0x08, 0x98, 0xff, 0xac, 0x33, 0x74, 0x68, 0xf1, 0x93, 0x18, 0x33, 0x71, 0x80, 0xd9,
+	0x79, 0x64, 0xf5, 0x15, 0x00, 0x8c, 0xc3, 0x84, 0x3b, 0x99, 0xfe, 0xda, 0xa4, 0x28, 0xb5, 0x61,
+	0xe6, 0xe6, 0x98, 0xd2, 0x1c, 0x73, 0x57, 0x9a, 0xd3, 0xf9, 0x2b, 0x4b, 0x74, 0xdd, 0x6f, 0x2e,
+	0xe4, 0xad, 0x0f, 0x5c, 0x33, 0x4e, 0x2f, 0x9a, 0x8a, 0x3d, 0x23, 0x62, 0x65, 0x6c, 0xd5, 0x02,
+	0x8b, 0x51, 0x1a, 0x3a, 0x38, 0xa6, 0xde, 0x01, 0x73, 0x62, 0x48, 0x90, 0x43, 0x7b, 0x38, 0xd1,
+	0xa6, 0x5a, 0x4a, 0xbb, 0x62, 0xff, 0x16, 0xa5, 0xe1, 0x53, 0x01, 0xed, 0x40, 0x82, 0x9e, 0xf7,
+	0x70, 0x52, 0x58, 0xe0, 0x05, 0x90, 0x84, 0xd0, 0x0d, 0xb0, 0x56, 0x95, 0x16, 0x74, 0xe5, 0x96,
+	0xa1, 0x81, 0xa5, 0x71, 0xdf, 0x6c, 0xcc, 0x62, 0x1a, 0x31, 0x6c, 0x7c, 0x52, 0xc0, 0xec, 0x36,
+	0xf3, 0x9f, 0x20, 0xb4, 0x4b, 0x73, 0x47, 0x07, 0x76, 0x29, 0x3f, 0xb7, 0x6b, 0x05, 0x4c, 0x8b,
+	0xb1, 0x71, 0x08, 0x12, 0xce, 0x56, 0xec, 0xaa, 0x58, 0x6f, 0x21, 0x15, 0x83, 0x6a, 0x82, 0x8f,
+	0x60, 0x82, 0x98, 0x56, 0xbe, 0x7f, 0x03, 0x64, 0x6c, 0x63, 0x19, 0xfc, 0x31, 0x56, 0xfa, 0xa0,
+	0x29, 0x02, 0xe6, 0xb3, 0x76, 0xb3, 0xf6, 0xed, 0x9c, 0x7b, 0xe7, 0xae, 0x4c, 0x30, 0x9d, 0x8d,
+	0x99, 0x43, 0x10, 0xd3, 0x26, 0x5a, 0xe5, 0x76, 0xa5, 0xf3, 0xfb, 0x75, 0xbf, 0x39, 0x9f, 0x53,
+	0x25, 0x62, 0xd8, 0xd5, 0xec, 0x73, 0x0b, 0x31, 0xe3, 0xbd, 0x02, 0x96, 0xbf, 0xc9, 0x25, 0xcb,
+	0x50, 0x39, 0x98, 0x17, 0xae, 0x60, 0xe4, 0x48, 0x39, 0x94, 0xfb, 0x97, 0x63, 0xae, 0xc8, 0x51,
+	0x64, 0x37, 0x5e, 0xe6, 0x77, 0x14, 0x46, 0x1e, 0x0e, 0xee, 0xcb, 0x51, 0xe3, 0x9d, 0x02, 0x96,
+	0xc6, 0xa3, 0x0e, 0xba, 0x4c, 0xc0, 0x5c, 0x82, 0xf7, 0xd3, 0x08, 0x61, 0xe4, 0xe4, 0x97, 0xee,
+	0x17, 0x34, 0x39, 0x2b, 0x53, 0x88, 0xe5, 0xe6, 0xdb, 0x32, 0x28, 0x6f, 0x33, 0x5f, 0x7d, 0x0d,
+	0x6a, 0xa3, 0x8f, 0x91, 0x61, 0x7e, 0xff, 0x8c, 0x9a, 0xe3, 0x83, 0xdf, 0x58, 0xbb, 0x9d, 0x33,
+	0x68, 0x6d, 0x0f, 0x80, 0x91, 0x8b, 0xb1, 0x7a, 0xc3, 0xc9, 0x21, 0xa5, 0xf1, 0xef, 0xad, 0x94,
+	0x41, 0xec, 0x37, 0xa0, 0x3e, 0x36, 0xa0, 0x7f, 0xdf, 0x54, 0xd7, 0x08, 0xa9, 0xf1, 0xdf, 0x1d,
+	0x48, 0x83, 0x0c, 0x99, 0x38, 0x23, 0x53, 0x70, 0xa3, 0x38, 0x43, 0x4e, 0x63, 0xed, 0x76, 0x8e,
+	0x0c, 0xdf, 0xd9, 0x39, 0xbb, 0xd4, 0x95, 0xf3, 0x4b, 0x5d, 0xf9, 0x72, 0xa9, 0x2b, 0xa7, 0x57,
+	0x7a, 0xe9, 0xfc, 0x4a, 0x2f, 0x7d, 0xbe, 0xd2, 0x4b, 0x7b, 0x8f, 0x46, 0x6c, 0x2d, 0xe2, 0xad,
+	0x07, 0xd0, 0x65, 0x72, 0x61, 0xf5, 0x36, 0x1e, 0x5a, 0xc7, 0x63, 0xff, 0x83, 0x99, 0xd5, 0xee,
+	0x94, 0x78, 0x36, 0x1f, 0x7c, 0x1d, 0x00, 0x42, 0x8a, 0x85, 0xff, 0x2a, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -333,6 +559,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
 	AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...grpc.CallOption) (*MsgAddToGaugeResponse, error)
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	CancelGauge(ctx context.Context, in *MsgCancelGauge, opts ...grpc.CallOption) (*MsgCancelGaugeResponse, error)
 }
 
 type msgClient struct {
@@ -361,10 +589,30 @@ func (c *msgClient) AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelGauge(ctx context.Context, in *MsgCancelGauge, opts ...grpc.CallOption) (*MsgCancelGaugeResponse, error) {
+	out := new(MsgCancelGaugeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/CancelGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
 	AddToGauge(context.Context, *MsgAddToGauge) (*MsgAddToGaugeResponse, error)
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	CancelGauge(context.Context, *MsgCancelGauge) (*MsgCancelGaugeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -377,6 +625,12 @@ func (*UnimplementedMsgServer) CreateGauge(ctx context.Context, req *MsgCreateGa
 func (*UnimplementedMsgServer) AddToGauge(ctx context.Context, req *MsgAddToGauge) (*MsgAddToGaugeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddToGauge not implemented")
 }
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (*UnimplementedMsgServer) CancelGauge(ctx context.Context, req *MsgCancelGauge) (*MsgCancelGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGauge not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -418,6 +672,42 @@ func _Msg_AddToGauge_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelGauge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/CancelGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelGauge(ctx, req.(*MsgCancelGauge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -430,6 +720,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddToGauge",
 			Handler:    _Msg_AddToGauge_Handler,
 		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "CancelGauge",
+			Handler:    _Msg_CancelGauge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/tx.proto",
@@ -455,6 +753,16 @@ func (m *MsgCreateGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsClaimable {
+		i--
+		if m.IsClaimable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.NumEpochsPaidOver != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.NumEpochsPaidOver))
 		i--
@@ -607,65 +915,225 @@ func (m *MsgAddToGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateGauge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IsPerpetual {
-		n += 2
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.DistributeTo.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.LockIds) > 0 {
+		dAtA4 := make([]byte, len(m.LockIds)*10)
+		var j3 int
+		for _, num := range m.LockIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	if m.NumEpochsPaidOver != 0 {
-		n += 1 + sovTx(uint64(m.NumEpochsPaidOver))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddToGauge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
+	if len(m.ClaimedRewards) > 0 {
+		for iNdEx := len(m.ClaimedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelGauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelGauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundedCoins) > 0 {
+		for iNdEx := len(m.RefundedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsPerpetual {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.DistributeTo.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.NumEpochsPaidOver != 0 {
+		n += 1 + sovTx(uint64(m.NumEpochsPaidOver))
+	}
+	if m.IsClaimable {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddToGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	if m.GaugeId != 0 {
 		n += 1 + sovTx(uint64(m.GaugeId))
 	}
@@ -687,6 +1155,72 @@ func (m *MsgAddToGaugeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LockIds) > 0 {
+		l = 0
+		for _, e := range m.LockIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimedRewards) > 0 {
+		for _, e := range m.ClaimedRewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovTx(uint64(m.GaugeId))
+	}
+	return n
+}
+
+func (m *MsgCancelGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RefundedCoins) > 0 {
+		for _, e := range m.RefundedCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -893,6 +1427,26 @@ func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsClaimable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsClaimable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1149,6 +1703,433 @@ func (m *MsgAddToGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockIds = append(m.LockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockIds) == 0 {
+					m.LockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockIds = append(m.LockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimedRewards = append(m.ClaimedRewards, types1.Coin{})
+			if err := m.ClaimedRewards[len(m.ClaimedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelGauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelGauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedCoins = append(m.RefundedCoins, types1.Coin{})
+			if err := m.RefundedCoins[len(m.RefundedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
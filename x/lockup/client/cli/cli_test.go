@@ -64,6 +64,36 @@ func TestBeginUnlockingByIDCmd(t *testing.T) {
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
 
+func TestTransferLockCmd(t *testing.T) {
+	desc, _ := NewTransferLockCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgTransferLock]{
+		"basic test": {
+			Cmd: "10 " + testAddresses[1].String() + " --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgTransferLock{
+				Owner:    testAddresses[0].String(),
+				ID:       10,
+				NewOwner: testAddresses[1].String(),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestSplitLockCmd(t *testing.T) {
+	desc, _ := NewSplitLockCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgSplitLock]{
+		"basic test": {
+			Cmd: "10 5uosmo --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSplitLock{
+				Owner: testAddresses[0].String(),
+				ID:    10,
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 5)),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestModuleBalanceCmd(t *testing.T) {
 	desc, _ := GetCmdModuleBalance()
 	tcs := map[string]osmocli.QueryCliTestCase[*types.ModuleBalanceRequest]{
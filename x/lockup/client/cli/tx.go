@@ -15,6 +15,8 @@ func GetTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(cmd, NewBeginUnlockingAllCmd)
 	osmocli.AddTxCmd(cmd, NewBeginUnlockByIDCmd)
 	osmocli.AddTxCmd(cmd, NewForceUnlockByIdCmd)
+	osmocli.AddTxCmd(cmd, NewTransferLockCmd)
+	osmocli.AddTxCmd(cmd, NewSplitLockCmd)
 
 	return cmd
 }
@@ -62,3 +64,21 @@ func NewForceUnlockByIdCmd() (*osmocli.TxCliDesc, *types.MsgForceUnlock) {
 		Flags: osmocli.FlagDesc{OptionalFlags: []*pflag.FlagSet{FlagSetUnlockTokens()}},
 	}, &types.MsgForceUnlock{}
 }
+
+// NewTransferLockCmd transfers the ownership of an individual period lock by ID.
+func NewTransferLockCmd() (*osmocli.TxCliDesc, *types.MsgTransferLock) {
+	return &osmocli.TxCliDesc{
+		Use:   "transfer-lock [id] [new-owner]",
+		Short: "transfer the ownership of an individual period lock by ID",
+		Long:  "transfer the ownership of an individual period lock by ID. superfluid delegated or unbonding locks cannot be transferred",
+	}, &types.MsgTransferLock{}
+}
+
+// NewSplitLockCmd splits the given amount off an individual period lock by ID into a new lock.
+func NewSplitLockCmd() (*osmocli.TxCliDesc, *types.MsgSplitLock) {
+	return &osmocli.TxCliDesc{
+		Use:   "split-lock [id] [amount]",
+		Short: "split the given amount off an individual period lock by ID into a new lock",
+		Long:  "split the given amount off an individual period lock by ID into a new lock with the same duration. unlocking or superfluid delegated locks cannot be split",
+	}, &types.MsgSplitLock{}
+}
@@ -25,3 +25,13 @@ func (k Keeper) GetCoinsFromLocks(locks []types.PeriodLock) sdk.Coins {
 func (k Keeper) Lock(ctx sdk.Context, lock types.PeriodLock, tokensToLock sdk.Coins) error {
 	return k.lock(ctx, lock, tokensToLock)
 }
+
+func (k Keeper) SetLock(ctx sdk.Context, lock types.PeriodLock) error {
+	return k.setLock(ctx, lock)
+}
+
+func (k Keeper) DeleteCreationTimeLockRefs(ctx sdk.Context, lock types.PeriodLock) {
+	for _, refKey := range creationTimeLockRefKeys(lock) {
+		k.deleteLockRefByKey(ctx, combineKeys(unlockingPrefix(lock.IsUnlocking()), refKey), lock.ID)
+	}
+}
@@ -97,11 +97,12 @@ func TestExportGenesis(t *testing.T) {
 			Coins:    sdk.Coins{sdk.NewInt64Coin("foo", 10000000)},
 		},
 		{
-			ID:       11,
-			Owner:    acc2.String(),
-			Duration: time.Second * 5,
-			EndTime:  time.Time{},
-			Coins:    sdk.Coins{sdk.NewInt64Coin("foo", 5000000)},
+			ID:           11,
+			Owner:        acc2.String(),
+			Duration:     time.Second * 5,
+			EndTime:      time.Time{},
+			Coins:        sdk.Coins{sdk.NewInt64Coin("foo", 5000000)},
+			CreationTime: ctx.BlockTime(),
 		},
 		{
 			ID:       3,
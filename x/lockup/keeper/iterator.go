@@ -38,6 +38,15 @@ func (k Keeper) iteratorBeforeTime(ctx sdk.Context, prefix []byte, maxTime time.
 	return store.Iterator(prefix, storetypes.PrefixEndBytes(key))
 }
 
+// iteratorStrictlyBeforeTime iterates through keys that use prefix, and have a time strictly before max time.
+func (k Keeper) iteratorStrictlyBeforeTime(ctx sdk.Context, prefix []byte, maxTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	// starting from the timestamp prefix excludes keys of denoms that extend the prefixed denom
+	start := combineKeys(prefix, types.KeyPrefixTimestamp)
+	end := combineKeys(prefix, getTimeKey(maxTime))
+	return store.Iterator(start, end)
+}
+
 // iteratorDuration iterates over a domain of keys for a specified duration.
 func (k Keeper) iteratorDuration(ctx sdk.Context, prefix []byte, duration time.Duration) sdk.Iterator {
 	durationKey := getDurationKey(duration)
@@ -106,6 +115,12 @@ func (k Keeper) LockIteratorLongerThanDurationDenom(ctx sdk.Context, isUnlocking
 	return k.iteratorLongerDuration(ctx, combineKeys(unlockingPrefix, types.KeyPrefixDenomLockDuration, []byte(denom)), duration)
 }
 
+// LockIteratorCreatedBeforeTimeDenom returns the iterator to get locks by denom created strictly before the given time.
+func (k Keeper) LockIteratorCreatedBeforeTimeDenom(ctx sdk.Context, isUnlocking bool, denom string, time time.Time) sdk.Iterator {
+	unlockingPrefix := unlockingPrefix(isUnlocking)
+	return k.iteratorStrictlyBeforeTime(ctx, combineKeys(unlockingPrefix, types.KeyPrefixDenomLockCreationTime, []byte(denom)), time)
+}
+
 // LockIteratorDenom returns the iterator used for getting all locks by denom.
 func (k Keeper) LockIteratorDenom(ctx sdk.Context, isUnlocking bool, denom string) sdk.Iterator {
 	unlockingPrefix := unlockingPrefix(isUnlocking)
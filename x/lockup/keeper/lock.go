@@ -35,8 +35,12 @@ func (k Keeper) GetModuleLockedCoins(ctx sdk.Context) sdk.Coins {
 }
 
 // GetPeriodLocksByDuration returns the total amount of query.Denom tokens locked for longer than
-// query.Duration.
+// query.Duration, or of tokens in locks created before query.Timestamp for ByTime queries.
 func (k Keeper) GetPeriodLocksAccumulation(ctx sdk.Context, query types.QueryCondition) sdk.Int {
+	if query.LockQueryType == types.ByTime {
+		locks := k.GetLocksCreatedBeforeTimeDenom(ctx, query.Denom, query.Timestamp)
+		return types.SumLocksByDenom(locks, query.Denom)
+	}
 	beginKey := accumulationKey(query.Duration)
 	return k.accumulationStore(ctx, query.Denom).SubsetAccumulation(beginKey, nil)
 }
@@ -89,12 +93,25 @@ func (k Keeper) AddTokensToLockByID(ctx sdk.Context, lockID uint64, owner sdk.Ac
 		return nil, types.ErrNotLockOwner
 	}
 
+	// the lock is re-indexed with the current block time as its creation time,
+	// so that added tokens do not count towards rewards for older locks.
+	prevLock := *lock
 	lock.Coins = lock.Coins.Add(tokensToAdd)
+	lock.CreationTime = ctx.BlockTime()
 	err = k.lock(ctx, *lock, sdk.NewCoins(tokensToAdd))
 	if err != nil {
 		return nil, err
 	}
 
+	err = k.deleteLockRefs(ctx, unlockingPrefix(prevLock.IsUnlocking()), prevLock)
+	if err != nil {
+		return nil, err
+	}
+	err = k.addLockRefs(ctx, *lock)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, synthlock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
 		k.accumulationStore(ctx, synthlock.SynthDenom).Increase(accumulationKey(synthlock.Duration), tokensToAdd.Amount)
 	}
@@ -116,6 +133,7 @@ func (k Keeper) CreateLock(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coin
 	// unlock time is initially set without a value, gets set as unlock start time + duration
 	// when unlocking starts.
 	lock := types.NewPeriodLock(ID, owner, duration, time.Time{}, coins)
+	lock.CreationTime = ctx.BlockTime()
 	err := k.lock(ctx, lock, lock.Coins)
 	if err != nil {
 		return lock, err
@@ -465,6 +483,107 @@ func (k Keeper) ExtendLockup(ctx sdk.Context, lockID uint64, owner sdk.AccAddres
 	return nil
 }
 
+// TransferLock transfers the ownership of the given lock from owner to newOwner.
+// The lock keeps its ID, coins, duration and unlocking status.
+// Transferring the lock would fail on either of the following conditions.
+// 1. Only lock owner is able to transfer the lock.
+// 2. The new owner is the same as the current owner.
+// 3. Locks that have synthetic lockup are not allowed to be transferred.
+func (k Keeper) TransferLock(ctx sdk.Context, lockID uint64, owner, newOwner sdk.AccAddress) error {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+
+	if lock.GetOwner() != owner.String() {
+		return types.ErrNotLockOwner
+	}
+
+	if owner.Equals(newOwner) {
+		return fmt.Errorf("cannot transfer lock %d to its current owner", lock.ID)
+	}
+
+	// superfluid delegated and unbonding locks are tied to their owner through their synthetic lockups
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return fmt.Errorf("cannot transfer lockup with synthetic lock %d", lock.ID)
+	}
+
+	// lock refs are indexed by owner, so they are re-created for the new owner
+	err = k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), *lock)
+	if err != nil {
+		return err
+	}
+
+	lock.Owner = newOwner.String()
+	err = k.setLockAndAddLockRefs(ctx, *lock)
+	if err != nil {
+		return err
+	}
+
+	k.hooks.OnLockTransfer(ctx, lock.ID, owner, newOwner)
+	return nil
+}
+
+// SplitLock splits the given coins off the given lock into a new lock with the same owner and duration.
+// Returns the new lock.
+// Splitting the lock would fail on either of the following conditions.
+// 1. Only lock owner is able to split the lock.
+// 2. Locks that are unlocking are not allowed to be split.
+// 3. Locks that have synthetic lockup are not allowed to be split.
+// 4. The coins to split must be non-empty and less than the coins in the lock.
+func (k Keeper) SplitLock(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, coins sdk.Coins) (types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	if lock.GetOwner() != owner.String() {
+		return types.PeriodLock{}, types.ErrNotLockOwner
+	}
+
+	if lock.IsUnlocking() {
+		return types.PeriodLock{}, fmt.Errorf("cannot split unlocking lockup for lock %d", lock.ID)
+	}
+
+	// superfluid delegations are made for the full amount of the lock
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return types.PeriodLock{}, fmt.Errorf("cannot split lockup with synthetic lock %d", lock.ID)
+	}
+
+	if coins.Empty() || !coins.IsAllPositive() {
+		return types.PeriodLock{}, fmt.Errorf("cannot split a zero or negative amount")
+	}
+
+	if !coins.IsAllLTE(lock.Coins) || coins.IsEqual(lock.Coins) {
+		return types.PeriodLock{}, fmt.Errorf("requested amount to split must be less than locked tokens")
+	}
+
+	// the lock may lose a denom entirely, so its lock refs are re-created after the split
+	err = k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, *lock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	splitLock, err := k.splitLock(ctx, *lock, coins, false)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	lock.Coins = lock.Coins.Sub(coins)
+	err = k.addLockRefs(ctx, *lock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	err = k.addLockRefs(ctx, splitLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	k.hooks.OnLockSplit(ctx, lock.ID, splitLock.ID, coins)
+	return splitLock, nil
+}
+
 // InitializeAllLocks takes a set of locks, and initializes state to be storing
 // them all correctly. This utilizes batch optimizations to improve efficiency,
 // as this becomes a bottleneck at chain initialization & upgrades.
@@ -710,6 +829,7 @@ func (k Keeper) splitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coin
 	k.SetLastLockID(ctx, splitLockID)
 
 	splitLock := types.NewPeriodLock(splitLockID, lock.OwnerAddress(), lock.Duration, lock.EndTime, coins)
+	splitLock.CreationTime = lock.CreationTime
 
 	err = k.setLock(ctx, splitLock)
 	return splitLock, err
@@ -318,6 +318,80 @@ func (suite *KeeperTestSuite) TestLocksPastTimeDenom() {
 	suite.Require().Len(locks, 1)
 }
 
+func (suite *KeeperTestSuite) TestLocksCreatedBeforeTimeDenom() {
+	suite.SetupTest()
+
+	lockIDs := func(locks []types.PeriodLock) []uint64 {
+		ids := []uint64{}
+		for _, lock := range locks {
+			ids = append(ids, lock.ID)
+		}
+		return ids
+	}
+
+	t0 := suite.Ctx.BlockTime()
+	t1 := t0.Add(time.Hour)
+	t2 := t1.Add(time.Hour)
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+
+	// initial check
+	locks := suite.App.LockupKeeper.GetLocksCreatedBeforeTimeDenom(suite.Ctx, "stake", t2)
+	suite.Require().Len(locks, 0)
+
+	// lock 1 and lock 2 of a denom prefixed by "stake" are created at t0
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	lockID1 := suite.App.LockupKeeper.GetLastLockID(suite.Ctx)
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stakex", 10)}, time.Second)
+
+	// lock 3 is created at t1
+	suite.Ctx = suite.Ctx.WithBlockTime(t1)
+	suite.LockTokens(addr2, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, time.Second)
+	lockID3 := suite.App.LockupKeeper.GetLastLockID(suite.Ctx)
+	lock3, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockID3)
+	suite.Require().NoError(err)
+	suite.Require().Equal(t1, lock3.CreationTime)
+
+	// locks created at the timestamp are excluded
+	locks = suite.App.LockupKeeper.GetLocksCreatedBeforeTimeDenom(suite.Ctx, "stake", t0)
+	suite.Require().Len(locks, 0)
+	locks = suite.App.LockupKeeper.GetLocksCreatedBeforeTimeDenom(suite.Ctx, "stake", t1)
+	suite.Require().Equal([]uint64{lockID1}, lockIDs(locks))
+	locks = suite.App.LockupKeeper.GetLocksCreatedBeforeTimeDenom(suite.Ctx, "stake", t2)
+	suite.Require().Equal([]uint64{lockID1, lockID3}, lockIDs(locks))
+
+	// unlocking locks are still returned
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockID1, nil)
+	suite.Require().NoError(err)
+	locks = suite.App.LockupKeeper.GetLocksCreatedBeforeTimeDenom(suite.Ctx, "stake", t1)
+	suite.Require().Equal([]uint64{lockID1}, lockIDs(locks))
+
+	// locks split from a lock keep its creation time
+	suite.Ctx = suite.Ctx.WithBlockTime(t2)
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockID3, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
+	suite.Require().NoError(err)
+	splitLockID := suite.App.LockupKeeper.GetLastLockID(suite.Ctx)
+	locks = suite.App.LockupKeeper.GetLocksCreatedBeforeTimeDenom(suite.Ctx, "stake", t2)
+	suite.Require().ElementsMatch([]uint64{lockID1, lockID3, splitLockID}, lockIDs(locks))
+
+	// adding tokens to a lock resets its creation time
+	suite.FundAcc(addr2, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	_, err = suite.App.LockupKeeper.AddTokensToLockByID(suite.Ctx, lockID3, addr2, sdk.NewInt64Coin("stake", 10))
+	suite.Require().NoError(err)
+	locks = suite.App.LockupKeeper.GetLocksCreatedBeforeTimeDenom(suite.Ctx, "stake", t2)
+	suite.Require().ElementsMatch([]uint64{lockID1, splitLockID}, lockIDs(locks))
+	locks = suite.App.LockupKeeper.GetLocksCreatedBeforeTimeDenom(suite.Ctx, "stake", t2.Add(time.Second))
+	suite.Require().ElementsMatch([]uint64{lockID1, lockID3, splitLockID}, lockIDs(locks))
+
+	// accumulation by time sums up the coins of the locks created before the timestamp
+	accum := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+		LockQueryType: types.ByTime,
+		Denom:         "stake",
+		Timestamp:     t2,
+	})
+	suite.Require().Equal(int64(15), accum.Int64())
+}
+
 func (suite *KeeperTestSuite) TestLocksLongerThanDurationDenom() {
 	suite.SetupTest()
 
@@ -1055,3 +1129,219 @@ func (suite *KeeperTestSuite) TestPartialForceUnlock() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestTransferLock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coinsToLock := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	testCases := []struct {
+		name             string
+		sender           sdk.AccAddress
+		newOwner         sdk.AccAddress
+		isUnlocking      bool
+		hasSyntheticLock bool
+		expectedPass     bool
+	}{
+		{
+			name:         "transfer lock",
+			sender:       addr1,
+			newOwner:     addr2,
+			expectedPass: true,
+		},
+		{
+			name:         "transfer unlocking lock",
+			sender:       addr1,
+			newOwner:     addr2,
+			isUnlocking:  true,
+			expectedPass: true,
+		},
+		{
+			name:     "sender is not the lock owner",
+			sender:   addr2,
+			newOwner: addr2,
+		},
+		{
+			name:     "new owner is the current owner",
+			sender:   addr1,
+			newOwner: addr1,
+		},
+		{
+			name:             "lock has synthetic lockup",
+			sender:           addr1,
+			newOwner:         addr2,
+			hasSyntheticLock: true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.FundAcc(addr1, coinsToLock)
+			lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, coinsToLock, time.Second)
+			suite.Require().NoError(err)
+
+			if tc.isUnlocking {
+				err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+				suite.Require().NoError(err)
+			}
+			if tc.hasSyntheticLock {
+				err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, lock.ID, "synthstakestakedtovalidator", time.Second, false)
+				suite.Require().NoError(err)
+			}
+
+			err = suite.App.LockupKeeper.TransferLock(suite.Ctx, lock.ID, tc.sender, tc.newOwner)
+			if !tc.expectedPass {
+				suite.Require().Error(err)
+
+				lockAfter, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(addr1.String(), lockAfter.Owner)
+				return
+			}
+			suite.Require().NoError(err)
+
+			lockAfter, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(addr2.String(), lockAfter.Owner)
+			suite.Require().Equal(coinsToLock, lockAfter.Coins)
+			suite.Require().Equal(tc.isUnlocking, lockAfter.IsUnlocking())
+
+			// lock refs are moved to the new owner
+			suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr1), 0)
+			suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr2), 1)
+			suite.Require().Equal(coinsToLock, suite.App.LockupKeeper.GetAccountLockedCoins(suite.Ctx, addr2))
+			suite.Require().Len(suite.App.LockupKeeper.GetLocksDenom(suite.Ctx, "stake"), 1)
+
+			// the new owner receives the tokens once the lock matures
+			if !tc.isUnlocking {
+				err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+				suite.Require().NoError(err)
+			}
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+			err = suite.App.LockupKeeper.UnlockMaturedLock(suite.Ctx, lock.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(coinsToLock, suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr2))
+			suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr1).Empty())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSplitLock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	defaultAmountToLock := sdk.NewInt(10000000)
+	coinsToLock := sdk.NewCoins(sdk.NewCoin("stake", defaultAmountToLock))
+
+	testCases := []struct {
+		name             string
+		sender           sdk.AccAddress
+		coinsToSplit     sdk.Coins
+		isUnlocking      bool
+		hasSyntheticLock bool
+		expectedPass     bool
+	}{
+		{
+			name:         "split lock",
+			sender:       addr1,
+			coinsToSplit: sdk.NewCoins(sdk.NewCoin("stake", defaultAmountToLock.QuoRaw(4))),
+			expectedPass: true,
+		},
+		{
+			name:         "sender is not the lock owner",
+			sender:       addr2,
+			coinsToSplit: sdk.NewCoins(sdk.NewCoin("stake", defaultAmountToLock.QuoRaw(4))),
+		},
+		{
+			name:         "split full amount",
+			sender:       addr1,
+			coinsToSplit: coinsToLock,
+		},
+		{
+			name:         "split more than locked",
+			sender:       addr1,
+			coinsToSplit: sdk.NewCoins(sdk.NewCoin("stake", defaultAmountToLock.AddRaw(1))),
+		},
+		{
+			name:         "split other denom",
+			sender:       addr1,
+			coinsToSplit: sdk.NewCoins(sdk.NewInt64Coin("foo", 1)),
+		},
+		{
+			name:         "split empty coins",
+			sender:       addr1,
+			coinsToSplit: sdk.Coins{},
+		},
+		{
+			name:         "lock is unlocking",
+			sender:       addr1,
+			coinsToSplit: sdk.NewCoins(sdk.NewCoin("stake", defaultAmountToLock.QuoRaw(4))),
+			isUnlocking:  true,
+		},
+		{
+			name:             "lock has synthetic lockup",
+			sender:           addr1,
+			coinsToSplit:     sdk.NewCoins(sdk.NewCoin("stake", defaultAmountToLock.QuoRaw(4))),
+			hasSyntheticLock: true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.FundAcc(addr1, coinsToLock)
+			lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, coinsToLock, time.Second)
+			suite.Require().NoError(err)
+
+			if tc.isUnlocking {
+				err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+				suite.Require().NoError(err)
+			}
+			if tc.hasSyntheticLock {
+				err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, lock.ID, "synthstakestakedtovalidator", time.Second, false)
+				suite.Require().NoError(err)
+			}
+
+			splitLock, err := suite.App.LockupKeeper.SplitLock(suite.Ctx, lock.ID, tc.sender, tc.coinsToSplit)
+			if !tc.expectedPass {
+				suite.Require().Error(err)
+
+				lockAfter, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(coinsToLock, lockAfter.Coins)
+				suite.Require().Equal(lock.ID, suite.App.LockupKeeper.GetLastLockID(suite.Ctx))
+				return
+			}
+			suite.Require().NoError(err)
+
+			lockAfter, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(coinsToLock.Sub(tc.coinsToSplit), lockAfter.Coins)
+
+			suite.Require().Equal(lock.ID+1, splitLock.ID)
+			suite.Require().Equal(addr1.String(), splitLock.Owner)
+			suite.Require().Equal(lock.Duration, splitLock.Duration)
+			suite.Require().Equal(tc.coinsToSplit, splitLock.Coins)
+			storedSplitLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, splitLock.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(splitLock, *storedSplitLock)
+
+			// both locks are indexed and the accumulation store is unchanged
+			suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr1), 2)
+			suite.Require().Len(suite.App.LockupKeeper.GetLocksLongerThanDurationDenom(suite.Ctx, "stake", time.Second), 2)
+			suite.Require().Equal(coinsToLock, suite.App.LockupKeeper.GetAccountLockedCoins(suite.Ctx, addr1))
+			acc := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+				Denom:    "stake",
+				Duration: time.Second,
+			})
+			suite.Require().Equal(defaultAmountToLock, acc)
+
+			// the split lock unlocks independently
+			err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, splitLock.ID, nil)
+			suite.Require().NoError(err)
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+			err = suite.App.LockupKeeper.UnlockMaturedLock(suite.Ctx, splitLock.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.coinsToSplit, suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr1))
+			suite.Require().Equal(lockAfter.Coins, suite.App.LockupKeeper.GetAccountLockedCoins(suite.Ctx, addr1))
+		})
+	}
+}
@@ -132,3 +132,23 @@ func MergeLockupsForSimilarDurations(
 		// numLocksNormalized, numLocksCreated)
 	}
 }
+
+// AddCreationTimeLockRefs indexes every existing lock by its denom and creation time.
+// Locks created before creation times were recorded have a zero creation time,
+// so they are returned by every ByTime query.
+func (k Keeper) AddCreationTimeLockRefs(ctx sdk.Context) error {
+	locks, err := k.GetPeriodLocks(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, lock := range locks {
+		lockRefPrefix := unlockingPrefix(lock.IsUnlocking())
+		for _, refKey := range creationTimeLockRefKeys(lock) {
+			if err := k.addLockRefByKey(ctx, combineKeys(lockRefPrefix, refKey), lock.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
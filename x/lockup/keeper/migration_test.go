@@ -69,3 +69,34 @@ func (suite *KeeperTestSuite) TestLockupMergeMigration() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestAddCreationTimeLockRefs() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Hour)
+	err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 2, nil)
+	suite.Require().NoError(err)
+
+	// locks stored before creation times were recorded have neither a creation time nor its refs
+	locks, err := suite.App.LockupKeeper.GetPeriodLocks(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(locks, 2)
+	for _, lock := range locks {
+		suite.App.LockupKeeper.DeleteCreationTimeLockRefs(suite.Ctx, lock)
+		lock.CreationTime = time.Time{}
+		err := suite.App.LockupKeeper.SetLock(suite.Ctx, lock)
+		suite.Require().NoError(err)
+	}
+	suite.Require().Len(suite.App.LockupKeeper.GetLocksCreatedBeforeTimeDenom(suite.Ctx, "stake", suite.Ctx.BlockTime().Add(time.Second)), 0)
+
+	err = suite.App.LockupKeeper.AddCreationTimeLockRefs(suite.Ctx)
+	suite.Require().NoError(err)
+
+	// both the not unlocking and the unlocking lock are returned for any timestamp
+	locks = suite.App.LockupKeeper.GetLocksCreatedBeforeTimeDenom(suite.Ctx, "stake", time.Unix(1, 0))
+	suite.Require().Len(locks, 2)
+	suite.Require().Equal(uint64(1), locks[0].ID)
+	suite.Require().Equal(uint64(2), locks[1].ID)
+}
@@ -222,3 +222,64 @@ func (server msgServer) ForceUnlock(goCtx context.Context, msg *types.MsgForceUn
 
 	return &types.MsgForceUnlockResponse{Success: true}, nil
 }
+
+// TransferLock transfers the ownership of the specified lock to the new owner.
+// TransferLock would fail if the sender is not the lock owner OR if the lock has a synthetic lock.
+func (server msgServer) TransferLock(goCtx context.Context, msg *types.MsgTransferLock) (*types.MsgTransferLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.TransferLock(ctx, msg.ID, owner, newOwner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTransferLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockNewOwner, msg.NewOwner),
+		),
+	})
+
+	return &types.MsgTransferLockResponse{Success: true}, nil
+}
+
+// SplitLock splits the given coins off the specified lock into a new lock with the same owner and duration.
+// SplitLock would fail if the sender is not the lock owner, OR if the lock is currently unlocking
+// OR if the lock has a synthetic lock.
+func (server msgServer) SplitLock(goCtx context.Context, msg *types.MsgSplitLock) (*types.MsgSplitLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	splitLock, err := server.keeper.SplitLock(ctx, msg.ID, owner, msg.Coins)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSplitLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockNewLockID, osmoutils.Uint64ToString(splitLock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, msg.Coins.String()),
+		),
+	})
+
+	return &types.MsgSplitLockResponse{NewLockID: splitLock.ID}, nil
+}
@@ -447,3 +447,60 @@ func (suite *KeeperTestSuite) TestMsgForceUnlock() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgTransferLock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coinsToLock := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	suite.SetupTest()
+	suite.FundAcc(addr1, coinsToLock)
+
+	msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+	c := sdk.WrapSDKContext(suite.Ctx)
+	resp, err := msgServer.LockTokens(c, types.NewMsgLockTokens(addr1, time.Second, coinsToLock))
+	suite.Require().NoError(err)
+
+	// only the lock owner may transfer the lock
+	_, err = msgServer.TransferLock(c, types.NewMsgTransferLock(addr2, resp.ID, addr1))
+	suite.Require().Error(err)
+
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	c = sdk.WrapSDKContext(suite.Ctx)
+	transferResp, err := msgServer.TransferLock(c, types.NewMsgTransferLock(addr1, resp.ID, addr2))
+	suite.Require().NoError(err)
+	suite.Require().True(transferResp.Success)
+	suite.AssertEventEmitted(suite.Ctx, types.TypeEvtTransferLock, 1)
+
+	lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, resp.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr2.String(), lock.Owner)
+}
+
+func (suite *KeeperTestSuite) TestMsgSplitLock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coinsToLock := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	coinsToSplit := sdk.Coins{sdk.NewInt64Coin("stake", 4)}
+
+	suite.SetupTest()
+	suite.FundAcc(addr1, coinsToLock)
+
+	msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+	c := sdk.WrapSDKContext(suite.Ctx)
+	resp, err := msgServer.LockTokens(c, types.NewMsgLockTokens(addr1, time.Second, coinsToLock))
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	c = sdk.WrapSDKContext(suite.Ctx)
+	splitResp, err := msgServer.SplitLock(c, types.NewMsgSplitLock(addr1, resp.ID, coinsToSplit))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSplitLock, 1)
+
+	splitLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, splitResp.NewLockID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coinsToSplit, splitLock.Coins)
+
+	lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, resp.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coinsToLock.Sub(coinsToSplit), lock.Coins)
+}
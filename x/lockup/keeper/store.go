@@ -235,6 +235,15 @@ func (k Keeper) GetLocksLongerThanDurationDenom(ctx sdk.Context, denom string, d
 	return combineLocks(notUnlockings, unlockings)
 }
 
+// GetLocksCreatedBeforeTimeDenom Returns the locks of the given denom created strictly before the given time.
+// Locks that existed before creation times were recorded have a zero creation time and are always returned.
+func (k Keeper) GetLocksCreatedBeforeTimeDenom(ctx sdk.Context, denom string, timestamp time.Time) []types.PeriodLock {
+	// returns both unlocking started and not started
+	unlockings := k.getLocksFromIterator(ctx, k.LockIteratorCreatedBeforeTimeDenom(ctx, true, denom, timestamp))
+	notUnlockings := k.getLocksFromIterator(ctx, k.LockIteratorCreatedBeforeTimeDenom(ctx, false, denom, timestamp))
+	return combineLocks(notUnlockings, unlockings)
+}
+
 // GetLockByID Returns lock from lockID.
 func (k Keeper) GetLockByID(ctx sdk.Context, lockID uint64) (*types.PeriodLock, error) {
 	lock := types.PeriodLock{}
@@ -60,9 +60,20 @@ func durationLockRefKeys(lock types.PeriodLock) ([][]byte, error) {
 		refKeys = append(refKeys, combineKeys(types.KeyPrefixDenomLockDuration, denomBz, durationKey))
 		refKeys = append(refKeys, combineKeys(types.KeyPrefixAccountDenomLockDuration, owner, denomBz, durationKey))
 	}
+	refKeys = append(refKeys, creationTimeLockRefKeys(lock)...)
 	return refKeys, nil
 }
 
+// creationTimeLockRefKeys returns the keys indexing the lock by denom and creation time.
+func creationTimeLockRefKeys(lock types.PeriodLock) [][]byte {
+	refKeys := [][]byte{}
+	creationTimeKey := getTimeKey(lock.CreationTime)
+	for _, coin := range lock.Coins {
+		refKeys = append(refKeys, combineKeys(types.KeyPrefixDenomLockCreationTime, []byte(coin.Denom), creationTimeKey))
+	}
+	return refKeys
+}
+
 func lockRefKeys(lock types.PeriodLock) ([][]byte, error) {
 	refKeys, _ := durationLockRefKeys(lock)
 	timeKey := getTimeKey(lock.EndTime)
@@ -13,6 +13,8 @@ type LockupHooks interface {
 	OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 	OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration time.Duration, newDuration time.Duration)
+	OnLockTransfer(ctx sdk.Context, lockID uint64, prevOwner sdk.AccAddress, newOwner sdk.AccAddress)
+	OnLockSplit(ctx sdk.Context, lockID uint64, newLockID uint64, amount sdk.Coins)
 }
 
 var _ LockupHooks = MultiLockupHooks{}
@@ -59,3 +61,15 @@ func (h MultiLockupHooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDur
 		h[i].OnLockupExtend(ctx, lockID, prevDuration, newDuration)
 	}
 }
+
+func (h MultiLockupHooks) OnLockTransfer(ctx sdk.Context, lockID uint64, prevOwner, newOwner sdk.AccAddress) {
+	for i := range h {
+		h[i].OnLockTransfer(ctx, lockID, prevOwner, newOwner)
+	}
+}
+
+func (h MultiLockupHooks) OnLockSplit(ctx sdk.Context, lockID, newLockID uint64, amount sdk.Coins) {
+	for i := range h {
+		h[i].OnLockSplit(ctx, lockID, newLockID, amount)
+	}
+}
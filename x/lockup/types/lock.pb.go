@@ -31,21 +31,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // LockQueryType defines the type of the lock query that can
 // either be by duration or start time of the lock.
+// NoLock queries match no locks and are used by gauges that distribute
+// to concentrated liquidity pools instead of locks.
 type LockQueryType int32
 
 const (
 	ByDuration LockQueryType = 0
 	ByTime     LockQueryType = 1
+	NoLock     LockQueryType = 2
 )
 
 var LockQueryType_name = map[int32]string{
 	0: "ByDuration",
 	1: "ByTime",
+	2: "NoLock",
 }
 
 var LockQueryType_value = map[string]int32{
 	"ByDuration": 0,
 	"ByTime":     1,
+	"NoLock":     2,
 }
 
 func (x LockQueryType) String() string {
@@ -78,6 +83,10 @@ type PeriodLock struct {
 	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
 	// Coins are the tokens locked within the lock, kept in the module account.
 	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// CreationTime is the block time at which the lock was created, or at which
+	// tokens were last added to it. Locks created before this field existed have
+	// a zero creation time.
+	CreationTime time.Time `protobuf:"bytes,6,opt,name=creation_time,json=creationTime,proto3,stdtime" json:"creation_time" yaml:"creation_time"`
 }
 
 func (m *PeriodLock) Reset()         { *m = PeriodLock{} }
@@ -148,6 +157,13 @@ func (m *PeriodLock) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *PeriodLock) GetCreationTime() time.Time {
+	if m != nil {
+		return m.CreationTime
+	}
+	return time.Time{}
+}
+
 // QueryCondition is a struct used for querying locks upon different conditions.
 // Duration field and timestamp fields could be optional, depending on the
 // LockQueryType.
@@ -160,9 +176,9 @@ type QueryCondition struct {
 	// duration. Duration field must not be nil when the lock query type is
 	// `ByLockDuration`.
 	Duration time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
-	// Timestamp is used by locks started before the specified duration.
-	// Timestamp field must not be nil when the lock query type is `ByLockTime`.
-	// Querying locks with timestamp is currently not implemented.
+	// Timestamp is used to query locks created strictly before the specified
+	// time. Timestamp field must not be zero when the lock query type is
+	// `ByLockTime`.
 	Timestamp time.Time `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
 }
 
@@ -317,45 +333,47 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/lock.proto", fileDescriptor_7e9d7527a237b489) }
 
 var fileDescriptor_7e9d7527a237b489 = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0xdd, 0xa4, 0xb4, 0x57, 0x92, 0x46, 0xa7, 0x0e, 0x69, 0x00, 0x3b, 0xf2, 0x80, 0x22,
-	0xd4, 0xda, 0x24, 0x30, 0x31, 0xba, 0x61, 0x88, 0xd4, 0x01, 0x4c, 0xc5, 0xc0, 0x12, 0xf9, 0xc7,
-	0xe1, 0x9c, 0x62, 0xfb, 0x8c, 0x7f, 0x14, 0xfc, 0x1f, 0x30, 0x76, 0x04, 0x89, 0x8d, 0x8d, 0xbf,
-	0xa4, 0x63, 0x47, 0xa6, 0x14, 0x25, 0x62, 0x61, 0xec, 0x5f, 0x80, 0xee, 0xce, 0x97, 0xa4, 0x45,
-	0x48, 0x1d, 0x60, 0x3a, 0xbf, 0xfb, 0xde, 0xfb, 0xde, 0xbb, 0xef, 0x7d, 0x32, 0xd8, 0x27, 0x59,
-	0x44, 0x32, 0x9c, 0x99, 0x21, 0xf1, 0xa6, 0x45, 0xc2, 0x0e, 0x23, 0x49, 0x49, 0x4e, 0x60, 0xb3,
-	0x82, 0x0c, 0x0e, 0x75, 0xf6, 0x02, 0x12, 0x10, 0x06, 0x99, 0xf4, 0x8b, 0x67, 0x75, 0xd4, 0x80,
-	0x90, 0x20, 0x44, 0x26, 0x8b, 0xdc, 0xe2, 0xad, 0xe9, 0x17, 0xa9, 0x93, 0x63, 0x12, 0x57, 0xb8,
-	0x76, 0x13, 0xcf, 0x71, 0x84, 0xb2, 0xdc, 0x89, 0x12, 0x41, 0xe0, 0xb1, 0x3e, 0xa6, 0xeb, 0x64,
-	0xc8, 0x3c, 0xed, 0xbb, 0x28, 0x77, 0xfa, 0xa6, 0x47, 0x70, 0x45, 0xa0, 0xff, 0x54, 0x00, 0x78,
-	0x81, 0x52, 0x4c, 0xfc, 0x63, 0xe2, 0x4d, 0x61, 0x13, 0x28, 0xa3, 0x61, 0x5b, 0xee, 0xca, 0xbd,
-	0x9a, 0xad, 0x8c, 0x86, 0xf0, 0x21, 0xa8, 0x93, 0xf7, 0x31, 0x4a, 0xdb, 0x4a, 0x57, 0xee, 0x6d,
-	0x5b, 0xad, 0xab, 0x99, 0x76, 0xb7, 0x74, 0xa2, 0xf0, 0x99, 0xce, 0xae, 0x75, 0x9b, 0xc3, 0x70,
-	0x02, 0xb6, 0xc4, 0x64, 0xed, 0x8d, 0xae, 0xdc, 0xdb, 0x19, 0xec, 0x1b, 0x7c, 0x34, 0x43, 0x8c,
-	0x66, 0x0c, 0xab, 0x04, 0xab, 0x7f, 0x3e, 0xd3, 0xa4, 0x5f, 0x33, 0x0d, 0x8a, 0x92, 0x03, 0x12,
-	0xe1, 0x1c, 0x45, 0x49, 0x5e, 0x5e, 0xcd, 0xb4, 0x5d, 0xce, 0x2f, 0x30, 0xfd, 0xd3, 0xa5, 0x26,
-	0xdb, 0x4b, 0x76, 0x68, 0x83, 0x2d, 0x14, 0xfb, 0x63, 0xfa, 0xce, 0x76, 0x8d, 0x75, 0xea, 0xfc,
-	0xd1, 0xe9, 0x44, 0x88, 0x60, 0xdd, 0xa3, 0xad, 0x56, 0xa4, 0xa2, 0x52, 0x3f, 0xa3, 0xa4, 0x77,
-	0x50, 0xec, 0xd3, 0x54, 0xe8, 0x80, 0x3a, 0x95, 0x24, 0x6b, 0xd7, 0xbb, 0x1b, 0x6c, 0x74, 0x2e,
-	0x9a, 0x41, 0x45, 0x33, 0x2a, 0xd1, 0x8c, 0x23, 0x82, 0x63, 0xeb, 0x31, 0xe5, 0xfb, 0x76, 0xa9,
-	0xf5, 0x02, 0x9c, 0x4f, 0x0a, 0xd7, 0xf0, 0x48, 0x64, 0x56, 0x0a, 0xf3, 0xe3, 0x30, 0xf3, 0xa7,
-	0x66, 0x5e, 0x26, 0x28, 0x63, 0x05, 0x99, 0xcd, 0x99, 0xf5, 0xcf, 0x0a, 0x68, 0xbe, 0x2c, 0x50,
-	0x5a, 0x1e, 0x91, 0xd8, 0xc7, 0xec, 0x25, 0xcf, 0xc1, 0x2e, 0xdd, 0xfd, 0xf8, 0x1d, 0xbd, 0x1e,
-	0xd3, 0x1a, 0x26, 0x7c, 0x73, 0xf0, 0xc0, 0xb8, 0xee, 0x0d, 0x83, 0xae, 0x86, 0x15, 0x9f, 0x94,
-	0x09, 0xb2, 0x1b, 0xe1, 0x7a, 0x08, 0xf7, 0x40, 0xdd, 0x47, 0x31, 0x89, 0xf8, 0x8a, 0x6c, 0x1e,
-	0x50, 0x99, 0x6e, 0xbf, 0x90, 0x1b, 0x2a, 0xfd, 0x4d, 0xfa, 0xd7, 0x60, 0x7b, 0x69, 0xaf, 0x5b,
-	0x68, 0x7f, 0xbf, 0x62, 0x6d, 0x71, 0xd6, 0x65, 0x29, 0x17, 0x7f, 0x45, 0xa5, 0x7f, 0x51, 0x40,
-	0xe3, 0x55, 0x19, 0xe7, 0x13, 0x94, 0x63, 0x8f, 0xd9, 0xf0, 0x00, 0xc0, 0x22, 0xf6, 0x51, 0x1a,
-	0x96, 0x38, 0x0e, 0xc6, 0x4c, 0x25, 0xec, 0x57, 0xb6, 0x6c, 0xad, 0x10, 0x9a, 0x3b, 0xf2, 0xa1,
-	0x06, 0x76, 0x32, 0x5a, 0x3e, 0x5e, 0xd7, 0x01, 0xb0, 0xab, 0xa1, 0x10, 0x63, 0xe9, 0x99, 0x8d,
-	0x7f, 0xe4, 0x99, 0x75, 0xc7, 0xd7, 0xfe, 0xa7, 0xe3, 0x1f, 0xf5, 0x41, 0xe3, 0x9a, 0x01, 0x60,
-	0x13, 0x00, 0xab, 0x14, 0xdc, 0x2d, 0x09, 0x02, 0xb0, 0x69, 0x95, 0x74, 0xa8, 0x96, 0xdc, 0xa9,
-	0x7d, 0xfc, 0xaa, 0x4a, 0xd6, 0xf1, 0xf9, 0x5c, 0x95, 0x2f, 0xe6, 0xaa, 0xfc, 0x63, 0xae, 0xca,
-	0x67, 0x0b, 0x55, 0xba, 0x58, 0xa8, 0xd2, 0xf7, 0x85, 0x2a, 0xbd, 0x19, 0xac, 0x19, 0xb7, 0x72,
-	0xd9, 0x61, 0xe8, 0xb8, 0x99, 0x08, 0xcc, 0xd3, 0xfe, 0x53, 0xf3, 0x83, 0xf8, 0x5f, 0x31, 0x23,
-	0xbb, 0x9b, 0xec, 0x41, 0x4f, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x22, 0x48, 0xd4, 0xe2, 0xce,
-	0x04, 0x00, 0x00,
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbd, 0x6e, 0xd4, 0x4c,
+	0x14, 0xb5, 0xbd, 0xbb, 0xf9, 0x92, 0x49, 0x76, 0x63, 0x8d, 0x52, 0x6c, 0xf6, 0x03, 0x7b, 0xe5,
+	0x02, 0xad, 0x50, 0x62, 0xb3, 0x81, 0x0a, 0x89, 0xc6, 0x59, 0x8a, 0x48, 0x11, 0x02, 0x13, 0x51,
+	0xd0, 0xac, 0xfc, 0x33, 0x38, 0xa3, 0xd8, 0x1e, 0xe3, 0x9f, 0x80, 0xdf, 0x80, 0x32, 0x25, 0x48,
+	0x74, 0x74, 0x3c, 0x49, 0xca, 0x94, 0x54, 0x1b, 0x94, 0xed, 0x28, 0xf3, 0x04, 0x68, 0x66, 0x3c,
+	0xfb, 0x13, 0x84, 0x48, 0x01, 0x95, 0x67, 0xe6, 0xde, 0x7b, 0xe6, 0xcc, 0xb9, 0xe7, 0x1a, 0x6c,
+	0x93, 0x3c, 0x26, 0x39, 0xce, 0xad, 0x88, 0xf8, 0x27, 0x65, 0xca, 0x3e, 0x66, 0x9a, 0x91, 0x82,
+	0xc0, 0x4e, 0x1d, 0x32, 0x79, 0xa8, 0xb7, 0x15, 0x92, 0x90, 0xb0, 0x90, 0x45, 0x57, 0x3c, 0xab,
+	0xa7, 0x85, 0x84, 0x84, 0x11, 0xb2, 0xd8, 0xce, 0x2b, 0xdf, 0x58, 0x41, 0x99, 0xb9, 0x05, 0x26,
+	0x49, 0x1d, 0xd7, 0x6f, 0xc6, 0x0b, 0x1c, 0xa3, 0xbc, 0x70, 0xe3, 0x54, 0x00, 0xf8, 0xec, 0x1e,
+	0xcb, 0x73, 0x73, 0x64, 0x9d, 0x0e, 0x3d, 0x54, 0xb8, 0x43, 0xcb, 0x27, 0xb8, 0x06, 0x30, 0xce,
+	0x1b, 0x00, 0x3c, 0x47, 0x19, 0x26, 0xc1, 0x21, 0xf1, 0x4f, 0x60, 0x07, 0x28, 0x07, 0xa3, 0xae,
+	0xdc, 0x97, 0x07, 0x4d, 0x47, 0x39, 0x18, 0xc1, 0x7b, 0xa0, 0x45, 0xde, 0x25, 0x28, 0xeb, 0x2a,
+	0x7d, 0x79, 0xb0, 0x66, 0xab, 0xd7, 0x13, 0x7d, 0xa3, 0x72, 0xe3, 0xe8, 0xb1, 0xc1, 0x8e, 0x0d,
+	0x87, 0x87, 0xe1, 0x31, 0x58, 0x15, 0xcc, 0xba, 0x8d, 0xbe, 0x3c, 0x58, 0xdf, 0xdb, 0x36, 0x39,
+	0x35, 0x53, 0x50, 0x33, 0x47, 0x75, 0x82, 0x3d, 0x3c, 0x9f, 0xe8, 0xd2, 0x8f, 0x89, 0x0e, 0x45,
+	0xc9, 0x0e, 0x89, 0x71, 0x81, 0xe2, 0xb4, 0xa8, 0xae, 0x27, 0xfa, 0x26, 0xc7, 0x17, 0x31, 0xe3,
+	0xe3, 0xa5, 0x2e, 0x3b, 0x33, 0x74, 0xe8, 0x80, 0x55, 0x94, 0x04, 0x63, 0xfa, 0xce, 0x6e, 0x93,
+	0xdd, 0xd4, 0xfb, 0xe5, 0xa6, 0x23, 0x21, 0x82, 0xfd, 0x3f, 0xbd, 0x6a, 0x0e, 0x2a, 0x2a, 0x8d,
+	0x33, 0x0a, 0xfa, 0x1f, 0x4a, 0x02, 0x9a, 0x0a, 0x5d, 0xd0, 0xa2, 0x92, 0xe4, 0xdd, 0x56, 0xbf,
+	0xc1, 0xa8, 0x73, 0xd1, 0x4c, 0x2a, 0x9a, 0x59, 0x8b, 0x66, 0xee, 0x13, 0x9c, 0xd8, 0x0f, 0x28,
+	0xde, 0xd7, 0x4b, 0x7d, 0x10, 0xe2, 0xe2, 0xb8, 0xf4, 0x4c, 0x9f, 0xc4, 0x56, 0xad, 0x30, 0xff,
+	0xec, 0xe6, 0xc1, 0x89, 0x55, 0x54, 0x29, 0xca, 0x59, 0x41, 0xee, 0x70, 0x64, 0xe8, 0x82, 0xb6,
+	0x9f, 0x21, 0xf6, 0x04, 0xce, 0x7d, 0xe5, 0x8f, 0xdc, 0xfb, 0x35, 0xf7, 0x2d, 0xce, 0x7d, 0xa9,
+	0x9c, 0x3f, 0x60, 0x43, 0x9c, 0xd1, 0x22, 0xe3, 0x93, 0x02, 0x3a, 0x2f, 0x4a, 0x94, 0x55, 0xfb,
+	0x24, 0x09, 0x30, 0x13, 0xeb, 0x29, 0xd8, 0xa4, 0xf6, 0x1a, 0xbf, 0xa5, 0xc7, 0x63, 0x4a, 0x8b,
+	0xf5, 0xb6, 0xb3, 0x77, 0xd7, 0x5c, 0xb6, 0x9f, 0x49, 0xbb, 0xcf, 0x8a, 0x8f, 0xaa, 0x14, 0x39,
+	0xed, 0x68, 0x71, 0x0b, 0xb7, 0x40, 0x2b, 0x40, 0x09, 0x89, 0xb9, 0x0b, 0x1c, 0xbe, 0xa1, 0x9d,
+	0xb8, 0x7d, 0xcf, 0x6f, 0x34, 0xe2, 0x77, 0xdd, 0x7d, 0x05, 0xd6, 0x66, 0x0e, 0xbe, 0x45, 0x7b,
+	0xef, 0xd4, 0xa8, 0x2a, 0x47, 0x9d, 0x95, 0x72, 0x79, 0xe6, 0x50, 0xc6, 0x67, 0x05, 0xb4, 0x5f,
+	0x56, 0x49, 0x71, 0x8c, 0x0a, 0xec, 0x33, 0xa7, 0xef, 0x00, 0x58, 0x26, 0x01, 0xca, 0xa2, 0x0a,
+	0x27, 0xe1, 0x98, 0xa9, 0x84, 0x83, 0xda, 0xf9, 0xea, 0x3c, 0x42, 0x73, 0x0f, 0x02, 0xa8, 0x83,
+	0xf5, 0x9c, 0x96, 0x8f, 0x17, 0x75, 0x00, 0xec, 0x68, 0x24, 0xc4, 0x98, 0xd9, 0xb2, 0xf1, 0x97,
+	0x6c, 0xb9, 0x38, 0x54, 0xcd, 0x7f, 0x39, 0x54, 0xf7, 0x9f, 0x80, 0xf6, 0x92, 0x01, 0x60, 0x07,
+	0x00, 0xbb, 0x12, 0xd8, 0xaa, 0x04, 0x01, 0x58, 0xb1, 0x2b, 0x4a, 0x4a, 0x95, 0xe9, 0xfa, 0x19,
+	0xa1, 0xe9, 0xaa, 0xd2, 0x6b, 0x7e, 0xf8, 0xa2, 0x49, 0xf6, 0xe1, 0xf9, 0x95, 0x26, 0x5f, 0x5c,
+	0x69, 0xf2, 0xf7, 0x2b, 0x4d, 0x3e, 0x9b, 0x6a, 0xd2, 0xc5, 0x54, 0x93, 0xbe, 0x4d, 0x35, 0xe9,
+	0xf5, 0xde, 0xc2, 0x9c, 0xd4, 0x8e, 0xdb, 0x8d, 0x5c, 0x2f, 0x17, 0x1b, 0xeb, 0x74, 0xf8, 0xc8,
+	0x7a, 0x2f, 0x7e, 0x8f, 0x6c, 0x6e, 0xbc, 0x15, 0xf6, 0xb8, 0x87, 0x3f, 0x07, 0x00, 0xc2, 0x3a,
+	0x78, 0x0f, 0x3d, 0x05, 0x00, 0x00,
 }
 
 func (m *PeriodLock) Marshal() (dAtA []byte, err error) {
@@ -378,6 +396,14 @@ func (m *PeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLock(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -392,21 +418,21 @@ func (m *PeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x2a
 		}
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintLock(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintLock(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintLock(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x1a
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
@@ -443,21 +469,21 @@ func (m *QueryCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintLock(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x22
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintLock(dAtA, i, uint64(n4))
 	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintLock(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x1a
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
@@ -494,21 +520,21 @@ func (m *SyntheticLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintLock(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x22
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
 	if err6 != nil {
 		return 0, err6
 	}
 	i -= n6
 	i = encodeVarintLock(dAtA, i, uint64(n6))
 	i--
+	dAtA[i] = 0x22
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintLock(dAtA, i, uint64(n7))
+	i--
 	dAtA[i] = 0x1a
 	if len(m.SynthDenom) > 0 {
 		i -= len(m.SynthDenom)
@@ -559,6 +585,8 @@ func (m *PeriodLock) Size() (n int) {
 			n += 1 + l + sovLock(uint64(l))
 		}
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreationTime)
+	n += 1 + l + sovLock(uint64(l))
 	return n
 }
 
@@ -788,6 +816,39 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -27,10 +27,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	distrInfo := k.GetDistrInfo(ctx)
 	lastPoolId := k.poolmanagerKeeper.GetNextPoolId(ctx)
-	lockableDurations := k.GetLockableDurations(ctx)
 	var poolToGauges types.PoolToGauges
 	for i := 1; i < int(lastPoolId); i++ {
-		for _, duration := range lockableDurations {
+		for _, duration := range k.GetPoolGaugeDurations(ctx, uint64(i)) {
 			gaugeID, err := k.GetPoolGaugeId(ctx, uint64(i), duration)
 			if err != nil {
 				panic(err)
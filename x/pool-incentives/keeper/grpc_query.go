@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,15 +33,15 @@ func (q Querier) GaugeIds(ctx context.Context, req *types.QueryGaugeIdsRequest)
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	lockableDurations := q.Keeper.GetLockableDurations(sdkCtx)
+	gaugeDurations := q.Keeper.GetPoolGaugeDurations(sdkCtx, req.PoolId)
 	distrInfo := q.Keeper.GetDistrInfo(sdkCtx)
-	gaugeIdsWithDuration := make([]*types.QueryGaugeIdsResponse_GaugeIdWithDuration, len(lockableDurations))
+	gaugeIdsWithDuration := make([]*types.QueryGaugeIdsResponse_GaugeIdWithDuration, len(gaugeDurations))
 
 	totalWeightDec := distrInfo.TotalWeight.ToDec()
 	incentivePercentage := sdk.NewDec(0)
 	percentMultiplier := sdk.NewInt(100)
 
-	for i, duration := range lockableDurations {
+	for i, duration := range gaugeDurations {
 		gaugeId, err := q.Keeper.GetPoolGaugeId(sdkCtx, req.PoolId, duration)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
@@ -91,8 +92,11 @@ func (q Querier) IncentivizedPools(ctx context.Context, _ *types.QueryIncentiviz
 	// equals to the number of incentivized gauges / number of lockable durations.
 	incentivizedPools := make([]types.IncentivizedPool, 0, len(distrInfo.Records)/len(lockableDurations))
 
+	// the gauges of concentrated liquidity pools are stored under the zero duration.
+	gaugeDurations := append([]time.Duration{0}, lockableDurations...)
+
 	for _, record := range distrInfo.Records {
-		for _, lockableDuration := range lockableDurations {
+		for _, lockableDuration := range gaugeDurations {
 			poolId, err := q.Keeper.GetPoolIdFromGaugeId(sdkCtx, record.GaugeId, lockableDuration)
 			if err == nil {
 				incentivizedPool := types.IncentivizedPool{
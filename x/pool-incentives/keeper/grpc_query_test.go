@@ -67,6 +67,20 @@ func (suite *KeeperTestSuite) TestGaugeIds() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGaugeIdsConcentratedPool() {
+	suite.SetupTest()
+	clPool := suite.PrepareConcentratedPool()
+
+	res, err := suite.queryClient.GaugeIds(context.Background(), &types.QueryGaugeIdsRequest{PoolId: clPool.GetId()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, len(res.GaugeIdsWithDuration))
+
+	gaugeId, err := suite.App.PoolIncentivesKeeper.GetPoolGaugeId(suite.Ctx, clPool.GetId(), 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(gaugeId, res.GaugeIdsWithDuration[0].GaugeId)
+	suite.Require().Equal(time.Duration(0), res.GaugeIdsWithDuration[0].Duration)
+}
+
 func (suite *KeeperTestSuite) TestDistrInfo() {
 	for _, tc := range []struct {
 		desc                 string
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cltypes "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v14/x/gamm/types"
 	minttypes "github.com/osmosis-labs/osmosis/v14/x/mint/types"
 )
@@ -60,3 +61,39 @@ func (h Hooks) AfterDistributeMintedCoin(ctx sdk.Context) {
 		panic(err)
 	}
 }
+
+type concentratedLiquidityListener struct {
+	k Keeper
+}
+
+var _ cltypes.ConcentratedLiquidityListener = concentratedLiquidityListener{}
+
+// ConcentratedLiquidityListener returns the listener that creates the gauges
+// of the created concentrated liquidity pools.
+func (k Keeper) ConcentratedLiquidityListener() cltypes.ConcentratedLiquidityListener {
+	return concentratedLiquidityListener{k}
+}
+
+// AfterConcentratedPoolCreated creates the NoLock gauge paying out to the pool's positions.
+func (l concentratedLiquidityListener) AfterConcentratedPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	err := l.k.CreateConcentratedPoolGauge(ctx, poolId)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// AfterConcentratedPoolSwap is a noop.
+func (l concentratedLiquidityListener) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+}
+
+// AfterPositionCreated is a noop.
+func (l concentratedLiquidityListener) AfterPositionCreated(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidity sdk.Dec) {
+}
+
+// AfterPositionModified is a noop.
+func (l concentratedLiquidityListener) AfterPositionModified(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidityDelta sdk.Dec) {
+}
+
+// AfterPositionWithdrawn is a noop.
+func (l concentratedLiquidityListener) AfterPositionWithdrawn(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensOut sdk.Coins, liquidity sdk.Dec) {
+}
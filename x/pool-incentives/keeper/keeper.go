@@ -86,6 +86,39 @@ func (k Keeper) CreatePoolGauges(ctx sdk.Context, poolId uint64) error {
 	return nil
 }
 
+// CreateConcentratedPoolGauge creates the perpetual NoLock gauge that pays out to the positions
+// of the given concentrated liquidity pool. NoLock gauges do not distribute to locks of any duration,
+// so the gauge is stored under the zero duration.
+func (k Keeper) CreateConcentratedPoolGauge(ctx sdk.Context, poolId uint64) error {
+	gaugeId, err := k.incentivesKeeper.CreateGauge(
+		ctx,
+		true,
+		k.accountKeeper.GetModuleAddress(types.ModuleName),
+		sdk.Coins{},
+		lockuptypes.QueryCondition{
+			LockQueryType: lockuptypes.NoLock,
+			Denom:         incentivestypes.GetNoLockGaugeDenom(poolId),
+		},
+		ctx.BlockTime(),
+		1,
+	)
+	if err != nil {
+		return err
+	}
+
+	k.SetPoolGaugeId(ctx, poolId, 0, gaugeId)
+	return nil
+}
+
+// GetPoolGaugeDurations returns the durations the gauges of the given pool are stored under:
+// the zero duration for concentrated liquidity pools, and the lockable durations otherwise.
+func (k Keeper) GetPoolGaugeDurations(ctx sdk.Context, poolId uint64) []time.Duration {
+	if _, err := k.GetPoolGaugeId(ctx, poolId, 0); err == nil {
+		return []time.Duration{0}
+	}
+	return k.GetLockableDurations(ctx)
+}
+
 func (k Keeper) SetPoolGaugeId(ctx sdk.Context, poolId uint64, lockableDuration time.Duration, gaugeId uint64) {
 	key := types.GetPoolGaugeIdStoreKey(poolId, lockableDuration)
 	store := ctx.KVStore(k.storeKey)
@@ -138,11 +171,10 @@ func (k Keeper) GetAllGauges(ctx sdk.Context) []incentivestypes.Gauge {
 }
 
 func (k Keeper) IsPoolIncentivized(ctx sdk.Context, poolId uint64) bool {
-	lockableDurations := k.GetLockableDurations(ctx)
 	distrInfo := k.GetDistrInfo(ctx)
 
 	candidateGaugeIds := []uint64{}
-	for _, lockableDuration := range lockableDurations {
+	for _, lockableDuration := range k.GetPoolGaugeDurations(ctx, poolId) {
 		gaugeId, err := k.GetPoolGaugeId(ctx, poolId, lockableDuration)
 		if err == nil {
 			candidateGaugeIds = append(candidateGaugeIds, gaugeId)
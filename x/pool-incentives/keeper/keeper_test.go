@@ -2,11 +2,15 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/osmosis-labs/osmosis/v14/app/apptesting"
 	gammtypes "github.com/osmosis-labs/osmosis/v14/x/gamm/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v14/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v14/x/pool-incentives/types"
 )
 
@@ -71,3 +75,41 @@ func (suite *KeeperTestSuite) TestCreateBalancerPoolGauges() {
 		suite.Equal(lockableDurations[2], gauge.DistributeTo.Duration)
 	}
 }
+
+func (suite *KeeperTestSuite) TestCreateConcentratedPoolGauge() {
+	suite.SetupTest()
+
+	keeper := suite.App.PoolIncentivesKeeper
+
+	balancerPoolId := suite.PrepareBalancerPool()
+	clPool := suite.PrepareConcentratedPool()
+
+	// A single NoLock gauge must be created for every concentrated liquidity pool, stored under the zero duration.
+	gaugeId, err := keeper.GetPoolGaugeId(suite.Ctx, clPool.GetId(), 0)
+	suite.Require().NoError(err)
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeId)
+	suite.Require().NoError(err)
+	suite.Equal(0, len(gauge.Coins))
+	suite.Equal(true, gauge.IsPerpetual)
+	suite.Equal(lockuptypes.NoLock, gauge.DistributeTo.LockQueryType)
+	suite.Equal(incentivestypes.GetNoLockGaugeDenom(clPool.GetId()), gauge.DistributeTo.Denom)
+	poolId, err := keeper.GetPoolIdFromGaugeId(suite.Ctx, gaugeId, 0)
+	suite.Require().NoError(err)
+	suite.Equal(clPool.GetId(), poolId)
+
+	suite.Equal([]time.Duration{0}, keeper.GetPoolGaugeDurations(suite.Ctx, clPool.GetId()))
+	suite.Equal(keeper.GetLockableDurations(suite.Ctx), keeper.GetPoolGaugeDurations(suite.Ctx, balancerPoolId))
+
+	// The gauge can be incentivized by a distribution record.
+	suite.False(keeper.IsPoolIncentivized(suite.Ctx, clPool.GetId()))
+	keeper.SetDistrInfo(suite.Ctx, types.DistrInfo{
+		TotalWeight: sdk.NewInt(100),
+		Records:     []types.DistrRecord{{GaugeId: gaugeId, Weight: sdk.NewInt(100)}},
+	})
+	suite.True(keeper.IsPoolIncentivized(suite.Ctx, clPool.GetId()))
+
+	// Both pools' gauges are exported.
+	genesis := keeper.ExportGenesis(suite.Ctx)
+	suite.Equal(len(keeper.GetLockableDurations(suite.Ctx))+1, len(genesis.PoolToGauges.PoolToGauge))
+	suite.Equal(types.PoolToGauge{PoolId: clPool.GetId(), GaugeId: gaugeId, Duration: 0}, genesis.PoolToGauges.PoolToGauge[len(genesis.PoolToGauges.PoolToGauge)-1])
+}
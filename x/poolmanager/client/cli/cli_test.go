@@ -2,9 +2,12 @@ package cli_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
@@ -184,6 +187,99 @@ func TestNewSwapExactAmountInCmd(t *testing.T) {
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
 
+func TestNewSwapExactAmountInWithPriceLimitCmd(t *testing.T) {
+	desc, _ := cli.NewSwapExactAmountInWithPriceLimitCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgSwapExactAmountInWithPriceLimit]{
+		"swap exact amount in with price limit": {
+			Cmd: "1 10stake node0token 3 0.95 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSwapExactAmountInWithPriceLimit{
+				Sender:            testAddresses[0].String(),
+				PoolId:            1,
+				TokenIn:           sdk.NewInt64Coin("stake", 10),
+				TokenOutDenom:     "node0token",
+				TokenOutMinAmount: sdk.NewIntFromUint64(3),
+				PriceLimit:        sdk.MustNewDecFromStr("0.95"),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewSplitRouteSwapExactAmountInCmd(t *testing.T) {
+	routesFile := filepath.Join(t.TempDir(), "routes.json")
+	routesJSON := `[
+		{"pools": [{"pool_id": 1, "token_out_denom": "node0token"}], "token_in_amount": "10"},
+		{"pools": [{"pool_id": 2, "token_out_denom": "uosmo"}, {"pool_id": 3, "token_out_denom": "node0token"}], "token_in_amount": "20"}
+	]`
+	require.NoError(t, os.WriteFile(routesFile, []byte(routesJSON), 0o600))
+
+	desc, _ := cli.NewSplitRouteSwapExactAmountInCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgSplitRouteSwapExactAmountIn]{
+		"split route swap exact amount in": {
+			Cmd: "stake 3 --routes-file=" + routesFile + " --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSplitRouteSwapExactAmountIn{
+				Sender: testAddresses[0].String(),
+				Routes: []types.SwapAmountInSplitRoute{
+					{
+						Pools:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "node0token"}},
+						TokenInAmount: sdk.NewInt(10),
+					},
+					{
+						Pools:         []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "uosmo"}, {PoolId: 3, TokenOutDenom: "node0token"}},
+						TokenInAmount: sdk.NewInt(20),
+					},
+				},
+				TokenInDenom:      "stake",
+				TokenOutMinAmount: sdk.NewIntFromUint64(3),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewSplitRouteSwapExactAmountOutCmd(t *testing.T) {
+	routesFile := filepath.Join(t.TempDir(), "routes.json")
+	routesJSON := `[{"pools": [{"pool_id": 1, "token_in_denom": "stake"}], "token_out_amount": "10"}]`
+	require.NoError(t, os.WriteFile(routesFile, []byte(routesJSON), 0o600))
+
+	desc, _ := cli.NewSplitRouteSwapExactAmountOutCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgSplitRouteSwapExactAmountOut]{
+		"split route swap exact amount out": {
+			Cmd: "node0token 20 --routes-file=" + routesFile + " --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSplitRouteSwapExactAmountOut{
+				Sender: testAddresses[0].String(),
+				Routes: []types.SwapAmountOutSplitRoute{
+					{
+						Pools:          []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "stake"}},
+						TokenOutAmount: sdk.NewInt(10),
+					},
+				},
+				TokenOutDenom:    "node0token",
+				TokenInMaxAmount: sdk.NewIntFromUint64(20),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewSwapExactAmountOutWithPriceLimitCmd(t *testing.T) {
+	desc, _ := cli.NewSwapExactAmountOutWithPriceLimitCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgSwapExactAmountOutWithPriceLimit]{
+		"swap exact amount out with price limit": {
+			Cmd: "1 stake 20 10node0token 0.95 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSwapExactAmountOutWithPriceLimit{
+				Sender:           testAddresses[0].String(),
+				PoolId:           1,
+				TokenInDenom:     "stake",
+				TokenInMaxAmount: sdk.NewIntFromUint64(20),
+				TokenOut:         sdk.NewInt64Coin("node0token", 10),
+				PriceLimit:       sdk.MustNewDecFromStr("0.95"),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestGetCmdNumPools(t *testing.T) {
 	desc, _ := cli.GetCmdNumPools()
 	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.NumPoolsRequest]{
@@ -225,6 +321,67 @@ func TestGetCmdEstimateSwapExactAmountOut(t *testing.T) {
 	}
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
+func TestGetCmdEstimateSwapExactAmountInWithPriceLimit(t *testing.T) {
+	desc, _ := cli.GetCmdEstimateSwapExactAmountInWithPriceLimit()
+	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.EstimateSwapExactAmountInWithPriceLimitRequest]{
+		"basic test": {
+			Cmd: "1 10stake node0token 0.95",
+			ExpectedQuery: &queryproto.EstimateSwapExactAmountInWithPriceLimitRequest{
+				PoolId:        1,
+				TokenIn:       "10stake",
+				TokenOutDenom: "node0token",
+				PriceLimit:    sdk.MustNewDecFromStr("0.95"),
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdEstimateSwapExactAmountOutWithPriceLimit(t *testing.T) {
+	desc, _ := cli.GetCmdEstimateSwapExactAmountOutWithPriceLimit()
+	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.EstimateSwapExactAmountOutWithPriceLimitRequest]{
+		"basic test": {
+			Cmd: "1 stake 10node0token 0.95",
+			ExpectedQuery: &queryproto.EstimateSwapExactAmountOutWithPriceLimitRequest{
+				PoolId:       1,
+				TokenInDenom: "stake",
+				TokenOut:     "10node0token",
+				PriceLimit:   sdk.MustNewDecFromStr("0.95"),
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdRouteQuote(t *testing.T) {
+	desc, _ := cli.GetCmdRouteQuote()
+	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.RouteQuoteRequest]{
+		"basic test": {
+			Cmd: "10stake node0token",
+			ExpectedQuery: &queryproto.RouteQuoteRequest{
+				TokenIn:       "10stake",
+				TokenOutDenom: "node0token",
+				PoolTypes:     []types.PoolType{},
+			},
+		},
+		"with flags": {
+			Cmd: "10stake node0token --max-hops=3 --max-routes=2 --pool-types=Balancer,Concentrated",
+			ExpectedQuery: &queryproto.RouteQuoteRequest{
+				TokenIn:       "10stake",
+				TokenOutDenom: "node0token",
+				MaxHops:       3,
+				MaxRoutes:     2,
+				PoolTypes:     []types.PoolType{types.Balancer, types.Concentrated},
+			},
+		},
+		"invalid pool type": {
+			Cmd:         "10stake node0token --pool-types=Unknown",
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
 func (s *IntegrationTestSuite) TestNewCreatePoolCmd() {
 	val := s.network.Validators[0]
 
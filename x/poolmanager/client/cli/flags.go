@@ -21,6 +21,14 @@ const (
 	FlagSwapRoutePoolIds = "swap-route-pool-ids"
 	// Will be parsed to []string.
 	FlagSwapRouteDenoms = "swap-route-denoms"
+	// Will be parsed to string.
+	FlagRoutesFile = "routes-file"
+	// Will be parsed to uint64.
+	FlagMaxHops = "max-hops"
+	// Will be parsed to uint64.
+	FlagMaxRoutes = "max-routes"
+	// Will be parsed to []types.PoolType.
+	FlagPoolTypes = "pool-types"
 )
 
 type createBalancerPoolInputs struct {
@@ -70,6 +78,22 @@ func FlagSetSwapAmountOutRoutes() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetSplitRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagRoutesFile, "", "Split routes json file path")
+	return fs
+}
+
+func FlagSetRouteQuote() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagMaxHops, 0, "Maximum number of pools in a route (defaults to 2)")
+	fs.Uint64(FlagMaxRoutes, 0, "Maximum number of routes returned (defaults to 5)")
+	fs.String(FlagPoolTypes, "", "Comma separated pool types to route through, e.g. Balancer,Concentrated (defaults to all)")
+	return fs
+}
+
 func FlagSetCreatePool() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -95,3 +95,27 @@ func parseCreateStableswapPoolFlags(fs *pflag.FlagSet) (*createStableswapPoolInp
 
 	return pool, nil
 }
+
+// parseSplitRoutesFile parses the split routes from the json file passed in with the --routes-file flag.
+// Errors if the file is missing or if it contains unexpected fields.
+func parseSplitRoutesFile[T any](fs *pflag.FlagSet) ([]T, error) {
+	routesFile, _ := fs.GetString(FlagRoutesFile)
+
+	if routesFile == "" {
+		return nil, fmt.Errorf("must pass in a routes json using the --%s flag", FlagRoutesFile)
+	}
+
+	contents, err := os.ReadFile(routesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := []T{}
+	dec := json.NewDecoder(bytes.NewReader(contents))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&routes); err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cobra"
@@ -16,6 +18,16 @@ var customRouterFlagOverride = map[string]string{
 	"router": FlagSwapRouteDenoms,
 }
 
+var splitRoutesFlagOverride = map[string]string{
+	"routes": FlagRoutesFile,
+}
+
+var routeQuoteFlagOverride = map[string]string{
+	"maxhops":   FlagMaxHops,
+	"maxroutes": FlagMaxRoutes,
+	"pooltypes": FlagPoolTypes,
+}
+
 // GetQueryCmd returns the cli query commands for this module.
 func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
@@ -23,6 +35,11 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdNumPools)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSwapExactAmountInWithPriceLimit)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSwapExactAmountOutWithPriceLimit)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSplitRouteSwapExactAmountIn)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSplitRouteSwapExactAmountOut)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdRouteQuote)
 
 	return cmd
 }
@@ -55,6 +72,72 @@ func GetCmdEstimateSwapExactAmountOut() (*osmocli.QueryDescriptor, *queryproto.E
 	}, &queryproto.EstimateSwapExactAmountOutRequest{}
 }
 
+// GetCmdEstimateSwapExactAmountInWithPriceLimit returns estimation of how much of the input coin can be swapped,
+// and the resulting output amount, before the pool's spot price reaches the given price limit.
+func GetCmdEstimateSwapExactAmountInWithPriceLimit() (*osmocli.QueryDescriptor, *queryproto.EstimateSwapExactAmountInWithPriceLimitRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-swap-exact-amount-in-with-price-limit [pool-id] [token-in] [token-out-denom] [price-limit]",
+		Short: "Query estimate-swap-exact-amount-in-with-price-limit",
+		Long: `Query estimate-swap-exact-amount-in-with-price-limit.
+The price limit is the spot price of the token in, quoted in the token out denom, at which the swap stops.{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-swap-exact-amount-in-with-price-limit 1 1000stake uosmo 0.95`,
+	}, &queryproto.EstimateSwapExactAmountInWithPriceLimitRequest{}
+}
+
+// GetCmdEstimateSplitRouteSwapExactAmountIn returns estimation of the total output coin of a swap split across several routes.
+func GetCmdEstimateSplitRouteSwapExactAmountIn() (*osmocli.QueryDescriptor, *queryproto.EstimateSplitRouteSwapExactAmountInRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-split-route-swap-exact-amount-in [token-in-denom]",
+		Short: "Query estimate-split-route-swap-exact-amount-in",
+		Long: `Query estimate-split-route-swap-exact-amount-in.
+The routes JSON file has the same format as for the split-route-swap-exact-amount-in tx.{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-split-route-swap-exact-amount-in uosmo --routes-file="routes.json"`,
+		ParseQuery:          EstimateSplitRouteSwapExactAmountInParseArgs,
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetSplitRoutes()}},
+		CustomFlagOverrides: splitRoutesFlagOverride,
+	}, &queryproto.EstimateSplitRouteSwapExactAmountInRequest{}
+}
+
+// GetCmdEstimateSplitRouteSwapExactAmountOut returns estimation of the total input coin of a swap split across several routes.
+func GetCmdEstimateSplitRouteSwapExactAmountOut() (*osmocli.QueryDescriptor, *queryproto.EstimateSplitRouteSwapExactAmountOutRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-split-route-swap-exact-amount-out [token-out-denom]",
+		Short: "Query estimate-split-route-swap-exact-amount-out",
+		Long: `Query estimate-split-route-swap-exact-amount-out.
+The routes JSON file has the same format as for the split-route-swap-exact-amount-out tx.{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-split-route-swap-exact-amount-out uion --routes-file="routes.json"`,
+		ParseQuery:          EstimateSplitRouteSwapExactAmountOutParseArgs,
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetSplitRoutes()}},
+		CustomFlagOverrides: splitRoutesFlagOverride,
+	}, &queryproto.EstimateSplitRouteSwapExactAmountOutRequest{}
+}
+
+// GetCmdRouteQuote returns the best candidate routes for swapping the input coin into the output denom.
+func GetCmdRouteQuote() (*osmocli.QueryDescriptor, *queryproto.RouteQuoteRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "route-quote [token-in] [token-out-denom]",
+		Short: "Query the best routes for swapping token in into token out denom",
+		Long: `{{.Short}}
+Routes are ordered by their estimated amount out.{{.ExampleHeader}}
+{{.CommandPrefix}} route-quote 1000uosmo uion --max-hops=3 --max-routes=5 --pool-types=Balancer,Stableswap`,
+		ParseQuery:          RouteQuoteParseArgs,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetRouteQuote()}},
+		CustomFlagOverrides: routeQuoteFlagOverride,
+	}, &queryproto.RouteQuoteRequest{}
+}
+
+// GetCmdEstimateSwapExactAmountOutWithPriceLimit returns estimation of how much of the output coin can be swapped for,
+// and the required input amount, before the pool's spot price reaches the given price limit.
+func GetCmdEstimateSwapExactAmountOutWithPriceLimit() (*osmocli.QueryDescriptor, *queryproto.EstimateSwapExactAmountOutWithPriceLimitRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-swap-exact-amount-out-with-price-limit [pool-id] [token-in-denom] [token-out] [price-limit]",
+		Short: "Query estimate-swap-exact-amount-out-with-price-limit",
+		Long: `Query estimate-swap-exact-amount-out-with-price-limit.
+The price limit is the spot price of the token in, quoted in the token out denom, at which the swap stops.{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-swap-exact-amount-out-with-price-limit 1 stake 1000uosmo 0.95`,
+	}, &queryproto.EstimateSwapExactAmountOutWithPriceLimitRequest{}
+}
+
 // GetCmdNumPools return number of pools available.
 func GetCmdNumPools() (*osmocli.QueryDescriptor, *queryproto.NumPoolsRequest) {
 	return &osmocli.QueryDescriptor{
@@ -101,3 +184,63 @@ func EstimateSwapExactAmountOutParseArgs(args []string, fs *flag.FlagSet) (proto
 		TokenOut: args[2],
 	}, nil
 }
+
+func EstimateSplitRouteSwapExactAmountInParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
+	routes, err := parseSplitRoutesFile[types.SwapAmountInSplitRoute](fs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.EstimateSplitRouteSwapExactAmountInRequest{
+		Routes:       routes,
+		TokenInDenom: args[0],
+	}, nil
+}
+
+func EstimateSplitRouteSwapExactAmountOutParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
+	routes, err := parseSplitRoutesFile[types.SwapAmountOutSplitRoute](fs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.EstimateSplitRouteSwapExactAmountOutRequest{
+		Routes:        routes,
+		TokenOutDenom: args[0],
+	}, nil
+}
+
+func RouteQuoteParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
+	maxHops, err := fs.GetUint64(FlagMaxHops)
+	if err != nil {
+		return nil, err
+	}
+
+	maxRoutes, err := fs.GetUint64(FlagMaxRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	poolTypesStr, err := fs.GetString(FlagPoolTypes)
+	if err != nil {
+		return nil, err
+	}
+	poolTypes := []types.PoolType{}
+	for _, poolTypeStr := range strings.Split(poolTypesStr, ",") {
+		if poolTypeStr == "" {
+			continue
+		}
+		poolType, ok := types.PoolType_value[poolTypeStr]
+		if !ok {
+			return nil, fmt.Errorf("invalid pool type (%s)", poolTypeStr)
+		}
+		poolTypes = append(poolTypes, types.PoolType(poolType))
+	}
+
+	return &queryproto.RouteQuoteRequest{
+		TokenIn:       args[0],
+		TokenOutDenom: args[1],
+		MaxHops:       maxHops,
+		MaxRoutes:     maxRoutes,
+		PoolTypes:     poolTypes,
+	}, nil
+}
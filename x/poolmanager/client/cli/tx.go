@@ -27,6 +27,10 @@ func NewTxCmd() *cobra.Command {
 
 	osmocli.AddTxCmd(txCmd, NewSwapExactAmountInCmd)
 	osmocli.AddTxCmd(txCmd, NewSwapExactAmountOutCmd)
+	osmocli.AddTxCmd(txCmd, NewSwapExactAmountInWithPriceLimitCmd)
+	osmocli.AddTxCmd(txCmd, NewSwapExactAmountOutWithPriceLimitCmd)
+	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountInCmd)
+	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountOutCmd)
 
 	txCmd.AddCommand(
 		NewCreatePoolCmd(),
@@ -56,6 +60,66 @@ func NewSwapExactAmountOutCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountOu
 		Flags:            osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
 	}, &types.MsgSwapExactAmountOut{}
 }
+func NewSwapExactAmountInWithPriceLimitCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountInWithPriceLimit) {
+	return &osmocli.TxCliDesc{
+		Use:   "swap-exact-amount-in-with-price-limit [pool-id] [token-in] [token-out-denom] [token-out-min-amount] [price-limit]",
+		Short: "swap up to the given amount in, stopping once the spot price of token in reaches the price limit",
+		Long: `Swap up to the given amount in through a single pool, stopping once the spot price of the token in,
+quoted in the token out denom, falls to the price limit. Any unswapped remainder of the token in stays with the sender.{{.ExampleHeader}}
+{{.CommandPrefix}} swap-exact-amount-in-with-price-limit 1 1000stake uosmo 900 0.95`,
+	}, &types.MsgSwapExactAmountInWithPriceLimit{}
+}
+
+func NewSwapExactAmountOutWithPriceLimitCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountOutWithPriceLimit) {
+	return &osmocli.TxCliDesc{
+		Use:   "swap-exact-amount-out-with-price-limit [pool-id] [token-in-denom] [token-in-max-amount] [token-out] [price-limit]",
+		Short: "swap for up to the given amount out, stopping once the spot price of token in reaches the price limit",
+		Long: `Swap for up to the given amount out through a single pool, stopping once the spot price of the token in,
+quoted in the token out denom, reaches the price limit. Only the part of the token out that respects the limit is received.{{.ExampleHeader}}
+{{.CommandPrefix}} swap-exact-amount-out-with-price-limit 1 stake 1100 1000uosmo 0.95`,
+	}, &types.MsgSwapExactAmountOutWithPriceLimit{}
+}
+
+func NewSplitRouteSwapExactAmountInCmd() (*osmocli.TxCliDesc, *types.MsgSplitRouteSwapExactAmountIn) {
+	return &osmocli.TxCliDesc{
+		Use:   "split-route-swap-exact-amount-in [token-in-denom] [token-out-min-amount]",
+		Short: "swap exact amount in split across several routes",
+		Long: `Swap the token in across several multihop routes at once, where each route swaps its own amount in.
+The swap fails unless the total amount out across all routes is at least the token out min amount.{{.ExampleHeader}}
+{{.CommandPrefix}} split-route-swap-exact-amount-in uosmo 1000 --routes-file="routes.json"
+
+Sample routes JSON file contents:
+[
+	{"pools": [{"pool_id": 1, "token_out_denom": "uion"}], "token_in_amount": "1000"},
+	{"pools": [{"pool_id": 2, "token_out_denom": "uatom"}, {"pool_id": 3, "token_out_denom": "uion"}], "token_in_amount": "500"}
+]`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes": osmocli.FlagOnlyParser(parseSplitRoutesFile[types.SwapAmountInSplitRoute]),
+		},
+		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetSplitRoutes()}},
+	}, &types.MsgSplitRouteSwapExactAmountIn{}
+}
+
+func NewSplitRouteSwapExactAmountOutCmd() (*osmocli.TxCliDesc, *types.MsgSplitRouteSwapExactAmountOut) {
+	return &osmocli.TxCliDesc{
+		Use:   "split-route-swap-exact-amount-out [token-out-denom] [token-in-max-amount]",
+		Short: "swap exact amount out split across several routes",
+		Long: `Swap into the token out across several multihop routes at once, where each route receives its own amount out.
+The swap fails if the total amount in across all routes exceeds the token in max amount.{{.ExampleHeader}}
+{{.CommandPrefix}} split-route-swap-exact-amount-out uion 2000 --routes-file="routes.json"
+
+Sample routes JSON file contents:
+[
+	{"pools": [{"pool_id": 1, "token_in_denom": "uosmo"}], "token_out_amount": "1000"},
+	{"pools": [{"pool_id": 2, "token_in_denom": "uosmo"}, {"pool_id": 3, "token_in_denom": "uatom"}], "token_out_amount": "500"}
+]`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes": osmocli.FlagOnlyParser(parseSplitRoutesFile[types.SwapAmountOutSplitRoute]),
+		},
+		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetSplitRoutes()}},
+	}, &types.MsgSplitRouteSwapExactAmountOut{}
+}
+
 func NewBuildSwapExactAmountInMsg(clientCtx client.Context, tokenInStr, tokenOutMinAmtStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
 	routes, err := swapAmountInRoutes(fs)
 	if err != nil {
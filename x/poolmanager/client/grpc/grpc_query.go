@@ -20,6 +20,16 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) RouteQuote(grpcCtx context.Context,
+	req *queryproto.RouteQuoteRequest,
+) (*queryproto.RouteQuoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RouteQuote(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -50,6 +60,26 @@ func (q Querier) EstimateSwapExactAmountOut(grpcCtx context.Context,
 	return q.Q.EstimateSwapExactAmountOut(ctx, *req)
 }
 
+func (q Querier) EstimateSwapExactAmountInWithPriceLimit(grpcCtx context.Context,
+	req *queryproto.EstimateSwapExactAmountInWithPriceLimitRequest,
+) (*queryproto.EstimateSwapExactAmountInWithPriceLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateSwapExactAmountInWithPriceLimit(ctx, *req)
+}
+
+func (q Querier) EstimateSwapExactAmountOutWithPriceLimit(grpcCtx context.Context,
+	req *queryproto.EstimateSwapExactAmountOutWithPriceLimitRequest,
+) (*queryproto.EstimateSwapExactAmountOutWithPriceLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateSwapExactAmountOutWithPriceLimit(ctx, *req)
+}
+
 func (q Querier) EstimateSwapExactAmountIn(grpcCtx context.Context,
 	req *queryproto.EstimateSwapExactAmountInRequest,
 ) (*queryproto.EstimateSwapExactAmountInResponse, error) {
@@ -60,3 +90,23 @@ func (q Querier) EstimateSwapExactAmountIn(grpcCtx context.Context,
 	return q.Q.EstimateSwapExactAmountIn(ctx, *req)
 }
 
+func (q Querier) EstimateSplitRouteSwapExactAmountOut(grpcCtx context.Context,
+	req *queryproto.EstimateSplitRouteSwapExactAmountOutRequest,
+) (*queryproto.EstimateSplitRouteSwapExactAmountOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateSplitRouteSwapExactAmountOut(ctx, *req)
+}
+
+func (q Querier) EstimateSplitRouteSwapExactAmountIn(grpcCtx context.Context,
+	req *queryproto.EstimateSplitRouteSwapExactAmountInRequest,
+) (*queryproto.EstimateSplitRouteSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateSplitRouteSwapExactAmountIn(ctx, *req)
+}
+
@@ -73,6 +73,129 @@ func (q Querier) EstimateSwapExactAmountOut(ctx sdk.Context, req queryproto.Esti
 	}, nil
 }
 
+// EstimateSwapExactAmountInWithPriceLimit estimates how much of the input token can be swapped,
+// and the resulting output amount, before the pool's spot price reaches the price limit.
+func (q Querier) EstimateSwapExactAmountInWithPriceLimit(ctx sdk.Context, req queryproto.EstimateSwapExactAmountInWithPriceLimitRequest) (*queryproto.EstimateSwapExactAmountInWithPriceLimitResponse, error) {
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	if err := sdk.ValidateDenom(req.TokenOutDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token out denom: %s", err.Error())
+	}
+
+	if req.PriceLimit.IsNil() || !req.PriceLimit.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "price limit must be positive")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	tokenInAmount, tokenOutAmount, err := q.K.EstimateSwapExactAmountInWithPriceLimit(ctx, req.PoolId, tokenIn, req.TokenOutDenom, req.PriceLimit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.EstimateSwapExactAmountInWithPriceLimitResponse{
+		TokenInAmount:  tokenInAmount,
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
+// EstimateSplitRouteSwapExactAmountIn estimates the total output token amount of a swap split across several routes.
+func (q Querier) EstimateSplitRouteSwapExactAmountIn(ctx sdk.Context, req queryproto.EstimateSplitRouteSwapExactAmountInRequest) (*queryproto.EstimateSplitRouteSwapExactAmountInResponse, error) {
+	if err := sdk.ValidateDenom(req.TokenInDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token in denom: %s", err.Error())
+	}
+
+	if err := types.SwapAmountInSplitRoutes(req.Routes).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tokenOutAmount, err := q.K.SplitRouteEstimateOutGivenExactAmountIn(ctx, req.Routes, req.TokenInDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.EstimateSplitRouteSwapExactAmountInResponse{
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
+// EstimateSplitRouteSwapExactAmountOut estimates the total input token amount of a swap split across several routes.
+func (q Querier) EstimateSplitRouteSwapExactAmountOut(ctx sdk.Context, req queryproto.EstimateSplitRouteSwapExactAmountOutRequest) (*queryproto.EstimateSplitRouteSwapExactAmountOutResponse, error) {
+	if err := sdk.ValidateDenom(req.TokenOutDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token out denom: %s", err.Error())
+	}
+
+	if err := types.SwapAmountOutSplitRoutes(req.Routes).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tokenInAmount, err := q.K.SplitRouteEstimateInGivenExactAmountOut(ctx, req.Routes, req.TokenOutDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.EstimateSplitRouteSwapExactAmountOutResponse{
+		TokenInAmount: tokenInAmount,
+	}, nil
+}
+
+// RouteQuote returns the best candidate routes for swapping the input token into the output denom.
+func (q Querier) RouteQuote(ctx sdk.Context, req queryproto.RouteQuoteRequest) (*queryproto.RouteQuoteResponse, error) {
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	quotedRoutes, err := q.K.GetRouteQuotes(ctx, tokenIn, req.TokenOutDenom, req.MaxHops, req.MaxRoutes, req.PoolTypes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &queryproto.RouteQuoteResponse{
+		QuotedRoutes: quotedRoutes,
+	}, nil
+}
+
+// EstimateSwapExactAmountOutWithPriceLimit estimates how much of the output token can be swapped for,
+// and the required input amount, before the pool's spot price reaches the price limit.
+func (q Querier) EstimateSwapExactAmountOutWithPriceLimit(ctx sdk.Context, req queryproto.EstimateSwapExactAmountOutWithPriceLimitRequest) (*queryproto.EstimateSwapExactAmountOutWithPriceLimitResponse, error) {
+	if req.TokenOut == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	if err := sdk.ValidateDenom(req.TokenInDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token in denom: %s", err.Error())
+	}
+
+	if req.PriceLimit.IsNil() || !req.PriceLimit.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "price limit must be positive")
+	}
+
+	tokenOut, err := sdk.ParseCoinNormalized(req.TokenOut)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	tokenInAmount, tokenOutAmount, err := q.K.EstimateSwapExactAmountOutWithPriceLimit(ctx, req.PoolId, req.TokenInDenom, tokenOut, req.PriceLimit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.EstimateSwapExactAmountOutWithPriceLimitResponse{
+		TokenInAmount:  tokenInAmount,
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
 // NumPools returns total number of pools.
 func (q Querier) NumPools(ctx sdk.Context, _ queryproto.NumPoolsRequest) (*queryproto.NumPoolsResponse, error) {
 	return &queryproto.NumPoolsResponse{
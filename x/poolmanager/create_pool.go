@@ -84,6 +84,8 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, er
 		return 0, err
 	}
 
+	k.indexPoolDenoms(ctx, pool)
+
 	emitCreatePoolEvents(ctx, poolId, msg)
 	return pool.GetId(), nil
 }
@@ -114,6 +116,34 @@ func (k Keeper) SetPoolRoute(ctx sdk.Context, poolId uint64, poolType types.Pool
 	osmoutils.MustSet(store, types.FormatModuleRouteKey(poolId), &types.ModuleRoute{PoolType: poolType})
 }
 
+// IndexPoolDenoms adds the pool with the given id to the index of pools by the denoms they trade.
+// Pools created through CreatePool are indexed upon creation. This is exposed for the upgrade handler
+// to index the pools that existed prior to the index.
+// Returns error if the pool's module route is not found or if the pool does not exist.
+func (k Keeper) IndexPoolDenoms(ctx sdk.Context, poolId uint64) error {
+	swapModule, err := k.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	pool, err := swapModule.GetPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	k.indexPoolDenoms(ctx, pool)
+	return nil
+}
+
+// indexPoolDenoms adds the given pool to the index of pools by the denoms they trade.
+// The denoms that a pool trades are fixed upon its creation, so the index never needs to be updated.
+func (k Keeper) indexPoolDenoms(ctx sdk.Context, pool types.PoolI) {
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range getPoolDenoms(ctx, pool) {
+		store.Set(types.FormatDenomPoolKey(denom, pool.GetId()), sdk.Uint64ToBigEndian(pool.GetId()))
+	}
+}
+
 // GetPoolModule returns the swap module for the given pool ID.
 // Returns error if:
 // - any database error occurs.
@@ -23,3 +23,10 @@ func (k Keeper) GetOsmoRoutedMultihopTotalSwapFee(ctx sdk.Context, route types.M
 func (k *Keeper) SetPoolRoutesUnsafe(routes map[types.PoolType]types.SwapI) {
 	k.routes = routes
 }
+
+// FindRouteQuoteCandidates returns the candidate routes of a route quote through pools of any type,
+// visiting at most maxSearchNodes pools while searching.
+func (k Keeper) FindRouteQuoteCandidates(ctx sdk.Context, tokenInDenom, tokenOutDenom string, maxHops, maxSearchNodes uint64) ([][]types.SwapAmountInRoute, error) {
+	loader := k.newRouteQuotePoolLoader(ctx, nil, maxSearchNodes)
+	return findCandidateRoutes(loader, tokenInDenom, tokenOutDenom, maxHops, types.MaxRouteQuoteCandidates, maxSearchNodes)
+}
@@ -0,0 +1,281 @@
+package poolmanager
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
+)
+
+// twoAssetPool is implemented by pools that always trade between exactly two denoms,
+// regardless of whether they currently hold any liquidity (e.g. concentrated liquidity pools).
+type twoAssetPool interface {
+	GetToken0() string
+	GetToken1() string
+}
+
+// routeQuotePool is a pool that is a candidate hop when searching for routes.
+type routeQuotePool struct {
+	id     uint64
+	denoms []string
+}
+
+// routeQuotePoolLoader loads the pools that are candidate hops when searching for routes, caching them
+// so that every pool is loaded from state at most once per route quote.
+type routeQuotePoolLoader struct {
+	k                Keeper
+	ctx              sdk.Context
+	allowedPoolTypes map[types.PoolType]bool
+	// maxPoolIdsPerDenom bounds the number of pool ids read from the index for a single denom.
+	maxPoolIdsPerDenom uint64
+
+	poolIdsByDenom map[string][]uint64
+	pools          map[uint64]routeQuotePool
+	isCandidate    map[uint64]bool
+}
+
+// GetRouteQuotes searches the pools registered through SetPoolRoute for multihop routes swapping tokenIn into
+// tokenOutDenom. It returns up to maxRoutes of the routes with the highest amount out, as estimated by
+// MultihopEstimateOutGivenExactAmountIn. Routes consist of at most maxHops pools and only go through active pools
+// of the given pool types, or of any type if poolTypes is empty. Zero maxHops and maxRoutes are replaced by their
+// defaults.
+//
+// The search is deterministic and bounded: pools are looked up through the index of pools by denom, at most
+// types.MaxRouteQuoteSearchNodes pools are visited while searching, and at most types.MaxRouteQuoteCandidates
+// candidate routes are estimated. The pools of a denom are visited in ascending pool id order.
+// Routes with equal amounts out are ordered by their number of hops and then by their pool ids.
+//
+// Returns error if tokenIn or tokenOutDenom are invalid, if they have the same denom or if maxHops or
+// maxRoutes exceed their limits.
+func (k Keeper) GetRouteQuotes(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops uint64,
+	maxRoutes uint64,
+	poolTypes []types.PoolType,
+) ([]types.QuotedRoute, error) {
+	if err := tokenIn.Validate(); err != nil {
+		return nil, err
+	}
+	if !tokenIn.IsPositive() {
+		return nil, fmt.Errorf("token in amount must be positive, was (%s)", tokenIn.Amount)
+	}
+	if err := sdk.ValidateDenom(tokenOutDenom); err != nil {
+		return nil, err
+	}
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, types.ErrSameTokenInAndOutDenom
+	}
+
+	if maxHops == 0 {
+		maxHops = types.DefaultRouteQuoteMaxHops
+	}
+	if maxHops > types.MaxRouteQuoteMaxHops {
+		return nil, fmt.Errorf("max hops (%d) must not exceed (%d)", maxHops, types.MaxRouteQuoteMaxHops)
+	}
+	if maxRoutes == 0 {
+		maxRoutes = types.DefaultRouteQuoteMaxRoutes
+	}
+	if maxRoutes > types.MaxRouteQuoteMaxRoutes {
+		return nil, fmt.Errorf("max routes (%d) must not exceed (%d)", maxRoutes, types.MaxRouteQuoteMaxRoutes)
+	}
+
+	loader := k.newRouteQuotePoolLoader(ctx, poolTypes, types.MaxRouteQuoteSearchNodes)
+	candidateRoutes, err := findCandidateRoutes(loader, tokenIn.Denom, tokenOutDenom, maxHops, types.MaxRouteQuoteCandidates, types.MaxRouteQuoteSearchNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	quotedRoutes := make([]types.QuotedRoute, 0, len(candidateRoutes))
+	for _, route := range candidateRoutes {
+		tokenOutAmount, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, route, tokenIn)
+		// Routes that cannot be swapped through, for example due to lack of liquidity, are not quoted.
+		if err != nil {
+			continue
+		}
+		quotedRoutes = append(quotedRoutes, types.QuotedRoute{Routes: route, TokenOutAmount: tokenOutAmount})
+	}
+
+	sort.SliceStable(quotedRoutes, func(i, j int) bool {
+		return isBetterQuotedRoute(quotedRoutes[i], quotedRoutes[j])
+	})
+
+	if uint64(len(quotedRoutes)) > maxRoutes {
+		quotedRoutes = quotedRoutes[:maxRoutes]
+	}
+	return quotedRoutes, nil
+}
+
+// newRouteQuotePoolLoader returns a loader of the active pools of the given pool types, or of any type if
+// poolTypes is empty. At most maxPoolIdsPerDenom pool ids are read from the index for a single denom.
+func (k Keeper) newRouteQuotePoolLoader(ctx sdk.Context, poolTypes []types.PoolType, maxPoolIdsPerDenom uint64) *routeQuotePoolLoader {
+	allowedPoolTypes := make(map[types.PoolType]bool, len(poolTypes))
+	for _, poolType := range poolTypes {
+		allowedPoolTypes[poolType] = true
+	}
+
+	return &routeQuotePoolLoader{
+		k:                  k,
+		ctx:                ctx,
+		allowedPoolTypes:   allowedPoolTypes,
+		maxPoolIdsPerDenom: maxPoolIdsPerDenom,
+		poolIdsByDenom:     make(map[string][]uint64),
+		pools:              make(map[uint64]routeQuotePool),
+		isCandidate:        make(map[uint64]bool),
+	}
+}
+
+// getPoolIds returns the ids of the pools trading the given denom, in ascending pool id order.
+func (l *routeQuotePoolLoader) getPoolIds(denom string) []uint64 {
+	if poolIds, ok := l.poolIdsByDenom[denom]; ok {
+		return poolIds
+	}
+
+	store := l.ctx.KVStore(l.k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FormatDenomPoolsPrefix(denom))
+	defer iterator.Close()
+
+	poolIds := []uint64{}
+	for ; iterator.Valid() && uint64(len(poolIds)) < l.maxPoolIdsPerDenom; iterator.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	l.poolIdsByDenom[denom] = poolIds
+	return poolIds
+}
+
+// getPool returns the pool with the given id and whether it is a candidate hop, which is the case if it
+// is active, of one of the allowed pool types and has a module route.
+func (l *routeQuotePoolLoader) getPool(poolId uint64) (routeQuotePool, bool, error) {
+	if isCandidate, ok := l.isCandidate[poolId]; ok {
+		return l.pools[poolId], isCandidate, nil
+	}
+
+	swapModule, err := l.k.GetPoolModule(l.ctx, poolId)
+	if err != nil {
+		// Pools without a route cannot be swapped through.
+		if errors.As(err, &types.FailedToFindRouteError{}) || errors.As(err, &types.UndefinedRouteError{}) {
+			l.isCandidate[poolId] = false
+			return routeQuotePool{}, false, nil
+		}
+		return routeQuotePool{}, false, err
+	}
+
+	pool, err := swapModule.GetPool(l.ctx, poolId)
+	if err != nil {
+		return routeQuotePool{}, false, err
+	}
+	if (len(l.allowedPoolTypes) > 0 && !l.allowedPoolTypes[pool.GetType()]) || !pool.IsActive(l.ctx) {
+		l.isCandidate[poolId] = false
+		return routeQuotePool{}, false, nil
+	}
+
+	l.pools[poolId] = routeQuotePool{id: poolId, denoms: getPoolDenoms(l.ctx, pool)}
+	l.isCandidate[poolId] = true
+	return l.pools[poolId], true, nil
+}
+
+// getPoolDenoms returns the sorted denoms that the given pool trades.
+func getPoolDenoms(ctx sdk.Context, pool types.PoolI) []string {
+	if twoAsset, ok := pool.(twoAssetPool); ok {
+		denoms := []string{twoAsset.GetToken0(), twoAsset.GetToken1()}
+		sort.Strings(denoms)
+		return denoms
+	}
+	return osmoutils.CoinsDenoms(pool.GetTotalPoolLiquidity(ctx))
+}
+
+// findCandidateRoutes returns the routes of at most maxHops pools from tokenInDenom to tokenOutDenom,
+// stopping once maxCandidates routes are found or maxSearchNodes pools have been visited across all
+// route lengths. Every pool trading a reached denom counts as visited, including pools that are not candidate hops,
+// so that the pools loaded from state are bounded as well. A route never goes through the same pool or the same
+// denom twice. Routes with fewer hops are found first, so that they are never crowded out by longer ones.
+// Routes of the same length are found in a deterministic order by visiting pools in ascending pool id order
+// and their denoms in sorted order.
+// Returns error if fails to load any of the visited pools.
+func findCandidateRoutes(loader *routeQuotePoolLoader, tokenInDenom, tokenOutDenom string, maxHops, maxCandidates, maxSearchNodes uint64) ([][]types.SwapAmountInRoute, error) {
+	candidateRoutes := [][]types.SwapAmountInRoute{}
+	usedPools := map[uint64]bool{}
+	visitedDenoms := map[string]bool{tokenInDenom: true}
+	searchNodes := uint64(0)
+
+	var searchErr error
+	var search func(denom string, route []types.SwapAmountInRoute, hops uint64)
+	search = func(denom string, route []types.SwapAmountInRoute, hops uint64) {
+		for _, poolId := range loader.getPoolIds(denom) {
+			if searchNodes >= maxSearchNodes || searchErr != nil {
+				return
+			}
+			searchNodes++
+
+			if usedPools[poolId] {
+				continue
+			}
+			pool, isCandidate, err := loader.getPool(poolId)
+			if err != nil {
+				searchErr = err
+				return
+			}
+			if !isCandidate {
+				continue
+			}
+			for _, nextDenom := range pool.denoms {
+				if uint64(len(candidateRoutes)) >= maxCandidates {
+					return
+				}
+				if visitedDenoms[nextDenom] {
+					continue
+				}
+
+				nextRoute := make([]types.SwapAmountInRoute, len(route), len(route)+1)
+				copy(nextRoute, route)
+				nextRoute = append(nextRoute, types.SwapAmountInRoute{PoolId: pool.id, TokenOutDenom: nextDenom})
+
+				if nextDenom == tokenOutDenom {
+					if uint64(len(nextRoute)) == hops {
+						candidateRoutes = append(candidateRoutes, nextRoute)
+					}
+					continue
+				}
+				if uint64(len(nextRoute)) >= hops {
+					continue
+				}
+
+				usedPools[pool.id] = true
+				visitedDenoms[nextDenom] = true
+				search(nextDenom, nextRoute, hops)
+				usedPools[pool.id] = false
+				visitedDenoms[nextDenom] = false
+			}
+		}
+	}
+	for hops := uint64(1); hops <= maxHops; hops++ {
+		search(tokenInDenom, nil, hops)
+		if searchErr != nil {
+			return nil, searchErr
+		}
+	}
+
+	return candidateRoutes, nil
+}
+
+// isBetterQuotedRoute returns true if route a has a higher amount out than route b.
+// Ties are broken in favor of fewer hops and then of lower pool ids.
+func isBetterQuotedRoute(a, b types.QuotedRoute) bool {
+	if !a.TokenOutAmount.Equal(b.TokenOutAmount) {
+		return a.TokenOutAmount.GT(b.TokenOutAmount)
+	}
+	if len(a.Routes) != len(b.Routes) {
+		return len(a.Routes) < len(b.Routes)
+	}
+	for i := range a.Routes {
+		if a.Routes[i].PoolId != b.Routes[i].PoolId {
+			return a.Routes[i].PoolId < b.Routes[i].PoolId
+		}
+	}
+	return false
+}
@@ -0,0 +1,214 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
+)
+
+// TestGetRouteQuotes tests that route quotes are found through all eligible pools,
+// that they are ordered by their estimated amount out and that the hop, route and
+// pool type bounds are respected.
+func (suite *KeeperTestSuite) TestGetRouteQuotes() {
+	var (
+		directRoute     = []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: baz}}
+		osmoRoute       = []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: uosmo}, {PoolId: 3, TokenOutDenom: baz}}
+		stableswapRoute = []types.SwapAmountInRoute{{PoolId: 4, TokenOutDenom: baz}}
+	)
+
+	tests := map[string]struct {
+		tokenIn        sdk.Coin
+		tokenOutDenom  string
+		maxHops        uint64
+		maxRoutes      uint64
+		poolTypes      []types.PoolType
+		expectedRoutes [][]types.SwapAmountInRoute
+		expectedErr    error
+		expectErr      bool
+	}{
+		"default bounds": {
+			tokenIn:        sdk.NewCoin(foo, defaultSwapAmount),
+			tokenOutDenom:  baz,
+			expectedRoutes: [][]types.SwapAmountInRoute{directRoute, osmoRoute, stableswapRoute},
+		},
+		"single hop routes only": {
+			tokenIn:        sdk.NewCoin(foo, defaultSwapAmount),
+			tokenOutDenom:  baz,
+			maxHops:        1,
+			expectedRoutes: [][]types.SwapAmountInRoute{directRoute, stableswapRoute},
+		},
+		"balancer pools only": {
+			tokenIn:        sdk.NewCoin(foo, defaultSwapAmount),
+			tokenOutDenom:  baz,
+			poolTypes:      []types.PoolType{types.Balancer},
+			expectedRoutes: [][]types.SwapAmountInRoute{directRoute, osmoRoute},
+		},
+		"stableswap pools only": {
+			tokenIn:        sdk.NewCoin(foo, defaultSwapAmount),
+			tokenOutDenom:  baz,
+			poolTypes:      []types.PoolType{types.Stableswap},
+			expectedRoutes: [][]types.SwapAmountInRoute{stableswapRoute},
+		},
+		"no route to token out denom": {
+			tokenIn:        sdk.NewCoin(foo, defaultSwapAmount),
+			tokenOutDenom:  "qux",
+			expectedRoutes: [][]types.SwapAmountInRoute{},
+		},
+		"error: same token in and token out denom": {
+			tokenIn:       sdk.NewCoin(foo, defaultSwapAmount),
+			tokenOutDenom: foo,
+			expectedErr:   types.ErrSameTokenInAndOutDenom,
+		},
+		"error: zero token in amount": {
+			tokenIn:       sdk.NewCoin(foo, sdk.ZeroInt()),
+			tokenOutDenom: baz,
+			expectErr:     true,
+		},
+		"error: max hops exceeds limit": {
+			tokenIn:       sdk.NewCoin(foo, defaultSwapAmount),
+			tokenOutDenom: baz,
+			maxHops:       types.MaxRouteQuoteMaxHops + 1,
+			expectErr:     true,
+		},
+		"error: max routes exceeds limit": {
+			tokenIn:       sdk.NewCoin(foo, defaultSwapAmount),
+			tokenOutDenom: baz,
+			maxRoutes:     types.MaxRouteQuoteMaxRoutes + 1,
+			expectErr:     true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+
+			suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)),   // pool 1.
+				sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(uosmo, defaultInitPoolAmount)), // pool 2.
+				sdk.NewCoins(sdk.NewCoin(uosmo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)), // pool 3.
+			}, []sdk.Dec{defaultPoolSwapFee, defaultPoolSwapFee, defaultPoolSwapFee})
+			suite.PrepareBasicStableswapPool() // pool 4 with foo, bar and baz.
+
+			quotedRoutes, err := poolmanagerKeeper.GetRouteQuotes(suite.Ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxHops, tc.maxRoutes, tc.poolTypes)
+
+			if tc.expectedErr != nil || tc.expectErr {
+				suite.Require().Error(err)
+				if tc.expectedErr != nil {
+					suite.Require().ErrorIs(err, tc.expectedErr)
+				}
+				return
+			}
+			suite.Require().NoError(err)
+
+			actualRoutes := make([][]types.SwapAmountInRoute, 0, len(quotedRoutes))
+			for i, quotedRoute := range quotedRoutes {
+				actualRoutes = append(actualRoutes, quotedRoute.Routes)
+
+				// Every quote matches the multihop estimate of its route.
+				expectedTokenOutAmount, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(suite.Ctx, quotedRoute.Routes, tc.tokenIn)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expectedTokenOutAmount.String(), quotedRoute.TokenOutAmount.String())
+
+				// Quotes are ordered from the highest to the lowest amount out.
+				if i > 0 {
+					suite.Require().True(quotedRoutes[i-1].TokenOutAmount.GTE(quotedRoute.TokenOutAmount))
+				}
+			}
+			suite.Require().ElementsMatch(tc.expectedRoutes, actualRoutes)
+
+			// Quotes are deterministic.
+			quotedRoutesAgain, err := poolmanagerKeeper.GetRouteQuotes(suite.Ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxHops, tc.maxRoutes, tc.poolTypes)
+			suite.Require().NoError(err)
+			suite.Require().Equal(quotedRoutes, quotedRoutesAgain)
+		})
+	}
+}
+
+// TestGetRouteQuotes_MaxRoutes tests that only the best max routes quotes are returned.
+func (suite *KeeperTestSuite) TestGetRouteQuotes_MaxRoutes() {
+	suite.SetupTest()
+	poolmanagerKeeper := suite.App.PoolManagerKeeper
+
+	suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)),   // pool 1.
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(uosmo, defaultInitPoolAmount)), // pool 2.
+		sdk.NewCoins(sdk.NewCoin(uosmo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)), // pool 3.
+	}, []sdk.Dec{defaultPoolSwapFee, defaultPoolSwapFee, defaultPoolSwapFee})
+	suite.PrepareBasicStableswapPool() // pool 4 with foo, bar and baz.
+
+	tokenIn := sdk.NewCoin(foo, defaultSwapAmount)
+	allQuotedRoutes, err := poolmanagerKeeper.GetRouteQuotes(suite.Ctx, tokenIn, baz, 0, 0, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(allQuotedRoutes, 3)
+
+	for maxRoutes := uint64(1); maxRoutes <= 3; maxRoutes++ {
+		quotedRoutes, err := poolmanagerKeeper.GetRouteQuotes(suite.Ctx, tokenIn, baz, 0, maxRoutes, nil)
+		suite.Require().NoError(err)
+		suite.Require().Equal(allQuotedRoutes[:maxRoutes], quotedRoutes)
+	}
+}
+
+// TestGetRouteQuotes_HighPoolIds tests that pools are found through the index of pools by denom
+// regardless of their pool id, even when many pools with lower ids exist.
+func (suite *KeeperTestSuite) TestGetRouteQuotes_HighPoolIds() {
+	suite.SetupTest()
+	poolmanagerKeeper := suite.App.PoolManagerKeeper
+
+	suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)), // pool 1.
+	}, []sdk.Dec{defaultPoolSwapFee})
+	poolmanagerKeeper.SetNextPoolId(suite.Ctx, 5000)
+	suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)), // pool 5000.
+	}, []sdk.Dec{defaultPoolSwapFee})
+
+	quotedRoutes, err := poolmanagerKeeper.GetRouteQuotes(suite.Ctx, sdk.NewCoin(foo, defaultSwapAmount), baz, 0, 0, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(quotedRoutes, 1)
+	suite.Require().Equal([]types.SwapAmountInRoute{{PoolId: 5000, TokenOutDenom: baz}}, quotedRoutes[0].Routes)
+}
+
+// TestFindRouteQuoteCandidates_Limits tests that the candidate route search stops once the search node limit is reached.
+func (suite *KeeperTestSuite) TestFindRouteQuoteCandidates_Limits() {
+	var (
+		directRoute     = []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: baz}}
+		osmoRoute       = []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: uosmo}, {PoolId: 3, TokenOutDenom: baz}}
+		stableswapRoute = []types.SwapAmountInRoute{{PoolId: 4, TokenOutDenom: baz}}
+	)
+
+	tests := map[string]struct {
+		maxSearchNodes uint64
+		expectedRoutes [][]types.SwapAmountInRoute
+	}{
+		"default limits": {
+			maxSearchNodes: types.MaxRouteQuoteSearchNodes,
+			expectedRoutes: [][]types.SwapAmountInRoute{directRoute, stableswapRoute, osmoRoute},
+		},
+		"search stops after visiting the first pool": {
+			maxSearchNodes: 1,
+			expectedRoutes: [][]types.SwapAmountInRoute{directRoute},
+		},
+		"no pools are visited": {
+			maxSearchNodes: 0,
+			expectedRoutes: [][]types.SwapAmountInRoute{},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+
+			suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)),   // pool 1.
+				sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(uosmo, defaultInitPoolAmount)), // pool 2.
+				sdk.NewCoins(sdk.NewCoin(uosmo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)), // pool 3.
+			}, []sdk.Dec{defaultPoolSwapFee, defaultPoolSwapFee, defaultPoolSwapFee})
+			suite.PrepareBasicStableswapPool() // pool 4 with foo, bar and baz.
+
+			candidateRoutes, err := suite.App.PoolManagerKeeper.FindRouteQuoteCandidates(suite.Ctx, foo, baz, types.DefaultRouteQuoteMaxHops, tc.maxSearchNodes)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedRoutes, candidateRoutes)
+		})
+	}
+}
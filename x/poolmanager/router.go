@@ -130,10 +130,172 @@ func (k Keeper) SwapExactAmountIn(
 	return tokenOutAmount, nil
 }
 
+// SwapExactAmountInWithPriceLimit is an API for swapping up to tokenIn through a single pool,
+// stopping early once the spot price of tokenIn quoted in tokenOutDenom falls to priceLimit.
+// Unlike SwapExactAmountIn, reaching the price limit results in a partial fill rather than an error.
+// Returns the amount of tokenIn actually swapped and the amount of tokenOut received.
+// Errors if the pool id is invalid, if the pool is inactive, if the price limit is not below
+// the current spot price or if tokenOutAmount is less than tokenOutMinAmount.
+func (k Keeper) SwapExactAmountInWithPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	priceLimit sdk.Dec,
+) (tokenInAmount, tokenOutAmount sdk.Int, err error) {
+	swapModule, pool, err := k.getActivePoolAndModule(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	swapFee := pool.GetSwapFee(ctx)
+
+	tokenInAmount, tokenOutAmount, err = swapModule.SwapExactAmountInWithPriceLimit(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee, priceLimit)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	return tokenInAmount, tokenOutAmount, nil
+}
+
+// EstimateSwapExactAmountInWithPriceLimit estimates the result of SwapExactAmountInWithPriceLimit
+// without mutating state. Returns the amount of tokenIn that would be swapped and the amount of
+// tokenOut that would be received.
+func (k Keeper) EstimateSwapExactAmountInWithPriceLimit(
+	ctx sdk.Context,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	priceLimit sdk.Dec,
+) (tokenInAmount, tokenOutAmount sdk.Int, err error) {
+	// recover from panic
+	defer func() {
+		if r := recover(); r != nil {
+			tokenInAmount, tokenOutAmount = sdk.Int{}, sdk.Int{}
+			err = fmt.Errorf("function EstimateSwapExactAmountInWithPriceLimit failed due to internal reason: %v", r)
+		}
+	}()
+
+	swapModule, pool, err := k.getActivePoolAndModule(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	tokenInUsed, tokenOut, err := swapModule.CalcOutAmtGivenInWithPriceLimit(ctx, pool, tokenIn, tokenOutDenom, pool.GetSwapFee(ctx), priceLimit)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	if !tokenOut.Amount.IsPositive() {
+		return sdk.Int{}, sdk.Int{}, errors.New("token amount must be positive")
+	}
+
+	return tokenInUsed.Amount, tokenOut.Amount, nil
+}
+
+// SwapExactAmountOutWithPriceLimit is an API for swapping for up to tokenOut through a single pool,
+// stopping early once the spot price of tokenInDenom quoted in tokenOut's denom reaches priceLimit.
+// Unlike SwapExactAmountOut, reaching the price limit results in a partial fill rather than an error.
+// Returns the amount of tokenIn paid and the amount of tokenOut actually received.
+// Errors if the pool id is invalid, if the pool is inactive, if the price limit is on the wrong side
+// of the current spot price or if tokenInAmount is greater than tokenInMaxAmount.
+func (k Keeper) SwapExactAmountOutWithPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	priceLimit sdk.Dec,
+) (tokenInAmount, tokenOutAmount sdk.Int, err error) {
+	swapModule, pool, err := k.getActivePoolAndModule(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	swapFee := pool.GetSwapFee(ctx)
+
+	tokenInAmount, tokenOutAmount, err = swapModule.SwapExactAmountOutWithPriceLimit(ctx, sender, pool, tokenInDenom, tokenInMaxAmount, tokenOut, swapFee, priceLimit)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	return tokenInAmount, tokenOutAmount, nil
+}
+
+// EstimateSwapExactAmountOutWithPriceLimit estimates the result of SwapExactAmountOutWithPriceLimit
+// without mutating state. Returns the amount of tokenIn that would be paid and the amount of
+// tokenOut that would be received.
+func (k Keeper) EstimateSwapExactAmountOutWithPriceLimit(
+	ctx sdk.Context,
+	poolId uint64,
+	tokenInDenom string,
+	tokenOut sdk.Coin,
+	priceLimit sdk.Dec,
+) (tokenInAmount, tokenOutAmount sdk.Int, err error) {
+	// recover from panic
+	defer func() {
+		if r := recover(); r != nil {
+			tokenInAmount, tokenOutAmount = sdk.Int{}, sdk.Int{}
+			err = fmt.Errorf("function EstimateSwapExactAmountOutWithPriceLimit failed due to internal reason: %v", r)
+		}
+	}()
+
+	swapModule, pool, err := k.getActivePoolAndModule(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	tokenIn, tokenOutUsed, err := swapModule.CalcInAmtGivenOutWithPriceLimit(ctx, pool, tokenOut, tokenInDenom, pool.GetSwapFee(ctx), priceLimit)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	if !tokenIn.Amount.IsPositive() {
+		return sdk.Int{}, sdk.Int{}, errors.New("token amount must be positive")
+	}
+
+	return tokenIn.Amount, tokenOutUsed.Amount, nil
+}
+
+// getActivePoolAndModule returns the pool with the given id along with the swap module routing to it.
+// Errors if the pool does not exist or if it is not active.
+func (k Keeper) getActivePoolAndModule(ctx sdk.Context, poolId uint64) (types.SwapI, types.PoolI, error) {
+	swapModule, err := k.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pool, err := swapModule.GetPool(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !pool.IsActive(ctx) {
+		return nil, nil, fmt.Errorf("pool %d is not active", pool.GetId())
+	}
+
+	return swapModule, pool, nil
+}
+
 func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 	ctx sdk.Context,
 	routes []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
+) (tokenOutAmount sdk.Int, err error) {
+	return k.multihopEstimateOutGivenExactAmountIn(ctx, routes, tokenIn, false)
+}
+
+// multihopEstimateOutGivenExactAmountIn estimates the amount out of swapping tokenIn through the given routes.
+// If applyToPools is true, every hop is also applied to the state of its pool, so that ctx must be a cache
+// context that is never written.
+func (k Keeper) multihopEstimateOutGivenExactAmountIn(
+	ctx sdk.Context,
+	routes []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	applyToPools bool,
 ) (tokenOutAmount sdk.Int, err error) {
 	var (
 		isMultiHopRouted bool
@@ -182,7 +344,12 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
 		}
 
-		tokenOut, err := swapModule.CalcOutAmtGivenIn(ctx, poolI, tokenIn, route.TokenOutDenom, swapFee)
+		var tokenOut sdk.Coin
+		if applyToPools {
+			tokenOut, err = swapModule.SimulateSwapExactAmountIn(ctx, poolI, tokenIn, route.TokenOutDenom, swapFee)
+		} else {
+			tokenOut, err = swapModule.CalcOutAmtGivenIn(ctx, poolI, tokenIn, route.TokenOutDenom, swapFee)
+		}
 		if err != nil {
 			return sdk.Int{}, err
 		}
@@ -308,6 +475,18 @@ func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	ctx sdk.Context,
 	routes []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
+) (tokenInAmount sdk.Int, err error) {
+	return k.multihopEstimateInGivenExactAmountOut(ctx, routes, tokenOut, false)
+}
+
+// multihopEstimateInGivenExactAmountOut estimates the amount in required to swap for tokenOut through the given routes.
+// If applyToPools is true, every hop is also applied to the state of its pool in the order RouteExactAmountOut
+// executes them, so that ctx must be a cache context that is never written.
+func (k Keeper) multihopEstimateInGivenExactAmountOut(
+	ctx sdk.Context,
+	routes []types.SwapAmountOutRoute,
+	tokenOut sdk.Coin,
+	applyToPools bool,
 ) (tokenInAmount sdk.Int, err error) {
 	isMultiHopRouted, routeSwapFee, sumOfSwapFees := false, sdk.Dec{}, sdk.Dec{}
 	var insExpected []sdk.Int
@@ -348,7 +527,176 @@ func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 		return sdk.Int{}, nil
 	}
 
-	return insExpected[0], nil
+	if !applyToPools {
+		return insExpected[0], nil
+	}
+
+	for i, route := range routes {
+		swapModule, err := k.GetPoolModule(ctx, route.PoolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		_tokenOut := tokenOut
+		if i != len(routes)-1 {
+			_tokenOut = sdk.NewCoin(routes[i+1].TokenInDenom, insExpected[i+1])
+		}
+
+		pool, poolErr := swapModule.GetPool(ctx, route.PoolId)
+		if poolErr != nil {
+			return sdk.Int{}, poolErr
+		}
+
+		swapFee := pool.GetSwapFee(ctx)
+		if isMultiHopRouted {
+			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
+		}
+
+		_tokenIn, swapErr := swapModule.SimulateSwapExactAmountOut(ctx, pool, _tokenOut, route.TokenInDenom, swapFee)
+		if swapErr != nil {
+			return sdk.Int{}, swapErr
+		}
+
+		if i == 0 {
+			tokenInAmount = _tokenIn.Amount
+		}
+	}
+
+	return tokenInAmount, nil
+}
+
+// SplitRouteExactAmountIn swaps tokenInDenom through each of the given split routes, where every route
+// swaps its own token in amount through its multihop route. The osmo-routed multihop swap fee discount
+// is determined for each route separately. The routes are executed atomically: state changes are only
+// persisted if every route succeeds and the total amount out across all routes is at least tokenOutMinAmount.
+func (k Keeper) SplitRouteExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	tokenOutMinAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	if err := sdk.ValidateDenom(tokenInDenom); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := types.SwapAmountInSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	cacheCtx, writeCacheCtx := ctx.CacheContext()
+	tokenOutAmount = sdk.ZeroInt()
+	for _, route := range routes {
+		// The minimum amount out is enforced on the total across all routes,
+		// so each individual route only has to produce a positive amount.
+		routeTokenOutAmount, err := k.RouteExactAmountIn(cacheCtx, sender, route.Pools, sdk.NewCoin(tokenInDenom, route.TokenInAmount), sdk.OneInt())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenOutAmount = tokenOutAmount.Add(routeTokenOutAmount)
+	}
+
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, types.InsufficientSplitRouteTokenOutError{TokenOutAmount: tokenOutAmount, TokenOutMinAmount: tokenOutMinAmount}
+	}
+
+	writeCacheCtx()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return tokenOutAmount, nil
+}
+
+// SplitRouteExactAmountOut swaps into tokenOutDenom through each of the given split routes, where every route
+// receives its own token out amount through its multihop route. The osmo-routed multihop swap fee discount
+// is determined for each route separately. The routes are executed atomically: state changes are only
+// persisted if every route succeeds and the total amount in across all routes is at most tokenInMaxAmount.
+func (k Keeper) SplitRouteExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountOutSplitRoute,
+	tokenOutDenom string,
+	tokenInMaxAmount sdk.Int,
+) (tokenInAmount sdk.Int, err error) {
+	if err := sdk.ValidateDenom(tokenOutDenom); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := types.SwapAmountOutSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	cacheCtx, writeCacheCtx := ctx.CacheContext()
+	tokenInAmount = sdk.ZeroInt()
+	for _, route := range routes {
+		// The maximum amount in is enforced on the total across all routes,
+		// so it only serves as an upper bound for each individual route.
+		routeTokenInAmount, err := k.RouteExactAmountOut(cacheCtx, sender, route.Pools, tokenInMaxAmount, sdk.NewCoin(tokenOutDenom, route.TokenOutAmount))
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenInAmount = tokenInAmount.Add(routeTokenInAmount)
+	}
+
+	if tokenInAmount.GT(tokenInMaxAmount) {
+		return sdk.Int{}, types.ExcessiveSplitRouteTokenInError{TokenInAmount: tokenInAmount, TokenInMaxAmount: tokenInMaxAmount}
+	}
+
+	writeCacheCtx()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return tokenInAmount, nil
+}
+
+// SplitRouteEstimateOutGivenExactAmountIn estimates the total amount out of SplitRouteExactAmountIn
+// without mutating state. The routes are estimated one after another on a cache context that is never
+// written, so routes sharing a pool see the pool state left by the earlier routes, mirroring execution.
+func (k Keeper) SplitRouteEstimateOutGivenExactAmountIn(
+	ctx sdk.Context,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+) (tokenOutAmount sdk.Int, err error) {
+	if err := sdk.ValidateDenom(tokenInDenom); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := types.SwapAmountInSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	tokenOutAmount = sdk.ZeroInt()
+	for _, route := range routes {
+		routeTokenOutAmount, err := k.multihopEstimateOutGivenExactAmountIn(cacheCtx, route.Pools, sdk.NewCoin(tokenInDenom, route.TokenInAmount), true)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenOutAmount = tokenOutAmount.Add(routeTokenOutAmount)
+	}
+	return tokenOutAmount, nil
+}
+
+// SplitRouteEstimateInGivenExactAmountOut estimates the total amount in of SplitRouteExactAmountOut
+// without mutating state. The routes are estimated one after another on a cache context that is never
+// written, so routes sharing a pool see the pool state left by the earlier routes, mirroring execution.
+func (k Keeper) SplitRouteEstimateInGivenExactAmountOut(
+	ctx sdk.Context,
+	routes []types.SwapAmountOutSplitRoute,
+	tokenOutDenom string,
+) (tokenInAmount sdk.Int, err error) {
+	if err := sdk.ValidateDenom(tokenOutDenom); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := types.SwapAmountOutSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	tokenInAmount = sdk.ZeroInt()
+	for _, route := range routes {
+		routeTokenInAmount, err := k.multihopEstimateInGivenExactAmountOut(cacheCtx, route.Pools, sdk.NewCoin(tokenOutDenom, route.TokenOutAmount), true)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenInAmount = tokenInAmount.Add(routeTokenInAmount)
+	}
+	return tokenInAmount, nil
 }
 
 func (k Keeper) isOsmoRoutedMultihop(ctx sdk.Context, route types.MultihopRoute, inDenom, outDenom string) (isRouted bool) {
@@ -468,3 +816,72 @@ func (k Keeper) createOsmoMultihopExpectedSwapOuts(
 
 	return insExpected, nil
 }
+
+// GetPoolDenoms returns the denoms of the assets in the pool with the given id,
+// routing to the module of the pool's type.
+func (k Keeper) GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error) {
+	swapModule, err := k.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return swapModule.GetPoolDenoms(ctx, poolId)
+}
+
+// CalculateSpotPrice returns the spot price of the base asset in terms of the quote asset
+// in the pool with the given id, routing to the module of the pool's type.
+func (k Keeper) CalculateSpotPrice(
+	ctx sdk.Context,
+	poolId uint64,
+	quoteAssetDenom string,
+	baseAssetDenom string,
+) (sdk.Dec, error) {
+	swapModule, err := k.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return swapModule.CalculateSpotPrice(ctx, poolId, quoteAssetDenom, baseAssetDenom)
+}
+
+// activeLiquidityPool is implemented by pools whose liquidity is concentrated in price ranges,
+// of which only the liquidity in range of the current price backs swaps.
+type activeLiquidityPool interface {
+	GetToken0() string
+	GetToken1() string
+	GetLiquidity() sdk.Dec
+	GetCurrentSqrtPrice() sdk.Dec
+}
+
+// GetActiveLiquidityReserves returns the reserves backing swaps against the pool with the given id
+// at its current price, routing to the module of the pool's type.
+// For pools that trade against all of their liquidity, these are the coins held by the pool.
+// For concentrated liquidity pools, these are the virtual reserves of the liquidity in range of the
+// current price, i.e. the reserves of a full range pool with the same liquidity and price:
+// L / sqrt(P) of token0 and L * sqrt(P) of token1. Liquidity outside of the current range is excluded.
+func (k Keeper) GetActiveLiquidityReserves(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	swapModule, err := k.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := swapModule.GetPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	activeLiquidityPool, ok := pool.(activeLiquidityPool)
+	if !ok {
+		return pool.GetTotalPoolLiquidity(ctx), nil
+	}
+
+	sqrtPrice := activeLiquidityPool.GetCurrentSqrtPrice()
+	if sqrtPrice.IsZero() {
+		return sdk.Coins{}, nil
+	}
+	liquidity := activeLiquidityPool.GetLiquidity()
+	return sdk.NewCoins(
+		sdk.NewCoin(activeLiquidityPool.GetToken0(), liquidity.Quo(sqrtPrice).TruncateInt()),
+		sdk.NewCoin(activeLiquidityPool.GetToken1(), liquidity.Mul(sqrtPrice).TruncateInt()),
+	), nil
+}
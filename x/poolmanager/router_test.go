@@ -5,9 +5,13 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v14/app/apptesting"
 	cl "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity"
+	cltypes "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
 	gamm "github.com/osmosis-labs/osmosis/v14/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v14/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v14/x/gamm/types"
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v14/x/pool-incentives/types"
 	"github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
@@ -98,6 +102,89 @@ func (suite *KeeperTestSuite) TestGetPoolModule() {
 	}
 }
 
+// TestGetPoolDenomsAndCalculateSpotPrice tests that pool denoms and spot prices are routed
+// to the module of the pool's type.
+func (suite *KeeperTestSuite) TestGetPoolDenomsAndCalculateSpotPrice() {
+	tests := map[string]struct {
+		poolType          types.PoolType
+		withoutPosition   bool
+		poolId            uint64
+		baseDenom         string
+		quoteDenom        string
+		expectedDenoms    []string
+		expectDenomsError error
+		expectPriceError  error
+	}{
+		"balancer pool": {
+			poolType:       types.Balancer,
+			baseDenom:      foo,
+			quoteDenom:     bar,
+			expectedDenoms: []string{bar, foo},
+		},
+		"concentrated pool": {
+			poolType:       types.Concentrated,
+			baseDenom:      apptesting.ETH,
+			quoteDenom:     apptesting.USDC,
+			expectedDenoms: []string{apptesting.ETH, apptesting.USDC},
+		},
+		"concentrated pool, inverse price": {
+			poolType:       types.Concentrated,
+			baseDenom:      apptesting.USDC,
+			quoteDenom:     apptesting.ETH,
+			expectedDenoms: []string{apptesting.ETH, apptesting.USDC},
+		},
+		"concentrated pool without positions has no price": {
+			poolType:         types.Concentrated,
+			withoutPosition:  true,
+			baseDenom:        apptesting.USDC,
+			quoteDenom:       apptesting.ETH,
+			expectedDenoms:   []string{apptesting.ETH, apptesting.USDC},
+			expectPriceError: gammtypes.ErrSpotPriceInternal,
+		},
+		"non-existent pool": {
+			poolType:          types.Balancer,
+			poolId:            2,
+			expectDenomsError: types.FailedToFindRouteError{PoolId: 2},
+			expectPriceError:  types.FailedToFindRouteError{PoolId: 2},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+
+			var poolId uint64
+			if tc.withoutPosition {
+				poolId = suite.PrepareConcentratedPool().GetId()
+			} else {
+				poolId = suite.preparePriceLimitPool(tc.poolType)
+			}
+			if tc.poolId != 0 {
+				poolId = tc.poolId
+			}
+
+			denoms, err := poolmanagerKeeper.GetPoolDenoms(suite.Ctx, poolId)
+			if tc.expectDenomsError != nil {
+				suite.Require().ErrorIs(err, tc.expectDenomsError)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expectedDenoms, denoms)
+			}
+
+			spotPrice, err := poolmanagerKeeper.CalculateSpotPrice(suite.Ctx, poolId, tc.quoteDenom, tc.baseDenom)
+			if tc.expectPriceError != nil {
+				suite.Require().ErrorIs(err, tc.expectPriceError)
+				return
+			}
+			suite.Require().NoError(err)
+			expectedSpotPrice := osmomath.SigFigRound(suite.tokenInSpotPrice(poolId, tc.baseDenom, tc.quoteDenom), gammtypes.SpotPriceSigFigs)
+			suite.Require().Equal(expectedSpotPrice, spotPrice)
+		})
+	}
+}
+
 // TestMultihopSwapExactAmountIn tests that the swaps are routed correctly.
 // That is:
 // - to the correct module (concentrated-liquidity or gamm)
@@ -1119,6 +1206,427 @@ func (suite *KeeperTestSuite) TestSingleSwapExactAmountIn() {
 	}
 }
 
+// TestSwapExactAmountInWithPriceLimit tests that price-limited swaps fill fully when the
+// limit is not reached, and stop at the limit otherwise, for every pool type.
+func (suite *KeeperTestSuite) TestSwapExactAmountInWithPriceLimit() {
+	tests := []struct {
+		name              string
+		poolType          types.PoolType
+		tokenIn           sdk.Coin
+		tokenOutDenom     string
+		tokenOutMinAmount sdk.Int
+		// priceLimit is given as a fraction of the spot price of tokenIn prior to the swap.
+		priceLimitFactor  sdk.Dec
+		expectPartialFill bool
+		expectError       bool
+	}{
+		{
+			name:              "balancer: limit not reached, full fill",
+			poolType:          types.Balancer,
+			tokenIn:           sdk.NewCoin(foo, sdk.NewInt(100000)),
+			tokenOutDenom:     bar,
+			tokenOutMinAmount: sdk.NewInt(1),
+			priceLimitFactor:  sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			name:              "balancer: limit reached, partial fill",
+			poolType:          types.Balancer,
+			tokenIn:           sdk.NewCoin(foo, sdk.NewInt(100000000000)),
+			tokenOutDenom:     bar,
+			tokenOutMinAmount: sdk.NewInt(1),
+			priceLimitFactor:  sdk.NewDecWithPrec(95, 2),
+			expectPartialFill: true,
+		},
+		{
+			name:              "balancer: limit above spot price",
+			poolType:          types.Balancer,
+			tokenIn:           sdk.NewCoin(foo, sdk.NewInt(100000)),
+			tokenOutDenom:     bar,
+			tokenOutMinAmount: sdk.NewInt(1),
+			priceLimitFactor:  sdk.NewDecWithPrec(11, 1),
+			expectError:       true,
+		},
+		{
+			name:              "balancer: token out less than min amount",
+			poolType:          types.Balancer,
+			tokenIn:           sdk.NewCoin(foo, sdk.NewInt(100000000000)),
+			tokenOutDenom:     bar,
+			tokenOutMinAmount: sdk.NewInt(100000000000),
+			priceLimitFactor:  sdk.NewDecWithPrec(95, 2),
+			expectError:       true,
+		},
+		{
+			name:              "stableswap: limit not reached, full fill",
+			poolType:          types.Stableswap,
+			tokenIn:           sdk.NewCoin(foo, sdk.NewInt(1000)),
+			tokenOutDenom:     bar,
+			tokenOutMinAmount: sdk.NewInt(1),
+			priceLimitFactor:  sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			name:              "stableswap: limit reached, partial fill",
+			poolType:          types.Stableswap,
+			tokenIn:           sdk.NewCoin(foo, sdk.NewInt(9000000)),
+			tokenOutDenom:     bar,
+			tokenOutMinAmount: sdk.NewInt(1),
+			priceLimitFactor:  sdk.NewDecWithPrec(99, 2),
+			expectPartialFill: true,
+		},
+		{
+			name:              "concentrated: limit not reached, full fill",
+			poolType:          types.Concentrated,
+			tokenIn:           sdk.NewCoin(apptesting.ETH, sdk.NewInt(10)),
+			tokenOutDenom:     apptesting.USDC,
+			tokenOutMinAmount: sdk.NewInt(1),
+			priceLimitFactor:  sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			name:              "concentrated: limit reached on token0 in, partial fill",
+			poolType:          types.Concentrated,
+			tokenIn:           sdk.NewCoin(apptesting.ETH, sdk.NewInt(200000)),
+			tokenOutDenom:     apptesting.USDC,
+			tokenOutMinAmount: sdk.NewInt(1),
+			priceLimitFactor:  sdk.NewDecWithPrec(99, 2),
+			expectPartialFill: true,
+		},
+		{
+			name:              "concentrated: limit reached on token1 in, partial fill",
+			poolType:          types.Concentrated,
+			tokenIn:           sdk.NewCoin(apptesting.USDC, sdk.NewInt(1000000000)),
+			tokenOutDenom:     apptesting.ETH,
+			tokenOutMinAmount: sdk.NewInt(1),
+			priceLimitFactor:  sdk.NewDecWithPrec(99, 2),
+			expectPartialFill: true,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+
+			poolId := suite.preparePriceLimitPool(tc.poolType)
+			suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(tc.tokenIn))
+
+			spotPriceBefore := suite.tokenInSpotPrice(poolId, tc.tokenIn.Denom, tc.tokenOutDenom)
+			priceLimit := spotPriceBefore.Mul(tc.priceLimitFactor)
+
+			estimatedTokenIn, estimatedTokenOut, estimateErr := poolmanagerKeeper.EstimateSwapExactAmountInWithPriceLimit(suite.Ctx, poolId, tc.tokenIn, tc.tokenOutDenom, priceLimit)
+
+			tokenInAmount, tokenOutAmount, err := poolmanagerKeeper.SwapExactAmountInWithPriceLimit(suite.Ctx, suite.TestAccs[0], poolId, tc.tokenIn, tc.tokenOutDenom, tc.tokenOutMinAmount, priceLimit)
+			if tc.expectError {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(tokenOutAmount.IsPositive())
+
+			// the estimate must match the executed swap
+			suite.Require().NoError(estimateErr)
+			suite.Require().Equal(estimatedTokenIn.String(), tokenInAmount.String())
+			suite.Require().Equal(estimatedTokenOut.String(), tokenOutAmount.String())
+
+			// the unused remainder of token in is left with the sender
+			balance := suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[0], tc.tokenIn.Denom)
+			suite.Require().True(balance.Amount.GTE(tc.tokenIn.Amount.Sub(tokenInAmount)))
+
+			spotPriceAfter := suite.tokenInSpotPrice(poolId, tc.tokenIn.Denom, tc.tokenOutDenom)
+			if !tc.expectPartialFill {
+				suite.Require().Equal(tc.tokenIn.Amount.String(), tokenInAmount.String())
+				suite.Require().True(spotPriceAfter.GT(priceLimit))
+				return
+			}
+
+			// the swap must stop at the price limit without crossing it
+			suite.Require().True(tokenInAmount.LT(tc.tokenIn.Amount))
+			suite.Require().True(spotPriceAfter.GTE(priceLimit), "spot price after %s, limit %s", spotPriceAfter, priceLimit)
+			suite.Require().True(spotPriceAfter.Sub(priceLimit).Quo(priceLimit).LTE(sdk.NewDecWithPrec(1, 3)), "spot price after %s, limit %s", spotPriceAfter, priceLimit)
+		})
+	}
+}
+
+// TestSwapExactAmountOutWithPriceLimit tests that price-limited exact amount out swaps fill fully
+// when the limit is not reached, and stop at the limit otherwise, for every pool type.
+func (suite *KeeperTestSuite) TestSwapExactAmountOutWithPriceLimit() {
+	tests := []struct {
+		name             string
+		poolType         types.PoolType
+		tokenInDenom     string
+		tokenInMaxAmount sdk.Int
+		tokenOut         sdk.Coin
+		// priceLimit is given as a multiple of the spot price of tokenIn prior to the swap.
+		// Concentrated liquidity in given out swaps move the price of the token in upwards,
+		// so their limits lie above the current spot price.
+		priceLimitFactor  sdk.Dec
+		expectPartialFill bool
+		expectError       bool
+	}{
+		{
+			name:             "balancer: limit not reached, full fill",
+			poolType:         types.Balancer,
+			tokenInDenom:     foo,
+			tokenInMaxAmount: sdk.NewInt(1000000),
+			tokenOut:         sdk.NewCoin(bar, sdk.NewInt(100000)),
+			priceLimitFactor: sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			name:              "balancer: limit reached, partial fill",
+			poolType:          types.Balancer,
+			tokenInDenom:      foo,
+			tokenInMaxAmount:  defaultInitPoolAmount,
+			tokenOut:          sdk.NewCoin(bar, defaultInitPoolAmount.QuoRaw(10)),
+			priceLimitFactor:  sdk.NewDecWithPrec(95, 2),
+			expectPartialFill: true,
+		},
+		{
+			name:             "balancer: limit above spot price",
+			poolType:         types.Balancer,
+			tokenInDenom:     foo,
+			tokenInMaxAmount: sdk.NewInt(1000000),
+			tokenOut:         sdk.NewCoin(bar, sdk.NewInt(100000)),
+			priceLimitFactor: sdk.NewDecWithPrec(11, 1),
+			expectError:      true,
+		},
+		{
+			name:             "balancer: token in greater than max amount",
+			poolType:         types.Balancer,
+			tokenInDenom:     foo,
+			tokenInMaxAmount: sdk.NewInt(1),
+			tokenOut:         sdk.NewCoin(bar, defaultInitPoolAmount.QuoRaw(2)),
+			priceLimitFactor: sdk.NewDecWithPrec(95, 2),
+			expectError:      true,
+		},
+		{
+			name:             "stableswap: limit not reached, full fill",
+			poolType:         types.Stableswap,
+			tokenInDenom:     foo,
+			tokenInMaxAmount: sdk.NewInt(1000000),
+			tokenOut:         sdk.NewCoin(bar, sdk.NewInt(1000)),
+			priceLimitFactor: sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			name:              "stableswap: limit reached, partial fill",
+			poolType:          types.Stableswap,
+			tokenInDenom:      foo,
+			tokenInMaxAmount:  sdk.NewInt(100000000),
+			tokenOut:          sdk.NewCoin(bar, sdk.NewInt(5000000)),
+			priceLimitFactor:  sdk.NewDecWithPrec(99, 2),
+			expectPartialFill: true,
+		},
+		{
+			name:             "concentrated: limit not reached, full fill",
+			poolType:         types.Concentrated,
+			tokenInDenom:     apptesting.ETH,
+			tokenInMaxAmount: sdk.NewInt(1000),
+			tokenOut:         sdk.NewCoin(apptesting.USDC, sdk.NewInt(1000000)),
+			priceLimitFactor: sdk.NewDec(2),
+		},
+		{
+			name:              "concentrated: limit reached on token0 in, partial fill",
+			poolType:          types.Concentrated,
+			tokenInDenom:      apptesting.ETH,
+			tokenInMaxAmount:  sdk.NewInt(1000000),
+			tokenOut:          sdk.NewCoin(apptesting.USDC, sdk.NewInt(1000000000)),
+			priceLimitFactor:  sdk.NewDecWithPrec(101, 2),
+			expectPartialFill: true,
+		},
+		{
+			name:              "concentrated: limit reached on token1 in, partial fill",
+			poolType:          types.Concentrated,
+			tokenInDenom:      apptesting.USDC,
+			tokenInMaxAmount:  sdk.NewInt(5000000000),
+			tokenOut:          sdk.NewCoin(apptesting.ETH, sdk.NewInt(200000)),
+			priceLimitFactor:  sdk.NewDecWithPrec(101, 2),
+			expectPartialFill: true,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+
+			poolId := suite.preparePriceLimitPool(tc.poolType)
+			suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewCoin(tc.tokenInDenom, tc.tokenInMaxAmount)))
+			balanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[0], tc.tokenInDenom)
+
+			spotPriceBefore := suite.tokenInSpotPrice(poolId, tc.tokenInDenom, tc.tokenOut.Denom)
+			priceLimit := spotPriceBefore.Mul(tc.priceLimitFactor)
+
+			estimatedTokenIn, estimatedTokenOut, estimateErr := poolmanagerKeeper.EstimateSwapExactAmountOutWithPriceLimit(suite.Ctx, poolId, tc.tokenInDenom, tc.tokenOut, priceLimit)
+
+			tokenInAmount, tokenOutAmount, err := poolmanagerKeeper.SwapExactAmountOutWithPriceLimit(suite.Ctx, suite.TestAccs[0], poolId, tc.tokenInDenom, tc.tokenInMaxAmount, tc.tokenOut, priceLimit)
+			if tc.expectError {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(tokenInAmount.IsPositive())
+
+			// the estimate must match the executed swap
+			suite.Require().NoError(estimateErr)
+			suite.Require().Equal(estimatedTokenIn.String(), tokenInAmount.String())
+			suite.Require().Equal(estimatedTokenOut.String(), tokenOutAmount.String())
+
+			// only the amount in actually needed is taken from the sender
+			balanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[0], tc.tokenInDenom)
+			suite.Require().Equal(balanceBefore.Amount.Sub(tokenInAmount).String(), balanceAfter.Amount.String())
+
+			spotPriceAfter := suite.tokenInSpotPrice(poolId, tc.tokenInDenom, tc.tokenOut.Denom)
+			if !tc.expectPartialFill {
+				suite.Require().Equal(tc.tokenOut.Amount.String(), tokenOutAmount.String())
+				return
+			}
+
+			// the swap must stop at the price limit without crossing it
+			suite.Require().True(tokenOutAmount.LT(tc.tokenOut.Amount))
+			// up to the rounding of the concentrated liquidity pool's square root price
+			crossedBy := spotPriceAfter.Sub(priceLimit)
+			if spotPriceBefore.GT(priceLimit) {
+				crossedBy = crossedBy.Neg()
+			}
+			suite.Require().True(crossedBy.LTE(priceLimit.Mul(sdk.NewDecWithPrec(1, 15))), "spot price after %s, limit %s", spotPriceAfter, priceLimit)
+			suite.Require().True(spotPriceAfter.Sub(priceLimit).Abs().Quo(priceLimit).LTE(sdk.NewDecWithPrec(1, 3)), "spot price after %s, limit %s", spotPriceAfter, priceLimit)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetActiveLiquidityReserves() {
+	tests := map[string]struct {
+		poolType        types.PoolType
+		withoutPosition bool
+		// withOutOfRangePosition adds a position below the current price, that holds only usdc.
+		withOutOfRangePosition bool
+		poolId                 uint64
+		expectError            error
+	}{
+		"balancer pool": {
+			poolType: types.Balancer,
+		},
+		"concentrated pool": {
+			poolType: types.Concentrated,
+		},
+		"concentrated pool with a position out of range": {
+			poolType:               types.Concentrated,
+			withOutOfRangePosition: true,
+		},
+		"concentrated pool without positions": {
+			poolType:        types.Concentrated,
+			withoutPosition: true,
+		},
+		"non-existent pool": {
+			poolType:    types.Balancer,
+			poolId:      2,
+			expectError: types.FailedToFindRouteError{PoolId: 2},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+
+			var poolId uint64
+			if tc.withoutPosition {
+				poolId = suite.PrepareConcentratedPool().GetId()
+			} else {
+				poolId = suite.preparePriceLimitPool(tc.poolType)
+			}
+			if tc.poolId != 0 {
+				poolId = tc.poolId
+			}
+
+			var reservesBefore sdk.Coins
+			if tc.withOutOfRangePosition {
+				var err error
+				reservesBefore, err = poolmanagerKeeper.GetActiveLiquidityReserves(suite.Ctx, poolId)
+				suite.Require().NoError(err)
+
+				outOfRangeCoin := sdk.NewCoin(apptesting.USDC, sdk.NewInt(50000000000))
+				suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(outOfRangeCoin))
+				_, _, _, _, err = suite.App.ConcentratedLiquidityKeeper.CreatePosition(suite.Ctx, poolId, suite.TestAccs[0], sdk.ZeroInt(), outOfRangeCoin.Amount, sdk.ZeroInt(), sdk.ZeroInt(), 300000, 305000, suite.Ctx.BlockTime())
+				suite.Require().NoError(err)
+			}
+
+			reserves, err := poolmanagerKeeper.GetActiveLiquidityReserves(suite.Ctx, poolId)
+			if tc.expectError != nil {
+				suite.Require().ErrorIs(err, tc.expectError)
+				return
+			}
+			suite.Require().NoError(err)
+
+			swapModule, err := poolmanagerKeeper.GetPoolModule(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			pool, err := swapModule.GetPool(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+
+			if tc.withoutPosition {
+				suite.Require().True(reserves.Empty())
+				return
+			}
+			if tc.poolType == types.Balancer {
+				suite.Require().Equal(pool.GetTotalPoolLiquidity(suite.Ctx), reserves)
+				return
+			}
+
+			// The virtual reserves of the liquidity in range of the current price.
+			clPool, ok := pool.(cltypes.ConcentratedPoolExtension)
+			suite.Require().True(ok)
+			suite.Require().Equal(sdk.NewCoins(
+				sdk.NewCoin(apptesting.ETH, clPool.GetLiquidity().Quo(clPool.GetCurrentSqrtPrice()).TruncateInt()),
+				sdk.NewCoin(apptesting.USDC, clPool.GetLiquidity().Mul(clPool.GetCurrentSqrtPrice()).TruncateInt()),
+			), reserves)
+
+			// The out of range position is held by the pool, but does not back swaps at the current price.
+			if tc.withOutOfRangePosition {
+				suite.Require().Equal(reservesBefore, reserves)
+			}
+		})
+	}
+}
+
+// preparePriceLimitPool creates a pool of the given type with liquidity to swap against and returns its ID.
+func (suite *KeeperTestSuite) preparePriceLimitPool(poolType types.PoolType) uint64 {
+	switch poolType {
+	case types.Balancer:
+		poolCoins := sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount))
+		suite.FundAcc(suite.TestAccs[0], poolCoins)
+		return suite.PrepareCustomBalancerPoolFromCoins(poolCoins, balancer.PoolParams{
+			SwapFee: defaultPoolSwapFee,
+			ExitFee: sdk.ZeroDec(),
+		})
+	case types.Stableswap:
+		return suite.PrepareBasicStableswapPool()
+	default:
+		pool := suite.PrepareConcentratedPool()
+		// a position around the current price of 5000 usdc per eth
+		coins := sdk.NewCoins(sdk.NewCoin(apptesting.ETH, sdk.NewInt(1000000)), sdk.NewCoin(apptesting.USDC, sdk.NewInt(5000000000)))
+		suite.FundAcc(suite.TestAccs[0], coins)
+		_, _, _, _, err := suite.App.ConcentratedLiquidityKeeper.CreatePosition(suite.Ctx, pool.GetId(), suite.TestAccs[0], coins.AmountOf(apptesting.ETH), coins.AmountOf(apptesting.USDC), sdk.ZeroInt(), sdk.ZeroInt(), 305450, 315000, suite.Ctx.BlockTime())
+		suite.Require().NoError(err)
+		return pool.GetId()
+	}
+}
+
+// tokenInSpotPrice returns the spot price of tokenInDenom quoted in tokenOutDenom.
+func (suite *KeeperTestSuite) tokenInSpotPrice(poolId uint64, tokenInDenom, tokenOutDenom string) sdk.Dec {
+	swapModule, err := suite.App.PoolManagerKeeper.GetPoolModule(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	pool, err := swapModule.GetPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	if clPool, ok := pool.(cltypes.ConcentratedPoolExtension); ok {
+		price := clPool.GetCurrentSqrtPrice().Power(2)
+		if tokenInDenom == clPool.GetToken0() {
+			return price
+		}
+		return sdk.OneDec().Quo(price)
+	}
+	spotPrice, err := pool.SpotPrice(suite.Ctx, tokenOutDenom, tokenInDenom)
+	suite.Require().NoError(err)
+	return spotPrice
+}
+
 // setupPools creates pools of desired type and returns their IDs
 func (suite *KeeperTestSuite) setupPools(poolType types.PoolType, poolDefaultSwapFee sdk.Dec) (firstEstimatePoolId, secondEstimatePoolId uint64) {
 	switch poolType {
@@ -1158,3 +1666,276 @@ func (suite *KeeperTestSuite) setupPools(poolType types.PoolType, poolDefaultSwa
 		return
 	}
 }
+
+// TestSplitRouteExactAmountIn tests that split route swaps are executed atomically,
+// that the osmo-routed fee reduction is applied to each route separately and that
+// the minimum amount out is enforced on the total across all routes.
+func (suite *KeeperTestSuite) TestSplitRouteExactAmountIn() {
+	var (
+		directRoute = []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: baz}}
+		osmoRoute   = []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: uosmo}, {PoolId: 3, TokenOutDenom: baz}}
+		// sharedOsmoRoute shares pool 2 with osmoRoute.
+		sharedOsmoRoute = []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: uosmo}, {PoolId: 4, TokenOutDenom: baz}}
+
+		directRouteAmount = sdk.NewInt(100000)
+		osmoRouteAmount   = sdk.NewInt(200000)
+		// sharedRouteAmount is large enough for the routes sharing a pool to move each other's price.
+		sharedRouteAmount = defaultInitPoolAmount.QuoRaw(100)
+
+		defaultRoutes = []types.SwapAmountInSplitRoute{
+			{Pools: directRoute, TokenInAmount: directRouteAmount},
+			{Pools: osmoRoute, TokenInAmount: osmoRouteAmount},
+		}
+	)
+
+	tests := map[string]struct {
+		routes            []types.SwapAmountInSplitRoute
+		tokenOutMinAmount sdk.Int
+		// routesSharePool is set when the routes cannot be estimated as separate swaps.
+		routesSharePool bool
+		expectedErr     error
+		expectErr       bool
+	}{
+		"valid split route": {
+			routes:            defaultRoutes,
+			tokenOutMinAmount: sdk.OneInt(),
+		},
+		"valid split route, routes share a pool": {
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: osmoRoute, TokenInAmount: sharedRouteAmount},
+				{Pools: sharedOsmoRoute, TokenInAmount: sharedRouteAmount},
+			},
+			tokenOutMinAmount: sdk.OneInt(),
+			routesSharePool:   true,
+		},
+		"error: total amount out is less than the minimum amount out": {
+			routes:            defaultRoutes,
+			tokenOutMinAmount: defaultInitPoolAmount,
+			expectErr:         true,
+		},
+		"error: second route fails after the first route succeeds": {
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: directRoute, TokenInAmount: directRouteAmount},
+				{Pools: []types.SwapAmountInRoute{{PoolId: 10, TokenOutDenom: baz}}, TokenInAmount: osmoRouteAmount},
+			},
+			tokenOutMinAmount: sdk.OneInt(),
+			expectErr:         true,
+		},
+		"error: duplicate routes": {
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: directRoute, TokenInAmount: directRouteAmount},
+				{Pools: directRoute, TokenInAmount: osmoRouteAmount},
+			},
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrDuplicateRoutesNotAllowed,
+		},
+		"error: routes end in different denoms": {
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: directRoute, TokenInAmount: directRouteAmount},
+				{Pools: osmoRoute[:1], TokenInAmount: osmoRouteAmount},
+			},
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.InconsistentSplitRouteDenomError{Expected: baz, Actual: uosmo},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+
+			suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)),   // pool 1.
+				sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(uosmo, defaultInitPoolAmount)), // pool 2.
+				sdk.NewCoins(sdk.NewCoin(uosmo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)), // pool 3.
+				sdk.NewCoins(sdk.NewCoin(uosmo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)), // pool 4.
+			}, []sdk.Dec{defaultPoolSwapFee, defaultPoolSwapFee, defaultPoolSwapFee, defaultPoolSwapFee})
+			suite.makeGaugesIncentivized([]uint64{1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+			totalTokenInAmount := sdk.ZeroInt()
+			independentTokenOutAmount := sdk.ZeroInt()
+			for _, route := range tc.routes {
+				totalTokenInAmount = totalTokenInAmount.Add(route.TokenInAmount)
+				if tc.routesSharePool {
+					routeTokenOutAmount, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(suite.Ctx, route.Pools, sdk.NewCoin(foo, route.TokenInAmount))
+					suite.Require().NoError(err)
+					independentTokenOutAmount = independentTokenOutAmount.Add(routeTokenOutAmount)
+				}
+			}
+
+			sender := suite.TestAccs[0]
+			suite.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(foo, totalTokenInAmount)))
+			balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+
+			estimatedTokenOutAmount, estimateErr := poolmanagerKeeper.SplitRouteEstimateOutGivenExactAmountIn(suite.Ctx, tc.routes, foo)
+			tokenOutAmount, err := poolmanagerKeeper.SplitRouteExactAmountIn(suite.Ctx, sender, tc.routes, foo, tc.tokenOutMinAmount)
+
+			if tc.expectedErr != nil || tc.expectErr {
+				suite.Require().Error(err)
+				if tc.expectedErr != nil {
+					suite.Require().ErrorIs(err, tc.expectedErr)
+					suite.Require().ErrorIs(estimateErr, tc.expectedErr)
+				}
+
+				// No route may be partially executed.
+				suite.Require().Equal(balancesBefore, suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender))
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NoError(estimateErr)
+			suite.Require().Equal(tokenOutAmount.String(), estimatedTokenOutAmount.String())
+
+			if tc.routesSharePool {
+				// The second route swaps through pool 2 after the first route has already moved its price.
+				suite.Require().True(tokenOutAmount.LT(independentTokenOutAmount))
+			} else {
+				// Only the route through uosmo is eligible for the osmo-routed fee reduction.
+				expectedTokenOutAmount := suite.calcInAmountAsSeparateSwaps(false, directRoute, sdk.NewCoin(foo, directRouteAmount)).Amount.
+					Add(suite.calcInAmountAsSeparateSwaps(true, osmoRoute, sdk.NewCoin(foo, osmoRouteAmount)).Amount)
+				suite.Require().Equal(expectedTokenOutAmount.String(), tokenOutAmount.String())
+			}
+
+			expectedBalances := balancesBefore.Sub(sdk.NewCoins(sdk.NewCoin(foo, totalTokenInAmount))).Add(sdk.NewCoin(baz, tokenOutAmount))
+			suite.Require().Equal(expectedBalances, suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender))
+		})
+	}
+}
+
+// TestSplitRouteExactAmountOut tests that split route swaps are executed atomically,
+// that the osmo-routed fee reduction is applied to each route separately and that
+// the maximum amount in is enforced on the total across all routes.
+func (suite *KeeperTestSuite) TestSplitRouteExactAmountOut() {
+	var (
+		directRoute = []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: foo}}
+		osmoRoute   = []types.SwapAmountOutRoute{{PoolId: 2, TokenInDenom: foo}, {PoolId: 3, TokenInDenom: uosmo}}
+		// sharedOsmoRoute shares pool 2 with osmoRoute.
+		sharedOsmoRoute = []types.SwapAmountOutRoute{{PoolId: 2, TokenInDenom: foo}, {PoolId: 4, TokenInDenom: uosmo}}
+
+		directRouteAmount = sdk.NewInt(100000)
+		osmoRouteAmount   = sdk.NewInt(200000)
+		// sharedRouteAmount is large enough for the routes sharing a pool to move each other's price.
+		sharedRouteAmount = defaultInitPoolAmount.QuoRaw(100)
+
+		defaultRoutes = []types.SwapAmountOutSplitRoute{
+			{Pools: directRoute, TokenOutAmount: directRouteAmount},
+			{Pools: osmoRoute, TokenOutAmount: osmoRouteAmount},
+		}
+
+		tokenInMaxAmount = sdk.NewInt(1000000)
+	)
+
+	tests := map[string]struct {
+		routes           []types.SwapAmountOutSplitRoute
+		tokenInMaxAmount sdk.Int
+		// routesSharePool is set when the routes cannot be estimated as separate swaps.
+		routesSharePool bool
+		expectedErr     error
+		expectErr       bool
+	}{
+		"valid split route": {
+			routes:           defaultRoutes,
+			tokenInMaxAmount: tokenInMaxAmount,
+		},
+		"valid split route, routes share a pool": {
+			routes: []types.SwapAmountOutSplitRoute{
+				{Pools: osmoRoute, TokenOutAmount: sharedRouteAmount},
+				{Pools: sharedOsmoRoute, TokenOutAmount: sharedRouteAmount},
+			},
+			tokenInMaxAmount: defaultInitPoolAmount.QuoRaw(10),
+			routesSharePool:  true,
+		},
+		"error: total amount in is greater than the maximum amount in": {
+			// Each route on its own needs less than the maximum amount in, but together they need more.
+			routes:           defaultRoutes,
+			tokenInMaxAmount: osmoRouteAmount.Add(osmoRouteAmount.QuoRaw(10)),
+			expectErr:        true,
+		},
+		"error: second route fails after the first route succeeds": {
+			routes: []types.SwapAmountOutSplitRoute{
+				{Pools: directRoute, TokenOutAmount: directRouteAmount},
+				{Pools: []types.SwapAmountOutRoute{{PoolId: 10, TokenInDenom: foo}}, TokenOutAmount: osmoRouteAmount},
+			},
+			tokenInMaxAmount: tokenInMaxAmount,
+			expectErr:        true,
+		},
+		"error: duplicate routes": {
+			routes: []types.SwapAmountOutSplitRoute{
+				{Pools: directRoute, TokenOutAmount: directRouteAmount},
+				{Pools: directRoute, TokenOutAmount: osmoRouteAmount},
+			},
+			tokenInMaxAmount: tokenInMaxAmount,
+			expectedErr:      types.ErrDuplicateRoutesNotAllowed,
+		},
+		"error: routes start from different denoms": {
+			routes: []types.SwapAmountOutSplitRoute{
+				{Pools: directRoute, TokenOutAmount: directRouteAmount},
+				{Pools: osmoRoute[1:], TokenOutAmount: osmoRouteAmount},
+			},
+			tokenInMaxAmount: tokenInMaxAmount,
+			expectedErr:      types.InconsistentSplitRouteDenomError{Expected: foo, Actual: uosmo},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+
+			suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)),   // pool 1.
+				sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(uosmo, defaultInitPoolAmount)), // pool 2.
+				sdk.NewCoins(sdk.NewCoin(uosmo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)), // pool 3.
+				sdk.NewCoins(sdk.NewCoin(uosmo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)), // pool 4.
+			}, []sdk.Dec{defaultPoolSwapFee, defaultPoolSwapFee, defaultPoolSwapFee, defaultPoolSwapFee})
+			suite.makeGaugesIncentivized([]uint64{1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+			totalTokenOutAmount := sdk.ZeroInt()
+			independentTokenInAmount := sdk.ZeroInt()
+			for _, route := range tc.routes {
+				totalTokenOutAmount = totalTokenOutAmount.Add(route.TokenOutAmount)
+				if tc.routesSharePool {
+					routeTokenInAmount, err := poolmanagerKeeper.MultihopEstimateInGivenExactAmountOut(suite.Ctx, route.Pools, sdk.NewCoin(baz, route.TokenOutAmount))
+					suite.Require().NoError(err)
+					independentTokenInAmount = independentTokenInAmount.Add(routeTokenInAmount)
+				}
+			}
+
+			sender := suite.TestAccs[0]
+			suite.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(foo, tc.tokenInMaxAmount)))
+			balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+
+			estimatedTokenInAmount, estimateErr := poolmanagerKeeper.SplitRouteEstimateInGivenExactAmountOut(suite.Ctx, tc.routes, baz)
+			tokenInAmount, err := poolmanagerKeeper.SplitRouteExactAmountOut(suite.Ctx, sender, tc.routes, baz, tc.tokenInMaxAmount)
+
+			if tc.expectedErr != nil || tc.expectErr {
+				suite.Require().Error(err)
+				if tc.expectedErr != nil {
+					suite.Require().ErrorIs(err, tc.expectedErr)
+					suite.Require().ErrorIs(estimateErr, tc.expectedErr)
+				}
+
+				// No route may be partially executed.
+				suite.Require().Equal(balancesBefore, suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender))
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NoError(estimateErr)
+			suite.Require().Equal(tokenInAmount.String(), estimatedTokenInAmount.String())
+
+			if tc.routesSharePool {
+				// The second route swaps through pool 2 after the first route has already moved its price.
+				suite.Require().True(tokenInAmount.GT(independentTokenInAmount))
+			} else {
+				// Only the route through uosmo is eligible for the osmo-routed fee reduction.
+				expectedTokenInAmount := suite.calcOutAmountAsSeparateSwaps(false, directRoute, sdk.NewCoin(baz, directRouteAmount)).Amount.
+					Add(suite.calcOutAmountAsSeparateSwaps(true, osmoRoute, sdk.NewCoin(baz, osmoRouteAmount)).Amount)
+				suite.Require().Equal(expectedTokenInAmount.String(), tokenInAmount.String())
+			}
+
+			expectedBalances := balancesBefore.Sub(sdk.NewCoins(sdk.NewCoin(foo, tokenInAmount))).Add(sdk.NewCoin(baz, totalTokenOutAmount))
+			suite.Require().Equal(expectedBalances, suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender))
+		})
+	}
+}
@@ -13,6 +13,10 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSwapExactAmountIn{}, "osmosis/poolmanager/swap-exact-amount-in", nil)
 	cdc.RegisterConcrete(&MsgSwapExactAmountOut{}, "osmosis/poolmanager/swap-exact-amount-out", nil)
+	cdc.RegisterConcrete(&MsgSwapExactAmountInWithPriceLimit{}, "osmosis/poolmanager/swap-price-limit", nil)
+	cdc.RegisterConcrete(&MsgSwapExactAmountOutWithPriceLimit{}, "osmosis/poolmanager/out-price-limit", nil)
+	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountIn{}, "osmosis/poolmanager/split-amount-in", nil)
+	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountOut{}, "osmosis/poolmanager/split-amount-out", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -20,6 +24,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgSwapExactAmountIn{},
 		&MsgSwapExactAmountOut{},
+		&MsgSwapExactAmountInWithPriceLimit{},
+		&MsgSwapExactAmountOutWithPriceLimit{},
+		&MsgSplitRouteSwapExactAmountIn{},
+		&MsgSplitRouteSwapExactAmountOut{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -3,6 +3,8 @@ package types
 import (
 	"errors"
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -10,6 +12,10 @@ var (
 	ErrInvalidPool       = errors.New("attempting to create an invalid pool")
 	ErrTooFewPoolAssets  = errors.New("pool should have at least 2 assets, as they must be swapping between at least two assets")
 	ErrTooManyPoolAssets = errors.New("pool has too many assets (currently capped at 8 assets per pool)")
+
+	ErrSameTokenInAndOutDenom = errors.New("token in and token out denoms must be different")
+
+	ErrDuplicateRoutesNotAllowed = errors.New("duplicate multihop routes are not allowed")
 )
 
 type nonPositiveAmountError struct {
@@ -20,6 +26,41 @@ func (e nonPositiveAmountError) Error() string {
 	return fmt.Sprintf("min out amount or max in amount should be positive, was (%s)", e.Amount)
 }
 
+type nonPositivePriceLimitError struct {
+	PriceLimit sdk.Dec
+}
+
+func (e nonPositivePriceLimitError) Error() string {
+	return fmt.Sprintf("price limit should be positive, was (%s)", e.PriceLimit)
+}
+
+type InconsistentSplitRouteDenomError struct {
+	Expected string
+	Actual   string
+}
+
+func (e InconsistentSplitRouteDenomError) Error() string {
+	return fmt.Sprintf("all split routes must swap between the same denoms, expected denom (%s), was (%s)", e.Expected, e.Actual)
+}
+
+type InsufficientSplitRouteTokenOutError struct {
+	TokenOutAmount    sdk.Int
+	TokenOutMinAmount sdk.Int
+}
+
+func (e InsufficientSplitRouteTokenOutError) Error() string {
+	return fmt.Sprintf("total token out amount (%s) across split routes is less than the minimum amount (%s)", e.TokenOutAmount, e.TokenOutMinAmount)
+}
+
+type ExcessiveSplitRouteTokenInError struct {
+	TokenInAmount    sdk.Int
+	TokenInMaxAmount sdk.Int
+}
+
+func (e ExcessiveSplitRouteTokenInError) Error() string {
+	return fmt.Sprintf("total token in amount (%s) across split routes is greater than the maximum amount (%s)", e.TokenInAmount, e.TokenInMaxAmount)
+}
+
 type FailedToFindRouteError struct {
 	PoolId uint64
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
 )
 
@@ -21,6 +22,9 @@ var (
 
 	// SwapModuleRouterPrefix defines prefix to store pool id to swap module mappings.
 	SwapModuleRouterPrefix = []byte{0x02}
+
+	// DenomPoolsPrefix defines prefix to store the index of pool ids by the denoms that the pools trade.
+	DenomPoolsPrefix = []byte{0x03}
 )
 
 // ModuleRouteToBytes serializes moduleRoute to bytes.
@@ -28,6 +32,17 @@ func FormatModuleRouteKey(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d", SwapModuleRouterPrefix, poolId))
 }
 
+// FormatDenomPoolsPrefix returns the index prefix for all pools trading the given denom.
+func FormatDenomPoolsPrefix(denom string) []byte {
+	return []byte(fmt.Sprintf("%s%s|", DenomPoolsPrefix, denom))
+}
+
+// FormatDenomPoolKey returns the index key of the given pool trading the given denom.
+// The pool id is big endian encoded so that the pools of a denom are iterated in ascending pool id order.
+func FormatDenomPoolKey(denom string, poolId uint64) []byte {
+	return append(FormatDenomPoolsPrefix(denom), sdk.Uint64ToBigEndian(poolId)...)
+}
+
 // ParseModuleRouteFromBz parses the raw bytes into ModuleRoute.
 // Returns error if fails to parse or if the bytes are empty.
 func ParseModuleRouteFromBz(bz []byte) (ModuleRoute, error) {
@@ -9,6 +9,12 @@ import (
 const (
 	TypeMsgSwapExactAmountIn  = "swap_exact_amount_in"
 	TypeMsgSwapExactAmountOut = "swap_exact_amount_out"
+
+	TypeMsgSwapExactAmountInWithPriceLimit  = "swap_exact_amount_in_with_price_limit"
+	TypeMsgSwapExactAmountOutWithPriceLimit = "swap_exact_amount_out_with_price_limit"
+
+	TypeMsgSplitRouteSwapExactAmountIn  = "split_route_swap_exact_amount_in"
+	TypeMsgSplitRouteSwapExactAmountOut = "split_route_swap_exact_amount_out"
 )
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -87,3 +93,195 @@ func (msg MsgSwapExactAmountOut) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSwapExactAmountInWithPriceLimit{}
+
+func (msg MsgSwapExactAmountInWithPriceLimit) Route() string { return RouterKey }
+func (msg MsgSwapExactAmountInWithPriceLimit) Type() string {
+	return TypeMsgSwapExactAmountInWithPriceLimit
+}
+
+func (msg MsgSwapExactAmountInWithPriceLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	err = sdk.ValidateDenom(msg.TokenOutDenom)
+	if err != nil {
+		return err
+	}
+
+	if msg.TokenIn.Denom == msg.TokenOutDenom {
+		return ErrSameTokenInAndOutDenom
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
+	}
+
+	if msg.PriceLimit.IsNil() || !msg.PriceLimit.IsPositive() {
+		return nonPositivePriceLimitError{msg.PriceLimit}
+	}
+
+	return nil
+}
+
+func (msg MsgSwapExactAmountInWithPriceLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSwapExactAmountInWithPriceLimit) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSwapExactAmountOutWithPriceLimit{}
+
+func (msg MsgSwapExactAmountOutWithPriceLimit) Route() string { return RouterKey }
+func (msg MsgSwapExactAmountOutWithPriceLimit) Type() string {
+	return TypeMsgSwapExactAmountOutWithPriceLimit
+}
+
+func (msg MsgSwapExactAmountOutWithPriceLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenOut.IsValid() || !msg.TokenOut.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenOut.String())
+	}
+
+	err = sdk.ValidateDenom(msg.TokenInDenom)
+	if err != nil {
+		return err
+	}
+
+	if msg.TokenInDenom == msg.TokenOut.Denom {
+		return ErrSameTokenInAndOutDenom
+	}
+
+	if !msg.TokenInMaxAmount.IsPositive() {
+		return nonPositiveAmountError{msg.TokenInMaxAmount.String()}
+	}
+
+	if msg.PriceLimit.IsNil() || !msg.PriceLimit.IsPositive() {
+		return nonPositivePriceLimitError{msg.PriceLimit}
+	}
+
+	return nil
+}
+
+func (msg MsgSwapExactAmountOutWithPriceLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSwapExactAmountOutWithPriceLimit) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSplitRouteSwapExactAmountIn{}
+
+func (msg MsgSplitRouteSwapExactAmountIn) Route() string { return RouterKey }
+func (msg MsgSplitRouteSwapExactAmountIn) Type() string {
+	return TypeMsgSplitRouteSwapExactAmountIn
+}
+
+func (msg MsgSplitRouteSwapExactAmountIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = sdk.ValidateDenom(msg.TokenInDenom)
+	if err != nil {
+		return err
+	}
+
+	routes := SwapAmountInSplitRoutes(msg.Routes)
+	err = routes.Validate()
+	if err != nil {
+		return err
+	}
+
+	if msg.TokenInDenom == routes.TokenOutDenom() {
+		return ErrSameTokenInAndOutDenom
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgSplitRouteSwapExactAmountIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSplitRouteSwapExactAmountIn) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSplitRouteSwapExactAmountOut{}
+
+func (msg MsgSplitRouteSwapExactAmountOut) Route() string { return RouterKey }
+func (msg MsgSplitRouteSwapExactAmountOut) Type() string {
+	return TypeMsgSplitRouteSwapExactAmountOut
+}
+
+func (msg MsgSplitRouteSwapExactAmountOut) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = sdk.ValidateDenom(msg.TokenOutDenom)
+	if err != nil {
+		return err
+	}
+
+	routes := SwapAmountOutSplitRoutes(msg.Routes)
+	err = routes.Validate()
+	if err != nil {
+		return err
+	}
+
+	if msg.TokenOutDenom == routes.TokenInDenom() {
+		return ErrSameTokenInAndOutDenom
+	}
+
+	if !msg.TokenInMaxAmount.IsPositive() {
+		return nonPositiveAmountError{msg.TokenInMaxAmount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgSplitRouteSwapExactAmountOut) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSplitRouteSwapExactAmountOut) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
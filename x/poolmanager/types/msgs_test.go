@@ -274,6 +274,524 @@ func TestMsgSwapExactAmountOut(t *testing.T) {
 }
 
 // Test authz serialize and de-serializes for poolmanager msg.
+func TestMsgSwapExactAmountInWithPriceLimit(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+		properMsg := types.MsgSwapExactAmountInWithPriceLimit{
+			Sender:            addr1,
+			PoolId:            1,
+			TokenIn:           sdk.NewCoin("test", sdk.NewInt(100)),
+			TokenOutDenom:     "test2",
+			TokenOutMinAmount: sdk.NewInt(1),
+			PriceLimit:        sdk.NewDecWithPrec(5, 1),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "swap_exact_amount_in_with_price_limit")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSwapExactAmountInWithPriceLimit
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token out denom",
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token in denom",
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.TokenIn.Denom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "same token in and out denom",
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.TokenOutDenom = msg.TokenIn.Denom
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount token",
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.TokenIn.Amount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount criteria",
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.TokenOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero price limit",
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.PriceLimit = sdk.ZeroDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative price limit",
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.PriceLimit = sdk.NewDec(-1)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "nil price limit",
+			msg: createMsg(func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.PriceLimit = sdk.Dec{}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSwapExactAmountOutWithPriceLimit(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg types.MsgSwapExactAmountOutWithPriceLimit) types.MsgSwapExactAmountOutWithPriceLimit) types.MsgSwapExactAmountOutWithPriceLimit {
+		properMsg := types.MsgSwapExactAmountOutWithPriceLimit{
+			Sender:           addr1,
+			PoolId:           1,
+			TokenInDenom:     "test",
+			TokenInMaxAmount: sdk.NewInt(100),
+			TokenOut:         sdk.NewCoin("test2", sdk.NewInt(10)),
+			PriceLimit:       sdk.NewDecWithPrec(5, 1),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgSwapExactAmountOutWithPriceLimit) types.MsgSwapExactAmountOutWithPriceLimit {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "swap_exact_amount_out_with_price_limit")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSwapExactAmountOutWithPriceLimit
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgSwapExactAmountOutWithPriceLimit) types.MsgSwapExactAmountOutWithPriceLimit {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg types.MsgSwapExactAmountOutWithPriceLimit) types.MsgSwapExactAmountOutWithPriceLimit {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token out denom",
+			msg: createMsg(func(msg types.MsgSwapExactAmountOutWithPriceLimit) types.MsgSwapExactAmountOutWithPriceLimit {
+				msg.TokenOut.Denom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token in denom",
+			msg: createMsg(func(msg types.MsgSwapExactAmountOutWithPriceLimit) types.MsgSwapExactAmountOutWithPriceLimit {
+				msg.TokenInDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "same token in and out denom",
+			msg: createMsg(func(msg types.MsgSwapExactAmountOutWithPriceLimit) types.MsgSwapExactAmountOutWithPriceLimit {
+				msg.TokenInDenom = msg.TokenOut.Denom
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount token",
+			msg: createMsg(func(msg types.MsgSwapExactAmountOutWithPriceLimit) types.MsgSwapExactAmountOutWithPriceLimit {
+				msg.TokenOut.Amount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount criteria",
+			msg: createMsg(func(msg types.MsgSwapExactAmountOutWithPriceLimit) types.MsgSwapExactAmountOutWithPriceLimit {
+				msg.TokenInMaxAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero price limit",
+			msg: createMsg(func(msg types.MsgSwapExactAmountOutWithPriceLimit) types.MsgSwapExactAmountOutWithPriceLimit {
+				msg.PriceLimit = sdk.ZeroDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative price limit",
+			msg: createMsg(func(msg types.MsgSwapExactAmountOutWithPriceLimit) types.MsgSwapExactAmountOutWithPriceLimit {
+				msg.PriceLimit = sdk.NewDec(-1)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "nil price limit",
+			msg: createMsg(func(msg types.MsgSwapExactAmountOutWithPriceLimit) types.MsgSwapExactAmountOutWithPriceLimit {
+				msg.PriceLimit = sdk.Dec{}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSplitRouteSwapExactAmountIn(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+		properMsg := types.MsgSplitRouteSwapExactAmountIn{
+			Sender: addr1,
+			Routes: []types.SwapAmountInSplitRoute{
+				{
+					Pools:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "test2"}},
+					TokenInAmount: sdk.NewInt(100),
+				},
+				{
+					Pools:         []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "test3"}, {PoolId: 3, TokenOutDenom: "test2"}},
+					TokenInAmount: sdk.NewInt(50),
+				},
+			},
+			TokenInDenom:      "test",
+			TokenOutMinAmount: sdk.NewInt(200),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "split_route_swap_exact_amount_in")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSplitRouteSwapExactAmountIn
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token in denom",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.TokenInDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty route pools",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid route denom",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools[0].TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero route amount",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[0].TokenInAmount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate routes",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools = msg.Routes[0].Pools
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "routes end in different denoms",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools[1].TokenOutDenom = "test4"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "same token in and out denom",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.TokenInDenom = "test2"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount criteria",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.TokenOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSplitRouteSwapExactAmountOut(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+		properMsg := types.MsgSplitRouteSwapExactAmountOut{
+			Sender: addr1,
+			Routes: []types.SwapAmountOutSplitRoute{
+				{
+					Pools:          []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "test"}},
+					TokenOutAmount: sdk.NewInt(100),
+				},
+				{
+					Pools:          []types.SwapAmountOutRoute{{PoolId: 2, TokenInDenom: "test"}, {PoolId: 3, TokenInDenom: "test3"}},
+					TokenOutAmount: sdk.NewInt(50),
+				},
+			},
+			TokenOutDenom:    "test2",
+			TokenInMaxAmount: sdk.NewInt(200),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "split_route_swap_exact_amount_out")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSplitRouteSwapExactAmountOut
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token out denom",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero route amount",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Routes[1].TokenOutAmount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate routes",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Routes[1].Pools = msg.Routes[0].Pools
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "routes start from different denoms",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Routes[1].Pools[0].TokenInDenom = "test4"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "same token in and out denom",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.TokenOutDenom = "test"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount criteria",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.TokenInMaxAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
 	addr1 := sdk.AccAddress(pk1.Address()).String()
@@ -313,6 +831,41 @@ func TestAuthzMsg(t *testing.T) {
 				TokenInMaxAmount: sdk.NewInt(1),
 			},
 		},
+		{
+			name: "MsgSwapExactAmountInWithPriceLimit",
+			msg: &types.MsgSwapExactAmountInWithPriceLimit{
+				Sender:            addr1,
+				PoolId:            1,
+				TokenIn:           coin,
+				TokenOutDenom:     "test",
+				TokenOutMinAmount: sdk.NewInt(1),
+				PriceLimit:        sdk.NewDecWithPrec(5, 1),
+			},
+		},
+		{
+			name: "MsgSplitRouteSwapExactAmountIn",
+			msg: &types.MsgSplitRouteSwapExactAmountIn{
+				Sender: addr1,
+				Routes: []types.SwapAmountInSplitRoute{{
+					Pools:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "test"}},
+					TokenInAmount: sdk.NewInt(1),
+				}},
+				TokenInDenom:      sdk.DefaultBondDenom,
+				TokenOutMinAmount: sdk.NewInt(1),
+			},
+		},
+		{
+			name: "MsgSplitRouteSwapExactAmountOut",
+			msg: &types.MsgSplitRouteSwapExactAmountOut{
+				Sender: addr1,
+				Routes: []types.SwapAmountOutSplitRoute{{
+					Pools:          []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "test"}},
+					TokenOutAmount: sdk.NewInt(1),
+				}},
+				TokenOutDenom:    sdk.DefaultBondDenom,
+				TokenInMaxAmount: sdk.NewInt(1),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
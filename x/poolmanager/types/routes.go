@@ -1,12 +1,32 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+const (
+	// DefaultRouteQuoteMaxHops is the maximum number of pools in a quoted route when none is given.
+	DefaultRouteQuoteMaxHops = 2
+	// MaxRouteQuoteMaxHops is the upper limit on the maximum number of pools in a quoted route.
+	MaxRouteQuoteMaxHops = 3
+	// DefaultRouteQuoteMaxRoutes is the maximum number of quoted routes returned when none is given.
+	DefaultRouteQuoteMaxRoutes = 5
+	// MaxRouteQuoteMaxRoutes is the upper limit on the maximum number of quoted routes returned.
+	MaxRouteQuoteMaxRoutes = 10
+	// MaxRouteQuoteCandidates is the maximum number of candidate routes estimated by a single route quote.
+	// It bounds the gas consumed by the quote regardless of the number of pools.
+	MaxRouteQuoteCandidates = 50
+	// MaxRouteQuoteSearchNodes is the maximum number of pools visited while searching for candidate routes
+	// by a single route quote. It bounds the search, and the pools loaded from state, regardless of the number
+	// of pools and of how densely they share denoms.
+	MaxRouteQuoteSearchNodes = 10000
+)
+
 // AccountI defines the account contract that must be fulfilled when
 // creating a x/gamm keeper.
 type AccountI interface {
@@ -32,6 +52,10 @@ type SwapI interface {
 	InitializePool(ctx sdk.Context, pool PoolI, creatorAddress sdk.AccAddress) error
 
 	GetPool(ctx sdk.Context, poolId uint64) (PoolI, error)
+	// GetPoolDenoms returns the denoms of the assets in the pool, sorted lexicographically.
+	GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
+	// CalculateSpotPrice returns the spot price of the base asset in terms of the quote asset in the pool.
+	CalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteAssetDenom, baseAssetDenom string) (sdk.Dec, error)
 
 	SwapExactAmountIn(
 		ctx sdk.Context,
@@ -70,6 +94,77 @@ type SwapI interface {
 		tokenInDenom string,
 		swapFee sdk.Dec,
 	) (tokenIn sdk.Coin, err error)
+
+	// SwapExactAmountInWithPriceLimit swaps up to tokenIn through the pool, stopping early once the spot price
+	// of tokenIn quoted in tokenOutDenom falls to priceLimit. Returns the amount of tokenIn actually swapped
+	// and the amount of tokenOut received. Errors if the amount out is less than tokenOutMinAmount.
+	SwapExactAmountInWithPriceLimit(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		pool PoolI,
+		tokenIn sdk.Coin,
+		tokenOutDenom string,
+		tokenOutMinAmount sdk.Int,
+		swapFee sdk.Dec,
+		priceLimit sdk.Dec,
+	) (tokenInAmount, tokenOutAmount sdk.Int, err error)
+	// CalcOutAmtGivenInWithPriceLimit calculates how much of tokenIn can be swapped before the spot price
+	// of tokenIn quoted in tokenOutDenom falls to priceLimit, along with the resulting tokenOut.
+	// Returns error on internal calculations or if the price limit is not below the current spot price.
+	CalcOutAmtGivenInWithPriceLimit(
+		ctx sdk.Context,
+		poolI PoolI,
+		tokenIn sdk.Coin,
+		tokenOutDenom string,
+		swapFee sdk.Dec,
+		priceLimit sdk.Dec,
+	) (tokenInUsed, tokenOut sdk.Coin, err error)
+
+	// SwapExactAmountOutWithPriceLimit swaps for up to tokenOut through the pool, stopping early once the spot
+	// price of tokenInDenom quoted in tokenOut's denom reaches priceLimit. Returns the amount of tokenIn paid
+	// and the amount of tokenOut actually received. Errors if the amount in is greater than tokenInMaxAmount.
+	SwapExactAmountOutWithPriceLimit(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		pool PoolI,
+		tokenInDenom string,
+		tokenInMaxAmount sdk.Int,
+		tokenOut sdk.Coin,
+		swapFee sdk.Dec,
+		priceLimit sdk.Dec,
+	) (tokenInAmount, tokenOutAmount sdk.Int, err error)
+	// CalcInAmtGivenOutWithPriceLimit calculates how much of tokenOut can be swapped for before the spot price
+	// of tokenInDenom quoted in tokenOut's denom reaches priceLimit, along with the tokenIn required.
+	// Returns error on internal calculations or if the price limit is on the wrong side of the current spot price.
+	CalcInAmtGivenOutWithPriceLimit(
+		ctx sdk.Context,
+		poolI PoolI,
+		tokenOut sdk.Coin,
+		tokenInDenom string,
+		swapFee sdk.Dec,
+		priceLimit sdk.Dec,
+	) (tokenIn, tokenOutUsed sdk.Coin, err error)
+
+	// SimulateSwapExactAmountIn applies swapping tokenIn through the pool to the pool's state without settling
+	// any balances, and returns the resulting tokenOut. It is only meant to be called on a cache context that
+	// is never written, so that several swaps through the same pool can be estimated one after another.
+	SimulateSwapExactAmountIn(
+		ctx sdk.Context,
+		poolI PoolI,
+		tokenIn sdk.Coin,
+		tokenOutDenom string,
+		swapFee sdk.Dec,
+	) (tokenOut sdk.Coin, err error)
+	// SimulateSwapExactAmountOut applies swapping for tokenOut through the pool to the pool's state without
+	// settling any balances, and returns the required tokenIn. It is only meant to be called on a cache context
+	// that is never written, so that several swaps through the same pool can be estimated one after another.
+	SimulateSwapExactAmountOut(
+		ctx sdk.Context,
+		poolI PoolI,
+		tokenOut sdk.Coin,
+		tokenInDenom string,
+		swapFee sdk.Dec,
+	) (tokenIn sdk.Coin, err error)
 }
 
 type PoolIncentivesKeeperI interface {
@@ -163,3 +258,94 @@ func (routes SwapAmountOutRoutes) PoolIds() []uint64 {
 func (routes SwapAmountOutRoutes) Length() int {
 	return len(routes)
 }
+
+type SwapAmountInSplitRoutes []SwapAmountInSplitRoute
+
+// Validate validates each of the split routes and their amounts in. Returns error if there are no routes,
+// if any route is invalid, if the same multihop route is given more than once or if the routes do not
+// all end in the same token out denom.
+func (routes SwapAmountInSplitRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	seenRoutes := make(map[string]bool, len(routes))
+	var tokenOutDenom string
+	for i, route := range routes {
+		multihopRoute := SwapAmountInRoutes(route.Pools)
+		if err := multihopRoute.Validate(); err != nil {
+			return err
+		}
+
+		if route.TokenInAmount.IsNil() || !route.TokenInAmount.IsPositive() {
+			return nonPositiveAmountError{route.TokenInAmount.String()}
+		}
+
+		routeKey := fmt.Sprint(route.Pools)
+		if seenRoutes[routeKey] {
+			return ErrDuplicateRoutesNotAllowed
+		}
+		seenRoutes[routeKey] = true
+
+		lastDenom := route.Pools[len(route.Pools)-1].TokenOutDenom
+		if i == 0 {
+			tokenOutDenom = lastDenom
+		} else if lastDenom != tokenOutDenom {
+			return InconsistentSplitRouteDenomError{Expected: tokenOutDenom, Actual: lastDenom}
+		}
+	}
+
+	return nil
+}
+
+// TokenOutDenom returns the denom that all of the split routes swap into.
+// CONTRACT: the routes are valid.
+func (routes SwapAmountInSplitRoutes) TokenOutDenom() string {
+	pools := routes[0].Pools
+	return pools[len(pools)-1].TokenOutDenom
+}
+
+type SwapAmountOutSplitRoutes []SwapAmountOutSplitRoute
+
+// Validate validates each of the split routes and their amounts out. Returns error if there are no routes,
+// if any route is invalid, if the same multihop route is given more than once or if the routes do not
+// all start from the same token in denom.
+func (routes SwapAmountOutSplitRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	seenRoutes := make(map[string]bool, len(routes))
+	var tokenInDenom string
+	for i, route := range routes {
+		multihopRoute := SwapAmountOutRoutes(route.Pools)
+		if err := multihopRoute.Validate(); err != nil {
+			return err
+		}
+
+		if route.TokenOutAmount.IsNil() || !route.TokenOutAmount.IsPositive() {
+			return nonPositiveAmountError{route.TokenOutAmount.String()}
+		}
+
+		routeKey := fmt.Sprint(route.Pools)
+		if seenRoutes[routeKey] {
+			return ErrDuplicateRoutesNotAllowed
+		}
+		seenRoutes[routeKey] = true
+
+		firstDenom := route.Pools[0].TokenInDenom
+		if i == 0 {
+			tokenInDenom = firstDenom
+		} else if firstDenom != tokenInDenom {
+			return InconsistentSplitRouteDenomError{Expected: tokenInDenom, Actual: firstDenom}
+		}
+	}
+
+	return nil
+}
+
+// TokenInDenom returns the denom that all of the split routes swap from.
+// CONTRACT: the routes are valid.
+func (routes SwapAmountOutSplitRoutes) TokenInDenom() string {
+	return routes[0].Pools[0].TokenInDenom
+}
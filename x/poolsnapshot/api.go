@@ -0,0 +1,41 @@
+package poolsnapshot
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/types"
+)
+
+// GetPoolSnapshots returns the snapshots of the given pool that are in effect within [startTime, endTime],
+// in increasing order of time, paginated by the given page request.
+//
+// Snapshots are only taken at the end of every snapshot interval in which the pool changed,
+// so the state of the pool at any time is that of its latest snapshot at or before that time.
+// Hence, the latest snapshot taken at or before startTime, if any, is returned first, followed by the snapshots
+// taken within [startTime, endTime]. Non-zero startHeight and endHeight further bound the range by height
+// in the same way. Snapshots older than the retention period are pruned, except for the latest of them
+// for every pool, so the state of a pool that did not change since is never lost.
+//
+// startTime must be at or before endTime, heights must not be negative and a non-zero startHeight
+// must be at or below a non-zero endHeight.
+func (k Keeper) GetPoolSnapshots(
+	ctx sdk.Context,
+	poolId uint64,
+	startTime time.Time,
+	endTime time.Time,
+	startHeight int64,
+	endHeight int64,
+	pagination *query.PageRequest,
+) ([]types.PoolSnapshot, *query.PageResponse, error) {
+	if startTime.After(endTime) {
+		return nil, nil, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if startHeight < 0 || endHeight < 0 || (endHeight != 0 && startHeight > endHeight) {
+		return nil, nil, types.InvalidHeightRangeError{StartHeight: startHeight, EndHeight: endHeight}
+	}
+
+	return k.getSnapshotsInRange(ctx, poolId, startTime, endTime, startHeight, endHeight, pagination)
+}
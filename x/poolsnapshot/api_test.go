@@ -0,0 +1,180 @@
+package poolsnapshot_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/types"
+)
+
+func (s *TestSuite) TestGetPoolSnapshots() {
+	tPlusOne := baseTime.Add(time.Second)
+	tPlusTwo := baseTime.Add(2 * time.Second)
+	snapshots := []types.PoolSnapshot{
+		newSnapshot(basePoolId, 1, baseTime),
+		newSnapshot(basePoolId, 2, tPlusOne),
+		newSnapshot(basePoolId, 3, tPlusTwo),
+		newSnapshot(basePoolId+1, 2, tPlusOne),
+	}
+
+	tests := map[string]struct {
+		poolId      uint64
+		startTime   time.Time
+		endTime     time.Time
+		startHeight int64
+		endHeight   int64
+		expected    []types.PoolSnapshot
+		expectErr   error
+	}{
+		"all snapshots of a pool": {
+			poolId:    basePoolId,
+			startTime: baseTime.Add(-time.Hour),
+			endTime:   baseTime.Add(time.Hour),
+			expected:  snapshots[:3],
+		},
+		"start and end time are inclusive": {
+			poolId:    basePoolId,
+			startTime: tPlusOne,
+			endTime:   tPlusTwo,
+			expected:  snapshots[1:3],
+		},
+		"start time equals end time": {
+			poolId:    basePoolId,
+			startTime: tPlusOne,
+			endTime:   tPlusOne,
+			expected:  snapshots[1:2],
+		},
+		"latest snapshot before the start time is in effect at the start time": {
+			poolId:    basePoolId,
+			startTime: baseTime.Add(time.Millisecond),
+			endTime:   tPlusOne,
+			expected:  snapshots[:2],
+		},
+		"range between two snapshots": {
+			poolId:    basePoolId,
+			startTime: baseTime.Add(time.Millisecond),
+			endTime:   baseTime.Add(2 * time.Millisecond),
+			expected:  snapshots[:1],
+		},
+		"range after the latest snapshot": {
+			poolId:    basePoolId,
+			startTime: tPlusTwo.Add(time.Nanosecond),
+			endTime:   tPlusTwo.Add(time.Hour),
+			expected:  snapshots[2:3],
+		},
+		"range before the first snapshot": {
+			poolId:    basePoolId,
+			startTime: baseTime.Add(-time.Hour),
+			endTime:   baseTime.Add(-time.Nanosecond),
+			expected:  []types.PoolSnapshot{},
+		},
+		"other pool": {
+			poolId:    basePoolId + 1,
+			startTime: baseTime,
+			endTime:   tPlusTwo,
+			expected:  snapshots[3:],
+		},
+		"pool without snapshots": {
+			poolId:    basePoolId + 2,
+			startTime: baseTime,
+			endTime:   tPlusTwo,
+			expected:  []types.PoolSnapshot{},
+		},
+		"start and end height are inclusive": {
+			poolId:      basePoolId,
+			startTime:   baseTime.Add(-time.Hour),
+			endTime:     baseTime.Add(time.Hour),
+			startHeight: 2,
+			endHeight:   2,
+			expected:    snapshots[1:2],
+		},
+		"latest snapshot below the start height is in effect at the start height": {
+			poolId:      basePoolId,
+			startTime:   baseTime.Add(-time.Hour),
+			endTime:     baseTime.Add(time.Hour),
+			startHeight: 1000,
+			expected:    snapshots[2:3],
+		},
+		"later of start time and start height bounds the range": {
+			poolId:      basePoolId,
+			startTime:   tPlusOne,
+			endTime:     baseTime.Add(time.Hour),
+			startHeight: 1,
+			expected:    snapshots[1:3],
+		},
+		"error: start time after end time": {
+			poolId:    basePoolId,
+			startTime: tPlusOne,
+			endTime:   baseTime,
+			expectErr: types.StartTimeAfterEndTimeError{StartTime: tPlusOne, EndTime: baseTime},
+		},
+		"error: start height above end height": {
+			poolId:      basePoolId,
+			startTime:   baseTime,
+			endTime:     tPlusTwo,
+			startHeight: 3,
+			endHeight:   2,
+			expectErr:   types.InvalidHeightRangeError{StartHeight: 3, EndHeight: 2},
+		},
+		"error: negative height": {
+			poolId:    basePoolId,
+			startTime: baseTime,
+			endTime:   tPlusTwo,
+			endHeight: -1,
+			expectErr: types.InvalidHeightRangeError{StartHeight: 0, EndHeight: -1},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			for _, snapshot := range snapshots {
+				s.snapshotKeeper.StoreSnapshot(s.Ctx, snapshot)
+			}
+
+			result, _, err := s.snapshotKeeper.GetPoolSnapshots(s.Ctx, tc.poolId, tc.startTime, tc.endTime, tc.startHeight, tc.endHeight, nil)
+
+			if tc.expectErr != nil {
+				s.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, result)
+		})
+	}
+}
+
+// TestGetPoolSnapshots_Pagination tests that pages of snapshots are consecutive
+// and together return every snapshot in range.
+func (s *TestSuite) TestGetPoolSnapshots_Pagination() {
+	snapshots := []types.PoolSnapshot{}
+	for i := int64(0); i < 5; i++ {
+		snapshot := newSnapshot(basePoolId, i+1, baseTime.Add(time.Duration(i)*time.Second))
+		s.snapshotKeeper.StoreSnapshot(s.Ctx, snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+	s.snapshotKeeper.StoreSnapshot(s.Ctx, newSnapshot(basePoolId+1, 1, baseTime))
+
+	// The range starts between the first two snapshots, so the first one is in effect at its start.
+	startTime := baseTime.Add(time.Millisecond)
+	endTime := baseTime.Add(time.Hour)
+
+	result, pageRes, err := s.snapshotKeeper.GetPoolSnapshots(s.Ctx, basePoolId, startTime, endTime, 0, 0, &query.PageRequest{Limit: 2, CountTotal: true})
+	s.Require().NoError(err)
+	s.Require().Equal(snapshots[:2], result)
+	s.Require().Equal(uint64(5), pageRes.Total)
+
+	result, pageRes, err = s.snapshotKeeper.GetPoolSnapshots(s.Ctx, basePoolId, startTime, endTime, 0, 0, &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Equal(snapshots[2:4], result)
+
+	result, pageRes, err = s.snapshotKeeper.GetPoolSnapshots(s.Ctx, basePoolId, startTime, endTime, 0, 0, &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Equal(snapshots[4:], result)
+	s.Require().Nil(pageRes.NextKey)
+
+	result, _, err = s.snapshotKeeper.GetPoolSnapshots(s.Ctx, basePoolId, startTime, endTime, 0, 0, &query.PageRequest{Offset: 3, Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Equal(snapshots[3:], result)
+}
@@ -0,0 +1,18 @@
+package cli
+
+import flag "github.com/spf13/pflag"
+
+const (
+	// Will be parsed to int64.
+	FlagStartHeight = "start-height"
+	// Will be parsed to int64.
+	FlagEndHeight = "end-height"
+)
+
+func FlagSetPoolSnapshots() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagStartHeight, "0", "Lowest height of the returned snapshots (defaults to no bound)")
+	fs.String(FlagEndHeight, "0", "Highest height of the returned snapshots (defaults to no bound)")
+	return fs
+}
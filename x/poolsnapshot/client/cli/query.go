@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPoolSnapshots)
+	cmd.AddCommand(
+		osmocli.GetParams[*queryproto.ParamsRequest](
+			types.ModuleName, queryproto.NewQueryClient),
+	)
+
+	return cmd
+}
+
+func GetCmdPoolSnapshots() (*osmocli.QueryDescriptor, *queryproto.PoolSnapshotsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-snapshots [pool-id] [start-time] [end-time]",
+		Short: "Query the snapshots of a pool taken between start-time and end-time",
+		Long: `{{.Short}}
+Start and end time must be unix time. The latest snapshot taken before the start is returned first,
+as it holds the state of the pool at the start.
+{{.ExampleHeader}}
+{{.CommandPrefix}} pool-snapshots 1 1667088000 1667174400 --start-height=100 --end-height=200 --limit=10`,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetPoolSnapshots()}},
+		CustomFlagOverrides: map[string]string{"startheight": FlagStartHeight, "endheight": FlagEndHeight},
+		CustomFieldParsers:  map[string]osmocli.CustomFieldParserFn{"EndTime": parseEndTime},
+	}, &queryproto.PoolSnapshotsRequest{}
+}
+
+func parseEndTime(arg string, _ *flag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	endTime, err := osmocli.ParseUnixTime(arg, "end time")
+	if err != nil {
+		return nil, osmocli.UsedArg, err
+	}
+	return &endTime, osmocli.UsedArg, nil
+}
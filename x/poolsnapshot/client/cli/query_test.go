@@ -0,0 +1,41 @@
+package cli_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/client/cli"
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/client/queryproto"
+)
+
+func TestGetCmdPoolSnapshots(t *testing.T) {
+	startTime := time.Unix(1667088000, 0)
+	endTime := time.Unix(1667174400, 0)
+	desc, _ := cli.GetCmdPoolSnapshots()
+	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.PoolSnapshotsRequest]{
+		"time range": {
+			Cmd: "1 1667088000 1667174400",
+			ExpectedQuery: &queryproto.PoolSnapshotsRequest{
+				PoolId:     1,
+				StartTime:  startTime,
+				EndTime:    &endTime,
+				Pagination: &query.PageRequest{Key: []uint8{}, Limit: 100},
+			},
+		},
+		"height range and pagination": {
+			Cmd: "1 1667088000 1667174400 --start-height=100 --end-height=200 --limit=10",
+			ExpectedQuery: &queryproto.PoolSnapshotsRequest{
+				PoolId:      1,
+				StartTime:   startTime,
+				EndTime:     &endTime,
+				StartHeight: 100,
+				EndHeight:   200,
+				Pagination:  &query.PageRequest{Key: []uint8{}, Limit: 10},
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot"
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/client/queryproto"
+)
+
+// This file should evolve to being code gen'd, off of `proto/poolsnapshot/v1beta1/query.yml`
+
+type Querier struct {
+	K poolsnapshot.Keeper
+}
+
+func (q Querier) PoolSnapshots(ctx sdk.Context,
+	req queryproto.PoolSnapshotsRequest,
+) (*queryproto.PoolSnapshotsResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	snapshots, pageRes, err := q.K.GetPoolSnapshots(ctx, req.PoolId, req.StartTime, *req.EndTime, req.StartHeight, req.EndHeight, req.Pagination)
+
+	return &queryproto.PoolSnapshotsResponse{Snapshots: snapshots, Pagination: pageRes}, err
+}
+
+func (q Querier) Params(ctx sdk.Context,
+	req queryproto.ParamsRequest,
+) (*queryproto.ParamsResponse, error) {
+	params := q.K.GetParams(ctx)
+	return &queryproto.ParamsResponse{Params: params}, nil
+}
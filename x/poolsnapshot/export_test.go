@@ -0,0 +1,33 @@
+package poolsnapshot
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/types"
+)
+
+func (k Keeper) TrackChangedPool(ctx sdk.Context, poolId uint64) {
+	k.trackChangedPool(ctx, poolId)
+}
+
+func (k Keeper) GetChangedPools(ctx sdk.Context) []uint64 {
+	return k.getChangedPools(ctx)
+}
+
+func (k Keeper) StoreSnapshot(ctx sdk.Context, snapshot types.PoolSnapshot) {
+	k.storeSnapshot(ctx, snapshot)
+}
+
+func (k Keeper) SnapshotPool(ctx sdk.Context, poolId uint64) (types.PoolSnapshot, error) {
+	return k.snapshotPool(ctx, poolId)
+}
+
+func (k Keeper) PruneSnapshotsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time) error {
+	return k.pruneSnapshotsBeforeTimeButNewest(ctx, lastKeptTime)
+}
+
+func (k Keeper) GetAllSnapshots(ctx sdk.Context) ([]types.PoolSnapshot, error) {
+	return k.getAllSnapshots(ctx)
+}
@@ -0,0 +1,72 @@
+package poolsnapshot
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/types"
+)
+
+type Keeper struct {
+	storeKey sdk.StoreKey
+
+	paramSpace paramtypes.Subspace
+
+	poolmanagerKeeper types.PoolManagerInterface
+	clKeeper          types.ConcentratedLiquidityKeeper
+}
+
+func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, poolmanagerKeeper types.PoolManagerInterface, clKeeper types.ConcentratedLiquidityKeeper) *Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return &Keeper{storeKey: storeKey, paramSpace: paramSpace, poolmanagerKeeper: poolmanagerKeeper, clKeeper: clKeeper}
+}
+
+// GetParams returns the total set of poolsnapshot parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of poolsnapshot parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// InitGenesis initializes the poolsnapshot module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.SetParams(ctx, genState.Params)
+
+	for _, snapshot := range genState.Snapshots {
+		k.storeSnapshot(ctx, snapshot)
+	}
+
+	for _, poolId := range genState.ChangedPoolIds {
+		k.trackChangedPool(ctx, poolId)
+	}
+}
+
+// ExportGenesis returns the poolsnapshot module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	// These are ordered in increasing order of time, guaranteed by the iterator
+	// that is prefixed by time.
+	snapshots, err := k.getAllSnapshots(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Params:         k.GetParams(ctx),
+		Snapshots:      snapshots,
+		ChangedPoolIds: k.getChangedPools(ctx),
+	}
+}
@@ -0,0 +1,85 @@
+package poolsnapshot_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v14/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot"
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/types"
+)
+
+var (
+	denom0                        = "token/A"
+	denom1                        = "token/B"
+	denom2                        = "token/C"
+	defaultTwoAssetCoins          = sdk.NewCoins(sdk.NewInt64Coin(denom0, 1_000_000_000), sdk.NewInt64Coin(denom1, 2_000_000_000))
+	defaultThreeAssetCoins        = sdk.NewCoins(sdk.NewInt64Coin(denom0, 1_000_000_000), sdk.NewInt64Coin(denom1, 2_000_000_000), sdk.NewInt64Coin(denom2, 4_000_000_000))
+	baseTime                      = time.Unix(1257894000, 0).UTC()
+	basePoolId             uint64 = 1
+	basicParams                   = types.NewParams(10, 48*time.Hour)
+)
+
+type TestSuite struct {
+	apptesting.KeeperTestHelper
+	snapshotKeeper *poolsnapshot.Keeper
+}
+
+func TestSuiteRun(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) SetupTest() {
+	s.Setup()
+	s.snapshotKeeper = s.App.PoolSnapshotKeeper
+	s.Ctx = s.Ctx.WithBlockTime(baseTime).WithBlockHeight(1)
+	s.snapshotKeeper.SetParams(s.Ctx, basicParams)
+}
+
+func newSnapshot(poolId uint64, height int64, t time.Time) types.PoolSnapshot {
+	return types.PoolSnapshot{
+		PoolId:      poolId,
+		Height:      height,
+		Time:        t,
+		Reserves:    defaultTwoAssetCoins,
+		TotalShares: sdk.NewInt(100),
+		Liquidity:   sdk.ZeroDec(),
+		SpotPrices:  []types.SpotPrice{{BaseAsset: denom0, QuoteAsset: denom1, SpotPrice: sdk.NewDec(2)}},
+	}
+}
+
+// TestInitExportGenesis tests that the genesis state is the same after
+// initializing and exporting it.
+func (s *TestSuite) TestInitExportGenesis() {
+	tests := map[string]struct {
+		genesis *types.GenesisState
+	}{
+		"default genesis": {
+			genesis: types.DefaultGenesis(),
+		},
+		"custom genesis": {
+			genesis: types.NewGenesisState(
+				basicParams,
+				[]types.PoolSnapshot{
+					newSnapshot(basePoolId, 1, baseTime),
+					newSnapshot(basePoolId+1, 1, baseTime),
+					newSnapshot(basePoolId, 11, baseTime.Add(time.Minute)),
+				},
+				[]uint64{basePoolId, basePoolId + 2}),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+			k := s.App.PoolSnapshotKeeper
+
+			k.InitGenesis(s.Ctx, tc.genesis)
+
+			s.Require().Equal(tc.genesis, k.ExportGenesis(s.Ctx))
+		})
+	}
+}
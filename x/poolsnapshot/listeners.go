@@ -0,0 +1,66 @@
+package poolsnapshot
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cltypes "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v14/x/gamm/types"
+)
+
+var (
+	_ types.GammHooks                       = &gammhook{}
+	_ cltypes.ConcentratedLiquidityListener = &concentratedLiquidityListener{}
+)
+
+type gammhook struct {
+	k Keeper
+}
+
+func (k Keeper) GammHooks() types.GammHooks {
+	return &gammhook{k}
+}
+
+// AfterPoolCreated is called after CreatePool
+func (hook *gammhook) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	hook.k.trackChangedPool(ctx, poolId)
+}
+
+func (hook *gammhook) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	hook.k.trackChangedPool(ctx, poolId)
+}
+
+func (hook *gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+	hook.k.trackChangedPool(ctx, poolId)
+}
+
+func (hook *gammhook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+	hook.k.trackChangedPool(ctx, poolId)
+}
+
+type concentratedLiquidityListener struct {
+	k Keeper
+}
+
+func (k Keeper) ConcentratedLiquidityListener() cltypes.ConcentratedLiquidityListener {
+	return &concentratedLiquidityListener{k}
+}
+
+func (l *concentratedLiquidityListener) AfterConcentratedPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	l.k.trackChangedPool(ctx, poolId)
+}
+
+func (l *concentratedLiquidityListener) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	l.k.trackChangedPool(ctx, poolId)
+}
+
+func (l *concentratedLiquidityListener) AfterPositionCreated(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidity sdk.Dec) {
+	l.k.trackChangedPool(ctx, poolId)
+}
+
+func (l *concentratedLiquidityListener) AfterPositionModified(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidityDelta sdk.Dec) {
+	l.k.trackChangedPool(ctx, poolId)
+}
+
+func (l *concentratedLiquidityListener) AfterPositionWithdrawn(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensOut sdk.Coins, liquidity sdk.Dec) {
+	l.k.trackChangedPool(ctx, poolId)
+}
@@ -0,0 +1,103 @@
+package poolsnapshot
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cltypes "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/types"
+)
+
+// EndBlock snapshots every pool that changed since the last snapshot, once every snapshot interval blocks,
+// and prunes the snapshots that are older than the retention period, except for the newest of them for every pool.
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.SnapshotInterval == 0 || ctx.BlockHeight()%int64(params.SnapshotInterval) != 0 {
+		return
+	}
+
+	for _, poolId := range k.getChangedPools(ctx) {
+		snapshot, err := k.snapshotPool(ctx, poolId)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("Error snapshotting pool %d: %s", poolId, err))
+			continue
+		}
+		k.storeSnapshot(ctx, snapshot)
+	}
+	k.clearChangedPools(ctx)
+
+	lastKeptTime := ctx.BlockTime().Add(-params.RetentionPeriod)
+	if err := k.pruneSnapshotsBeforeTimeButNewest(ctx, lastKeptTime); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("Error pruning old pool snapshots: %s", err))
+	}
+}
+
+// snapshotPool returns the current state of the given pool.
+func (k Keeper) snapshotPool(ctx sdk.Context, poolId uint64) (types.PoolSnapshot, error) {
+	swapModule, err := k.poolmanagerKeeper.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return types.PoolSnapshot{}, err
+	}
+	pool, err := swapModule.GetPool(ctx, poolId)
+	if err != nil {
+		return types.PoolSnapshot{}, err
+	}
+
+	snapshot := types.PoolSnapshot{
+		PoolId:      poolId,
+		Height:      ctx.BlockHeight(),
+		Time:        ctx.BlockTime(),
+		TotalShares: sdk.ZeroInt(),
+		Liquidity:   sdk.ZeroDec(),
+		SpotPrices:  []types.SpotPrice{},
+	}
+
+	if concentratedPool, ok := pool.(cltypes.ConcentratedPoolExtension); ok {
+		// Concentrated liquidity pools do not track their reserves. The balances of the pool's address
+		// also hold uncollected fees, so the reserves are computed from the pool's positions instead.
+		snapshot.Reserves, err = k.clKeeper.GetPoolReserves(ctx, poolId)
+		if err != nil {
+			return types.PoolSnapshot{}, err
+		}
+		snapshot.Liquidity = concentratedPool.GetLiquidity()
+	} else {
+		snapshot.Reserves = pool.GetTotalPoolLiquidity(ctx)
+		snapshot.TotalShares = pool.GetTotalShares()
+	}
+
+	// Reserves are sorted by denom, so the base asset of every pair sorts before its quote asset.
+	for i, base := range snapshot.Reserves {
+		for _, quote := range snapshot.Reserves[i+1:] {
+			spotPrice, err := spotPrice(ctx, pool, base.Denom, quote.Denom)
+			if err != nil {
+				spotPrice = sdk.ZeroDec()
+			}
+			snapshot.SpotPrices = append(snapshot.SpotPrices, types.SpotPrice{
+				BaseAsset:  base.Denom,
+				QuoteAsset: quote.Denom,
+				SpotPrice:  spotPrice,
+			})
+		}
+	}
+	return snapshot, nil
+}
+
+// spotPrice returns the price of the base asset in terms of the quote asset in the given pool.
+// This function is guaranteed to not panic.
+func spotPrice(ctx sdk.Context, pool poolmanagertypes.PoolI, baseAsset, quoteAsset string) (price sdk.Dec, err error) {
+	// defer to catch panics, in case something internal overflows or divides by zero.
+	defer func() {
+		if r := recover(); r != nil {
+			price = sdk.Dec{}
+			err = fmt.Errorf("error calculating the spot price of %s in %s: %v", baseAsset, quoteAsset, r)
+		}
+	}()
+
+	// Unlike CFMM pools, concentrated liquidity pools take the base asset first.
+	if _, ok := pool.(cltypes.ConcentratedPoolExtension); ok {
+		return pool.SpotPrice(ctx, baseAsset, quoteAsset)
+	}
+	return pool.SpotPrice(ctx, quoteAsset, baseAsset)
+}
@@ -0,0 +1,225 @@
+package poolsnapshot_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v14/app/apptesting"
+	cl "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity"
+	cltypes "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/types"
+)
+
+func (s *TestSuite) TestSnapshotPool_Balancer() {
+	poolId := s.PrepareBalancerPoolWithCoins(defaultThreeAssetCoins...)
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+
+	snapshot, err := s.snapshotKeeper.SnapshotPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+
+	s.Require().Equal(poolId, snapshot.PoolId)
+	s.Require().Equal(s.Ctx.BlockHeight(), snapshot.Height)
+	s.Require().Equal(s.Ctx.BlockTime(), snapshot.Time)
+	s.Require().Equal(defaultThreeAssetCoins, snapshot.Reserves)
+	s.Require().Equal(pool.GetTotalShares(), snapshot.TotalShares)
+	s.Require().Equal(sdk.ZeroDec(), snapshot.Liquidity)
+	// every pair of assets has a spot price, with the base asset sorting before the quote asset.
+	s.Require().Equal([]types.SpotPrice{
+		{BaseAsset: denom0, QuoteAsset: denom1, SpotPrice: sdk.NewDec(2)},
+		{BaseAsset: denom0, QuoteAsset: denom2, SpotPrice: sdk.NewDec(4)},
+		{BaseAsset: denom1, QuoteAsset: denom2, SpotPrice: sdk.NewDec(2)},
+	}, snapshot.SpotPrices)
+}
+
+func (s *TestSuite) TestSnapshotPool_Concentrated() {
+	swapFee := sdk.MustNewDecFromStr("0.01")
+	clPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], apptesting.ETH, apptesting.USDC, apptesting.DefaultTickSpacing, apptesting.DefaultExponentAtPriceOne, swapFee)
+	poolId := clPool.GetId()
+
+	// create a full range position
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(apptesting.ETH, 1_000_000), sdk.NewInt64Coin(apptesting.USDC, 5_000_000_000)))
+	minTick, maxTick := cl.GetMinAndMaxTicksFromExponentAtPriceOne(clPool.GetPrecisionFactorAtPriceOne())
+	_, amount0, amount1, _, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, poolId, s.TestAccs[0],
+		sdk.NewInt(1_000_000), sdk.NewInt(5_000_000_000), sdk.ZeroInt(), sdk.ZeroInt(), minTick, maxTick, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+
+	// reserves are what the position holds.
+	snapshot, err := s.snapshotKeeper.SnapshotPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(apptesting.ETH, amount0), sdk.NewCoin(apptesting.USDC, amount1)), snapshot.Reserves)
+
+	// swap against the position, leaving the swap fee in the pool's balance.
+	tokenIn := sdk.NewInt64Coin(apptesting.USDC, 1_000_000)
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0],
+		[]poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: apptesting.ETH}}, tokenIn, sdk.OneInt())
+	s.Require().NoError(err)
+
+	poolI, err := s.App.ConcentratedLiquidityKeeper.GetPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	clPool = poolI.(cltypes.ConcentratedPoolExtension)
+	expectedSpotPrice, err := clPool.SpotPrice(s.Ctx, apptesting.ETH, apptesting.USDC)
+	s.Require().NoError(err)
+	expectedReserves, err := s.App.ConcentratedLiquidityKeeper.GetPoolReserves(s.Ctx, poolId)
+	s.Require().NoError(err)
+
+	snapshot, err = s.snapshotKeeper.SnapshotPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+
+	// reserves are computed from the position rather than the pool's balances, so they exclude the
+	// uncollected swap fee, and the pool has liquidity rather than shares.
+	s.Require().Equal(expectedReserves, snapshot.Reserves)
+	poolBalance := s.App.BankKeeper.GetBalance(s.Ctx, clPool.GetAddress(), apptesting.USDC)
+	expectedFee := swapFee.MulInt(tokenIn.Amount).TruncateInt()
+	s.Require().Equal(amount1.Add(tokenIn.Amount), poolBalance.Amount)
+	errTolerance := osmomath.ErrTolerance{AdditiveTolerance: sdk.OneDec()}
+	s.Require().Equal(0, errTolerance.Compare(poolBalance.Amount.Sub(expectedFee), snapshot.Reserves.AmountOf(apptesting.USDC)))
+	s.Require().Equal(sdk.ZeroInt(), snapshot.TotalShares)
+	s.Require().Equal(clPool.GetLiquidity(), snapshot.Liquidity)
+	s.Require().Equal([]types.SpotPrice{
+		{BaseAsset: apptesting.ETH, QuoteAsset: apptesting.USDC, SpotPrice: expectedSpotPrice},
+	}, snapshot.SpotPrices)
+}
+
+func (s *TestSuite) TestSnapshotPool_NonExistentPool() {
+	_, err := s.snapshotKeeper.SnapshotPool(s.Ctx, 10)
+	s.Require().Error(err)
+}
+
+// TestEndBlock tests that pools that changed are snapshotted only at the end of every snapshot interval,
+// and that snapshots older than the retention period are pruned.
+func (s *TestSuite) TestEndBlock() {
+	poolId := s.PrepareBalancerPoolWithCoins(defaultTwoAssetCoins...)
+	untouchedPoolId := s.PrepareBalancerPoolWithCoins(defaultThreeAssetCoins...)
+	s.Require().Equal([]uint64{poolId, untouchedPoolId}, s.snapshotKeeper.GetChangedPools(s.Ctx))
+
+	// not a multiple of the snapshot interval, nothing happens.
+	s.snapshotKeeper.EndBlock(s.Ctx)
+	s.Require().Len(s.snapshotKeeper.GetChangedPools(s.Ctx), 2)
+	snapshots, err := s.snapshotKeeper.GetAllSnapshots(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(snapshots)
+
+	// both pools are snapshotted and no longer tracked.
+	s.Ctx = s.Ctx.WithBlockHeight(int64(basicParams.SnapshotInterval))
+	s.snapshotKeeper.EndBlock(s.Ctx)
+	s.Require().Empty(s.snapshotKeeper.GetChangedPools(s.Ctx))
+	snapshots, err = s.snapshotKeeper.GetAllSnapshots(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(snapshots, 2)
+
+	// only the pool that was swapped against is snapshotted in the next interval.
+	s.Ctx = s.Ctx.WithBlockHeight(int64(2 * basicParams.SnapshotInterval)).WithBlockTime(baseTime.Add(time.Hour))
+	s.RunBasicSwap(poolId)
+	s.snapshotKeeper.EndBlock(s.Ctx)
+	snapshots, err = s.snapshotKeeper.GetAllSnapshots(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(snapshots, 3)
+	s.Require().Equal(poolId, snapshots[2].PoolId)
+	s.Require().Equal(s.Ctx.BlockTime(), snapshots[2].Time)
+
+	// once all snapshots are older than the retention period, only the newest snapshot of every pool is kept,
+	// which is the only snapshot of the pool that did not change since.
+	s.Ctx = s.Ctx.WithBlockHeight(int64(3 * basicParams.SnapshotInterval)).WithBlockTime(baseTime.Add(time.Hour).Add(basicParams.RetentionPeriod).Add(time.Second))
+	s.snapshotKeeper.EndBlock(s.Ctx)
+	snapshots, err = s.snapshotKeeper.GetAllSnapshots(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(snapshots, 2)
+	s.Require().Equal(untouchedPoolId, snapshots[0].PoolId)
+	s.Require().Equal(baseTime, snapshots[0].Time)
+	s.Require().Equal(poolId, snapshots[1].PoolId)
+	s.Require().Equal(baseTime.Add(time.Hour), snapshots[1].Time)
+}
+
+// TestEndBlock_Disabled tests that nothing is tracked or snapshotted while the snapshot interval is zero.
+func (s *TestSuite) TestEndBlock_Disabled() {
+	s.snapshotKeeper.SetParams(s.Ctx, types.NewParams(0, basicParams.RetentionPeriod))
+
+	s.PrepareBalancerPoolWithCoins(defaultTwoAssetCoins...)
+	s.Require().Empty(s.snapshotKeeper.GetChangedPools(s.Ctx))
+
+	s.snapshotKeeper.EndBlock(s.Ctx)
+	snapshots, err := s.snapshotKeeper.GetAllSnapshots(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(snapshots)
+}
+
+// TestConcentratedLiquidityListener tests that creating, swapping against, LPing and adding to a position in a
+// concentrated liquidity pool tracks it until its next snapshot.
+func (s *TestSuite) TestConcentratedLiquidityListener() {
+	clPool := s.PrepareConcentratedPool()
+	poolId := clPool.GetId()
+	s.Require().Equal([]uint64{poolId}, s.snapshotKeeper.GetChangedPools(s.Ctx))
+
+	s.Ctx = s.Ctx.WithBlockHeight(int64(basicParams.SnapshotInterval))
+	s.snapshotKeeper.EndBlock(s.Ctx)
+	s.Require().Empty(s.snapshotKeeper.GetChangedPools(s.Ctx))
+
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(apptesting.ETH, 1_000_000), sdk.NewInt64Coin(apptesting.USDC, 5_000_000_000)))
+	minTick, maxTick := cl.GetMinAndMaxTicksFromExponentAtPriceOne(clPool.GetPrecisionFactorAtPriceOne())
+	positionId, _, _, liquidity, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, poolId, s.TestAccs[0],
+		sdk.NewInt(1_000_000), sdk.NewInt(5_000_000_000), sdk.ZeroInt(), sdk.ZeroInt(), minTick, maxTick, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{poolId}, s.snapshotKeeper.GetChangedPools(s.Ctx))
+
+	s.Ctx = s.Ctx.WithBlockHeight(int64(2 * basicParams.SnapshotInterval)).WithBlockTime(baseTime.Add(time.Minute))
+	s.snapshotKeeper.EndBlock(s.Ctx)
+	tokenIn := sdk.NewInt64Coin(apptesting.USDC, 1_000_000)
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0],
+		[]poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: apptesting.ETH}}, tokenIn, sdk.OneInt())
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{poolId}, s.snapshotKeeper.GetChangedPools(s.Ctx))
+
+	s.Ctx = s.Ctx.WithBlockHeight(int64(3 * basicParams.SnapshotInterval)).WithBlockTime(baseTime.Add(2 * time.Minute))
+	s.snapshotKeeper.EndBlock(s.Ctx)
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(apptesting.ETH, 1_000_000), sdk.NewInt64Coin(apptesting.USDC, 5_000_000_000)))
+	_, _, _, err = s.App.ConcentratedLiquidityKeeper.AddToPosition(s.Ctx, s.TestAccs[0], positionId,
+		sdk.NewInt(1_000_000), sdk.NewInt(5_000_000_000), sdk.ZeroInt(), sdk.ZeroInt(), s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{poolId}, s.snapshotKeeper.GetChangedPools(s.Ctx))
+
+	s.Ctx = s.Ctx.WithBlockHeight(int64(4 * basicParams.SnapshotInterval)).WithBlockTime(baseTime.Add(3 * time.Minute))
+	s.snapshotKeeper.EndBlock(s.Ctx)
+	_, _, err = s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, s.TestAccs[0], positionId, liquidity)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{poolId}, s.snapshotKeeper.GetChangedPools(s.Ctx))
+
+	snapshots, err := s.snapshotKeeper.GetAllSnapshots(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(snapshots, 4)
+}
+
+func (s *TestSuite) TestPruneSnapshotsBeforeTimeButNewest() {
+	snapshots := []types.PoolSnapshot{
+		newSnapshot(basePoolId, 1, baseTime.Add(-2*time.Second)),
+		newSnapshot(basePoolId, 2, baseTime.Add(-time.Second)),
+		newSnapshot(basePoolId+1, 2, baseTime.Add(-time.Second)),
+		newSnapshot(basePoolId, 3, baseTime),
+		newSnapshot(basePoolId, 4, baseTime.Add(time.Second)),
+	}
+	for _, snapshot := range snapshots {
+		s.snapshotKeeper.StoreSnapshot(s.Ctx, snapshot)
+	}
+
+	err := s.snapshotKeeper.PruneSnapshotsBeforeTimeButNewest(s.Ctx, baseTime)
+	s.Require().NoError(err)
+
+	// the newest snapshot of every pool before the last kept time, and the snapshots taken
+	// at or after it are kept, in both indexes.
+	remaining, err := s.snapshotKeeper.GetAllSnapshots(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(snapshots[1:], remaining)
+	poolOneSnapshots, _, err := s.snapshotKeeper.GetPoolSnapshots(s.Ctx, basePoolId, baseTime.Add(-time.Hour), baseTime.Add(time.Hour), 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PoolSnapshot{snapshots[1], snapshots[3], snapshots[4]}, poolOneSnapshots)
+
+	// the only snapshot of a pool that did not change since is never pruned.
+	poolTwoSnapshots, _, err := s.snapshotKeeper.GetPoolSnapshots(s.Ctx, basePoolId+1, baseTime, baseTime.Add(time.Hour), 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(snapshots[2:3], poolTwoSnapshots)
+}
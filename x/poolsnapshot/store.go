@@ -0,0 +1,181 @@
+package poolsnapshot
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v14/x/poolsnapshot/types"
+)
+
+// trackChangedPool places an entry into the store, to track that this pool changed since the last snapshot.
+// Unlike in the twap module, the store is not transient, as snapshots are only taken every few blocks.
+// Nothing is tracked while snapshots are disabled.
+func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
+	if k.GetParams(ctx).SnapshotInterval == 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FormatChangedPoolKey(poolId), sdk.Uint64ToBigEndian(poolId))
+}
+
+// getChangedPools returns all poolIDs that changed since the last snapshot, in increasing order.
+// This is to be guaranteed by trackChangedPool being called on every
+// pool-affecting action.
+func (k Keeper) getChangedPools(ctx sdk.Context) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	changedPoolIds, err := osmoutils.GatherValuesFromStorePrefix(store, []byte(types.ChangedPoolsPrefix), parsePoolIdFromBz)
+	if err != nil {
+		panic(err)
+	}
+	return changedPoolIds
+}
+
+// clearChangedPools deletes the tracking of all pools that changed since the last snapshot.
+func (k Keeper) clearChangedPools(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, poolId := range k.getChangedPools(ctx) {
+		store.Delete(types.FormatChangedPoolKey(poolId))
+	}
+}
+
+func parsePoolIdFromBz(bz []byte) (uint64, error) {
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+// storeSnapshot writes a snapshot to the store, in all needed indexing.
+func (k Keeper) storeSnapshot(ctx sdk.Context, snapshot types.PoolSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	key1 := types.FormatSnapshotTimeIndexKey(snapshot.Time, snapshot.PoolId)
+	key2 := types.FormatSnapshotPoolIndexKey(snapshot.PoolId, snapshot.Time)
+	osmoutils.MustSet(store, key1, &snapshot)
+	osmoutils.MustSet(store, key2, &snapshot)
+}
+
+// deleteSnapshot deletes a snapshot from all of its indexes.
+func (k Keeper) deleteSnapshot(ctx sdk.Context, snapshot types.PoolSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FormatSnapshotTimeIndexKey(snapshot.Time, snapshot.PoolId))
+	store.Delete(types.FormatSnapshotPoolIndexKey(snapshot.PoolId, snapshot.Time))
+}
+
+// pruneSnapshotsBeforeTimeButNewest deletes all snapshots taken before the given time, except for the newest
+// of them for every pool. Since pools are only snapshotted when they change, that snapshot holds the state of
+// its pool at the given time, and it may be the only snapshot left of a pool that did not change since.
+func (k Keeper) pruneSnapshotsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+
+	// Reverse iterator guarantees that we iterate through the newest per pool first.
+	iter := store.ReverseIterator([]byte(types.SnapshotTimeIndexPrefix), types.FormatSnapshotTimeIndexKey(lastKeptTime, 0))
+	defer iter.Close()
+
+	seenPools := map[uint64]struct{}{}
+	snapshotsToDelete := []types.PoolSnapshot{}
+	for ; iter.Valid(); iter.Next() {
+		snapshot, err := types.ParseSnapshotFromBz(iter.Value())
+		if err != nil {
+			return err
+		}
+
+		if _, hasSeenPool := seenPools[snapshot.PoolId]; !hasSeenPool {
+			seenPools[snapshot.PoolId] = struct{}{}
+			continue
+		}
+		snapshotsToDelete = append(snapshotsToDelete, snapshot)
+	}
+
+	for _, snapshot := range snapshotsToDelete {
+		k.deleteSnapshot(ctx, snapshot)
+	}
+	return nil
+}
+
+// getAllSnapshots returns all snapshots in increasing order of time.
+func (k Keeper) getAllSnapshots(ctx sdk.Context) ([]types.PoolSnapshot, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.SnapshotTimeIndexPrefix), types.ParseSnapshotFromBz)
+}
+
+// getSnapshotsInRange returns the pool's snapshot in effect at the start of the range, followed by its snapshots
+// taken within [startTime, endTime], at or above startHeight and at or below a non-zero endHeight, in increasing
+// order of time and paginated by the given page request.
+func (k Keeper) getSnapshotsInRange(
+	ctx sdk.Context,
+	poolId uint64,
+	startTime time.Time,
+	endTime time.Time,
+	startHeight int64,
+	endHeight int64,
+	pagination *query.PageRequest,
+) ([]types.PoolSnapshot, *query.PageResponse, error) {
+	firstSnapshotTime, err := k.getFirstSnapshotTimeInRange(ctx, poolId, startTime, startHeight)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	poolStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FormatSnapshotPoolIndexPrefix(poolId))
+	snapshots := []types.PoolSnapshot{}
+	pageRes, err := query.FilteredPaginate(poolStore, pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		snapshot, err := types.ParseSnapshotFromBz(value)
+		if err != nil {
+			return false, err
+		}
+
+		if snapshot.Time.Before(firstSnapshotTime) || snapshot.Time.After(endTime) || (endHeight != 0 && snapshot.Height > endHeight) {
+			return false, nil
+		}
+
+		if accumulate {
+			snapshots = append(snapshots, snapshot)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return snapshots, pageRes, nil
+}
+
+// getFirstSnapshotTimeInRange returns the time of the pool's snapshot in effect at the start of the range,
+// that is its latest snapshot taken at or before startTime, or at or below a non-zero startHeight.
+// If there is none, startTime is returned.
+func (k Keeper) getFirstSnapshotTimeInRange(ctx sdk.Context, poolId uint64, startTime time.Time, startHeight int64) (time.Time, error) {
+	store := ctx.KVStore(k.storeKey)
+	poolPrefix := types.FormatSnapshotPoolIndexPrefix(poolId)
+	afterStartTimeKey := types.FormatSnapshotPoolIndexTimeSuffix(poolId, startTime)
+
+	// The latest snapshot taken at or before startTime.
+	firstSnapshotTime := startTime
+	reverseIter := store.ReverseIterator(poolPrefix, afterStartTimeKey)
+	if reverseIter.Valid() {
+		snapshot, err := types.ParseSnapshotFromBz(reverseIter.Value())
+		if err != nil {
+			reverseIter.Close()
+			return time.Time{}, err
+		}
+		firstSnapshotTime = snapshot.Time
+	}
+	reverseIter.Close()
+
+	if startHeight == 0 {
+		return firstSnapshotTime, nil
+	}
+
+	// Snapshots taken after startTime but at or below startHeight are also at or before the start of the range.
+	// Snapshot heights increase with their time, so these directly follow the snapshots taken before startTime.
+	iter := store.Iterator(afterStartTimeKey, sdk.PrefixEndBytes(poolPrefix))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		snapshot, err := types.ParseSnapshotFromBz(iter.Value())
+		if err != nil {
+			return time.Time{}, err
+		}
+		if snapshot.Height > startHeight {
+			break
+		}
+		firstSnapshotTime = snapshot.Time
+	}
+	return firstSnapshotTime, nil
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+	time "time"
+)
+
+type StartTimeAfterEndTimeError struct {
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+func (e StartTimeAfterEndTimeError) Error() string {
+	return fmt.Sprintf("called GetPoolSnapshots with a start time that is after the end time."+
+		" (start time %s, end time %s)", e.StartTime, e.EndTime)
+}
+
+type InvalidHeightRangeError struct {
+	StartHeight int64
+	EndHeight   int64
+}
+
+func (e InvalidHeightRangeError) Error() string {
+	return fmt.Sprintf("called GetPoolSnapshots with a negative height or a start height that is above the end height."+
+		" (start height %d, end height %d)", e.StartHeight, e.EndHeight)
+}
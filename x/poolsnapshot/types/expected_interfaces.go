@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
+)
+
+// PoolManagerInterface is the functionality needed to look up a pool of any type by its ID.
+type PoolManagerInterface interface {
+	GetPoolModule(ctx sdk.Context, poolId uint64) (poolmanagertypes.SwapI, error)
+}
+
+// ConcentratedLiquidityKeeper is the functionality needed to compute the reserves of concentrated liquidity pools,
+// which do not track them.
+type ConcentratedLiquidityKeeper interface {
+	GetPoolReserves(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// NewGenesisState returns genesis state with the given parameters, snapshots and changed pools.
+func NewGenesisState(params Params, snapshots []PoolSnapshot, changedPoolIds []uint64) *GenesisState {
+	return &GenesisState{
+		Params:         params,
+		Snapshots:      snapshots,
+		ChangedPoolIds: changedPoolIds,
+	}
+}
+
+// DefaultGenesis returns the default poolsnapshot genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), []PoolSnapshot{}, []uint64{})
+}
+
+// Validate validates the genesis state. Returns nil on success, error otherwise.
+func (g *GenesisState) Validate() error {
+	if err := g.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, snapshot := range g.Snapshots {
+		if err := snapshot.validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, poolId := range g.ChangedPoolIds {
+		if poolId == 0 {
+			return errors.New("changed pool id cannot be 0")
+		}
+	}
+	return nil
+}
+
+// validate validates the pool snapshot, returns nil on success, error otherwise.
+func (s PoolSnapshot) validate() error {
+	if s.PoolId == 0 {
+		return errors.New("pool id cannot be 0")
+	}
+
+	if s.Height <= 0 {
+		return fmt.Errorf("pool snapshot height must be positive, was (%d)", s.Height)
+	}
+
+	if s.Time.IsZero() {
+		return errors.New("pool snapshot time cannot be 0")
+	}
+
+	if err := s.Reserves.Validate(); err != nil {
+		return fmt.Errorf("pool snapshot reserves are invalid: %w", err)
+	}
+
+	if s.TotalShares.IsNil() || s.TotalShares.IsNegative() {
+		return fmt.Errorf("pool snapshot total shares cannot be negative, was (%s)", s.TotalShares)
+	}
+
+	if s.Liquidity.IsNil() || s.Liquidity.IsNegative() {
+		return fmt.Errorf("pool snapshot liquidity cannot be negative, was (%s)", s.Liquidity)
+	}
+
+	for _, spotPrice := range s.SpotPrices {
+		if spotPrice.BaseAsset == "" || spotPrice.QuoteAsset == "" {
+			return fmt.Errorf("pool snapshot spot price assets cannot be empty, were (%s, %s)", spotPrice.BaseAsset, spotPrice.QuoteAsset)
+		}
+
+		if spotPrice.SpotPrice.IsNil() || spotPrice.SpotPrice.IsNegative() {
+			return fmt.Errorf("pool snapshot spot price cannot be negative, was (%s)", spotPrice.SpotPrice)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"testing"
+	time "time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	baseTime     = time.Unix(1257894000, 0).UTC()
+	baseSnapshot = PoolSnapshot{
+		PoolId:      1,
+		Height:      3,
+		Time:        baseTime,
+		Reserves:    sdk.NewCoins(sdk.NewInt64Coin("token/A", 10), sdk.NewInt64Coin("token/B", 20)),
+		TotalShares: sdk.NewInt(100),
+		Liquidity:   sdk.ZeroDec(),
+		SpotPrices:  []SpotPrice{{BaseAsset: "token/A", QuoteAsset: "token/B", SpotPrice: sdk.NewDec(2)}},
+	}
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	basicParams := NewParams(10, 48*time.Hour)
+
+	withSnapshot := func(f func(s *PoolSnapshot)) *GenesisState {
+		snapshot := baseSnapshot
+		snapshot.SpotPrices = []SpotPrice{baseSnapshot.SpotPrices[0]}
+		f(&snapshot)
+		return NewGenesisState(basicParams, []PoolSnapshot{snapshot}, []uint64{})
+	}
+
+	testCases := map[string]struct {
+		genesis *GenesisState
+
+		expectedErr bool
+	}{
+		"valid default genesis": {
+			genesis: DefaultGenesis(),
+		},
+		"valid custom genesis": {
+			genesis: NewGenesisState(basicParams, []PoolSnapshot{baseSnapshot}, []uint64{1, 2}),
+		},
+		"invalid params - zero retention period": {
+			genesis:     NewGenesisState(NewParams(10, 0), []PoolSnapshot{}, []uint64{}),
+			expectedErr: true,
+		},
+		"invalid changed pool id - zero": {
+			genesis:     NewGenesisState(basicParams, []PoolSnapshot{}, []uint64{0}),
+			expectedErr: true,
+		},
+		"invalid snapshot - zero pool id": {
+			genesis:     withSnapshot(func(s *PoolSnapshot) { s.PoolId = 0 }),
+			expectedErr: true,
+		},
+		"invalid snapshot - zero height": {
+			genesis:     withSnapshot(func(s *PoolSnapshot) { s.Height = 0 }),
+			expectedErr: true,
+		},
+		"invalid snapshot - zero time": {
+			genesis:     withSnapshot(func(s *PoolSnapshot) { s.Time = time.Time{} }),
+			expectedErr: true,
+		},
+		"invalid snapshot - unsorted reserves": {
+			genesis:     withSnapshot(func(s *PoolSnapshot) { s.Reserves = sdk.Coins{s.Reserves[1], s.Reserves[0]} }),
+			expectedErr: true,
+		},
+		"invalid snapshot - nil total shares": {
+			genesis:     withSnapshot(func(s *PoolSnapshot) { s.TotalShares = sdk.Int{} }),
+			expectedErr: true,
+		},
+		"invalid snapshot - negative liquidity": {
+			genesis:     withSnapshot(func(s *PoolSnapshot) { s.Liquidity = sdk.NewDec(-1) }),
+			expectedErr: true,
+		},
+		"invalid snapshot - empty spot price asset": {
+			genesis:     withSnapshot(func(s *PoolSnapshot) { s.SpotPrices[0].BaseAsset = "" }),
+			expectedErr: true,
+		},
+		"invalid snapshot - negative spot price": {
+			genesis:     withSnapshot(func(s *PoolSnapshot) { s.SpotPrices[0].SpotPrice = sdk.NewDec(-1) }),
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"errors"
+	fmt "fmt"
+	time "time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+)
+
+const (
+	ModuleName = "poolsnapshot"
+
+	StoreKey  = ModuleName
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+	KeySeparator = "|"
+)
+
+var (
+	changedPoolsNoSeparator      = "changed_pools"
+	snapshotTimeIndexNoSeparator = "snapshot_time_index"
+	snapshotPoolIndexNoSeparator = "snapshot_pool_index"
+
+	// format is pool id
+	// made for tracking the pools that changed since the last snapshot
+	ChangedPoolsPrefix = changedPoolsNoSeparator + KeySeparator
+	// format is time | pool id
+	// made for efficiently deleting snapshots by time in pruning
+	SnapshotTimeIndexPrefix = snapshotTimeIndexNoSeparator + KeySeparator
+	// format is pool id | time
+	// made for efficiently getting snapshots given a pool id and time bounds
+	SnapshotPoolIndexPrefix = snapshotPoolIndexNoSeparator + KeySeparator
+)
+
+func FormatChangedPoolKey(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s", ChangedPoolsPrefix, osmoutils.FormatFixedLengthU64(poolId)))
+}
+
+func FormatSnapshotTimeIndexKey(snapshotTime time.Time, poolId uint64) []byte {
+	timeS := osmoutils.FormatTimeString(snapshotTime)
+	return []byte(fmt.Sprintf("%s%s%s%s", SnapshotTimeIndexPrefix, timeS, KeySeparator, osmoutils.FormatFixedLengthU64(poolId)))
+}
+
+// FormatSnapshotPoolIndexPrefix returns the prefix of all pool index keys of the given pool.
+func FormatSnapshotPoolIndexPrefix(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%s", SnapshotPoolIndexPrefix, osmoutils.FormatFixedLengthU64(poolId), KeySeparator))
+}
+
+func FormatSnapshotPoolIndexKey(poolId uint64, snapshotTime time.Time) []byte {
+	timeS := osmoutils.FormatTimeString(snapshotTime)
+	return []byte(fmt.Sprintf("%s%s%s%s", SnapshotPoolIndexPrefix, osmoutils.FormatFixedLengthU64(poolId), KeySeparator, timeS))
+}
+
+func FormatSnapshotPoolIndexTimeSuffix(poolId uint64, snapshotTime time.Time) []byte {
+	timeS := osmoutils.FormatTimeString(snapshotTime)
+	// . acts as a suffix for lexicographical orderings
+	return []byte(fmt.Sprintf("%s%s%s%s.", SnapshotPoolIndexPrefix, osmoutils.FormatFixedLengthU64(poolId), KeySeparator, timeS))
+}
+
+func ParseSnapshotFromBz(bz []byte) (snapshot PoolSnapshot, err error) {
+	if len(bz) == 0 {
+		return PoolSnapshot{}, errors.New("pool snapshot not found")
+	}
+	err = proto.Unmarshal(bz, &snapshot)
+	return snapshot, err
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"strings"
+	"testing"
+	time "time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatChangedPoolKey(t *testing.T) {
+	tests := map[string]struct {
+		poolId uint64
+		want   string
+	}{
+		"standard":       {poolId: 1, want: "changed_pools|00000000000000000001"},
+		"standard2digit": {poolId: 10, want: "changed_pools|00000000000000000010"},
+		"maxPoolId":      {poolId: ^uint64(0), want: "changed_pools|18446744073709551615"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := FormatChangedPoolKey(tt.poolId)
+			require.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestFormatSnapshotKeys(t *testing.T) {
+	// go playground default time
+	// 2009-11-10 23:00:00 +0000 UTC m=+0.000000001
+	baseTime := time.Unix(1257894000, 0).UTC()
+	tests := map[string]struct {
+		poolId        uint64
+		time          time.Time
+		wantPoolIndex string
+		wantTimeIndex string
+	}{
+		"standard": {poolId: 1, time: baseTime, wantTimeIndex: "snapshot_time_index|2009-11-10T23:00:00.000000000|00000000000000000001", wantPoolIndex: "snapshot_pool_index|00000000000000000001|2009-11-10T23:00:00.000000000"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotTimeKey := FormatSnapshotTimeIndexKey(tt.time, tt.poolId)
+			gotPoolKey := FormatSnapshotPoolIndexKey(tt.poolId, tt.time)
+			require.Equal(t, tt.wantTimeIndex, string(gotTimeKey))
+			require.Equal(t, tt.wantPoolIndex, string(gotPoolKey))
+
+			poolIndexSuffix := FormatSnapshotPoolIndexTimeSuffix(tt.poolId, tt.time)
+			require.True(t, strings.HasPrefix(string(poolIndexSuffix), string(gotPoolKey)))
+		})
+	}
+}
+
+func TestParseSnapshotFromBz(t *testing.T) {
+	baseTime := time.Unix(1257894000, 0).UTC()
+	snapshot := PoolSnapshot{
+		PoolId:      123,
+		Height:      1,
+		Time:        baseTime,
+		Reserves:    sdk.NewCoins(sdk.NewInt64Coin("tokenA", 10), sdk.NewInt64Coin("tokenB", 20)),
+		TotalShares: sdk.NewInt(100),
+		Liquidity:   sdk.ZeroDec(),
+		SpotPrices:  []SpotPrice{{BaseAsset: "tokenA", QuoteAsset: "tokenB", SpotPrice: sdk.NewDec(2)}},
+	}
+
+	bz, err := proto.Marshal(&snapshot)
+	require.NoError(t, err)
+	got, err := ParseSnapshotFromBz(bz)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, got)
+
+	_, err = ParseSnapshotFromBz([]byte{})
+	require.Error(t, err)
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeySnapshotInterval = []byte("SnapshotInterval")
+	KeyRetentionPeriod  = []byte("RetentionPeriod")
+
+	_ paramtypes.ParamSet = &Params{}
+)
+
+const (
+	// snapshots are disabled by default.
+	defaultSnapshotInterval = uint64(0)
+	defaultRetentionPeriod  = 7 * 24 * time.Hour
+)
+
+// ParamTable for poolsnapshot module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(snapshotInterval uint64, retentionPeriod time.Duration) Params {
+	return Params{
+		SnapshotInterval: snapshotInterval,
+		RetentionPeriod:  retentionPeriod,
+	}
+}
+
+// default poolsnapshot module parameters.
+func DefaultParams() Params {
+	return Params{
+		SnapshotInterval: defaultSnapshotInterval,
+		RetentionPeriod:  defaultRetentionPeriod,
+	}
+}
+
+// validate params.
+func (p Params) Validate() error {
+	if err := validateSnapshotInterval(p.SnapshotInterval); err != nil {
+		return err
+	}
+
+	if err := validatePeriod(p.RetentionPeriod); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySnapshotInterval, &p.SnapshotInterval, validateSnapshotInterval),
+		paramtypes.NewParamSetPair(KeyRetentionPeriod, &p.RetentionPeriod, validatePeriod),
+	}
+}
+
+func validateSnapshotInterval(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("time must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,916 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/poolsnapshot/v1beta1/snapshot.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A PoolSnapshot is the state of a pool at the end of a block.
+type PoolSnapshot struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// height is the height of the block the snapshot was taken at.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the block the snapshot was taken at.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// reserves are the tokens held by the pool's liquidity providers.
+	Reserves github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=reserves,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserves" yaml:"reserves"`
+	// total_shares is the amount of LP shares of a CFMM pool, zero for
+	// concentrated liquidity pools.
+	TotalShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_shares" yaml:"total_shares"`
+	// liquidity is the active liquidity of a concentrated liquidity pool, zero
+	// for CFMM pools.
+	Liquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+	// spot_prices holds the spot price of every pair of the pool's assets.
+	SpotPrices []SpotPrice `protobuf:"bytes,7,rep,name=spot_prices,json=spotPrices,proto3" json:"spot_prices" yaml:"spot_prices"`
+}
+
+func (m *PoolSnapshot) Reset()         { *m = PoolSnapshot{} }
+func (m *PoolSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PoolSnapshot) ProtoMessage()    {}
+func (*PoolSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f04550cd94ea7361, []int{0}
+}
+func (m *PoolSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolSnapshot.Merge(m, src)
+}
+func (m *PoolSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolSnapshot proto.InternalMessageInfo
+
+func (m *PoolSnapshot) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PoolSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *PoolSnapshot) GetReserves() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Reserves
+	}
+	return nil
+}
+
+func (m *PoolSnapshot) GetSpotPrices() []SpotPrice {
+	if m != nil {
+		return m.SpotPrices
+	}
+	return nil
+}
+
+// SpotPrice is the price of the base asset in terms of the quote asset.
+// The base asset sorts before the quote asset. The price is zero if it could
+// not be calculated.
+type SpotPrice struct {
+	BaseAsset  string                                 `protobuf:"bytes,1,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty" yaml:"base_asset"`
+	QuoteAsset string                                 `protobuf:"bytes,2,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty" yaml:"quote_asset"`
+	SpotPrice  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=spot_price,json=spotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price" yaml:"spot_price"`
+}
+
+func (m *SpotPrice) Reset()         { *m = SpotPrice{} }
+func (m *SpotPrice) String() string { return proto.CompactTextString(m) }
+func (*SpotPrice) ProtoMessage()    {}
+func (*SpotPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f04550cd94ea7361, []int{1}
+}
+func (m *SpotPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpotPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpotPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpotPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotPrice.Merge(m, src)
+}
+func (m *SpotPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpotPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpotPrice proto.InternalMessageInfo
+
+func (m *SpotPrice) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
+	}
+	return ""
+}
+
+func (m *SpotPrice) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*PoolSnapshot)(nil), "osmosis.poolsnapshot.v1beta1.PoolSnapshot")
+	proto.RegisterType((*SpotPrice)(nil), "osmosis.poolsnapshot.v1beta1.SpotPrice")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/poolsnapshot/v1beta1/snapshot.proto", fileDescriptor_f04550cd94ea7361)
+}
+
+var fileDescriptor_f04550cd94ea7361 = []byte{
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0xb5, 0x74, 0xc4, 0x99, 0x04, 0x0b, 0xb0, 0x85, 0x0a, 0x25, 0x95, 0x0f, 0x10,
+	0x09, 0xcd, 0x56, 0xc7, 0x24, 0x10, 0x37, 0x52, 0x10, 0xda, 0x6d, 0x72, 0x39, 0x71, 0x29, 0x49,
+	0x6b, 0x12, 0x8b, 0xb4, 0xce, 0x6a, 0xb7, 0xa2, 0x3c, 0xc5, 0x9e, 0x83, 0x07, 0xe0, 0x19, 0x76,
+	0xdc, 0x11, 0x71, 0xc8, 0x50, 0xfb, 0x06, 0x7d, 0x02, 0x64, 0x27, 0x69, 0x0b, 0x07, 0xb4, 0x9d,
+	0xe2, 0x7f, 0xfc, 0xfd, 0xff, 0xbf, 0x7c, 0x5f, 0x6c, 0xf0, 0x9c, 0x8b, 0x11, 0x17, 0x4c, 0xe0,
+	0x8c, 0xf3, 0x54, 0x8c, 0xc3, 0x4c, 0x24, 0x5c, 0xe2, 0x59, 0x27, 0xa2, 0x32, 0xec, 0xe0, 0xea,
+	0x05, 0xca, 0x26, 0x5c, 0x72, 0xfb, 0x49, 0x59, 0x8c, 0xb6, 0x8b, 0x51, 0x59, 0xdc, 0x7a, 0x18,
+	0xf3, 0x98, 0xeb, 0x42, 0xac, 0x56, 0x85, 0xa7, 0xe5, 0x0e, 0xb4, 0x09, 0x47, 0xa1, 0xa0, 0xeb,
+	0xdc, 0x01, 0x67, 0xe3, 0x72, 0xdf, 0x8b, 0x39, 0x8f, 0x53, 0x8a, 0xb5, 0x8a, 0xa6, 0x9f, 0xb1,
+	0x64, 0x23, 0x2a, 0x64, 0x38, 0xca, 0x8a, 0x02, 0xf8, 0xa3, 0x01, 0xf6, 0xce, 0x38, 0x4f, 0x7b,
+	0x25, 0xcf, 0x3e, 0x04, 0xbb, 0x8a, 0xdf, 0x67, 0x43, 0xc7, 0x68, 0x1b, 0x7e, 0x83, 0x34, 0x95,
+	0x3c, 0x1d, 0xda, 0x07, 0xa0, 0x99, 0x50, 0x16, 0x27, 0xd2, 0xd9, 0x69, 0x1b, 0x7e, 0x9d, 0x94,
+	0xca, 0x7e, 0x0f, 0x1a, 0x2a, 0xd4, 0xa9, 0xb7, 0x0d, 0xdf, 0x3a, 0x6e, 0xa1, 0x82, 0x88, 0x2a,
+	0x22, 0xfa, 0x50, 0x11, 0x83, 0xc3, 0xcb, 0xdc, 0xab, 0xad, 0x72, 0xcf, 0x9a, 0x87, 0xa3, 0xf4,
+	0x35, 0x54, 0x2e, 0x78, 0x71, 0xed, 0x19, 0x44, 0x07, 0xd8, 0xdf, 0xc0, 0xdd, 0x09, 0x15, 0x74,
+	0x32, 0xa3, 0xc2, 0x69, 0xb4, 0xeb, 0xbe, 0x75, 0xfc, 0x18, 0x15, 0xed, 0x21, 0xd5, 0x5e, 0x35,
+	0x09, 0xd4, 0xe5, 0x6c, 0x1c, 0x74, 0xcb, 0xac, 0x7b, 0x45, 0x56, 0x65, 0x84, 0xdf, 0xaf, 0x3d,
+	0x3f, 0x66, 0x32, 0x99, 0x46, 0x68, 0xc0, 0x47, 0xb8, 0x1c, 0x4f, 0xf1, 0x38, 0x12, 0xc3, 0x2f,
+	0x58, 0xce, 0x33, 0x2a, 0x74, 0x86, 0x20, 0x6b, 0x9e, 0x9d, 0x80, 0x3d, 0xc9, 0x65, 0x98, 0xf6,
+	0x45, 0x12, 0x4e, 0xa8, 0x70, 0xee, 0xb4, 0x0d, 0xdf, 0x0c, 0xde, 0x29, 0xc8, 0xaf, 0xdc, 0x7b,
+	0x7a, 0x83, 0xc4, 0xd3, 0xb1, 0x5c, 0xe5, 0xde, 0x83, 0xb2, 0xb5, 0xad, 0x2c, 0x48, 0x2c, 0x2d,
+	0x7b, 0x5a, 0xd9, 0x9f, 0x80, 0x99, 0xb2, 0xf3, 0x29, 0x1b, 0x32, 0x39, 0x77, 0x9a, 0x1a, 0x13,
+	0xdc, 0x02, 0xf3, 0x96, 0x0e, 0x56, 0xb9, 0x77, 0xbf, 0xc0, 0xac, 0x83, 0x20, 0xd9, 0x84, 0xda,
+	0x43, 0x60, 0x89, 0x8c, 0xcb, 0x7e, 0x36, 0x61, 0x03, 0x2a, 0x9c, 0x5d, 0x3d, 0xca, 0x67, 0xe8,
+	0x7f, 0xa7, 0x0b, 0xf5, 0x32, 0x2e, 0xcf, 0x54, 0x7d, 0xd0, 0x2a, 0x07, 0x6b, 0x17, 0x88, 0xad,
+	0x24, 0x48, 0x80, 0xa8, 0xca, 0x04, 0xcc, 0x0d, 0x60, 0xae, 0x5d, 0xf6, 0x09, 0x00, 0xea, 0x1f,
+	0xf5, 0x43, 0x21, 0xa8, 0xd4, 0x07, 0xc7, 0x0c, 0x1e, 0xad, 0x72, 0x6f, 0xbf, 0x48, 0xd9, 0xec,
+	0x41, 0x62, 0x2a, 0xf1, 0x46, 0xad, 0xed, 0x97, 0xc0, 0x3a, 0x9f, 0x72, 0x59, 0xd9, 0x76, 0xb4,
+	0xed, 0x60, 0x03, 0xdf, 0xda, 0x84, 0x04, 0x68, 0x55, 0x18, 0x23, 0x00, 0x36, 0x1f, 0xa6, 0x4f,
+	0x9e, 0x19, 0x74, 0x6f, 0x3d, 0xc5, 0xfd, 0x7f, 0x5b, 0x84, 0xc4, 0x5c, 0x77, 0x18, 0x90, 0xcb,
+	0x85, 0x6b, 0x5c, 0x2d, 0x5c, 0xe3, 0xf7, 0xc2, 0x35, 0x2e, 0x96, 0x6e, 0xed, 0x6a, 0xe9, 0xd6,
+	0x7e, 0x2e, 0xdd, 0xda, 0xc7, 0x57, 0x5b, 0x84, 0x72, 0xaa, 0x47, 0x69, 0x18, 0x89, 0x4a, 0xe0,
+	0x59, 0xe7, 0x04, 0x7f, 0xfd, 0xfb, 0xce, 0x6b, 0x6e, 0xd4, 0xd4, 0xb7, 0xe2, 0xc5, 0x9f, 0x01,
+	0x00, 0x9d, 0x3c, 0x88, 0xc0, 0x18, 0x04, 0x00, 0x00,
+}
+
+func (m *PoolSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpotPrices) > 0 {
+		for iNdEx := len(m.SpotPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpotPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSnapshot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalShares.Size()
+		i -= size
+		if _, err := m.TotalShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSnapshot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Reserves) > 0 {
+		for iNdEx := len(m.Reserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSnapshot(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpotPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpotPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpotPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSnapshot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSnapshot(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovSnapshot(uint64(m.PoolId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSnapshot(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSnapshot(uint64(l))
+	if len(m.Reserves) > 0 {
+		for _, e := range m.Reserves {
+			l = e.Size()
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovSnapshot(uint64(l))
+	l = m.Liquidity.Size()
+	n += 1 + l + sovSnapshot(uint64(l))
+	if len(m.SpotPrices) > 0 {
+		for _, e := range m.SpotPrices {
+			l = e.Size()
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpotPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovSnapshot(uint64(l))
+	return n
+}
+
+func sovSnapshot(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSnapshot(x uint64) (n int) {
+	return sovSnapshot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserves = append(m.Reserves, types1.Coin{})
+			if err := m.Reserves[len(m.Reserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotPrices = append(m.SpotPrices, SpotPrice{})
+			if err := m.SpotPrices[len(m.SpotPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpotPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpotPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpotPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSnapshot(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSnapshot
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSnapshot
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSnapshot
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSnapshot        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSnapshot          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSnapshot = fmt.Errorf("proto: unexpected end of group")
+)
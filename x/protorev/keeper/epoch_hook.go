@@ -94,31 +94,52 @@ func (k Keeper) GetHighestLiquidityPools(ctx sdk.Context, baseDenomPools map[str
 
 	// Iterate through all pools and find valid matches
 	for _, pool := range pools {
-		coins := pool.GetTotalPoolLiquidity(ctx)
-
-		// Pool must be active and the number of coins must be 2
-		if pool.IsActive(ctx) && len(coins) == 2 {
-			tokenA := coins[0]
-			tokenB := coins[1]
+		// Pool must be active
+		if pool.IsActive(ctx) {
+			k.updateHighestLiquidityPools(pool.GetId(), pool.GetTotalPoolLiquidity(ctx), baseDenomPools)
+		}
+	}
 
-			newPool := LiquidityPoolStruct{
-				PoolId:    pool.GetId(),
-				Liquidity: tokenA.Amount.Mul(tokenB.Amount),
-			}
+	// Concentrated liquidity pools are compared by the reserves backing their active liquidity
+	for _, poolId := range k.GetAllConcentratedPoolIds(ctx) {
+		if err := k.IsValidPool(ctx, poolId); err != nil {
+			continue
+		}
 
-			// Update happens both ways to ensure the pools that contain multiple base denoms are properly updated
-			if highestLiquidityPools, ok := baseDenomPools[tokenA.Denom]; ok {
-				k.updateHighestLiquidityPool(tokenB.Denom, highestLiquidityPools, newPool)
-			}
-			if highestLiquidityPools, ok := baseDenomPools[tokenB.Denom]; ok {
-				k.updateHighestLiquidityPool(tokenA.Denom, highestLiquidityPools, newPool)
-			}
+		coins, err := k.poolmanagerKeeper.GetActiveLiquidityReserves(ctx, poolId)
+		if err != nil {
+			return err
 		}
+		k.updateHighestLiquidityPools(poolId, coins, baseDenomPools)
 	}
 
 	return nil
 }
 
+// updateHighestLiquidityPools updates the highest liquidity pools of the base denoms the pool trades against with the pool
+func (k Keeper) updateHighestLiquidityPools(poolId uint64, coins sdk.Coins, baseDenomPools map[string]map[string]LiquidityPoolStruct) {
+	// The number of coins must be 2
+	if len(coins) != 2 {
+		return
+	}
+
+	tokenA := coins[0]
+	tokenB := coins[1]
+
+	newPool := LiquidityPoolStruct{
+		PoolId:    poolId,
+		Liquidity: tokenA.Amount.Mul(tokenB.Amount),
+	}
+
+	// Update happens both ways to ensure the pools that contain multiple base denoms are properly updated
+	if highestLiquidityPools, ok := baseDenomPools[tokenA.Denom]; ok {
+		k.updateHighestLiquidityPool(tokenB.Denom, highestLiquidityPools, newPool)
+	}
+	if highestLiquidityPools, ok := baseDenomPools[tokenB.Denom]; ok {
+		k.updateHighestLiquidityPool(tokenA.Denom, highestLiquidityPools, newPool)
+	}
+}
+
 // updateHighestLiquidityPool updates the pool with the highest liquidity for the base denom
 func (k Keeper) updateHighestLiquidityPool(denom string, pools map[string]LiquidityPoolStruct, newPool LiquidityPoolStruct) {
 	if currPool, ok := pools[denom]; !ok {
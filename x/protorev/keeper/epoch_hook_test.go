@@ -4,6 +4,11 @@ import (
 	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v14/app/apptesting"
+	cl "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v14/x/protorev/types"
 )
 
@@ -106,3 +111,38 @@ func contains(baseDenoms []*types.BaseDenom, denomToMatch string) bool {
 	}
 	return false
 }
+
+// TestConcentratedLiquidityPools tests that the concentrated liquidity pools are tracked when they are created and
+// are considered when updating the highest liquidity pools, by the reserves backing their active liquidity. The pools
+// can then be routed through at the concentrated liquidity pool weight.
+func (suite *KeeperTestSuite) TestConcentratedLiquidityPools() {
+	clPool := suite.PrepareCustomConcentratedPool(suite.TestAccs[0], types.OsmosisDenomination, "clcoin", apptesting.DefaultTickSpacing, apptesting.DefaultExponentAtPriceOne, sdk.ZeroDec())
+	suite.Require().Contains(suite.App.ProtoRevKeeper.GetAllConcentratedPoolIds(suite.Ctx), clPool.GetId())
+
+	// The pool has no liquidity yet, so it is not paired with the base denom
+	err := suite.App.ProtoRevKeeper.UpdatePools(suite.Ctx)
+	suite.Require().NoError(err)
+	_, err = suite.App.ProtoRevKeeper.GetPoolForDenomPair(suite.Ctx, types.OsmosisDenomination, "clcoin")
+	suite.Require().Error(err)
+
+	// Once there is active liquidity, the pool is the highest liquidity pool of the pair
+	owner := suite.TestAccs[0]
+	suite.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(5000)), sdk.NewCoin("clcoin", sdk.NewInt(5000))))
+	minTick, maxTick := cl.GetMinAndMaxTicksFromExponentAtPriceOne(clPool.GetPrecisionFactorAtPriceOne())
+	_, _, _, _, err = suite.App.ConcentratedLiquidityKeeper.CreatePosition(suite.Ctx, clPool.GetId(), owner, sdk.NewInt(5000), sdk.NewInt(5000), sdk.ZeroInt(), sdk.ZeroInt(), minTick, maxTick, suite.Ctx.BlockTime())
+	suite.Require().NoError(err)
+
+	err = suite.App.ProtoRevKeeper.UpdatePools(suite.Ctx)
+	suite.Require().NoError(err)
+	poolId, err := suite.App.ProtoRevKeeper.GetPoolForDenomPair(suite.Ctx, types.OsmosisDenomination, "clcoin")
+	suite.Require().NoError(err)
+	suite.Require().Equal(clPool.GetId(), poolId)
+
+	// The pool is routed through at the concentrated liquidity pool weight
+	suite.App.ProtoRevKeeper.SetPoolWeights(suite.Ctx, types.PoolWeights{StableWeight: 3, BalancerWeight: 2, ConcentratedWeight: 1})
+	remainingPoolPoints := uint64(10)
+	route := poolmanagertypes.SwapAmountInRoutes{{PoolId: clPool.GetId(), TokenOutDenom: ""}, {PoolId: 1, TokenOutDenom: ""}}
+	err = suite.App.ProtoRevKeeper.CheckAndUpdateRouteState(suite.Ctx, route, &remainingPoolPoints)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(7), remainingPoolPoints)
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cltypes "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+)
+
+type concentratedLiquidityListener struct {
+	k Keeper
+}
+
+var _ cltypes.ConcentratedLiquidityListener = concentratedLiquidityListener{}
+
+// ConcentratedLiquidityListener returns the listener that tracks the created
+// concentrated liquidity pools so that they can be used in cyclic arbitrage routes.
+func (k Keeper) ConcentratedLiquidityListener() cltypes.ConcentratedLiquidityListener {
+	return concentratedLiquidityListener{k}
+}
+
+// AfterConcentratedPoolCreated stores the id of the pool so that it is considered when updating the highest liquidity pools.
+func (l concentratedLiquidityListener) AfterConcentratedPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	l.k.SetConcentratedPoolId(ctx, poolId)
+}
+
+// AfterConcentratedPoolSwap is a noop.
+func (l concentratedLiquidityListener) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+}
+
+// AfterPositionCreated is a noop.
+func (l concentratedLiquidityListener) AfterPositionCreated(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidity sdk.Dec) {
+}
+
+// AfterPositionModified is a noop.
+func (l concentratedLiquidityListener) AfterPositionModified(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidityDelta sdk.Dec) {
+}
+
+// AfterPositionWithdrawn is a noop.
+func (l concentratedLiquidityListener) AfterPositionWithdrawn(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensOut sdk.Coins, liquidity sdk.Dec) {
+}
@@ -158,6 +158,27 @@ func (k Keeper) DeleteAllEntriesForKeyPrefix(ctx sdk.Context, keyPrefix []byte)
 	}
 }
 
+// GetAllConcentratedPoolIds returns the ids of all of the concentrated liquidity pools
+func (k Keeper) GetAllConcentratedPoolIds(ctx sdk.Context) []uint64 {
+	poolIds := make([]uint64, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixConcentratedPools)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iterator.Value()))
+	}
+
+	return poolIds
+}
+
+// SetConcentratedPoolId stores the id of a concentrated liquidity pool so that it is considered when updating the highest liquidity pools
+func (k Keeper) SetConcentratedPoolId(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyPrefixConcentratedPool(poolId), sdk.Uint64ToBigEndian(poolId))
+}
+
 // ---------------------- Config Stores  ---------------------- //
 
 // GetDaysSinceModuleGenesis returns the number of days since the module was initialized
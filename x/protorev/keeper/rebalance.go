@@ -57,27 +57,16 @@ func (k Keeper) ConvertProfits(ctx sdk.Context, inputCoin sdk.Coin, profit sdk.I
 		return profit, err
 	}
 
-	// Get the pool
-	conversionPool, err := k.gammKeeper.GetPoolAndPoke(ctx, conversionPoolID)
-	if err != nil {
-		return profit, err
-	}
-
 	// Calculate the amount of uosmo that we can get if we swapped the
 	// profited amount of the orignal asset through the highest uosmo liquidity pool
-	conversionTokenOut, err := conversionPool.CalcOutAmtGivenIn(
-		ctx,
-		sdk.NewCoins(sdk.NewCoin(inputCoin.Denom, profit)),
-		types.OsmosisDenomination,
-		conversionPool.GetSwapFee(ctx),
-	)
-
+	conversionRoute := []poolmanagertypes.SwapAmountInRoute{{PoolId: conversionPoolID, TokenOutDenom: types.OsmosisDenomination}}
+	conversionAmountOut, err := k.poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(ctx, conversionRoute, sdk.NewCoin(inputCoin.Denom, profit))
 	if err != nil {
 		return profit, err
 	}
 
 	// return the profit denominated in uosmo
-	return conversionTokenOut.Amount, nil
+	return conversionAmountOut, nil
 }
 
 // EstimateMultihopProfit estimates the profit for a given route
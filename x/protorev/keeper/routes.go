@@ -145,16 +145,12 @@ func (k Keeper) CheckAndUpdateRouteState(ctx sdk.Context, route poolmanagertypes
 	poolIds := route.PoolIds()
 	for index := 0; totalWeight <= *remainingPoolPoints && index < len(poolIds); index++ {
 		// Ensure that all of the pools in the route exist and are active
-		if err := k.IsValidPool(ctx, poolIds[index]); err != nil {
-			return err
-		}
-
-		poolType, err := k.gammKeeper.GetPoolType(ctx, poolIds[index])
+		pool, err := k.getActivePool(ctx, poolIds[index])
 		if err != nil {
 			return err
 		}
 
-		switch poolType {
+		switch pool.GetType() {
 		case poolmanagertypes.Balancer:
 			totalWeight += poolWeights.BalancerWeight
 		case poolmanagertypes.Stableswap:
@@ -180,14 +176,25 @@ func (k Keeper) CheckAndUpdateRouteState(ctx sdk.Context, route poolmanagertypes
 
 // IsValidPool checks if the pool is active and exists
 func (k Keeper) IsValidPool(ctx sdk.Context, poolId uint64) error {
-	pool, err := k.gammKeeper.GetPoolAndPoke(ctx, poolId)
+	_, err := k.getActivePool(ctx, poolId)
+	return err
+}
+
+// getActivePool returns the pool with the given id from the pool manager, so that pools of any
+// type can be routed through. Returns an error if the pool does not exist or is not active.
+func (k Keeper) getActivePool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error) {
+	swapModule, err := k.poolmanagerKeeper.GetPoolModule(ctx, poolId)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	pool, err := swapModule.GetPool(ctx, poolId)
+	if err != nil {
+		return nil, err
 	}
 	if !pool.IsActive(ctx) {
-		return fmt.Errorf("pool %d is not active", poolId)
+		return nil, fmt.Errorf("pool %d is not active", poolId)
 	}
-	return nil
+	return pool, nil
 }
 
 // RemainingPoolPointsForTx calculates the number of pool points that can be consumed in the current transaction.
@@ -25,10 +25,7 @@ type BankKeeper interface {
 // GAMMKeeper defines the Gamm contract that must be fulfilled when
 // creating a x/protorev keeper.
 type GAMMKeeper interface {
-	GetPoolAndPoke(ctx sdk.Context, poolId uint64) (gammtypes.CFMMPoolI, error)
 	GetPoolsAndPoke(ctx sdk.Context) (res []gammtypes.CFMMPoolI, err error)
-	GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
-	GetPoolType(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolType, error)
 }
 
 // PoolManagerKeeper defines the PoolManager contract that must be fulfilled when
@@ -46,6 +43,9 @@ type PoolManagerKeeper interface {
 		routes []poolmanagertypes.SwapAmountInRoute,
 		tokenIn sdk.Coin,
 	) (tokenOutAmount sdk.Int, err error)
+
+	GetPoolModule(ctx sdk.Context, poolId uint64) (poolmanagertypes.SwapI, error)
+	GetActiveLiquidityReserves(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
 }
 
 // EpochKeeper defines the Epoch contract that must be fulfilled when
@@ -37,6 +37,7 @@ const (
 	prefixPoolPointCountForBlock
 	prefixLatestBlockHeight
 	prefixPoolWeights
+	prefixConcentratedPools
 )
 
 var (
@@ -50,6 +51,9 @@ var (
 	// KeyPrefixBaseDenoms is the prefix that is used to store the base denoms that are used to create cyclic arbitrage routes
 	KeyPrefixBaseDenoms = []byte{prefixBaseDenoms}
 
+	// KeyPrefixConcentratedPools is the prefix that is used to store the ids of the concentrated liquidity pools
+	KeyPrefixConcentratedPools = []byte{prefixConcentratedPools}
+
 	// -------------- Keys for statistics stores -------------- //
 	// KeyPrefixNumberOfTrades is the prefix for the store that keeps track of the number of trades executed
 	KeyPrefixNumberOfTrades = []byte{prefixNumberOfTrades}
@@ -105,6 +109,11 @@ func GetKeyPrefixBaseDenom(priority uint64) []byte {
 	return append(KeyPrefixBaseDenoms, sdk.Uint64ToBigEndian(priority)...)
 }
 
+// Returns the key needed to fetch a concentrated liquidity pool id
+func GetKeyPrefixConcentratedPool(poolId uint64) []byte {
+	return append(KeyPrefixConcentratedPools, sdk.Uint64ToBigEndian(poolId)...)
+}
+
 // Returns the key needed to fetch the tokenPair routes for a given pair of tokens
 func GetKeyPrefixRouteForTokenPair(tokenA, tokenB string) []byte {
 	return append(KeyPrefixTokenPairRoutes, []byte(tokenA+"|"+tokenB)...)
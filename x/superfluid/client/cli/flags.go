@@ -1,8 +1,31 @@
 package cli
 
+import (
+	flag "github.com/spf13/pflag"
+
+	gammcli "github.com/osmosis-labs/osmosis/v14/x/gamm/client/cli"
+)
+
 // Proposal flags.
 const (
 	FlagSuperfluidAssets = "superfluid-assets"
 	FlagPoolIds          = "pool-ids"
 	FlagOverwrite        = "is-overwrite"
 )
+
+// Zap out flags.
+const (
+	FlagShares     = "shares"
+	FlagPositionId = "position-id"
+	FlagLockId     = "lock-id"
+)
+
+func FlagSetZapOut() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagShares, "", "The gamm shares to exit")
+	fs.Uint64(FlagPositionId, 0, "The id of the concentrated liquidity position to exit")
+	fs.Uint64(FlagLockId, 0, "The id of the matured lock of gamm shares to exit")
+	fs.StringArray(gammcli.FlagTokenInRoutes, []string{}, "The routes swapping an exited token into the token out, formatted as "+
+		"[token-in-denom]=[pool-id]:[token-out-denom],... (specify multiple tokens with: --token-in-routes=uion=1:uosmo --token-in-routes=uatom=2:uosmo)")
+	return fs
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	gammcli "github.com/osmosis-labs/osmosis/v14/x/gamm/client/cli"
 	"github.com/osmosis-labs/osmosis/v14/x/superfluid/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -30,6 +31,8 @@ func GetTxCmd() *cobra.Command {
 		// NewSuperfluidRedelegateCmd(),
 		NewCmdLockAndSuperfluidDelegate(),
 		NewCmdUnPoolWhitelistedPool(),
+		NewCmdUnlockAndMigrateSharesToFullRangeConcentratedPosition(),
+		NewCmdZapOut(),
 	)
 
 	return cmd
@@ -295,6 +298,44 @@ func NewCmdUnPoolWhitelistedPool() *cobra.Command {
 	})
 }
 
+func NewCmdUnlockAndMigrateSharesToFullRangeConcentratedPosition() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition](&osmocli.TxCliDesc{
+		Use:   "unlock-and-migrate-to-cl [lock_id] [shares_to_migrate] [flags]",
+		Short: "break a lock of gamm shares and migrate them to a full range concentrated liquidity position",
+		Long: `Break a lock of gamm shares, superfluid undelegating it first if needed, and migrate the given shares to a full range position in the linked concentrated liquidity pool.
+The position is frozen until the lock would have finished unlocking. A zero amount of shares migrates all of the lock's shares.`,
+		Example: "unlock-and-migrate-to-cl 1 1000000gamm/pool/1 --from val --chain-id osmosis-1",
+	})
+}
+
+func NewCmdZapOut() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgZapOut](&osmocli.TxCliDesc{
+		Use:   "zap-out [token-out-denom] [token-out-min-amount]",
+		Short: "exit gamm shares, a concentrated liquidity position or a matured lock into a single denom",
+		Long: `Exit the gamm shares, the concentrated liquidity position or the matured lock of gamm shares given by exactly one of the --shares, --position-id and --lock-id flags.
+Every exited token other than the token out is then swapped into the token out along its --token-in-routes.`,
+		Example: "zap-out uosmo 1000000 --lock-id 1 --token-in-routes=uatom=1:uosmo --from val --chain-id osmosis-1",
+		CustomFlagOverrides: map[string]string{
+			"positionid": FlagPositionId,
+			"lockid":     FlagLockId,
+		},
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Shares":        osmocli.FlagOnlyParser(parseShares),
+			"TokenInRoutes": osmocli.FlagOnlyParser(gammcli.ParseTokenInRoutes),
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetZapOut()}},
+	})
+}
+
+// parseShares parses the shares flag, which is left empty when exiting a position or a lock.
+func parseShares(fs *flag.FlagSet) (sdk.Coin, error) {
+	sharesStr, err := fs.GetString(FlagShares)
+	if err != nil || sharesStr == "" {
+		return sdk.Coin{}, err
+	}
+	return sdk.ParseCoinNormalized(sharesStr)
+}
+
 // NewCmdUpdateUnpoolWhitelistProposal defines the command to create a new update unpool whitelist proposal command.
 func NewCmdUpdateUnpoolWhitelistProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -149,3 +149,43 @@ func newUnpoolIdEvent(sender string, lpShareDenom string, allExitedLockIDsSerial
 		sdk.NewAttribute(types.AttributeNewLockIds, string(allExitedLockIDsSerialized)),
 	)
 }
+
+func EmitUnlockAndMigrateSharesEvent(ctx sdk.Context, sender string, lockId uint64, sharesToMigrate sdk.Coin, positionId uint64, remainingLockId uint64) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newUnlockAndMigrateSharesEvent(sender, lockId, sharesToMigrate, positionId, remainingLockId),
+	})
+}
+
+func newUnlockAndMigrateSharesEvent(sender string, lockId uint64, sharesToMigrate sdk.Coin, positionId uint64, remainingLockId uint64) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtUnlockAndMigrateShares,
+		sdk.NewAttribute(sdk.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeLockId, osmoutils.Uint64ToString(lockId)),
+		sdk.NewAttribute(types.AttributeAmount, sharesToMigrate.String()),
+		sdk.NewAttribute(types.AttributePositionId, osmoutils.Uint64ToString(positionId)),
+		sdk.NewAttribute(types.AttributeRemainingLockId, osmoutils.Uint64ToString(remainingLockId)),
+	)
+}
+
+func EmitZapOutEvent(ctx sdk.Context, sender string, tokensExited sdk.Coins, tokenOut sdk.Coin) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newZapOutEvent(sender, tokensExited, tokenOut),
+	})
+}
+
+func newZapOutEvent(sender string, tokensExited sdk.Coins, tokenOut sdk.Coin) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtZapOut,
+		sdk.NewAttribute(sdk.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeTokensExited, tokensExited.String()),
+		sdk.NewAttribute(types.AttributeTokenOut, tokenOut.String()),
+	)
+}
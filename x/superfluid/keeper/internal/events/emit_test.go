@@ -376,3 +376,103 @@ func (suite *SuperfluidEventsTestSuite) TestEmitUnpoolIdEvent() {
 		})
 	}
 }
+
+func (suite *SuperfluidEventsTestSuite) TestEmitUnlockAndMigrateSharesEvent() {
+	testcases := map[string]struct {
+		ctx             sdk.Context
+		sender          string
+		lockId          uint64
+		sharesToMigrate sdk.Coin
+		positionId      uint64
+		remainingLockId uint64
+	}{
+		"basic valid": {
+			ctx:             suite.CreateTestContext(),
+			sender:          sdk.AccAddress([]byte(addressString)).String(),
+			lockId:          1,
+			sharesToMigrate: sdk.NewInt64Coin("gamm/pool/1", 100),
+			positionId:      2,
+			remainingLockId: 3,
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtUnlockAndMigrateShares,
+					sdk.NewAttribute(sdk.AttributeKeySender, tc.sender),
+					sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", tc.lockId)),
+					sdk.NewAttribute(types.AttributeAmount, tc.sharesToMigrate.String()),
+					sdk.NewAttribute(types.AttributePositionId, fmt.Sprintf("%d", tc.positionId)),
+					sdk.NewAttribute(types.AttributeRemainingLockId, fmt.Sprintf("%d", tc.remainingLockId)),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitUnlockAndMigrateSharesEvent(tc.ctx, tc.sender, tc.lockId, tc.sharesToMigrate, tc.positionId, tc.remainingLockId)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
+func (suite *SuperfluidEventsTestSuite) TestEmitZapOutEvent() {
+	testcases := map[string]struct {
+		ctx          sdk.Context
+		sender       string
+		tokensExited sdk.Coins
+		tokenOut     sdk.Coin
+	}{
+		"basic valid": {
+			ctx:          suite.CreateTestContext(),
+			sender:       sdk.AccAddress([]byte(addressString)).String(),
+			tokensExited: sdk.NewCoins(sdk.NewInt64Coin("foo", 100), sdk.NewInt64Coin("uosmo", 200)),
+			tokenOut:     sdk.NewInt64Coin("uosmo", 290),
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtZapOut,
+					sdk.NewAttribute(sdk.AttributeKeySender, tc.sender),
+					sdk.NewAttribute(types.AttributeTokensExited, tc.tokensExited.String()),
+					sdk.NewAttribute(types.AttributeTokenOut, tc.tokenOut.String()),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitZapOutEvent(tc.ctx, tc.sender, tc.tokensExited, tc.tokenOut)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
@@ -18,14 +18,16 @@ type Keeper struct {
 	storeKey   sdk.StoreKey
 	paramSpace paramtypes.Subspace
 
-	ak authkeeper.AccountKeeper
-	bk types.BankKeeper
-	sk types.StakingKeeper
-	ck types.CommunityPoolKeeper
-	ek types.EpochKeeper
-	lk types.LockupKeeper
-	gk types.GammKeeper
-	ik types.IncentivesKeeper
+	ak  authkeeper.AccountKeeper
+	bk  types.BankKeeper
+	sk  types.StakingKeeper
+	ck  types.CommunityPoolKeeper
+	ek  types.EpochKeeper
+	lk  types.LockupKeeper
+	gk  types.GammKeeper
+	ik  types.IncentivesKeeper
+	clk types.ConcentratedKeeper
+	pmk types.PoolManagerKeeper
 
 	lms types.LockupMsgServer
 }
@@ -33,7 +35,7 @@ type Keeper struct {
 var _ govtypes.StakingKeeper = (*Keeper)(nil)
 
 // NewKeeper returns an instance of Keeper.
-func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, ak authkeeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.CommunityPoolKeeper, ek types.EpochKeeper, lk types.LockupKeeper, gk types.GammKeeper, ik types.IncentivesKeeper, lms types.LockupMsgServer) *Keeper {
+func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, ak authkeeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.CommunityPoolKeeper, ek types.EpochKeeper, lk types.LockupKeeper, gk types.GammKeeper, ik types.IncentivesKeeper, clk types.ConcentratedKeeper, pmk types.PoolManagerKeeper, lms types.LockupMsgServer) *Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -50,6 +52,8 @@ func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, ak authkee
 		lk:         lk,
 		gk:         gk,
 		ik:         ik,
+		clk:        clk,
+		pmk:        pmk,
 
 		lms: lms,
 	}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v14/x/superfluid/types"
+)
+
+// UnlockAndMigrateSharesToFullRangeConcentratedPosition breaks the given lock of gamm shares without waiting out its
+// unbonding period and migrates sharesToMigrate of them to a full range position in the concentrated liquidity pool
+// linked to the shares' balancer pool. A zero sharesToMigrate amount migrates all of the lock's shares.
+// The position is frozen until the lock would have finished unlocking. If the lock was superfluid delegated, the position
+// stays exposed to slashing of the validator for the unbonding period that superfluid undelegating would have started.
+// Superfluid unbonding locks cannot be migrated until they finish unbonding.
+// Returns the id of the new lock holding the shares that were not migrated, or zero if all shares were migrated.
+func (k Keeper) UnlockAndMigrateSharesToFullRangeConcentratedPosition(ctx sdk.Context, sender sdk.AccAddress, lockId uint64, sharesToMigrate sdk.Coin) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, frozenUntil time.Time, remainingLockId uint64, err error) {
+	// Steps for migrating the shares of a (sender, lockID) pair.
+	// 1) Consistency check that lockID corresponds to sender, and that it holds enough of the shares to migrate.
+	// 2) Get remaining duration on the lock, and the validator it is superfluid delegated to, if any.
+	//    Reject the lock if it is superfluid unbonding.
+	// 3) If superfluid delegated, superfluid undelegate
+	// 4) Break underlying lock.
+	// 5) Migrate the shares to a full range position that is frozen for the remaining duration of the lock.
+	//    If superfluid delegated, keep the position exposed to slashing for the unbonding period.
+	// 6) Lock the shares that were not migrated the same way they were before.
+
+	// 1) Consistency check that lockID corresponds to sender, and that it holds enough of the shares to migrate.
+	lock, err := k.validateLockForMigration(ctx, sender, lockId, sharesToMigrate)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, err
+	}
+	sharesInLock := lock.Coins[0]
+	if sharesToMigrate.IsZero() {
+		sharesToMigrate = sharesInLock
+	}
+
+	// 2) Get remaining duration on the lock. Handle if the lock was unbonding.
+	lockRemainingDuration := k.getExistingLockRemainingDuration(ctx, lock)
+	intermediaryAcc, wasSuperfluidDelegated := k.GetIntermediaryAccountFromLockId(ctx, lockId)
+	// A lock that is no longer superfluid delegated but still has synthetic lockups is superfluid unbonding.
+	// Breaking it would delete the unbonding synthetic lockup and with it the lock's exposure to slashing.
+	if !wasSuperfluidDelegated && len(k.lk.GetAllSyntheticLockupsByLockup(ctx, lockId)) > 0 {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, types.ErrMigrateSuperfluidUnbondingLock
+	}
+
+	// 3) If superfluid delegated, superfluid undelegate
+	err = k.unbondSuperfluidIfExists(ctx, sender, lockId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, err
+	}
+
+	// 4) finish unlocking directly for locked locks
+	// this also unlocks locks that were in the unlocking queue
+	err = k.lk.ForceUnlock(ctx, *lock)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, err
+	}
+
+	// 5) Migrate the shares. The position is frozen until the lock would have been unlocked.
+	frozenUntil = ctx.BlockTime().Add(lockRemainingDuration)
+	positionId, amount0, amount1, liquidity, _, _, err = k.gk.MigrateSharesToFullRangeConcentratedPosition(ctx, sender, sharesToMigrate, frozenUntil)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, err
+	}
+	if wasSuperfluidDelegated {
+		// Superfluid undelegating started an unbonding synthetic lockup that ForceUnlock deleted.
+		// Its unbonding period is carried over to the position, which is frozen for at least as long.
+		exposedUntil := ctx.BlockTime().Add(k.sk.UnbondingTime(ctx))
+		k.setMigratedPositionSlashingExposure(ctx, intermediaryAcc.GetAccAddress(), positionId, exposedUntil)
+	}
+
+	// 6) Lock the remaining shares with the lock's duration, keeping its end time if it was unlocking
+	// and its delegation if it was superfluid delegated.
+	remainingShares := sharesInLock.Sub(sharesToMigrate)
+	if remainingShares.IsPositive() {
+		remainingLock, err := k.lk.CreateLock(ctx, sender, sdk.NewCoins(remainingShares), lock.Duration)
+		if err != nil {
+			return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, err
+		}
+		remainingLockId = remainingLock.ID
+
+		if lock.IsUnlocking() {
+			err = k.lk.BeginForceUnlockWithEndTime(ctx, remainingLockId, lock.EndTime)
+		} else if wasSuperfluidDelegated {
+			err = k.SuperfluidDelegate(ctx, sender.String(), remainingLockId, intermediaryAcc.ValAddr)
+		}
+		if err != nil {
+			return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, err
+		}
+	}
+
+	return positionId, amount0, amount1, liquidity, frozenUntil, remainingLockId, nil
+}
+
+// setMigratedPositionSlashingExposure records that the position with the given id, migrated from a lock superfluid
+// delegated through the given intermediary account, is slashed along with the account's validator until exposedUntil.
+func (k Keeper) setMigratedPositionSlashingExposure(ctx sdk.Context, intermediaryAcc sdk.AccAddress, positionId uint64, exposedUntil time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, migratedPositionSlashingExposurePrefix(intermediaryAcc))
+	prefixStore.Set(sdk.Uint64ToBigEndian(positionId), sdk.FormatTimeBytes(exposedUntil))
+}
+
+// migratedPositionSlashingExposurePrefix returns the store prefix of the slashing exposures of the positions
+// migrated from locks superfluid delegated through the given intermediary account.
+func migratedPositionSlashingExposurePrefix(intermediaryAcc sdk.AccAddress) []byte {
+	return append(types.KeyPrefixMigratedPositionSlashingExposure, address.MustLengthPrefix(intermediaryAcc)...)
+}
+
+// validateLockForMigration checks that the lock belongs to the sender and holds at least sharesToMigrate.
+func (k Keeper) validateLockForMigration(ctx sdk.Context, sender sdk.AccAddress, lockId uint64, sharesToMigrate sdk.Coin) (*lockuptypes.PeriodLock, error) {
+	lock, err := k.lk.GetLockByID(ctx, lockId)
+	if err != nil {
+		return lock, err
+	}
+
+	if lock.Owner != sender.String() {
+		return lock, lockuptypes.ErrNotLockOwner
+	}
+
+	if lock.Coins.Len() != 1 {
+		return lock, types.ErrMultipleCoinsLockupNotSupported
+	}
+
+	sharesInLock := lock.Coins[0]
+	if sharesToMigrate.Denom != sharesInLock.Denom {
+		return lock, types.ErrSharesToMigrateDenomMismatch
+	}
+	if sharesToMigrate.Amount.GT(sharesInLock.Amount) {
+		return lock, types.ErrSharesToMigrateExceedLock
+	}
+
+	return lock, nil
+}
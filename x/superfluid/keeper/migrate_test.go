@@ -0,0 +1,340 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/v14/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v14/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v14/x/superfluid/keeper"
+	"github.com/osmosis-labs/osmosis/v14/x/superfluid/types"
+)
+
+// we test migrating in the following circumstances:
+// 1. migrating all shares of a lock that is not superfluid delegated, not unlocking
+// 2. migrating all shares of a lock that is not superfluid delegated, unlocking
+// 3. migrating all shares of a lock that is superfluid delegated, not unlocking
+// 4. migrating some shares of the above locks, re-locking the rest
+// and that superfluid undelegating locks are rejected.
+func (suite *KeeperTestSuite) TestUnlockAndMigrateSharesToFullRangeConcentratedPosition() {
+	testCases := map[string]struct {
+		superfluidDelegated    bool
+		superfluidUndelegating bool
+		unlocking              bool
+		// fraction of the lock's shares to migrate, all of them if zero.
+		percentSharesToMigrate sdk.Dec
+		overwriteSender        bool
+		overwriteSharesDenom   string
+		overwriteSharesAmount  sdk.Int
+		expectedErr            error
+	}{
+		"lock that is not superfluid delegated, not unlocking": {},
+		"lock that is not superfluid delegated, unlocking": {
+			unlocking: true,
+		},
+		"lock that is superfluid delegated, not unlocking": {
+			superfluidDelegated: true,
+		},
+		"lock that is not superfluid delegated, not unlocking, partial migration": {
+			percentSharesToMigrate: sdk.MustNewDecFromStr("0.4"),
+		},
+		"lock that is not superfluid delegated, unlocking, partial migration": {
+			unlocking:              true,
+			percentSharesToMigrate: sdk.MustNewDecFromStr("0.4"),
+		},
+		"lock that is superfluid delegated, not unlocking, partial migration": {
+			superfluidDelegated:    true,
+			percentSharesToMigrate: sdk.MustNewDecFromStr("0.4"),
+		},
+		"error: lock that is superfluid undelegating, not unlocking": {
+			superfluidDelegated:    true,
+			superfluidUndelegating: true,
+			expectedErr:            types.ErrMigrateSuperfluidUnbondingLock,
+		},
+		"error: lock that is superfluid undelegating, unlocking": {
+			superfluidDelegated:    true,
+			superfluidUndelegating: true,
+			unlocking:              true,
+			expectedErr:            types.ErrMigrateSuperfluidUnbondingLock,
+		},
+		"error: sender is not the lock owner": {
+			overwriteSender: true,
+			expectedErr:     lockuptypes.ErrNotLockOwner,
+		},
+		"error: shares to migrate have a different denom than the lock": {
+			overwriteSharesDenom: "foo",
+			expectedErr:          types.ErrSharesToMigrateDenomMismatch,
+		},
+		"error: shares to migrate exceed the lock": {
+			superfluidDelegated:   true,
+			overwriteSharesAmount: gammtypes.OneShare.MulRaw(51),
+			expectedErr:           types.ErrSharesToMigrateExceedLock,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			ctx := suite.Ctx
+			bankKeeper := suite.App.BankKeeper
+			gammKeeper := suite.App.GAMMKeeper
+			superfluidKeeper := suite.App.SuperfluidKeeper
+			lockupKeeper := suite.App.LockupKeeper
+			stakingKeeper := suite.App.StakingKeeper
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+
+			// generate one delegator Addr, one gamm pool
+			delAddrs := CreateRandomAccounts(2)
+			poolCreateAcc := delAddrs[0]
+			poolJoinAcc := delAddrs[1]
+			for _, acc := range delAddrs {
+				suite.FundAcc(acc, defaultAcctFunds)
+			}
+
+			// set up validator
+			valAddr := suite.SetupValidator(stakingtypes.BondStatus(stakingtypes.Bonded))
+
+			// create pool of "stake" and "foo", and a concentrated pool of the same assets linked to it
+			msg := balancer.NewMsgCreateBalancerPool(poolCreateAcc, balancer.PoolParams{
+				SwapFee: sdk.NewDecWithPrec(1, 2),
+				ExitFee: sdk.NewDec(0),
+			}, defaultPoolAssets, defaultFutureGovernor)
+			balancerPoolId, err := poolmanagerKeeper.CreatePool(ctx, msg)
+			suite.Require().NoError(err)
+
+			clPool := suite.PrepareCustomConcentratedPool(poolCreateAcc, defaultFooAsset.Token.Denom, sdk.DefaultBondDenom, 1, sdk.NewInt(-4), sdk.ZeroDec())
+			err = gammKeeper.ReplaceMigrationRecords(ctx, []gammtypes.BalancerToConcentratedPoolLink{{BalancerPoolId: balancerPoolId, ClPoolId: clPool.GetId()}})
+			suite.Require().NoError(err)
+
+			// join pool
+			_, _, err = gammKeeper.JoinPoolNoSwap(ctx, poolJoinAcc, balancerPoolId, gammtypes.OneShare.MulRaw(50), sdk.Coins{})
+			suite.Require().NoError(err)
+			poolDenom := gammtypes.GetPoolShareDenom(balancerPoolId)
+			poolShareOut := bankKeeper.GetBalance(ctx, poolJoinAcc, poolDenom)
+
+			// register a LP token as a superfluid asset
+			err = superfluidKeeper.AddNewSuperfluidAsset(ctx, types.SuperfluidAsset{
+				Denom:     poolDenom,
+				AssetType: types.SuperfluidAssetTypeLPShare,
+			})
+			suite.Require().NoError(err)
+
+			// create lock
+			unbondingDuration := stakingKeeper.GetParams(ctx).UnbondingTime
+			lockID := suite.LockTokens(poolJoinAcc, sdk.NewCoins(poolShareOut), unbondingDuration)
+
+			if tc.superfluidDelegated {
+				err = superfluidKeeper.SuperfluidDelegate(ctx, poolJoinAcc.String(), lockID, valAddr.String())
+				suite.Require().NoError(err)
+			}
+			if tc.superfluidUndelegating {
+				err = superfluidKeeper.SuperfluidUndelegate(ctx, poolJoinAcc.String(), lockID)
+				suite.Require().NoError(err)
+			}
+			if tc.unlocking {
+				if tc.superfluidUndelegating {
+					err = superfluidKeeper.SuperfluidUnbondLock(ctx, lockID, poolJoinAcc.String())
+				} else {
+					_, err = lockupKeeper.BeginForceUnlock(ctx, lockID, sdk.NewCoins())
+				}
+				suite.Require().NoError(err)
+
+				// move time forward to check that only the remaining unbonding duration is frozen
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour * 24))
+			}
+
+			lock, err := lockupKeeper.GetLockByID(ctx, lockID)
+			suite.Require().NoError(err)
+
+			sender := poolJoinAcc
+			if tc.overwriteSender {
+				sender = poolCreateAcc
+			}
+			sharesToMigrate := sdk.NewCoin(poolDenom, sdk.ZeroInt())
+			if !tc.percentSharesToMigrate.IsNil() {
+				sharesToMigrate.Amount = tc.percentSharesToMigrate.MulInt(poolShareOut.Amount).TruncateInt()
+			}
+			if tc.overwriteSharesDenom != "" {
+				sharesToMigrate.Denom = tc.overwriteSharesDenom
+			}
+			if !tc.overwriteSharesAmount.IsNil() {
+				sharesToMigrate.Amount = tc.overwriteSharesAmount
+			}
+
+			unlockedSharesBefore := bankKeeper.GetBalance(ctx, poolJoinAcc, poolDenom)
+
+			// System under test.
+			positionId, amount0, amount1, liquidity, frozenUntil, remainingLockId, err := superfluidKeeper.UnlockAndMigrateSharesToFullRangeConcentratedPosition(ctx, sender, lockID, sharesToMigrate)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+
+				// the lock is left untouched.
+				lockAfterErr, err := lockupKeeper.GetLockByID(ctx, lockID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(lock, lockAfterErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the position is frozen until the lock would have finished unlocking.
+			expectedFrozenUntil := ctx.BlockTime().Add(unbondingDuration)
+			if tc.unlocking {
+				expectedFrozenUntil = lock.EndTime
+			}
+			suite.Require().Equal(expectedFrozenUntil, frozenUntil)
+
+			position, err := suite.App.ConcentratedLiquidityKeeper.GetPosition(ctx, positionId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(poolJoinAcc.String(), position.Address)
+			suite.Require().Equal(clPool.GetId(), position.PoolId)
+			suite.Require().Equal(frozenUntil, position.FrozenUntil)
+			suite.Require().Equal(liquidity, position.Liquidity)
+			suite.Require().True(amount0.IsPositive())
+			suite.Require().True(amount1.IsPositive())
+
+			// the old lock and its superfluid state are deleted.
+			_, err = lockupKeeper.GetLockByID(ctx, lockID)
+			suite.Require().Error(err)
+			addr := superfluidKeeper.GetLockIdIntermediaryAccountConnection(ctx, lockID)
+			suite.Require().Equal("", addr.String())
+			suite.Require().Empty(lockupKeeper.GetAllSyntheticLockupsByLockup(ctx, lockID))
+
+			// none of the lock's shares are left unlocked.
+			suite.Require().Equal(unlockedSharesBefore, bankKeeper.GetBalance(ctx, poolJoinAcc, poolDenom))
+
+			if tc.percentSharesToMigrate.IsNil() {
+				suite.Require().Zero(remainingLockId)
+				return
+			}
+
+			// the remaining shares are locked the same way they were before.
+			remainingLock, err := lockupKeeper.GetLockByID(ctx, remainingLockId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(poolJoinAcc.String(), remainingLock.Owner)
+			suite.Require().Equal(sdk.NewCoins(poolShareOut.Sub(sharesToMigrate)), remainingLock.Coins)
+			suite.Require().Equal(lock.Duration, remainingLock.Duration)
+			suite.Require().Equal(lock.EndTime, remainingLock.EndTime)
+
+			if tc.superfluidDelegated {
+				intermediaryAcc, found := superfluidKeeper.GetIntermediaryAccountFromLockId(ctx, remainingLockId)
+				suite.Require().True(found)
+				suite.Require().Equal(valAddr.String(), intermediaryAcc.ValAddr)
+				_, err = lockupKeeper.GetSyntheticLockup(ctx, remainingLockId, keeper.StakingSyntheticDenom(poolDenom, valAddr.String()))
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+// we test slashing the validator of a migrated lock in the following circumstances:
+// 1. the lock was superfluid delegated and the slash happens within the unbonding period after migrating
+// 2. the lock was superfluid delegated and the slash happens after the unbonding period
+// 3. the lock was not superfluid delegated
+func (suite *KeeperTestSuite) TestSlashMigratedPositions() {
+	testCases := map[string]struct {
+		superfluidDelegated bool
+		timeAfterMigration  time.Duration
+		expectSlashed       bool
+	}{
+		"superfluid delegated lock, slashed within the unbonding period": {
+			superfluidDelegated: true,
+			timeAfterMigration:  time.Hour * 24,
+			expectSlashed:       true,
+		},
+		"superfluid delegated lock, slashed after the unbonding period": {
+			superfluidDelegated: true,
+			timeAfterMigration:  stakingtypes.DefaultUnbondingTime,
+		},
+		"lock that is not superfluid delegated": {
+			timeAfterMigration: time.Hour * 24,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			ctx := suite.Ctx
+			gammKeeper := suite.App.GAMMKeeper
+			superfluidKeeper := suite.App.SuperfluidKeeper
+			clKeeper := suite.App.ConcentratedLiquidityKeeper
+			distrKeeper := suite.App.DistrKeeper
+
+			delAddrs := CreateRandomAccounts(2)
+			poolCreateAcc := delAddrs[0]
+			poolJoinAcc := delAddrs[1]
+			for _, acc := range delAddrs {
+				suite.FundAcc(acc, defaultAcctFunds)
+			}
+			valAddr := suite.SetupValidator(stakingtypes.BondStatus(stakingtypes.Bonded))
+
+			// create a balancer pool and a concentrated pool of the same assets linked to it
+			msg := balancer.NewMsgCreateBalancerPool(poolCreateAcc, balancer.PoolParams{
+				SwapFee: sdk.NewDecWithPrec(1, 2),
+				ExitFee: sdk.NewDec(0),
+			}, defaultPoolAssets, defaultFutureGovernor)
+			balancerPoolId, err := suite.App.PoolManagerKeeper.CreatePool(ctx, msg)
+			suite.Require().NoError(err)
+			clPool := suite.PrepareCustomConcentratedPool(poolCreateAcc, defaultFooAsset.Token.Denom, sdk.DefaultBondDenom, 1, sdk.NewInt(-4), sdk.ZeroDec())
+			err = gammKeeper.ReplaceMigrationRecords(ctx, []gammtypes.BalancerToConcentratedPoolLink{{BalancerPoolId: balancerPoolId, ClPoolId: clPool.GetId()}})
+			suite.Require().NoError(err)
+
+			_, _, err = gammKeeper.JoinPoolNoSwap(ctx, poolJoinAcc, balancerPoolId, gammtypes.OneShare.MulRaw(50), sdk.Coins{})
+			suite.Require().NoError(err)
+			poolDenom := gammtypes.GetPoolShareDenom(balancerPoolId)
+			poolShareOut := suite.App.BankKeeper.GetBalance(ctx, poolJoinAcc, poolDenom)
+			err = superfluidKeeper.AddNewSuperfluidAsset(ctx, types.SuperfluidAsset{
+				Denom:     poolDenom,
+				AssetType: types.SuperfluidAssetTypeLPShare,
+			})
+			suite.Require().NoError(err)
+
+			lockID := suite.LockTokens(poolJoinAcc, sdk.NewCoins(poolShareOut), stakingtypes.DefaultUnbondingTime)
+			if tc.superfluidDelegated {
+				err = superfluidKeeper.SuperfluidDelegate(ctx, poolJoinAcc.String(), lockID, valAddr.String())
+				suite.Require().NoError(err)
+			}
+
+			positionId, _, _, liquidity, _, _, err := superfluidKeeper.UnlockAndMigrateSharesToFullRangeConcentratedPosition(ctx, poolJoinAcc, lockID, sdk.NewCoin(poolDenom, sdk.ZeroInt()))
+			suite.Require().NoError(err)
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tc.timeAfterMigration))
+			communityPoolBefore := distrKeeper.GetFeePoolCommunityCoins(ctx)
+			ownerBalanceBefore := suite.App.BankKeeper.GetAllBalances(ctx, poolJoinAcc)
+
+			// System under test.
+			slashFactor := sdk.NewDecWithPrec(5, 2)
+			superfluidKeeper.SlashLockupsForValidatorSlash(ctx, valAddr, ctx.BlockHeight(), slashFactor)
+
+			position, err := clKeeper.GetPosition(ctx, positionId)
+			suite.Require().NoError(err)
+			communityPoolAfter := distrKeeper.GetFeePoolCommunityCoins(ctx)
+
+			// the owner does not receive any of the slashed tokens.
+			suite.Require().Equal(ownerBalanceBefore, suite.App.BankKeeper.GetAllBalances(ctx, poolJoinAcc))
+
+			if !tc.expectSlashed {
+				suite.Require().Equal(liquidity, position.Liquidity)
+				suite.Require().Equal(communityPoolBefore, communityPoolAfter)
+				return
+			}
+
+			// slashFactor of the position's liquidity is withdrawn to the community pool.
+			suite.Require().Equal(liquidity.Sub(liquidity.Mul(slashFactor)), position.Liquidity)
+			slashed := communityPoolAfter.Sub(communityPoolBefore)
+			suite.Require().True(slashed.AmountOf(defaultFooAsset.Token.Denom).IsPositive())
+			suite.Require().True(slashed.AmountOf(sdk.DefaultBondDenom).IsPositive())
+
+			// the position is exposed to slashing only until the unbonding period ends.
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(stakingtypes.DefaultUnbondingTime))
+			superfluidKeeper.SlashLockupsForValidatorSlash(ctx, valAddr, ctx.BlockHeight(), slashFactor)
+			positionAfterExposure, err := clKeeper.GetPosition(ctx, positionId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(position.Liquidity, positionAfterExposure.Liquidity)
+		})
+	}
+}
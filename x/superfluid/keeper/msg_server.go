@@ -163,3 +163,53 @@ func (server msgServer) UnPoolWhitelistedPool(goCtx context.Context, msg *types.
 
 	return &types.MsgUnPoolWhitelistedPoolResponse{ExitedLockIds: allExitedLockIDs}, nil
 }
+
+// UnlockAndMigrateSharesToFullRangeConcentratedPosition breaks a lock of gamm shares, superfluid undelegating it first
+// if needed, and migrates the given amount of its shares to a full range position in the linked concentrated liquidity
+// pool. The position is frozen until the lock would have finished unlocking.
+// Shares that are not migrated are locked again with the lock's duration. If the lock was unlocking,
+// the new lock unlocks at the same time, and if it was superfluid delegated, the new lock is delegated to the same validator.
+func (server msgServer) UnlockAndMigrateSharesToFullRangeConcentratedPosition(goCtx context.Context, msg *types.MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) (*types.MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	positionId, amount0, amount1, liquidity, frozenUntil, remainingLockId, err := server.keeper.UnlockAndMigrateSharesToFullRangeConcentratedPosition(ctx, sender, msg.LockId, msg.SharesToMigrate)
+	if err != nil {
+		return nil, err
+	}
+
+	events.EmitUnlockAndMigrateSharesEvent(ctx, msg.Sender, msg.LockId, msg.SharesToMigrate, positionId, remainingLockId)
+
+	return &types.MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse{
+		PositionId:       positionId,
+		Amount0:          amount0,
+		Amount1:          amount1,
+		LiquidityCreated: liquidity,
+		FrozenUntil:      frozenUntil,
+		RemainingLockId:  remainingLockId,
+	}, nil
+}
+
+// ZapOut exits gamm shares, a concentrated liquidity position or a matured lock of gamm shares,
+// and swaps every exited token into a single denom along the given routes.
+func (server msgServer) ZapOut(goCtx context.Context, msg *types.MsgZapOut) (*types.MsgZapOutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensExited, tokenOutAmount, err := server.keeper.ZapOut(ctx, sender, msg.Shares, msg.PositionId, msg.LockId, msg.TokenOutDenom, msg.TokenInRoutes, msg.TokenOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	events.EmitZapOutEvent(ctx, msg.Sender, tokensExited, sdk.NewCoin(msg.TokenOutDenom, tokenOutAmount))
+
+	return &types.MsgZapOutResponse{TokenOutAmount: tokenOutAmount}, nil
+}
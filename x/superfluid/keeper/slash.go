@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	cltypes "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -53,6 +57,72 @@ func (k Keeper) SlashLockupsForValidatorSlash(ctx sdk.Context, valAddr sdk.ValAd
 			// but this seems to be an acceptable trade-off based upon choices taken in the SDK.
 			k.slashSynthLock(ctx, synthLock, slashFactor)
 		}
+
+		// positions migrated from superfluid delegated locks are slashed for the unbonding period of the lock.
+		k.slashMigratedPositions(ctx, acc.GetAccAddress(), slashFactor)
+	}
+}
+
+// slashMigratedPositions slashes slashFactor of the liquidity of every concentrated liquidity position migrated from
+// a lock superfluid delegated through the given intermediary account that is still exposed to slashing.
+// The withdrawn tokens are moved to the community pool. Expired exposures and those of deleted positions are removed.
+func (k Keeper) slashMigratedPositions(ctx sdk.Context, intermediaryAcc sdk.AccAddress, slashFactor sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, migratedPositionSlashingExposurePrefix(intermediaryAcc))
+
+	exposedPositionIds := []uint64{}
+	expiredKeys := [][]byte{}
+	iterator := prefixStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		exposedUntil, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+		if !exposedUntil.After(ctx.BlockTime()) {
+			expiredKeys = append(expiredKeys, iterator.Key())
+			continue
+		}
+		exposedPositionIds = append(exposedPositionIds, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, key := range expiredKeys {
+		prefixStore.Delete(key)
+	}
+
+	for _, positionId := range exposedPositionIds {
+		position, err := k.clk.GetPosition(ctx, positionId)
+		if err != nil {
+			// the position was withdrawn in full.
+			prefixStore.Delete(sdk.Uint64ToBigEndian(positionId))
+			continue
+		}
+
+		slashLiquidity := position.Liquidity.Mul(slashFactor)
+		if !slashLiquidity.IsPositive() {
+			continue
+		}
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			owner, err := sdk.AccAddressFromBech32(position.Address)
+			if err != nil {
+				return err
+			}
+			poolI, err := k.clk.GetPool(cacheCtx, position.PoolId)
+			if err != nil {
+				return err
+			}
+			pool, ok := poolI.(cltypes.ConcentratedPoolExtension)
+			if !ok {
+				return fmt.Errorf("given pool does not implement ConcentratedPoolExtension, implements %T", poolI)
+			}
+			amount0, amount1, err := k.clk.ForceWithdrawPosition(cacheCtx, owner, positionId, slashLiquidity)
+			if err != nil {
+				return err
+			}
+			// These tokens get moved to the community pool.
+			slashCoins := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1))
+			return k.ck.FundCommunityPool(cacheCtx, slashCoins, owner)
+		})
 	}
 }
 
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	cltypes "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v14/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v14/x/superfluid/types"
+)
+
+// ZapOut exits the given gamm shares, the concentrated liquidity position with id positionId, or the matured lock of
+// gamm shares with id lockId, whichever is set, and swaps every exited token other than tokenOutDenom into tokenOutDenom
+// along its routes in tokenInRoutes.
+// Returns the exited tokens and the amount of tokenOutDenom obtained, which must be at least tokenOutMinAmount.
+func (k Keeper) ZapOut(ctx sdk.Context, sender sdk.AccAddress, shares sdk.Coin, positionId, lockId uint64, tokenOutDenom string, tokenInRoutes []gammtypes.TokenInRoutes, tokenOutMinAmount sdk.Int) (tokensExited sdk.Coins, tokenOutAmount sdk.Int, err error) {
+	// Steps for zapping out.
+	// 1) Exit the shares, position or lock into the sender's account.
+	// 2) Swap every exited token that isn't the token out along its routes.
+	// 3) Check that the amount of the token out obtained is at least the minimum.
+
+	// 1) Exit the shares, position or lock into the sender's account.
+	tokensExited, err = k.exitForZapOut(ctx, sender, shares, positionId, lockId)
+	if err != nil {
+		return sdk.Coins{}, sdk.Int{}, err
+	}
+
+	// 2) Swap every exited token that isn't the token out along its routes.
+	routesByDenom := make(map[string]gammtypes.TokenInRoutes, len(tokenInRoutes))
+	for _, tokenInRoute := range tokenInRoutes {
+		routesByDenom[tokenInRoute.TokenInDenom] = tokenInRoute
+	}
+
+	tokenOutAmount = sdk.ZeroInt()
+	for _, tokenExited := range tokensExited {
+		if tokenExited.Denom == tokenOutDenom {
+			tokenOutAmount = tokenOutAmount.Add(tokenExited.Amount)
+			continue
+		}
+
+		tokenInRoute, ok := routesByDenom[tokenExited.Denom]
+		if !ok {
+			return sdk.Coins{}, sdk.Int{}, sdkerrors.Wrapf(gammtypes.ErrTokenInRoutesNotFound, "token %s", tokenExited.Denom)
+		}
+		swapOutAmount, err := k.pmk.RouteExactAmountIn(ctx, sender, tokenInRoute.Routes, tokenExited, sdk.OneInt())
+		if err != nil {
+			return sdk.Coins{}, sdk.Int{}, err
+		}
+		tokenOutAmount = tokenOutAmount.Add(swapOutAmount)
+	}
+
+	// 3) Check that the amount of the token out obtained is at least the minimum.
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Coins{}, sdk.Int{}, sdkerrors.Wrapf(types.ErrZapOutTokenOutBelowMin, "got %s%s, needed a minimum of %s", tokenOutAmount, tokenOutDenom, tokenOutMinAmount)
+	}
+
+	return tokensExited, tokenOutAmount, nil
+}
+
+// exitForZapOut exits the lock with id lockId if set, the concentrated liquidity position with id positionId if set,
+// or the given gamm shares otherwise. Returns the exited tokens, which are sent to the sender.
+func (k Keeper) exitForZapOut(ctx sdk.Context, sender sdk.AccAddress, shares sdk.Coin, positionId, lockId uint64) (sdk.Coins, error) {
+	switch {
+	case lockId != 0:
+		lock, err := k.lk.GetLockByID(ctx, lockId)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		if lock.Owner != sender.String() {
+			return sdk.Coins{}, lockuptypes.ErrNotLockOwner
+		}
+		if lock.Coins.Len() != 1 {
+			return sdk.Coins{}, types.ErrMultipleCoinsLockupNotSupported
+		}
+		if err := gammtypes.ValidatePoolShareDenom(lock.Coins[0].Denom); err != nil {
+			return sdk.Coins{}, sdkerrors.Wrapf(types.ErrZapOutNotGammShares, "lock %d holds %s", lockId, lock.Coins)
+		}
+
+		// errors if the lock hasn't finished unlocking.
+		if err := k.lk.UnlockMaturedLock(ctx, lockId); err != nil {
+			return sdk.Coins{}, err
+		}
+		return k.exitGammShares(ctx, sender, lock.Coins[0])
+
+	case positionId != 0:
+		position, err := k.clk.GetPosition(ctx, positionId)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		poolI, err := k.clk.GetPool(ctx, position.PoolId)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		concentratedPool, ok := poolI.(cltypes.ConcentratedPoolExtension)
+		if !ok {
+			return sdk.Coins{}, fmt.Errorf("given pool does not implement ConcentratedPoolExtension, implements %T", poolI)
+		}
+
+		// errors if the position isn't owned by the sender or is still frozen.
+		amount0, amount1, err := k.clk.WithdrawPosition(ctx, sender, positionId, position.Liquidity)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		return sdk.NewCoins(sdk.NewCoin(concentratedPool.GetToken0(), amount0), sdk.NewCoin(concentratedPool.GetToken1(), amount1)), nil
+
+	default:
+		return k.exitGammShares(ctx, sender, shares)
+	}
+}
+
+// exitGammShares exits all of the given gamm shares from their pool.
+func (k Keeper) exitGammShares(ctx sdk.Context, sender sdk.AccAddress, shares sdk.Coin) (sdk.Coins, error) {
+	if err := gammtypes.ValidatePoolShareDenom(shares.Denom); err != nil {
+		return sdk.Coins{}, err
+	}
+	return k.gk.ExitPool(ctx, sender, gammtypes.MustGetPoolIdFromShareDenom(shares.Denom), shares.Amount, sdk.Coins{})
+}
@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cl "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v14/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v14/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v14/x/superfluid/types"
+)
+
+// we test zapping out of the following into a single denom:
+// 1. gamm shares, with the token out being outside of the pool
+// 2. gamm shares, with the token out being a pool asset
+// 3. a concentrated liquidity position
+// 4. a matured lock of gamm shares
+func (suite *KeeperTestSuite) TestZapOut() {
+	const (
+		exitGammShares = iota
+		exitPosition
+		exitLock
+	)
+	var (
+		fooStakePoolId  uint64 = 1
+		fooOsmoPoolId   uint64 = 2
+		stakeOsmoPoolId uint64 = 3
+	)
+	toOsmoRoutes := []gammtypes.TokenInRoutes{
+		{TokenInDenom: "foo", Routes: []poolmanagertypes.SwapAmountInRoute{{PoolId: fooOsmoPoolId, TokenOutDenom: "uosmo"}}},
+		{TokenInDenom: sdk.DefaultBondDenom, Routes: []poolmanagertypes.SwapAmountInRoute{{PoolId: stakeOsmoPoolId, TokenOutDenom: "uosmo"}}},
+	}
+
+	testCases := map[string]struct {
+		exitType          int
+		tokenOutDenom     string
+		tokenInRoutes     []gammtypes.TokenInRoutes
+		tokenOutMinAmount sdk.Int
+		lockNotMatured    bool
+		positionFrozen    bool
+		overwriteSender   bool
+		expectErr         bool
+		expectedErr       error
+	}{
+		"gamm shares": {
+			exitType:      exitGammShares,
+			tokenOutDenom: "uosmo",
+			tokenInRoutes: toOsmoRoutes,
+		},
+		"gamm shares, token out is a pool asset": {
+			exitType:      exitGammShares,
+			tokenOutDenom: "foo",
+			tokenInRoutes: []gammtypes.TokenInRoutes{
+				{TokenInDenom: sdk.DefaultBondDenom, Routes: []poolmanagertypes.SwapAmountInRoute{{PoolId: fooStakePoolId, TokenOutDenom: "foo"}}},
+			},
+		},
+		"concentrated liquidity position": {
+			exitType:      exitPosition,
+			tokenOutDenom: "uosmo",
+			tokenInRoutes: toOsmoRoutes,
+		},
+		"matured lock": {
+			exitType:      exitLock,
+			tokenOutDenom: "uosmo",
+			tokenInRoutes: toOsmoRoutes,
+		},
+		"error: missing routes": {
+			exitType:      exitGammShares,
+			tokenOutDenom: "uosmo",
+			tokenInRoutes: toOsmoRoutes[:1],
+			expectErr:     true,
+			expectedErr:   gammtypes.ErrTokenInRoutesNotFound,
+		},
+		"error: token out below the minimum": {
+			exitType:          exitGammShares,
+			tokenOutDenom:     "uosmo",
+			tokenInRoutes:     toOsmoRoutes,
+			tokenOutMinAmount: sdk.NewInt(1000000),
+			expectErr:         true,
+			expectedErr:       types.ErrZapOutTokenOutBelowMin,
+		},
+		"error: lock has not matured": {
+			exitType:       exitLock,
+			tokenOutDenom:  "uosmo",
+			tokenInRoutes:  toOsmoRoutes,
+			lockNotMatured: true,
+			expectErr:      true,
+		},
+		"error: sender is not the lock owner": {
+			exitType:        exitLock,
+			tokenOutDenom:   "uosmo",
+			tokenInRoutes:   toOsmoRoutes,
+			overwriteSender: true,
+			expectErr:       true,
+			expectedErr:     lockuptypes.ErrNotLockOwner,
+		},
+		"error: position is frozen": {
+			exitType:       exitPosition,
+			tokenOutDenom:  "uosmo",
+			tokenInRoutes:  toOsmoRoutes,
+			positionFrozen: true,
+			expectErr:      true,
+		},
+		"error: sender is not the position owner": {
+			exitType:        exitPosition,
+			tokenOutDenom:   "uosmo",
+			tokenInRoutes:   toOsmoRoutes,
+			overwriteSender: true,
+			expectErr:       true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			ctx := suite.Ctx
+			bankKeeper := suite.App.BankKeeper
+			gammKeeper := suite.App.GAMMKeeper
+			superfluidKeeper := suite.App.SuperfluidKeeper
+			lockupKeeper := suite.App.LockupKeeper
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+			clKeeper := suite.App.ConcentratedLiquidityKeeper
+
+			delAddrs := CreateRandomAccounts(2)
+			poolCreateAcc := delAddrs[0]
+			owner := delAddrs[1]
+			for _, acc := range delAddrs {
+				suite.FundAcc(acc, defaultAcctFunds)
+			}
+
+			// create the pool of "foo" and "stake" to exit, and the pools routing them into "uosmo"
+			for _, poolAssets := range [][]balancer.PoolAsset{
+				defaultPoolAssets,
+				{defaultFooAsset, {Weight: sdk.NewInt(100), Token: sdk.NewCoin("uosmo", sdk.NewInt(10000))}},
+				{defaultBondDenomAsset, {Weight: sdk.NewInt(100), Token: sdk.NewCoin("uosmo", sdk.NewInt(10000))}},
+			} {
+				msg := balancer.NewMsgCreateBalancerPool(poolCreateAcc, balancer.PoolParams{
+					SwapFee: sdk.NewDecWithPrec(1, 2),
+					ExitFee: sdk.NewDec(0),
+				}, poolAssets, defaultFutureGovernor)
+				_, err := poolmanagerKeeper.CreatePool(ctx, msg)
+				suite.Require().NoError(err)
+			}
+
+			var (
+				shares     sdk.Coin
+				positionId uint64
+				lockId     uint64
+			)
+			switch tc.exitType {
+			case exitGammShares:
+				_, _, err := gammKeeper.JoinPoolNoSwap(ctx, owner, fooStakePoolId, gammtypes.OneShare.MulRaw(50), sdk.Coins{})
+				suite.Require().NoError(err)
+				shares = sdk.NewCoin(gammtypes.GetPoolShareDenom(fooStakePoolId), gammtypes.OneShare.MulRaw(50))
+
+			case exitPosition:
+				clPool := suite.PrepareCustomConcentratedPool(poolCreateAcc, defaultFooAsset.Token.Denom, sdk.DefaultBondDenom, 1, sdk.NewInt(-4), sdk.ZeroDec())
+				minTick, maxTick := cl.GetMinAndMaxTicksFromExponentAtPriceOne(clPool.GetPrecisionFactorAtPriceOne())
+				frozenUntil := ctx.BlockTime()
+				if tc.positionFrozen {
+					frozenUntil = frozenUntil.Add(time.Hour)
+				}
+				var err error
+				positionId, _, _, _, err = clKeeper.CreatePosition(ctx, clPool.GetId(), owner, sdk.NewInt(5000), sdk.NewInt(5000), sdk.ZeroInt(), sdk.ZeroInt(), minTick, maxTick, frozenUntil)
+				suite.Require().NoError(err)
+
+			case exitLock:
+				_, _, err := gammKeeper.JoinPoolNoSwap(ctx, owner, fooStakePoolId, gammtypes.OneShare.MulRaw(50), sdk.Coins{})
+				suite.Require().NoError(err)
+				lockShares := sdk.NewCoin(gammtypes.GetPoolShareDenom(fooStakePoolId), gammtypes.OneShare.MulRaw(50))
+				lockId = suite.LockTokens(owner, sdk.NewCoins(lockShares), time.Hour)
+				_, err = lockupKeeper.BeginForceUnlock(ctx, lockId, sdk.NewCoins())
+				suite.Require().NoError(err)
+				if !tc.lockNotMatured {
+					ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+				}
+			}
+
+			sender := owner
+			if tc.overwriteSender {
+				sender = poolCreateAcc
+			}
+			tokenOutMinAmount := sdk.OneInt()
+			if !tc.tokenOutMinAmount.IsNil() {
+				tokenOutMinAmount = tc.tokenOutMinAmount
+			}
+			balancesBefore := bankKeeper.GetAllBalances(ctx, owner)
+
+			// System under test.
+			tokensExited, tokenOutAmount, err := superfluidKeeper.ZapOut(ctx, sender, shares, positionId, lockId, tc.tokenOutDenom, tc.tokenInRoutes, tokenOutMinAmount)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				if tc.expectedErr != nil {
+					suite.Require().ErrorIs(err, tc.expectedErr)
+				}
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(tokensExited, 2)
+			suite.Require().True(tokensExited.IsAllPositive())
+			suite.Require().True(tokenOutAmount.GTE(tokenOutMinAmount))
+
+			// the exited tokens are all swapped into the token out.
+			balancesAfter := bankKeeper.GetAllBalances(ctx, owner)
+			suite.Require().Equal(balancesBefore.AmountOf(tc.tokenOutDenom).Add(tokenOutAmount), balancesAfter.AmountOf(tc.tokenOutDenom))
+			for _, tokenExited := range tokensExited {
+				if tokenExited.Denom != tc.tokenOutDenom {
+					suite.Require().Equal(balancesBefore.AmountOf(tokenExited.Denom), balancesAfter.AmountOf(tokenExited.Denom))
+				}
+			}
+
+			// the shares, position or lock are gone.
+			switch tc.exitType {
+			case exitGammShares:
+				suite.Require().Equal(balancesBefore.AmountOf(shares.Denom).Sub(shares.Amount).String(), balancesAfter.AmountOf(shares.Denom).String())
+			case exitPosition:
+				_, err = clKeeper.GetPosition(ctx, positionId)
+				suite.Require().Error(err)
+			case exitLock:
+				_, err = lockupKeeper.GetLockByID(ctx, lockId)
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -19,6 +19,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&UpdateUnpoolWhiteListProposal{}, "osmosis/update-unpool-whitelist", nil)
 	cdc.RegisterConcrete(&RemoveSuperfluidAssetsProposal{}, "osmosis/del-superfluid-assets-proposal", nil)
 	cdc.RegisterConcrete(&MsgUnPoolWhitelistedPool{}, "osmosis/unpool-whitelisted-pool", nil)
+	cdc.RegisterConcrete(&MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition{}, "osmosis/unlock-and-migrate", nil)
+	cdc.RegisterConcrete(&MsgZapOut{}, "osmosis/zap-out", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -30,6 +32,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSuperfluidUnbondLock{},
 		&MsgSuperfluidUndelegateAndUnbondLock{},
 		&MsgUnPoolWhitelistedPool{},
+		&MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition{},
+		&MsgZapOut{},
 	)
 
 	registry.RegisterImplementations(
@@ -21,4 +21,13 @@ var (
 	ErrPoolNotWhitelisted   = sdkerrors.Register(ModuleName, 41, "pool not whitelisted to unpool")
 	ErrLockUnpoolNotAllowed = sdkerrors.Register(ModuleName, 42, "lock not eligible for unpooling")
 	ErrLockLengthMismatch   = sdkerrors.Register(ModuleName, 43, "lock has more than one asset")
+
+	ErrSharesToMigrateDenomMismatch = sdkerrors.Register(ModuleName, 44, "shares to migrate must have the same denom as the lock")
+	ErrSharesToMigrateExceedLock    = sdkerrors.Register(ModuleName, 45, "shares to migrate exceed the shares in the lock")
+
+	ErrInvalidZapOutSource    = sdkerrors.Register(ModuleName, 46, "exactly one of shares, position id and lock id must be set")
+	ErrZapOutNotGammShares    = sdkerrors.Register(ModuleName, 47, "lock to zap out must hold gamm shares")
+	ErrZapOutTokenOutBelowMin = sdkerrors.Register(ModuleName, 48, "token out amount is less than the minimum amount")
+
+	ErrMigrateSuperfluidUnbondingLock = sdkerrors.Register(ModuleName, 49, "superfluid unbonding lock cannot be migrated before it finishes unbonding")
 )
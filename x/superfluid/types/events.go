@@ -13,6 +13,14 @@ const (
 	TypeEvtUnpoolId     = "unpool_pool_id"
 	AttributeNewLockIds = "new_lock_ids"
 
+	TypeEvtUnlockAndMigrateShares = "unlock_and_migrate_shares"
+	AttributePositionId           = "position_id"
+	AttributeRemainingLockId      = "remaining_lock_id"
+
+	TypeEvtZapOut         = "zap_out"
+	AttributeTokensExited = "tokens_exited"
+	AttributeTokenOut     = "token_out"
+
 	AttributeDenom               = "denom"
 	AttributeSuperfluidAssetType = "superfluid_asset_type"
 	AttributeLockId              = "lock_id"
@@ -7,10 +7,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/model"
 	epochstypes "github.com/osmosis-labs/osmosis/v14/x/epochs/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v14/x/gamm/types"
 	incentivestypes "github.com/osmosis-labs/osmosis/v14/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v14/x/lockup/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
 )
 
 // LockupKeeper defines the expected interface needed to retrieve locks.
@@ -25,7 +27,9 @@ type LockupKeeper interface {
 	// Despite the name, BeginForceUnlock is really BeginUnlock
 	// TODO: Fix this in future code update
 	BeginForceUnlock(ctx sdk.Context, lockID uint64, coins sdk.Coins) (uint64, error)
+	BeginForceUnlockWithEndTime(ctx sdk.Context, lockID uint64, endTime time.Time) error
 	ForceUnlock(ctx sdk.Context, lock lockuptypes.PeriodLock) error
+	UnlockMaturedLock(ctx sdk.Context, lockID uint64) error
 
 	CreateLock(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, duration time.Duration) (lockuptypes.PeriodLock, error)
 
@@ -47,6 +51,7 @@ type GammKeeper interface {
 	GetPoolAndPoke(ctx sdk.Context, poolId uint64) (gammtypes.CFMMPoolI, error)
 	GetPoolsAndPoke(ctx sdk.Context) (res []gammtypes.CFMMPoolI, err error)
 	ExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, tokenOutMins sdk.Coins) (exitCoins sdk.Coins, err error)
+	MigrateSharesToFullRangeConcentratedPosition(ctx sdk.Context, sender sdk.AccAddress, sharesToMigrate sdk.Coin, frozenUntil time.Time) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, poolIdLeaving, poolIdEntering uint64, err error)
 }
 
 type BankKeeper interface {
@@ -78,6 +83,7 @@ type StakingKeeper interface {
 // CommunityPoolKeeper expected distribution keeper.
 type CommunityPoolKeeper interface {
 	WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error)
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 // IncentivesKeeper expected incentives keeper.
@@ -95,3 +101,21 @@ type EpochKeeper interface {
 	GetEpochInfo(ctx sdk.Context, identifier string) epochstypes.EpochInfo
 	NumBlocksSinceEpochStart(ctx sdk.Context, identifier string) (int64, error)
 }
+
+// ConcentratedKeeper defines the expected interface needed to withdraw and slash concentrated liquidity positions.
+type ConcentratedKeeper interface {
+	GetPool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error)
+	GetPosition(ctx sdk.Context, positionId uint64) (model.Position, error)
+	WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw sdk.Dec) (amtDenom0, amtDenom1 sdk.Int, err error)
+	ForceWithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, liquidityAmount sdk.Dec) (amtDenom0, amtDenom1 sdk.Int, err error)
+}
+
+// PoolManagerKeeper defines the expected interface needed to swap along routes.
+type PoolManagerKeeper interface {
+	RouteExactAmountIn(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		routes []poolmanagertypes.SwapAmountInRoute,
+		tokenIn sdk.Coin,
+		tokenOutMinAmount sdk.Int) (tokenOutAmount sdk.Int, err error)
+}
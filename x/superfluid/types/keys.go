@@ -27,4 +27,8 @@ var (
 
 	// KeyUnpoolAllowedPools defines key to unpool allowed pools.
 	KeyUnpoolAllowedPools = []byte{0x06}
+
+	// KeyPrefixMigratedPositionSlashingExposure defines prefix to connect an intermediary account address and the id of
+	// a concentrated liquidity position migrated from one of its locks to the time until which the position can be slashed.
+	KeyPrefixMigratedPositionSlashingExposure = []byte{0x07}
 )
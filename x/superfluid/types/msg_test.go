@@ -6,8 +6,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v14/app/apptesting"
+	gammtypes "github.com/osmosis-labs/osmosis/v14/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v14/x/superfluid/types"
 
+	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 )
 
@@ -71,6 +74,27 @@ func TestAuthzMsg(t *testing.T) {
 				PoolId: 1,
 			},
 		},
+		{
+			name: "MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition",
+			msg: &types.MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition{
+				Sender:          addr1,
+				LockId:          1,
+				SharesToMigrate: sdk.NewInt64Coin("gamm/pool/1", 100),
+			},
+		},
+		{
+			name: "MsgZapOut",
+			msg: &types.MsgZapOut{
+				Sender:        addr1,
+				LockId:        1,
+				TokenOutDenom: "uosmo",
+				TokenInRoutes: []gammtypes.TokenInRoutes{{
+					TokenInDenom: "uatom",
+					Routes:       []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}},
+				}},
+				TokenOutMinAmount: sdk.NewInt(100),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -78,3 +102,159 @@ func TestAuthzMsg(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgZapOut(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	atomToOsmo := []gammtypes.TokenInRoutes{{
+		TokenInDenom: "uatom",
+		Routes:       []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}},
+	}}
+
+	createMsg := func(after func(msg types.MsgZapOut) types.MsgZapOut) types.MsgZapOut {
+		properMsg := types.MsgZapOut{
+			Sender:            addr1,
+			Shares:            sdk.NewInt64Coin("gamm/pool/1", 100),
+			TokenOutDenom:     "uosmo",
+			TokenInRoutes:     atomToOsmo,
+			TokenOutMinAmount: sdk.NewInt(100),
+		}
+		return after(properMsg)
+	}
+
+	tests := []struct {
+		name       string
+		msg        types.MsgZapOut
+		expectPass bool
+	}{
+		{
+			name:       "proper msg with shares",
+			msg:        createMsg(func(msg types.MsgZapOut) types.MsgZapOut { return msg }),
+			expectPass: true,
+		},
+		{
+			name: "proper msg with a position",
+			msg: createMsg(func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.Shares = sdk.Coin{}
+				msg.PositionId = 1
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "proper msg with a lock",
+			msg: createMsg(func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.Shares = sdk.Coin{}
+				msg.LockId = 1
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "proper msg without routes",
+			msg: createMsg(func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.TokenInRoutes = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.Sender = "invalid"
+				return msg
+			}),
+		},
+		{
+			name: "nothing to exit",
+			msg: createMsg(func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.Shares = sdk.Coin{}
+				return msg
+			}),
+		},
+		{
+			name: "shares and a lock",
+			msg: createMsg(func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.LockId = 1
+				return msg
+			}),
+		},
+		{
+			name: "a position and a lock",
+			msg: createMsg(func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.Shares = sdk.Coin{}
+				msg.PositionId = 1
+				msg.LockId = 1
+				return msg
+			}),
+		},
+		{
+			name: "zero shares",
+			msg: createMsg(func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.Shares = sdk.NewInt64Coin("gamm/pool/1", 0)
+				return msg
+			}),
+		},
+		{
+			name: "shares that are not gamm shares",
+			msg: createMsg(func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.Shares = sdk.NewInt64Coin("uatom", 100)
+				return msg
+			}),
+		},
+		{
+			name: "invalid token out denom",
+			msg: createMsg(func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.TokenOutDenom = "1"
+				return msg
+			}),
+		},
+		{
+			name: "routes ending in another denom",
+			msg: createMsg(func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.TokenOutDenom = "uion"
+				return msg
+			}),
+		},
+		{
+			name: "routes for the token out",
+			msg: createMsg(func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.TokenOutDenom = "uatom"
+				msg.TokenInRoutes = []gammtypes.TokenInRoutes{{
+					TokenInDenom: "uatom",
+					Routes:       []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uatom"}},
+				}}
+				return msg
+			}),
+		},
+		{
+			name: "duplicate routes",
+			msg: createMsg(func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.TokenInRoutes = append(atomToOsmo, atomToOsmo...)
+				return msg
+			}),
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.TokenInRoutes = []gammtypes.TokenInRoutes{{TokenInDenom: "uatom"}}
+				return msg
+			}),
+		},
+		{
+			name: "zero token out min amount",
+			msg: createMsg(func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.TokenOutMinAmount = sdk.ZeroInt()
+				return msg
+			}),
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v14/x/gamm/types"
 )
 
 // constants.
@@ -16,6 +18,8 @@ const (
 	TypeMsgSuperfluidUndeledgateAndUnbondLock = "superfluid_undelegate_and_unbond_lock"
 	TypeMsgLockAndSuperfluidDelegate          = "lock_and_superfluid_delegate"
 	TypeMsgUnPoolWhitelistedPool              = "unpool_whitelisted_pool"
+	TypeMsgUnlockAndMigrateShares             = "unlock_and_migrate_shares"
+	TypeMsgZapOut                             = "zap_out"
 )
 
 var _ sdk.Msg = &MsgSuperfluidDelegate{}
@@ -260,3 +264,147 @@ func (msg MsgUnPoolWhitelistedPool) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition{}
+
+// NewMsgUnlockAndMigrateSharesToFullRangeConcentratedPosition creates a message to break a lock and migrate its gamm shares
+// to a full range concentrated liquidity position.
+func NewMsgUnlockAndMigrateSharesToFullRangeConcentratedPosition(sender sdk.AccAddress, lockId uint64, sharesToMigrate sdk.Coin) *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition {
+	return &MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition{
+		Sender:          sender.String(),
+		LockId:          lockId,
+		SharesToMigrate: sharesToMigrate,
+	}
+}
+
+func (msg MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) Route() string { return RouterKey }
+func (msg MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) Type() string {
+	return TypeMsgUnlockAndMigrateShares
+}
+
+func (msg MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", msg.LockId)
+	}
+
+	// a zero amount migrates all of the lock's shares.
+	if err := msg.SharesToMigrate.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid shares to migrate (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgZapOut{}
+
+// NewMsgZapOut creates a message to exit gamm shares, a concentrated liquidity position or a matured lock
+// into a single denom.
+func NewMsgZapOut(sender sdk.AccAddress, shares sdk.Coin, positionId, lockId uint64, tokenOutDenom string, tokenInRoutes []gammtypes.TokenInRoutes, tokenOutMinAmount sdk.Int) *MsgZapOut {
+	return &MsgZapOut{
+		Sender:            sender.String(),
+		Shares:            shares,
+		PositionId:        positionId,
+		LockId:            lockId,
+		TokenOutDenom:     tokenOutDenom,
+		TokenInRoutes:     tokenInRoutes,
+		TokenOutMinAmount: tokenOutMinAmount,
+	}
+}
+
+func (msg MsgZapOut) Route() string { return RouterKey }
+func (msg MsgZapOut) Type() string  { return TypeMsgZapOut }
+
+func (msg MsgZapOut) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	hasShares := msg.Shares.Denom != ""
+	numSources := 0
+	for _, isSet := range []bool{hasShares, msg.PositionId != 0, msg.LockId != 0} {
+		if isSet {
+			numSources++
+		}
+	}
+	if numSources != 1 {
+		return ErrInvalidZapOutSource
+	}
+
+	if hasShares {
+		if !msg.Shares.IsValid() || !msg.Shares.IsPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid shares (%s)", msg.Shares)
+		}
+		if err := gammtypes.ValidatePoolShareDenom(msg.Shares.Denom); err != nil {
+			return err
+		}
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenOutDenom); err != nil {
+		return err
+	}
+
+	if err := validateZapOutRoutes(msg.TokenInRoutes, msg.TokenOutDenom); err != nil {
+		return err
+	}
+
+	if msg.TokenOutMinAmount.IsNil() || !msg.TokenOutMinAmount.IsPositive() {
+		return fmt.Errorf("token out min amount should be positive: %s", msg.TokenOutMinAmount)
+	}
+
+	return nil
+}
+
+// validateZapOutRoutes checks that no token has more than one set of routes,
+// and that every set of routes is valid and swaps a token other than tokenOutDenom into tokenOutDenom.
+func validateZapOutRoutes(tokenInRoutes []gammtypes.TokenInRoutes, tokenOutDenom string) error {
+	seenDenoms := make(map[string]bool, len(tokenInRoutes))
+	for _, tokenInRoute := range tokenInRoutes {
+		if seenDenoms[tokenInRoute.TokenInDenom] {
+			return sdkerrors.Wrapf(gammtypes.ErrInvalidTokenInRoutes, "token %s has more than one set of routes", tokenInRoute.TokenInDenom)
+		}
+		seenDenoms[tokenInRoute.TokenInDenom] = true
+
+		if tokenInRoute.TokenInDenom == tokenOutDenom {
+			return sdkerrors.Wrapf(gammtypes.ErrInvalidTokenInRoutes, "token %s is the token out and can't be swapped", tokenOutDenom)
+		}
+
+		if err := gammtypes.SwapAmountInRoutes(tokenInRoute.Routes).Validate(); err != nil {
+			return err
+		}
+
+		if lastDenom := tokenInRoute.Routes[len(tokenInRoute.Routes)-1].TokenOutDenom; lastDenom != tokenOutDenom {
+			return sdkerrors.Wrapf(gammtypes.ErrInvalidTokenInRoutes, "routes of token %s end in %s instead of %s", tokenInRoute.TokenInDenom, lastDenom, tokenOutDenom)
+		}
+	}
+	return nil
+}
+
+func (msg MsgZapOut) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgZapOut) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
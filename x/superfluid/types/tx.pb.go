@@ -12,18 +12,22 @@ import (
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types2 "github.com/osmosis-labs/osmosis/v14/x/gamm/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -615,6 +619,273 @@ func (m *MsgUnPoolWhitelistedPoolResponse) GetExitedLockIds() []uint64 {
 	return nil
 }
 
+// MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition breaks the lock with
+// id lock_id, superfluid undelegating it first if needed, and migrates
+// shares_to_migrate of its gamm shares to a full range position in the
+// concentrated liquidity pool linked to the shares' balancer pool. A zero
+// shares_to_migrate amount migrates all of the lock's shares. The position is
+// frozen until the lock would have finished unlocking. Shares that are not
+// migrated are locked again with the lock's duration, keeping the lock's
+// unlocking end time and superfluid delegation.
+type MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition struct {
+	Sender          string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId          uint64     `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+	SharesToMigrate types.Coin `protobuf:"bytes,3,opt,name=shares_to_migrate,json=sharesToMigrate,proto3" json:"shares_to_migrate" yaml:"shares_to_migrate"`
+}
+
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) Reset() {
+	*m = MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition{}
+}
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) ProtoMessage() {}
+func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{12}
+}
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition.Merge(m, src)
+}
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition proto.InternalMessageInfo
+
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) GetSharesToMigrate() types.Coin {
+	if m != nil {
+		return m.SharesToMigrate
+	}
+	return types.Coin{}
+}
+
+type MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse struct {
+	PositionId       uint64                                 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	Amount0          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount0" yaml:"amount0"`
+	Amount1          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount1" yaml:"amount1"`
+	LiquidityCreated github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=liquidity_created,json=liquidityCreated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_created" yaml:"liquidity_created"`
+	FrozenUntil      time.Time                              `protobuf:"bytes,5,opt,name=frozen_until,json=frozenUntil,proto3,stdtime" json:"frozen_until" yaml:"frozen_until"`
+	// Id of the lock holding the shares that were not migrated, zero if all of
+	// the lock's shares were migrated.
+	RemainingLockId uint64 `protobuf:"varint,6,opt,name=remaining_lock_id,json=remainingLockId,proto3" json:"remaining_lock_id,omitempty" yaml:"remaining_lock_id"`
+}
+
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Reset() {
+	*m = MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse{}
+}
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) ProtoMessage() {}
+func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{13}
+}
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse.Merge(m, src)
+}
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse proto.InternalMessageInfo
+
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) GetFrozenUntil() time.Time {
+	if m != nil {
+		return m.FrozenUntil
+	}
+	return time.Time{}
+}
+
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) GetRemainingLockId() uint64 {
+	if m != nil {
+		return m.RemainingLockId
+	}
+	return 0
+}
+
+// MsgZapOut exits the gamm shares, the concentrated liquidity position with id
+// position_id, or the matured lock of gamm shares with id lock_id, and swaps
+// every exited token other than token_out_denom into token_out_denom along its
+// token_in_routes. Exactly one of shares, position_id and lock_id must be set.
+type MsgZapOut struct {
+	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Shares            types.Coin                             `protobuf:"bytes,2,opt,name=shares,proto3" json:"shares" yaml:"shares"`
+	PositionId        uint64                                 `protobuf:"varint,3,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	LockId            uint64                                 `protobuf:"varint,4,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+	TokenOutDenom     string                                 `protobuf:"bytes,5,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	TokenInRoutes     []types2.TokenInRoutes                 `protobuf:"bytes,6,rep,name=token_in_routes,json=tokenInRoutes,proto3" json:"token_in_routes" yaml:"token_in_routes"`
+	TokenOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+}
+
+func (m *MsgZapOut) Reset()         { *m = MsgZapOut{} }
+func (m *MsgZapOut) String() string { return proto.CompactTextString(m) }
+func (*MsgZapOut) ProtoMessage()    {}
+func (*MsgZapOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{14}
+}
+func (m *MsgZapOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgZapOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgZapOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgZapOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgZapOut.Merge(m, src)
+}
+func (m *MsgZapOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgZapOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgZapOut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgZapOut proto.InternalMessageInfo
+
+func (m *MsgZapOut) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgZapOut) GetShares() types.Coin {
+	if m != nil {
+		return m.Shares
+	}
+	return types.Coin{}
+}
+
+func (m *MsgZapOut) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *MsgZapOut) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgZapOut) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *MsgZapOut) GetTokenInRoutes() []types2.TokenInRoutes {
+	if m != nil {
+		return m.TokenInRoutes
+	}
+	return nil
+}
+
+type MsgZapOutResponse struct {
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *MsgZapOutResponse) Reset()         { *m = MsgZapOutResponse{} }
+func (m *MsgZapOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgZapOutResponse) ProtoMessage()    {}
+func (*MsgZapOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{15}
+}
+func (m *MsgZapOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgZapOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgZapOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgZapOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgZapOutResponse.Merge(m, src)
+}
+func (m *MsgZapOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgZapOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgZapOutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgZapOutResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSuperfluidDelegate)(nil), "osmosis.superfluid.MsgSuperfluidDelegate")
 	proto.RegisterType((*MsgSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidDelegateResponse")
@@ -628,55 +899,91 @@ func init() {
 	proto.RegisterType((*MsgLockAndSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegateResponse")
 	proto.RegisterType((*MsgUnPoolWhitelistedPool)(nil), "osmosis.superfluid.MsgUnPoolWhitelistedPool")
 	proto.RegisterType((*MsgUnPoolWhitelistedPoolResponse)(nil), "osmosis.superfluid.MsgUnPoolWhitelistedPoolResponse")
+	proto.RegisterType((*MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition)(nil), "osmosis.superfluid.MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition")
+	proto.RegisterType((*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse)(nil), "osmosis.superfluid.MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse")
+	proto.RegisterType((*MsgZapOut)(nil), "osmosis.superfluid.MsgZapOut")
+	proto.RegisterType((*MsgZapOutResponse)(nil), "osmosis.superfluid.MsgZapOutResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x5f, 0x4f, 0xd3, 0x5e,
-	0x18, 0x5e, 0xb7, 0xfd, 0xc6, 0xcf, 0x97, 0x80, 0xb1, 0x42, 0x28, 0x55, 0xdb, 0x51, 0x8d, 0x99,
-	0x01, 0x7a, 0x18, 0x10, 0x42, 0xbc, 0x92, 0xc9, 0xcd, 0x0c, 0x4b, 0x48, 0x0d, 0x31, 0x31, 0x31,
-	0xa4, 0xdd, 0x39, 0x94, 0x86, 0xd2, 0xb3, 0xf4, 0x74, 0xcb, 0x88, 0x1f, 0xc0, 0x5b, 0xaf, 0xbc,
-	0xf5, 0xde, 0x0b, 0xbf, 0x86, 0x5c, 0x72, 0xe9, 0xd5, 0x34, 0xf0, 0x0d, 0xf8, 0x04, 0xe6, 0xf4,
-	0x1f, 0xa2, 0x2d, 0x6c, 0x8a, 0x57, 0x3b, 0xe7, 0xbc, 0xcf, 0xfb, 0xbc, 0xcf, 0x9b, 0xf7, 0xcf,
-	0x0a, 0xf7, 0x28, 0x3b, 0xa4, 0xcc, 0x61, 0x88, 0x75, 0x3b, 0xc4, 0xdf, 0x73, 0xbb, 0x0e, 0x46,
-	0x41, 0x5f, 0xef, 0xf8, 0x34, 0xa0, 0xa2, 0x18, 0x1b, 0xf5, 0x0b, 0xa3, 0x3c, 0x65, 0x53, 0x9b,
-	0x86, 0x66, 0xc4, 0x4f, 0x11, 0x52, 0x56, 0x6c, 0x4a, 0x6d, 0x97, 0xa0, 0xf0, 0x66, 0x75, 0xf7,
-	0x10, 0xee, 0xfa, 0x66, 0xe0, 0x50, 0x2f, 0xb1, 0xb7, 0x43, 0x2a, 0x64, 0x99, 0x8c, 0xa0, 0x5e,
-	0xdd, 0x22, 0x81, 0x59, 0x47, 0x6d, 0xea, 0x24, 0xf6, 0x87, 0x19, 0x32, 0x2e, 0x8e, 0x11, 0x48,
-	0xeb, 0xc1, 0x74, 0x8b, 0xd9, 0x2f, 0xd3, 0xe7, 0x4d, 0xe2, 0x12, 0xdb, 0x0c, 0x88, 0xf8, 0x04,
-	0x2a, 0x8c, 0x78, 0x98, 0xf8, 0x92, 0x50, 0x15, 0x6a, 0xb7, 0x1a, 0x77, 0xce, 0x07, 0xea, 0xc4,
-	0x91, 0x79, 0xe8, 0x3e, 0xd5, 0xa2, 0x77, 0xcd, 0x88, 0x01, 0xe2, 0x0c, 0x8c, 0xb9, 0xb4, 0x7d,
-	0xb0, 0xeb, 0x60, 0xa9, 0x58, 0x15, 0x6a, 0x65, 0xa3, 0xc2, 0xaf, 0x4d, 0x2c, 0xce, 0xc2, 0xff,
-	0x3d, 0xd3, 0xdd, 0x35, 0x31, 0xf6, 0xa5, 0x12, 0x67, 0x31, 0xc6, 0x7a, 0xa6, 0xbb, 0x81, 0xb1,
-	0xaf, 0xa9, 0xf0, 0x20, 0x33, 0xae, 0x41, 0x58, 0x87, 0x7a, 0x8c, 0x68, 0x6f, 0x60, 0xe6, 0x12,
-	0x60, 0xc7, 0xc3, 0x37, 0x28, 0x4d, 0x9b, 0x03, 0x35, 0x87, 0xfe, 0x0a, 0x05, 0x16, 0xf5, 0xf0,
-	0x16, 0x6d, 0x1f, 0xfc, 0x23, 0x05, 0x09, 0x7d, 0xaa, 0xe0, 0xb3, 0x00, 0x8f, 0x72, 0x54, 0x6e,
-	0x78, 0x37, 0xac, 0x47, 0x6c, 0x40, 0x99, 0x37, 0x4f, 0x58, 0xa8, 0xf1, 0xe5, 0x59, 0x3d, 0xea,
-	0x2e, 0x9d, 0x77, 0x97, 0x1e, 0x77, 0x97, 0xfe, 0x9c, 0x3a, 0x5e, 0xe3, 0xee, 0xf1, 0x40, 0x2d,
-	0x9c, 0x0f, 0xd4, 0xf1, 0x28, 0x00, 0x77, 0xd2, 0x8c, 0xd0, 0x57, 0xd3, 0x61, 0x61, 0x18, 0xbd,
-	0x69, 0x82, 0x5f, 0x04, 0xb8, 0xdf, 0x62, 0x36, 0x7f, 0xdb, 0xf0, 0xf0, 0xdf, 0x75, 0xa1, 0x09,
-	0xff, 0x71, 0x0d, 0x4c, 0x2a, 0x56, 0x4b, 0x57, 0x27, 0xb0, 0xc4, 0x13, 0xf8, 0xf4, 0x4d, 0xad,
-	0xd9, 0x4e, 0xb0, 0xdf, 0xb5, 0xf4, 0x36, 0x3d, 0x44, 0xf1, 0x2c, 0x45, 0x3f, 0x8b, 0x0c, 0x1f,
-	0xa0, 0xe0, 0xa8, 0x43, 0x58, 0xe8, 0xc0, 0x8c, 0x88, 0xf9, 0xaa, 0x7e, 0x5e, 0x0b, 0x2b, 0x95,
-	0x9b, 0x48, 0x92, 0xb1, 0x38, 0x09, 0xc5, 0xe6, 0x66, 0x98, 0x4c, 0xd9, 0x28, 0x36, 0x37, 0x35,
-	0x1f, 0xa4, 0x16, 0xb3, 0x77, 0xbc, 0x6d, 0x4a, 0xdd, 0x57, 0xfb, 0x4e, 0x40, 0x5c, 0x87, 0x05,
-	0x04, 0xf3, 0xeb, 0x28, 0xc9, 0xcf, 0xc3, 0x58, 0x87, 0x52, 0x37, 0xad, 0x6a, 0x43, 0x3c, 0x1f,
-	0xa8, 0x93, 0x11, 0x36, 0x36, 0x68, 0x46, 0x85, 0x9f, 0x9a, 0x58, 0x7b, 0x01, 0xd5, 0xbc, 0x98,
-	0xa9, 0xce, 0xc7, 0x70, 0x9b, 0xf4, 0x9d, 0x80, 0xe0, 0xdd, 0xb8, 0x5b, 0x98, 0x24, 0x54, 0x4b,
-	0xb5, 0xb2, 0x31, 0x11, 0x3d, 0x6f, 0x85, 0x4d, 0xc3, 0x96, 0x3f, 0x54, 0xa0, 0xd4, 0x62, 0xb6,
-	0xe8, 0x83, 0x98, 0x55, 0x3e, 0xfd, 0xf7, 0x6d, 0xa7, 0x67, 0xce, 0xbd, 0x5c, 0x1f, 0x1a, 0x9a,
-	0x6a, 0xec, 0xc3, 0x54, 0xe6, 0x7e, 0x98, 0xbf, 0x96, 0xea, 0x02, 0x2c, 0xaf, 0x8c, 0x00, 0xce,
-	0x8b, 0x9c, 0xce, 0xe1, 0x30, 0x91, 0x13, 0xf0, 0x50, 0x91, 0x7f, 0x9d, 0x18, 0xf1, 0xa3, 0x00,
-	0x73, 0xd7, 0xef, 0x83, 0xf5, 0x11, 0x92, 0xba, 0xe4, 0x29, 0x3f, 0xfb, 0x53, 0xcf, 0x54, 0xe1,
-	0x3b, 0x01, 0x66, 0xf3, 0x07, 0x7a, 0x29, 0x87, 0x3f, 0xd7, 0x43, 0x5e, 0x1f, 0xd5, 0x23, 0x55,
-	0xf2, 0x16, 0xa6, 0xb3, 0x07, 0x6b, 0x21, 0x87, 0x32, 0x13, 0x2d, 0xaf, 0x8e, 0x82, 0x4e, 0x82,
-	0x37, 0xb6, 0x8f, 0x4f, 0x15, 0xe1, 0xe4, 0x54, 0x11, 0xbe, 0x9f, 0x2a, 0xc2, 0xfb, 0x33, 0xa5,
-	0x70, 0x72, 0xa6, 0x14, 0xbe, 0x9e, 0x29, 0x85, 0xd7, 0x6b, 0x3f, 0xad, 0x9d, 0x98, 0x79, 0xd1,
-	0x35, 0x2d, 0x96, 0x5c, 0x50, 0xaf, 0xbe, 0x8a, 0xfa, 0x97, 0x3e, 0x1e, 0xf8, 0x2a, 0xb2, 0x2a,
-	0xe1, 0x3f, 0xf6, 0xca, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0x3d, 0x2c, 0x3c, 0x5f, 0x08,
-	0x00, 0x00,
+	// 1195 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0x66, 0xb1, 0x63, 0xc2, 0x50, 0x20, 0xde, 0x40, 0x58, 0x36, 0xc1, 0xeb, 0x4c, 0xd2, 0x88,
+	0x2a, 0xc9, 0x2e, 0x0e, 0x69, 0x8a, 0x7a, 0x02, 0x83, 0xaa, 0x38, 0xc2, 0x0d, 0xda, 0x80, 0x2a,
+	0x21, 0xb5, 0xd6, 0xda, 0x3b, 0x2c, 0x2b, 0x76, 0x67, 0xdc, 0x9d, 0x59, 0x0a, 0xad, 0xd4, 0x6b,
+	0x7b, 0xcc, 0xad, 0xc7, 0xde, 0x7b, 0xe8, 0x5f, 0x51, 0xa9, 0x39, 0xe6, 0x54, 0x55, 0xad, 0xe4,
+	0x54, 0xf0, 0x1f, 0x70, 0xed, 0xa5, 0xda, 0x1f, 0x33, 0x06, 0x63, 0x03, 0xa6, 0xe4, 0xe4, 0x9d,
+	0xf7, 0xde, 0x7c, 0xef, 0xfb, 0x76, 0xbe, 0xd9, 0x19, 0x83, 0xdb, 0x84, 0xfa, 0x84, 0xba, 0xd4,
+	0xa0, 0x61, 0x13, 0x05, 0x5b, 0x5e, 0xe8, 0xda, 0x06, 0xdb, 0xd3, 0x9b, 0x01, 0x61, 0x44, 0x96,
+	0xd3, 0xa4, 0xde, 0x4e, 0xaa, 0x13, 0x0e, 0x71, 0x48, 0x9c, 0x36, 0xa2, 0xa7, 0xa4, 0x52, 0x2d,
+	0x38, 0x84, 0x38, 0x1e, 0x32, 0xe2, 0x51, 0x3d, 0xdc, 0x32, 0xec, 0x30, 0xb0, 0x98, 0x4b, 0x70,
+	0x9a, 0xd7, 0x3a, 0xf3, 0xcc, 0xf5, 0x11, 0x65, 0x96, 0xdf, 0xe4, 0x00, 0x8d, 0xb8, 0x97, 0x51,
+	0xb7, 0x28, 0x32, 0x76, 0x4b, 0x75, 0xc4, 0xac, 0x92, 0xd1, 0x20, 0x2e, 0x07, 0xb8, 0xd7, 0x85,
+	0x67, 0xfb, 0x31, 0x2d, 0x9a, 0xe1, 0x45, 0x8e, 0xe5, 0xfb, 0x02, 0x85, 0xcb, 0x81, 0xbb, 0x60,
+	0xb2, 0x4a, 0x9d, 0x57, 0x62, 0xd6, 0x0a, 0xf2, 0x90, 0x63, 0x31, 0x24, 0x7f, 0x04, 0x72, 0x14,
+	0x61, 0x1b, 0x05, 0x8a, 0x54, 0x94, 0x66, 0x87, 0xcb, 0xf9, 0xa3, 0x96, 0x36, 0xba, 0x6f, 0xf9,
+	0xde, 0xa7, 0x30, 0x89, 0x43, 0x33, 0x2d, 0x90, 0xa7, 0xc0, 0x90, 0x47, 0x1a, 0x3b, 0x35, 0xd7,
+	0x56, 0x06, 0x8b, 0xd2, 0x6c, 0xd6, 0xcc, 0x45, 0xc3, 0x8a, 0x2d, 0x4f, 0x83, 0xeb, 0xbb, 0x96,
+	0x57, 0xb3, 0x6c, 0x3b, 0x50, 0x32, 0x11, 0x8a, 0x39, 0xb4, 0x6b, 0x79, 0x4b, 0xb6, 0x1d, 0x40,
+	0x0d, 0xcc, 0x74, 0xed, 0x6b, 0x22, 0xda, 0x24, 0x98, 0x22, 0xf8, 0x25, 0x98, 0x3a, 0x51, 0xb0,
+	0x81, 0xed, 0x2b, 0xa4, 0x06, 0xef, 0x02, 0xad, 0x07, 0xfc, 0x19, 0x0c, 0xea, 0x04, 0xdb, 0xab,
+	0xa4, 0xb1, 0xf3, 0x9e, 0x18, 0x70, 0x78, 0xc1, 0xe0, 0x57, 0x09, 0xdc, 0xef, 0xc1, 0x72, 0x09,
+	0x5f, 0x31, 0x1f, 0xb9, 0x0c, 0xb2, 0x91, 0xb7, 0xe2, 0x85, 0x1a, 0x79, 0x32, 0xad, 0x27, 0xe6,
+	0xd3, 0x23, 0xf3, 0xe9, 0xa9, 0x6d, 0xf4, 0x65, 0xe2, 0xe2, 0xf2, 0xcd, 0x37, 0x2d, 0x6d, 0xe0,
+	0xa8, 0xa5, 0x8d, 0x24, 0x0d, 0xa2, 0x49, 0xd0, 0x8c, 0xe7, 0x42, 0x1d, 0x3c, 0xba, 0x08, 0x5f,
+	0x21, 0xf0, 0x77, 0x09, 0xdc, 0xa9, 0x52, 0x27, 0x8a, 0x2d, 0x61, 0xfb, 0xff, 0xb9, 0xd0, 0x02,
+	0xd7, 0x22, 0x0e, 0x54, 0x19, 0x2c, 0x66, 0xce, 0x16, 0x30, 0x17, 0x09, 0xf8, 0xe5, 0x9d, 0x36,
+	0xeb, 0xb8, 0x6c, 0x3b, 0xac, 0xeb, 0x0d, 0xe2, 0x1b, 0xe9, 0x56, 0x4b, 0x7e, 0x1e, 0x53, 0x7b,
+	0xc7, 0x60, 0xfb, 0x4d, 0x44, 0xe3, 0x09, 0xd4, 0x4c, 0x90, 0xcf, 0xf2, 0xf3, 0x33, 0x70, 0xff,
+	0x2c, 0x21, 0x5c, 0xb1, 0x3c, 0x06, 0x06, 0x2b, 0x2b, 0xb1, 0x98, 0xac, 0x39, 0x58, 0x59, 0x81,
+	0x01, 0x50, 0xaa, 0xd4, 0xd9, 0xc0, 0x6b, 0x84, 0x78, 0x5f, 0x6c, 0xbb, 0x0c, 0x79, 0x2e, 0x65,
+	0xc8, 0x8e, 0x86, 0xfd, 0x88, 0x7f, 0x08, 0x86, 0x9a, 0x84, 0x78, 0x62, 0x55, 0xcb, 0xf2, 0x51,
+	0x4b, 0x1b, 0x4b, 0x6a, 0xd3, 0x04, 0x34, 0x73, 0xd1, 0x53, 0xc5, 0x86, 0x2f, 0x40, 0xb1, 0x57,
+	0x4f, 0xc1, 0xf3, 0x01, 0x18, 0x47, 0x7b, 0x2e, 0x43, 0x76, 0x2d, 0x75, 0x0b, 0x55, 0xa4, 0x62,
+	0x66, 0x36, 0x6b, 0x8e, 0x26, 0xe1, 0xd5, 0xd8, 0x34, 0x14, 0xfe, 0x2b, 0x81, 0x85, 0x18, 0xcc,
+	0x4b, 0xa4, 0x57, 0x5d, 0x27, 0xb0, 0x18, 0x7a, 0xb5, 0x6d, 0x05, 0x88, 0xae, 0x93, 0xcf, 0x42,
+	0xcf, 0x33, 0x2d, 0xec, 0xa0, 0x65, 0x82, 0x1b, 0x08, 0xb3, 0x28, 0x67, 0xaf, 0x11, 0xea, 0x46,
+	0xdf, 0xc1, 0x3e, 0x05, 0x9e, 0xb0, 0xed, 0x71, 0x81, 0x69, 0x02, 0x0a, 0x2b, 0x3b, 0x20, 0x4f,
+	0x63, 0x02, 0x35, 0x46, 0x6a, 0x7e, 0xc2, 0xe8, 0x7c, 0x5f, 0x17, 0x53, 0x5f, 0x2b, 0x29, 0x83,
+	0x4e, 0x04, 0x68, 0x8e, 0xd3, 0x54, 0x56, 0xaa, 0x12, 0xfe, 0x91, 0x05, 0x8b, 0x97, 0x55, 0x2f,
+	0x5e, 0xf5, 0x27, 0x60, 0xa4, 0x99, 0xc6, 0x22, 0x79, 0xb1, 0x37, 0xca, 0xb7, 0x8e, 0x5a, 0x9a,
+	0xcc, 0xd7, 0x4f, 0x24, 0xa1, 0x09, 0xf8, 0xa8, 0x62, 0xcb, 0x9b, 0x60, 0xc8, 0xf2, 0x49, 0x88,
+	0xd9, 0x5c, 0xfc, 0x4e, 0x86, 0xcb, 0x8b, 0x91, 0x82, 0xbf, 0x5a, 0xda, 0x83, 0x0b, 0x18, 0xbb,
+	0x82, 0x59, 0xfb, 0x0d, 0xa6, 0x30, 0xd0, 0xe4, 0x80, 0x6d, 0xec, 0x92, 0x92, 0xb9, 0x0a, 0xec,
+	0x92, 0xc0, 0x2e, 0xc9, 0xdf, 0x80, 0xbc, 0xe7, 0x7e, 0x1d, 0xba, 0xb6, 0xcb, 0xf6, 0x6b, 0x8d,
+	0x00, 0x45, 0x6f, 0x45, 0xc9, 0xc6, 0x5d, 0x5e, 0xf4, 0xd1, 0x65, 0x05, 0x35, 0xda, 0xab, 0x75,
+	0x0a, 0x10, 0x9a, 0x37, 0x44, 0x6c, 0x39, 0x09, 0xc9, 0x5f, 0x81, 0x0f, 0xb6, 0x02, 0xf2, 0x2d,
+	0xc2, 0xb5, 0x10, 0x33, 0xd7, 0x53, 0xae, 0xc5, 0x96, 0x50, 0xf5, 0xe4, 0x20, 0xd6, 0xf9, 0x41,
+	0xac, 0xaf, 0xf3, 0x83, 0xb8, 0xac, 0xa5, 0x9e, 0xb8, 0x99, 0x74, 0x39, 0x3e, 0x1b, 0xbe, 0x7e,
+	0xa7, 0x49, 0xe6, 0x48, 0x12, 0xda, 0x88, 0x22, 0xf2, 0x73, 0x90, 0x0f, 0x90, 0x6f, 0xb9, 0xd8,
+	0xc5, 0x0e, 0xdf, 0x37, 0x4a, 0x2e, 0x5e, 0xcf, 0x3b, 0x6d, 0xaa, 0xa7, 0x4a, 0xa0, 0x39, 0x2e,
+	0x62, 0xc9, 0xbe, 0x82, 0x3f, 0x65, 0xc1, 0x70, 0x95, 0x3a, 0x9b, 0x56, 0xf3, 0x65, 0xc8, 0xfa,
+	0xd9, 0x27, 0xcf, 0x41, 0x2e, 0x31, 0xa9, 0x32, 0x78, 0x9e, 0xdf, 0x27, 0x53, 0x6d, 0xa3, 0xc7,
+	0xfd, 0x1e, 0x21, 0xc5, 0x0f, 0x9d, 0xb6, 0xcc, 0x5c, 0xd8, 0x96, 0xc7, 0xb6, 0x6a, 0xf6, 0xdc,
+	0xad, 0x5a, 0x06, 0xe3, 0x8c, 0xec, 0x20, 0x5c, 0x23, 0x21, 0xab, 0xd9, 0x08, 0x13, 0x3f, 0x5e,
+	0x95, 0xe1, 0xb2, 0x7a, 0xd4, 0xd2, 0x6e, 0x25, 0x93, 0x3a, 0x0a, 0xa0, 0x39, 0x1a, 0x47, 0x5e,
+	0x86, 0x6c, 0x25, 0x1a, 0xcb, 0x3b, 0x1c, 0xc3, 0xc5, 0xb5, 0x80, 0x84, 0x0c, 0x51, 0x25, 0x17,
+	0x9f, 0x01, 0xf7, 0x74, 0x7e, 0x59, 0x8b, 0x2e, 0x3f, 0x42, 0xfd, 0x7a, 0x54, 0x5c, 0xc1, 0x66,
+	0x5c, 0x5a, 0x2e, 0xa4, 0xaf, 0xe1, 0x44, 0x33, 0x81, 0xc4, 0x9b, 0xf1, 0x72, 0xf9, 0x7b, 0x30,
+	0xd1, 0xe6, 0xe3, 0xbb, 0xb8, 0x96, 0xb8, 0x5a, 0x19, 0x8a, 0x59, 0x57, 0xfb, 0xde, 0x25, 0xb7,
+	0x3b, 0x35, 0xb6, 0x31, 0xa1, 0x99, 0xe7, 0x42, 0xab, 0x2e, 0x5e, 0x4a, 0x62, 0x3f, 0x4a, 0x20,
+	0x2f, 0x9c, 0x21, 0xbe, 0x21, 0x14, 0xdc, 0x68, 0x23, 0xa4, 0x8c, 0x12, 0xaf, 0x54, 0xfa, 0x66,
+	0x34, 0xd5, 0xc9, 0x88, 0xb3, 0x19, 0xe3, 0x6c, 0x12, 0x2a, 0x4f, 0x7e, 0xbb, 0x0e, 0x32, 0x55,
+	0xea, 0xc8, 0x01, 0x90, 0xbb, 0x1d, 0xdd, 0xfa, 0xe9, 0x9b, 0xb2, 0xde, 0xf5, 0xce, 0xa7, 0x96,
+	0x2e, 0x5c, 0x2a, 0x04, 0xef, 0x81, 0x89, 0xae, 0x77, 0xc3, 0x87, 0xe7, 0x42, 0xb5, 0x8b, 0xd5,
+	0xf9, 0x3e, 0x8a, 0x7b, 0x75, 0x16, 0x77, 0xb0, 0x8b, 0x74, 0xe6, 0xc5, 0xea, 0x7c, 0x1f, 0xc5,
+	0xa2, 0xf3, 0xcf, 0x12, 0xb8, 0x7b, 0xfe, 0x5d, 0x70, 0xa1, 0x0f, 0x51, 0x27, 0x66, 0xaa, 0x8b,
+	0x97, 0x9d, 0x29, 0x18, 0xfe, 0x20, 0x81, 0xe9, 0xde, 0x97, 0xb9, 0xb9, 0x1e, 0xf8, 0x3d, 0x67,
+	0xa8, 0x0b, 0xfd, 0xce, 0x10, 0x4c, 0xbe, 0x03, 0x93, 0xdd, 0x2f, 0x55, 0x8f, 0x7a, 0x40, 0x76,
+	0xad, 0x56, 0x9f, 0xf6, 0x53, 0x2d, 0x9a, 0xff, 0x2d, 0x81, 0x8f, 0x2f, 0x77, 0x23, 0x5a, 0xed,
+	0xd9, 0xef, 0x12, 0x68, 0xea, 0xfa, 0x55, 0xa2, 0x09, 0x75, 0x9f, 0x83, 0x5c, 0x7a, 0x2e, 0xcd,
+	0xf4, 0xc0, 0x4f, 0xd2, 0xea, 0x87, 0x67, 0xa6, 0x39, 0x5e, 0x79, 0xed, 0xcd, 0x41, 0x41, 0x7a,
+	0x7b, 0x50, 0x90, 0xfe, 0x39, 0x28, 0x48, 0xaf, 0x0f, 0x0b, 0x03, 0x6f, 0x0f, 0x0b, 0x03, 0x7f,
+	0x1e, 0x16, 0x06, 0x36, 0x9f, 0x1d, 0xfb, 0x66, 0xa5, 0x50, 0x8f, 0x3d, 0xab, 0x4e, 0xf9, 0xc0,
+	0xd8, 0x2d, 0x3d, 0x35, 0xf6, 0x4e, 0xfc, 0x4d, 0x8f, 0xbe, 0x63, 0xf5, 0x5c, 0x7c, 0x92, 0xcf,
+	0xff, 0x37, 0x00, 0x70, 0x0a, 0x75, 0xf6, 0xc9, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -703,6 +1010,12 @@ type MsgClient interface {
 	// Execute lockup lock and superfluid delegation in a single msg
 	LockAndSuperfluidDelegate(ctx context.Context, in *MsgLockAndSuperfluidDelegate, opts ...grpc.CallOption) (*MsgLockAndSuperfluidDelegateResponse, error)
 	UnPoolWhitelistedPool(ctx context.Context, in *MsgUnPoolWhitelistedPool, opts ...grpc.CallOption) (*MsgUnPoolWhitelistedPoolResponse, error)
+	// Break a lock, including a superfluid one, and migrate its gamm shares to a
+	// full range concentrated liquidity position.
+	UnlockAndMigrateSharesToFullRangeConcentratedPosition(ctx context.Context, in *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition, opts ...grpc.CallOption) (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse, error)
+	// Exit gamm shares, a concentrated liquidity position or a matured lock of
+	// gamm shares, and swap every exited token into a single denom.
+	ZapOut(ctx context.Context, in *MsgZapOut, opts ...grpc.CallOption) (*MsgZapOutResponse, error)
 }
 
 type msgClient struct {
@@ -767,6 +1080,24 @@ func (c *msgClient) UnPoolWhitelistedPool(ctx context.Context, in *MsgUnPoolWhit
 	return out, nil
 }
 
+func (c *msgClient) UnlockAndMigrateSharesToFullRangeConcentratedPosition(ctx context.Context, in *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition, opts ...grpc.CallOption) (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse, error) {
+	out := new(MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/UnlockAndMigrateSharesToFullRangeConcentratedPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ZapOut(ctx context.Context, in *MsgZapOut, opts ...grpc.CallOption) (*MsgZapOutResponse, error) {
+	out := new(MsgZapOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/ZapOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Execute superfluid delegation for a lockup
@@ -781,6 +1112,12 @@ type MsgServer interface {
 	// Execute lockup lock and superfluid delegation in a single msg
 	LockAndSuperfluidDelegate(context.Context, *MsgLockAndSuperfluidDelegate) (*MsgLockAndSuperfluidDelegateResponse, error)
 	UnPoolWhitelistedPool(context.Context, *MsgUnPoolWhitelistedPool) (*MsgUnPoolWhitelistedPoolResponse, error)
+	// Break a lock, including a superfluid one, and migrate its gamm shares to a
+	// full range concentrated liquidity position.
+	UnlockAndMigrateSharesToFullRangeConcentratedPosition(context.Context, *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse, error)
+	// Exit gamm shares, a concentrated liquidity position or a matured lock of
+	// gamm shares, and swap every exited token into a single denom.
+	ZapOut(context.Context, *MsgZapOut) (*MsgZapOutResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -805,6 +1142,12 @@ func (*UnimplementedMsgServer) LockAndSuperfluidDelegate(ctx context.Context, re
 func (*UnimplementedMsgServer) UnPoolWhitelistedPool(ctx context.Context, req *MsgUnPoolWhitelistedPool) (*MsgUnPoolWhitelistedPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnPoolWhitelistedPool not implemented")
 }
+func (*UnimplementedMsgServer) UnlockAndMigrateSharesToFullRangeConcentratedPosition(ctx context.Context, req *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockAndMigrateSharesToFullRangeConcentratedPosition not implemented")
+}
+func (*UnimplementedMsgServer) ZapOut(ctx context.Context, req *MsgZapOut) (*MsgZapOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZapOut not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -918,6 +1261,42 @@ func _Msg_UnPoolWhitelistedPool_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UnlockAndMigrateSharesToFullRangeConcentratedPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlockAndMigrateSharesToFullRangeConcentratedPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/UnlockAndMigrateSharesToFullRangeConcentratedPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlockAndMigrateSharesToFullRangeConcentratedPosition(ctx, req.(*MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ZapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgZapOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ZapOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/ZapOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ZapOut(ctx, req.(*MsgZapOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.superfluid.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -946,6 +1325,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnPoolWhitelistedPool",
 			Handler:    _Msg_UnPoolWhitelistedPool_Handler,
 		},
+		{
+			MethodName: "UnlockAndMigrateSharesToFullRangeConcentratedPosition",
+			Handler:    _Msg_UnlockAndMigrateSharesToFullRangeConcentratedPosition_Handler,
+		},
+		{
+			MethodName: "ZapOut",
+			Handler:    _Msg_ZapOut_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/superfluid/tx.proto",
@@ -1355,44 +1742,274 @@ func (m *MsgUnPoolWhitelistedPoolResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSuperfluidDelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.SharesToMigrate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.LockId != 0 {
-		n += 1 + sovTx(uint64(m.LockId))
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ValAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSuperfluidDelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingLockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RemainingLockId))
+		i--
+		dAtA[i] = 0x30
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FrozenUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FrozenUntil):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LiquidityCreated.Size()
+		i -= size
+		if _, err := m.LiquidityCreated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgZapOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgZapOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgZapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.TokenInRoutes) > 0 {
+		for iNdEx := len(m.TokenInRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenInRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgZapOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgZapOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgZapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSuperfluidDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *MsgSuperfluidUndelegate) Size() (n int) {
@@ -1539,6 +2156,91 @@ func (m *MsgUnPoolWhitelistedPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = m.SharesToMigrate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.Amount0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidityCreated.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FrozenUntil)
+	n += 1 + l + sovTx(uint64(l))
+	if m.RemainingLockId != 0 {
+		n += 1 + sovTx(uint64(m.RemainingLockId))
+	}
+	return n
+}
+
+func (m *MsgZapOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TokenInRoutes) > 0 {
+		for _, e := range m.TokenInRoutes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgZapOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2658,6 +3360,700 @@ func (m *MsgUnPoolWhitelistedPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesToMigrate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesToMigrate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCreated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityCreated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FrozenUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingLockId", wireType)
+			}
+			m.RemainingLockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingLockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgZapOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgZapOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgZapOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInRoutes = append(m.TokenInRoutes, types2.TokenInRoutes{})
+			if err := m.TokenInRoutes[len(m.TokenInRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgZapOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgZapOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgZapOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package twap
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -78,8 +80,142 @@ func (k Keeper) GetGeometricTwapToNow(
 	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetGeometricStrategy())
 }
 
+// GetHarmonicMeanTwap returns a harmonic mean time weighted average price.
+// The harmonic mean weighs low prices more heavily than the arithmetic mean,
+// making it resistant to manipulation of the price upwards.
+// See GetArithmeticTwap for the semantics of the arguments and the error cases.
+func (k Keeper) GetHarmonicMeanTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetHarmonicStrategy())
+}
+
+// GetHarmonicMeanTwapToNow returns harmonic mean twap from start time until the current block time for quote and base
+// assets in a given pool.
+func (k Keeper) GetHarmonicMeanTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetHarmonicStrategy())
+}
+
+// GetVolatility returns the realized volatility of the spot price of the base and quote assets
+// in a given pool from start time until end time. It is the standard deviation of the
+// natural logarithm of the spot price over the window and does not depend on which asset is the quote.
+// See GetArithmeticTwap for the semantics of the arguments and the error cases.
+func (k Keeper) GetVolatility(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetVolatilityStrategy())
+}
+
+// GetVolatilityToNow returns the realized volatility from start time until the current block time for quote and base
+// assets in a given pool.
+func (k Keeper) GetVolatilityToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetVolatilityStrategy())
+}
+
+// GetTwapByType returns the time weighted average price of the given type.
+// It has the same semantics and error cases as GetArithmeticTwap, GetGeometricTwap
+// and GetHarmonicMeanTwap, and additionally errors if the twap type is unknown.
+func (k Keeper) GetTwapByType(
+	ctx sdk.Context,
+	twapType types.TwapType,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	strategy, err := k.getStrategyByType(twapType)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, strategy)
+}
+
+// GetLiquidityWeightedTwap returns the time weighted average price of the given type
+// of the base asset in units of the quote asset, aggregated across the given pools.
+// The twap of every pool is weighted by the amount of the quote asset backing swaps
+// against the pool at the current block. For concentrated liquidity pools, only the
+// liquidity in range of the current price is counted, see the poolmanager's GetActiveLiquidityReserves.
+//
+// This function will error if:
+// * no pools, more than types.MaxAggregatedTwapPools pools, or duplicate pools are given
+// * the twap of any of the pools errors, see GetTwapByType
+// * none of the pools have any active liquidity of the quote asset
+func (k Keeper) GetLiquidityWeightedTwap(
+	ctx sdk.Context,
+	twapType types.TwapType,
+	poolIds []uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	if len(poolIds) == 0 {
+		return sdk.Dec{}, errors.New("no pools to aggregate twaps across")
+	}
+	if len(poolIds) > types.MaxAggregatedTwapPools {
+		return sdk.Dec{}, fmt.Errorf("too many pools to aggregate twaps across, max: %d, got: %d", types.MaxAggregatedTwapPools, len(poolIds))
+	}
+
+	strategy, err := k.getStrategyByType(twapType)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	seenPoolIds := make(map[uint64]bool, len(poolIds))
+	weightedTwapSum := sdk.ZeroDec()
+	totalWeight := sdk.ZeroInt()
+	for _, poolId := range poolIds {
+		if seenPoolIds[poolId] {
+			return sdk.Dec{}, fmt.Errorf("duplicate pool id %d", poolId)
+		}
+		seenPoolIds[poolId] = true
+
+		twap, err := k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, strategy)
+		if err != nil {
+			return sdk.Dec{}, fmt.Errorf("twap of pool %d: %w", poolId, err)
+		}
+
+		liquidity, err := k.ammkeeper.GetActiveLiquidityReserves(ctx, poolId)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		weight := liquidity.AmountOf(quoteAssetDenom)
+
+		weightedTwapSum = weightedTwapSum.Add(twap.MulInt(weight))
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	if totalWeight.IsZero() {
+		return sdk.Dec{}, types.NoQuoteAssetLiquidityError{PoolIds: poolIds, QuoteAsset: quoteAssetDenom}
+	}
+	return weightedTwapSum.QuoInt(totalWeight), nil
+}
+
 // getTwap computes and returns twap from the start time until the end time. The type
-// of twap returned depends on the strategy given and can be either arithmetic or geometric.
+// of twap returned depends on the strategy given and can be arithmetic, geometric, harmonic or volatility.
 func (k Keeper) getTwap(
 	ctx sdk.Context,
 	poolId uint64,
@@ -110,7 +246,7 @@ func (k Keeper) getTwap(
 }
 
 // getTwapToNow computes and returns twap from the start time until the current block time. The type
-// of twap returned depends on the strategy given and can be either arithmetic or geometric.
+// of twap returned depends on the strategy given and can be arithmetic, geometric, harmonic or volatility.
 func (k Keeper) getTwapToNow(
 	ctx sdk.Context,
 	poolId uint64,
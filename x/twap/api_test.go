@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v14/app/apptesting"
 	sdkrand "github.com/osmosis-labs/osmosis/v14/simulation/simtypes/random"
 	"github.com/osmosis-labs/osmosis/v14/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v14/x/twap"
@@ -112,7 +113,7 @@ func (s *TestSuite) TestGetBeginBlockAccumulatorRecord() {
 		"idempotent overwrite2":                             {initStartRecord, recordWithUpdatedAccum(initStartRecord, OneSec, OneSec, sdk.ZeroDec()), tPlusOne, 1, denomA, denomB, nil},
 		"diff spot price": {
 			zeroAccumTenPoint1Record,
-			recordWithUpdatedAccum(zeroAccumTenPoint1Record, OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum),
+			withSquaredLogAccum(recordWithUpdatedAccum(zeroAccumTenPoint1Record, OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum), squaredLogTenSecAccum),
 			tPlusOne, 1, denomA, denomB, nil,
 		},
 	}
@@ -913,3 +914,138 @@ func (s *TestSuite) TestGeometricTwapToNow_BalancerPool_Randomized() {
 		})
 	}
 }
+
+func (s *TestSuite) TestGetTwapByType() {
+	// spot price of denom0 in denom1 is 2.
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 1_000_000_000), sdk.NewInt64Coin(denom1, 2_000_000_000))
+	startTime := s.Ctx.BlockTime()
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+
+	tests := map[string]struct {
+		twapType    types.TwapType
+		expectedErr error
+	}{
+		"arithmetic":    {twapType: types.TwapTypeArithmetic},
+		"geometric":     {twapType: types.TwapTypeGeometric},
+		"harmonic mean": {twapType: types.TwapTypeHarmonicMean},
+		"unknown type":  {twapType: types.TwapType(3), expectedErr: types.UnknownTwapTypeError{TwapType: 3}},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			twap, err := s.twapkeeper.GetTwapByType(s.Ctx, test.twapType, poolId, denom0, denom1, startTime, s.Ctx.BlockTime())
+			if test.expectedErr != nil {
+				s.Require().ErrorIs(err, test.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewDec(2), twap)
+		})
+	}
+}
+
+func (s *TestSuite) TestGetLiquidityWeightedTwap() {
+	// spot price of denom0 in denom1 is 2 in the first pool and 4 in the second.
+	poolIdA := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 1_000_000_000), sdk.NewInt64Coin(denom1, 2_000_000_000))
+	poolIdB := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 1_000_000_000), sdk.NewInt64Coin(denom1, 4_000_000_000))
+	poolIdOtherPair := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 1_000_000_000), sdk.NewInt64Coin(denom2, 1_000_000_000))
+	startTime := s.Ctx.BlockTime()
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+
+	tooManyPoolIds := make([]uint64, types.MaxAggregatedTwapPools+1)
+	for i := range tooManyPoolIds {
+		tooManyPoolIds[i] = uint64(i + 1)
+	}
+
+	tests := map[string]struct {
+		twapType     types.TwapType
+		poolIds      []uint64
+		expectedTwap sdk.Dec
+		expectErr    bool
+	}{
+		"single pool": {
+			poolIds:      []uint64{poolIdA},
+			expectedTwap: sdk.NewDec(2),
+		},
+		"two pools, weighted by quote asset liquidity": {
+			// (2 * 2_000_000_000 + 4 * 4_000_000_000) / 6_000_000_000
+			poolIds:      []uint64{poolIdA, poolIdB},
+			expectedTwap: sdk.MustNewDecFromStr("3.333333333333333333"),
+		},
+		"geometric": {
+			twapType:     types.TwapTypeGeometric,
+			poolIds:      []uint64{poolIdB, poolIdA},
+			expectedTwap: sdk.MustNewDecFromStr("3.333333333333333333"),
+		},
+		"no pools": {
+			poolIds:   []uint64{},
+			expectErr: true,
+		},
+		"too many pools": {
+			poolIds:   tooManyPoolIds,
+			expectErr: true,
+		},
+		"duplicate pools": {
+			poolIds:   []uint64{poolIdA, poolIdA},
+			expectErr: true,
+		},
+		"pool without the pair": {
+			poolIds:   []uint64{poolIdA, poolIdOtherPair},
+			expectErr: true,
+		},
+		"non-existent pool": {
+			poolIds:   []uint64{poolIdA, 100},
+			expectErr: true,
+		},
+		"unknown twap type": {
+			twapType:  types.TwapType(3),
+			poolIds:   []uint64{poolIdA},
+			expectErr: true,
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			twap, err := s.twapkeeper.GetLiquidityWeightedTwap(s.Ctx, test.twapType, test.poolIds, denom0, denom1, startTime, s.Ctx.BlockTime())
+			if test.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedTwap, twap)
+		})
+	}
+}
+
+// TestGetLiquidityWeightedTwap_Concentrated tests that a concentrated liquidity pool is weighted by the liquidity
+// in range of its current price, rather than by all of the quote asset it holds.
+func (s *TestSuite) TestGetLiquidityWeightedTwap_Concentrated() {
+	balancerPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(apptesting.ETH, 1_000_000), sdk.NewInt64Coin(apptesting.USDC, 4_000_000_000))
+	clPool := s.PrepareConcentratedPool()
+	s.createFullRangePosition(clPool, sdk.NewInt(1_000_000), sdk.NewInt(5_000_000_000))
+	// a position below the current price only holds usdc, that does not back swaps at the current price.
+	currentTick := s.getConcentratedPool(clPool.GetId()).GetCurrentTick().Int64()
+	s.createPosition(clPool, sdk.ZeroInt(), sdk.NewInt(100_000_000_000), currentTick-20000, currentTick-10000)
+	startTime := s.Ctx.BlockTime()
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+
+	poolIds := []uint64{balancerPoolId, clPool.GetId()}
+	weightedTwapSum, totalWeight := sdk.ZeroDec(), sdk.ZeroInt()
+	for _, poolId := range poolIds {
+		twap, err := s.twapkeeper.GetArithmeticTwap(s.Ctx, poolId, apptesting.ETH, apptesting.USDC, startTime, s.Ctx.BlockTime())
+		s.Require().NoError(err)
+		reserves, err := s.App.PoolManagerKeeper.GetActiveLiquidityReserves(s.Ctx, poolId)
+		s.Require().NoError(err)
+		weightedTwapSum = weightedTwapSum.Add(twap.MulInt(reserves.AmountOf(apptesting.USDC)))
+		totalWeight = totalWeight.Add(reserves.AmountOf(apptesting.USDC))
+	}
+
+	// the concentrated liquidity pool is weighted by about the 5_000_000_000 usdc of its full range position.
+	clReserves, err := s.App.PoolManagerKeeper.GetActiveLiquidityReserves(s.Ctx, clPool.GetId())
+	s.Require().NoError(err)
+	s.Require().True(clReserves.AmountOf(apptesting.USDC).LT(sdk.NewInt(5_100_000_000)), "cl pool weight %s", clReserves)
+	clBalance := s.App.BankKeeper.GetBalance(s.Ctx, clPool.GetAddress(), apptesting.USDC)
+	s.Require().True(clBalance.Amount.GT(sdk.NewInt(100_000_000_000)))
+
+	twap, err := s.twapkeeper.GetLiquidityWeightedTwap(s.Ctx, types.TwapTypeArithmetic, poolIds, apptesting.ETH, apptesting.USDC, startTime, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().Equal(weightedTwapSum.QuoInt(totalWeight), twap)
+}
@@ -20,6 +20,26 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) VolatilityToNow(grpcCtx context.Context,
+	req *queryproto.VolatilityToNowRequest,
+) (*queryproto.VolatilityToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.VolatilityToNow(ctx, *req)
+}
+
+func (q Querier) Volatility(grpcCtx context.Context,
+	req *queryproto.VolatilityRequest,
+) (*queryproto.VolatilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Volatility(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -30,6 +50,26 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) HarmonicMeanTwapToNow(grpcCtx context.Context,
+	req *queryproto.HarmonicMeanTwapToNowRequest,
+) (*queryproto.HarmonicMeanTwapToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.HarmonicMeanTwapToNow(ctx, *req)
+}
+
+func (q Querier) HarmonicMeanTwap(grpcCtx context.Context,
+	req *queryproto.HarmonicMeanTwapRequest,
+) (*queryproto.HarmonicMeanTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.HarmonicMeanTwap(ctx, *req)
+}
+
 func (q Querier) GeometricTwapToNow(grpcCtx context.Context,
 	req *queryproto.GeometricTwapToNowRequest,
 ) (*queryproto.GeometricTwapToNowResponse, error) {
@@ -50,6 +90,16 @@ func (q Querier) GeometricTwap(grpcCtx context.Context,
 	return q.Q.GeometricTwap(ctx, *req)
 }
 
+func (q Querier) BatchTwap(grpcCtx context.Context,
+	req *queryproto.BatchTwapRequest,
+) (*queryproto.BatchTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.BatchTwap(ctx, *req)
+}
+
 func (q Querier) ArithmeticTwapToNow(grpcCtx context.Context,
 	req *queryproto.ArithmeticTwapToNowRequest,
 ) (*queryproto.ArithmeticTwapToNowResponse, error) {
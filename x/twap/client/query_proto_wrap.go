@@ -7,6 +7,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v14/x/twap"
 	"github.com/osmosis-labs/osmosis/v14/x/twap/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v14/x/twap/types"
 )
 
 // This file should evolve to being code gen'd, off of `proto/twap/v1beta/query.yml`
@@ -61,6 +62,99 @@ func (q Querier) GeometricTwapToNow(ctx sdk.Context,
 	return &queryproto.GeometricTwapToNowResponse{GeometricTwap: twap}, err
 }
 
+func (q Querier) HarmonicMeanTwap(ctx sdk.Context,
+	req queryproto.HarmonicMeanTwapRequest,
+) (*queryproto.HarmonicMeanTwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetHarmonicMeanTwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.HarmonicMeanTwapResponse{HarmonicMeanTwap: twap}, err
+}
+
+func (q Querier) HarmonicMeanTwapToNow(ctx sdk.Context,
+	req queryproto.HarmonicMeanTwapToNowRequest,
+) (*queryproto.HarmonicMeanTwapToNowResponse, error) {
+	twap, err := q.K.GetHarmonicMeanTwapToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+
+	return &queryproto.HarmonicMeanTwapToNowResponse{HarmonicMeanTwap: twap}, err
+}
+
+func (q Querier) Volatility(ctx sdk.Context,
+	req queryproto.VolatilityRequest,
+) (*queryproto.VolatilityResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	volatility, err := q.K.GetVolatility(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.VolatilityResponse{Volatility: volatility}, err
+}
+
+func (q Querier) VolatilityToNow(ctx sdk.Context,
+	req queryproto.VolatilityToNowRequest,
+) (*queryproto.VolatilityToNowResponse, error) {
+	volatility, err := q.K.GetVolatilityToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+
+	return &queryproto.VolatilityToNowResponse{Volatility: volatility}, err
+}
+
+func (q Querier) BatchTwap(ctx sdk.Context,
+	req queryproto.BatchTwapRequest,
+) (*queryproto.BatchTwapResponse, error) {
+	numQueries := len(req.Queries) + len(req.AggregatedQueries)
+	if numQueries > types.MaxBatchTwapQueries {
+		return nil, types.TooManyTwapQueriesError{Actual: numQueries, Max: types.MaxBatchTwapQueries}
+	}
+
+	results := make([]queryproto.TwapResult, 0, len(req.Queries))
+	for _, query := range req.Queries {
+		endTime := endTimeOrBlockTime(ctx, query.EndTime)
+		twap, err := q.K.GetTwapByType(ctx, req.TwapType, query.PoolId, query.BaseAsset, query.QuoteAsset, query.StartTime, endTime)
+		results = append(results, newTwapResult(twap, err))
+	}
+
+	aggregatedResults := make([]queryproto.TwapResult, 0, len(req.AggregatedQueries))
+	for _, query := range req.AggregatedQueries {
+		endTime := endTimeOrBlockTime(ctx, query.EndTime)
+		twap, err := q.K.GetLiquidityWeightedTwap(ctx, req.TwapType, query.PoolIds, query.BaseAsset, query.QuoteAsset, query.StartTime, endTime)
+		aggregatedResults = append(aggregatedResults, newTwapResult(twap, err))
+	}
+
+	return &queryproto.BatchTwapResponse{Results: results, AggregatedResults: aggregatedResults}, nil
+}
+
+// endTimeOrBlockTime returns the given end time, or the block time if it is not set.
+func endTimeOrBlockTime(ctx sdk.Context, endTime *time.Time) time.Time {
+	if endTime == nil || (*endTime == time.Time{}) {
+		return ctx.BlockTime()
+	}
+	return *endTime
+}
+
+// newTwapResult returns the result of a single query of a batched twap query.
+// The twap is returned alongside the error, as a twap can be computed despite
+// spot price errors within its time range.
+func newTwapResult(twap sdk.Dec, err error) queryproto.TwapResult {
+	if twap.IsNil() {
+		twap = sdk.ZeroDec()
+	}
+	result := queryproto.TwapResult{Twap: twap}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
 func (q Querier) Params(ctx sdk.Context,
 	req queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
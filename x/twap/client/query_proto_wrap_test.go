@@ -12,6 +12,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v14/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v14/x/twap/client"
 	"github.com/osmosis-labs/osmosis/v14/x/twap/client/queryproto"
+	twaptypes "github.com/osmosis-labs/osmosis/v14/x/twap/types"
 )
 
 type QueryTestSuite struct {
@@ -235,5 +236,132 @@ func (suite *QueryTestSuite) TestQueryTwap() {
 				suite.Require().Equal(result.GeometricTwap.String(), resultToNow.GeometricTwap.String())
 			}
 		})
+
+		suite.Run(tc.name+" harmonic", func() {
+			client := client.Querier{K: *suite.App.TwapKeeper}
+
+			startTime := validStartTime
+			if tc.startTimeOverwrite != nil {
+				startTime = *tc.startTimeOverwrite
+			}
+
+			result, err := client.HarmonicMeanTwap(ctx, queryproto.HarmonicMeanTwapRequest{
+				PoolId:     tc.poolId,
+				BaseAsset:  tc.baseAssetDenom,
+				QuoteAsset: tc.quoteAssetDenom,
+				StartTime:  startTime,
+				EndTime:    tc.endTime,
+			})
+
+			if tc.expectErr {
+				suite.Require().Error(err, "expected error - HarmonicMeanTwap")
+			} else {
+				suite.Require().NoError(err, "unexpected error - HarmonicMeanTwap")
+				suite.Require().Equal(tc.result, result.HarmonicMeanTwap.String())
+			}
+
+			resultToNow, err := client.HarmonicMeanTwapToNow(ctx, queryproto.HarmonicMeanTwapToNowRequest{
+				PoolId:     tc.poolId,
+				BaseAsset:  tc.baseAssetDenom,
+				QuoteAsset: tc.quoteAssetDenom,
+				StartTime:  startTime,
+			})
+
+			if tc.expectErr {
+				suite.Require().Error(err, "expected error - HarmonicMeanTwapToNow")
+			} else {
+				suite.Require().NoError(err, "unexpected error - HarmonicMeanTwapToNow")
+				suite.Require().Equal(tc.result, resultToNow.HarmonicMeanTwap.String())
+			}
+		})
+
+		suite.Run(tc.name+" volatility", func() {
+			client := client.Querier{K: *suite.App.TwapKeeper}
+
+			startTime := validStartTime
+			if tc.startTimeOverwrite != nil {
+				startTime = *tc.startTimeOverwrite
+			}
+
+			result, err := client.Volatility(ctx, queryproto.VolatilityRequest{
+				PoolId:     tc.poolId,
+				BaseAsset:  tc.baseAssetDenom,
+				QuoteAsset: tc.quoteAssetDenom,
+				StartTime:  startTime,
+				EndTime:    tc.endTime,
+			})
+
+			// The spot price does not change, so there is no volatility.
+			if tc.expectErr {
+				suite.Require().Error(err, "expected error - Volatility")
+			} else {
+				suite.Require().NoError(err, "unexpected error - Volatility")
+				suite.Require().Equal(sdk.ZeroDec().String(), result.Volatility.String())
+			}
+
+			resultToNow, err := client.VolatilityToNow(ctx, queryproto.VolatilityToNowRequest{
+				PoolId:     tc.poolId,
+				BaseAsset:  tc.baseAssetDenom,
+				QuoteAsset: tc.quoteAssetDenom,
+				StartTime:  startTime,
+			})
+
+			if tc.expectErr {
+				suite.Require().Error(err, "expected error - VolatilityToNow")
+			} else {
+				suite.Require().NoError(err, "unexpected error - VolatilityToNow")
+				suite.Require().Equal(sdk.ZeroDec().String(), resultToNow.Volatility.String())
+			}
+		})
 	}
 }
+
+func (suite *QueryTestSuite) TestQueryBatchTwap() {
+	suite.SetupTest()
+
+	var (
+		poolIdA = suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("tokenA", 1000), sdk.NewInt64Coin("tokenB", 2000))
+		poolIdB = suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("tokenA", 1000), sdk.NewInt64Coin("tokenB", 4000))
+
+		startTime    = suite.Ctx.BlockTime()
+		newBlockTime = startTime.Add(time.Hour)
+		endTime      = startTime.Add(time.Minute)
+		futureTime   = newBlockTime.Add(time.Hour)
+
+		ctx    = suite.Ctx.WithBlockTime(newBlockTime)
+		client = client.Querier{K: *suite.App.TwapKeeper}
+	)
+
+	result, err := client.BatchTwap(ctx, queryproto.BatchTwapRequest{
+		TwapType: twaptypes.TwapTypeArithmetic,
+		Queries: []queryproto.TwapQuery{
+			{PoolId: poolIdA, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime},
+			{PoolId: poolIdB, BaseAsset: "tokenB", QuoteAsset: "tokenA", StartTime: startTime, EndTime: &endTime},
+			{PoolId: poolIdA, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime, EndTime: &futureTime},
+			{PoolId: 100, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime},
+		},
+		AggregatedQueries: []queryproto.AggregatedTwapQuery{
+			{PoolIds: []uint64{poolIdA, poolIdB}, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime},
+			{PoolIds: []uint64{poolIdA, poolIdA}, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime},
+		},
+	})
+	suite.Require().NoError(err)
+
+	// Each query carries its own result and error.
+	suite.Require().Len(result.Results, 4)
+	suite.Require().Equal(queryproto.TwapResult{Twap: sdk.NewDec(2)}, result.Results[0])
+	suite.Require().Equal(queryproto.TwapResult{Twap: sdk.MustNewDecFromStr("0.25")}, result.Results[1])
+	suite.Require().NotEmpty(result.Results[2].Error)
+	suite.Require().NotEmpty(result.Results[3].Error)
+
+	// (2 * 2000 + 4 * 4000) / 6000
+	suite.Require().Len(result.AggregatedResults, 2)
+	suite.Require().Equal(queryproto.TwapResult{Twap: sdk.MustNewDecFromStr("3.333333333333333333")}, result.AggregatedResults[0])
+	suite.Require().NotEmpty(result.AggregatedResults[1].Error)
+
+	// The batch is rejected as a whole if it is too large.
+	_, err = client.BatchTwap(ctx, queryproto.BatchTwapRequest{
+		Queries: make([]queryproto.TwapQuery, twaptypes.MaxBatchTwapQueries+1),
+	})
+	suite.Require().Error(err)
+}
@@ -14,6 +14,8 @@ type (
 	TwapStrategy           = twapStrategy
 	ArithmeticTwapStrategy = arithmetic
 	GeometricTwapStrategy  = geometric
+	HarmonicTwapStrategy   = harmonic
+	VolatilityStrategy     = volatility
 )
 
 func (k Keeper) StoreNewRecord(ctx sdk.Context, record types.TwapRecord) {
@@ -36,6 +38,14 @@ func (k Keeper) GetAllHistoricalTimeIndexedTWAPs(ctx sdk.Context) ([]types.TwapR
 	return k.getAllHistoricalTimeIndexedTWAPs(ctx)
 }
 
+func (k Keeper) StoreArchivedRecord(ctx sdk.Context, record types.TwapRecord) {
+	k.storeArchivedRecord(ctx, record)
+}
+
+func (k Keeper) GetAllArchivedTimeIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
+	return k.getAllArchivedTimeIndexedTWAPs(ctx)
+}
+
 func (k Keeper) GetAllHistoricalPoolIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
 	return k.getAllHistoricalPoolIndexedTWAPs(ctx)
 }
@@ -56,8 +66,8 @@ func (k Keeper) UpdateRecords(ctx sdk.Context, poolId uint64) error {
 	return k.updateRecords(ctx, poolId)
 }
 
-func (k Keeper) PruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time) error {
-	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime)
+func (k Keeper) PruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time, poolLastKeptTimes map[uint64]time.Time) error {
+	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime, poolLastKeptTimes)
 }
 
 func (k Keeper) PruneRecords(ctx sdk.Context) error {
@@ -80,6 +90,14 @@ func (gs geometric) ComputeTwap(startRecord types.TwapRecord, endRecord types.Tw
 	return gs.computeTwap(startRecord, endRecord, quoteAsset)
 }
 
+func (hs harmonic) ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
+	return hs.computeTwap(startRecord, endRecord, quoteAsset)
+}
+
+func (vs volatility) ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
+	return vs.computeTwap(startRecord, endRecord, quoteAsset)
+}
+
 func RecordWithUpdatedAccumulators(record types.TwapRecord, t time.Time) types.TwapRecord {
 	return recordWithUpdatedAccumulators(record, t)
 }
@@ -48,6 +48,22 @@ func (k *Keeper) RecordHistoryKeepPeriod(ctx sdk.Context) time.Duration {
 	return k.GetParams(ctx).RecordHistoryKeepPeriod
 }
 
+// PoolRecordHistoryKeepPeriod returns the record history keep period of the given pool.
+// This is the pool's override if governance set one, and the module's record history keep period otherwise.
+func (k *Keeper) PoolRecordHistoryKeepPeriod(ctx sdk.Context, poolId uint64) time.Duration {
+	params := k.GetParams(ctx)
+	for _, poolKeepPeriod := range params.PoolRecordHistoryKeepPeriods {
+		if poolKeepPeriod.PoolId == poolId {
+			return poolKeepPeriod.KeepPeriod
+		}
+	}
+	return params.RecordHistoryKeepPeriod
+}
+
+func (k *Keeper) ArchivedRecordInterval(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).ArchivedRecordInterval
+}
+
 // InitGenesis initializes the twap module's state from a provided genesis
 // state.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
@@ -67,6 +83,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, twap := range genState.Twaps {
 		k.storeNewRecord(ctx, twap)
 	}
+
+	for _, twap := range genState.ArchivedTwaps {
+		k.storeArchivedRecord(ctx, twap)
+	}
 }
 
 // ExportGenesis returns the twap module's exported genesis.
@@ -78,9 +98,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	archivedTwapRecords, err := k.getAllArchivedTimeIndexedTWAPs(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
-		Twaps:  twapRecords,
+		Params:        k.GetParams(ctx),
+		Twaps:         twapRecords,
+		ArchivedTwaps: archivedTwapRecords,
 	}
 }
 
@@ -93,3 +119,27 @@ func (k Keeper) GetGeometricStrategy() *geometric {
 func (k Keeper) GetArithmeticStrategy() *arithmetic {
 	return &arithmetic{k}
 }
+
+// GetHarmonicStrategy gets harmonic mean TWAP keeper.
+func (k Keeper) GetHarmonicStrategy() *harmonic {
+	return &harmonic{k}
+}
+
+// GetVolatilityStrategy gets volatility TWAP keeper.
+func (k Keeper) GetVolatilityStrategy() *volatility {
+	return &volatility{k}
+}
+
+// getStrategyByType returns the strategy computing twaps of the given type.
+func (k Keeper) getStrategyByType(twapType types.TwapType) (twapStrategy, error) {
+	switch twapType {
+	case types.TwapTypeArithmetic:
+		return k.GetArithmeticStrategy(), nil
+	case types.TwapTypeGeometric:
+		return k.GetGeometricStrategy(), nil
+	case types.TwapTypeHarmonicMean:
+		return k.GetHarmonicStrategy(), nil
+	default:
+		return nil, types.UnknownTwapTypeError{TwapType: twapType}
+	}
+}
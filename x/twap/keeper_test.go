@@ -57,6 +57,7 @@ var (
 		P0ArithmeticTwapAccumulator: sdk.OneDec(),
 		P1ArithmeticTwapAccumulator: sdk.OneDec(),
 		GeometricTwapAccumulator:    sdk.OneDec(),
+		SquaredLogPriceAccumulator:  sdk.OneDec(),
 	}
 
 	basicCustomGenesis = types.NewGenesisState(
@@ -79,6 +80,7 @@ var (
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
 				GeometricTwapAccumulator:    sdk.OneDec(),
+				SquaredLogPriceAccumulator:  sdk.OneDec(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -91,6 +93,7 @@ var (
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
 				GeometricTwapAccumulator:    sdk.OneDec(),
+				SquaredLogPriceAccumulator:  sdk.OneDec(),
 			},
 			mostRecentRecordPoolOne,
 		})
@@ -106,6 +109,7 @@ var (
 		P0ArithmeticTwapAccumulator: sdk.OneDec(),
 		P1ArithmeticTwapAccumulator: sdk.OneDec(),
 		GeometricTwapAccumulator:    sdk.OneDec(),
+		SquaredLogPriceAccumulator:  sdk.OneDec(),
 	}
 
 	decreasingOrderByTimeRecordsPoolTwo = types.NewGenesisState(
@@ -123,6 +127,7 @@ var (
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
 				GeometricTwapAccumulator:    sdk.OneDec(),
+				SquaredLogPriceAccumulator:  sdk.OneDec(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -135,6 +140,7 @@ var (
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
 				GeometricTwapAccumulator:    sdk.OneDec(),
+				SquaredLogPriceAccumulator:  sdk.OneDec(),
 			},
 		})
 
@@ -221,6 +227,7 @@ func (suite *TestSuite) TestTwapInitGenesis() {
 						P0ArithmeticTwapAccumulator: sdk.OneDec(),
 						P1ArithmeticTwapAccumulator: sdk.OneDec(),
 						GeometricTwapAccumulator:    sdk.OneDec(),
+						SquaredLogPriceAccumulator:  sdk.OneDec(),
 					},
 				}),
 
@@ -274,6 +281,19 @@ func (suite *TestSuite) TestTWAPExportGenesis() {
 		"custom multi-record; decreasing": {
 			expectedGenesis: decreasingOrderByTimeRecordsPoolTwo,
 		},
+		"custom genesis with pool keep periods and archived records": {
+			expectedGenesis: &types.GenesisState{
+				Params: types.Params{
+					PruneEpochIdentifier:         "week",
+					RecordHistoryKeepPeriod:      48 * time.Hour,
+					PoolRecordHistoryKeepPeriods: []types.PoolRecordHistoryKeepPeriod{{PoolId: basePoolId, KeepPeriod: 720 * time.Hour}},
+					ArchivedRecordInterval:       2 * time.Hour,
+					ArchivedRecordKeepPeriod:     1440 * time.Hour,
+				},
+				Twaps:         []types.TwapRecord{mostRecentRecordPoolOne},
+				ArchivedTwaps: []types.TwapRecord{mostRecentRecordPoolOne},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -299,6 +319,7 @@ func (suite *TestSuite) TestTWAPExportGenesis() {
 			})
 
 			suite.Require().Equal(tc.expectedGenesis.Twaps, actualGenesis.Twaps)
+			suite.Require().ElementsMatch(tc.expectedGenesis.ArchivedTwaps, actualGenesis.ArchivedTwaps)
 		})
 	}
 }
@@ -418,6 +439,7 @@ func newTwoAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accum0, accum1, geo
 		P0ArithmeticTwapAccumulator: accum0,
 		P1ArithmeticTwapAccumulator: accum1,
 		GeometricTwapAccumulator:    geomAccum,
+		SquaredLogPriceAccumulator:  sdk.ZeroDec(),
 	}
 }
 
@@ -439,6 +461,7 @@ func newThreeAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accumA, accumB, a
 		P0ArithmeticTwapAccumulator: accumA,
 		P1ArithmeticTwapAccumulator: accumB,
 		GeometricTwapAccumulator:    geomAccumAB,
+		SquaredLogPriceAccumulator:  sdk.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -466,6 +489,7 @@ func newEmptyPriceRecord(poolId uint64, t time.Time, asset0 string, asset1 strin
 		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		GeometricTwapAccumulator:    sdk.ZeroDec(),
+		SquaredLogPriceAccumulator:  sdk.ZeroDec(),
 	}
 }
 
@@ -491,6 +515,7 @@ func newRecord(poolId uint64, t time.Time, sp0, accum0, accum1, geomAccum sdk.De
 		P0ArithmeticTwapAccumulator: accum0.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accum1.Add(sdk.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccum.Add(sdk.ZeroDec()),
+		SquaredLogPriceAccumulator:  sdk.ZeroDec(),
 	}
 }
 
@@ -503,6 +528,7 @@ func newExpRecord(accum0, accum1, geomAccum sdk.Dec) types.TwapRecord {
 		P0ArithmeticTwapAccumulator: accum0.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accum1.Add(sdk.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccum.Add(sdk.ZeroDec()),
+		SquaredLogPriceAccumulator:  sdk.ZeroDec(),
 	}
 }
 
@@ -521,6 +547,7 @@ func newThreeAssetRecord(poolId uint64, t time.Time, sp0, accumA, accumB, accumC
 		P0ArithmeticTwapAccumulator: accumA.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accumB.Add(sdk.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccumAB.Add(sdk.ZeroDec()),
+		SquaredLogPriceAccumulator:  sdk.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -545,6 +572,7 @@ func newThreeAssetExpRecord(poolId uint64, accumA, accumB, accumC, geomAccumAB,
 		P0ArithmeticTwapAccumulator: accumA.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accumB.Add(sdk.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccumAB.Add(sdk.ZeroDec()),
+		SquaredLogPriceAccumulator:  sdk.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -564,6 +592,8 @@ func newOneSidedRecord(time time.Time, accum sdk.Dec, useP0 bool) types.TwapReco
 	} else {
 		record.P1ArithmeticTwapAccumulator = accum
 	}
+	record.GeometricTwapAccumulator = sdk.ZeroDec()
+	record.SquaredLogPriceAccumulator = sdk.ZeroDec()
 	record.P0LastSpotPrice = sdk.ZeroDec()
 	record.P1LastSpotPrice = sdk.OneDec()
 	return record
@@ -572,6 +602,7 @@ func newOneSidedRecord(time time.Time, accum sdk.Dec, useP0 bool) types.TwapReco
 func newOneSidedGeometricRecord(time time.Time, accum sdk.Dec) types.TwapRecord {
 	record := types.TwapRecord{Time: time, Asset0Denom: denom0, Asset1Denom: denom1}
 	record.GeometricTwapAccumulator = accum
+	record.SquaredLogPriceAccumulator = sdk.ZeroDec()
 	record.P0LastSpotPrice = sdk.NewDec(10)
 	return record
 }
@@ -584,6 +615,7 @@ func newThreeAssetOneSidedRecord(time time.Time, accum sdk.Dec, useP0 bool) []ty
 		record.P1ArithmeticTwapAccumulator = accum
 	}
 	record.GeometricTwapAccumulator = accum
+	record.SquaredLogPriceAccumulator = sdk.ZeroDec()
 	record.P0LastSpotPrice = sdk.ZeroDec()
 	record.P1LastSpotPrice = sdk.OneDec()
 	records := []types.TwapRecord{record, record, record}
@@ -600,6 +632,18 @@ func recordWithUpdatedAccum(record types.TwapRecord, accum0 sdk.Dec, accum1, geo
 	return record
 }
 
+func withSquaredLogAccum(record types.TwapRecord, accum sdk.Dec) types.TwapRecord {
+	record.SquaredLogPriceAccumulator = accum
+	return record
+}
+
+func withThreeAssetSquaredLogAccums(records []types.TwapRecord, accumAB, accumAC, accumBC sdk.Dec) []types.TwapRecord {
+	records[0].SquaredLogPriceAccumulator = accumAB
+	records[1].SquaredLogPriceAccumulator = accumAC
+	records[2].SquaredLogPriceAccumulator = accumBC
+	return records
+}
+
 func recordWithUpdatedSpotPrice(record types.TwapRecord, sp0 sdk.Dec, sp1 sdk.Dec) types.TwapRecord {
 	record.P0LastSpotPrice = sp0
 	record.P1LastSpotPrice = sp1
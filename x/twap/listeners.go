@@ -1,15 +1,19 @@
 package twap
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cltypes "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
 	epochtypes "github.com/osmosis-labs/osmosis/v14/x/epochs/types"
 	"github.com/osmosis-labs/osmosis/v14/x/gamm/types"
 )
 
 var (
-	_ types.GammHooks       = &gammhook{}
-	_ epochtypes.EpochHooks = &epochhook{}
+	_ types.GammHooks                       = &gammhook{}
+	_ epochtypes.EpochHooks                 = &epochhook{}
+	_ cltypes.ConcentratedLiquidityListener = &concentratedLiquidityListener{}
 )
 
 type epochhook struct {
@@ -61,3 +65,49 @@ func (hook *gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, pool
 func (hook *gammhook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
 	hook.k.trackChangedPool(ctx, poolId)
 }
+
+type concentratedLiquidityListener struct {
+	k Keeper
+}
+
+func (k Keeper) ConcentratedLiquidityListener() cltypes.ConcentratedLiquidityListener {
+	return &concentratedLiquidityListener{k}
+}
+
+// AfterConcentratedPoolCreated is a no-op, since a concentrated liquidity pool
+// has no spot price until its first position is created.
+func (l *concentratedLiquidityListener) AfterConcentratedPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+}
+
+func (l *concentratedLiquidityListener) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	l.afterConcentratedPoolChanged(ctx, poolId)
+}
+
+func (l *concentratedLiquidityListener) AfterPositionCreated(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidity sdk.Dec) {
+	l.afterConcentratedPoolChanged(ctx, poolId)
+}
+
+func (l *concentratedLiquidityListener) AfterPositionModified(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidityDelta sdk.Dec) {
+	l.afterConcentratedPoolChanged(ctx, poolId)
+}
+
+func (l *concentratedLiquidityListener) AfterPositionWithdrawn(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensOut sdk.Coins, liquidity sdk.Dec) {
+	l.afterConcentratedPoolChanged(ctx, poolId)
+}
+
+// afterConcentratedPoolChanged creates the records of a concentrated liquidity pool
+// the first time it changes, i.e. when its first position is created, and tracks it
+// as changed afterwards. Errors are logged rather than halting the pool change.
+func (l *concentratedLiquidityListener) afterConcentratedPoolChanged(ctx sdk.Context, poolId uint64) {
+	records, err := l.k.getAllMostRecentRecordsForPool(ctx, poolId)
+	if err == nil && len(records) == 0 {
+		err = l.k.afterCreatePool(ctx, poolId)
+	} else if err == nil {
+		l.k.trackChangedPool(ctx, poolId)
+	}
+	if err != nil {
+		ctx.Logger().Error(fmt.Errorf(
+			"error in TWAP concentrated liquidity listener, for pool id %d."+
+				" Skipping record update. Underlying err: %w", poolId, err).Error())
+	}
+}
@@ -6,6 +6,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v14/app/apptesting"
+	cl "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity"
+	cltypes "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v14/x/twap"
 	"github.com/osmosis-labs/osmosis/v14/x/twap/types"
 )
@@ -50,7 +54,7 @@ func (s *TestSuite) TestAfterPoolCreatedHook() {
 			denomPairs := types.GetAllUniqueDenomPairs(denoms)
 			expectedRecords := []types.TwapRecord{}
 			for _, denomPair := range denomPairs {
-				expectedRecord, err := twap.NewTwapRecord(s.App.GAMMKeeper, s.Ctx, poolId, denomPair.Denom0, denomPair.Denom1)
+				expectedRecord, err := twap.NewTwapRecord(s.App.PoolManagerKeeper, s.Ctx, poolId, denomPair.Denom0, denomPair.Denom1)
 				s.Require().NoError(err)
 				expectedRecords = append(expectedRecords, expectedRecord)
 			}
@@ -334,3 +338,148 @@ func (s *TestSuite) TestPoolStateChange() {
 // func (s *TestSuite) TestSafetyWithPoolThatHasSpotPriceError() {
 // 	s.Require().Fail("Need to implement")
 // }
+
+// TestConcentratedLiquidityListener tests that the records of a concentrated liquidity pool are created
+// with its first position rather than on pool creation, since the pool has no spot price before then,
+// and that swaps and position changes afterwards trigger tracking the pool.
+func (s *TestSuite) TestConcentratedLiquidityListener() {
+	clPool := s.PrepareConcentratedPool()
+	poolId := clPool.GetId()
+
+	// no records nor tracking on pool creation.
+	records, err := s.twapkeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Empty(records)
+	s.Require().Empty(s.twapkeeper.GetChangedPools(s.Ctx))
+
+	// the first position creates the records.
+	positionId, liquidity := s.createFullRangePosition(clPool, sdk.NewInt(1_000_000), sdk.NewInt(5_000_000_000))
+	expectedRecord, err := twap.NewTwapRecord(s.App.PoolManagerKeeper, s.Ctx, poolId, apptesting.ETH, apptesting.USDC)
+	s.Require().NoError(err)
+	s.Require().Equal(time.Time{}, expectedRecord.LastErrorTime)
+	records, err = s.twapkeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.TwapRecord{expectedRecord}, records)
+	s.Require().Equal([]uint64{poolId}, s.twapkeeper.GetChangedPools(s.Ctx))
+	s.twapkeeper.EndBlock(s.Ctx)
+	s.Commit()
+
+	// a swap tracks the pool.
+	s.swapExactAmountIn(poolId, sdk.NewInt64Coin(apptesting.USDC, 1_000_000), apptesting.ETH)
+	s.Require().Equal([]uint64{poolId}, s.twapkeeper.GetChangedPools(s.Ctx))
+	s.twapkeeper.EndBlock(s.Ctx)
+	s.Commit()
+
+	// a second position tracks the pool, without creating new records.
+	s.createFullRangePosition(clPool, sdk.NewInt(1_000_000), sdk.NewInt(5_000_000_000))
+	s.Require().Equal([]uint64{poolId}, s.twapkeeper.GetChangedPools(s.Ctx))
+	records, err = s.twapkeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Len(records, 1)
+	s.twapkeeper.EndBlock(s.Ctx)
+	s.Commit()
+
+	// withdrawing a position tracks the pool.
+	_, _, err = s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, s.TestAccs[0], positionId, liquidity)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{poolId}, s.twapkeeper.GetChangedPools(s.Ctx))
+}
+
+// TestConcentratedLiquidityTwapAcrossTickCrossings tests that the arithmetic TWAP of a concentrated liquidity pool
+// is the time weighted average of its spot prices, as swaps move its price across initialized ticks.
+func (s *TestSuite) TestConcentratedLiquidityTwapAcrossTickCrossings() {
+	clPool := s.PrepareConcentratedPool()
+	poolId := clPool.GetId()
+	startTime := s.Ctx.BlockTime()
+
+	// a full range position sets the price, and a deeper position is concentrated around it.
+	s.createFullRangePosition(clPool, sdk.NewInt(1_000_000), sdk.NewInt(5_000_000_000))
+	currentTick := s.getConcentratedPool(poolId).GetCurrentTick().Int64()
+	lowerTick, upperTick := currentTick-1000, currentTick+1000
+	amount0, _ := s.createPosition(clPool, sdk.NewInt(10_000_000), sdk.NewInt(50_000_000_000), lowerTick, upperTick)
+
+	spotPrices := []sdk.Dec{s.ethSpotPrice(poolId)}
+	s.twapkeeper.EndBlock(s.Ctx)
+	s.Commit()
+
+	// buying eth moves the price above the upper tick of the concentrated position.
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(10 * time.Second))
+	s.swapExactAmountIn(poolId, sdk.NewCoin(apptesting.USDC, amount0.MulRaw(2*5000)), apptesting.ETH)
+	s.Require().Greater(s.getConcentratedPool(poolId).GetCurrentTick().Int64(), upperTick)
+	spotPrices = append(spotPrices, s.ethSpotPrice(poolId))
+	s.twapkeeper.EndBlock(s.Ctx)
+	s.Commit()
+
+	// selling eth moves the price back across the concentrated position, below its lower tick.
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(40 * time.Second))
+	s.swapExactAmountIn(poolId, sdk.NewCoin(apptesting.ETH, amount0.MulRaw(4)), apptesting.USDC)
+	s.Require().Less(s.getConcentratedPool(poolId).GetCurrentTick().Int64(), lowerTick)
+	spotPrices = append(spotPrices, s.ethSpotPrice(poolId))
+	s.twapkeeper.EndBlock(s.Ctx)
+	s.Commit()
+
+	s.Require().True(spotPrices[1].GT(spotPrices[0]))
+	s.Require().True(spotPrices[2].LT(spotPrices[0]))
+
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(100 * time.Second))
+	tests := map[string]struct {
+		start, end time.Duration
+		// seconds each spot price was held within the window.
+		weights []int64
+	}{
+		"whole history": {start: 0, end: 100 * time.Second, weights: []int64{10, 30, 60}},
+		"window starting and ending between swaps": {start: 5 * time.Second, end: 70 * time.Second, weights: []int64{5, 30, 30}},
+		"window between the two swaps":             {start: 15 * time.Second, end: 35 * time.Second, weights: []int64{0, 20, 0}},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			weightedSum, totalWeight := sdk.ZeroDec(), int64(0)
+			for i, weight := range tc.weights {
+				weightedSum = weightedSum.Add(spotPrices[i].MulInt64(weight * 1000))
+				totalWeight += weight * 1000
+			}
+			expectedTwap := weightedSum.QuoInt64(totalWeight)
+
+			twap, err := s.twapkeeper.GetArithmeticTwap(s.Ctx, poolId, apptesting.ETH, apptesting.USDC, startTime.Add(tc.start), startTime.Add(tc.end))
+			s.Require().NoError(err)
+			s.Require().Equal(expectedTwap, twap)
+		})
+	}
+}
+
+func (s *TestSuite) createFullRangePosition(clPool cltypes.ConcentratedPoolExtension, amount0, amount1 sdk.Int) (positionId uint64, liquidity sdk.Dec) {
+	minTick, maxTick := cl.GetMinAndMaxTicksFromExponentAtPriceOne(clPool.GetPrecisionFactorAtPriceOne())
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewCoin(clPool.GetToken0(), amount0), sdk.NewCoin(clPool.GetToken1(), amount1)))
+	positionId, _, _, liquidity, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, clPool.GetId(), s.TestAccs[0],
+		amount0, amount1, sdk.ZeroInt(), sdk.ZeroInt(), minTick, maxTick, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	return positionId, liquidity
+}
+
+func (s *TestSuite) createPosition(clPool cltypes.ConcentratedPoolExtension, amount0, amount1 sdk.Int, lowerTick, upperTick int64) (actualAmount0, actualAmount1 sdk.Int) {
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewCoin(clPool.GetToken0(), amount0), sdk.NewCoin(clPool.GetToken1(), amount1)))
+	_, actualAmount0, actualAmount1, _, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, clPool.GetId(), s.TestAccs[0],
+		amount0, amount1, sdk.ZeroInt(), sdk.ZeroInt(), lowerTick, upperTick, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	return actualAmount0, actualAmount1
+}
+
+func (s *TestSuite) swapExactAmountIn(poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) {
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+	_, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0],
+		[]poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}, tokenIn, sdk.OneInt())
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) getConcentratedPool(poolId uint64) cltypes.ConcentratedPoolExtension {
+	poolI, err := s.App.ConcentratedLiquidityKeeper.GetPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	return poolI.(cltypes.ConcentratedPoolExtension)
+}
+
+// ethSpotPrice returns the price of eth in usdc in the given pool.
+func (s *TestSuite) ethSpotPrice(poolId uint64) sdk.Dec {
+	spotPrice, err := s.App.PoolManagerKeeper.CalculateSpotPrice(s.Ctx, poolId, apptesting.USDC, apptesting.ETH)
+	s.Require().NoError(err)
+	return spotPrice
+}
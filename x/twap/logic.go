@@ -29,6 +29,7 @@ func newTwapRecord(k types.AmmInterface, ctx sdk.Context, poolId uint64, denom0,
 		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		GeometricTwapAccumulator:    sdk.ZeroDec(),
+		SquaredLogPriceAccumulator:  sdk.ZeroDec(),
 		LastErrorTime:               lastErrorTime,
 	}, nil
 }
@@ -86,6 +87,9 @@ func (k Keeper) afterCreatePool(ctx sdk.Context, poolId uint64) error {
 		// furthermore, this protects against an edge case where a pool is created
 		// during EndBlock, after twapkeeper's endblock.
 		k.storeNewRecord(ctx, record)
+		// the first record of a pool is always archived, so that archived records
+		// are available from pool creation.
+		k.storeArchivedRecord(ctx, record)
 	}
 	k.trackChangedPool(ctx, poolId)
 	return err
@@ -135,13 +139,24 @@ func (k Keeper) updateRecords(ctx sdk.Context, poolId uint64) error {
 		return types.InvalidRecordCountError{Expected: expectedRecordsLength, Actual: len(records)}
 	}
 
+	archivedRecordInterval := k.ArchivedRecordInterval(ctx)
 	for _, record := range records {
 		newRecord := k.updateRecord(ctx, record)
 		k.storeNewRecord(ctx, newRecord)
+		if isInNewArchiveInterval(record, newRecord, archivedRecordInterval) {
+			k.storeArchivedRecord(ctx, newRecord)
+		}
 	}
 	return nil
 }
 
+// isInNewArchiveInterval returns true if the new record is the first record of its
+// archived record interval, i.e. if it is in a later interval than the previous record.
+// Intervals are aligned to multiples of the interval since the zero time.
+func isInNewArchiveInterval(previousRecord types.TwapRecord, newRecord types.TwapRecord, interval time.Duration) bool {
+	return newRecord.Time.Truncate(interval).After(previousRecord.Time.Truncate(interval))
+}
+
 // updateRecord returns a new record with updated accumulators and block time
 // for the current block time.
 func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) types.TwapRecord {
@@ -160,15 +175,26 @@ func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) types.Twa
 }
 
 // pruneRecords prunes twap records that happened earlier than recordHistoryKeepPeriod
-// before current block time while preserving the most recent record before the threshold.
+// (or the pool's own record history keep period, if set) before current block time
+// while preserving the most recent record before the threshold.
 // Such record is preserved for each pool.
+// Archived records are pruned the same way, using the archived record keep period.
 // See TWAP keeper's `pruneRecordsBeforeTimeButNewest(...)` for more details about the reasons for
 // keeping this record.
 func (k Keeper) pruneRecords(ctx sdk.Context) error {
-	recordHistoryKeepPeriod := k.RecordHistoryKeepPeriod(ctx)
+	params := k.GetParams(ctx)
 
-	lastKeptTime := ctx.BlockTime().Add(-recordHistoryKeepPeriod)
-	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime)
+	lastKeptTime := ctx.BlockTime().Add(-params.RecordHistoryKeepPeriod)
+	poolLastKeptTimes := make(map[uint64]time.Time, len(params.PoolRecordHistoryKeepPeriods))
+	for _, poolKeepPeriod := range params.PoolRecordHistoryKeepPeriods {
+		poolLastKeptTimes[poolKeepPeriod.PoolId] = ctx.BlockTime().Add(-poolKeepPeriod.KeepPeriod)
+	}
+	if err := k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime, poolLastKeptTimes); err != nil {
+		return err
+	}
+
+	lastKeptArchivedTime := ctx.BlockTime().Add(-params.ArchivedRecordKeepPeriod)
+	return k.pruneArchivedRecordsBeforeTimeButNewest(ctx, lastKeptArchivedTime)
 }
 
 // recordWithUpdatedAccumulators returns a record, with updated accumulator values and time for provided newTime,
@@ -208,6 +234,10 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 	p0NewGeomAccum := types.SpotPriceMulDuration(logP0SpotPrice, timeDelta)
 	newRecord.GeometricTwapAccumulator = newRecord.GeometricTwapAccumulator.Add(p0NewGeomAccum)
 
+	// p0NewSquaredLogAccum = (log_{2}{P_0})^2 * timeDelta
+	p0NewSquaredLogAccum := types.SpotPriceMulDuration(logP0SpotPrice.Mul(logP0SpotPrice), timeDelta)
+	newRecord.SquaredLogPriceAccumulator = newRecord.SquaredLogPriceAccumulator.Add(p0NewSquaredLogAccum)
+
 	return newRecord
 }
 
@@ -238,7 +268,7 @@ func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, asse
 }
 
 // computeTwap computes and returns a TWAP of a given
-// type - arithmetic or geometric.
+// type - arithmetic, geometric, harmonic or volatility.
 // Between two records given the quote asset.
 // precondition: endRecord.Time >= startRecord.Time
 // if (endRecord.LastErrorTime >= startRecord.Time) returns an error at end + result
@@ -256,7 +286,11 @@ func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quote
 	}
 	timeDelta := endRecord.Time.Sub(startRecord.Time)
 	// if time difference is 0, then return the last spot price based off of start.
+	// There is no volatility over an empty window.
 	if timeDelta == time.Duration(0) {
+		if _, ok := strategy.(*volatility); ok {
+			return sdk.ZeroDec(), err
+		}
 		if quoteAsset == startRecord.Asset0Denom {
 			return endRecord.P0LastSpotPrice, err
 		}
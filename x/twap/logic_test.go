@@ -13,21 +13,24 @@ import (
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
 	gammtypes "github.com/osmosis-labs/osmosis/v14/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v14/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v14/x/twap"
 	"github.com/osmosis-labs/osmosis/v14/x/twap/types"
 	"github.com/osmosis-labs/osmosis/v14/x/twap/types/twapmock"
 )
 
 var (
-	zeroDec              = sdk.ZeroDec()
-	oneDec               = sdk.OneDec()
-	twoDec               = oneDec.Add(oneDec)
-	pointFiveDec         = sdk.OneDec().Quo(twoDec)
-	OneSec               = sdk.MustNewDecFromStr("1000.000000000000000000")
-	logTen               = twap.TwapLog(sdk.NewDec(10))
-	logOneOverTen        = twap.TwapLog(sdk.OneDec().QuoInt64(10))
-	tenSecAccum          = OneSec.MulInt64(10)
-	geometricTenSecAccum = OneSec.Mul(logTen)
+	zeroDec                      = sdk.ZeroDec()
+	oneDec                       = sdk.OneDec()
+	twoDec                       = oneDec.Add(oneDec)
+	pointFiveDec                 = sdk.OneDec().Quo(twoDec)
+	OneSec                       = sdk.MustNewDecFromStr("1000.000000000000000000")
+	logTen                       = twap.TwapLog(sdk.NewDec(10))
+	logOneOverTen                = twap.TwapLog(sdk.OneDec().QuoInt64(10))
+	tenSecAccum                  = OneSec.MulInt64(10)
+	geometricTenSecAccum         = OneSec.Mul(logTen)
+	squaredLogTenSecAccum        = OneSec.Mul(logTen.Mul(logTen))
+	squaredLogOneOverTenSecAccum = OneSec.Mul(logOneOverTen.Mul(logOneOverTen))
 )
 
 func (s *TestSuite) TestGetSpotPrices() {
@@ -134,7 +137,7 @@ func (s *TestSuite) TestNewTwapRecord() {
 	}
 	for name, test := range tests {
 		s.Run(name, func() {
-			twapRecord, err := twap.NewTwapRecord(s.App.GAMMKeeper, s.Ctx, test.poolId, test.denom0, test.denom1)
+			twapRecord, err := twap.NewTwapRecord(s.App.PoolManagerKeeper, s.Ctx, test.poolId, test.denom0, test.denom1)
 
 			if test.expectedPanic {
 				s.Require().Equal(twapRecord.LastErrorTime, s.Ctx.BlockTime())
@@ -168,7 +171,7 @@ func (s *TestSuite) TestUpdateRecord() {
 	baseTimeMinusOne := time.Unix(1, 0).UTC()
 
 	zeroAccumNoErrSp10Record := newRecord(poolId, baseTime, sdk.NewDec(10), zeroDec, zeroDec, zeroDec)
-	sp10OneTimeUnitAccumRecord := newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum)
+	sp10OneTimeUnitAccumRecord := withSquaredLogAccum(newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum), squaredLogTenSecAccum)
 	// all tests occur with updateTime = base time + time.Unix(1, 0)
 	tests := map[string]struct {
 		record           types.TwapRecord
@@ -253,17 +256,17 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 		"accum with zero value": {
 			record:    newRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), zeroDec, zeroDec, zeroDec),
 			newTime:   time.Unix(2, 0),
-			expRecord: newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum),
+			expRecord: withSquaredLogAccum(newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum), squaredLogTenSecAccum),
 		},
 		"small starting accumulators": {
 			record:    defaultRecord,
 			newTime:   time.Unix(2, 0),
-			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)),
+			expRecord: withSquaredLogAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)), squaredLogTenSecAccum),
 		},
 		"larger time interval": {
 			record:    newRecord(poolId, time.Unix(11, 0), sdk.NewDec(10), oneDec, twoDec, pointFiveDec),
 			newTime:   time.Unix(55, 0),
-			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(44*10)), twoDec.Add(OneSec.MulInt64(44).QuoInt64(10)), pointFiveDec.Add(OneSec.MulInt64(44).Mul(logTen))),
+			expRecord: withSquaredLogAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(44*10)), twoDec.Add(OneSec.MulInt64(44).QuoInt64(10)), pointFiveDec.Add(OneSec.MulInt64(44).Mul(logTen))), OneSec.MulInt64(44).Mul(logTen.Mul(logTen))),
 		},
 		"same time, accumulator should not change": {
 			record:    defaultRecord,
@@ -278,7 +281,7 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 		"sp1 - zero spot price - accum0 updated, accum1 unchanged, geom accum updated correctly": {
 			record:    withPrice1Set(defaultRecord, sdk.ZeroDec()),
 			newTime:   defaultRecord.Time.Add(time.Second),
-			expRecord: newExpRecord(tenSecAccum.Add(oneDec), twoDec, pointFiveDec.Add(geometricTenSecAccum)),
+			expRecord: withSquaredLogAccum(newExpRecord(tenSecAccum.Add(oneDec), twoDec, pointFiveDec.Add(geometricTenSecAccum)), squaredLogTenSecAccum),
 		},
 		"both sp - zero spot price - accum0 unchange, accum1 unchanged, geom accum unchanged": {
 			record:    withPrice1Set(withPrice0Set(defaultRecord, sdk.ZeroDec()), sdk.ZeroDec()),
@@ -318,17 +321,17 @@ func TestRecordWithUpdatedAccumulators_ThreeAsset(t *testing.T) {
 		"accum with zero value": {
 			record:          newThreeAssetRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), zeroDec, zeroDec, zeroDec, zeroDec, zeroDec, zeroDec),
 			interpolateTime: time.Unix(2, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, OneSec.MulInt64(10), OneSec.QuoInt64(10), OneSec.MulInt64(20), geometricTenSecAccum, geometricTenSecAccum, OneSec.Mul(logOneOverTen)),
+			expRecord:       withThreeAssetSquaredLogAccums(newThreeAssetExpRecord(poolId, OneSec.MulInt64(10), OneSec.QuoInt64(10), OneSec.MulInt64(20), geometricTenSecAccum, geometricTenSecAccum, OneSec.Mul(logOneOverTen)), squaredLogTenSecAccum, squaredLogTenSecAccum, squaredLogOneOverTenSecAccum),
 		},
 		"small starting accumulators": {
 			record:          newThreeAssetRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), twoDec, oneDec, twoDec, oneDec, twoDec, oneDec),
 			interpolateTime: time.Unix(2, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(10)), oneDec.Add(OneSec.QuoInt64(10)), twoDec.Add(OneSec.MulInt64(20)), oneDec.Add(geometricTenSecAccum), twoDec.Add(geometricTenSecAccum), oneDec.Add(OneSec.Mul(logOneOverTen))),
+			expRecord:       withThreeAssetSquaredLogAccums(newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(10)), oneDec.Add(OneSec.QuoInt64(10)), twoDec.Add(OneSec.MulInt64(20)), oneDec.Add(geometricTenSecAccum), twoDec.Add(geometricTenSecAccum), oneDec.Add(OneSec.Mul(logOneOverTen))), squaredLogTenSecAccum, squaredLogTenSecAccum, squaredLogOneOverTenSecAccum),
 		},
 		"larger time interval": {
 			record:          newThreeAssetRecord(poolId, time.Unix(11, 0), sdk.NewDec(10), twoDec, oneDec, twoDec, oneDec, twoDec, oneDec),
 			interpolateTime: time.Unix(55, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(44*10)), oneDec.Add(OneSec.MulInt64(44).QuoInt64(10)), twoDec.Add(OneSec.MulInt64(44*20)), oneDec.Add(OneSec.MulInt64(44).Mul(logTen)), twoDec.Add(OneSec.MulInt64(44).Mul(logTen)), oneDec.Add(OneSec.MulInt64(44).Mul(logOneOverTen))),
+			expRecord:       withThreeAssetSquaredLogAccums(newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(44*10)), oneDec.Add(OneSec.MulInt64(44).QuoInt64(10)), twoDec.Add(OneSec.MulInt64(44*20)), oneDec.Add(OneSec.MulInt64(44).Mul(logTen)), twoDec.Add(OneSec.MulInt64(44).Mul(logTen)), oneDec.Add(OneSec.MulInt64(44).Mul(logOneOverTen))), squaredLogTenSecAccum.MulInt64(44), squaredLogTenSecAccum.MulInt64(44), squaredLogOneOverTenSecAccum.MulInt64(44)),
 		},
 	}
 
@@ -612,6 +615,41 @@ func (s *TestSuite) TestPruneRecords() {
 	s.validateExpectedRecords(expectedKeptRecords)
 }
 
+// TestPruneRecords_PoolKeepPeriodsAndArchivedRecords tests that pruning respects
+// per pool record history keep periods, and prunes archived records by their own keep period.
+func (s *TestSuite) TestPruneRecords_PoolKeepPeriodsAndArchivedRecords() {
+	s.SetupTest()
+	params := types.DefaultParams()
+	recordHistoryKeepPeriod := params.RecordHistoryKeepPeriod
+	params.PoolRecordHistoryKeepPeriods = []types.PoolRecordHistoryKeepPeriod{
+		{PoolId: 2, KeepPeriod: 4 * recordHistoryKeepPeriod},
+	}
+	s.twapkeeper.SetParams(s.Ctx, params)
+
+	pool1OldestRecord := newEmptyPriceRecord(1, baseTime.Add(3*-recordHistoryKeepPeriod), denom0, denom1) // deleted
+	pool1OlderRecord := newEmptyPriceRecord(1, baseTime.Add(2*-recordHistoryKeepPeriod), denom0, denom1)  // kept as newest under keep period
+	pool2OldestRecord := newEmptyPriceRecord(2, baseTime.Add(3*-recordHistoryKeepPeriod), denom0, denom1) // kept under pool keep period
+	pool2OlderRecord := newEmptyPriceRecord(2, baseTime.Add(2*-recordHistoryKeepPeriod), denom0, denom1)  // kept under pool keep period
+	s.preSetRecords([]types.TwapRecord{pool2OlderRecord, pool1OldestRecord, pool1OlderRecord, pool2OldestRecord})
+
+	archivedKeepPeriod := params.ArchivedRecordKeepPeriod
+	pool1OldestArchivedRecord := newEmptyPriceRecord(1, baseTime.Add(-archivedKeepPeriod).Add(-2*time.Hour), denom0, denom1) // deleted
+	pool1OlderArchivedRecord := newEmptyPriceRecord(1, baseTime.Add(-archivedKeepPeriod).Add(-time.Hour), denom0, denom1)    // kept as newest under keep period
+	pool1ArchivedRecord := newEmptyPriceRecord(1, baseTime.Add(-time.Hour), denom0, denom1)                                  // kept
+	for _, record := range []types.TwapRecord{pool1ArchivedRecord, pool1OldestArchivedRecord, pool1OlderArchivedRecord} {
+		s.twapkeeper.StoreArchivedRecord(s.Ctx, record)
+	}
+
+	err := s.twapkeeper.PruneRecords(s.Ctx.WithBlockTime(baseTime))
+	s.Require().NoError(err)
+
+	s.validateExpectedRecords([]types.TwapRecord{pool2OldestRecord, pool1OlderRecord, pool2OlderRecord})
+
+	archivedRecords, err := s.twapkeeper.GetAllArchivedTimeIndexedTWAPs(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.TwapRecord{pool1OlderArchivedRecord, pool1ArchivedRecord}, archivedRecords)
+}
+
 // TestUpdateRecords tests that the records are updated correctly.
 // It tests the following:
 // - two-asset pools
@@ -664,14 +702,14 @@ func (s *TestSuite) TestUpdateRecords() {
 			poolId:        1,
 			blockTime:     baseTime,
 
-			expectError: gammtypes.PoolDoesNotExistError{PoolId: 1},
+			expectError: poolmanagertypes.FailedToFindRouteError{PoolId: 1},
 		},
 		"existing records in different pool; no-op": {
 			preSetRecords: []types.TwapRecord{baseRecord},
 			poolId:        baseRecord.PoolId + 1,
 			blockTime:     baseTime.Add(time.Second),
 
-			expectError: gammtypes.PoolDoesNotExistError{PoolId: baseRecord.PoolId + 1},
+			expectError: poolmanagertypes.FailedToFindRouteError{PoolId: baseRecord.PoolId + 1},
 		},
 		"the returned number of records does not match expected": {
 			preSetRecords: []types.TwapRecord{baseRecord},
@@ -1135,7 +1173,7 @@ func (s *TestSuite) TestUpdateRecords() {
 			ctx := s.Ctx.WithBlockTime(tc.blockTime)
 
 			if len(tc.spOverrides) > 0 {
-				ammMock := twapmock.NewProgrammedAmmInterface(s.App.GAMMKeeper)
+				ammMock := twapmock.NewProgrammedAmmInterface(s.App.PoolManagerKeeper)
 
 				for _, sp := range tc.spOverrides {
 					ammMock.ProgramPoolSpotPriceOverride(tc.poolId, sp.baseDenom, sp.quoteDenom, sp.overrideSp, sp.overrideErr)
@@ -1175,6 +1213,40 @@ func (s *TestSuite) TestUpdateRecords() {
 	}
 }
 
+// TestUpdateRecords_ArchivedRecords tests that the first record of a pool,
+// and the first record of every archived record interval is archived.
+func (s *TestSuite) TestUpdateRecords_ArchivedRecords() {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(baseTime)
+	poolId, _, _ := s.setupDefaultPool()
+
+	blockTimes := []time.Time{
+		baseTime.Add(10 * time.Minute),             // same interval as pool creation
+		baseTime.Add(time.Hour + 5*time.Minute),    // archived
+		baseTime.Add(time.Hour + 30*time.Minute),   // same interval
+		baseTime.Add(5*time.Hour + 59*time.Minute), // archived
+	}
+	for _, blockTime := range blockTimes {
+		s.Ctx = s.Ctx.WithBlockTime(blockTime)
+		err := s.twapkeeper.UpdateRecords(s.Ctx, poolId)
+		s.Require().NoError(err)
+	}
+
+	archivedRecords, err := s.twapkeeper.GetAllArchivedTimeIndexedTWAPs(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(archivedRecords, 3)
+	s.Require().Equal(baseTime, archivedRecords[0].Time)
+	s.Require().Equal(blockTimes[1], archivedRecords[1].Time)
+	s.Require().Equal(blockTimes[3], archivedRecords[2].Time)
+
+	// The archived records are the historical records at the same time.
+	for _, archivedRecord := range archivedRecords {
+		historicalRecord, err := s.twapkeeper.GetRecordAtOrBeforeTime(s.Ctx, poolId, archivedRecord.Time, denom0, denom1)
+		s.Require().NoError(err)
+		s.Require().Equal(historicalRecord, archivedRecord)
+	}
+}
+
 func (s *TestSuite) TestAfterCreatePool() {
 	tests := map[string]struct {
 		poolId    uint64
@@ -1234,7 +1306,7 @@ func (s *TestSuite) TestAfterCreatePool() {
 			denomPairs := types.GetAllUniqueDenomPairs(denoms)
 			expectedRecords := []types.TwapRecord{}
 			for _, denomPair := range denomPairs {
-				expectedRecord, err := twap.NewTwapRecord(s.App.GAMMKeeper, s.Ctx, poolId, denomPair.Denom0, denomPair.Denom1)
+				expectedRecord, err := twap.NewTwapRecord(s.App.PoolManagerKeeper, s.Ctx, poolId, denomPair.Denom0, denomPair.Denom1)
 				s.Require().NoError(err)
 				expectedRecords = append(expectedRecords, expectedRecord)
 			}
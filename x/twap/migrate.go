@@ -1,7 +1,12 @@
 package twap
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v14/x/twap/types"
 )
 
 // MigrateExistingPools iterates through all pools and creates state entry for the twap module.
@@ -14,3 +19,58 @@ func (k Keeper) MigrateExistingPools(ctx sdk.Context, latestPoolId uint64) error
 	}
 	return nil
 }
+
+// MigrateSquaredLogPriceAccumulators initializes the squared log price accumulator
+// of all existing twap records.
+// For every (pool id, asset0, asset1) triplet, the oldest historical record starts at zero
+// and every subsequent record is accumulated from the last spot price of its predecessor,
+// exactly as it would have been had the accumulator existed when the records were written.
+// This keeps the squared log price accumulator consistent with the geometric accumulator
+// over any window, which is required for the volatility computation.
+func (k Keeper) MigrateSquaredLogPriceAccumulators(ctx sdk.Context) error {
+	// Records are sorted by (pool id, asset0, asset1, time) in the pool index.
+	historicalRecords, err := k.getAllHistoricalPoolIndexedTWAPs(ctx)
+	if err != nil {
+		return err
+	}
+
+	type uniqueTriplet struct {
+		poolId uint64
+		asset0 string
+		asset1 string
+	}
+	latestRecords := map[uniqueTriplet]types.TwapRecord{}
+	// triplets keeps the order in which the triplets were seen, to update
+	// the most recent records deterministically.
+	triplets := []uniqueTriplet{}
+
+	for _, record := range historicalRecords {
+		triplet := uniqueTriplet{poolId: record.PoolId, asset0: record.Asset0Denom, asset1: record.Asset1Denom}
+		previousRecord, found := latestRecords[triplet]
+		if found {
+			record.SquaredLogPriceAccumulator = recordWithUpdatedAccumulators(previousRecord, record.Time).SquaredLogPriceAccumulator
+		} else {
+			record.SquaredLogPriceAccumulator = sdk.ZeroDec()
+			triplets = append(triplets, triplet)
+		}
+		k.storeHistoricalTWAP(ctx, record)
+		latestRecords[triplet] = record
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, triplet := range triplets {
+		mostRecentRecord, err := k.getMostRecentRecordStoreRepresentation(ctx, triplet.poolId, triplet.asset0, triplet.asset1)
+		if err != nil {
+			return err
+		}
+		latestRecord := latestRecords[triplet]
+		if mostRecentRecord.Time.Before(latestRecord.Time) {
+			return fmt.Errorf("most recent twap record for pool %d (%s, %s) is older than its latest historical record",
+				triplet.poolId, triplet.asset0, triplet.asset1)
+		}
+		mostRecentRecord.SquaredLogPriceAccumulator = recordWithUpdatedAccumulators(latestRecord, mostRecentRecord.Time).SquaredLogPriceAccumulator
+		key := types.FormatMostRecentTWAPKey(mostRecentRecord.PoolId, mostRecentRecord.Asset0Denom, mostRecentRecord.Asset1Denom)
+		osmoutils.MustSet(store, key, &mostRecentRecord)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/osmosis-labs/osmosis/v14/x/twap/types"
+	"github.com/osmosis-labs/osmosis/v14/x/twap/types/twapmock"
 )
 
 func (s *TestSuite) TestMigrateExistingPools() {
@@ -68,6 +69,54 @@ func (s *TestSuite) TestMigrateExistingPoolsError() {
 	s.Require().Error(err)
 }
 
+// TestMigrateSquaredLogPriceAccumulators tests that the migration initializes
+// the squared log price accumulator of existing records to the values
+// they would have had if the accumulator existed when they were written.
+func (s *TestSuite) TestMigrateSquaredLogPriceAccumulators() {
+	poolId := s.PrepareBalancerPoolWithCoins(defaultTwoAssetCoins...)
+	programmableAmmInterface := twapmock.NewProgrammedAmmInterface(s.App.TwapKeeper.GetAmmInterface())
+	s.App.TwapKeeper.SetAmmInterface(programmableAmmInterface)
+
+	// write a few records with different spot prices.
+	for _, sp := range []sdk.Dec{sdk.NewDec(2), sdk.NewDec(8), sdk.NewDec(4)} {
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+		programmableAmmInterface.ProgramPoolSpotPriceOverride(poolId, denom0, denom1, sp, nil)
+		programmableAmmInterface.ProgramPoolSpotPriceOverride(poolId, denom1, denom0, sdk.OneDec().Quo(sp), nil)
+		s.Require().NoError(s.twapkeeper.UpdateRecords(s.Ctx, poolId))
+	}
+
+	expectedHistoricalRecords, err := s.twapkeeper.GetAllHistoricalPoolIndexedTWAPs(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(expectedHistoricalRecords, 4)
+	expectedMostRecentRecords, err := s.twapkeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().False(expectedMostRecentRecords[0].SquaredLogPriceAccumulator.IsZero())
+
+	// simulate records written before the accumulator existed.
+	for _, record := range expectedHistoricalRecords {
+		record.SquaredLogPriceAccumulator = sdk.Dec{}
+		s.twapkeeper.StoreNewRecord(s.Ctx, record)
+	}
+	mostRecentRecords, err := s.twapkeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().True(mostRecentRecords[0].SquaredLogPriceAccumulator.IsZero())
+
+	err = s.twapkeeper.MigrateSquaredLogPriceAccumulators(s.Ctx)
+	s.Require().NoError(err)
+
+	historicalRecords, err := s.twapkeeper.GetAllHistoricalPoolIndexedTWAPs(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedHistoricalRecords, historicalRecords)
+
+	timeIndexedRecords, err := s.twapkeeper.GetAllHistoricalTimeIndexedTWAPs(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedHistoricalRecords, timeIndexedRecords)
+
+	mostRecentRecords, err = s.twapkeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedMostRecentRecords, mostRecentRecords)
+}
+
 // TestTwapRecord_GeometricTwap_MarshalUnmarshal this test proves that migrations
 // to initialize geometric twap accumulators are not required.
 // This is because proto marshalling will initialize the field to the zero value.
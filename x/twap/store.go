@@ -2,6 +2,7 @@ package twap
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"time"
 
@@ -73,15 +74,57 @@ func (k Keeper) storeHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
 // So, in order to have correct behavior for the desired guarantee,
 // we keep the newest record that is older than the pruning time.
 // This is why we would keep the -50 hour and -1hour twaps despite a 48hr pruning period
-func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time) error {
+//
+// poolLastKeptTimes overrides lastKeptTime for the pools it contains.
+func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time, poolLastKeptTimes map[uint64]time.Time) error {
+	// We iterate up to the latest of the last kept times, and skip the records
+	// of pools that are within their own keep period.
+	latestLastKeptTime := lastKeptTime
+	for _, poolLastKeptTime := range poolLastKeptTimes {
+		if poolLastKeptTime.After(latestLastKeptTime) {
+			latestLastKeptTime = poolLastKeptTime
+		}
+	}
+	isPrunable := func(twap types.TwapRecord) bool {
+		poolLastKeptTime, ok := poolLastKeptTimes[twap.PoolId]
+		if !ok {
+			poolLastKeptTime = lastKeptTime
+		}
+		return twap.Time.Before(poolLastKeptTime)
+	}
+
+	return k.pruneTimeIndexedRecordsButNewest(ctx,
+		[]byte(types.HistoricalTWAPTimeIndexPrefix),
+		types.FormatHistoricalTimeIndexTWAPKey(latestLastKeptTime, 0, "", ""),
+		isPrunable,
+		k.deleteHistoricalRecord)
+}
+
+// pruneArchivedRecordsBeforeTimeButNewest prunes all archived records for each pool before the given time
+// but the newest record, for the same reasons as pruneRecordsBeforeTimeButNewest.
+func (k Keeper) pruneArchivedRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time) error {
+	return k.pruneTimeIndexedRecordsButNewest(ctx,
+		[]byte(types.ArchivedTWAPTimeIndexPrefix),
+		types.FormatArchivedTimeIndexTWAPKey(lastKeptTime, 0, "", ""),
+		func(types.TwapRecord) bool { return true },
+		k.deleteArchivedRecord)
+}
+
+// pruneTimeIndexedRecordsButNewest deletes all prunable records in the time index with the given prefix
+// before the given end key, except for the newest prunable record of each (pool id, asset 0, asset 1) triplet.
+func (k Keeper) pruneTimeIndexedRecordsButNewest(
+	ctx sdk.Context,
+	timeIndexPrefix []byte,
+	endKey []byte,
+	isPrunable func(types.TwapRecord) bool,
+	deleteRecord func(sdk.Context, types.TwapRecord),
+) error {
 	store := ctx.KVStore(k.storeKey)
 
 	// Reverse iterator guarantees that we iterate through the newest per pool first.
 	// Due to how it is indexed, we will only iterate times starting from
-	// lastKeptTime exclusively down to the oldest record.
-	iter := store.ReverseIterator(
-		[]byte(types.HistoricalTWAPTimeIndexPrefix),
-		types.FormatHistoricalTimeIndexTWAPKey(lastKeptTime, 0, "", ""))
+	// the end key exclusively down to the oldest record.
+	iter := store.ReverseIterator(timeIndexPrefix, endKey)
 	defer iter.Close()
 
 	// We mark what (pool id, asset 0, asset 1) triplets we've seen.
@@ -99,6 +142,10 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime ti
 			return err
 		}
 
+		if !isPrunable(twapToRemove) {
+			continue
+		}
+
 		poolKey := uniqueTriplet{
 			poolId: twapToRemove.PoolId,
 			asset0: twapToRemove.Asset0Denom,
@@ -110,7 +157,7 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime ti
 			continue
 		}
 
-		k.deleteHistoricalRecord(ctx, twapToRemove)
+		deleteRecord(ctx, twapToRemove)
 	}
 	return nil
 }
@@ -123,6 +170,23 @@ func (k Keeper) deleteHistoricalRecord(ctx sdk.Context, twap types.TwapRecord) {
 	store.Delete(key2)
 }
 
+// storeArchivedRecord writes a twap to the archived record store, in all needed indexing.
+func (k Keeper) storeArchivedRecord(ctx sdk.Context, twap types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key1 := types.FormatArchivedTimeIndexTWAPKey(twap.Time, twap.PoolId, twap.Asset0Denom, twap.Asset1Denom)
+	key2 := types.FormatArchivedPoolIndexTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, twap.Time)
+	osmoutils.MustSet(store, key1, &twap)
+	osmoutils.MustSet(store, key2, &twap)
+}
+
+func (k Keeper) deleteArchivedRecord(ctx sdk.Context, twap types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key1 := types.FormatArchivedTimeIndexTWAPKey(twap.Time, twap.PoolId, twap.Asset0Denom, twap.Asset1Denom)
+	key2 := types.FormatArchivedPoolIndexTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, twap.Time)
+	store.Delete(key1)
+	store.Delete(key2)
+}
+
 // getMostRecentRecordStoreRepresentation returns the most recent twap record in the store
 // for the provided (pool, asset0, asset1) triplet.
 // Its called store representation, because most recent record can refer to it being
@@ -157,11 +221,15 @@ func (k Keeper) getAllHistoricalTimeIndexedTWAPs(ctx sdk.Context) ([]types.TwapR
 }
 
 // getAllHistoricalPoolIndexedTWAPs returns all historical TWAPs indexed by pool id.
-// nolint: unused
 func (k Keeper) getAllHistoricalPoolIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
 	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.HistoricalTWAPPoolIndexPrefix), types.ParseTwapFromBz)
 }
 
+// getAllArchivedTimeIndexedTWAPs returns all archived TWAPs indexed by time.
+func (k Keeper) getAllArchivedTimeIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.ArchivedTWAPTimeIndexPrefix), types.ParseTwapFromBz)
+}
+
 // storeNewRecord stores a record, in both the most recent record store and historical stores.
 func (k Keeper) storeNewRecord(ctx sdk.Context, twap types.TwapRecord) {
 	store := ctx.KVStore(k.storeKey)
@@ -170,7 +238,34 @@ func (k Keeper) storeNewRecord(ctx sdk.Context, twap types.TwapRecord) {
 	k.storeHistoricalTWAP(ctx, twap)
 }
 
-// getRecordAtOrBeforeTime on a given input (id, t, asset0, asset1)
+// getRecordAtOrBeforeTime returns the latest record at or before t for (id, asset0, asset1).
+// Within the record history keep period of the pool, it is the historical record returned by
+// getHistoricalRecordAtOrBeforeTime.
+// Before the keep period, the historical records around t may have been pruned,
+// so the archived record at or before t is returned instead if it is more recent,
+// or if there is no historical record at or before t.
+func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.Time, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
+	twap, err := k.getHistoricalRecordAtOrBeforeTime(ctx, poolId, t, asset0Denom, asset1Denom)
+	lastKeptTime := ctx.BlockTime().Add(-k.PoolRecordHistoryKeepPeriod(ctx, poolId))
+	if !t.Before(lastKeptTime) {
+		return twap, err
+	}
+	var tooOldErr timeTooOldError
+	if err != nil && !errors.As(err, &tooOldErr) {
+		return types.TwapRecord{}, err
+	}
+
+	archivedTwap, archiveErr := k.getArchivedRecordAtOrBeforeTime(ctx, poolId, t, asset0Denom, asset1Denom)
+	if archiveErr != nil {
+		return twap, err
+	}
+	if err != nil || archivedTwap.Time.After(twap.Time) {
+		return archivedTwap, nil
+	}
+	return twap, nil
+}
+
+// getHistoricalRecordAtOrBeforeTime on a given input (id, t, asset0, asset1)
 // returns the TWAP record from state for (id, t', asset0, asset1),
 // where t' is such that:
 // * t' <= t
@@ -182,7 +277,7 @@ func (k Keeper) storeNewRecord(ctx sdk.Context, twap types.TwapRecord) {
 //
 // * there is no record for the asset pair (asset0, asset1) in particular
 //   - e.g. asset not in pool, or provided in wrong order.
-func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.Time, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
+func (k Keeper) getHistoricalRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.Time, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
 	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
 	if err != nil {
 		return types.TwapRecord{}, err
@@ -211,3 +306,22 @@ func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.T
 
 	return twap, nil
 }
+
+// getArchivedRecordAtOrBeforeTime returns the latest archived record at or before t
+// for (id, asset0, asset1). Returns an error if there is no such record.
+func (k Keeper) getArchivedRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.Time, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	startKey := types.FormatArchivedPoolIndexTimePrefix(poolId, asset0Denom, asset1Denom)
+	endKey := types.FormatArchivedPoolIndexTimeSuffix(poolId, asset0Denom, asset1Denom, t)
+	reverseIterate := true
+
+	twap, err := osmoutils.GetFirstValueInRange(store, startKey, endKey, reverseIterate, types.ParseTwapFromBz)
+	if err != nil {
+		return types.TwapRecord{}, timeTooOldError{Time: t}
+	}
+	return twap, nil
+}
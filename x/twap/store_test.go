@@ -251,6 +251,83 @@ func (s *TestSuite) TestGetRecordAtOrBeforeTime() {
 // TestPruneRecordsBeforeTime tests that all twap records earlier than
 // current block time - given time are pruned from the store while
 // the newest record for each pool before the time to keep is preserved.
+// TestGetRecordAtOrBeforeTime_ArchivedRecords tests that archived records are used
+// before the record history keep period, if they are closer to the requested time
+// than the historical records.
+func (s *TestSuite) TestGetRecordAtOrBeforeTime_ArchivedRecords() {
+	keepPeriod := types.DefaultParams().RecordHistoryKeepPeriod
+	tMinKeepPeriod := baseTime.Add(-keepPeriod)
+
+	historicalRecords := []types.TwapRecord{
+		newEmptyPriceRecord(1, tMinKeepPeriod.Add(-2*time.Hour), denom0, denom1),
+		newEmptyPriceRecord(1, baseTime.Add(-time.Hour), denom0, denom1),
+	}
+	archivedRecords := []types.TwapRecord{
+		newEmptyPriceRecord(1, tMinKeepPeriod.Add(-50*time.Hour), denom0, denom1),
+		newEmptyPriceRecord(1, tMinKeepPeriod.Add(-12*time.Hour), denom0, denom1),
+		newEmptyPriceRecord(1, tMinKeepPeriod.Add(-time.Hour), denom0, denom1),
+	}
+
+	tests := map[string]struct {
+		t                time.Time
+		poolKeepPeriods  []types.PoolRecordHistoryKeepPeriod
+		expectedRecord   types.TwapRecord
+		expectTimeTooOld bool
+	}{
+		"within keep period; historical record": {
+			t:              baseTime.Add(-30 * time.Minute),
+			expectedRecord: historicalRecords[1],
+		},
+		"before keep period; archived record more recent than historical record": {
+			t:              tMinKeepPeriod.Add(-30 * time.Minute),
+			expectedRecord: archivedRecords[2],
+		},
+		"before keep period; historical record more recent than archived record": {
+			t:              tMinKeepPeriod.Add(-90 * time.Minute),
+			expectedRecord: historicalRecords[0],
+		},
+		"before keep period; no historical record; archived record": {
+			t:              tMinKeepPeriod.Add(-6 * time.Hour),
+			expectedRecord: archivedRecords[1],
+		},
+		"before all records; error": {
+			t:                tMinKeepPeriod.Add(-51 * time.Hour),
+			expectTimeTooOld: true,
+		},
+		"within pool keep period override; historical record": {
+			t:               tMinKeepPeriod.Add(-30 * time.Minute),
+			poolKeepPeriods: []types.PoolRecordHistoryKeepPeriod{{PoolId: 1, KeepPeriod: 2 * keepPeriod}},
+			expectedRecord:  historicalRecords[0],
+		},
+		"other pool keep period override; archived record": {
+			t:               tMinKeepPeriod.Add(-30 * time.Minute),
+			poolKeepPeriods: []types.PoolRecordHistoryKeepPeriod{{PoolId: 2, KeepPeriod: 2 * keepPeriod}},
+			expectedRecord:  archivedRecords[2],
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(baseTime)
+			params := types.DefaultParams()
+			params.PoolRecordHistoryKeepPeriods = test.poolKeepPeriods
+			s.twapkeeper.SetParams(s.Ctx, params)
+			s.preSetRecords(historicalRecords)
+			for _, record := range archivedRecords {
+				s.twapkeeper.StoreArchivedRecord(s.Ctx, record)
+			}
+
+			record, err := s.twapkeeper.GetRecordAtOrBeforeTime(s.Ctx, 1, test.t, denom0, denom1)
+			if test.expectTimeTooOld {
+				s.Require().ErrorIs(err, twap.TimeTooOldError{Time: test.t})
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedRecord, record)
+		})
+	}
+}
+
 func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 	// N.B.: the records follow the following naming convention:
 	// <pool id><delta from base time in seconds><delta from base time in milliseconds>
@@ -286,7 +363,8 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 		// across many test cases on purpose.
 		recordsToPreSet []types.TwapRecord
 
-		lastKeptTime time.Time
+		lastKeptTime      time.Time
+		poolLastKeptTimes map[uint64]time.Time
 
 		expectedKeptRecords []types.TwapRecord
 	}{
@@ -430,6 +508,36 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 				pool5Plus1SBaseMsAB, pool5Plus1SBaseMsAC, pool5Plus1SBaseMsBC, // base time + 1s; kept since older
 			},
 		},
+		"base time; pools 1 and 3; pool 3 keeps records for longer; only pool 1 pruned": {
+			recordsToPreSet: []types.TwapRecord{
+				pool3BaseSecMin3Ms, // base time - 3ms; kept since newest before pool 3 lastKeptTime
+				pool1Min2SMin2Ms,   // base time - 2s - 2ms; deleted
+				pool3BaseSecBaseMs, // base time; kept since older than pool 3 lastKeptTime
+				pool1Min2SBaseMs,   // base time - 2s; kept since newest before lastKeptTime
+				pool3BaseSecMin1Ms, // base time - 1ms; kept since older than pool 3 lastKeptTime
+				pool1Min2SMin1Ms,   // base time - 2s - 1ms; deleted
+				pool3BaseSecMin2Ms, // base time - 2ms; kept since at pool 3 lastKeptTime
+			},
+
+			lastKeptTime:      baseTime,
+			poolLastKeptTimes: map[uint64]time.Time{pool3BaseSecBaseMs.PoolId: baseTime.Add(2 * -time.Millisecond)},
+
+			expectedKeptRecords: []types.TwapRecord{pool1Min2SBaseMs, pool3BaseSecMin3Ms, pool3BaseSecMin2Ms, pool3BaseSecMin1Ms, pool3BaseSecBaseMs},
+		},
+		"base time - 3s; pools 1 and 3; pool 1 keeps records for shorter; only pool 1 pruned": {
+			recordsToPreSet: []types.TwapRecord{
+				pool3BaseSecMin3Ms, // base time - 3ms; kept since older than lastKeptTime
+				pool1Min2SMin3Ms,   // base time - 2s - 3ms; deleted
+				pool3BaseSecBaseMs, // base time; kept since older than lastKeptTime
+				pool1Min2SBaseMs,   // base time - 2s; kept since newest before pool 1 lastKeptTime
+				pool1Min2SMin1Ms,   // base time - 2s - 1ms; deleted
+			},
+
+			lastKeptTime:      baseTime.Add(-3 * time.Second),
+			poolLastKeptTimes: map[uint64]time.Time{pool1Min2SBaseMs.PoolId: baseTime},
+
+			expectedKeptRecords: []types.TwapRecord{pool1Min2SBaseMs, pool3BaseSecMin3Ms, pool3BaseSecBaseMs},
+		},
 		"no pre-set records - no error": {
 			recordsToPreSet: []types.TwapRecord{},
 
@@ -446,7 +554,7 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 			ctx := s.Ctx
 			twapKeeper := s.twapkeeper
 
-			err := twapKeeper.PruneRecordsBeforeTimeButNewest(ctx, tc.lastKeptTime)
+			err := twapKeeper.PruneRecordsBeforeTimeButNewest(ctx, tc.lastKeptTime, tc.poolLastKeptTimes)
 			s.Require().NoError(err)
 
 			s.validateExpectedRecords(tc.expectedKeptRecords)
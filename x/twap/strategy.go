@@ -10,7 +10,8 @@ import (
 )
 
 // twapStrategy is an interface for computing TWAPs.
-// We have two strategies implementing the interface - arithmetic and geometric.
+// We have four strategies implementing the interface - arithmetic, geometric,
+// harmonic and volatility.
 // We expose a common TWAP API to reduce duplication and avoid complexity.
 type twapStrategy interface {
 	// computeTwap calculates the TWAP with specific startRecord and endRecord.
@@ -25,6 +26,18 @@ type geometric struct {
 	TwapKeeper Keeper
 }
 
+type harmonic struct {
+	TwapKeeper Keeper
+}
+
+type volatility struct {
+	TwapKeeper Keeper
+}
+
+// ln2 is the natural logarithm of 2, used to convert base 2 logarithms
+// into natural logarithms.
+var ln2 = sdk.MustNewDecFromStr("0.693147180559945309")
+
 // computeTwap computes and returns an arithmetic TWAP between
 // two records given the quote asset.
 func (s *arithmetic) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
@@ -72,3 +85,61 @@ func (s *geometric) computeTwap(startRecord types.TwapRecord, endRecord types.Tw
 	// by the underlying spot price function.
 	return osmomath.SigFigRound(result.SDKDec(), gammtypes.SpotPriceSigFigs)
 }
+
+// computeTwap computes and returns a harmonic mean TWAP between
+// two records given the quote asset.
+// The harmonic mean of the spot price of the base asset is the reciprocal of
+// the arithmetic mean of its reciprocal, which is the spot price of the quote asset.
+// As a result, it is derived from the arithmetic accumulator of the other asset.
+func (s *harmonic) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
+	var accumDiff sdk.Dec
+	if quoteAsset == startRecord.Asset0Denom {
+		accumDiff = endRecord.P1ArithmeticTwapAccumulator.Sub(startRecord.P1ArithmeticTwapAccumulator)
+	} else {
+		accumDiff = endRecord.P0ArithmeticTwapAccumulator.Sub(startRecord.P0ArithmeticTwapAccumulator)
+	}
+
+	if accumDiff.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	timeDelta := endRecord.Time.Sub(startRecord.Time)
+	arithmeticMeanOfReciprocals := types.AccumDiffDivDuration(accumDiff, timeDelta)
+	if arithmeticMeanOfReciprocals.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	result := sdk.OneDec().Quo(arithmeticMeanOfReciprocals)
+
+	// N.B. we round because this is the max number of significant figures supported
+	// by the underlying spot price function.
+	return osmomath.SigFigRound(result, gammtypes.SpotPriceSigFigs)
+}
+
+// computeTwap computes and returns the realized volatility between two records.
+// The volatility is the standard deviation of the natural logarithm of the spot price
+// over the time window. It is computed as sqrt(E[l^2] - E[l]^2) where l is
+// the base 2 logarithm of the spot price, scaled by ln(2).
+// Since log(1/P) = -log(P), the result does not depend on the quote asset.
+func (s *volatility) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
+	timeDelta := endRecord.Time.Sub(startRecord.Time)
+
+	logAccumDiff := endRecord.GeometricTwapAccumulator.Sub(startRecord.GeometricTwapAccumulator)
+	squaredLogAccumDiff := endRecord.SquaredLogPriceAccumulator.Sub(startRecord.SquaredLogPriceAccumulator)
+
+	meanOfLogPrices := types.AccumDiffDivDuration(logAccumDiff, timeDelta)
+	meanOfSquaredLogPrices := types.AccumDiffDivDuration(squaredLogAccumDiff, timeDelta)
+
+	variance := meanOfSquaredLogPrices.Sub(meanOfLogPrices.Mul(meanOfLogPrices))
+	// N.B. the variance may be slightly negative due to rounding errors
+	// when the spot price did not change over the window.
+	if !variance.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	stdDev, err := variance.ApproxSqrt()
+	if err != nil {
+		return sdk.ZeroDec()
+	}
+	return stdDev.Mul(ln2)
+}
@@ -35,6 +35,10 @@ func (s *TestSuite) TestComputeTwap() {
 		TwapKeeper: *s.App.TwapKeeper,
 	}
 
+	volatilityStrategy := &twap.VolatilityStrategy{
+		TwapKeeper: *s.App.TwapKeeper,
+	}
+
 	tests := map[string]computeTwapTestCase{
 		"arithmetic only, basic: spot price = 1 for one second, 0 init accumulator": {
 			startRecord: newOneSidedRecord(baseTime, sdk.ZeroDec(), true),
@@ -82,6 +86,15 @@ func (s *TestSuite) TestComputeTwap() {
 		"geometric only: accumulator = log(10)*OneSec, t=5s. 0 base accum": geometricTestCaseFromDeltas0(
 			s, sdk.ZeroDec(), geometricTenSecAccum, 5*time.Second, twap.TwapPow(geometricTenSecAccum.QuoInt64(5*1000))),
 		"geometric only: accumulator = log(10)*OneSec, t=100s. 0 base accum (asset 1)": geometricTestCaseFromDeltas1(s, sdk.ZeroDec(), geometricTenSecAccum, 100*time.Second, sdk.OneDec().Quo(twap.TwapPow(geometricTenSecAccum.QuoInt64(100*1000)))),
+		"volatility only: same record, zero volatility": {
+			startRecord: newOneSidedRecord(baseTime, sdk.ZeroDec(), true),
+			endRecord:   newOneSidedRecord(baseTime, sdk.ZeroDec(), true),
+			quoteAsset:  denom1,
+			twapStrategies: []twap.TwapStrategy{
+				volatilityStrategy,
+			},
+			expTwap: sdk.ZeroDec(),
+		},
 		"three asset same record: asset1, end spot price = 1": {
 			startRecord: newThreeAssetOneSidedRecord(baseTime, sdk.ZeroDec(), true)[1],
 			endRecord:   newThreeAssetOneSidedRecord(baseTime, sdk.ZeroDec(), true)[1],
@@ -297,6 +310,119 @@ func (s *TestSuite) TestComputeGeometricStrategyTwap() {
 	}
 }
 
+// TestComputeHarmonicStrategyTwap tests harmonic strategy's computeTwap.
+// The harmonic mean of the base asset price is derived from the arithmetic
+// accumulator of the other asset.
+func (s *TestSuite) TestComputeHarmonicStrategyTwap() {
+	// asset0 spot price of 2 for one second and 8 for one second.
+	// asset1 spot price is then 0.5 for one second and 0.125 for one second.
+	twoThenEightP0Accum := OneSec.MulInt64(2).Add(OneSec.MulInt64(8))
+	twoThenEightP1Accum := OneSec.QuoInt64(2).Add(OneSec.QuoInt64(8))
+
+	tests := map[string]computeTwapTestCase{
+		"denom0: spot price = 10 for one second": {
+			startRecord: newOneSidedRecord(baseTime, sdk.ZeroDec(), false),
+			endRecord:   newOneSidedRecord(tPlusOne, OneSec.QuoInt64(10), false),
+			quoteAsset:  denom0,
+			expTwap:     sdk.NewDec(10),
+		},
+		"denom1: spot price = 0.1 for one second": {
+			startRecord: newOneSidedRecord(baseTime, sdk.ZeroDec(), true),
+			endRecord:   newOneSidedRecord(tPlusOne, tenSecAccum, true),
+			quoteAsset:  denom1,
+			expTwap:     sdk.NewDecWithPrec(1, 1),
+		},
+		// arithmetic mean is 5, geometric mean is 4, harmonic mean is 2 / (1/2 + 1/8) = 3.2
+		"denom0: spot price = 2 then 8, harmonic mean below geometric mean": {
+			startRecord: newOneSidedRecord(baseTime, sdk.ZeroDec(), false),
+			endRecord:   newOneSidedRecord(baseTime.Add(2*time.Second), twoThenEightP1Accum, false),
+			quoteAsset:  denom0,
+			expTwap:     sdk.MustNewDecFromStr("3.2"),
+		},
+		// harmonic mean of 0.5 and 0.125 is 2 / (2 + 8) = 0.2
+		"denom1: spot price = 0.5 then 0.125": {
+			startRecord: newOneSidedRecord(baseTime, sdk.ZeroDec(), true),
+			endRecord:   newOneSidedRecord(baseTime.Add(2*time.Second), twoThenEightP0Accum, true),
+			quoteAsset:  denom1,
+			expTwap:     sdk.MustNewDecFromStr("0.2"),
+		},
+		"base accum has no impact": {
+			startRecord: newOneSidedRecord(baseTime, tenSecAccum, false),
+			endRecord:   newOneSidedRecord(baseTime.Add(2*time.Second), tenSecAccum.Add(twoThenEightP1Accum), false),
+			quoteAsset:  denom0,
+			expTwap:     sdk.MustNewDecFromStr("3.2"),
+		},
+		"zero accum difference - return zero": {
+			startRecord: newOneSidedRecord(baseTime, sdk.ZeroDec(), false),
+			endRecord:   newOneSidedRecord(tPlusOne, sdk.ZeroDec(), false),
+			quoteAsset:  denom0,
+			expTwap:     sdk.ZeroDec(),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			harmonicStrategy := &twap.HarmonicTwapStrategy{TwapKeeper: *s.App.TwapKeeper}
+			actualTwap := harmonicStrategy.ComputeTwap(tc.startRecord, tc.endRecord, tc.quoteAsset)
+			s.Require().Equal(tc.expTwap, actualTwap)
+		})
+	}
+}
+
+// TestComputeVolatilityStrategyTwap tests volatility strategy's computeTwap.
+// The result is the standard deviation of the natural logarithm of the spot price
+// and must not depend on the quote asset.
+func (s *TestSuite) TestComputeVolatilityStrategyTwap() {
+	newVolatilityRecord := func(t time.Time, geomAccum, squaredLogAccum sdk.Dec) types.TwapRecord {
+		record := newOneSidedGeometricRecord(t, geomAccum)
+		record.SquaredLogPriceAccumulator = squaredLogAccum
+		return record
+	}
+
+	// log2 spot price of 1 for one second and 3 for one second.
+	// The mean is 2, the mean of the squares is 5, so the variance is 1.
+	oneThenThreeGeomAccum := OneSec.MulInt64(1 + 3)
+	oneThenThreeSquaredLogAccum := OneSec.MulInt64(1 + 9)
+	ln2 := sdk.MustNewDecFromStr("0.693147180559945309")
+
+	tests := map[string]computeTwapTestCase{
+		"constant spot price - zero volatility": {
+			startRecord: newVolatilityRecord(baseTime, sdk.ZeroDec(), sdk.ZeroDec()),
+			endRecord:   newVolatilityRecord(tPlusOne, geometricTenSecAccum, squaredLogTenSecAccum),
+			quoteAsset:  denom0,
+			expTwap:     sdk.ZeroDec(),
+		},
+		"spot price = 2 then 8, denom0": {
+			startRecord: newVolatilityRecord(baseTime, sdk.ZeroDec(), sdk.ZeroDec()),
+			endRecord:   newVolatilityRecord(baseTime.Add(2*time.Second), oneThenThreeGeomAccum, oneThenThreeSquaredLogAccum),
+			quoteAsset:  denom0,
+			expTwap:     ln2,
+		},
+		"spot price = 2 then 8, denom1": {
+			startRecord: newVolatilityRecord(baseTime, sdk.ZeroDec(), sdk.ZeroDec()),
+			endRecord:   newVolatilityRecord(baseTime.Add(2*time.Second), oneThenThreeGeomAccum, oneThenThreeSquaredLogAccum),
+			quoteAsset:  denom1,
+			expTwap:     ln2,
+		},
+		"base accum has no impact": {
+			startRecord: newVolatilityRecord(baseTime, geometricTenSecAccum, squaredLogTenSecAccum),
+			endRecord:   newVolatilityRecord(baseTime.Add(2*time.Second), geometricTenSecAccum.Add(oneThenThreeGeomAccum), squaredLogTenSecAccum.Add(oneThenThreeSquaredLogAccum)),
+			quoteAsset:  denom0,
+			expTwap:     ln2,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			volatilityStrategy := &twap.VolatilityStrategy{TwapKeeper: *s.App.TwapKeeper}
+			actualVolatility := volatilityStrategy.ComputeTwap(tc.startRecord, tc.endRecord, tc.quoteAsset)
+			osmoassert.DecApproxEq(s.T(), tc.expTwap, actualVolatility, sdk.MustNewDecFromStr("0.000000001"))
+		})
+	}
+}
+
 func (s *TestSuite) TestComputeArithmeticStrategyTwap_ThreeAsset() {
 	tenSecAccum := OneSec.MulInt64(10)
 	pointOneAccum := OneSec.QuoInt64(10)
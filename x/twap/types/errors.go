@@ -62,3 +62,29 @@ type InvalidRecordCountError struct {
 func (e InvalidRecordCountError) Error() string {
 	return fmt.Sprintf("The number of records do not match, expected: %d\n got: %d", e.Expected, e.Actual)
 }
+
+type UnknownTwapTypeError struct {
+	TwapType TwapType
+}
+
+func (e UnknownTwapTypeError) Error() string {
+	return fmt.Sprintf("unknown twap type (%d)", e.TwapType)
+}
+
+type TooManyTwapQueriesError struct {
+	Actual int
+	Max    int
+}
+
+func (e TooManyTwapQueriesError) Error() string {
+	return fmt.Sprintf("too many twap queries in a batch, max: %d, got: %d", e.Max, e.Actual)
+}
+
+type NoQuoteAssetLiquidityError struct {
+	PoolIds    []uint64
+	QuoteAsset string
+}
+
+func (e NoQuoteAssetLiquidityError) Error() string {
+	return fmt.Sprintf("pools %v have no liquidity of quote asset %s", e.PoolIds, e.QuoteAsset)
+}
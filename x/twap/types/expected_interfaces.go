@@ -15,4 +15,7 @@ type AmmInterface interface {
 		quoteAssetDenom string,
 		baseAssetDenom string,
 	) (price sdk.Dec, err error)
+	// GetActiveLiquidityReserves returns the reserves backing swaps against the pool at its current price.
+	// For concentrated liquidity pools, these only include the liquidity in range of the current price.
+	GetActiveLiquidityReserves(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
 }
@@ -29,6 +29,12 @@ func (g *GenesisState) Validate() error {
 			return err
 		}
 	}
+
+	for _, twap := range g.ArchivedTwaps {
+		if err := twap.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -85,5 +91,9 @@ func (t TwapRecord) validate() error {
 	if t.GeometricTwapAccumulator.IsNil() {
 		return fmt.Errorf("twap record geometric accumulator cannot be nil, was (%s)", t.GeometricTwapAccumulator)
 	}
+
+	if t.SquaredLogPriceAccumulator.IsNil() || t.SquaredLogPriceAccumulator.IsNegative() {
+		return fmt.Errorf("twap record squared log price accumulator cannot be negative, was (%s)", t.SquaredLogPriceAccumulator)
+	}
 	return nil
 }
@@ -33,6 +33,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	PruneEpochIdentifier    string        `protobuf:"bytes,1,opt,name=prune_epoch_identifier,json=pruneEpochIdentifier,proto3" json:"prune_epoch_identifier,omitempty"`
 	RecordHistoryKeepPeriod time.Duration `protobuf:"bytes,2,opt,name=record_history_keep_period,json=recordHistoryKeepPeriod,proto3,stdduration" json:"record_history_keep_period" yaml:"record_history_keep_period"`
+	// pool_record_history_keep_periods overrides the record history keep period
+	// of individual pools.
+	PoolRecordHistoryKeepPeriods []PoolRecordHistoryKeepPeriod `protobuf:"bytes,3,rep,name=pool_record_history_keep_periods,json=poolRecordHistoryKeepPeriods,proto3" json:"pool_record_history_keep_periods" yaml:"pool_record_history_keep_periods"`
+	// archived_record_interval is the interval at which records are archived.
+	// The first record of every interval is archived.
+	ArchivedRecordInterval time.Duration `protobuf:"bytes,4,opt,name=archived_record_interval,json=archivedRecordInterval,proto3,stdduration" json:"archived_record_interval" yaml:"archived_record_interval"`
+	// archived_record_keep_period is the period for which archived records are
+	// kept.
+	ArchivedRecordKeepPeriod time.Duration `protobuf:"bytes,5,opt,name=archived_record_keep_period,json=archivedRecordKeepPeriod,proto3,stdduration" json:"archived_record_keep_period" yaml:"archived_record_keep_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -82,19 +91,96 @@ func (m *Params) GetRecordHistoryKeepPeriod() time.Duration {
 	return 0
 }
 
+func (m *Params) GetPoolRecordHistoryKeepPeriods() []PoolRecordHistoryKeepPeriod {
+	if m != nil {
+		return m.PoolRecordHistoryKeepPeriods
+	}
+	return nil
+}
+
+func (m *Params) GetArchivedRecordInterval() time.Duration {
+	if m != nil {
+		return m.ArchivedRecordInterval
+	}
+	return 0
+}
+
+func (m *Params) GetArchivedRecordKeepPeriod() time.Duration {
+	if m != nil {
+		return m.ArchivedRecordKeepPeriod
+	}
+	return 0
+}
+
+// PoolRecordHistoryKeepPeriod is the record history keep period of a pool,
+// overriding the record history keep period of the module.
+type PoolRecordHistoryKeepPeriod struct {
+	PoolId     uint64        `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	KeepPeriod time.Duration `protobuf:"bytes,2,opt,name=keep_period,json=keepPeriod,proto3,stdduration" json:"keep_period" yaml:"keep_period"`
+}
+
+func (m *PoolRecordHistoryKeepPeriod) Reset()         { *m = PoolRecordHistoryKeepPeriod{} }
+func (m *PoolRecordHistoryKeepPeriod) String() string { return proto.CompactTextString(m) }
+func (*PoolRecordHistoryKeepPeriod) ProtoMessage()    {}
+func (*PoolRecordHistoryKeepPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRecordHistoryKeepPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRecordHistoryKeepPeriod.Merge(m, src)
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRecordHistoryKeepPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRecordHistoryKeepPeriod proto.InternalMessageInfo
+
+func (m *PoolRecordHistoryKeepPeriod) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolRecordHistoryKeepPeriod) GetKeepPeriod() time.Duration {
+	if m != nil {
+		return m.KeepPeriod
+	}
+	return 0
+}
+
 // GenesisState defines the twap module's genesis state.
 type GenesisState struct {
 	// twaps is the collection of all twap records.
 	Twaps []TwapRecord `protobuf:"bytes,1,rep,name=twaps,proto3" json:"twaps"`
 	// params is the container of twap parameters.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// archived_twaps is the collection of all archived twap records.
+	ArchivedTwaps []TwapRecord `protobuf:"bytes,3,rep,name=archived_twaps,json=archivedTwaps,proto3" json:"archived_twaps"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+	return fileDescriptor_3f4bdf49b69bd63c, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,8 +223,16 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetArchivedTwaps() []TwapRecord {
+	if m != nil {
+		return m.ArchivedTwaps
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.twap.v1beta1.Params")
+	proto.RegisterType((*PoolRecordHistoryKeepPeriod)(nil), "osmosis.twap.v1beta1.PoolRecordHistoryKeepPeriod")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.twap.v1beta1.GenesisState")
 }
 
@@ -147,32 +241,43 @@ func init() {
 }
 
 var fileDescriptor_3f4bdf49b69bd63c = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbf, 0xce, 0xd3, 0x30,
-	0x14, 0xc5, 0x63, 0xfe, 0x54, 0x22, 0x1f, 0x53, 0x54, 0x41, 0xbf, 0x0a, 0xa5, 0x21, 0x03, 0xea,
-	0x52, 0x9b, 0x96, 0x4e, 0x15, 0x53, 0x05, 0xe2, 0xdf, 0x52, 0x05, 0x26, 0x96, 0xc8, 0x49, 0x6e,
-	0x53, 0x8b, 0x26, 0xb6, 0x6c, 0xa7, 0x25, 0x0f, 0x80, 0xc4, 0xc8, 0xc8, 0x13, 0xa1, 0x8e, 0x1d,
-	0x99, 0x0a, 0x6a, 0xdf, 0x80, 0x27, 0x40, 0x89, 0x5d, 0x06, 0xd4, 0x6f, 0xcb, 0xd5, 0xef, 0x9c,
-	0xa3, 0x93, 0x7b, 0xed, 0x86, 0x5c, 0x15, 0x5c, 0x31, 0x45, 0xf4, 0x96, 0x0a, 0xb2, 0x19, 0x27,
-	0xa0, 0xe9, 0x98, 0xe4, 0x50, 0x82, 0x62, 0x0a, 0x0b, 0xc9, 0x35, 0xf7, 0xba, 0x56, 0x83, 0x1b,
-	0x0d, 0xb6, 0x9a, 0x7e, 0x37, 0xe7, 0x39, 0x6f, 0x05, 0xa4, 0xf9, 0x32, 0xda, 0xfe, 0x93, 0x8b,
-	0x79, 0xcd, 0x10, 0x4b, 0x48, 0xb9, 0xcc, 0xac, 0xee, 0x3a, 0xe7, 0x3c, 0x5f, 0x03, 0x69, 0xa7,
-	0xa4, 0x5a, 0x12, 0x5a, 0xd6, 0x67, 0x94, 0xb6, 0x19, 0xb1, 0xc9, 0x36, 0x83, 0x45, 0xfe, 0xff,
-	0xae, 0xac, 0x92, 0x54, 0x33, 0x5e, 0x1a, 0x1e, 0xfe, 0x40, 0x6e, 0x67, 0x41, 0x25, 0x2d, 0x94,
-	0x37, 0x75, 0x1f, 0x08, 0x59, 0x95, 0x10, 0x83, 0xe0, 0xe9, 0x2a, 0x66, 0x19, 0x94, 0x9a, 0x2d,
-	0x19, 0xc8, 0x1e, 0x0a, 0xd0, 0xf0, 0x5e, 0xd4, 0x6d, 0xe9, 0xcb, 0x06, 0xbe, 0xf9, 0xc7, 0xbc,
-	0x2f, 0xc8, 0xed, 0x9b, 0x9e, 0xf1, 0x8a, 0x29, 0xcd, 0x65, 0x1d, 0x7f, 0x02, 0x10, 0xb1, 0x00,
-	0xc9, 0x78, 0xd6, 0xbb, 0x15, 0xa0, 0xe1, 0xd5, 0xe4, 0x1a, 0x9b, 0x1a, 0xf8, 0x5c, 0x03, 0xbf,
-	0xb0, 0x35, 0xe6, 0xa3, 0xdd, 0x61, 0xe0, 0xfc, 0x39, 0x0c, 0x1e, 0xd7, 0xb4, 0x58, 0xcf, 0xc2,
-	0x9b, 0xa3, 0xc2, 0xef, 0xbf, 0x06, 0x28, 0x7a, 0x68, 0x04, 0xaf, 0x0d, 0x7f, 0x07, 0x20, 0x16,
-	0x86, 0x7e, 0x45, 0xee, 0xfd, 0x57, 0xe6, 0x08, 0xef, 0x35, 0xd5, 0xe0, 0x3d, 0x77, 0xef, 0x36,
-	0x4b, 0x54, 0x3d, 0x14, 0xdc, 0x1e, 0x5e, 0x4d, 0x02, 0x7c, 0xe9, 0x26, 0xf8, 0xc3, 0x96, 0x8a,
-	0xa8, 0x8d, 0x9c, 0xdf, 0x69, 0x9a, 0x44, 0xc6, 0xe4, 0xcd, 0xdc, 0x8e, 0x68, 0xd7, 0x62, 0xff,
-	0xe0, 0xd1, 0x65, 0xbb, 0x59, 0x9d, 0xb5, 0x5a, 0xc7, 0xfc, 0xed, 0xee, 0xe8, 0xa3, 0xfd, 0xd1,
-	0x47, 0xbf, 0x8f, 0x3e, 0xfa, 0x76, 0xf2, 0x9d, 0xfd, 0xc9, 0x77, 0x7e, 0x9e, 0x7c, 0xe7, 0xe3,
-	0xd3, 0x9c, 0xe9, 0x55, 0x95, 0xe0, 0x94, 0x17, 0xc4, 0xe6, 0x8d, 0xd6, 0x34, 0x51, 0xe7, 0x81,
-	0x6c, 0xc6, 0x53, 0xf2, 0xd9, 0xbc, 0x04, 0x5d, 0x0b, 0x50, 0x49, 0xa7, 0xdd, 0xd8, 0xb3, 0xbf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x84, 0x00, 0x47, 0x76, 0x02, 0x00, 0x00,
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x31, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc6, 0x73, 0xff, 0x26, 0xf9, 0x8b, 0x0b, 0x74, 0x38, 0x45, 0xc5, 0x4d, 0x2b, 0x27, 0x78,
+	0x80, 0x48, 0x55, 0x6d, 0x52, 0x3a, 0x55, 0x4c, 0x11, 0x08, 0x02, 0x42, 0x8a, 0x0c, 0x53, 0x17,
+	0xeb, 0x12, 0x5f, 0x9d, 0x53, 0x1d, 0xdf, 0xc9, 0x77, 0x49, 0xc9, 0x06, 0x03, 0x12, 0x23, 0x23,
+	0x9f, 0x80, 0x81, 0x4f, 0xd2, 0xb1, 0x1b, 0x4c, 0x01, 0x25, 0xdf, 0xa0, 0x9f, 0x00, 0xf9, 0xee,
+	0xdc, 0x96, 0x36, 0x21, 0xea, 0xe6, 0xd3, 0xf3, 0xbc, 0x8f, 0x7f, 0x7e, 0xdf, 0xf7, 0x0c, 0x1d,
+	0x26, 0x86, 0x4c, 0x50, 0xe1, 0xc9, 0x13, 0xcc, 0xbd, 0x71, 0xab, 0x47, 0x24, 0x6e, 0x79, 0x11,
+	0x49, 0x88, 0xa0, 0xc2, 0xe5, 0x29, 0x93, 0x0c, 0x55, 0x8d, 0xc7, 0xcd, 0x3c, 0xae, 0xf1, 0xd4,
+	0xaa, 0x11, 0x8b, 0x98, 0x32, 0x78, 0xd9, 0x93, 0xf6, 0xd6, 0x1e, 0x2e, 0xcc, 0xcb, 0x0e, 0x41,
+	0x4a, 0xfa, 0x2c, 0x0d, 0x8d, 0x6f, 0x33, 0x62, 0x2c, 0x8a, 0x89, 0xa7, 0x4e, 0xbd, 0xd1, 0x91,
+	0x87, 0x93, 0x49, 0x2e, 0xf5, 0x55, 0x46, 0xa0, 0xb3, 0xf5, 0xc1, 0x48, 0xf6, 0xf5, 0xaa, 0x70,
+	0x94, 0x62, 0x49, 0x59, 0xa2, 0x75, 0xe7, 0x63, 0x09, 0x96, 0xbb, 0x38, 0xc5, 0x43, 0x81, 0xf6,
+	0xe1, 0x06, 0x4f, 0x47, 0x09, 0x09, 0x08, 0x67, 0xfd, 0x41, 0x40, 0x43, 0x92, 0x48, 0x7a, 0x44,
+	0x49, 0x6a, 0x81, 0x06, 0x68, 0xde, 0xf1, 0xab, 0x4a, 0x7d, 0x9e, 0x89, 0x9d, 0x0b, 0x0d, 0x7d,
+	0x02, 0xb0, 0xa6, 0x39, 0x83, 0x01, 0x15, 0x92, 0xa5, 0x93, 0xe0, 0x98, 0x10, 0x1e, 0x70, 0x92,
+	0x52, 0x16, 0x5a, 0xff, 0x35, 0x40, 0xb3, 0xb2, 0xb7, 0xe9, 0x6a, 0x0c, 0x37, 0xc7, 0x70, 0x9f,
+	0x19, 0x8c, 0xf6, 0xee, 0xe9, 0xb4, 0x5e, 0x38, 0x9f, 0xd6, 0x1f, 0x4c, 0xf0, 0x30, 0x3e, 0x70,
+	0x96, 0x47, 0x39, 0x5f, 0x7f, 0xd5, 0x81, 0x7f, 0x5f, 0x1b, 0x5e, 0x6a, 0xfd, 0x35, 0x21, 0xbc,
+	0xab, 0x54, 0xf4, 0x1d, 0xc0, 0x06, 0x67, 0x2c, 0x0e, 0x96, 0x27, 0x08, 0x6b, 0xad, 0xb1, 0xd6,
+	0xac, 0xec, 0xb5, 0xdc, 0x45, 0xe3, 0x71, 0xbb, 0x8c, 0xc5, 0xfe, 0xe2, 0xf4, 0xb6, 0x67, 0x28,
+	0x1f, 0x69, 0xca, 0x55, 0x2f, 0x72, 0xfc, 0x6d, 0xbe, 0x3c, 0x4d, 0xa0, 0x0f, 0x00, 0x5a, 0x38,
+	0xed, 0x0f, 0xe8, 0x98, 0x84, 0x79, 0x0e, 0x4d, 0x24, 0x49, 0xc7, 0x38, 0xb6, 0x8a, 0xab, 0x5a,
+	0xb6, 0x63, 0x60, 0xea, 0x1a, 0x66, 0x59, 0x90, 0x6e, 0xd8, 0x46, 0x2e, 0x6b, 0x98, 0x8e, 0x11,
+	0xd1, 0x67, 0x00, 0xb7, 0xae, 0x57, 0x5e, 0x1d, 0x5c, 0x69, 0x15, 0x85, 0x6b, 0x28, 0x9c, 0xc5,
+	0x14, 0x37, 0x26, 0x67, 0xfd, 0x0d, 0x72, 0xd9, 0x0e, 0xe7, 0x1b, 0x80, 0x5b, 0xff, 0x68, 0x3e,
+	0xda, 0x81, 0xff, 0xab, 0x86, 0xd3, 0x50, 0x6d, 0x62, 0xb1, 0x8d, 0xce, 0xa7, 0xf5, 0xf5, 0x2b,
+	0x93, 0xa0, 0xa1, 0xe3, 0x97, 0xb3, 0xa7, 0x4e, 0x88, 0x0e, 0x61, 0xe5, 0x56, 0xfb, 0x67, 0x9b,
+	0xcf, 0x40, 0x3a, 0xef, 0x06, 0x36, 0x3c, 0xbe, 0x04, 0xfd, 0x01, 0xe0, 0xdd, 0x17, 0xfa, 0xa2,
+	0xbf, 0x95, 0x58, 0x12, 0xf4, 0x14, 0x96, 0xb2, 0x15, 0x12, 0x16, 0x50, 0x8b, 0xd5, 0x58, 0xbc,
+	0x58, 0xef, 0x4e, 0x30, 0xd7, 0xdf, 0xd6, 0x2e, 0x66, 0x6f, 0xf3, 0x75, 0x11, 0x3a, 0x80, 0x65,
+	0xae, 0xae, 0x9e, 0xa1, 0xdc, 0x5e, 0xb2, 0x97, 0xca, 0x63, 0x4a, 0x4d, 0x05, 0x7a, 0x03, 0xd7,
+	0x2f, 0x3a, 0xae, 0x11, 0xd6, 0x6e, 0x85, 0x70, 0x2f, 0xaf, 0xce, 0x14, 0xd1, 0x7e, 0x75, 0x3a,
+	0xb3, 0xc1, 0xd9, 0xcc, 0x06, 0xbf, 0x67, 0x36, 0xf8, 0x32, 0xb7, 0x0b, 0x67, 0x73, 0xbb, 0xf0,
+	0x73, 0x6e, 0x17, 0x0e, 0x1f, 0x47, 0x54, 0x0e, 0x46, 0x3d, 0xb7, 0xcf, 0x86, 0x9e, 0x89, 0xde,
+	0x8d, 0x71, 0x4f, 0xe4, 0x07, 0x6f, 0xdc, 0xda, 0xf7, 0xde, 0xeb, 0x9f, 0x97, 0x9c, 0x70, 0x22,
+	0x7a, 0x65, 0xd5, 0xe4, 0x27, 0x7f, 0x06, 0x00, 0x18, 0xfe, 0x6f, 0x36, 0x29, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -195,13 +300,43 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RecordHistoryKeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod):])
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ArchivedRecordKeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ArchivedRecordKeepPeriod):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintGenesis(dAtA, i, uint64(n1))
 	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ArchivedRecordInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ArchivedRecordInterval):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.PoolRecordHistoryKeepPeriods) > 0 {
+		for iNdEx := len(m.PoolRecordHistoryKeepPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolRecordHistoryKeepPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RecordHistoryKeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x12
 	if len(m.PruneEpochIdentifier) > 0 {
 		i -= len(m.PruneEpochIdentifier)
@@ -213,6 +348,42 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolRecordHistoryKeepPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolRecordHistoryKeepPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRecordHistoryKeepPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.KeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.KeepPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -233,6 +404,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ArchivedTwaps) > 0 {
+		for iNdEx := len(m.ArchivedTwaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedTwaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -283,6 +468,30 @@ func (m *Params) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod)
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolRecordHistoryKeepPeriods) > 0 {
+		for _, e := range m.PoolRecordHistoryKeepPeriods {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ArchivedRecordInterval)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ArchivedRecordKeepPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PoolRecordHistoryKeepPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.KeepPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -300,6 +509,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ArchivedTwaps) > 0 {
+		for _, e := range m.ArchivedTwaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -403,6 +618,208 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRecordHistoryKeepPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRecordHistoryKeepPeriods = append(m.PoolRecordHistoryKeepPeriods, PoolRecordHistoryKeepPeriod{})
+			if err := m.PoolRecordHistoryKeepPeriods[len(m.PoolRecordHistoryKeepPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedRecordInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ArchivedRecordInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedRecordKeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ArchivedRecordKeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRecordHistoryKeepPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRecordHistoryKeepPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRecordHistoryKeepPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.KeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -520,6 +937,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedTwaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedTwaps = append(m.ArchivedTwaps, TwapRecord{})
+			if err := m.ArchivedTwaps[len(m.ArchivedTwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -29,6 +29,7 @@ var (
 		P0ArithmeticTwapAccumulator: sdk.OneDec(),
 		P1ArithmeticTwapAccumulator: sdk.OneDec(),
 		GeometricTwapAccumulator:    sdk.OneDec(),
+		SquaredLogPriceAccumulator:  sdk.OneDec(),
 	}
 )
 
@@ -57,6 +58,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					P0ArithmeticTwapAccumulator: sdk.OneDec(),
 					P1ArithmeticTwapAccumulator: sdk.OneDec(),
 					GeometricTwapAccumulator:    sdk.OneDec(),
+					SquaredLogPriceAccumulator:  sdk.OneDec(),
 				},
 				{
 					PoolId:                      basePoolId,
@@ -69,6 +71,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					P0ArithmeticTwapAccumulator: sdk.OneDec(),
 					P1ArithmeticTwapAccumulator: sdk.OneDec(),
 					GeometricTwapAccumulator:    sdk.OneDec(),
+					SquaredLogPriceAccumulator:  sdk.OneDec(),
 				},
 			})
 	)
@@ -78,6 +81,11 @@ func TestGenesisState_Validate(t *testing.T) {
 		return record
 	}
 
+	withSquaredLogAcc := func(record TwapRecord, squaredLogAcc sdk.Dec) TwapRecord {
+		record.SquaredLogPriceAccumulator = squaredLogAcc
+		return record
+	}
+
 	testCases := map[string]struct {
 		twapGenesis *GenesisState
 
@@ -102,6 +110,14 @@ func TestGenesisState_Validate(t *testing.T) {
 			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withGeometricAcc(baseRecord, sdk.Dec{})}),
 			expectedErr: true,
 		},
+		"invalid squared log price acc is negative": {
+			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withSquaredLogAcc(baseRecord, sdk.NewDec(-1))}),
+			expectedErr: true,
+		},
+		"invalid squared log price acc is nil": {
+			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withSquaredLogAcc(baseRecord, sdk.Dec{})}),
+			expectedErr: true,
+		},
 		"invalid genesis - pool ID doesn't exist": {
 			twapGenesis: NewGenesisState(
 				NewParams("week", 48*time.Hour),
@@ -137,6 +153,54 @@ func TestGenesisState_Validate(t *testing.T) {
 					baseRecord,
 				}),
 
+			expectedErr: true,
+		},
+		"valid pool record history keep periods": {
+			twapGenesis: NewGenesisState(
+				withPoolRecordHistoryKeepPeriods(basicParams, PoolRecordHistoryKeepPeriod{PoolId: 1, KeepPeriod: time.Hour}, PoolRecordHistoryKeepPeriod{PoolId: 2, KeepPeriod: 720 * time.Hour}),
+				[]TwapRecord{baseRecord}),
+		},
+		"invalid pool record history keep period - zero pool id": {
+			twapGenesis: NewGenesisState(
+				withPoolRecordHistoryKeepPeriods(basicParams, PoolRecordHistoryKeepPeriod{PoolId: 0, KeepPeriod: time.Hour}),
+				[]TwapRecord{baseRecord}),
+
+			expectedErr: true,
+		},
+		"invalid pool record history keep period - duplicate pool id": {
+			twapGenesis: NewGenesisState(
+				withPoolRecordHistoryKeepPeriods(basicParams, PoolRecordHistoryKeepPeriod{PoolId: 1, KeepPeriod: time.Hour}, PoolRecordHistoryKeepPeriod{PoolId: 1, KeepPeriod: 2 * time.Hour}),
+				[]TwapRecord{baseRecord}),
+
+			expectedErr: true,
+		},
+		"invalid pool record history keep period - zero duration": {
+			twapGenesis: NewGenesisState(
+				withPoolRecordHistoryKeepPeriods(basicParams, PoolRecordHistoryKeepPeriod{PoolId: 1}),
+				[]TwapRecord{baseRecord}),
+
+			expectedErr: true,
+		},
+		"invalid archivedRecordInterval - error": {
+			twapGenesis: NewGenesisState(
+				Params{PruneEpochIdentifier: "week", RecordHistoryKeepPeriod: 48 * time.Hour, ArchivedRecordKeepPeriod: time.Hour},
+				[]TwapRecord{baseRecord}),
+
+			expectedErr: true,
+		},
+		"invalid archivedRecordKeepPeriod - error": {
+			twapGenesis: NewGenesisState(
+				Params{PruneEpochIdentifier: "week", RecordHistoryKeepPeriod: 48 * time.Hour, ArchivedRecordInterval: time.Hour},
+				[]TwapRecord{baseRecord}),
+
+			expectedErr: true,
+		},
+		"valid archived records": {
+			twapGenesis: &GenesisState{Params: basicParams, Twaps: []TwapRecord{baseRecord}, ArchivedTwaps: []TwapRecord{baseRecord}},
+		},
+		"invalid archived record": {
+			twapGenesis: &GenesisState{Params: basicParams, Twaps: []TwapRecord{baseRecord}, ArchivedTwaps: []TwapRecord{withSquaredLogAcc(baseRecord, sdk.Dec{})}},
+
 			expectedErr: true,
 		},
 	}
@@ -364,3 +428,8 @@ func TestTWAPRecord_Validate(t *testing.T) {
 		})
 	}
 }
+
+func withPoolRecordHistoryKeepPeriods(params Params, poolKeepPeriods ...PoolRecordHistoryKeepPeriod) Params {
+	params.PoolRecordHistoryKeepPeriods = poolKeepPeriods
+	return params
+}
@@ -23,10 +23,20 @@ const (
 	KeySeparator = "|"
 )
 
+const (
+	// MaxBatchTwapQueries is the maximum number of queries, including aggregated queries,
+	// in a single batched twap query.
+	MaxBatchTwapQueries = 100
+	// MaxAggregatedTwapPools is the maximum number of pools of a single aggregated twap query.
+	MaxAggregatedTwapPools = 10
+)
+
 var (
 	mostRecentTWAPsNoSeparator         = "recent_twap"
 	historicalTWAPTimeIndexNoSeparator = "historical_time_index"
 	historicalTWAPPoolIndexNoSeparator = "historical_pool_index"
+	archivedTWAPTimeIndexNoSeparator   = "archived_time_index"
+	archivedTWAPPoolIndexNoSeparator   = "archived_pool_index"
 
 	// We do key management to let us easily meet the goals of (AKA minimal iteration):
 	// * Get most recent twap for a (pool id, asset 1, asset 2) with no iteration
@@ -42,6 +52,12 @@ var (
 	// format is pool id | denom1 | denom2 | time
 	// made for efficiently getting records given (pool id, denom1, denom2) and time bounds
 	HistoricalTWAPPoolIndexPrefix = historicalTWAPPoolIndexNoSeparator + KeySeparator
+	// Archived records are downsampled historical records, kept for longer than the historical records.
+	// They have the same indexes as historical records.
+	// format is time | pool id | denom1 | denom2
+	ArchivedTWAPTimeIndexPrefix = archivedTWAPTimeIndexNoSeparator + KeySeparator
+	// format is pool id | denom1 | denom2 | time
+	ArchivedTWAPPoolIndexPrefix = archivedTWAPPoolIndexNoSeparator + KeySeparator
 )
 
 // TODO: make utility command to automatically interlace separators
@@ -72,6 +88,26 @@ func FormatHistoricalPoolIndexTimeSuffix(poolId uint64, denom1, denom2 string, a
 	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s.", HistoricalTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
 }
 
+func FormatArchivedTimeIndexTWAPKey(accumulatorWriteTime time.Time, poolId uint64, denom1, denom2 string) []byte {
+	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
+	return []byte(fmt.Sprintf("%s%s%s%d%s%s%s%s", ArchivedTWAPTimeIndexPrefix, timeS, KeySeparator, poolId, KeySeparator, denom1, KeySeparator, denom2))
+}
+
+func FormatArchivedPoolIndexTWAPKey(poolId uint64, denom1, denom2 string, accumulatorWriteTime time.Time) []byte {
+	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s", ArchivedTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
+}
+
+func FormatArchivedPoolIndexTimePrefix(poolId uint64, denom1, denom2 string) []byte {
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s", ArchivedTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator))
+}
+
+func FormatArchivedPoolIndexTimeSuffix(poolId uint64, denom1, denom2 string, accumulatorWriteTime time.Time) []byte {
+	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
+	// . acts as a suffix for lexicographical orderings
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s.", ArchivedTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
+}
+
 // GetAllMostRecentTwapsForPool returns all of the most recent twap records for a pool id.
 // if the pool id doesn't exist, then this returns a blank list.
 func GetAllMostRecentTwapsForPool(store sdk.KVStore, poolId uint64) ([]TwapRecord, error) {
@@ -90,5 +126,8 @@ func ParseTwapFromBz(bz []byte) (twap TwapRecord, err error) {
 	if twap.GeometricTwapAccumulator.IsNil() {
 		twap.GeometricTwapAccumulator = sdk.ZeroDec()
 	}
+	if twap.SquaredLogPriceAccumulator.IsNil() {
+		twap.SquaredLogPriceAccumulator = sdk.ZeroDec()
+	}
 	return twap, err
 }
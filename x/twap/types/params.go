@@ -11,8 +11,11 @@ import (
 
 // Parameter store keys.
 var (
-	KeyPruneEpochIdentifier    = []byte("PruneEpochIdentifier")
-	KeyRecordHistoryKeepPeriod = []byte("RecordHistoryKeepPeriod")
+	KeyPruneEpochIdentifier         = []byte("PruneEpochIdentifier")
+	KeyRecordHistoryKeepPeriod      = []byte("RecordHistoryKeepPeriod")
+	KeyPoolRecordHistoryKeepPeriods = []byte("PoolRecordHistoryKeepPeriods")
+	KeyArchivedRecordInterval       = []byte("ArchivedRecordInterval")
+	KeyArchivedRecordKeepPeriod     = []byte("ArchivedRecordKeepPeriod")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -20,6 +23,9 @@ var (
 const (
 	defaultPruneEpochIdentifier    = "day"
 	defaultRecordHistoryKeepPeriod = 48 * time.Hour
+
+	DefaultArchivedRecordInterval   = time.Hour
+	DefaultArchivedRecordKeepPeriod = 31 * 24 * time.Hour
 )
 
 // ParamTable for twap module.
@@ -29,16 +35,20 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 func NewParams(pruneEpochIdentifier string, recordHistoryKeepPeriod time.Duration) Params {
 	return Params{
-		PruneEpochIdentifier:    pruneEpochIdentifier,
-		RecordHistoryKeepPeriod: recordHistoryKeepPeriod,
+		PruneEpochIdentifier:     pruneEpochIdentifier,
+		RecordHistoryKeepPeriod:  recordHistoryKeepPeriod,
+		ArchivedRecordInterval:   DefaultArchivedRecordInterval,
+		ArchivedRecordKeepPeriod: DefaultArchivedRecordKeepPeriod,
 	}
 }
 
 // default twap module parameters.
 func DefaultParams() Params {
 	return Params{
-		PruneEpochIdentifier:    defaultPruneEpochIdentifier,
-		RecordHistoryKeepPeriod: defaultRecordHistoryKeepPeriod,
+		PruneEpochIdentifier:     defaultPruneEpochIdentifier,
+		RecordHistoryKeepPeriod:  defaultRecordHistoryKeepPeriod,
+		ArchivedRecordInterval:   DefaultArchivedRecordInterval,
+		ArchivedRecordKeepPeriod: DefaultArchivedRecordKeepPeriod,
 	}
 }
 
@@ -52,6 +62,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePoolRecordHistoryKeepPeriods(p.PoolRecordHistoryKeepPeriods); err != nil {
+		return err
+	}
+
+	if err := validatePeriod(p.ArchivedRecordInterval); err != nil {
+		return err
+	}
+
+	if err := validatePeriod(p.ArchivedRecordKeepPeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -60,6 +82,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPruneEpochIdentifier, &p.PruneEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
 		paramtypes.NewParamSetPair(KeyRecordHistoryKeepPeriod, &p.RecordHistoryKeepPeriod, validatePeriod),
+		paramtypes.NewParamSetPair(KeyPoolRecordHistoryKeepPeriods, &p.PoolRecordHistoryKeepPeriods, validatePoolRecordHistoryKeepPeriods),
+		paramtypes.NewParamSetPair(KeyArchivedRecordInterval, &p.ArchivedRecordInterval, validatePeriod),
+		paramtypes.NewParamSetPair(KeyArchivedRecordKeepPeriod, &p.ArchivedRecordKeepPeriod, validatePeriod),
 	}
 }
 
@@ -75,3 +100,27 @@ func validatePeriod(i interface{}) error {
 
 	return nil
 }
+
+func validatePoolRecordHistoryKeepPeriods(i interface{}) error {
+	v, ok := i.([]PoolRecordHistoryKeepPeriod)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenPoolIds := make(map[uint64]bool, len(v))
+	for _, poolKeepPeriod := range v {
+		if poolKeepPeriod.PoolId == 0 {
+			return fmt.Errorf("pool id cannot be 0")
+		}
+		if seenPoolIds[poolKeepPeriod.PoolId] {
+			return fmt.Errorf("duplicate record history keep period for pool id %d", poolKeepPeriod.PoolId)
+		}
+		seenPoolIds[poolKeepPeriod.PoolId] = true
+
+		if err := validatePeriod(poolKeepPeriod.KeepPeriod); err != nil {
+			return fmt.Errorf("invalid record history keep period for pool id %d: %w", poolKeepPeriod.PoolId, err)
+		}
+	}
+
+	return nil
+}
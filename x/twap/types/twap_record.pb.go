@@ -31,6 +31,35 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TwapType is the kind of time weighted average price returned by a query.
+type TwapType int32
+
+const (
+	TwapTypeArithmetic   TwapType = 0
+	TwapTypeGeometric    TwapType = 1
+	TwapTypeHarmonicMean TwapType = 2
+)
+
+var TwapType_name = map[int32]string{
+	0: "TwapTypeArithmetic",
+	1: "TwapTypeGeometric",
+	2: "TwapTypeHarmonicMean",
+}
+
+var TwapType_value = map[string]int32{
+	"TwapTypeArithmetic":   0,
+	"TwapTypeGeometric":    1,
+	"TwapTypeHarmonicMean": 2,
+}
+
+func (x TwapType) String() string {
+	return proto.EnumName(TwapType_name, int32(x))
+}
+
+func (TwapType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dbf5c78678e601aa, []int{0}
+}
+
 // A TWAP record should be indexed in state by pool_id, (asset pair), timestamp
 // The asset pair assets should be lexicographically sorted.
 // Technically (pool_id, asset_0_denom, asset_1_denom, height) do not need to
@@ -56,6 +85,10 @@ type TwapRecord struct {
 	P0ArithmeticTwapAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=p0_arithmetic_twap_accumulator,json=p0ArithmeticTwapAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p0_arithmetic_twap_accumulator"`
 	P1ArithmeticTwapAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=p1_arithmetic_twap_accumulator,json=p1ArithmeticTwapAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p1_arithmetic_twap_accumulator"`
 	GeometricTwapAccumulator    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=geometric_twap_accumulator,json=geometricTwapAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"geometric_twap_accumulator"`
+	// Accumulator of the squared base 2 logarithm of the asset0 spot price
+	// over time. Together with geometric_twap_accumulator, it is used to
+	// compute the realized volatility over a window.
+	SquaredLogPriceAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=squared_log_price_accumulator,json=squaredLogPriceAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"squared_log_price_accumulator"`
 	// This field contains the time in which the last spot price error occured.
 	// It is used to alert the caller if they are getting a potentially erroneous
 	// TWAP, due to an unforeseen underlying error.
@@ -138,6 +171,7 @@ func (m *TwapRecord) GetLastErrorTime() time.Time {
 }
 
 func init() {
+	proto.RegisterEnum("osmosis.twap.v1beta1.TwapType", TwapType_name, TwapType_value)
 	proto.RegisterType((*TwapRecord)(nil), "osmosis.twap.v1beta1.TwapRecord")
 }
 
@@ -146,41 +180,46 @@ func init() {
 }
 
 var fileDescriptor_dbf5c78678e601aa = []byte{
-	// 540 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x1a, 0x52, 0x7a, 0xa1, 0xaa, 0x64, 0x45, 0x60, 0x82, 0x64, 0x87, 0x0c, 0x55,
-	0x18, 0xea, 0x1f, 0xc0, 0xc4, 0x96, 0xa8, 0x0c, 0x20, 0x84, 0x90, 0xe9, 0x04, 0xc3, 0xe9, 0x6c,
-	0x5f, 0x1d, 0x0b, 0x3b, 0x77, 0xba, 0xbb, 0xb4, 0xe4, 0xbf, 0xe8, 0x9f, 0xd5, 0xb1, 0x23, 0x62,
-	0x30, 0x28, 0xd9, 0x18, 0x3b, 0x31, 0xa2, 0xfb, 0x91, 0xd0, 0x84, 0x5f, 0x52, 0xa6, 0xe4, 0xbd,
-	0xf7, 0x7d, 0x9f, 0xef, 0x7b, 0xf6, 0x93, 0xc1, 0x21, 0xe1, 0x15, 0xe1, 0x05, 0x0f, 0xc4, 0x39,
-	0xa2, 0xc1, 0x59, 0x94, 0x60, 0x81, 0x22, 0x15, 0x40, 0x86, 0x53, 0xc2, 0x32, 0x9f, 0x32, 0x22,
-	0x88, 0xdd, 0x31, 0x3a, 0x5f, 0x96, 0x7c, 0xa3, 0xeb, 0x76, 0x72, 0x92, 0x13, 0x25, 0x08, 0xe4,
-	0x3f, 0xad, 0xed, 0x3e, 0xc8, 0x09, 0xc9, 0x4b, 0x1c, 0xa8, 0x28, 0x99, 0x9e, 0x06, 0x68, 0x32,
-	0x5b, 0x96, 0x52, 0xc5, 0x81, 0xba, 0x47, 0x07, 0xa6, 0xe4, 0xea, 0x28, 0x48, 0x10, 0xc7, 0xab,
-	0x41, 0x52, 0x52, 0x4c, 0x4c, 0xdd, 0xdb, 0xa4, 0x8a, 0xa2, 0xc2, 0x5c, 0xa0, 0x8a, 0x6a, 0x41,
-	0xff, 0x47, 0x0b, 0x80, 0x93, 0x73, 0x44, 0x63, 0x35, 0xb7, 0x7d, 0x1f, 0xec, 0x52, 0x42, 0x4a,
-	0x58, 0x64, 0x8e, 0xd5, 0xb3, 0x06, 0xcd, 0xb8, 0x25, 0xc3, 0x97, 0x99, 0xfd, 0x08, 0xdc, 0x45,
-	0x9c, 0x63, 0x11, 0xc2, 0x0c, 0x4f, 0x48, 0xe5, 0xdc, 0xea, 0x59, 0x83, 0xbd, 0xb8, 0xad, 0x73,
-	0xc7, 0x32, 0xb5, 0x92, 0x44, 0x46, 0xb2, 0x73, 0x43, 0x12, 0x69, 0xc9, 0x10, 0xb4, 0xc6, 0xb8,
-	0xc8, 0xc7, 0xc2, 0x69, 0xf6, 0xac, 0xc1, 0xce, 0xe8, 0xf1, 0xf7, 0xda, 0xdb, 0xd7, 0x8f, 0x0c,
-	0xea, 0xc2, 0x75, 0xed, 0x75, 0x66, 0xa8, 0x2a, 0x9f, 0xf7, 0xd7, 0xd2, 0xfd, 0xd8, 0x34, 0xda,
-	0x6f, 0x40, 0x53, 0xee, 0xe0, 0xdc, 0xee, 0x59, 0x83, 0xf6, 0x93, 0xae, 0xaf, 0x17, 0xf4, 0x97,
-	0x0b, 0xfa, 0x27, 0xcb, 0x05, 0x47, 0xee, 0x65, 0xed, 0x35, 0xae, 0x6b, 0xcf, 0x5e, 0xe3, 0xc9,
-	0xe6, 0xfe, 0xc5, 0x57, 0xcf, 0x8a, 0x15, 0xc7, 0xfe, 0x00, 0x6c, 0x1a, 0xc2, 0x12, 0x71, 0x01,
-	0x39, 0x25, 0x02, 0x52, 0x56, 0xa4, 0xd8, 0x69, 0xc9, 0xd9, 0x47, 0xbe, 0x24, 0x7c, 0xa9, 0xbd,
-	0xc3, 0xbc, 0x10, 0xe3, 0x69, 0xe2, 0xa7, 0xa4, 0x32, 0x8f, 0xdf, 0xfc, 0x1c, 0xf1, 0xec, 0x63,
-	0x20, 0x66, 0x14, 0x73, 0xff, 0x18, 0xa7, 0xf1, 0x01, 0x0d, 0x5f, 0x23, 0x2e, 0xde, 0x51, 0x22,
-	0xde, 0x4a, 0x8c, 0x82, 0x47, 0xbf, 0xc1, 0x77, 0xb7, 0x84, 0x47, 0xeb, 0x70, 0x0e, 0x5c, 0x1a,
-	0x42, 0xc4, 0x0a, 0x31, 0xae, 0xb0, 0x28, 0x52, 0xa8, 0x0e, 0x10, 0xa5, 0xe9, 0xb4, 0x9a, 0x96,
-	0x48, 0x10, 0xe6, 0xdc, 0xd9, 0xca, 0xe8, 0x21, 0x0d, 0x87, 0x2b, 0xa8, 0xbc, 0x8d, 0xe1, 0x2f,
-	0xa4, 0x32, 0x8d, 0xfe, 0x69, 0xba, 0xb7, 0xa5, 0x69, 0xf4, 0x77, 0xd3, 0x12, 0x74, 0x73, 0x4c,
-	0x2a, 0x2c, 0xd8, 0x9f, 0x0c, 0xc1, 0x56, 0x86, 0xce, 0x8a, 0xb8, 0xe9, 0x76, 0x0a, 0x0e, 0xd4,
-	0x1b, 0xc3, 0x8c, 0x11, 0xa6, 0xee, 0xc5, 0x69, 0xff, 0xf7, 0xd8, 0xfa, 0xe6, 0xd8, 0xee, 0xe9,
-	0x63, 0xdb, 0x00, 0xe8, 0x83, 0xdb, 0x97, 0xd9, 0x17, 0x32, 0x29, 0xfb, 0x46, 0xaf, 0x2e, 0xe7,
-	0xae, 0x75, 0x35, 0x77, 0xad, 0x6f, 0x73, 0xd7, 0xba, 0x58, 0xb8, 0x8d, 0xab, 0x85, 0xdb, 0xf8,
-	0xbc, 0x70, 0x1b, 0xef, 0xc3, 0x1b, 0x3b, 0x98, 0x4f, 0xc8, 0x51, 0x89, 0x12, 0xbe, 0x0c, 0x82,
-	0xb3, 0xe8, 0x59, 0xf0, 0x49, 0x7f, 0x7d, 0xd4, 0x46, 0x49, 0x4b, 0x8d, 0xf4, 0xf4, 0x67, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xe4, 0x2d, 0x3d, 0xaa, 0x9a, 0x04, 0x00, 0x00,
+	// 620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0xdb, 0x90, 0xb6, 0xd3, 0x56, 0x2d, 0x56, 0x28, 0xc6, 0x08, 0x3b, 0x64, 0x51, 0x05,
+	0xa4, 0xfa, 0x01, 0xac, 0xd8, 0x35, 0x2a, 0xe2, 0xa1, 0x82, 0x90, 0xe9, 0x06, 0x58, 0x58, 0x63,
+	0x7b, 0xea, 0x58, 0xd8, 0x99, 0xe9, 0xcc, 0xa4, 0x25, 0x7f, 0xc0, 0xb2, 0xff, 0xc0, 0xcf, 0x74,
+	0xd9, 0x25, 0x62, 0x11, 0x50, 0xbb, 0x83, 0x5d, 0xbf, 0x00, 0xcd, 0x8c, 0x9d, 0x36, 0xe1, 0x25,
+	0x65, 0x65, 0xdf, 0x7b, 0xcf, 0x3d, 0x67, 0xee, 0x9d, 0xa3, 0x01, 0x9b, 0x98, 0x15, 0x98, 0x65,
+	0xcc, 0xe5, 0x47, 0x90, 0xb8, 0x87, 0x7e, 0x84, 0x38, 0xf4, 0x65, 0x10, 0x52, 0x14, 0x63, 0x9a,
+	0x38, 0x84, 0x62, 0x8e, 0xf5, 0x66, 0x89, 0x73, 0x44, 0xc9, 0x29, 0x71, 0x66, 0x33, 0xc5, 0x29,
+	0x96, 0x00, 0x57, 0xfc, 0x29, 0xac, 0x79, 0x2b, 0xc5, 0x38, 0xcd, 0x91, 0x2b, 0xa3, 0x68, 0xb0,
+	0xef, 0xc2, 0xfe, 0xb0, 0x2a, 0xc5, 0x92, 0x27, 0x54, 0x3d, 0x2a, 0x28, 0x4b, 0x96, 0x8a, 0xdc,
+	0x08, 0x32, 0x34, 0x3e, 0x48, 0x8c, 0xb3, 0x7e, 0x59, 0xb7, 0xa7, 0x59, 0x79, 0x56, 0x20, 0xc6,
+	0x61, 0x41, 0x14, 0xa0, 0xfd, 0x73, 0x01, 0x80, 0xbd, 0x23, 0x48, 0x02, 0x79, 0x6e, 0xfd, 0x26,
+	0x58, 0x20, 0x18, 0xe7, 0x61, 0x96, 0x18, 0x5a, 0x4b, 0xeb, 0xd4, 0x83, 0x86, 0x08, 0x9f, 0x27,
+	0xfa, 0x5d, 0xb0, 0x02, 0x19, 0x43, 0xdc, 0x0b, 0x13, 0xd4, 0xc7, 0x85, 0x31, 0xd7, 0xd2, 0x3a,
+	0x4b, 0xc1, 0xb2, 0xca, 0xed, 0x88, 0xd4, 0x18, 0xe2, 0x97, 0x90, 0xf9, 0x2b, 0x10, 0x5f, 0x41,
+	0xb6, 0x41, 0xa3, 0x87, 0xb2, 0xb4, 0xc7, 0x8d, 0x7a, 0x4b, 0xeb, 0xcc, 0x77, 0xef, 0xfd, 0x18,
+	0xd9, 0xab, 0x6a, 0x65, 0xa1, 0x2a, 0x5c, 0x8c, 0xec, 0xe6, 0x10, 0x16, 0xf9, 0xe3, 0xf6, 0x44,
+	0xba, 0x1d, 0x94, 0x8d, 0xfa, 0x2b, 0x50, 0x17, 0x33, 0x18, 0xd7, 0x5a, 0x5a, 0x67, 0xf9, 0x81,
+	0xe9, 0xa8, 0x01, 0x9d, 0x6a, 0x40, 0x67, 0xaf, 0x1a, 0xb0, 0x6b, 0x9d, 0x8c, 0xec, 0xda, 0xc5,
+	0xc8, 0xd6, 0x27, 0xf8, 0x44, 0x73, 0xfb, 0xf8, 0x9b, 0xad, 0x05, 0x92, 0x47, 0x7f, 0x0f, 0x74,
+	0xe2, 0x85, 0x39, 0x64, 0x3c, 0x64, 0x04, 0xf3, 0x90, 0xd0, 0x2c, 0x46, 0x46, 0x43, 0x9c, 0xbd,
+	0xeb, 0x08, 0x86, 0xaf, 0x23, 0x7b, 0x33, 0xcd, 0x78, 0x6f, 0x10, 0x39, 0x31, 0x2e, 0xca, 0xf5,
+	0x97, 0x9f, 0x2d, 0x96, 0x7c, 0x70, 0xf9, 0x90, 0x20, 0xe6, 0xec, 0xa0, 0x38, 0x58, 0x23, 0xde,
+	0x2e, 0x64, 0xfc, 0x0d, 0xc1, 0xfc, 0xb5, 0xa0, 0x91, 0xe4, 0xfe, 0x6f, 0xe4, 0x0b, 0x33, 0x92,
+	0xfb, 0x93, 0xe4, 0x0c, 0x58, 0xc4, 0x0b, 0x21, 0xcd, 0x78, 0xaf, 0x40, 0x3c, 0x8b, 0x43, 0x69,
+	0x40, 0x18, 0xc7, 0x83, 0x62, 0x90, 0x43, 0x8e, 0xa9, 0xb1, 0x38, 0x93, 0xd0, 0x6d, 0xe2, 0x6d,
+	0x8f, 0x49, 0x85, 0x37, 0xb6, 0x2f, 0x29, 0xa5, 0xa8, 0xff, 0x4f, 0xd1, 0xa5, 0x19, 0x45, 0xfd,
+	0xbf, 0x8b, 0xe6, 0xc0, 0x4c, 0x11, 0x2e, 0x10, 0xa7, 0x7f, 0x12, 0x04, 0x33, 0x09, 0x1a, 0x63,
+	0xc6, 0x69, 0xb5, 0x03, 0x70, 0x87, 0x1d, 0x0c, 0x20, 0x45, 0x49, 0x98, 0xe3, 0x54, 0xdd, 0xd9,
+	0x84, 0xe0, 0xca, 0x4c, 0x82, 0x66, 0x49, 0xba, 0x8b, 0x53, 0x79, 0x7f, 0x57, 0x25, 0xf7, 0xc1,
+	0x9a, 0x34, 0x09, 0xa2, 0x14, 0x53, 0x69, 0x51, 0x63, 0xf9, 0xbf, 0xfe, 0x6e, 0x97, 0xfe, 0xde,
+	0x50, 0xfe, 0x9e, 0x22, 0x50, 0x1e, 0x5f, 0x15, 0xd9, 0x27, 0x22, 0x29, 0xfa, 0xee, 0xbf, 0x05,
+	0x8b, 0x62, 0xda, 0xbd, 0x21, 0x41, 0xfa, 0x06, 0xd0, 0xab, 0xff, 0xcb, 0xcd, 0xaf, 0xd7, 0xf4,
+	0x1b, 0xe0, 0x7a, 0x95, 0x7f, 0x5a, 0xad, 0x68, 0x5d, 0xd3, 0x0d, 0xd0, 0xac, 0xd2, 0xcf, 0x20,
+	0x2d, 0x70, 0x3f, 0x8b, 0x5f, 0x22, 0xd8, 0x5f, 0x9f, 0x33, 0xeb, 0x9f, 0x3e, 0x5b, 0xb5, 0xee,
+	0x8b, 0x93, 0x33, 0x4b, 0x3b, 0x3d, 0xb3, 0xb4, 0xef, 0x67, 0x96, 0x76, 0x7c, 0x6e, 0xd5, 0x4e,
+	0xcf, 0xad, 0xda, 0x97, 0x73, 0xab, 0xf6, 0xce, 0xbb, 0xb2, 0xa0, 0xf2, 0x41, 0xdc, 0xca, 0x61,
+	0xc4, 0xaa, 0xc0, 0x3d, 0xf4, 0x1f, 0xb9, 0x1f, 0xd5, 0x5b, 0x2a, 0xd7, 0x15, 0x35, 0xe4, 0xb4,
+	0x0f, 0x7f, 0x0d, 0x00, 0x65, 0x9e, 0x2a, 0x35, 0x68, 0x05, 0x00, 0x00,
 }
 
 func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
@@ -203,6 +242,16 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SquaredLogPriceAccumulator.Size()
+		i -= size
+		if _, err := m.SquaredLogPriceAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastErrorTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastErrorTime):])
 	if err1 != nil {
 		return 0, err1
@@ -341,6 +390,8 @@ func (m *TwapRecord) Size() (n int) {
 	n += 1 + l + sovTwapRecord(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastErrorTime)
 	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.SquaredLogPriceAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
 	return n
 }
 
@@ -717,6 +768,40 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquaredLogPriceAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SquaredLogPriceAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTwapRecord(dAtA[iNdEx:])
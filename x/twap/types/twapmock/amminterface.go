@@ -80,3 +80,7 @@ func (p *ProgrammedAmmInterface) CalculateSpotPrice(ctx sdk.Context,
 	}
 	return p.underlyingKeeper.CalculateSpotPrice(ctx, poolId, quoteDenom, baseDenom)
 }
+
+func (p *ProgrammedAmmInterface) GetActiveLiquidityReserves(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	return p.underlyingKeeper.GetActiveLiquidityReserves(ctx, poolId)
+}
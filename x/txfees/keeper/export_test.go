@@ -0,0 +1,7 @@
+package keeper
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+func (k Keeper) GetBaseDenomPoolIds(ctx sdk.Context, denom string) []uint64 {
+	return k.getBaseDenomPoolIds(ctx, denom)
+}
@@ -189,6 +189,8 @@ func (suite *KeeperTestSuite) TestFeeDecorator() {
 				sdk.NewInt64Coin(uion, 500),
 			)
 			suite.ExecuteUpgradeFeeTokenProposal(uion, uionPoolId)
+			// Fee tokens are converted using a TWAP, which needs pool history over its window.
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.FeeTokenTwapWindow))
 
 			suite.Ctx = suite.Ctx.WithIsCheckTx(tc.isCheckTx).WithMinGasPrices(tc.minGasPrices)
 			suite.Ctx = suite.Ctx.WithMinGasPrices(tc.minGasPrices)
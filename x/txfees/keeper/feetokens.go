@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/osmosis-labs/osmosis/v14/x/txfees/types"
@@ -10,6 +14,8 @@ import (
 )
 
 // ConvertToBaseToken converts a fee amount in a whitelisted fee token to the base fee token amount.
+// The conversion uses the geometric TWAP of the fee token's pool over the last FeeTokenTwapWindow,
+// rather than the spot price, which can be manipulated within a block.
 func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin, error) {
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
@@ -20,17 +26,46 @@ func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin
 		return inputFee, nil
 	}
 
-	feeToken, err := k.GetFeeToken(ctx, inputFee.Denom)
+	twap, err := k.CalcFeeTwap(ctx, inputFee.Denom)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
 
-	spotPrice, err := k.CalcFeeSpotPrice(ctx, feeToken.Denom)
+	return sdk.NewCoin(baseDenom, twap.MulInt(inputFee.Amount).RoundInt()), nil
+}
+
+// CalcFeeTwap returns the price of the provided fee token in the base denomination,
+// as the geometric TWAP of the fee token's pool over the last FeeTokenTwapWindow.
+// Errors if the fee token is not whitelisted, or if the TWAP cannot be computed,
+// e.g. because the pool is younger than the window or its spot price errored within the window.
+func (k Keeper) CalcFeeTwap(ctx sdk.Context, inputDenom string) (sdk.Dec, error) {
+	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
-		return sdk.Coin{}, err
+		return sdk.Dec{}, err
 	}
 
-	return sdk.NewCoin(baseDenom, spotPrice.MulInt(inputFee.Amount).RoundInt()), nil
+	feeToken, err := k.GetFeeToken(ctx, inputDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return k.calcPoolTwap(ctx, feeToken.PoolID, feeToken.Denom, baseDenom)
+}
+
+// calcPoolTwap returns the geometric TWAP of the fee denom in units of the base denom
+// in the given pool, over the last FeeTokenTwapWindow.
+func (k Keeper) calcPoolTwap(ctx sdk.Context, poolId uint64, feeDenom, baseDenom string) (sdk.Dec, error) {
+	startTime := ctx.BlockTime().Add(-types.FeeTokenTwapWindow)
+	twap, err := k.twapKeeper.GetGeometricTwapToNow(ctx, poolId, feeDenom, baseDenom, startTime)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	// The geometric TWAP is zero when the mean of the logarithm of the price is zero,
+	// that is when the geometric mean of the price is one.
+	if twap.IsZero() {
+		return sdk.OneDec(), nil
+	}
+	return twap, nil
 }
 
 // CalcFeeSpotPrice converts the provided tx fees into their equivalent value in the base denomination.
@@ -86,8 +121,8 @@ func (k Keeper) SetBaseDenom(ctx sdk.Context, denom string) error {
 // It checks:
 // - The denom exists
 // - The denom is not the base denom
-// - The gamm pool exists
-// - The gamm pool includes the base token and fee token.
+// - The pool exists
+// - The pool includes the base token and fee token.
 func (k Keeper) ValidateFeeToken(ctx sdk.Context, feeToken types.FeeToken) error {
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
@@ -125,6 +160,7 @@ func (k Keeper) GetFeeToken(ctx sdk.Context, denom string) (types.FeeToken, erro
 
 // setFeeToken sets a new fee token record for a specific denom.
 // PoolID is just the pool to swap rate between alt fee token and native fee token.
+// It is replaced by the deepest pool of the fee token at the end of every epoch, see RefreshFeeTokenPools.
 // If the feeToken pool ID is 0, deletes the fee Token entry.
 func (k Keeper) setFeeToken(ctx sdk.Context, feeToken types.FeeToken) error {
 	prefixStore := k.GetFeeTokensStore(ctx)
@@ -181,3 +217,122 @@ func (k Keeper) SetFeeTokens(ctx sdk.Context, feetokens []types.FeeToken) error
 	}
 	return nil
 }
+
+// RefreshFeeTokenPools sets the pool of every fee token to its deepest pool against the base denom,
+// out of all pools of any type that trade both denoms, see getCandidatePools.
+// A pool is only chosen if the TWAP of the fee token can be computed from it.
+// The current pool of a fee token is kept on ties, and if no pool is eligible.
+func (k Keeper) RefreshFeeTokenPools(ctx sdk.Context) {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return
+	}
+
+	for _, feeToken := range k.GetFeeTokens(ctx) {
+		candidatePools := k.getCandidatePools(ctx, feeToken, baseDenom)
+		if len(candidatePools) == 0 || candidatePools[0].poolId == feeToken.PoolID {
+			continue
+		}
+
+		feeToken.PoolID = candidatePools[0].poolId
+		if err := k.setFeeToken(ctx, feeToken); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to set pool %d for fee token %s: %s", feeToken.PoolID, feeToken.Denom, err))
+		}
+	}
+}
+
+type candidatePool struct {
+	poolId uint64
+	depth  sdk.Int
+}
+
+// getCandidatePools returns the pools trading the fee token against the base denom that the TWAP of the
+// fee token can be computed from, ordered from the deepest pool to the shallowest. The current pool of the
+// fee token comes first on ties, then the pool with the lower id.
+// The depth of a pool is the liquidity it provides to swap between the pair: the lower of its active reserves
+// of the base denom, and of its active reserves of the fee token valued in the base denom at the TWAP.
+// Thus only the liquidity in range of the current price counts for concentrated liquidity pools,
+// and multi-asset pools holding little of the fee token are shallow, however much base denom they hold.
+// Only the pools indexed as trading the fee token against the base denom are considered.
+func (k Keeper) getCandidatePools(ctx sdk.Context, feeToken types.FeeToken, baseDenom string) []candidatePool {
+	candidatePools := []candidatePool{}
+	for _, poolId := range k.getBaseDenomPoolIds(ctx, feeToken.Denom) {
+		twap, err := k.calcPoolTwap(ctx, poolId, feeToken.Denom, baseDenom)
+		if err != nil {
+			continue
+		}
+
+		reserves, err := k.poolManager.GetActiveLiquidityReserves(ctx, poolId)
+		if err != nil {
+			continue
+		}
+		feeTokenDepth := twap.MulInt(reserves.AmountOf(feeToken.Denom)).TruncateInt()
+		depth := sdk.MinInt(reserves.AmountOf(baseDenom), feeTokenDepth)
+		if !depth.IsPositive() {
+			continue
+		}
+
+		candidatePools = append(candidatePools, candidatePool{poolId: poolId, depth: depth})
+	}
+
+	sort.SliceStable(candidatePools, func(i, j int) bool {
+		if !candidatePools[i].depth.Equal(candidatePools[j].depth) {
+			return candidatePools[i].depth.GT(candidatePools[j].depth)
+		}
+		return candidatePools[i].poolId == feeToken.PoolID
+	})
+	return candidatePools
+}
+
+// IndexBaseDenomPools indexes every existing pool that trades the base denom, see indexBaseDenomPool.
+// Pools created afterwards are indexed by the pool creation hooks.
+func (k Keeper) IndexBaseDenomPools(ctx sdk.Context) {
+	nextPoolId := k.poolManager.GetNextPoolId(ctx)
+	for poolId := uint64(1); poolId < nextPoolId; poolId++ {
+		k.indexBaseDenomPool(ctx, poolId)
+	}
+}
+
+// indexBaseDenomPool indexes the given pool under every other denom it trades,
+// if it trades the base denom, so that it is a candidate pool of these denoms as fee tokens.
+func (k Keeper) indexBaseDenomPool(ctx sdk.Context, poolId uint64) {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return
+	}
+
+	denoms, err := k.poolManager.GetPoolDenoms(ctx, poolId)
+	if err != nil || !containsDenom(denoms, baseDenom) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range denoms {
+		if denom != baseDenom {
+			store.Set(types.FormatBaseDenomPoolKey(denom, poolId), sdk.Uint64ToBigEndian(poolId))
+		}
+	}
+}
+
+// getBaseDenomPoolIds returns the ids of the indexed pools trading the given denom against the base denom,
+// in ascending order.
+func (k Keeper) getBaseDenomPoolIds(ctx sdk.Context, denom string) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FormatBaseDenomPoolsPrefix(denom))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	poolIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	return poolIds
+}
+
+func containsDenom(denoms []string, denom string) bool {
+	for _, d := range denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"github.com/osmosis-labs/osmosis/v14/app/apptesting"
+	cl "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity"
+	cltypes "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v14/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -44,6 +47,9 @@ func (suite *KeeperTestSuite) TestUpgradeFeeTokenProposals() {
 		sdk.NewInt64Coin("foo", 1000),
 	)
 
+	// Fee tokens are converted using a TWAP, which needs pool history over its window.
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.FeeTokenTwapWindow))
+
 	tests := []struct {
 		name       string
 		feeToken   string
@@ -213,6 +219,7 @@ func (suite *KeeperTestSuite) TestFeeTokenConversions() {
 			)
 
 			suite.ExecuteUpgradeFeeTokenProposal(tc.feeTokenPoolInput.Denom, poolId)
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.FeeTokenTwapWindow))
 
 			converted, err := suite.App.TxFeesKeeper.ConvertToBaseToken(suite.Ctx, tc.inputFee)
 			if tc.expectedConvertable {
@@ -224,3 +231,190 @@ func (suite *KeeperTestSuite) TestFeeTokenConversions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestFeeTokenConversionsUseTwap() {
+	suite.SetupTest(false)
+
+	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+	poolId := suite.PrepareBalancerPoolWithCoins(
+		sdk.NewInt64Coin(baseDenom, 1000),
+		sdk.NewInt64Coin("foo", 2000),
+	)
+	suite.ExecuteUpgradeFeeTokenProposal("foo", poolId)
+
+	// The pool is younger than the TWAP window, so its fee token cannot be converted yet.
+	_, err := suite.App.TxFeesKeeper.ConvertToBaseToken(suite.Ctx, sdk.NewInt64Coin("foo", 100))
+	suite.Require().Error(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.FeeTokenTwapWindow))
+	converted, err := suite.App.TxFeesKeeper.ConvertToBaseToken(suite.Ctx, sdk.NewInt64Coin("foo", 100))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(baseDenom, 50), converted)
+
+	// Moving the spot price within the block does not change the conversion.
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)))
+	_, err = suite.App.PoolManagerKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewInt64Coin(baseDenom, 1000), "foo", sdk.OneInt())
+	suite.Require().NoError(err)
+	spotPrice, err := suite.App.TxFeesKeeper.CalcFeeSpotPrice(suite.Ctx, "foo")
+	suite.Require().NoError(err)
+	suite.Require().True(spotPrice.GT(sdk.OneDec()))
+
+	converted, err = suite.App.TxFeesKeeper.ConvertToBaseToken(suite.Ctx, sdk.NewInt64Coin("foo", 100))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(baseDenom, 50), converted)
+}
+
+func (suite *KeeperTestSuite) TestRefreshFeeTokenPools() {
+	tests := []struct {
+		name string
+		// createDeeperPools creates pools of foo against the base denom after the initial fee token pool,
+		// and returns the id of the pool expected to be chosen.
+		createDeeperPools func(baseDenom string, initialPoolId uint64) uint64
+	}{
+		{
+			name: "no other pool, keeps the initial pool",
+			createDeeperPools: func(baseDenom string, initialPoolId uint64) uint64 {
+				return initialPoolId
+			},
+		},
+		{
+			name: "deeper balancer pool is chosen",
+			createDeeperPools: func(baseDenom string, initialPoolId uint64) uint64 {
+				return suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 10000), sdk.NewInt64Coin("foo", 20000))
+			},
+		},
+		{
+			name: "equally deep pool keeps the initial pool",
+			createDeeperPools: func(baseDenom string, initialPoolId uint64) uint64 {
+				suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1000), sdk.NewInt64Coin("foo", 3000))
+				return initialPoolId
+			},
+		},
+		{
+			name: "pools without the fee token are ignored",
+			createDeeperPools: func(baseDenom string, initialPoolId uint64) uint64 {
+				suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 10000), sdk.NewInt64Coin("bar", 20000))
+				return initialPoolId
+			},
+		},
+		{
+			name: "deepest concentrated liquidity pool is chosen",
+			createDeeperPools: func(baseDenom string, initialPoolId uint64) uint64 {
+				suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 10000), sdk.NewInt64Coin("foo", 20000))
+
+				clPool := suite.PrepareCustomConcentratedPool(suite.TestAccs[0], "foo", baseDenom, apptesting.DefaultTickSpacing, apptesting.DefaultExponentAtPriceOne, sdk.ZeroDec())
+				coins := sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin(baseDenom, 5_000_000_000))
+				suite.FundAcc(suite.TestAccs[0], coins)
+				_, _, _, _, err := suite.App.ConcentratedLiquidityKeeper.CreatePosition(suite.Ctx, clPool.GetId(), suite.TestAccs[0], coins.AmountOf("foo"), coins.AmountOf(baseDenom), sdk.ZeroInt(), sdk.ZeroInt(), 305450, 315000, suite.Ctx.BlockTime())
+				suite.Require().NoError(err)
+				return clPool.GetId()
+			},
+		},
+		{
+			name: "multi-asset pool holding little of the fee token is not chosen",
+			createDeeperPools: func(baseDenom string, initialPoolId uint64) uint64 {
+				deeperPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 10000), sdk.NewInt64Coin("foo", 20000))
+
+				// The pool holds more base denom, but its foo is only worth 40000 / 8 = 5000 of it.
+				coins := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 40000), sdk.NewInt64Coin("foo", 200), sdk.NewInt64Coin("bar", 10000))
+				suite.PrepareBalancerPoolWithCoinsAndWeights(coins, []int64{1, 1, 8})
+				return deeperPoolId
+			},
+		},
+		{
+			name: "concentrated liquidity pool with its liquidity out of range is not chosen",
+			createDeeperPools: func(baseDenom string, initialPoolId uint64) uint64 {
+				deeperPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 10000), sdk.NewInt64Coin("foo", 20000))
+
+				clPool := suite.PrepareCustomConcentratedPool(suite.TestAccs[0], "foo", baseDenom, apptesting.DefaultTickSpacing, apptesting.DefaultExponentAtPriceOne, sdk.ZeroDec())
+				minTick, maxTick := cl.GetMinAndMaxTicksFromExponentAtPriceOne(clPool.GetPrecisionFactorAtPriceOne())
+				coins := sdk.NewCoins(sdk.NewInt64Coin("foo", 2000), sdk.NewInt64Coin(baseDenom, 1000))
+				suite.FundAcc(suite.TestAccs[0], coins)
+				_, _, _, _, err := suite.App.ConcentratedLiquidityKeeper.CreatePosition(suite.Ctx, clPool.GetId(), suite.TestAccs[0], coins.AmountOf("foo"), coins.AmountOf(baseDenom), sdk.ZeroInt(), sdk.ZeroInt(), minTick, maxTick, suite.Ctx.BlockTime())
+				suite.Require().NoError(err)
+
+				// A position below the current price only holds base denom, that does not back swaps at the current price.
+				currentTick := suite.getConcentratedPool(clPool.GetId()).GetCurrentTick().Int64()
+				outOfRangeCoin := sdk.NewInt64Coin(baseDenom, 1_000_000)
+				suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(outOfRangeCoin))
+				_, _, _, _, err = suite.App.ConcentratedLiquidityKeeper.CreatePosition(suite.Ctx, clPool.GetId(), suite.TestAccs[0], sdk.ZeroInt(), outOfRangeCoin.Amount, sdk.ZeroInt(), sdk.ZeroInt(), currentTick-2000, currentTick-1000, suite.Ctx.BlockTime())
+				suite.Require().NoError(err)
+				return deeperPoolId
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest(false)
+			baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+
+			initialPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1000), sdk.NewInt64Coin("foo", 2000))
+			suite.ExecuteUpgradeFeeTokenProposal("foo", initialPoolId)
+			expectedPoolId := tc.createDeeperPools(baseDenom, initialPoolId)
+
+			// A deeper pool created within the TWAP window is not chosen yet.
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.FeeTokenTwapWindow))
+			tooYoungPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1_000_000_000_000), sdk.NewInt64Coin("foo", 1_000_000_000_000))
+
+			suite.App.TxFeesKeeper.RefreshFeeTokenPools(suite.Ctx)
+
+			feeToken, err := suite.App.TxFeesKeeper.GetFeeToken(suite.Ctx, "foo")
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedPoolId, feeToken.PoolID)
+			suite.Require().NotEqual(tooYoungPoolId, feeToken.PoolID)
+
+			converted, err := suite.App.TxFeesKeeper.ConvertToBaseToken(suite.Ctx, sdk.NewInt64Coin("foo", 10))
+			suite.Require().NoError(err)
+			suite.Require().True(converted.IsPositive())
+
+			// Once its TWAP is available, the deepest pool is chosen at the end of the next epoch.
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.FeeTokenTwapWindow))
+			err = suite.App.TxFeesKeeper.AfterEpochEnd(suite.Ctx, "day", 1)
+			suite.Require().NoError(err)
+
+			feeToken, err = suite.App.TxFeesKeeper.GetFeeToken(suite.Ctx, "foo")
+			suite.Require().NoError(err)
+			suite.Require().Equal(tooYoungPoolId, feeToken.PoolID)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestIndexBaseDenomPools() {
+	suite.SetupTest(false)
+	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+
+	// Pools are indexed on creation.
+	fooPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1000), sdk.NewInt64Coin("foo", 2000))
+	multiAssetPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1000), sdk.NewInt64Coin("foo", 2000), sdk.NewInt64Coin("bar", 2000))
+	suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 1000), sdk.NewInt64Coin("bar", 2000))
+	clPool := suite.PrepareCustomConcentratedPool(suite.TestAccs[0], "foo", baseDenom, apptesting.DefaultTickSpacing, apptesting.DefaultExponentAtPriceOne, sdk.ZeroDec())
+
+	expectedFooPoolIds := []uint64{fooPoolId, multiAssetPoolId, clPool.GetId()}
+	expectedBarPoolIds := []uint64{multiAssetPoolId}
+	suite.Require().Equal(expectedFooPoolIds, suite.App.TxFeesKeeper.GetBaseDenomPoolIds(suite.Ctx, "foo"))
+	suite.Require().Equal(expectedBarPoolIds, suite.App.TxFeesKeeper.GetBaseDenomPoolIds(suite.Ctx, "bar"))
+	suite.Require().Empty(suite.App.TxFeesKeeper.GetBaseDenomPoolIds(suite.Ctx, baseDenom))
+
+	// Existing pools are indexed the same way, e.g. at genesis.
+	store := suite.App.GetKey(types.StoreKey)
+	for _, key := range [][]byte{
+		types.FormatBaseDenomPoolKey("foo", fooPoolId),
+		types.FormatBaseDenomPoolKey("foo", multiAssetPoolId),
+		types.FormatBaseDenomPoolKey("foo", clPool.GetId()),
+		types.FormatBaseDenomPoolKey("bar", multiAssetPoolId),
+	} {
+		suite.Ctx.KVStore(store).Delete(key)
+	}
+	suite.Require().Empty(suite.App.TxFeesKeeper.GetBaseDenomPoolIds(suite.Ctx, "foo"))
+
+	suite.App.TxFeesKeeper.IndexBaseDenomPools(suite.Ctx)
+	suite.Require().Equal(expectedFooPoolIds, suite.App.TxFeesKeeper.GetBaseDenomPoolIds(suite.Ctx, "foo"))
+	suite.Require().Equal(expectedBarPoolIds, suite.App.TxFeesKeeper.GetBaseDenomPoolIds(suite.Ctx, "bar"))
+}
+
+func (suite *KeeperTestSuite) getConcentratedPool(poolId uint64) cltypes.ConcentratedPoolExtension {
+	poolI, err := suite.App.ConcentratedLiquidityKeeper.GetPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	return poolI.(cltypes.ConcentratedPoolExtension)
+}
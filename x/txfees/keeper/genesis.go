@@ -17,6 +17,8 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if err != nil {
 		panic(err)
 	}
+	// The pools imported at genesis are not created through the pool creation hooks.
+	k.IndexBaseDenomPools(ctx)
 }
 
 // ExportGenesis returns the txfees module's exported genesis.
@@ -4,7 +4,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	cltypes "github.com/osmosis-labs/osmosis/v14/x/concentrated-liquidity/types"
 	epochstypes "github.com/osmosis-labs/osmosis/v14/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v14/x/gamm/types"
 	txfeestypes "github.com/osmosis-labs/osmosis/v14/x/txfees/types"
 )
 
@@ -12,8 +14,11 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 	return nil
 }
 
-// at the end of each epoch, swap all non-OSMO fees into OSMO and transfer to fee module account
+// at the end of each epoch, refresh the pools of the fee tokens,
+// then swap all non-OSMO fees into OSMO and transfer to fee module account
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	k.RefreshFeeTokenPools(ctx)
+
 	nonNativeFeeAddr := k.accountKeeper.GetModuleAddress(txfeestypes.NonNativeFeeCollectorName)
 	baseDenom, _ := k.GetBaseDenom(ctx)
 	feeTokens := k.GetFeeTokens(ctx)
@@ -69,3 +74,64 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	return h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+type gammHooks struct {
+	k Keeper
+}
+
+var _ gammtypes.GammHooks = gammHooks{}
+
+// GammHooks returns the gamm hooks that index the created pools trading the base denom.
+func (k Keeper) GammHooks() gammtypes.GammHooks {
+	return gammHooks{k}
+}
+
+// AfterPoolCreated indexes the pool if it trades the base denom.
+func (h gammHooks) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	h.k.indexBaseDenomPool(ctx, poolId)
+}
+
+// AfterJoinPool hook is a noop.
+func (h gammHooks) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+}
+
+// AfterExitPool hook is a noop.
+func (h gammHooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+}
+
+// AfterSwap hook is a noop.
+func (h gammHooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+}
+
+type concentratedLiquidityListener struct {
+	k Keeper
+}
+
+var _ cltypes.ConcentratedLiquidityListener = concentratedLiquidityListener{}
+
+// ConcentratedLiquidityListener returns the listener that indexes the created
+// concentrated liquidity pools trading the base denom.
+func (k Keeper) ConcentratedLiquidityListener() cltypes.ConcentratedLiquidityListener {
+	return concentratedLiquidityListener{k}
+}
+
+// AfterConcentratedPoolCreated indexes the pool if it trades the base denom.
+func (l concentratedLiquidityListener) AfterConcentratedPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	l.k.indexBaseDenomPool(ctx, poolId)
+}
+
+// AfterConcentratedPoolSwap is a noop.
+func (l concentratedLiquidityListener) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+}
+
+// AfterPositionCreated is a noop.
+func (l concentratedLiquidityListener) AfterPositionCreated(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidity sdk.Dec) {
+}
+
+// AfterPositionModified is a noop.
+func (l concentratedLiquidityListener) AfterPositionModified(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensIn sdk.Coins, liquidityDelta sdk.Dec) {
+}
+
+// AfterPositionWithdrawn is a noop.
+func (l concentratedLiquidityListener) AfterPositionWithdrawn(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, positionId uint64, tokensOut sdk.Coins, liquidity sdk.Dec) {
+}
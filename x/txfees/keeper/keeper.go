@@ -18,6 +18,7 @@ type Keeper struct {
 	bankKeeper          types.BankKeeper
 	poolManager         types.PoolManager
 	spotPriceCalculator types.SpotPriceCalculator
+	twapKeeper          types.TwapKeeper
 }
 
 var _ types.TxFeesKeeper = (*Keeper)(nil)
@@ -28,6 +29,7 @@ func NewKeeper(
 	storeKey sdk.StoreKey,
 	poolManager types.PoolManager,
 	spotPriceCalculator types.SpotPriceCalculator,
+	twapKeeper types.TwapKeeper,
 ) Keeper {
 	return Keeper{
 		accountKeeper:       accountKeeper,
@@ -35,6 +37,7 @@ func NewKeeper(
 		storeKey:            storeKey,
 		poolManager:         poolManager,
 		spotPriceCalculator: spotPriceCalculator,
+		twapKeeper:          twapKeeper,
 	}
 }
 
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -8,7 +10,7 @@ import (
 )
 
 // SpotPriceCalculator defines the contract that must be fulfilled by a spot price calculator
-// The x/gamm keeper is expected to satisfy this interface.
+// The x/poolmanager keeper is expected to satisfy this interface.
 type SpotPriceCalculator interface {
 	CalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteDenom, baseDenom string) (sdk.Dec, error)
 }
@@ -30,6 +32,23 @@ type PoolManager interface {
 		tokenOutDenom string,
 		tokenOutMinAmount sdk.Int,
 	) (sdk.Int, error)
+
+	GetNextPoolId(ctx sdk.Context) uint64
+
+	GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
+
+	GetActiveLiquidityReserves(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
+}
+
+// TwapKeeper defines the contract needed for TWAP related APIs.
+type TwapKeeper interface {
+	GetGeometricTwapToNow(
+		ctx sdk.Context,
+		poolId uint64,
+		baseAssetDenom string,
+		quoteAssetDenom string,
+		startTime time.Time,
+	) (sdk.Dec, error)
 }
 
 // AccountKeeper defines the contract needed for AccountKeeper related APIs.
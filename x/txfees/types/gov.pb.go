@@ -24,10 +24,11 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // UpdateFeeTokenProposal is a gov Content type for adding a new whitelisted fee
-// token. It must specify a denom along with gamm pool ID to use as a spot price
-// calculator. It can be used to add a new denom to the whitelist It can also be
-// used to update the Pool to associate with the denom. If Pool ID is set to 0,
-// it will remove the denom from the whitelisted set.
+// token. It must specify a denom along with a pool ID to use as a TWAP
+// calculator until the end of the next epoch, after which the deepest pool of
+// the denom against the base denom is used. It can be used to add a new denom
+// to the whitelist. If Pool ID is set to 0, it will remove the denom from the
+// whitelisted set.
 type UpdateFeeTokenProposal struct {
 	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
 	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
@@ -74,25 +75,25 @@ func init() { proto.RegisterFile("osmosis/txfees/v1beta1/gov.proto", fileDescrip
 
 var fileDescriptor_2c4a51bafc82863d = []byte{
 	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xa9, 0x48, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0xcf, 0x2f, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0xaa,
-	0xd0, 0x83, 0xa8, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07,
-	0xb1, 0x20, 0xaa, 0xa5, 0x54, 0x71, 0x98, 0x97, 0x96, 0x9a, 0x5a, 0x92, 0x9f, 0x9d, 0x9a, 0x07,
-	0x51, 0xa6, 0xf4, 0x80, 0x91, 0x4b, 0x2c, 0xb4, 0x20, 0x25, 0xb1, 0x24, 0xd5, 0x2d, 0x35, 0x35,
-	0x04, 0x24, 0x11, 0x50, 0x94, 0x5f, 0x90, 0x5f, 0x9c, 0x98, 0x23, 0xa4, 0xc6, 0xc5, 0x5a, 0x92,
-	0x59, 0x92, 0x93, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf0, 0xe9, 0x9e, 0x3c, 0x4f,
-	0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x58, 0x58, 0x29, 0x08, 0x22, 0x2d, 0x64, 0xc1, 0xc5, 0x9d,
-	0x92, 0x5a, 0x9c, 0x5c, 0x94, 0x59, 0x50, 0x92, 0x99, 0x9f, 0x27, 0xc1, 0x04, 0x56, 0x2d, 0xf6,
-	0xe9, 0x9e, 0xbc, 0x10, 0x44, 0x35, 0x92, 0xa4, 0x52, 0x10, 0xb2, 0x52, 0xa1, 0x30, 0x2e, 0x0e,
-	0x98, 0x73, 0x24, 0x98, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x14, 0xf4, 0xb0, 0x7b, 0x52, 0x0f, 0xe6,
-	0x3a, 0x27, 0x89, 0x13, 0xf7, 0xe4, 0x19, 0x3e, 0xdd, 0x93, 0x17, 0x80, 0x18, 0x9e, 0x96, 0x9a,
-	0x1a, 0x0f, 0x36, 0x40, 0x29, 0x08, 0x6e, 0x96, 0x15, 0x4f, 0xc7, 0x02, 0x79, 0x86, 0x19, 0x0b,
-	0xe4, 0x19, 0x5e, 0x2c, 0x90, 0x67, 0x74, 0xf2, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
-	0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63,
-	0x39, 0x86, 0x28, 0xa3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xa8,
-	0xbd, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x30, 0x8e, 0x7e, 0x99, 0xa1, 0x89, 0x7e, 0x05, 0x2c, 0x04,
-	0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xe1, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0xd0, 0x31, 0x4f, 0xf2, 0x40,
+	0x18, 0x07, 0xf0, 0xbb, 0xf7, 0x8d, 0x46, 0x0b, 0x03, 0x69, 0x0c, 0x69, 0x18, 0xee, 0x9a, 0x26,
+	0x1a, 0x16, 0xef, 0x02, 0x3a, 0x18, 0x46, 0x06, 0x27, 0x07, 0x43, 0xd4, 0xc1, 0xc5, 0xb4, 0xf0,
+	0x50, 0x1b, 0x0b, 0x4f, 0xc3, 0x9d, 0x04, 0xbe, 0x81, 0xa3, 0xa3, 0x23, 0x1f, 0x87, 0x91, 0xd1,
+	0xa9, 0x21, 0xb0, 0x38, 0xf7, 0x13, 0x18, 0x7a, 0xad, 0x61, 0xd0, 0xad, 0xbd, 0xff, 0x2f, 0xff,
+	0x7b, 0xee, 0xb1, 0x5c, 0x54, 0x23, 0x54, 0x91, 0x92, 0x7a, 0x36, 0x04, 0x50, 0x72, 0xda, 0x0a,
+	0x40, 0xfb, 0x2d, 0x19, 0xe2, 0x54, 0x24, 0x13, 0xd4, 0x68, 0xd7, 0x0b, 0x21, 0x8c, 0x10, 0x85,
+	0x68, 0x9c, 0x84, 0x18, 0x62, 0x4e, 0xe4, 0xee, 0xcb, 0xe8, 0xc6, 0xe9, 0x1f, 0x7d, 0x43, 0x00,
+	0x8d, 0x2f, 0x30, 0x36, 0xcc, 0x5b, 0x53, 0xab, 0x7e, 0x9f, 0x0c, 0x7c, 0x0d, 0xd7, 0x00, 0x77,
+	0xbb, 0xe0, 0x76, 0x82, 0x09, 0x2a, 0x3f, 0xb6, 0xcf, 0xac, 0x03, 0x1d, 0xe9, 0x18, 0x1c, 0xea,
+	0xd2, 0xe6, 0x71, 0xb7, 0x96, 0xa5, 0xbc, 0x3a, 0xf7, 0x47, 0x71, 0xc7, 0xcb, 0x8f, 0xbd, 0x9e,
+	0x89, 0xed, 0x2b, 0xab, 0x32, 0x00, 0xd5, 0x9f, 0x44, 0x89, 0x8e, 0x70, 0xec, 0xfc, 0xcb, 0x75,
+	0x3d, 0x4b, 0xb9, 0x6d, 0xf4, 0x5e, 0xe8, 0xf5, 0xf6, 0xa9, 0xfd, 0x60, 0x1d, 0x95, 0xe3, 0x38,
+	0xff, 0x5d, 0xda, 0xac, 0xb4, 0x5d, 0xf1, 0xfb, 0x23, 0x45, 0x39, 0x5d, 0xd7, 0x59, 0xa6, 0x9c,
+	0x64, 0x29, 0xaf, 0x99, 0xf2, 0x21, 0xc0, 0x53, 0x5e, 0xe0, 0xf5, 0x7e, 0xba, 0x3a, 0xd5, 0xb7,
+	0x05, 0x27, 0x1f, 0x0b, 0x4e, 0xbe, 0x16, 0x9c, 0x76, 0x6f, 0x96, 0x1b, 0x46, 0x57, 0x1b, 0x46,
+	0xd7, 0x1b, 0x46, 0xdf, 0xb7, 0x8c, 0xac, 0xb6, 0x8c, 0x7c, 0x6e, 0x19, 0x79, 0x6c, 0x87, 0x91,
+	0x7e, 0x7e, 0x0d, 0x44, 0x1f, 0x47, 0xb2, 0xb8, 0xf7, 0x3c, 0xf6, 0x03, 0x55, 0xfe, 0xc8, 0x69,
+	0xeb, 0x52, 0xce, 0xca, 0x0d, 0xea, 0x79, 0x02, 0x2a, 0x38, 0xcc, 0xf7, 0x76, 0xf1, 0x3d, 0x00,
 	0x96, 0x6e, 0x0f, 0x2a, 0xb0, 0x01, 0x00, 0x00,
 }
 
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name.
 	ModuleName = "txfees"
@@ -18,9 +25,27 @@ const (
 
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
+
+	// FeeTokenTwapWindow is the window of the geometric TWAP used to convert fee tokens to the base denom.
+	FeeTokenTwapWindow = time.Minute
 )
 
 var (
-	BaseDenomKey         = []byte("base_denom")
-	FeeTokensStorePrefix = []byte("fee_tokens")
+	BaseDenomKey              = []byte("base_denom")
+	FeeTokensStorePrefix      = []byte("fee_tokens")
+	BaseDenomPoolsStorePrefix = []byte("denom_base_pools")
+
+	// KeySeparator separates the denom from the pool id in base denom pool keys.
+	// Denoms cannot contain it.
+	KeySeparator = "|"
 )
+
+// FormatBaseDenomPoolsPrefix returns the prefix of the keys of the pools trading the given denom against the base denom.
+func FormatBaseDenomPoolsPrefix(denom string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s", BaseDenomPoolsStorePrefix, denom, KeySeparator))
+}
+
+// FormatBaseDenomPoolKey returns the key recording that the given pool trades the given denom against the base denom.
+func FormatBaseDenomPoolKey(denom string, poolId uint64) []byte {
+	return append(FormatBaseDenomPoolsPrefix(denom), sdk.Uint64ToBigEndian(poolId)...)
+}